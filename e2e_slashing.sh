@@ -173,16 +173,17 @@ sleep 20
 END_H=$(timeout 10s curl -s http://127.0.0.1:26657/status | jq -r .result.sync_info.latest_block_height)
 echo "New Height: $END_H"
 
-# Check balance of Provider 2 (who did nothing)
+# Check the bond of Provider 2 (who did nothing)
 PROVIDER2_ADDR=$($BINARY keys show provider2 -a --home $HOME_DIR --keyring-backend test | tail -n 1)
-BAL_P2=$($BINARY query bank balances $PROVIDER2_ADDR --home $HOME_DIR --output json | jq -r '.balances[] | select(.denom=="stake") | .amount')
-echo "Provider 2 Stake Balance: $BAL_P2"
+BOND_P2=$($BINARY query nilchain get-provider-bond --address $PROVIDER2_ADDR --home $HOME_DIR --output json | jq -r '.bond.amount')
+REQUIRED_P2=$($BINARY query nilchain get-provider-bond --address $PROVIDER2_ADDR --home $HOME_DIR --output json | jq -r '.required_bond.amount')
+echo "Provider 2 Bond: $BOND_P2 (required: $REQUIRED_P2)"
 
-# Initial balance was 200000000 stake. Slashing deducts 10000000 stake.
-if [ "$BAL_P2" -lt "200000000" ]; then
+# Registration locks exactly the required bond. Slashing burns part of it.
+if [ "$BOND_P2" -lt "$REQUIRED_P2" ]; then
     echo "SUCCESS: Provider 2 was slashed for downtime."
 else
-    echo "FAILURE: Provider 2 was NOT slashed (Balance: $BAL_P2)."
+    echo "FAILURE: Provider 2 was NOT slashed (Bond: $BOND_P2)."
 fi
 
 # Cleanup
@@ -3,7 +3,12 @@ import assert from 'node:assert/strict'
 import { hashTypedData, recoverTypedDataAddress } from 'viem'
 import { privateKeyToAccount } from 'viem/accounts'
 
-import { buildCreateDealTypedData, buildUpdateContentTypedData } from './eip712'
+import {
+  buildCloseDealTypedData,
+  buildCreateDealTypedData,
+  buildTransferDealTypedData,
+  buildUpdateContentTypedData,
+} from './eip712'
 
 const CHAIN_ID = 31337
 const TEST_PRIVKEY =
@@ -62,3 +67,44 @@ test('UpdateContent typed data hashes to chain digest', async () => {
   const recovered = await recoverTypedDataAddress({ ...viemTypedData, signature })
   assert.equal(recovered.toLowerCase(), TEST_ACCOUNT.address.toLowerCase())
 })
+
+test('CloseDeal typed data hashes to chain digest', async () => {
+  const intent = {
+    creator_evm: TEST_ACCOUNT.address,
+    deal_id: 7,
+    nonce: 3,
+  }
+
+  const typedData = buildCloseDealTypedData(intent, CHAIN_ID)
+  const viemTypedData = asViemTypedData(typedData)
+  const digest = hashTypedData(viemTypedData)
+  assert.equal(
+    digest.toLowerCase(),
+    '0x9e2dcd8c683f3d05c960bb85ada21140b52a3dbe435deaf7278401c0c83cdcc0',
+  )
+
+  const signature = await TEST_ACCOUNT.signTypedData(viemTypedData)
+  const recovered = await recoverTypedDataAddress({ ...viemTypedData, signature })
+  assert.equal(recovered.toLowerCase(), TEST_ACCOUNT.address.toLowerCase())
+})
+
+test('TransferDeal typed data hashes to chain digest', async () => {
+  const intent = {
+    creator_evm: TEST_ACCOUNT.address,
+    deal_id: 7,
+    new_owner: '0x000000000000000000000000000000000000dEaD',
+    nonce: 4,
+  }
+
+  const typedData = buildTransferDealTypedData(intent, CHAIN_ID)
+  const viemTypedData = asViemTypedData(typedData)
+  const digest = hashTypedData(viemTypedData)
+  assert.equal(
+    digest.toLowerCase(),
+    '0xeed827c304d4b893d4831d4374040e8f02a791520e3c7760b21c2427a0441a96',
+  )
+
+  const signature = await TEST_ACCOUNT.signTypedData(viemTypedData)
+  const recovered = await recoverTypedDataAddress({ ...viemTypedData, signature })
+  assert.equal(recovered.toLowerCase(), TEST_ACCOUNT.address.toLowerCase())
+})
@@ -38,6 +38,25 @@ export const UpdateContentTypes = {
   ],
 } as const
 
+export const CloseDealTypes = {
+  EIP712Domain: EIP712DomainTypes,
+  CloseDeal: [
+    { name: 'creator', type: 'address' },
+    { name: 'deal_id', type: 'uint64' },
+    { name: 'nonce', type: 'uint64' },
+  ],
+} as const
+
+export const TransferDealTypes = {
+  EIP712Domain: EIP712DomainTypes,
+  TransferDeal: [
+    { name: 'creator', type: 'address' },
+    { name: 'deal_id', type: 'uint64' },
+    { name: 'new_owner', type: 'string' },
+    { name: 'nonce', type: 'uint64' },
+  ],
+} as const
+
 export interface CreateDealIntent {
   creator_evm: string
   duration_blocks: number
@@ -55,6 +74,21 @@ export interface UpdateContentIntent {
   nonce: number
 }
 
+export interface CloseDealIntent {
+  creator_evm: string
+  deal_id: number
+  nonce: number
+}
+
+// Signed by the owner to propose new_owner (empty cancels), or by the pending
+// owner with new_owner set to its own address to accept.
+export interface TransferDealIntent {
+  creator_evm: string
+  deal_id: number
+  new_owner: string
+  nonce: number
+}
+
 export function buildCreateDealTypedData(intent: CreateDealIntent, chainId: number) {
   return {
     domain: {
@@ -96,6 +130,43 @@ export function buildUpdateContentTypedData(intent: UpdateContentIntent, chainId
   }
 }
 
+export function buildCloseDealTypedData(intent: CloseDealIntent, chainId: number) {
+  return {
+    domain: {
+      name: EIP712_DOMAIN_NAME,
+      version: EIP712_DOMAIN_VERSION,
+      chainId,
+      verifyingContract: EIP712_VERIFYING_CONTRACT,
+    },
+    types: CloseDealTypes,
+    primaryType: 'CloseDeal' as const,
+    message: {
+      creator: intent.creator_evm,
+      deal_id: Number(intent.deal_id),
+      nonce: Number(intent.nonce),
+    },
+  }
+}
+
+export function buildTransferDealTypedData(intent: TransferDealIntent, chainId: number) {
+  return {
+    domain: {
+      name: EIP712_DOMAIN_NAME,
+      version: EIP712_DOMAIN_VERSION,
+      chainId,
+      verifyingContract: EIP712_VERIFYING_CONTRACT,
+    },
+    types: TransferDealTypes,
+    primaryType: 'TransferDeal' as const,
+    message: {
+      creator: intent.creator_evm,
+      deal_id: Number(intent.deal_id),
+      new_owner: intent.new_owner,
+      nonce: Number(intent.nonce),
+    },
+  }
+}
+
 export const RetrievalReceiptTypes = {
   EIP712Domain: EIP712DomainTypes,
   RetrievalReceipt: [
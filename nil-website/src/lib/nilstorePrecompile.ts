@@ -167,6 +167,496 @@ export const NILSTORE_PRECOMPILE_ABI = [
     inputs: [{ name: 'sessionIds', type: 'bytes32[]' }],
     outputs: [{ name: 'ok', type: 'bool' }],
   },
+  {
+    type: 'function',
+    name: 'transferDealOwnership',
+    stateMutability: 'nonpayable',
+    inputs: [
+      { name: 'dealId', type: 'uint64' },
+      { name: 'newOwner', type: 'string' },
+    ],
+    outputs: [{ name: 'ok', type: 'bool' }],
+  },
+  {
+    type: 'function',
+    name: 'acceptDealOwnership',
+    stateMutability: 'nonpayable',
+    inputs: [{ name: 'dealId', type: 'uint64' }],
+    outputs: [{ name: 'ok', type: 'bool' }],
+  },
+  {
+    type: 'function',
+    name: 'transferDealOwnershipWithSig',
+    stateMutability: 'nonpayable',
+    inputs: [
+      { name: 'dealId', type: 'uint64' },
+      { name: 'newOwner', type: 'string' },
+      { name: 'signer', type: 'address' },
+      { name: 'nonce', type: 'uint64' },
+      { name: 'signature', type: 'bytes' },
+    ],
+    outputs: [{ name: 'ok', type: 'bool' }],
+  },
+  {
+    type: 'function',
+    name: 'registerProvider',
+    stateMutability: 'nonpayable',
+    inputs: [
+      { name: 'capabilities', type: 'string' },
+      { name: 'totalStorage', type: 'uint64' },
+      { name: 'endpoints', type: 'string[]' },
+      { name: 'bond', type: 'uint256' },
+      { name: 'operatorId', type: 'string' },
+      { name: 'region', type: 'string' },
+      { name: 'hostGroup', type: 'string' },
+    ],
+    outputs: [{ name: 'ok', type: 'bool' }],
+  },
+  {
+    type: 'function',
+    name: 'updateProvider',
+    stateMutability: 'nonpayable',
+    inputs: [
+      { name: 'endpoints', type: 'string[]' },
+      { name: 'totalStorage', type: 'uint64' },
+      { name: 'capabilities', type: 'string' },
+    ],
+    outputs: [{ name: 'ok', type: 'bool' }],
+  },
+  {
+    type: 'function',
+    name: 'setProviderStatus',
+    stateMutability: 'nonpayable',
+    inputs: [{ name: 'status', type: 'string' }],
+    outputs: [{ name: 'status', type: 'string' }],
+  },
+  {
+    type: 'function',
+    name: 'topUpProviderBond',
+    stateMutability: 'nonpayable',
+    inputs: [{ name: 'amount', type: 'uint256' }],
+    outputs: [{ name: 'bond', type: 'uint256' }],
+  },
+  {
+    type: 'function',
+    name: 'unbondProviderBond',
+    stateMutability: 'nonpayable',
+    inputs: [{ name: 'amount', type: 'uint256' }],
+    outputs: [{ name: 'completionHeight', type: 'uint64' }],
+  },
+  {
+    type: 'function',
+    name: 'deregisterProvider',
+    stateMutability: 'nonpayable',
+    inputs: [],
+    outputs: [
+      { name: 'pendingMigrations', type: 'uint64' },
+      { name: 'completionHeight', type: 'uint64' },
+    ],
+  },
+  {
+    type: 'function',
+    name: 'withdrawRewards',
+    stateMutability: 'nonpayable',
+    inputs: [],
+    outputs: [
+      { name: 'amount', type: 'uint256' },
+      { name: 'storageRewards', type: 'uint256' },
+      { name: 'bandwidthRewards', type: 'uint256' },
+    ],
+  },
+  {
+    type: 'function',
+    name: 'addCredit',
+    stateMutability: 'nonpayable',
+    inputs: [
+      { name: 'dealId', type: 'uint64' },
+      { name: 'amount', type: 'uint256' },
+    ],
+    outputs: [{ name: 'newBalance', type: 'uint256' }],
+  },
+  {
+    type: 'function',
+    name: 'extendDeal',
+    stateMutability: 'nonpayable',
+    inputs: [
+      { name: 'dealId', type: 'uint64' },
+      { name: 'additionalBlocks', type: 'uint64' },
+    ],
+    outputs: [
+      { name: 'endBlock', type: 'uint64' },
+      { name: 'escrowAdded', type: 'uint256' },
+      { name: 'escrowBalance', type: 'uint256' },
+    ],
+  },
+  {
+    type: 'function',
+    name: 'closeDeal',
+    stateMutability: 'nonpayable',
+    inputs: [{ name: 'dealId', type: 'uint64' }],
+    outputs: [
+      { name: 'providerPayout', type: 'uint256' },
+      { name: 'escrowRefund', type: 'uint256' },
+      { name: 'sessionsSettled', type: 'uint64' },
+    ],
+  },
+  {
+    type: 'function',
+    name: 'signalSaturation',
+    stateMutability: 'nonpayable',
+    inputs: [{ name: 'dealId', type: 'uint64' }],
+    outputs: [{ name: 'newProviders', type: 'string[]' }],
+  },
+  {
+    type: 'function',
+    name: 'startSlotRepair',
+    stateMutability: 'nonpayable',
+    inputs: [
+      { name: 'dealId', type: 'uint64' },
+      { name: 'slot', type: 'uint32' },
+      { name: 'pendingProvider', type: 'string' },
+    ],
+    outputs: [{ name: 'ok', type: 'bool' }],
+  },
+  {
+    type: 'function',
+    name: 'completeSlotRepair',
+    stateMutability: 'nonpayable',
+    inputs: [
+      { name: 'dealId', type: 'uint64' },
+      { name: 'slot', type: 'uint32' },
+      {
+        name: 'proofs',
+        type: 'tuple[]',
+        components: [
+          { name: 'mduIndex', type: 'uint64' },
+          { name: 'mduRootFr', type: 'bytes' },
+          { name: 'manifestOpening', type: 'bytes' },
+          { name: 'blobCommitment', type: 'bytes' },
+          { name: 'merklePath', type: 'bytes[]' },
+          { name: 'blobIndex', type: 'uint32' },
+          { name: 'zValue', type: 'bytes' },
+          { name: 'yValue', type: 'bytes' },
+          { name: 'kzgOpeningProof', type: 'bytes' },
+        ],
+      },
+    ],
+    outputs: [{ name: 'ok', type: 'bool' }],
+  },
+  {
+    type: 'function',
+    name: 'cancelRetrievalSession',
+    stateMutability: 'nonpayable',
+    inputs: [{ name: 'sessionId', type: 'bytes32' }],
+    outputs: [{ name: 'ok', type: 'bool' }],
+  },
+  {
+    type: 'function',
+    name: 'submitRetrievalSessionProof',
+    stateMutability: 'nonpayable',
+    inputs: [
+      { name: 'sessionId', type: 'bytes32' },
+      {
+        name: 'proofs',
+        type: 'tuple[]',
+        components: [
+          { name: 'mduIndex', type: 'uint64' },
+          { name: 'mduRootFr', type: 'bytes' },
+          { name: 'manifestOpening', type: 'bytes' },
+          { name: 'blobCommitment', type: 'bytes' },
+          { name: 'merklePath', type: 'bytes[]' },
+          { name: 'blobIndex', type: 'uint32' },
+          { name: 'zValue', type: 'bytes' },
+          { name: 'yValue', type: 'bytes' },
+          { name: 'kzgOpeningProof', type: 'bytes' },
+        ],
+      },
+    ],
+    outputs: [{ name: 'ok', type: 'bool' }],
+  },
+  {
+    type: 'function',
+    name: 'proveLiveness',
+    stateMutability: 'nonpayable',
+    inputs: [
+      { name: 'dealId', type: 'uint64' },
+      { name: 'epochId', type: 'uint64' },
+      {
+        name: 'proof',
+        type: 'tuple',
+        components: [
+          { name: 'mduIndex', type: 'uint64' },
+          { name: 'mduRootFr', type: 'bytes' },
+          { name: 'manifestOpening', type: 'bytes' },
+          { name: 'blobCommitment', type: 'bytes' },
+          { name: 'merklePath', type: 'bytes[]' },
+          { name: 'blobIndex', type: 'uint32' },
+          { name: 'zValue', type: 'bytes' },
+          { name: 'yValue', type: 'bytes' },
+          { name: 'kzgOpeningProof', type: 'bytes' },
+        ],
+      },
+    ],
+    outputs: [
+      { name: 'ok', type: 'bool' },
+      { name: 'tier', type: 'uint32' },
+    ],
+  },
+  {
+    type: 'function',
+    name: 'grantDealAccess',
+    stateMutability: 'nonpayable',
+    inputs: [
+      { name: 'dealId', type: 'uint64' },
+      { name: 'grantee', type: 'string' },
+      { name: 'expiresAt', type: 'uint64' },
+      { name: 'maxBytes', type: 'uint64' },
+      { name: 'maxFee', type: 'uint256' },
+    ],
+    outputs: [{ name: 'ok', type: 'bool' }],
+  },
+  {
+    type: 'function',
+    name: 'revokeDealAccess',
+    stateMutability: 'nonpayable',
+    inputs: [
+      { name: 'dealId', type: 'uint64' },
+      { name: 'grantee', type: 'string' },
+    ],
+    outputs: [{ name: 'ok', type: 'bool' }],
+  },
+  {
+    type: 'function',
+    name: 'registerSessionKey',
+    stateMutability: 'nonpayable',
+    inputs: [
+      { name: 'sessionKey', type: 'address' },
+      { name: 'dealIds', type: 'uint64[]' },
+      { name: 'expiresAt', type: 'uint64' },
+      { name: 'maxBytes', type: 'uint64' },
+      { name: 'maxFee', type: 'uint256' },
+    ],
+    outputs: [{ name: 'ok', type: 'bool' }],
+  },
+  {
+    type: 'function',
+    name: 'revokeSessionKey',
+    stateMutability: 'nonpayable',
+    inputs: [{ name: 'sessionKey', type: 'address' }],
+    outputs: [{ name: 'ok', type: 'bool' }],
+  },
+  {
+    type: 'function',
+    name: 'submitFraudProof',
+    stateMutability: 'nonpayable',
+    inputs: [
+      { name: 'dealId', type: 'uint64' },
+      { name: 'provider', type: 'string' },
+      { name: 'generation', type: 'uint64' },
+      { name: 'manifestRoot', type: 'bytes' },
+      {
+        name: 'proof',
+        type: 'tuple',
+        components: [
+          { name: 'mduIndex', type: 'uint64' },
+          { name: 'mduRootFr', type: 'bytes' },
+          { name: 'manifestOpening', type: 'bytes' },
+          { name: 'blobCommitment', type: 'bytes' },
+          { name: 'merklePath', type: 'bytes[]' },
+          { name: 'blobIndex', type: 'uint32' },
+          { name: 'zValue', type: 'bytes' },
+          { name: 'yValue', type: 'bytes' },
+          { name: 'kzgOpeningProof', type: 'bytes' },
+        ],
+      },
+      { name: 'data', type: 'bytes' },
+      { name: 'providerSignature', type: 'bytes' },
+    ],
+    outputs: [
+      { name: 'reason', type: 'string' },
+      { name: 'reward', type: 'uint256' },
+    ],
+  },
+  {
+    type: 'function',
+    name: 'getDeal',
+    stateMutability: 'view',
+    inputs: [{ name: 'dealId', type: 'uint64' }],
+    outputs: [
+      {
+        name: 'deal',
+        type: 'tuple',
+        components: [
+          { name: 'id', type: 'uint64' },
+          { name: 'owner', type: 'address' },
+          { name: 'pendingOwner', type: 'address' },
+          { name: 'manifestRoot', type: 'bytes' },
+          { name: 'sizeBytes', type: 'uint64' },
+          { name: 'escrowBalance', type: 'uint256' },
+          { name: 'maxMonthlySpend', type: 'uint256' },
+          { name: 'startBlock', type: 'uint64' },
+          { name: 'endBlock', type: 'uint64' },
+          { name: 'redundancyMode', type: 'uint32' },
+          { name: 'currentReplication', type: 'uint64' },
+          { name: 'serviceHint', type: 'string' },
+          { name: 'totalMdus', type: 'uint64' },
+          { name: 'witnessMdus', type: 'uint64' },
+          { name: 'currentGen', type: 'uint64' },
+          { name: 'status', type: 'uint8' },
+        ],
+      },
+    ],
+  },
+  {
+    type: 'function',
+    name: 'getDealProviders',
+    stateMutability: 'view',
+    inputs: [{ name: 'dealId', type: 'uint64' }],
+    outputs: [{ name: 'providers', type: 'string[]' }],
+  },
+  {
+    type: 'function',
+    name: 'getMode2Slots',
+    stateMutability: 'view',
+    inputs: [{ name: 'dealId', type: 'uint64' }],
+    outputs: [
+      {
+        name: 'slots',
+        type: 'tuple[]',
+        components: [
+          { name: 'slot', type: 'uint32' },
+          { name: 'provider', type: 'string' },
+          { name: 'status', type: 'uint8' },
+          { name: 'pendingProvider', type: 'string' },
+          { name: 'statusSinceHeight', type: 'int64' },
+          { name: 'repairTargetGen', type: 'uint64' },
+          { name: 'repairDeadlineHeight', type: 'uint64' },
+        ],
+      },
+    ],
+  },
+  {
+    type: 'function',
+    name: 'getProvider',
+    stateMutability: 'view',
+    inputs: [{ name: 'provider', type: 'string' }],
+    outputs: [
+      {
+        name: 'info',
+        type: 'tuple',
+        components: [
+          { name: 'provider', type: 'string' },
+          { name: 'totalStorage', type: 'uint64' },
+          { name: 'usedStorage', type: 'uint64' },
+          { name: 'reservedStorage', type: 'uint64' },
+          { name: 'capabilities', type: 'string' },
+          { name: 'status', type: 'string' },
+          { name: 'reputationScore', type: 'int64' },
+          { name: 'endpoints', type: 'string[]' },
+          {
+            name: 'bond',
+            type: 'tuple',
+            components: [
+              { name: 'denom', type: 'string' },
+              { name: 'amount', type: 'uint256' },
+            ],
+          },
+        ],
+      },
+    ],
+  },
+  {
+    type: 'function',
+    name: 'getRetrievalSession',
+    stateMutability: 'view',
+    inputs: [{ name: 'sessionId', type: 'bytes32' }],
+    outputs: [
+      {
+        name: 'session',
+        type: 'tuple',
+        components: [
+          { name: 'sessionId', type: 'bytes32' },
+          { name: 'dealId', type: 'uint64' },
+          { name: 'owner', type: 'address' },
+          { name: 'provider', type: 'string' },
+          { name: 'manifestRoot', type: 'bytes' },
+          { name: 'startMduIndex', type: 'uint64' },
+          { name: 'startBlobIndex', type: 'uint32' },
+          { name: 'blobCount', type: 'uint64' },
+          { name: 'totalBytes', type: 'uint64' },
+          { name: 'nonce', type: 'uint64' },
+          { name: 'expiresAt', type: 'uint64' },
+          { name: 'openedHeight', type: 'int64' },
+          { name: 'updatedHeight', type: 'int64' },
+          { name: 'status', type: 'uint8' },
+          { name: 'lockedFee', type: 'uint256' },
+        ],
+      },
+    ],
+  },
+  {
+    type: 'function',
+    name: 'getParams',
+    stateMutability: 'view',
+    inputs: [],
+    outputs: [
+      {
+        name: 'params',
+        type: 'tuple',
+        components: [
+          { name: 'eip712ChainId', type: 'uint64' },
+          { name: 'minDurationBlocks', type: 'uint64' },
+          { name: 'monthLenBlocks', type: 'uint64' },
+          { name: 'epochLenBlocks', type: 'uint64' },
+          { name: 'storagePrice', type: 'uint256' },
+          {
+            name: 'dealCreationFee',
+            type: 'tuple',
+            components: [
+              { name: 'denom', type: 'string' },
+              { name: 'amount', type: 'uint256' },
+            ],
+          },
+          {
+            name: 'baseRetrievalFee',
+            type: 'tuple',
+            components: [
+              { name: 'denom', type: 'string' },
+              { name: 'amount', type: 'uint256' },
+            ],
+          },
+          {
+            name: 'retrievalPricePerBlob',
+            type: 'tuple',
+            components: [
+              { name: 'denom', type: 'string' },
+              { name: 'amount', type: 'uint256' },
+            ],
+          },
+          { name: 'retrievalBurnBps', type: 'uint64' },
+          { name: 'retrievalSessionRetentionBlocks', type: 'uint64' },
+        ],
+      },
+    ],
+  },
+  {
+    type: 'function',
+    name: 'getDealHeat',
+    stateMutability: 'view',
+    inputs: [{ name: 'dealId', type: 'uint64' }],
+    outputs: [
+      {
+        name: 'heat',
+        type: 'tuple',
+        components: [
+          { name: 'bytesServedTotal', type: 'uint64' },
+          { name: 'failedChallengesTotal', type: 'uint64' },
+          { name: 'lastUpdateHeight', type: 'int64' },
+          { name: 'successfulRetrievalsTotal', type: 'uint64' },
+        ],
+      },
+    ],
+  },
   {
     type: 'event',
     name: 'DealCreated',
@@ -214,6 +704,219 @@ export const NILSTORE_PRECOMPILE_ABI = [
       { name: 'owner', type: 'address', indexed: true },
     ],
   },
+  {
+    type: 'event',
+    name: 'DealOwnershipProposed',
+    inputs: [
+      { name: 'dealId', type: 'uint64', indexed: true },
+      { name: 'owner', type: 'address', indexed: true },
+      { name: 'pendingOwner', type: 'address', indexed: false },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'DealOwnershipTransferred',
+    inputs: [
+      { name: 'dealId', type: 'uint64', indexed: true },
+      { name: 'owner', type: 'address', indexed: true },
+      { name: 'previousOwner', type: 'address', indexed: false },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'ProviderRegistered',
+    inputs: [
+      { name: 'provider', type: 'address', indexed: true },
+      { name: 'capabilities', type: 'string', indexed: false },
+      { name: 'totalStorage', type: 'uint64', indexed: false },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'ProviderUpdated',
+    inputs: [
+      { name: 'provider', type: 'address', indexed: true },
+      { name: 'capabilities', type: 'string', indexed: false },
+      { name: 'totalStorage', type: 'uint64', indexed: false },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'ProviderStatusChanged',
+    inputs: [
+      { name: 'provider', type: 'address', indexed: true },
+      { name: 'status', type: 'string', indexed: false },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'ProviderBondToppedUp',
+    inputs: [
+      { name: 'provider', type: 'address', indexed: true },
+      { name: 'amount', type: 'uint256', indexed: false },
+      { name: 'bond', type: 'uint256', indexed: false },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'ProviderBondUnbonding',
+    inputs: [
+      { name: 'provider', type: 'address', indexed: true },
+      { name: 'amount', type: 'uint256', indexed: false },
+      { name: 'completionHeight', type: 'uint64', indexed: false },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'ProviderDeregistered',
+    inputs: [
+      { name: 'provider', type: 'address', indexed: true },
+      { name: 'pendingMigrations', type: 'uint64', indexed: false },
+      { name: 'completionHeight', type: 'uint64', indexed: false },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'RewardsWithdrawn',
+    inputs: [
+      { name: 'provider', type: 'address', indexed: true },
+      { name: 'amount', type: 'uint256', indexed: false },
+      { name: 'storageRewards', type: 'uint256', indexed: false },
+      { name: 'bandwidthRewards', type: 'uint256', indexed: false },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'CreditAdded',
+    inputs: [
+      { name: 'dealId', type: 'uint64', indexed: true },
+      { name: 'sender', type: 'address', indexed: true },
+      { name: 'amount', type: 'uint256', indexed: false },
+      { name: 'newBalance', type: 'uint256', indexed: false },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'DealExtended',
+    inputs: [
+      { name: 'dealId', type: 'uint64', indexed: true },
+      { name: 'owner', type: 'address', indexed: true },
+      { name: 'endBlock', type: 'uint64', indexed: false },
+      { name: 'escrowAdded', type: 'uint256', indexed: false },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'DealClosed',
+    inputs: [
+      { name: 'dealId', type: 'uint64', indexed: true },
+      { name: 'owner', type: 'address', indexed: true },
+      { name: 'providerPayout', type: 'uint256', indexed: false },
+      { name: 'escrowRefund', type: 'uint256', indexed: false },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'SaturationSignaled',
+    inputs: [
+      { name: 'dealId', type: 'uint64', indexed: true },
+      { name: 'provider', type: 'address', indexed: true },
+      { name: 'newProviders', type: 'string[]', indexed: false },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'SlotRepairStarted',
+    inputs: [
+      { name: 'dealId', type: 'uint64', indexed: true },
+      { name: 'owner', type: 'address', indexed: true },
+      { name: 'slot', type: 'uint32', indexed: false },
+      { name: 'pendingProvider', type: 'string', indexed: false },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'SlotRepairCompleted',
+    inputs: [
+      { name: 'dealId', type: 'uint64', indexed: true },
+      { name: 'provider', type: 'address', indexed: true },
+      { name: 'slot', type: 'uint32', indexed: false },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'RetrievalSessionCanceled',
+    inputs: [
+      { name: 'sessionId', type: 'bytes32', indexed: true },
+      { name: 'owner', type: 'address', indexed: true },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'RetrievalSessionProofSubmitted',
+    inputs: [
+      { name: 'sessionId', type: 'bytes32', indexed: true },
+      { name: 'provider', type: 'address', indexed: true },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'LivenessProved',
+    inputs: [
+      { name: 'dealId', type: 'uint64', indexed: true },
+      { name: 'provider', type: 'address', indexed: true },
+      { name: 'epochId', type: 'uint64', indexed: false },
+      { name: 'tier', type: 'uint32', indexed: false },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'DealAccessGranted',
+    inputs: [
+      { name: 'dealId', type: 'uint64', indexed: true },
+      { name: 'owner', type: 'address', indexed: true },
+      { name: 'grantee', type: 'string', indexed: false },
+      { name: 'expiresAt', type: 'uint64', indexed: false },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'DealAccessRevoked',
+    inputs: [
+      { name: 'dealId', type: 'uint64', indexed: true },
+      { name: 'sender', type: 'address', indexed: true },
+      { name: 'grantee', type: 'string', indexed: false },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'SessionKeyRegistered',
+    inputs: [
+      { name: 'sessionKey', type: 'address', indexed: true },
+      { name: 'owner', type: 'address', indexed: true },
+      { name: 'dealIds', type: 'uint64[]', indexed: false },
+      { name: 'expiresAt', type: 'uint64', indexed: false },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'SessionKeyRevoked',
+    inputs: [
+      { name: 'sessionKey', type: 'address', indexed: true },
+      { name: 'sender', type: 'address', indexed: true },
+    ],
+  },
+  {
+    type: 'event',
+    name: 'FraudProofSubmitted',
+    inputs: [
+      { name: 'dealId', type: 'uint64', indexed: true },
+      { name: 'reporter', type: 'address', indexed: true },
+      { name: 'provider', type: 'string', indexed: false },
+      { name: 'reason', type: 'string', indexed: false },
+      { name: 'reward', type: 'uint256', indexed: false },
+    ],
+  },
 ] as const satisfies Abi
 
 export type RetrievalSessionInput = {
@@ -274,3 +977,18 @@ export function encodeConfirmRetrievalSessionsData(sessionIds: readonly Hex[]):
   })
 }
 
+export function encodeTransferDealOwnershipData(dealId: bigint, newOwner: string): Hex {
+  return encodeFunctionData({
+    abi: NILSTORE_PRECOMPILE_ABI,
+    functionName: 'transferDealOwnership',
+    args: [dealId, newOwner],
+  })
+}
+
+export function encodeAcceptDealOwnershipData(dealId: bigint): Hex {
+  return encodeFunctionData({
+    abi: NILSTORE_PRECOMPILE_ABI,
+    functionName: 'acceptDealOwnership',
+    args: [dealId],
+  })
+}
@@ -9,6 +9,7 @@ replace github.com/quic-go/quic-go => github.com/quic-go/quic-go v0.52.0
 require (
 	github.com/btcsuite/btcutil v1.0.2
 	github.com/consensys/gnark-crypto v0.18.0
+	github.com/cosmos/cosmos-sdk v0.53.5-0.20251030204916-768cb210885c
 	github.com/ethereum/go-ethereum v1.15.11
 	github.com/gorilla/mux v1.8.1
 	github.com/libp2p/go-libp2p v0.42.0
@@ -57,7 +58,6 @@ require (
 	github.com/cosmos/btcutil v1.0.5 // indirect
 	github.com/cosmos/cosmos-db v1.1.3 // indirect
 	github.com/cosmos/cosmos-proto v1.0.0-beta.5 // indirect
-	github.com/cosmos/cosmos-sdk v0.53.5-0.20251030204916-768cb210885c // indirect
 	github.com/cosmos/go-bip39 v1.0.0 // indirect
 	github.com/cosmos/gogogateway v1.2.0 // indirect
 	github.com/cosmos/gogoproto v1.7.2 // indirect
@@ -14,10 +14,12 @@ import (
 	"math/big"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -190,6 +192,22 @@ func evmHexToNilAddress(hexAddr string) (string, error) {
 	return bech32.Encode("nil", converted)
 }
 
+// nilAddressToEvmHex is the inverse of evmHexToNilAddress.
+func nilAddressToEvmHex(nilAddr string) (string, error) {
+	_, data, err := bech32.Decode(strings.TrimSpace(nilAddr))
+	if err != nil {
+		return "", err
+	}
+	raw, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return "", err
+	}
+	if len(raw) != 20 {
+		return "", fmt.Errorf("invalid address length: %d", len(raw))
+	}
+	return common.BytesToAddress(raw).Hex(), nil
+}
+
 func fundAddressOnce(addr string) {
 	client := &http.Client{Timeout: 5 * time.Second}
 	body := fmt.Sprintf(`{"address":"%s"}`, addr)
@@ -1853,7 +1871,12 @@ func GatewayOpenSession(w http.ResponseWriter, r *http.Request) {
 		writeJSONError(w, http.StatusInternalServerError, "failed to validate deal owner", "")
 		return
 	}
-	if dealOwner == "" || dealOwner != owner {
+	allowed, err := isDealReader(dealID, dealOwner, owner)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "failed to validate deal access", "")
+		return
+	}
+	if !allowed {
 		writeJSONError(w, http.StatusForbidden, "forbidden: owner does not match deal", "")
 		return
 	}
@@ -2106,7 +2129,12 @@ func GatewayFetch(w http.ResponseWriter, r *http.Request) {
 		writeJSONError(w, http.StatusInternalServerError, "failed to validate deal owner", "")
 		return
 	}
-	if dealOwner == "" || dealOwner != owner {
+	allowed, err := isDealReader(dealID, dealOwner, owner)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "failed to validate deal access", "")
+		return
+	}
+	if !allowed {
 		writeJSONError(w, http.StatusForbidden, "forbidden: owner does not match deal", "")
 		return
 	}
@@ -2555,8 +2583,33 @@ func GatewayFetch(w http.ResponseWriter, r *http.Request) {
 	if proofHash != "" {
 		w.Header().Set("X-Nil-Proof-Hash", proofHash)
 	}
+	var body io.Reader = content
 	if proofPayload != nil {
 		w.Header().Set("X-Nil-Proof-JSON", base64.StdEncoding.EncodeToString(proofPayload))
+		// Sign the response so a client can prove a wrong one on chain. The
+		// signature covers the body, so it is buffered first; a body that
+		// spans blobs is not covered by the proof and is left unsigned.
+		if servedRangeInBlob(absOffset, servedLen) {
+			served, rerr := io.ReadAll(io.LimitReader(content, int64(servedLen)))
+			if rerr != nil {
+				log.Printf("GatewayFetch: failed to read served range: %v", rerr)
+				writeJSONError(w, http.StatusInternalServerError, "failed to read file", "")
+				return
+			}
+			body = bytes.NewReader(served)
+			generation, gerr := fetchDealGenerationFromLCD(r.Context(), dealID)
+			if gerr == nil {
+				sig, serr := signServedResponse(dealID, generation, providerAddr, manifestRoot.Bytes[:], proofPayload, served)
+				if serr == nil {
+					w.Header().Set("X-Nil-Generation", strconv.FormatUint(generation, 10))
+					w.Header().Set("X-Nil-Provider-Sig", sig)
+				} else {
+					log.Printf("GatewayFetch: failed to sign served response: %v", serr)
+				}
+			} else {
+				log.Printf("GatewayFetch: failed to fetch deal generation: %v", gerr)
+			}
+		}
 	}
 
 	// Serve as attachment so browsers will download instead of inline JSON.
@@ -2571,7 +2624,7 @@ func GatewayFetch(w http.ResponseWriter, r *http.Request) {
 	} else {
 		w.WriteHeader(http.StatusOK)
 	}
-	_, _ = io.Copy(w, content)
+	_, _ = io.Copy(w, body)
 }
 
 // GatewayPlanRetrievalSession plans an on-chain RetrievalSession for a file byte-range by
@@ -2622,7 +2675,12 @@ func GatewayPlanRetrievalSession(w http.ResponseWriter, r *http.Request) {
 		writeJSONError(w, http.StatusInternalServerError, "failed to validate deal", "")
 		return
 	}
-	if dealOwner == "" || dealOwner != owner {
+	allowed, err := isDealReader(dealID, dealOwner, owner)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "failed to validate deal access", "")
+		return
+	}
+	if !allowed {
 		writeJSONError(w, http.StatusForbidden, "forbidden: owner does not match deal", "")
 		return
 	}
@@ -2885,7 +2943,12 @@ func GatewayListFiles(w http.ResponseWriter, r *http.Request) {
 		writeJSONError(w, http.StatusInternalServerError, "failed to validate deal owner", "")
 		return
 	}
-	if dealOwner == "" || dealOwner != owner {
+	allowed, err := isDealReader(dealID, dealOwner, owner)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "failed to validate deal access", "")
+		return
+	}
+	if !allowed {
 		writeJSONError(w, http.StatusForbidden, "forbidden: owner does not match deal", "")
 		return
 	}
@@ -3036,7 +3099,12 @@ func GatewaySlab(w http.ResponseWriter, r *http.Request) {
 			writeJSONError(w, http.StatusInternalServerError, "failed to validate deal owner", "")
 			return
 		}
-		if dealOwner == "" || dealOwner != owner {
+		allowed, err := isDealReader(dealID, dealOwner, owner)
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, "failed to validate deal access", "")
+			return
+		}
+		if !allowed {
 			writeJSONError(w, http.StatusForbidden, "forbidden: owner does not match deal", "")
 			return
 		}
@@ -3320,7 +3388,7 @@ func setCORS(w http.ResponseWriter) {
 	w.Header().Set("Access-Control-Allow-Origin", "*")
 	w.Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS")
 	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Range, X-Nil-Req-Sig, X-Nil-Req-Nonce, X-Nil-Req-Expires-At, X-Nil-Req-Range-Start, X-Nil-Req-Range-Len, X-Nil-Download-Session, X-Nil-Session-Id, X-Nil-Manifest-Root, X-Nil-Deal-ID, X-Nil-Mdu-Index, X-Nil-Slot, X-Nil-Gateway-Auth")
-	w.Header().Set("Access-Control-Expose-Headers", "Accept-Ranges, Content-Range, X-Nil-Deal-ID, X-Nil-Epoch, X-Nil-Bytes-Served, X-Nil-Provider, X-Nil-File-Path, X-Nil-Range-Start, X-Nil-Range-Len, X-Nil-Proof-JSON, X-Nil-Proof-Hash, X-Nil-Fetch-Session, X-Nil-Gateway-Proof-MS, X-Nil-Gateway-Fetch-MS")
+	w.Header().Set("Access-Control-Expose-Headers", "Accept-Ranges, Content-Range, X-Nil-Deal-ID, X-Nil-Epoch, X-Nil-Bytes-Served, X-Nil-Provider, X-Nil-File-Path, X-Nil-Range-Start, X-Nil-Range-Len, X-Nil-Proof-JSON, X-Nil-Proof-Hash, X-Nil-Generation, X-Nil-Provider-Sig, X-Nil-Fetch-Session, X-Nil-Gateway-Proof-MS, X-Nil-Gateway-Fetch-MS")
 }
 
 func extractTxHash(out string) string {
@@ -3468,23 +3536,41 @@ func verifyRetrievalRequestSignature(dealOwner string, dealID uint64, filePath s
 	if err != nil {
 		return fmt.Errorf("invalid req_sig: %w", err)
 	}
-	if len(sigBytes) != 65 {
+	if len(sigBytes) == 0 {
 		return fmt.Errorf("invalid req_sig length: %d", len(sigBytes))
 	}
 
 	domainSep := types.HashDomainSeparator(eip712ChainID())
 	structHash := types.HashRetrievalRequest(dealID, filePath, rangeStart, rangeLen, nonce, expiresAt)
 	digest := types.ComputeEIP712Digest(domainSep, structHash)
-	evmAddr, err := recoverEvmAddressFromDigest(digest, sigBytes)
+	if evmAddr, err := recoverEvmAddressFromDigest(digest, sigBytes); err == nil {
+		nilAddr, err := evmHexToNilAddress(evmAddr.Hex())
+		if err != nil {
+			return fmt.Errorf("failed to map request signer to nil address: %w", err)
+		}
+		allowed, err := isDealReader(dealID, dealOwner, nilAddr)
+		if err != nil {
+			return fmt.Errorf("failed to check deal access: %w", err)
+		}
+		if !allowed {
+			return fmt.Errorf("request signer is not deal owner or an access grantee")
+		}
+		return nil
+	}
+
+	// Owners that are contract wallets (Safe multisigs, ERC-4337 accounts)
+	// sign through EIP-1271, which only the chain can evaluate. Only
+	// signatures that are not a recoverable ECDSA signature get that far.
+	ownerEvm, err := nilAddressToEvmHex(dealOwner)
 	if err != nil {
-		return fmt.Errorf("failed to recover request signer: %w", err)
+		return fmt.Errorf("request signer is not deal owner or an access grantee")
 	}
-	nilAddr, err := evmHexToNilAddress(evmAddr.Hex())
+	valid, err := fetchEvmSignatureValid(ownerEvm, digest, sigBytes)
 	if err != nil {
-		return fmt.Errorf("failed to map request signer to nil address: %w", err)
+		return fmt.Errorf("failed to check owner contract signature: %w", err)
 	}
-	if strings.TrimSpace(nilAddr) != strings.TrimSpace(dealOwner) {
-		return fmt.Errorf("request signer is not deal owner")
+	if !valid {
+		return fmt.Errorf("request signer is not deal owner or an access grantee")
 	}
 	return nil
 }
@@ -3756,6 +3842,117 @@ func fetchDealOwnerAndCID(dealID uint64) (owner string, cid string, err error) {
 	return owner, cid, nil
 }
 
+// isDealReader reports whether addr may read a deal's content: it is the deal
+// owner, holds an active on-chain access grant for the deal, or is an active
+// session key the owner registered for the deal.
+func isDealReader(dealID uint64, dealOwner string, addr string) (bool, error) {
+	addr = strings.TrimSpace(addr)
+	if addr == "" {
+		return false, nil
+	}
+	if dealOwner = strings.TrimSpace(dealOwner); dealOwner != "" && addr == dealOwner {
+		return true, nil
+	}
+	granted, err := fetchDealAccessGrantActive(dealID, addr)
+	if err != nil || granted {
+		return granted, err
+	}
+	if dealOwner == "" {
+		return false, nil
+	}
+	return fetchSessionKeyActive(dealID, dealOwner, addr)
+}
+
+// fetchSessionKeyActive asks the LCD whether key is a usable session key that
+// dealOwner registered for the deal.
+func fetchSessionKeyActive(dealID uint64, dealOwner string, key string) (bool, error) {
+	reqURL := fmt.Sprintf("%s/nilchain/nilchain/v1/session-keys/%s", lcdBase, key)
+	resp, err := lcdHTTPClient.Get(reqURL)
+	if err != nil {
+		return false, fmt.Errorf("LCD request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+		body, _ := io.ReadAll(resp.Body)
+		return false, fmt.Errorf("LCD returned %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var payload struct {
+		SessionKey struct {
+			Owner   string   `json:"owner"`
+			DealIds []string `json:"deal_ids"`
+		} `json:"session_key"`
+		Active bool `json:"active"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return false, fmt.Errorf("failed to decode LCD response: %w", err)
+	}
+	if !payload.Active || payload.SessionKey.Owner != dealOwner {
+		return false, nil
+	}
+	return slices.Contains(payload.SessionKey.DealIds, strconv.FormatUint(dealID, 10)), nil
+}
+
+// fetchDealAccessGrantActive asks the LCD whether grantee holds a usable
+// (unexpired, within limits) access grant on the deal.
+func fetchDealAccessGrantActive(dealID uint64, grantee string) (bool, error) {
+	reqURL := fmt.Sprintf("%s/nilchain/nilchain/v1/deals/%d/access-grants/%s", lcdBase, dealID, grantee)
+	resp, err := lcdHTTPClient.Get(reqURL)
+	if err != nil {
+		return false, fmt.Errorf("LCD request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+		body, _ := io.ReadAll(resp.Body)
+		return false, fmt.Errorf("LCD returned %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var payload struct {
+		Active bool `json:"active"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return false, fmt.Errorf("failed to decode LCD response: %w", err)
+	}
+	return payload.Active, nil
+}
+
+// fetchEvmSignatureValid asks the LCD whether signer accepts sig over digest.
+// Only contract accounts count: their EIP-1271 isValidSignature runs on chain,
+// while plain accounts are already checked locally by ECDSA recovery.
+func fetchEvmSignatureValid(signer string, digest []byte, sig []byte) (bool, error) {
+	q := url.Values{}
+	q.Set("digest", base64.StdEncoding.EncodeToString(digest))
+	q.Set("signature", base64.StdEncoding.EncodeToString(sig))
+	reqURL := fmt.Sprintf("%s/nilchain/nilchain/v1/evm-signatures/%s?%s", lcdBase, signer, q.Encode())
+	resp, err := lcdHTTPClient.Get(reqURL)
+	if err != nil {
+		return false, fmt.Errorf("LCD request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return false, fmt.Errorf("LCD returned %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var payload struct {
+		Valid    bool `json:"valid"`
+		Contract bool `json:"contract"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return false, fmt.Errorf("failed to decode LCD response: %w", err)
+	}
+	return payload.Contract && payload.Valid, nil
+}
+
 // creatorHasSomeBalance checks whether a given bech32 address has any non-zero
 // balance in the bank module (stake or aatom). It is a devnet guard used to
 // ensure users have gone through the faucet flow before deals are created.
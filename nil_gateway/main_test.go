@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -21,6 +22,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/gorilla/mux"
 
@@ -715,3 +717,108 @@ func TestGatewayFetch_DealIDZero(t *testing.T) {
 		t.Fatalf("Fetched content mismatch. Expected: %q, Got: %q", string(fileContent), string(fetchedContent))
 	}
 }
+
+func TestVerifyRetrievalRequestSignature_ContractOwner(t *testing.T) {
+	const walletHex = "0x00000000000000000000000000000000000012A1"
+	owner, err := evmHexToNilAddress(walletHex)
+	if err != nil {
+		t.Fatalf("evmHexToNilAddress failed: %v", err)
+	}
+	if got, err := nilAddressToEvmHex(owner); err != nil || !strings.EqualFold(got, walletHex) {
+		t.Fatalf("nilAddressToEvmHex(%s) = %s, %v", owner, got, err)
+	}
+
+	expiresAt := uint64(time.Now().Unix()) + 60
+	digest := types.ComputeEIP712Digest(types.HashDomainSeparator(eip712ChainID()),
+		types.HashRetrievalRequest(1, "a.txt", 0, 1024, 7, expiresAt))
+	walletSig := []byte("safe-multisig-signatures")
+
+	contractCalls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/evm-signatures/"+common.HexToAddress(walletHex).Hex()) {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		contractCalls++
+		gotDigest, _ := base64.StdEncoding.DecodeString(r.URL.Query().Get("digest"))
+		gotSig, _ := base64.StdEncoding.DecodeString(r.URL.Query().Get("signature"))
+		valid := bytes.Equal(gotDigest, digest) && bytes.Equal(gotSig, walletSig)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"valid": valid, "contract": true})
+	}))
+	defer srv.Close()
+	oldLCD := lcdBase
+	lcdBase = srv.URL
+	defer func() { lcdBase = oldLCD }()
+
+	if err := verifyRetrievalRequestSignature(owner, 1, "a.txt", 0, 1024, 7, expiresAt, "0x"+hex.EncodeToString(walletSig)); err != nil {
+		t.Fatalf("expected contract wallet signature to be accepted: %v", err)
+	}
+	if err := verifyRetrievalRequestSignature(owner, 1, "a.txt", 0, 2048, 7, expiresAt, "0x"+hex.EncodeToString(walletSig)); err == nil {
+		t.Fatalf("expected signature over a different range to be rejected")
+	}
+	// An EOA key that is neither the owner nor a grantee is still rejected,
+	// without asking the chain to run the wallet's EIP-1271 check.
+	contractCalls = 0
+	if err := verifyRetrievalRequestSignature(owner, 1, "a.txt", 0, 1024, 7, expiresAt, signRetrievalRequest(t, 1, "a.txt", 0, 1024, 7, expiresAt)); err == nil {
+		t.Fatalf("expected stranger signature to be rejected")
+	}
+	if contractCalls != 0 {
+		t.Fatalf("expected no EIP-1271 lookup for a recoverable signature, got %d", contractCalls)
+	}
+}
+
+func TestVerifyRetrievalRequestSignature_SessionKey(t *testing.T) {
+	owner, err := evmHexToNilAddress("0x00000000000000000000000000000000000000aa")
+	if err != nil {
+		t.Fatalf("evmHexToNilAddress failed: %v", err)
+	}
+	key, err := ethcrypto.HexToECDSA(strings.TrimPrefix(testEvmPrivKeyHex, "0x"))
+	if err != nil {
+		t.Fatalf("HexToECDSA failed: %v", err)
+	}
+	sessionKey, err := evmHexToNilAddress(ethcrypto.PubkeyToAddress(key.PublicKey).Hex())
+	if err != nil {
+		t.Fatalf("evmHexToNilAddress failed: %v", err)
+	}
+
+	active := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/session-keys/"+sessionKey):
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"session_key": map[string]any{"key": sessionKey, "owner": owner, "deal_ids": []string{"1", "3"}},
+				"active":      active,
+			})
+		case strings.Contains(r.URL.Path, "/evm-signatures/"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"valid": false, "contract": false})
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	oldLCD := lcdBase
+	lcdBase = srv.URL
+	defer func() { lcdBase = oldLCD }()
+
+	expiresAt := uint64(time.Now().Unix()) + 60
+	sign := func(dealID uint64) string {
+		return signRetrievalRequest(t, dealID, "a.txt", 0, 1024, 7, expiresAt)
+	}
+
+	if err := verifyRetrievalRequestSignature(owner, 1, "a.txt", 0, 1024, 7, expiresAt, sign(1)); err != nil {
+		t.Fatalf("expected session key signature to be accepted: %v", err)
+	}
+	if err := verifyRetrievalRequestSignature(owner, 2, "a.txt", 0, 1024, 7, expiresAt, sign(2)); err == nil {
+		t.Fatalf("expected session key to be rejected for a deal it is not bound to")
+	}
+	other, _ := evmHexToNilAddress("0x00000000000000000000000000000000000000bb")
+	if err := verifyRetrievalRequestSignature(other, 1, "a.txt", 0, 1024, 7, expiresAt, sign(1)); err == nil {
+		t.Fatalf("expected session key to be rejected for another owner's deal")
+	}
+	active = false
+	if err := verifyRetrievalRequestSignature(owner, 1, "a.txt", 0, 1024, 7, expiresAt, sign(1)); err == nil {
+		t.Fatalf("expected inactive session key to be rejected")
+	}
+}
@@ -212,7 +212,11 @@ func validateDealOwnerCidQuery(r *http.Request, manifestRoot ManifestRoot) (uint
 		}
 		return 0, "", http.StatusInternalServerError, fmt.Errorf("failed to validate deal owner")
 	}
-	if dealOwner == "" || dealOwner != owner {
+	allowed, err := isDealReader(dealID, dealOwner, owner)
+	if err != nil {
+		return 0, "", http.StatusInternalServerError, fmt.Errorf("failed to validate deal access")
+	}
+	if !allowed {
 		return 0, "", http.StatusForbidden, fmt.Errorf("forbidden: owner does not match deal")
 	}
 	if strings.TrimSpace(dealCID) == "" {
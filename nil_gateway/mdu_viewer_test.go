@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"nilchain/x/crypto_ffi"
@@ -190,3 +191,44 @@ func TestGatewayMduKzg_Basic(t *testing.T) {
 		t.Fatalf("expected blobs to be non-empty")
 	}
 }
+
+func TestValidateDealOwnerCidQuery_AccessGrantee(t *testing.T) {
+	root := mustTestManifestRoot(t, "access-grantee")
+	owner := testDealOwner(t)
+	const grantee = "nil1grantee"
+	const lapsed = "nil1lapsed"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/nilchain/nilchain/v1/deals/1":
+			_ = json.NewEncoder(w).Encode(map[string]any{"deal": map[string]any{"id": "1", "owner": owner, "cid": root.Canonical}})
+		case strings.HasSuffix(r.URL.Path, "/access-grants/"+grantee):
+			_ = json.NewEncoder(w).Encode(map[string]any{"active": true})
+		case strings.HasSuffix(r.URL.Path, "/access-grants/"+lapsed):
+			_ = json.NewEncoder(w).Encode(map[string]any{"active": false})
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	oldLCD := lcdBase
+	lcdBase = srv.URL
+	defer func() { lcdBase = oldLCD }()
+
+	for _, tc := range []struct {
+		addr string
+		code int
+	}{
+		{owner, 0},
+		{grantee, 0},
+		{lapsed, http.StatusForbidden},
+		{"nil1stranger", http.StatusForbidden},
+	} {
+		req := httptest.NewRequest("GET", "/gateway/manifest-info/"+root.Canonical+"?deal_id=1&owner="+tc.addr, nil)
+		_, _, code, err := validateDealOwnerCidQuery(req, root)
+		if code != tc.code {
+			t.Fatalf("%s: expected status %d, got %d (%v)", tc.addr, tc.code, code, err)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"sort"
 	"strconv"
@@ -112,62 +113,100 @@ func s3DealIDToBucket(id uint64) string {
 	return fmt.Sprintf("deal-%d", id)
 }
 
-func fetchDealIDsFromLCD(ctx context.Context) ([]uint64, error) {
-	url := fmt.Sprintf("%s/nilchain/nilchain/v1/deals?pagination.limit=1000", lcdBase)
-	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	resp, err := lcdHTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("LCD request failed: %w", err)
+var (
+	// s3BucketOwner is the account whose deals S3 ListBuckets lists. When
+	// unset, the gateway lists the deals of its own faucet key, the key it
+	// commits S3 uploads with.
+	s3BucketOwner = envDefault("NIL_S3_OWNER", "")
+	// s3ListBucketsPageLimit is the page size used to walk the owner's deals.
+	s3ListBucketsPageLimit = 100
+)
+
+func resolveS3BucketOwner(ctx context.Context) (string, error) {
+	if owner := strings.TrimSpace(s3BucketOwner); owner != "" {
+		return owner, nil
 	}
-	defer resp.Body.Close()
+	return resolveKeyAddress(ctx, "faucet")
+}
 
-	body, _ := io.ReadAll(resp.Body)
-	if resp.StatusCode != http.StatusOK {
-		if resp.StatusCode == http.StatusNotFound {
-			return nil, nil
+// fetchDealIDsFromLCD returns the ids of the deals owned by owner, walking
+// every page of the chain's deals-by-owner index.
+func fetchDealIDsFromLCD(ctx context.Context, owner string) ([]uint64, error) {
+	out := make([]uint64, 0)
+	seen := make(map[uint64]struct{})
+	nextKey := ""
+	for {
+		q := url.Values{}
+		q.Set("pagination.limit", strconv.Itoa(s3ListBucketsPageLimit))
+		if nextKey != "" {
+			q.Set("pagination.key", nextKey)
+		}
+		reqURL := fmt.Sprintf("%s/nilchain/nilchain/v1/owners/%s/deals?%s", lcdBase, url.PathEscape(owner), q.Encode())
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+		resp, err := lcdHTTPClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("LCD request failed: %w", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			if resp.StatusCode == http.StatusNotFound {
+				return out, nil
+			}
+			return nil, fmt.Errorf("LCD returned %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
 		}
-		return nil, fmt.Errorf("LCD returned %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
-	}
 
-	var payload struct {
-		Deals []map[string]any `json:"deals"`
-	}
-	if err := json.Unmarshal(body, &payload); err != nil {
-		return nil, fmt.Errorf("failed to decode LCD response: %w", err)
-	}
+		var payload struct {
+			Deals      []map[string]any `json:"deals"`
+			Pagination struct {
+				NextKey string `json:"next_key"`
+			} `json:"pagination"`
+		}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, fmt.Errorf("failed to decode LCD response: %w", err)
+		}
 
-	out := make([]uint64, 0, len(payload.Deals))
-	seen := make(map[uint64]struct{}, len(payload.Deals))
-	for _, deal := range payload.Deals {
-		raw := deal["id"]
-		var id uint64
-		switch v := raw.(type) {
-		case string:
-			parsed, err := strconv.ParseUint(strings.TrimSpace(v), 10, 64)
-			if err != nil {
+		for _, deal := range payload.Deals {
+			raw := deal["id"]
+			var id uint64
+			switch v := raw.(type) {
+			case string:
+				parsed, err := strconv.ParseUint(strings.TrimSpace(v), 10, 64)
+				if err != nil {
+					continue
+				}
+				id = parsed
+			case float64:
+				if v < 0 {
+					continue
+				}
+				id = uint64(v)
+			default:
 				continue
 			}
-			id = parsed
-		case float64:
-			if v < 0 {
+			if _, ok := seen[id]; ok {
 				continue
 			}
-			id = uint64(v)
-		default:
-			continue
+			seen[id] = struct{}{}
+			out = append(out, id)
 		}
-		if _, ok := seen[id]; ok {
-			continue
+
+		if payload.Pagination.NextKey == "" || payload.Pagination.NextKey == nextKey {
+			break
 		}
-		seen[id] = struct{}{}
-		out = append(out, id)
+		nextKey = payload.Pagination.NextKey
 	}
 	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
 	return out, nil
 }
 
 func S3ListBuckets(w http.ResponseWriter, r *http.Request) {
-	ids, err := fetchDealIDsFromLCD(r.Context())
+	owner, err := resolveS3BucketOwner(r.Context())
+	if err != nil {
+		writeS3Error(w, http.StatusInternalServerError, "InternalError", err.Error())
+		return
+	}
+	ids, err := fetchDealIDsFromLCD(r.Context(), owner)
 	if err != nil {
 		writeS3Error(w, http.StatusInternalServerError, "InternalError", err.Error())
 		return
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"testing"
@@ -22,28 +23,48 @@ func mockLCDDealsServer(t *testing.T, dealStates map[uint64]struct {
 }) *httptest.Server {
 	t.Helper()
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if !strings.HasPrefix(r.URL.Path, "/nilchain/nilchain/v1/deals") {
-			http.Error(w, "not found", http.StatusNotFound)
-			return
-		}
+		if strings.HasPrefix(r.URL.Path, "/nilchain/nilchain/v1/owners/") && strings.HasSuffix(r.URL.Path, "/deals") {
+			// list deals by owner, one page at a time; the page key is the
+			// offset of the next deal
+			owner := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/nilchain/nilchain/v1/owners/"), "/deals")
+			ids := make([]uint64, 0, len(dealStates))
+			for id, state := range dealStates {
+				if state.Owner == owner {
+					ids = append(ids, id)
+				}
+			}
+			sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+			offset, _ := strconv.Atoi(r.URL.Query().Get("pagination.key"))
+			limit, err := strconv.Atoi(r.URL.Query().Get("pagination.limit"))
+			if err != nil || limit <= 0 {
+				limit = len(ids)
+			}
+			end := offset + limit
+			nextKey := ""
+			if end < len(ids) {
+				nextKey = strconv.Itoa(end)
+			} else {
+				end = len(ids)
+			}
 
-		trimmed := strings.TrimPrefix(r.URL.Path, "/nilchain/nilchain/v1/deals")
-		trimmed = strings.TrimPrefix(trimmed, "/")
-		if trimmed == "" {
-			// list deals
 			type dealRow struct {
 				ID string `json:"id"`
 			}
-			rows := make([]dealRow, 0, len(dealStates))
-			for id := range dealStates {
+			rows := make([]dealRow, 0, end-offset)
+			for _, id := range ids[offset:end] {
 				rows = append(rows, dealRow{ID: strconv.FormatUint(id, 10)})
 			}
-			resp := map[string]any{"deals": rows}
+			resp := map[string]any{"deals": rows, "pagination": map[string]any{"next_key": nextKey}}
 			w.Header().Set("Content-Type", "application/json")
 			_ = json.NewEncoder(w).Encode(resp)
 			return
 		}
+		if !strings.HasPrefix(r.URL.Path, "/nilchain/nilchain/v1/deals/") {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
 
+		trimmed := strings.TrimPrefix(r.URL.Path, "/nilchain/nilchain/v1/deals/")
 		dealID, err := strconv.ParseUint(trimmed, 10, 64)
 		if err != nil {
 			http.Error(w, "invalid deal ID", http.StatusBadRequest)
@@ -83,13 +104,18 @@ func TestS3_ListBuckets_UsesDealIDs(t *testing.T) {
 		CID   string
 	}{
 		1: {Owner: "nil1owner", CID: root.Canonical},
+		4: {Owner: "nil1other", CID: root.Canonical},
 		7: {Owner: "nil1owner", CID: root.Canonical},
+		9: {Owner: "nil1owner", CID: root.Canonical},
 	})
 	defer srv.Close()
 
 	old := lcdBase
 	lcdBase = srv.URL
 	t.Cleanup(func() { lcdBase = old })
+	oldOwner, oldLimit := s3BucketOwner, s3ListBucketsPageLimit
+	s3BucketOwner, s3ListBucketsPageLimit = "nil1owner", 2
+	t.Cleanup(func() { s3BucketOwner, s3ListBucketsPageLimit = oldOwner, oldLimit })
 
 	r := s3TestRouter()
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
@@ -106,6 +132,13 @@ func TestS3_ListBuckets_UsesDealIDs(t *testing.T) {
 	if !strings.Contains(body, "<Name>deal-7</Name>") {
 		t.Fatalf("expected deal-7 bucket, got: %s", body)
 	}
+	// deal-9 is on the second page of the owner's deals.
+	if !strings.Contains(body, "<Name>deal-9</Name>") {
+		t.Fatalf("expected deal-9 bucket, got: %s", body)
+	}
+	if strings.Contains(body, "<Name>deal-4</Name>") {
+		t.Fatalf("expected another owner's deal-4 to be hidden, got: %s", body)
+	}
 }
 
 func TestS3_ListObjects_ListsNilfsFileTable(t *testing.T) {
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+
+	"nilchain/x/nilchain/types"
+)
+
+var (
+	providerKeyringMu sync.Mutex
+	providerKeyring   keyring.Keyring
+)
+
+// openProviderKeyring opens the local test keyring the gateway also uses
+// through nilchaind, once.
+func openProviderKeyring() (keyring.Keyring, error) {
+	providerKeyringMu.Lock()
+	defer providerKeyringMu.Unlock()
+	if providerKeyring != nil {
+		return providerKeyring, nil
+	}
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	kr, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, homeDir, nil, codec.NewProtoCodec(registry))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open keyring: %w", err)
+	}
+	providerKeyring = kr
+	return kr, nil
+}
+
+// servedRangeInBlob reports whether the raw slab range [absOffset,
+// absOffset+length) lies within a single blob, the one its proof opens.
+func servedRangeInBlob(absOffset uint64, length uint64) bool {
+	if length == 0 || length > types.BlobPayloadBytes {
+		return false
+	}
+	return absOffset/types.BlobPayloadBytes == (absOffset+length-1)/types.BlobPayloadBytes
+}
+
+// signServedResponse signs types.HashServedResponse over a served proof and
+// the bytes served with it, using the provider's account key. A client that
+// finds the manifest root, the proof or the served blob wrong can submit the
+// response with MsgSubmitFraudProof.
+func signServedResponse(dealID uint64, generation uint64, provider string, manifestRoot []byte, proofPayload []byte, served []byte) (string, error) {
+	var wrapper struct {
+		ProofDetail json.RawMessage `json:"proof_details"`
+	}
+	if err := json.Unmarshal(proofPayload, &wrapper); err != nil {
+		return "", fmt.Errorf("failed to parse proof_details: %w", err)
+	}
+	var proof types.ChainedProof
+	if err := json.Unmarshal(wrapper.ProofDetail, &proof); err != nil {
+		return "", fmt.Errorf("failed to parse proof_details: %w", err)
+	}
+	digest, err := types.HashServedResponse(chainID, dealID, generation, provider, manifestRoot, &proof, served)
+	if err != nil {
+		return "", err
+	}
+
+	kr, err := openProviderKeyring()
+	if err != nil {
+		return "", err
+	}
+	keyName := envDefault("NIL_PROVIDER_KEY", "faucet")
+	sig, pubKey, err := kr.Sign(keyName, digest, signingtypes.SignMode_SIGN_MODE_DIRECT)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign with key %q: %w", keyName, err)
+	}
+	if signer := sdk.AccAddress(pubKey.Address()).String(); signer != provider {
+		return "", fmt.Errorf("key %q signs for %s, not provider %s", keyName, signer, provider)
+	}
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+// fetchDealGenerationFromLCD returns the deal's current generation.
+func fetchDealGenerationFromLCD(ctx context.Context, dealID uint64) (uint64, error) {
+	url := fmt.Sprintf("%s/nilchain/nilchain/v1/deals/%d", lcdBase, dealID)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	resp, err := lcdHTTPClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("LCD request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusNotFound {
+			return 0, ErrDealNotFound
+		}
+		body, _ := io.ReadAll(resp.Body)
+		return 0, fmt.Errorf("LCD returned %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var payload struct {
+		Deal struct {
+			CurrentGen string `json:"current_gen"`
+		} `json:"deal"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return 0, fmt.Errorf("failed to decode LCD response: %w", err)
+	}
+	if payload.Deal.CurrentGen == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(payload.Deal.CurrentGen, 10, 64)
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"nilchain/x/nilchain/types"
+)
+
+func TestSignServedResponse(t *testing.T) {
+	oldHome := homeDir
+	homeDir = t.TempDir()
+	providerKeyring = nil
+	t.Cleanup(func() {
+		homeDir = oldHome
+		providerKeyring = nil
+	})
+	t.Setenv("NIL_PROVIDER_KEY", "provider")
+
+	kr, err := openProviderKeyring()
+	if err != nil {
+		t.Fatalf("openProviderKeyring: %v", err)
+	}
+	record, _, err := kr.NewMnemonic("provider", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	if err != nil {
+		t.Fatalf("NewMnemonic: %v", err)
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		t.Fatalf("GetPubKey: %v", err)
+	}
+	provider := sdk.AccAddress(pubKey.Address()).String()
+
+	proof := types.ChainedProof{MduIndex: 3, BlobIndex: 5, ZValue: bytes.Repeat([]byte{1}, 32), YValue: bytes.Repeat([]byte{2}, 32)}
+	detail, err := json.Marshal(proof)
+	if err != nil {
+		t.Fatalf("marshal proof: %v", err)
+	}
+	payload, err := json.Marshal(map[string]json.RawMessage{"proof_details": detail})
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	root := bytes.Repeat([]byte{0xab}, 48)
+	served := []byte("served range")
+
+	encoded, err := signServedResponse(7, 2, provider, root, payload, served)
+	if err != nil {
+		t.Fatalf("signServedResponse: %v", err)
+	}
+	sig, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatalf("decode signature: %v", err)
+	}
+	digest, err := types.HashServedResponse(chainID, 7, 2, provider, root, &proof, served)
+	if err != nil {
+		t.Fatalf("HashServedResponse: %v", err)
+	}
+	if !pubKey.VerifySignature(digest, sig) {
+		t.Fatalf("signature does not verify against the served response digest")
+	}
+
+	// The signature binds the bytes that were served.
+	otherDigest, err := types.HashServedResponse(chainID, 7, 2, provider, root, &proof, []byte("other range"))
+	if err != nil {
+		t.Fatalf("HashServedResponse: %v", err)
+	}
+	if pubKey.VerifySignature(otherDigest, sig) {
+		t.Fatalf("signature verifies against bytes that were not served")
+	}
+
+	// The key must belong to the provider named in the response.
+	other := sdk.AccAddress(bytes.Repeat([]byte{9}, 20)).String()
+	if _, err := signServedResponse(7, 2, other, root, payload, served); err == nil {
+		t.Fatalf("expected an error for a provider the key does not sign for")
+	}
+}
+
+func TestServedRangeInBlob(t *testing.T) {
+	blob := uint64(types.BlobPayloadBytes)
+	cases := []struct {
+		offset uint64
+		length uint64
+		want   bool
+	}{
+		{0, blob, true},
+		{blob, blob, true},
+		{blob + 10, 100, true},
+		{blob - 1, 2, false},
+		{10, blob, false},
+		{0, blob + 1, false},
+		{0, 0, false},
+	}
+	for _, tc := range cases {
+		if got := servedRangeInBlob(tc.offset, tc.length); got != tc.want {
+			t.Fatalf("servedRangeInBlob(%d, %d) = %v, want %v", tc.offset, tc.length, got, tc.want)
+		}
+	}
+}
@@ -286,6 +286,8 @@ func New(
 		},
 	)
 	app.EVMKeeper.RegisterStaticPrecompile(nilstoreprecompile.Address, nilstoreprecompile.MustNew(&app.NilchainKeeper))
+	// Contract wallets sign deal intents and receipts through EIP-1271.
+	app.NilchainKeeper.SetEVMKeeper(app.EVMKeeper)
 
 	addressCodec := codecaddress.NewBech32Codec(AccountAddressPrefix)
 	realEvmModule := evm.NewAppModule(app.EVMKeeper, app.AuthKeeper, app.BankKeeper, addressCodec)
@@ -1,9 +1,25 @@
 package app
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	nilchainkeeper "nilchain/x/nilchain/keeper"
+	nilchainmodule "nilchain/x/nilchain/module"
+	nilchaintypes "nilchain/x/nilchain/types"
+
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
@@ -77,3 +93,113 @@ func BenchmarkFullAppSimulation(b *testing.B) {
 		simtestutil.PrintStats(db)
 	}
 }
+
+// BenchmarkCheckMissedProofs measures the per-block EndBlock cost of the
+// missed-proof sweep as the number of live deals grows. Deadlines are spread
+// so the same number of (deal, provider) pairs fall due every block; ns/op
+// should stay flat across the sub-benchmarks.
+//
+// `go test -benchmem -run=^$ ./app -bench ^BenchmarkCheckMissedProofs$`
+func BenchmarkCheckMissedProofs(b *testing.B) {
+	for _, numDeals := range []int{1_000, 10_000, 50_000} {
+		b.Run(fmt.Sprintf("deals=%d", numDeals), func(b *testing.B) {
+			benchmarkCheckMissedProofs(b, numDeals)
+		})
+	}
+}
+
+// unfundedBankKeeper rejects every transfer, so slashes take the
+// insufficient-funds path without needing funded provider accounts.
+type unfundedBankKeeper struct{}
+
+func (unfundedBankKeeper) SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins {
+	return sdk.NewCoins()
+}
+
+func (unfundedBankKeeper) MintCoins(context.Context, string, sdk.Coins) error { return nil }
+
+func (unfundedBankKeeper) SendCoinsFromModuleToAccount(context.Context, string, sdk.AccAddress, sdk.Coins) error {
+	return sdkerrors.ErrInsufficientFunds
+}
+
+func (unfundedBankKeeper) SendCoinsFromAccountToModule(context.Context, sdk.AccAddress, string, sdk.Coins) error {
+	return sdkerrors.ErrInsufficientFunds
+}
+
+func (unfundedBankKeeper) BurnCoins(context.Context, string, sdk.Coins) error { return nil }
+
+func benchmarkCheckMissedProofs(b *testing.B, numDeals int) {
+	const (
+		providersPerDeal = 3
+		startHeight      = uint64(1)
+	)
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(nilchainmodule.AppModule{})
+	storeKey := storetypes.NewKVStoreKey(nilchaintypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(b, storeKey, storetypes.NewTransientStoreKey("transient_bench"))
+	ctx := testCtx.Ctx
+	k := nilchainkeeper.NewKeeper(
+		runtime.NewKVStoreService(storeKey),
+		encCfg.Codec,
+		addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		authtypes.NewModuleAddress(nilchaintypes.GovModuleName),
+		unfundedBankKeeper{},
+		nil,
+	)
+
+	providers := make([]string, providersPerDeal*4)
+	for i := range providers {
+		providers[i] = sdk.AccAddress(fmt.Appendf(nil, "bench-provider-%05d", i)).String()
+	}
+
+	for i := 0; i < numDeals; i++ {
+		dealID := uint64(i)
+		deal := nilchaintypes.Deal{
+			Id:               dealID,
+			Owner:            providers[0],
+			EscrowBalance:    sdkmath.ZeroInt(),
+			MaxMonthlySpend:  sdkmath.ZeroInt(),
+			SpendWindowSpent: sdkmath.ZeroInt(),
+			StartBlock:       startHeight,
+			EndBlock:         ^uint64(0),
+		}
+		for j := 0; j < providersPerDeal; j++ {
+			deal.Providers = append(deal.Providers, providers[(i+j)%len(providers)])
+		}
+		require.NoError(b, k.Deals.Set(ctx, dealID, deal))
+		// One deal falls due per block, independent of numDeals.
+		deadline := nilchainkeeper.NextProofDeadline(startHeight) + dealID
+		for _, p := range deal.Providers {
+			require.NoError(b, k.SetProofDeadline(ctx, dealID, p, deadline))
+		}
+	}
+
+	// Iterating uncommitted IAVL state is not representative of EndBlock on a
+	// running chain, so commit the seeded state and each benchmarked block.
+	testCtx.CMS.Commit()
+
+	firstDue := nilchainkeeper.NextProofDeadline(startHeight)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		height := firstDue + uint64(i)
+		if err := k.CheckMissedProofs(ctx.WithBlockHeight(int64(height))); err != nil {
+			b.Fatal(err)
+		}
+		// The providers of the deal that just fell due prove again, pushing
+		// them to the back of the queue the way ProveLiveness would. Without
+		// this every slashed pair stays on an 11-block cadence and the due set
+		// grows with b.N instead of staying constant.
+		dealID := uint64(i % numDeals)
+		for j := 0; j < providersPerDeal; j++ {
+			p := providers[(int(dealID)+j)%len(providers)]
+			if err := k.SetProofDeadline(ctx, dealID, p, height+uint64(numDeals)); err != nil {
+				b.Fatal(err)
+			}
+		}
+
+		b.StopTimer()
+		testCtx.CMS.Commit()
+		b.StartTimer()
+	}
+}
@@ -30,6 +30,8 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+
+	nilchainmodulekeeper "nilchain/x/nilchain/keeper"
 )
 
 const (
@@ -56,6 +58,15 @@ func interBlockCacheOpt() func(*baseapp.BaseApp) {
 	return baseapp.SetInterBlockCache(store.NewCommitKVStoreCacheManager())
 }
 
+// requireNilchainInvariants runs the nilchain module invariants against the
+// app's latest committed state.
+func requireNilchainInvariants(tb testing.TB, app *App) {
+	tb.Helper()
+	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+	msg, broken := nilchainmodulekeeper.AllInvariants(app.NilchainKeeper)(ctx)
+	require.False(tb, broken, msg)
+}
+
 // BenchmarkSimulation run the chain simulation
 // Running using ignite command:
 // `ignite chain simulate -v --numBlocks 200 --blockSize 50`
@@ -104,6 +115,7 @@ func BenchmarkSimulation(b *testing.B) {
 	err = simtestutil.CheckExportSimulation(bApp, config, simParams)
 	require.NoError(b, err)
 	require.NoError(b, simErr)
+	requireNilchainInvariants(b, bApp)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -187,6 +199,7 @@ func TestFullAppSimulation(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(app, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	requireNilchainInvariants(t, app)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -231,6 +244,7 @@ func TestAppImportExport(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(bApp, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	requireNilchainInvariants(t, bApp)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -351,6 +365,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(bApp, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	requireNilchainInvariants(t, bApp)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -397,6 +412,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		bApp.AppCodec(),
 	)
 	require.NoError(t, err)
+	requireNilchainInvariants(t, newApp)
 }
 
 func TestAppStateDeterminism(t *testing.T) {
@@ -0,0 +1,132 @@
+package nilstore
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"nilchain/x/nilchain/types"
+)
+
+// methodGas is the work one call to a method does, in units of the params'
+// precompile gas schedule. Batch methods add the per-item counts once for each
+// element of their items argument.
+type methodGas struct {
+	reads    uint64
+	writes   uint64
+	proofs   uint64
+	sessions uint64
+
+	items        string
+	itemReads    uint64
+	itemWrites   uint64
+	itemProofs   uint64
+	itemSessions uint64
+}
+
+// methodGasTable counts the keeper reads and writes each method makes through
+// its message handler, including the indexes it maintains, the chained proofs
+// it verifies and the retrieval sessions it opens. Every ABI method has an
+// entry.
+var methodGasTable = map[string]methodGas{
+	// Deals.
+	"createDeal":                   {reads: 4, writes: 7},
+	"updateDealContent":            {reads: 3, writes: 3},
+	"transferDealOwnership":        {reads: 1, writes: 1},
+	"acceptDealOwnership":          {reads: 1, writes: 3},
+	"transferDealOwnershipWithSig": {reads: 2, writes: 3},
+	"addCredit":                    {reads: 2, writes: 1},
+	"extendDeal":                   {reads: 2, writes: 3},
+	"closeDeal":                    {reads: 3, writes: 6},
+	"grantDealAccess":              {reads: 2, writes: 1},
+	"revokeDealAccess":             {reads: 2, writes: 1},
+	"registerSessionKey":           {reads: 1, writes: 3, items: "dealIds", itemReads: 1},
+	"revokeSessionKey":             {reads: 1, writes: 3},
+	"signalSaturation":             {reads: 4, writes: 5},
+
+	// Providers.
+	"registerProvider":   {reads: 2, writes: 2},
+	"updateProvider":     {reads: 1, writes: 1},
+	"setProviderStatus":  {reads: 1, writes: 1},
+	"topUpProviderBond":  {reads: 2, writes: 1},
+	"unbondProviderBond": {reads: 2, writes: 2},
+	"deregisterProvider": {reads: 3, writes: 3},
+	"withdrawRewards":    {reads: 1, writes: 2},
+
+	// Retrieval.
+	"proveRetrievalBatch":         {reads: 2, writes: 4, items: "chunks", itemReads: 1, itemWrites: 1, itemProofs: 1},
+	"openRetrievalSession":        {reads: 3, writes: 6, sessions: 1},
+	"openRetrievalSessions":       {reads: 1, items: "sessions", itemReads: 3, itemWrites: 6, itemSessions: 1},
+	"confirmRetrievalSession":     {reads: 2, writes: 3},
+	"confirmRetrievalSessions":    {items: "sessionIds", itemReads: 2, itemWrites: 3},
+	"cancelRetrievalSession":      {reads: 3, writes: 3},
+	"submitRetrievalSessionProof": {reads: 3, writes: 4, items: "proofs", itemProofs: 1},
+
+	// Liveness, repair and fraud.
+	"proveLiveness":      {reads: 8, writes: 6, proofs: 1},
+	"startSlotRepair":    {reads: 2, writes: 2},
+	"completeSlotRepair": {reads: 2, writes: 3, items: "proofs", itemProofs: 1},
+	"submitFraudProof":   {reads: 5, writes: 4, proofs: 1},
+
+	// Views.
+	"computeRetrievalSessions":   {items: "sessions", itemSessions: 1},
+	"computeRetrievalSessionIds": {items: "sessions", itemSessions: 1},
+	"getDeal":                    {reads: 1},
+	"getDealProviders":           {reads: 1},
+	"getMode2Slots":              {reads: 1},
+	"getProvider":                {reads: 1},
+	"getRetrievalSession":        {reads: 1},
+	"getParams":                  {reads: 1},
+	"getDealHeat":                {reads: 2},
+}
+
+// requiredGas prices a call to method with the given calldata, selector
+// included, under the params' gas schedule. The output of a view method is
+// charged separately once its size is known.
+func requiredGas(params types.Params, method *abi.Method, input []byte) (uint64, error) {
+	cost, ok := methodGasTable[method.Name]
+	if !ok {
+		return 0, fmt.Errorf("nilstore precompile: no gas schedule for %q", method.Name)
+	}
+
+	items := uint64(0)
+	if cost.items != "" {
+		n, err := countItems(method, input[4:], cost.items)
+		if err != nil {
+			return 0, err
+		}
+		items = n
+	}
+
+	gas := params.PrecompileCallGas
+	if method.IsConstant() {
+		gas = params.PrecompileViewCallGas
+	}
+	gas += params.PrecompileCalldataByteGas * uint64(len(input))
+	gas += params.PrecompileStoreReadGas * (cost.reads + items*cost.itemReads)
+	gas += params.PrecompileStoreWriteGas * (cost.writes + items*cost.itemWrites)
+	gas += params.PrecompileProofVerifyGas * (cost.proofs + items*cost.itemProofs)
+	gas += params.PrecompileSessionOpenGas * (cost.sessions + items*cost.itemSessions)
+	return gas, nil
+}
+
+// countItems returns the length of the array argument name. Each element takes
+// at least one 32-byte word of calldata, which keeps the count, and the gas
+// derived from it, bounded by the input size.
+func countItems(method *abi.Method, data []byte, name string) (uint64, error) {
+	values, err := method.Inputs.Unpack(data)
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to unpack args: %w", method.Name, err)
+	}
+	for i, arg := range method.Inputs {
+		if arg.Name != name {
+			continue
+		}
+		v := reflect.ValueOf(values[i])
+		if v.Kind() != reflect.Slice {
+			return 0, fmt.Errorf("%s: %s is not an array", method.Name, name)
+		}
+		return uint64(v.Len()), nil
+	}
+	return 0, fmt.Errorf("%s: missing %s argument", method.Name, name)
+}
@@ -0,0 +1,238 @@
+package nilstore
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+
+	"nilchain/testutil/sample"
+	"nilchain/x/crypto_ffi"
+	"nilchain/x/nilchain/types"
+)
+
+// benchNsPerGas converts benchmark timings into gas. 20ns per gas (50 Mgas/s)
+// is the rate the EIP-4844 point evaluation precompile is priced at.
+const benchNsPerGas = 20
+
+type chunkInput struct {
+	RangeStart uint64            `abi:"rangeStart"`
+	RangeLen   uint64            `abi:"rangeLen"`
+	Proof      chainedProofInput `abi:"proof"`
+}
+
+func testChainedProof() chainedProofInput {
+	return chainedProofInput{
+		MduIndex:        3,
+		MduRootFr:       bytes.Repeat([]byte{1}, 32),
+		ManifestOpening: bytes.Repeat([]byte{2}, 48),
+		BlobCommitment:  bytes.Repeat([]byte{3}, 48),
+		MerklePath:      [][]byte{bytes.Repeat([]byte{4}, 32), bytes.Repeat([]byte{5}, 32)},
+		BlobIndex:       9,
+		ZValue:          bytes.Repeat([]byte{6}, 32),
+		YValue:          bytes.Repeat([]byte{7}, 32),
+		KzgOpeningProof: bytes.Repeat([]byte{8}, 48),
+	}
+}
+
+func testSessions(n int) []openSessionInput {
+	sessions := make([]openSessionInput, n)
+	for i := range sessions {
+		sessions[i] = openSessionInput{
+			DealId:       7,
+			Provider:     sample.AccAddress(),
+			ManifestRoot: bytes.Repeat([]byte{0xab}, 48),
+			BlobCount:    4,
+			Nonce:        uint64(i + 1),
+			ExpiresAt:    100,
+		}
+	}
+	return sessions
+}
+
+func TestGasSchedule_CoversEveryMethod(t *testing.T) {
+	p := MustNew(nil)
+	for name, method := range p.abi.Methods {
+		cost, ok := methodGasTable[name]
+		require.True(t, ok, "%s has no gas schedule", name)
+		if cost.items == "" {
+			continue
+		}
+		found := false
+		for _, arg := range method.Inputs {
+			if arg.Name == cost.items {
+				require.Equal(t, abi.SliceTy, arg.Type.T, "%s.%s", name, cost.items)
+				found = true
+			}
+		}
+		require.True(t, found, "%s has no %s argument", name, cost.items)
+	}
+	require.Len(t, methodGasTable, len(p.abi.Methods))
+}
+
+func TestRequiredGas_ScalesWithProofs(t *testing.T) {
+	p := MustNew(nil)
+	params := types.DefaultParams()
+	proof := testChainedProof()
+
+	price := func(name string, args ...any) (uint64, int) {
+		input, err := p.abi.Pack(name, args...)
+		require.NoError(t, err)
+		method := p.abi.Methods[name]
+		gas, err := requiredGas(params, &method, input)
+		require.NoError(t, err)
+		return gas, len(input)
+	}
+
+	t.Run("proveRetrievalBatch", func(t *testing.T) {
+		chunk := chunkInput{RangeLen: 1024, Proof: proof}
+		one, oneLen := price("proveRetrievalBatch", uint64(7), "nil1provider", "a.txt", uint64(1), []chunkInput{chunk})
+		four, fourLen := price("proveRetrievalBatch", uint64(7), "nil1provider", "a.txt", uint64(1), []chunkInput{chunk, chunk, chunk, chunk})
+
+		perChunk := params.PrecompileProofVerifyGas + params.PrecompileStoreReadGas + params.PrecompileStoreWriteGas
+		require.Equal(t, 3*perChunk+params.PrecompileCalldataByteGas*uint64(fourLen-oneLen), four-one)
+		require.Greater(t, one, params.PrecompileProofVerifyGas)
+	})
+
+	t.Run("completeSlotRepair", func(t *testing.T) {
+		one, oneLen := price("completeSlotRepair", uint64(7), uint32(1), []chainedProofInput{proof})
+		four, fourLen := price("completeSlotRepair", uint64(7), uint32(1), []chainedProofInput{proof, proof, proof, proof})
+		require.Equal(t, 3*params.PrecompileProofVerifyGas+params.PrecompileCalldataByteGas*uint64(fourLen-oneLen), four-one)
+	})
+
+	t.Run("computeRetrievalSessionIds is cheap", func(t *testing.T) {
+		gas, _ := price("computeRetrievalSessionIds", testSessions(1))
+		require.Less(t, gas, params.PrecompileProofVerifyGas)
+	})
+}
+
+func TestRun_ChargesScheduleBeforeDispatch(t *testing.T) {
+	f := initViewFixture(t)
+	deal := f.setDeal(t)
+
+	chunk := chunkInput{RangeLen: 1024, Proof: testChainedProof()}
+	input, err := f.p.abi.Pack("proveRetrievalBatch", deal.Id, deal.Providers[0], "a.txt", uint64(1), []chunkInput{chunk, chunk})
+	require.NoError(t, err)
+	method := f.p.abi.Methods["proveRetrievalBatch"]
+	gas, err := requiredGas(types.DefaultParams(), &method, input)
+	require.NoError(t, err)
+
+	// One gas short of the schedule fails before any proof is verified.
+	_, _, err = f.evm.RunPrecompiledContract(f.p, common.Address{}, input, gas-1, nil, false, nil)
+	require.ErrorIs(t, err, vm.ErrOutOfGas)
+}
+
+func TestRun_GasScheduleFollowsParams(t *testing.T) {
+	f := initViewFixture(t)
+	sessions := testSessions(3)
+
+	_, before := f.staticCall(t, "computeRetrievalSessionIds", sessions)
+
+	params := types.DefaultParams()
+	params.PrecompileSessionOpenGas += 5_000
+	params.PrecompileViewCallGas += 1_000
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	_, after := f.staticCall(t, "computeRetrievalSessionIds", sessions)
+	require.Equal(t, before+3*5_000+1_000, after)
+}
+
+func reportGas(b *testing.B) {
+	b.Helper()
+	b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N)/benchNsPerGas, "gas/op")
+}
+
+// BenchmarkStoreRead and BenchmarkStoreWrite run against an in-memory store,
+// so they bound the CPU side of a keeper access only; the schedule prices
+// reads and writes like the EVM's cold SLOAD and SSTORE to cover disk IO.
+func BenchmarkStoreRead(b *testing.B) {
+	f := initViewFixture(b)
+	deal := f.setDeal(b)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := f.keeper.Deals.Get(f.ctx, deal.Id); err != nil {
+			b.Fatal(err)
+		}
+	}
+	reportGas(b)
+}
+
+func BenchmarkStoreWrite(b *testing.B) {
+	f := initViewFixture(b)
+	deal := f.setDeal(b)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		deal.Size_++
+		if err := f.keeper.Deals.Set(f.ctx, deal.Id, deal); err != nil {
+			b.Fatal(err)
+		}
+	}
+	reportGas(b)
+}
+
+// BenchmarkSessionOpen covers the per-session work outside the store: the
+// provider address check and the session id hash.
+func BenchmarkSessionOpen(b *testing.B) {
+	owner := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	session := testSessions(1)[0]
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		provider, err := sdk.AccAddressFromBech32(session.Provider)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := types.HashRetrievalSessionID(owner.Bytes(), session.DealId, provider.Bytes(), session.ManifestRoot,
+			session.StartMduIndex, session.StartBlobIndex, session.BlobCount, session.Nonce, session.ExpiresAt); err != nil {
+			b.Fatal(err)
+		}
+	}
+	reportGas(b)
+}
+
+// BenchmarkProofVerify times one valid chained proof: the manifest and blob
+// KZG openings plus the blob Merkle path.
+func BenchmarkProofVerify(b *testing.B) {
+	if err := crypto_ffi.Init("../../trusted_setup.txt"); err != nil {
+		b.Skipf("KZG trusted setup unavailable: %v", err)
+	}
+
+	mdu := make([]byte, types.MDU_SIZE)
+	root, err := crypto_ffi.ComputeMduMerkleRoot(mdu)
+	require.NoError(b, err)
+	manifestRoot, manifestBlob, err := crypto_ffi.ComputeManifestCommitment([][]byte{root})
+	require.NoError(b, err)
+	manifestOpening, _, err := crypto_ffi.ComputeManifestProof(manifestBlob, 0)
+	require.NoError(b, err)
+	commitment, merkleProof, z, y, kzgProof, err := crypto_ffi.ComputeMduProofTest(mdu, 0)
+	require.NoError(b, err)
+
+	proof := types.ChainedProof{
+		MduRootFr:       root,
+		ManifestOpening: manifestOpening,
+		BlobCommitment:  commitment,
+		ZValue:          z,
+		YValue:          y,
+		KzgOpeningProof: kzgProof,
+	}
+	for i := 0; i < len(merkleProof); i += 32 {
+		proof.MerklePath = append(proof.MerklePath, merkleProof[i:i+32])
+	}
+	ctx := sdk.Context{}.WithLogger(log.NewNopLogger())
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ok, err := verifyChainedProof(ctx, manifestRoot, types.BlobsPerMdu, proof)
+		if err != nil || !ok {
+			b.Fatalf("proof did not verify: %v", err)
+		}
+	}
+	reportGas(b)
+}
@@ -0,0 +1,823 @@
+package nilstore
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	nilkeeper "nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+// The methods in this file relay the remaining MsgServer RPCs with the EVM
+// caller as creator, so the message handlers apply their usual authorization.
+
+type chainedProofInput struct {
+	MduIndex        uint64   `abi:"mduIndex"`
+	MduRootFr       []byte   `abi:"mduRootFr"`
+	ManifestOpening []byte   `abi:"manifestOpening"`
+	BlobCommitment  []byte   `abi:"blobCommitment"`
+	MerklePath      [][]byte `abi:"merklePath"`
+	BlobIndex       uint32   `abi:"blobIndex"`
+	ZValue          []byte   `abi:"zValue"`
+	YValue          []byte   `abi:"yValue"`
+	KzgOpeningProof []byte   `abi:"kzgOpeningProof"`
+}
+
+func (in chainedProofInput) toChainedProof() types.ChainedProof {
+	return types.ChainedProof{
+		MduIndex:        in.MduIndex,
+		MduRootFr:       in.MduRootFr,
+		ManifestOpening: in.ManifestOpening,
+		BlobCommitment:  in.BlobCommitment,
+		MerklePath:      in.MerklePath,
+		BlobIndex:       in.BlobIndex,
+		ZValue:          in.ZValue,
+		YValue:          in.YValue,
+		KzgOpeningProof: in.KzgOpeningProof,
+	}
+}
+
+func toChainedProofs(in []chainedProofInput) []types.ChainedProof {
+	out := make([]types.ChainedProof, len(in))
+	for i, proof := range in {
+		out[i] = proof.toChainedProof()
+	}
+	return out
+}
+
+type registerProviderInput struct {
+	Capabilities string   `abi:"capabilities"`
+	TotalStorage uint64   `abi:"totalStorage"`
+	Endpoints    []string `abi:"endpoints"`
+	Bond         *big.Int `abi:"bond"`
+	OperatorId   string   `abi:"operatorId"`
+	Region       string   `abi:"region"`
+	HostGroup    string   `abi:"hostGroup"`
+}
+
+type updateProviderInput struct {
+	Endpoints    []string `abi:"endpoints"`
+	TotalStorage uint64   `abi:"totalStorage"`
+	Capabilities string   `abi:"capabilities"`
+}
+
+type completeSlotRepairInput struct {
+	DealId uint64              `abi:"dealId"`
+	Slot   uint32              `abi:"slot"`
+	Proofs []chainedProofInput `abi:"proofs"`
+}
+
+type submitRetrievalSessionProofInput struct {
+	SessionId [32]byte            `abi:"sessionId"`
+	Proofs    []chainedProofInput `abi:"proofs"`
+}
+
+type proveLivenessInput struct {
+	DealId  uint64            `abi:"dealId"`
+	EpochId uint64            `abi:"epochId"`
+	Proof   chainedProofInput `abi:"proof"`
+}
+
+type grantDealAccessInput struct {
+	DealId    uint64   `abi:"dealId"`
+	Grantee   string   `abi:"grantee"`
+	ExpiresAt uint64   `abi:"expiresAt"`
+	MaxBytes  uint64   `abi:"maxBytes"`
+	MaxFee    *big.Int `abi:"maxFee"`
+}
+
+type registerSessionKeyInput struct {
+	SessionKey common.Address `abi:"sessionKey"`
+	DealIds    []uint64       `abi:"dealIds"`
+	ExpiresAt  uint64         `abi:"expiresAt"`
+	MaxBytes   uint64         `abi:"maxBytes"`
+	MaxFee     *big.Int       `abi:"maxFee"`
+}
+
+type submitFraudProofInput struct {
+	DealId            uint64            `abi:"dealId"`
+	Provider          string            `abi:"provider"`
+	Generation        uint64            `abi:"generation"`
+	ManifestRoot      []byte            `abi:"manifestRoot"`
+	Proof             chainedProofInput `abi:"proof"`
+	Data              []byte            `abi:"data"`
+	ProviderSignature []byte            `abi:"providerSignature"`
+}
+
+// unpackInputs decodes a method's arguments into the matching input struct.
+func unpackInputs(method *abi.Method, data []byte, dst any) error {
+	values, err := method.Inputs.Unpack(data)
+	if err != nil {
+		return fmt.Errorf("%s: failed to unpack args: %w", method.Name, err)
+	}
+	if err := method.Inputs.Copy(dst, values); err != nil {
+		return fmt.Errorf("%s: failed to decode args: %w", method.Name, err)
+	}
+	return nil
+}
+
+func (p *Precompile) bondDenom(ctx sdk.Context) (string, error) {
+	params, err := p.keeper.Params.Get(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to load params: %w", err)
+	}
+	return params.MinProviderBond.Denom, nil
+}
+
+func (p *Precompile) runRegisterProvider(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	var in registerProviderInput
+	if err := unpackInputs(method, data, &in); err != nil {
+		return nil, err
+	}
+	bondAmount, err := asMathInt(in.Bond)
+	if err != nil {
+		return nil, fmt.Errorf("registerProvider: invalid bond: %w", err)
+	}
+
+	// A zero bond leaves the coin unset so the handler locks exactly the
+	// required bond.
+	var bond sdk.Coin
+	if bondAmount.IsPositive() {
+		denom, err := p.bondDenom(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("registerProvider: %w", err)
+		}
+		bond = sdk.NewCoin(denom, bondAmount)
+	}
+
+	caller := contract.Caller()
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	_, err = msgServer.RegisterProvider(sdk.WrapSDKContext(ctx), &types.MsgRegisterProvider{
+		Creator:      sdk.AccAddress(caller.Bytes()).String(),
+		Capabilities: strings.TrimSpace(in.Capabilities),
+		TotalStorage: in.TotalStorage,
+		Endpoints:    in.Endpoints,
+		Bond:         bond,
+		FailureDomain: types.ProviderFailureDomain{
+			OperatorId: in.OperatorId,
+			Region:     in.Region,
+			HostGroup:  in.HostGroup,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p.emitEvent(evm, "ProviderRegistered", []common.Hash{addressTopic(caller)}, strings.TrimSpace(in.Capabilities), in.TotalStorage)
+
+	out, err := method.Outputs.Pack(true)
+	if err != nil {
+		return nil, fmt.Errorf("registerProvider: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runUpdateProvider(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	var in updateProviderInput
+	if err := unpackInputs(method, data, &in); err != nil {
+		return nil, err
+	}
+
+	caller := contract.Caller()
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	res, err := msgServer.UpdateProvider(sdk.WrapSDKContext(ctx), &types.MsgUpdateProvider{
+		Creator:      sdk.AccAddress(caller.Bytes()).String(),
+		Endpoints:    in.Endpoints,
+		TotalStorage: in.TotalStorage,
+		Capabilities: strings.TrimSpace(in.Capabilities),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p.emitEvent(evm, "ProviderUpdated", []common.Hash{addressTopic(caller)}, res.Provider.Capabilities, res.Provider.TotalStorage)
+
+	out, err := method.Outputs.Pack(true)
+	if err != nil {
+		return nil, fmt.Errorf("updateProvider: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runSetProviderStatus(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	args := make(map[string]any)
+	if err := method.Inputs.UnpackIntoMap(args, data); err != nil {
+		return nil, fmt.Errorf("setProviderStatus: failed to unpack args: %w", err)
+	}
+	status, err := asString(args["status"])
+	if err != nil {
+		return nil, errors.New("setProviderStatus: invalid status")
+	}
+
+	caller := contract.Caller()
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	res, err := msgServer.SetProviderStatus(sdk.WrapSDKContext(ctx), &types.MsgSetProviderStatus{
+		Creator: sdk.AccAddress(caller.Bytes()).String(),
+		Status:  strings.TrimSpace(status),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p.emitEvent(evm, "ProviderStatusChanged", []common.Hash{addressTopic(caller)}, res.Status)
+
+	out, err := method.Outputs.Pack(res.Status)
+	if err != nil {
+		return nil, fmt.Errorf("setProviderStatus: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runTopUpProviderBond(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	args := make(map[string]any)
+	if err := method.Inputs.UnpackIntoMap(args, data); err != nil {
+		return nil, fmt.Errorf("topUpProviderBond: failed to unpack args: %w", err)
+	}
+	amount, err := asMathInt(args["amount"])
+	if err != nil {
+		return nil, fmt.Errorf("topUpProviderBond: invalid amount: %w", err)
+	}
+	denom, err := p.bondDenom(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("topUpProviderBond: %w", err)
+	}
+
+	caller := contract.Caller()
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	res, err := msgServer.TopUpProviderBond(sdk.WrapSDKContext(ctx), &types.MsgTopUpProviderBond{
+		Creator: sdk.AccAddress(caller.Bytes()).String(),
+		Amount:  sdk.NewCoin(denom, amount),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bond := intToBig(res.Bond.Amount)
+	p.emitEvent(evm, "ProviderBondToppedUp", []common.Hash{addressTopic(caller)}, amount.BigInt(), bond)
+
+	out, err := method.Outputs.Pack(bond)
+	if err != nil {
+		return nil, fmt.Errorf("topUpProviderBond: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runUnbondProviderBond(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	args := make(map[string]any)
+	if err := method.Inputs.UnpackIntoMap(args, data); err != nil {
+		return nil, fmt.Errorf("unbondProviderBond: failed to unpack args: %w", err)
+	}
+	amount, err := asMathInt(args["amount"])
+	if err != nil {
+		return nil, fmt.Errorf("unbondProviderBond: invalid amount: %w", err)
+	}
+	denom, err := p.bondDenom(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unbondProviderBond: %w", err)
+	}
+
+	caller := contract.Caller()
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	res, err := msgServer.UnbondProviderBond(sdk.WrapSDKContext(ctx), &types.MsgUnbondProviderBond{
+		Creator: sdk.AccAddress(caller.Bytes()).String(),
+		Amount:  sdk.NewCoin(denom, amount),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p.emitEvent(evm, "ProviderBondUnbonding", []common.Hash{addressTopic(caller)}, amount.BigInt(), res.CompletionHeight)
+
+	out, err := method.Outputs.Pack(res.CompletionHeight)
+	if err != nil {
+		return nil, fmt.Errorf("unbondProviderBond: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runDeregisterProvider(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, _ []byte) ([]byte, error) {
+	caller := contract.Caller()
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	res, err := msgServer.DeregisterProvider(sdk.WrapSDKContext(ctx), &types.MsgDeregisterProvider{
+		Creator: sdk.AccAddress(caller.Bytes()).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p.emitEvent(evm, "ProviderDeregistered", []common.Hash{addressTopic(caller)}, res.PendingMigrations, res.CompletionHeight)
+
+	out, err := method.Outputs.Pack(res.PendingMigrations, res.CompletionHeight)
+	if err != nil {
+		return nil, fmt.Errorf("deregisterProvider: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runWithdrawRewards(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, _ []byte) ([]byte, error) {
+	caller := contract.Caller()
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	res, err := msgServer.WithdrawRewards(sdk.WrapSDKContext(ctx), &types.MsgWithdrawRewards{
+		Creator: sdk.AccAddress(caller.Bytes()).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	amount := intToBig(res.AmountWithdrawn)
+	storage := intToBig(res.StorageRewards)
+	bandwidth := intToBig(res.BandwidthRewards)
+	p.emitEvent(evm, "RewardsWithdrawn", []common.Hash{addressTopic(caller)}, amount, storage, bandwidth)
+
+	out, err := method.Outputs.Pack(amount, storage, bandwidth)
+	if err != nil {
+		return nil, fmt.Errorf("withdrawRewards: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runAddCredit(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	args := make(map[string]any)
+	if err := method.Inputs.UnpackIntoMap(args, data); err != nil {
+		return nil, fmt.Errorf("addCredit: failed to unpack args: %w", err)
+	}
+	dealID, err := asUint64(args["dealId"])
+	if err != nil {
+		return nil, errors.New("addCredit: invalid dealId")
+	}
+	amount, err := asMathInt(args["amount"])
+	if err != nil {
+		return nil, fmt.Errorf("addCredit: invalid amount: %w", err)
+	}
+
+	caller := contract.Caller()
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	res, err := msgServer.AddCredit(sdk.WrapSDKContext(ctx), &types.MsgAddCredit{
+		Creator: sdk.AccAddress(caller.Bytes()).String(),
+		DealId:  dealID,
+		Amount:  amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	newBalance := intToBig(res.NewBalance)
+	p.emitEvent(evm, "CreditAdded", []common.Hash{uint64Topic(dealID), addressTopic(caller)}, amount.BigInt(), newBalance)
+
+	out, err := method.Outputs.Pack(newBalance)
+	if err != nil {
+		return nil, fmt.Errorf("addCredit: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runExtendDeal(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	args := make(map[string]any)
+	if err := method.Inputs.UnpackIntoMap(args, data); err != nil {
+		return nil, fmt.Errorf("extendDeal: failed to unpack args: %w", err)
+	}
+	dealID, err := asUint64(args["dealId"])
+	if err != nil {
+		return nil, errors.New("extendDeal: invalid dealId")
+	}
+	additionalBlocks, err := asUint64(args["additionalBlocks"])
+	if err != nil {
+		return nil, errors.New("extendDeal: invalid additionalBlocks")
+	}
+
+	caller := contract.Caller()
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	res, err := msgServer.ExtendDeal(sdk.WrapSDKContext(ctx), &types.MsgExtendDeal{
+		Creator:          sdk.AccAddress(caller.Bytes()).String(),
+		DealId:           dealID,
+		AdditionalBlocks: additionalBlocks,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	escrowAdded := intToBig(res.EscrowAdded)
+	p.emitEvent(evm, "DealExtended", []common.Hash{uint64Topic(dealID), addressTopic(caller)}, res.EndBlock, escrowAdded)
+
+	out, err := method.Outputs.Pack(res.EndBlock, escrowAdded, intToBig(res.EscrowBalance))
+	if err != nil {
+		return nil, fmt.Errorf("extendDeal: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runCloseDeal(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	args := make(map[string]any)
+	if err := method.Inputs.UnpackIntoMap(args, data); err != nil {
+		return nil, fmt.Errorf("closeDeal: failed to unpack args: %w", err)
+	}
+	dealID, err := asUint64(args["dealId"])
+	if err != nil {
+		return nil, errors.New("closeDeal: invalid dealId")
+	}
+
+	caller := contract.Caller()
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	res, err := msgServer.CloseDeal(sdk.WrapSDKContext(ctx), &types.MsgCloseDeal{
+		Creator: sdk.AccAddress(caller.Bytes()).String(),
+		DealId:  dealID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	payout := intToBig(res.ProviderPayout)
+	refund := intToBig(res.EscrowRefund)
+	p.emitEvent(evm, "DealClosed", []common.Hash{uint64Topic(dealID), addressTopic(caller)}, payout, refund)
+
+	out, err := method.Outputs.Pack(payout, refund, res.SessionsSettled)
+	if err != nil {
+		return nil, fmt.Errorf("closeDeal: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runSignalSaturation(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	args := make(map[string]any)
+	if err := method.Inputs.UnpackIntoMap(args, data); err != nil {
+		return nil, fmt.Errorf("signalSaturation: failed to unpack args: %w", err)
+	}
+	dealID, err := asUint64(args["dealId"])
+	if err != nil {
+		return nil, errors.New("signalSaturation: invalid dealId")
+	}
+
+	caller := contract.Caller()
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	res, err := msgServer.SignalSaturation(sdk.WrapSDKContext(ctx), &types.MsgSignalSaturation{
+		Creator: sdk.AccAddress(caller.Bytes()).String(),
+		DealId:  dealID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	newProviders := res.NewProviders
+	if newProviders == nil {
+		newProviders = []string{}
+	}
+	p.emitEvent(evm, "SaturationSignaled", []common.Hash{uint64Topic(dealID), addressTopic(caller)}, newProviders)
+
+	out, err := method.Outputs.Pack(newProviders)
+	if err != nil {
+		return nil, fmt.Errorf("signalSaturation: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runStartSlotRepair(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	args := make(map[string]any)
+	if err := method.Inputs.UnpackIntoMap(args, data); err != nil {
+		return nil, fmt.Errorf("startSlotRepair: failed to unpack args: %w", err)
+	}
+	dealID, err := asUint64(args["dealId"])
+	if err != nil {
+		return nil, errors.New("startSlotRepair: invalid dealId")
+	}
+	slot, err := asUint64(args["slot"])
+	if err != nil || slot > uint64(^uint32(0)) {
+		return nil, errors.New("startSlotRepair: invalid slot")
+	}
+	pendingProvider, err := asString(args["pendingProvider"])
+	if err != nil || strings.TrimSpace(pendingProvider) == "" {
+		return nil, errors.New("startSlotRepair: invalid pendingProvider")
+	}
+	pendingProvider = strings.TrimSpace(pendingProvider)
+
+	caller := contract.Caller()
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	_, err = msgServer.StartSlotRepair(sdk.WrapSDKContext(ctx), &types.MsgStartSlotRepair{
+		Creator:         sdk.AccAddress(caller.Bytes()).String(),
+		DealId:          dealID,
+		Slot:            uint32(slot),
+		PendingProvider: pendingProvider,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p.emitEvent(evm, "SlotRepairStarted", []common.Hash{uint64Topic(dealID), addressTopic(caller)}, uint32(slot), pendingProvider)
+
+	out, err := method.Outputs.Pack(true)
+	if err != nil {
+		return nil, fmt.Errorf("startSlotRepair: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runCompleteSlotRepair(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	var in completeSlotRepairInput
+	if err := unpackInputs(method, data, &in); err != nil {
+		return nil, err
+	}
+
+	caller := contract.Caller()
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	_, err := msgServer.CompleteSlotRepair(sdk.WrapSDKContext(ctx), &types.MsgCompleteSlotRepair{
+		Creator: sdk.AccAddress(caller.Bytes()).String(),
+		DealId:  in.DealId,
+		Slot:    in.Slot,
+		Proofs:  toChainedProofs(in.Proofs),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p.emitEvent(evm, "SlotRepairCompleted", []common.Hash{uint64Topic(in.DealId), addressTopic(caller)}, in.Slot)
+
+	out, err := method.Outputs.Pack(true)
+	if err != nil {
+		return nil, fmt.Errorf("completeSlotRepair: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runCancelRetrievalSession(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	args := make(map[string]any)
+	if err := method.Inputs.UnpackIntoMap(args, data); err != nil {
+		return nil, fmt.Errorf("cancelRetrievalSession: failed to unpack args: %w", err)
+	}
+	sessionID, err := asBytes32(args["sessionId"])
+	if err != nil {
+		return nil, errors.New("cancelRetrievalSession: invalid sessionId")
+	}
+
+	caller := contract.Caller()
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	_, err = msgServer.CancelRetrievalSession(sdk.WrapSDKContext(ctx), &types.MsgCancelRetrievalSession{
+		Creator:   sdk.AccAddress(caller.Bytes()).String(),
+		SessionId: sessionID[:],
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p.emitEvent(evm, "RetrievalSessionCanceled", []common.Hash{common.Hash(sessionID), addressTopic(caller)})
+
+	out, err := method.Outputs.Pack(true)
+	if err != nil {
+		return nil, fmt.Errorf("cancelRetrievalSession: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runSubmitRetrievalSessionProof(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	var in submitRetrievalSessionProofInput
+	if err := unpackInputs(method, data, &in); err != nil {
+		return nil, err
+	}
+
+	caller := contract.Caller()
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	_, err := msgServer.SubmitRetrievalSessionProof(sdk.WrapSDKContext(ctx), &types.MsgSubmitRetrievalSessionProof{
+		Creator:   sdk.AccAddress(caller.Bytes()).String(),
+		SessionId: in.SessionId[:],
+		Proofs:    toChainedProofs(in.Proofs),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p.emitEvent(evm, "RetrievalSessionProofSubmitted", []common.Hash{common.Hash(in.SessionId), addressTopic(caller)})
+
+	out, err := method.Outputs.Pack(true)
+	if err != nil {
+		return nil, fmt.Errorf("submitRetrievalSessionProof: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+// runProveLiveness submits a system proof (Path B). Receipt-based proofs
+// carry the user's signature and are relayed by providers from their Cosmos
+// accounts.
+func (p *Precompile) runProveLiveness(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	var in proveLivenessInput
+	if err := unpackInputs(method, data, &in); err != nil {
+		return nil, err
+	}
+
+	caller := contract.Caller()
+	proof := in.Proof.toChainedProof()
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	res, err := msgServer.ProveLiveness(sdk.WrapSDKContext(ctx), &types.MsgProveLiveness{
+		Creator:   sdk.AccAddress(caller.Bytes()).String(),
+		DealId:    in.DealId,
+		EpochId:   in.EpochId,
+		ProofType: &types.MsgProveLiveness_SystemProof{SystemProof: &proof},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Success {
+		p.emitEvent(evm, "LivenessProved", []common.Hash{uint64Topic(in.DealId), addressTopic(caller)}, in.EpochId, res.Tier)
+	}
+
+	out, err := method.Outputs.Pack(res.Success, res.Tier)
+	if err != nil {
+		return nil, fmt.Errorf("proveLiveness: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runGrantDealAccess(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	var in grantDealAccessInput
+	if err := unpackInputs(method, data, &in); err != nil {
+		return nil, err
+	}
+	maxFee, err := asMathInt(in.MaxFee)
+	if err != nil {
+		return nil, fmt.Errorf("grantDealAccess: invalid maxFee: %w", err)
+	}
+	grantee := strings.TrimSpace(in.Grantee)
+
+	caller := contract.Caller()
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	_, err = msgServer.GrantDealAccess(sdk.WrapSDKContext(ctx), &types.MsgGrantDealAccess{
+		Creator:   sdk.AccAddress(caller.Bytes()).String(),
+		DealId:    in.DealId,
+		Grantee:   grantee,
+		ExpiresAt: in.ExpiresAt,
+		MaxBytes:  in.MaxBytes,
+		MaxFee:    maxFee,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p.emitEvent(evm, "DealAccessGranted", []common.Hash{uint64Topic(in.DealId), addressTopic(caller)}, grantee, in.ExpiresAt)
+
+	out, err := method.Outputs.Pack(true)
+	if err != nil {
+		return nil, fmt.Errorf("grantDealAccess: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runRevokeDealAccess(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	args := make(map[string]any)
+	if err := method.Inputs.UnpackIntoMap(args, data); err != nil {
+		return nil, fmt.Errorf("revokeDealAccess: failed to unpack args: %w", err)
+	}
+	dealID, err := asUint64(args["dealId"])
+	if err != nil {
+		return nil, errors.New("revokeDealAccess: invalid dealId")
+	}
+	grantee, err := asString(args["grantee"])
+	if err != nil {
+		return nil, errors.New("revokeDealAccess: invalid grantee")
+	}
+	grantee = strings.TrimSpace(grantee)
+
+	caller := contract.Caller()
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	_, err = msgServer.RevokeDealAccess(sdk.WrapSDKContext(ctx), &types.MsgRevokeDealAccess{
+		Creator: sdk.AccAddress(caller.Bytes()).String(),
+		DealId:  dealID,
+		Grantee: grantee,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p.emitEvent(evm, "DealAccessRevoked", []common.Hash{uint64Topic(dealID), addressTopic(caller)}, grantee)
+
+	out, err := method.Outputs.Pack(true)
+	if err != nil {
+		return nil, fmt.Errorf("revokeDealAccess: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runRegisterSessionKey(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	var in registerSessionKeyInput
+	if err := unpackInputs(method, data, &in); err != nil {
+		return nil, err
+	}
+	maxFee, err := asMathInt(in.MaxFee)
+	if err != nil {
+		return nil, fmt.Errorf("registerSessionKey: invalid maxFee: %w", err)
+	}
+
+	caller := contract.Caller()
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	_, err = msgServer.RegisterSessionKey(sdk.WrapSDKContext(ctx), &types.MsgRegisterSessionKey{
+		Creator:    sdk.AccAddress(caller.Bytes()).String(),
+		SessionKey: sdk.AccAddress(in.SessionKey.Bytes()).String(),
+		DealIds:    in.DealIds,
+		ExpiresAt:  in.ExpiresAt,
+		MaxBytes:   in.MaxBytes,
+		MaxFee:     maxFee,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p.emitEvent(evm, "SessionKeyRegistered", []common.Hash{addressTopic(in.SessionKey), addressTopic(caller)}, in.DealIds, in.ExpiresAt)
+
+	out, err := method.Outputs.Pack(true)
+	if err != nil {
+		return nil, fmt.Errorf("registerSessionKey: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runRevokeSessionKey(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	args := make(map[string]any)
+	if err := method.Inputs.UnpackIntoMap(args, data); err != nil {
+		return nil, fmt.Errorf("revokeSessionKey: failed to unpack args: %w", err)
+	}
+	sessionKey, ok := args["sessionKey"].(common.Address)
+	if !ok {
+		return nil, errors.New("revokeSessionKey: invalid sessionKey")
+	}
+
+	caller := contract.Caller()
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	_, err := msgServer.RevokeSessionKey(sdk.WrapSDKContext(ctx), &types.MsgRevokeSessionKey{
+		Creator:    sdk.AccAddress(caller.Bytes()).String(),
+		SessionKey: sdk.AccAddress(sessionKey.Bytes()).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p.emitEvent(evm, "SessionKeyRevoked", []common.Hash{addressTopic(sessionKey), addressTopic(caller)})
+
+	out, err := method.Outputs.Pack(true)
+	if err != nil {
+		return nil, fmt.Errorf("revokeSessionKey: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runSubmitFraudProof(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	var in submitFraudProofInput
+	if err := unpackInputs(method, data, &in); err != nil {
+		return nil, err
+	}
+	provider := strings.TrimSpace(in.Provider)
+
+	caller := contract.Caller()
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	res, err := msgServer.SubmitFraudProof(sdk.WrapSDKContext(ctx), &types.MsgSubmitFraudProof{
+		Creator:           sdk.AccAddress(caller.Bytes()).String(),
+		DealId:            in.DealId,
+		Provider:          provider,
+		Generation:        in.Generation,
+		ManifestRoot:      in.ManifestRoot,
+		Proof:             in.Proof.toChainedProof(),
+		Data:              in.Data,
+		ProviderSignature: in.ProviderSignature,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reward := intToBig(res.Reward.Amount)
+	p.emitEvent(evm, "FraudProofSubmitted", []common.Hash{uint64Topic(in.DealId), addressTopic(caller)}, provider, res.Reason, reward)
+
+	out, err := method.Outputs.Pack(res.Reason, reward)
+	if err != nil {
+		return nil, fmt.Errorf("submitFraudProof: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+// emitEvent logs the named ABI event with the given indexed topics and
+// non-indexed values.
+func (p *Precompile) emitEvent(evm *vm.EVM, name string, topics []common.Hash, values ...any) {
+	ev, ok := p.abi.Events[name]
+	if !ok {
+		return
+	}
+	data, err := ev.Inputs.NonIndexed().Pack(values...)
+	if err != nil {
+		return
+	}
+	evm.StateDB.AddLog(&ethtypes.Log{
+		Address: p.Address(),
+		Topics:  append([]common.Hash{ev.ID}, topics...),
+		Data:    data,
+	})
+}
+
+func uint64Topic(v uint64) common.Hash {
+	return common.BigToHash(new(big.Int).SetUint64(v))
+}
+
+func addressTopic(addr common.Address) common.Hash {
+	return common.BytesToHash(addr.Bytes())
+}
@@ -13,6 +13,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"nilchain/x/crypto_ffi"
@@ -165,11 +166,456 @@ const nilstoreABIJSON = `[
     "inputs":[{"name":"sessionIds","type":"bytes32[]"}],
     "outputs":[{"name":"ok","type":"bool"}]
   },
+  {
+    "type":"function",
+    "name":"transferDealOwnership",
+    "stateMutability":"nonpayable",
+    "inputs":[
+      {"name":"dealId","type":"uint64"},
+      {"name":"newOwner","type":"string"}
+    ],
+    "outputs":[{"name":"ok","type":"bool"}]
+  },
+  {
+    "type":"function",
+    "name":"acceptDealOwnership",
+    "stateMutability":"nonpayable",
+    "inputs":[{"name":"dealId","type":"uint64"}],
+    "outputs":[{"name":"ok","type":"bool"}]
+  },
+  {
+    "type":"function",
+    "name":"transferDealOwnershipWithSig",
+    "stateMutability":"nonpayable",
+    "inputs":[
+      {"name":"dealId","type":"uint64"},
+      {"name":"newOwner","type":"string"},
+      {"name":"signer","type":"address"},
+      {"name":"nonce","type":"uint64"},
+      {"name":"signature","type":"bytes"}
+    ],
+    "outputs":[{"name":"ok","type":"bool"}]
+  },
+  {
+    "type":"function",
+    "name":"registerProvider",
+    "stateMutability":"nonpayable",
+    "inputs":[
+      {"name":"capabilities","type":"string"},
+      {"name":"totalStorage","type":"uint64"},
+      {"name":"endpoints","type":"string[]"},
+      {"name":"bond","type":"uint256"},
+      {"name":"operatorId","type":"string"},
+      {"name":"region","type":"string"},
+      {"name":"hostGroup","type":"string"}
+    ],
+    "outputs":[{"name":"ok","type":"bool"}]
+  },
+  {
+    "type":"function",
+    "name":"updateProvider",
+    "stateMutability":"nonpayable",
+    "inputs":[
+      {"name":"endpoints","type":"string[]"},
+      {"name":"totalStorage","type":"uint64"},
+      {"name":"capabilities","type":"string"}
+    ],
+    "outputs":[{"name":"ok","type":"bool"}]
+  },
+  {
+    "type":"function",
+    "name":"setProviderStatus",
+    "stateMutability":"nonpayable",
+    "inputs":[{"name":"status","type":"string"}],
+    "outputs":[{"name":"status","type":"string"}]
+  },
+  {
+    "type":"function",
+    "name":"topUpProviderBond",
+    "stateMutability":"nonpayable",
+    "inputs":[{"name":"amount","type":"uint256"}],
+    "outputs":[{"name":"bond","type":"uint256"}]
+  },
+  {
+    "type":"function",
+    "name":"unbondProviderBond",
+    "stateMutability":"nonpayable",
+    "inputs":[{"name":"amount","type":"uint256"}],
+    "outputs":[{"name":"completionHeight","type":"uint64"}]
+  },
+  {
+    "type":"function",
+    "name":"deregisterProvider",
+    "stateMutability":"nonpayable",
+    "inputs":[],
+    "outputs":[
+      {"name":"pendingMigrations","type":"uint64"},
+      {"name":"completionHeight","type":"uint64"}
+    ]
+  },
+  {
+    "type":"function",
+    "name":"withdrawRewards",
+    "stateMutability":"nonpayable",
+    "inputs":[],
+    "outputs":[
+      {"name":"amount","type":"uint256"},
+      {"name":"storageRewards","type":"uint256"},
+      {"name":"bandwidthRewards","type":"uint256"}
+    ]
+  },
+  {
+    "type":"function",
+    "name":"addCredit",
+    "stateMutability":"nonpayable",
+    "inputs":[
+      {"name":"dealId","type":"uint64"},
+      {"name":"amount","type":"uint256"}
+    ],
+    "outputs":[{"name":"newBalance","type":"uint256"}]
+  },
+  {
+    "type":"function",
+    "name":"extendDeal",
+    "stateMutability":"nonpayable",
+    "inputs":[
+      {"name":"dealId","type":"uint64"},
+      {"name":"additionalBlocks","type":"uint64"}
+    ],
+    "outputs":[
+      {"name":"endBlock","type":"uint64"},
+      {"name":"escrowAdded","type":"uint256"},
+      {"name":"escrowBalance","type":"uint256"}
+    ]
+  },
+  {
+    "type":"function",
+    "name":"closeDeal",
+    "stateMutability":"nonpayable",
+    "inputs":[{"name":"dealId","type":"uint64"}],
+    "outputs":[
+      {"name":"providerPayout","type":"uint256"},
+      {"name":"escrowRefund","type":"uint256"},
+      {"name":"sessionsSettled","type":"uint64"}
+    ]
+  },
+  {
+    "type":"function",
+    "name":"signalSaturation",
+    "stateMutability":"nonpayable",
+    "inputs":[{"name":"dealId","type":"uint64"}],
+    "outputs":[{"name":"newProviders","type":"string[]"}]
+  },
+  {
+    "type":"function",
+    "name":"startSlotRepair",
+    "stateMutability":"nonpayable",
+    "inputs":[
+      {"name":"dealId","type":"uint64"},
+      {"name":"slot","type":"uint32"},
+      {"name":"pendingProvider","type":"string"}
+    ],
+    "outputs":[{"name":"ok","type":"bool"}]
+  },
+  {
+    "type":"function",
+    "name":"completeSlotRepair",
+    "stateMutability":"nonpayable",
+    "inputs":[
+      {"name":"dealId","type":"uint64"},
+      {"name":"slot","type":"uint32"},
+      {"name":"proofs","type":"tuple[]","components":[
+        {"name":"mduIndex","type":"uint64"},
+        {"name":"mduRootFr","type":"bytes"},
+        {"name":"manifestOpening","type":"bytes"},
+        {"name":"blobCommitment","type":"bytes"},
+        {"name":"merklePath","type":"bytes[]"},
+        {"name":"blobIndex","type":"uint32"},
+        {"name":"zValue","type":"bytes"},
+        {"name":"yValue","type":"bytes"},
+        {"name":"kzgOpeningProof","type":"bytes"}
+      ]}
+    ],
+    "outputs":[{"name":"ok","type":"bool"}]
+  },
+  {
+    "type":"function",
+    "name":"cancelRetrievalSession",
+    "stateMutability":"nonpayable",
+    "inputs":[{"name":"sessionId","type":"bytes32"}],
+    "outputs":[{"name":"ok","type":"bool"}]
+  },
+  {
+    "type":"function",
+    "name":"submitRetrievalSessionProof",
+    "stateMutability":"nonpayable",
+    "inputs":[
+      {"name":"sessionId","type":"bytes32"},
+      {"name":"proofs","type":"tuple[]","components":[
+        {"name":"mduIndex","type":"uint64"},
+        {"name":"mduRootFr","type":"bytes"},
+        {"name":"manifestOpening","type":"bytes"},
+        {"name":"blobCommitment","type":"bytes"},
+        {"name":"merklePath","type":"bytes[]"},
+        {"name":"blobIndex","type":"uint32"},
+        {"name":"zValue","type":"bytes"},
+        {"name":"yValue","type":"bytes"},
+        {"name":"kzgOpeningProof","type":"bytes"}
+      ]}
+    ],
+    "outputs":[{"name":"ok","type":"bool"}]
+  },
+  {
+    "type":"function",
+    "name":"proveLiveness",
+    "stateMutability":"nonpayable",
+    "inputs":[
+      {"name":"dealId","type":"uint64"},
+      {"name":"epochId","type":"uint64"},
+      {"name":"proof","type":"tuple","components":[
+        {"name":"mduIndex","type":"uint64"},
+        {"name":"mduRootFr","type":"bytes"},
+        {"name":"manifestOpening","type":"bytes"},
+        {"name":"blobCommitment","type":"bytes"},
+        {"name":"merklePath","type":"bytes[]"},
+        {"name":"blobIndex","type":"uint32"},
+        {"name":"zValue","type":"bytes"},
+        {"name":"yValue","type":"bytes"},
+        {"name":"kzgOpeningProof","type":"bytes"}
+      ]}
+    ],
+    "outputs":[
+      {"name":"ok","type":"bool"},
+      {"name":"tier","type":"uint32"}
+    ]
+  },
+  {
+    "type":"function",
+    "name":"grantDealAccess",
+    "stateMutability":"nonpayable",
+    "inputs":[
+      {"name":"dealId","type":"uint64"},
+      {"name":"grantee","type":"string"},
+      {"name":"expiresAt","type":"uint64"},
+      {"name":"maxBytes","type":"uint64"},
+      {"name":"maxFee","type":"uint256"}
+    ],
+    "outputs":[{"name":"ok","type":"bool"}]
+  },
+  {
+    "type":"function",
+    "name":"revokeDealAccess",
+    "stateMutability":"nonpayable",
+    "inputs":[
+      {"name":"dealId","type":"uint64"},
+      {"name":"grantee","type":"string"}
+    ],
+    "outputs":[{"name":"ok","type":"bool"}]
+  },
+  {
+    "type":"function",
+    "name":"registerSessionKey",
+    "stateMutability":"nonpayable",
+    "inputs":[
+      {"name":"sessionKey","type":"address"},
+      {"name":"dealIds","type":"uint64[]"},
+      {"name":"expiresAt","type":"uint64"},
+      {"name":"maxBytes","type":"uint64"},
+      {"name":"maxFee","type":"uint256"}
+    ],
+    "outputs":[{"name":"ok","type":"bool"}]
+  },
+  {
+    "type":"function",
+    "name":"revokeSessionKey",
+    "stateMutability":"nonpayable",
+    "inputs":[{"name":"sessionKey","type":"address"}],
+    "outputs":[{"name":"ok","type":"bool"}]
+  },
+  {
+    "type":"function",
+    "name":"submitFraudProof",
+    "stateMutability":"nonpayable",
+    "inputs":[
+      {"name":"dealId","type":"uint64"},
+      {"name":"provider","type":"string"},
+      {"name":"generation","type":"uint64"},
+      {"name":"manifestRoot","type":"bytes"},
+      {"name":"proof","type":"tuple","components":[
+        {"name":"mduIndex","type":"uint64"},
+        {"name":"mduRootFr","type":"bytes"},
+        {"name":"manifestOpening","type":"bytes"},
+        {"name":"blobCommitment","type":"bytes"},
+        {"name":"merklePath","type":"bytes[]"},
+        {"name":"blobIndex","type":"uint32"},
+        {"name":"zValue","type":"bytes"},
+        {"name":"yValue","type":"bytes"},
+        {"name":"kzgOpeningProof","type":"bytes"}
+      ]},
+      {"name":"data","type":"bytes"},
+      {"name":"providerSignature","type":"bytes"}
+    ],
+    "outputs":[
+      {"name":"reason","type":"string"},
+      {"name":"reward","type":"uint256"}
+    ]
+  },
+  {
+    "type":"function",
+    "name":"getDeal",
+    "stateMutability":"view",
+    "inputs":[{"name":"dealId","type":"uint64"}],
+    "outputs":[{"name":"deal","type":"tuple","components":[
+      {"name":"id","type":"uint64"},
+      {"name":"owner","type":"address"},
+      {"name":"pendingOwner","type":"address"},
+      {"name":"manifestRoot","type":"bytes"},
+      {"name":"sizeBytes","type":"uint64"},
+      {"name":"escrowBalance","type":"uint256"},
+      {"name":"maxMonthlySpend","type":"uint256"},
+      {"name":"startBlock","type":"uint64"},
+      {"name":"endBlock","type":"uint64"},
+      {"name":"redundancyMode","type":"uint32"},
+      {"name":"currentReplication","type":"uint64"},
+      {"name":"serviceHint","type":"string"},
+      {"name":"totalMdus","type":"uint64"},
+      {"name":"witnessMdus","type":"uint64"},
+      {"name":"currentGen","type":"uint64"},
+      {"name":"status","type":"uint8"}
+    ]}]
+  },
+  {
+    "type":"function",
+    "name":"getDealProviders",
+    "stateMutability":"view",
+    "inputs":[{"name":"dealId","type":"uint64"}],
+    "outputs":[{"name":"providers","type":"string[]"}]
+  },
+  {
+    "type":"function",
+    "name":"getMode2Slots",
+    "stateMutability":"view",
+    "inputs":[{"name":"dealId","type":"uint64"}],
+    "outputs":[{"name":"slots","type":"tuple[]","components":[
+      {"name":"slot","type":"uint32"},
+      {"name":"provider","type":"string"},
+      {"name":"status","type":"uint8"},
+      {"name":"pendingProvider","type":"string"},
+      {"name":"statusSinceHeight","type":"int64"},
+      {"name":"repairTargetGen","type":"uint64"},
+      {"name":"repairDeadlineHeight","type":"uint64"}
+    ]}]
+  },
+  {
+    "type":"function",
+    "name":"getProvider",
+    "stateMutability":"view",
+    "inputs":[{"name":"provider","type":"string"}],
+    "outputs":[{"name":"info","type":"tuple","components":[
+      {"name":"provider","type":"string"},
+      {"name":"totalStorage","type":"uint64"},
+      {"name":"usedStorage","type":"uint64"},
+      {"name":"reservedStorage","type":"uint64"},
+      {"name":"capabilities","type":"string"},
+      {"name":"status","type":"string"},
+      {"name":"reputationScore","type":"int64"},
+      {"name":"endpoints","type":"string[]"},
+      {"name":"bond","type":"tuple","components":[
+        {"name":"denom","type":"string"},
+        {"name":"amount","type":"uint256"}
+      ]}
+    ]}]
+  },
+  {
+    "type":"function",
+    "name":"getRetrievalSession",
+    "stateMutability":"view",
+    "inputs":[{"name":"sessionId","type":"bytes32"}],
+    "outputs":[{"name":"session","type":"tuple","components":[
+      {"name":"sessionId","type":"bytes32"},
+      {"name":"dealId","type":"uint64"},
+      {"name":"owner","type":"address"},
+      {"name":"provider","type":"string"},
+      {"name":"manifestRoot","type":"bytes"},
+      {"name":"startMduIndex","type":"uint64"},
+      {"name":"startBlobIndex","type":"uint32"},
+      {"name":"blobCount","type":"uint64"},
+      {"name":"totalBytes","type":"uint64"},
+      {"name":"nonce","type":"uint64"},
+      {"name":"expiresAt","type":"uint64"},
+      {"name":"openedHeight","type":"int64"},
+      {"name":"updatedHeight","type":"int64"},
+      {"name":"status","type":"uint8"},
+      {"name":"lockedFee","type":"uint256"}
+    ]}]
+  },
+  {
+    "type":"function",
+    "name":"getParams",
+    "stateMutability":"view",
+    "inputs":[],
+    "outputs":[{"name":"params","type":"tuple","components":[
+      {"name":"eip712ChainId","type":"uint64"},
+      {"name":"minDurationBlocks","type":"uint64"},
+      {"name":"monthLenBlocks","type":"uint64"},
+      {"name":"epochLenBlocks","type":"uint64"},
+      {"name":"storagePrice","type":"uint256"},
+      {"name":"dealCreationFee","type":"tuple","components":[
+        {"name":"denom","type":"string"},
+        {"name":"amount","type":"uint256"}
+      ]},
+      {"name":"baseRetrievalFee","type":"tuple","components":[
+        {"name":"denom","type":"string"},
+        {"name":"amount","type":"uint256"}
+      ]},
+      {"name":"retrievalPricePerBlob","type":"tuple","components":[
+        {"name":"denom","type":"string"},
+        {"name":"amount","type":"uint256"}
+      ]},
+      {"name":"retrievalBurnBps","type":"uint64"},
+      {"name":"retrievalSessionRetentionBlocks","type":"uint64"}
+    ]}]
+  },
+  {
+    "type":"function",
+    "name":"getDealHeat",
+    "stateMutability":"view",
+    "inputs":[{"name":"dealId","type":"uint64"}],
+    "outputs":[{"name":"heat","type":"tuple","components":[
+      {"name":"bytesServedTotal","type":"uint64"},
+      {"name":"failedChallengesTotal","type":"uint64"},
+      {"name":"lastUpdateHeight","type":"int64"},
+      {"name":"successfulRetrievalsTotal","type":"uint64"}
+    ]}]
+  },
   {"type":"event","name":"DealCreated","inputs":[{"name":"dealId","type":"uint64","indexed":true},{"name":"owner","type":"address","indexed":true}]},
   {"type":"event","name":"DealContentUpdated","inputs":[{"name":"dealId","type":"uint64","indexed":true},{"name":"manifestRoot","type":"bytes","indexed":false},{"name":"sizeBytes","type":"uint64","indexed":false}]},
   {"type":"event","name":"RetrievalProved","inputs":[{"name":"dealId","type":"uint64","indexed":true},{"name":"owner","type":"address","indexed":true},{"name":"provider","type":"string","indexed":false},{"name":"filePath","type":"string","indexed":false},{"name":"bytesServed","type":"uint64","indexed":false},{"name":"nonce","type":"uint64","indexed":false}]},
   {"type":"event","name":"RetrievalSessionOpened","inputs":[{"name":"dealId","type":"uint64","indexed":true},{"name":"owner","type":"address","indexed":true},{"name":"provider","type":"string","indexed":false},{"name":"sessionId","type":"bytes32","indexed":false}]},
-  {"type":"event","name":"RetrievalSessionConfirmed","inputs":[{"name":"sessionId","type":"bytes32","indexed":true},{"name":"owner","type":"address","indexed":true}]}
+  {"type":"event","name":"RetrievalSessionConfirmed","inputs":[{"name":"sessionId","type":"bytes32","indexed":true},{"name":"owner","type":"address","indexed":true}]},
+  {"type":"event","name":"DealOwnershipProposed","inputs":[{"name":"dealId","type":"uint64","indexed":true},{"name":"owner","type":"address","indexed":true},{"name":"pendingOwner","type":"address","indexed":false}]},
+  {"type":"event","name":"DealOwnershipTransferred","inputs":[{"name":"dealId","type":"uint64","indexed":true},{"name":"owner","type":"address","indexed":true},{"name":"previousOwner","type":"address","indexed":false}]},
+  {"type":"event","name":"ProviderRegistered","inputs":[{"name":"provider","type":"address","indexed":true},{"name":"capabilities","type":"string","indexed":false},{"name":"totalStorage","type":"uint64","indexed":false}]},
+  {"type":"event","name":"ProviderUpdated","inputs":[{"name":"provider","type":"address","indexed":true},{"name":"capabilities","type":"string","indexed":false},{"name":"totalStorage","type":"uint64","indexed":false}]},
+  {"type":"event","name":"ProviderStatusChanged","inputs":[{"name":"provider","type":"address","indexed":true},{"name":"status","type":"string","indexed":false}]},
+  {"type":"event","name":"ProviderBondToppedUp","inputs":[{"name":"provider","type":"address","indexed":true},{"name":"amount","type":"uint256","indexed":false},{"name":"bond","type":"uint256","indexed":false}]},
+  {"type":"event","name":"ProviderBondUnbonding","inputs":[{"name":"provider","type":"address","indexed":true},{"name":"amount","type":"uint256","indexed":false},{"name":"completionHeight","type":"uint64","indexed":false}]},
+  {"type":"event","name":"ProviderDeregistered","inputs":[{"name":"provider","type":"address","indexed":true},{"name":"pendingMigrations","type":"uint64","indexed":false},{"name":"completionHeight","type":"uint64","indexed":false}]},
+  {"type":"event","name":"RewardsWithdrawn","inputs":[{"name":"provider","type":"address","indexed":true},{"name":"amount","type":"uint256","indexed":false},{"name":"storageRewards","type":"uint256","indexed":false},{"name":"bandwidthRewards","type":"uint256","indexed":false}]},
+  {"type":"event","name":"CreditAdded","inputs":[{"name":"dealId","type":"uint64","indexed":true},{"name":"sender","type":"address","indexed":true},{"name":"amount","type":"uint256","indexed":false},{"name":"newBalance","type":"uint256","indexed":false}]},
+  {"type":"event","name":"DealExtended","inputs":[{"name":"dealId","type":"uint64","indexed":true},{"name":"owner","type":"address","indexed":true},{"name":"endBlock","type":"uint64","indexed":false},{"name":"escrowAdded","type":"uint256","indexed":false}]},
+  {"type":"event","name":"DealClosed","inputs":[{"name":"dealId","type":"uint64","indexed":true},{"name":"owner","type":"address","indexed":true},{"name":"providerPayout","type":"uint256","indexed":false},{"name":"escrowRefund","type":"uint256","indexed":false}]},
+  {"type":"event","name":"SaturationSignaled","inputs":[{"name":"dealId","type":"uint64","indexed":true},{"name":"provider","type":"address","indexed":true},{"name":"newProviders","type":"string[]","indexed":false}]},
+  {"type":"event","name":"SlotRepairStarted","inputs":[{"name":"dealId","type":"uint64","indexed":true},{"name":"owner","type":"address","indexed":true},{"name":"slot","type":"uint32","indexed":false},{"name":"pendingProvider","type":"string","indexed":false}]},
+  {"type":"event","name":"SlotRepairCompleted","inputs":[{"name":"dealId","type":"uint64","indexed":true},{"name":"provider","type":"address","indexed":true},{"name":"slot","type":"uint32","indexed":false}]},
+  {"type":"event","name":"RetrievalSessionCanceled","inputs":[{"name":"sessionId","type":"bytes32","indexed":true},{"name":"owner","type":"address","indexed":true}]},
+  {"type":"event","name":"RetrievalSessionProofSubmitted","inputs":[{"name":"sessionId","type":"bytes32","indexed":true},{"name":"provider","type":"address","indexed":true}]},
+  {"type":"event","name":"LivenessProved","inputs":[{"name":"dealId","type":"uint64","indexed":true},{"name":"provider","type":"address","indexed":true},{"name":"epochId","type":"uint64","indexed":false},{"name":"tier","type":"uint32","indexed":false}]},
+  {"type":"event","name":"DealAccessGranted","inputs":[{"name":"dealId","type":"uint64","indexed":true},{"name":"owner","type":"address","indexed":true},{"name":"grantee","type":"string","indexed":false},{"name":"expiresAt","type":"uint64","indexed":false}]},
+  {"type":"event","name":"DealAccessRevoked","inputs":[{"name":"dealId","type":"uint64","indexed":true},{"name":"sender","type":"address","indexed":true},{"name":"grantee","type":"string","indexed":false}]},
+  {"type":"event","name":"SessionKeyRegistered","inputs":[{"name":"sessionKey","type":"address","indexed":true},{"name":"owner","type":"address","indexed":true},{"name":"dealIds","type":"uint64[]","indexed":false},{"name":"expiresAt","type":"uint64","indexed":false}]},
+  {"type":"event","name":"SessionKeyRevoked","inputs":[{"name":"sessionKey","type":"address","indexed":true},{"name":"sender","type":"address","indexed":true}]},
+  {"type":"event","name":"FraudProofSubmitted","inputs":[{"name":"dealId","type":"uint64","indexed":true},{"name":"reporter","type":"address","indexed":true},{"name":"provider","type":"string","indexed":false},{"name":"reason","type":"string","indexed":false},{"name":"reward","type":"uint256","indexed":false}]}
 ]`
 
 type sdkContextGetter interface {
@@ -199,17 +645,13 @@ func MustNew(keeper *nilkeeper.Keeper) *Precompile {
 
 func (p *Precompile) Address() common.Address { return Address }
 
+// RequiredGas is zero: the gas schedule lives in the module params, so Run
+// charges it once it has the SDK context.
 func (p *Precompile) RequiredGas(input []byte) uint64 {
-	// Conservative linear model: calldata + fixed overhead; avoids underpricing heavy FFI verification.
-	const base = uint64(200_000)
-	const perByte = uint64(64)
-	return base + perByte*uint64(len(input))
+	return 0
 }
 
 func (p *Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
-	if readonly {
-		return nil, errors.New("nilstore precompile: readonly calls are not supported")
-	}
 	if evm == nil || contract == nil {
 		return nil, errors.New("nilstore precompile: missing evm/contract")
 	}
@@ -232,6 +674,22 @@ func (p *Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]b
 		return nil, fmt.Errorf("nilstore precompile: unknown selector: %w", err)
 	}
 
+	params := p.keeper.GetParams(ctx)
+	gas, err := requiredGas(params, method, input)
+	if err != nil {
+		return nil, err
+	}
+	if !contract.UseGas(gas, nil, tracing.GasChangeCallPrecompiledContract) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	if method.IsConstant() {
+		return p.runView(ctx, evm, contract, method, input[4:], params.PrecompileOutputByteGas)
+	}
+	if readonly {
+		return nil, fmt.Errorf("nilstore precompile: %s is not a view method and cannot run in a static call", method.Name)
+	}
+
 	switch method.Name {
 	case "createDeal":
 		return p.runCreateDeal(ctx, evm, contract, method, input[4:])
@@ -243,14 +701,58 @@ func (p *Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]b
 		return p.runOpenRetrievalSession(ctx, evm, contract, method, input[4:])
 	case "openRetrievalSessions":
 		return p.runOpenRetrievalSessions(ctx, evm, contract, method, input[4:])
-	case "computeRetrievalSessions":
-		return p.runComputeRetrievalSessions(ctx, evm, contract, method, input[4:])
-	case "computeRetrievalSessionIds":
-		return p.runComputeRetrievalSessionIds(ctx, evm, contract, method, input[4:])
 	case "confirmRetrievalSession":
 		return p.runConfirmRetrievalSession(ctx, evm, contract, method, input[4:])
 	case "confirmRetrievalSessions":
 		return p.runConfirmRetrievalSessions(ctx, evm, contract, method, input[4:])
+	case "transferDealOwnership":
+		return p.runTransferDealOwnership(ctx, evm, contract, method, input[4:])
+	case "acceptDealOwnership":
+		return p.runAcceptDealOwnership(ctx, evm, contract, method, input[4:])
+	case "transferDealOwnershipWithSig":
+		return p.runTransferDealOwnershipWithSig(ctx, evm, contract, method, input[4:])
+	case "registerProvider":
+		return p.runRegisterProvider(ctx, evm, contract, method, input[4:])
+	case "updateProvider":
+		return p.runUpdateProvider(ctx, evm, contract, method, input[4:])
+	case "setProviderStatus":
+		return p.runSetProviderStatus(ctx, evm, contract, method, input[4:])
+	case "topUpProviderBond":
+		return p.runTopUpProviderBond(ctx, evm, contract, method, input[4:])
+	case "unbondProviderBond":
+		return p.runUnbondProviderBond(ctx, evm, contract, method, input[4:])
+	case "deregisterProvider":
+		return p.runDeregisterProvider(ctx, evm, contract, method, input[4:])
+	case "withdrawRewards":
+		return p.runWithdrawRewards(ctx, evm, contract, method, input[4:])
+	case "addCredit":
+		return p.runAddCredit(ctx, evm, contract, method, input[4:])
+	case "extendDeal":
+		return p.runExtendDeal(ctx, evm, contract, method, input[4:])
+	case "closeDeal":
+		return p.runCloseDeal(ctx, evm, contract, method, input[4:])
+	case "signalSaturation":
+		return p.runSignalSaturation(ctx, evm, contract, method, input[4:])
+	case "startSlotRepair":
+		return p.runStartSlotRepair(ctx, evm, contract, method, input[4:])
+	case "completeSlotRepair":
+		return p.runCompleteSlotRepair(ctx, evm, contract, method, input[4:])
+	case "cancelRetrievalSession":
+		return p.runCancelRetrievalSession(ctx, evm, contract, method, input[4:])
+	case "submitRetrievalSessionProof":
+		return p.runSubmitRetrievalSessionProof(ctx, evm, contract, method, input[4:])
+	case "proveLiveness":
+		return p.runProveLiveness(ctx, evm, contract, method, input[4:])
+	case "grantDealAccess":
+		return p.runGrantDealAccess(ctx, evm, contract, method, input[4:])
+	case "revokeDealAccess":
+		return p.runRevokeDealAccess(ctx, evm, contract, method, input[4:])
+	case "registerSessionKey":
+		return p.runRegisterSessionKey(ctx, evm, contract, method, input[4:])
+	case "revokeSessionKey":
+		return p.runRevokeSessionKey(ctx, evm, contract, method, input[4:])
+	case "submitFraudProof":
+		return p.runSubmitFraudProof(ctx, evm, contract, method, input[4:])
 	default:
 		return nil, fmt.Errorf("nilstore precompile: unsupported method %q", method.Name)
 	}
@@ -689,6 +1191,141 @@ func (p *Precompile) runUpdateDealContent(ctx sdk.Context, evm *vm.EVM, contract
 	return out, nil
 }
 
+func (p *Precompile) runTransferDealOwnership(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	args := make(map[string]any)
+	if err := method.Inputs.UnpackIntoMap(args, data); err != nil {
+		return nil, fmt.Errorf("transferDealOwnership: failed to unpack args: %w", err)
+	}
+
+	dealID, err := asUint64(args["dealId"])
+	if err != nil {
+		return nil, errors.New("transferDealOwnership: invalid dealId")
+	}
+	newOwner, err := asString(args["newOwner"])
+	if err != nil {
+		return nil, errors.New("transferDealOwnership: invalid newOwner")
+	}
+
+	caller := contract.Caller()
+	creator := sdk.AccAddress(caller.Bytes()).String()
+
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	res, err := msgServer.TransferDealOwnership(sdk.WrapSDKContext(ctx), &types.MsgTransferDealOwnership{
+		Creator:  creator,
+		DealId:   dealID,
+		NewOwner: newOwner,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p.emitEventDealOwnershipProposed(evm, dealID, caller, bech32ToEvmAddress(res.PendingOwner))
+
+	out, err := method.Outputs.Pack(true)
+	if err != nil {
+		return nil, fmt.Errorf("transferDealOwnership: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runAcceptDealOwnership(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	args := make(map[string]any)
+	if err := method.Inputs.UnpackIntoMap(args, data); err != nil {
+		return nil, fmt.Errorf("acceptDealOwnership: failed to unpack args: %w", err)
+	}
+
+	dealID, err := asUint64(args["dealId"])
+	if err != nil {
+		return nil, errors.New("acceptDealOwnership: invalid dealId")
+	}
+
+	caller := contract.Caller()
+	creator := sdk.AccAddress(caller.Bytes()).String()
+
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	res, err := msgServer.AcceptDealOwnership(sdk.WrapSDKContext(ctx), &types.MsgAcceptDealOwnership{
+		Creator: creator,
+		DealId:  dealID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p.emitEventDealOwnershipTransferred(evm, dealID, caller, bech32ToEvmAddress(res.PreviousOwner))
+
+	out, err := method.Outputs.Pack(true)
+	if err != nil {
+		return nil, fmt.Errorf("acceptDealOwnership: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+// runTransferDealOwnershipWithSig relays an EvmTransferDealIntent signed by
+// signer, letting a contract submit either step of the transfer on behalf of
+// an EOA.
+func (p *Precompile) runTransferDealOwnershipWithSig(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
+	args := make(map[string]any)
+	if err := method.Inputs.UnpackIntoMap(args, data); err != nil {
+		return nil, fmt.Errorf("transferDealOwnershipWithSig: failed to unpack args: %w", err)
+	}
+
+	dealID, err := asUint64(args["dealId"])
+	if err != nil {
+		return nil, errors.New("transferDealOwnershipWithSig: invalid dealId")
+	}
+	newOwner, err := asString(args["newOwner"])
+	if err != nil {
+		return nil, errors.New("transferDealOwnershipWithSig: invalid newOwner")
+	}
+	signer, ok := args["signer"].(common.Address)
+	if !ok {
+		return nil, errors.New("transferDealOwnershipWithSig: invalid signer")
+	}
+	nonce, err := asUint64(args["nonce"])
+	if err != nil {
+		return nil, errors.New("transferDealOwnershipWithSig: invalid nonce")
+	}
+	signature, err := asBytes(args["signature"])
+	if err != nil || len(signature) != 65 {
+		return nil, errors.New("transferDealOwnershipWithSig: signature must be 65 bytes")
+	}
+
+	deal, err := p.keeper.Deals.Get(ctx, dealID)
+	if err != nil {
+		return nil, fmt.Errorf("transferDealOwnershipWithSig: deal %d not found", dealID)
+	}
+	previousOwner := bech32ToEvmAddress(deal.Owner)
+
+	msgServer := nilkeeper.NewMsgServerImpl(*p.keeper)
+	res, err := msgServer.TransferDealOwnershipFromEvm(sdk.WrapSDKContext(ctx), &types.MsgTransferDealOwnershipFromEvm{
+		Sender: sdk.AccAddress(contract.Caller().Bytes()).String(),
+		Intent: &types.EvmTransferDealIntent{
+			CreatorEvm: signer.Hex(),
+			DealId:     dealID,
+			NewOwner:   newOwner,
+			Nonce:      nonce,
+			ChainId:    ctx.ChainID(),
+		},
+		EvmSignature: signature,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	owner := bech32ToEvmAddress(res.Owner)
+	if owner != previousOwner {
+		p.emitEventDealOwnershipTransferred(evm, dealID, owner, previousOwner)
+	} else {
+		p.emitEventDealOwnershipProposed(evm, dealID, owner, bech32ToEvmAddress(res.PendingOwner))
+	}
+
+	out, err := method.Outputs.Pack(true)
+	if err != nil {
+		return nil, fmt.Errorf("transferDealOwnershipWithSig: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
 func (p *Precompile) runProveRetrievalBatch(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte) ([]byte, error) {
 	args := make(map[string]any)
 	if err := method.Inputs.UnpackIntoMap(args, data); err != nil {
@@ -797,15 +1434,8 @@ func (p *Precompile) runProveRetrievalBatch(ctx sdk.Context, evm *vm.EVM, contra
 		return nil, fmt.Errorf("proveRetrievalBatch: failed to update deal state: %w", err)
 	}
 
-	currentRewards, err := p.keeper.ProviderRewards.Get(ctx, provider)
-	if err != nil {
-		if !errors.Is(err, collections.ErrNotFound) {
-			return nil, fmt.Errorf("proveRetrievalBatch: failed to load provider rewards: %w", err)
-		}
-		currentRewards = math.ZeroInt()
-	}
-	if err := p.keeper.ProviderRewards.Set(ctx, provider, currentRewards.Add(bandwidthPayment)); err != nil {
-		return nil, fmt.Errorf("proveRetrievalBatch: failed to set provider rewards: %w", err)
+	if err := p.keeper.CreditProviderRewards(ctx, provider, math.ZeroInt(), bandwidthPayment); err != nil {
+		return nil, fmt.Errorf("proveRetrievalBatch: %w", err)
 	}
 
 	if err := p.keeper.ReceiptNoncesByDealFile.Set(ctx, collections.Join(dealID, filePath), nonce); err != nil {
@@ -1034,6 +1664,48 @@ func (p *Precompile) emitEventRetrievalSessionConfirmed(evm *vm.EVM, sessionID [
 	})
 }
 
+func (p *Precompile) emitEventDealOwnershipProposed(evm *vm.EVM, dealID uint64, owner common.Address, pendingOwner common.Address) {
+	ev, ok := p.abi.Events["DealOwnershipProposed"]
+	if !ok {
+		return
+	}
+	data, err := ev.Inputs.NonIndexed().Pack(pendingOwner)
+	if err != nil {
+		return
+	}
+	evm.StateDB.AddLog(&ethtypes.Log{
+		Address: p.Address(),
+		Topics:  []common.Hash{ev.ID, common.BigToHash(new(big.Int).SetUint64(dealID)), common.BytesToHash(owner.Bytes())},
+		Data:    data,
+	})
+}
+
+func (p *Precompile) emitEventDealOwnershipTransferred(evm *vm.EVM, dealID uint64, owner common.Address, previousOwner common.Address) {
+	ev, ok := p.abi.Events["DealOwnershipTransferred"]
+	if !ok {
+		return
+	}
+	data, err := ev.Inputs.NonIndexed().Pack(previousOwner)
+	if err != nil {
+		return
+	}
+	evm.StateDB.AddLog(&ethtypes.Log{
+		Address: p.Address(),
+		Topics:  []common.Hash{ev.ID, common.BigToHash(new(big.Int).SetUint64(dealID)), common.BytesToHash(owner.Bytes())},
+		Data:    data,
+	})
+}
+
+// bech32ToEvmAddress maps a nil account to its EVM address; empty or invalid
+// input yields the zero address.
+func bech32ToEvmAddress(addr string) common.Address {
+	acc, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return common.Address{}
+	}
+	return common.BytesToAddress(acc.Bytes())
+}
+
 func asUint64(v any) (uint64, error) {
 	switch t := v.(type) {
 	case uint64:
@@ -0,0 +1,431 @@
+package nilstore
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"nilchain/testutil/sample"
+	nilkeeper "nilchain/x/nilchain/keeper"
+	module "nilchain/x/nilchain/module"
+	"nilchain/x/nilchain/types"
+)
+
+// contextStateDB is the part of the EVM state the precompile needs: the SDK
+// context its keeper reads from and a log sink for its events.
+type contextStateDB struct {
+	vm.StateDB
+	ctx  sdk.Context
+	logs []*ethtypes.Log
+}
+
+func (s *contextStateDB) GetContext() sdk.Context { return s.ctx }
+
+func (s *contextStateDB) AddLog(log *ethtypes.Log) { s.logs = append(s.logs, log) }
+
+type viewFixture struct {
+	ctx    sdk.Context
+	keeper *nilkeeper.Keeper
+	p      *Precompile
+	db     *contextStateDB
+	evm    *vm.EVM
+}
+
+func initViewFixture(t testing.TB) *viewFixture {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
+	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx.
+		WithChainID("test-chain").
+		WithBlockHeight(10)
+
+	k := nilkeeper.NewKeeper(
+		runtime.NewKVStoreService(storeKey),
+		encCfg.Codec,
+		addressCodec,
+		authtypes.NewModuleAddress(types.GovModuleName),
+		nil,
+		nil,
+	)
+	require.NoError(t, k.Params.Set(ctx, types.DefaultParams()))
+
+	db := &contextStateDB{ctx: ctx}
+	evm := vm.NewEVM(vm.BlockContext{BlockNumber: big.NewInt(10)}, db, params.TestChainConfig, vm.Config{})
+	return &viewFixture{ctx: ctx, keeper: &k, p: MustNew(&k), db: db, evm: evm}
+}
+
+// staticCall runs a view method the way a STATICCALL from a contract would.
+func (f *viewFixture) staticCall(t *testing.T, name string, args ...any) ([]any, uint64) {
+	t.Helper()
+
+	input, err := f.p.abi.Pack(name, args...)
+	require.NoError(t, err)
+	const gas = uint64(1_000_000)
+	out, remaining, err := f.evm.RunPrecompiledContract(f.p, common.Address{}, input, gas, nil, true, nil)
+	require.NoError(t, err)
+
+	values, err := f.p.abi.Unpack(name, out)
+	require.NoError(t, err)
+	return values, gas - remaining
+}
+
+func (f *viewFixture) setDeal(t testing.TB) types.Deal {
+	t.Helper()
+
+	owner := sample.AccAddress()
+	deal := types.Deal{
+		Id:               7,
+		ManifestRoot:     bytes.Repeat([]byte{0xab}, 48),
+		Size_:            4096,
+		Owner:            owner,
+		EscrowBalance:    math.NewInt(1_000_000),
+		MaxMonthlySpend:  math.NewInt(500),
+		StartBlock:       1,
+		EndBlock:         1_000,
+		Providers:        []string{sample.AccAddress(), sample.AccAddress()},
+		RedundancyMode:   2,
+		ServiceHint:      "General:rs=1+1",
+		SpendWindowSpent: math.ZeroInt(),
+		CurrentGen:       3,
+		Status:           types.DealStatus_DEAL_STATUS_ACTIVE,
+	}
+	for i, provider := range deal.Providers {
+		deal.Mode2Slots = append(deal.Mode2Slots, &types.DealSlot{
+			Slot:     uint32(i),
+			Provider: provider,
+			Status:   types.SlotStatus_SLOT_STATUS_ACTIVE,
+		})
+	}
+	deal.Mode2Slots[1].Status = types.SlotStatus_SLOT_STATUS_REPAIRING
+	deal.Mode2Slots[1].PendingProvider = sample.AccAddress()
+	deal.Mode2Slots[1].RepairDeadlineHeight = 50
+	require.NoError(t, f.keeper.Deals.Set(f.ctx, deal.Id, deal))
+	return deal
+}
+
+func TestViewMethods_StaticCall(t *testing.T) {
+	f := initViewFixture(t)
+	deal := f.setDeal(t)
+
+	t.Run("getDeal", func(t *testing.T) {
+		values, _ := f.staticCall(t, "getDeal", deal.Id)
+		got := *abiConvert[viewDeal](t, values[0])
+		require.Equal(t, deal.Id, got.Id)
+		require.Equal(t, bech32ToEvmAddress(deal.Owner), got.Owner)
+		require.Equal(t, common.Address{}, got.PendingOwner)
+		require.Equal(t, deal.ManifestRoot, got.ManifestRoot)
+		require.Equal(t, big.NewInt(1_000_000), got.EscrowBalance)
+		require.Equal(t, uint32(2), got.RedundancyMode)
+		require.Equal(t, deal.ServiceHint, got.ServiceHint)
+		require.Equal(t, uint64(3), got.CurrentGen)
+		require.Equal(t, uint8(types.DealStatus_DEAL_STATUS_ACTIVE), got.Status)
+	})
+
+	t.Run("getDealProviders", func(t *testing.T) {
+		values, _ := f.staticCall(t, "getDealProviders", deal.Id)
+		require.Equal(t, deal.Providers, values[0])
+	})
+
+	t.Run("getMode2Slots", func(t *testing.T) {
+		values, _ := f.staticCall(t, "getMode2Slots", deal.Id)
+		slots := *abiConvert[[]viewSlot](t, values[0])
+		require.Len(t, slots, 2)
+		require.Equal(t, deal.Providers[0], slots[0].Provider)
+		require.Equal(t, uint8(types.SlotStatus_SLOT_STATUS_REPAIRING), slots[1].Status)
+		require.Equal(t, deal.Mode2Slots[1].PendingProvider, slots[1].PendingProvider)
+		require.Equal(t, uint64(50), slots[1].RepairDeadlineHeight)
+	})
+
+	t.Run("getProvider", func(t *testing.T) {
+		addr := deal.Providers[0]
+		require.NoError(t, f.keeper.Providers.Set(f.ctx, addr, types.Provider{
+			Address:      addr,
+			TotalStorage: 1 << 30,
+			UsedStorage:  4096,
+			Capabilities: "General",
+			Status:       "Active",
+			Endpoints:    []string{"/ip4/127.0.0.1/tcp/8082/http"},
+			Bond:         sdk.NewInt64Coin("stake", 250),
+		}))
+
+		values, _ := f.staticCall(t, "getProvider", addr)
+		got := *abiConvert[viewProvider](t, values[0])
+		require.Equal(t, addr, got.Provider)
+		require.Equal(t, uint64(1<<30), got.TotalStorage)
+		require.Equal(t, "Active", got.Status)
+		require.Equal(t, []string{"/ip4/127.0.0.1/tcp/8082/http"}, got.Endpoints)
+		require.Equal(t, "stake", got.Bond.Denom)
+		require.Equal(t, big.NewInt(250), got.Bond.Amount)
+	})
+
+	t.Run("getRetrievalSession", func(t *testing.T) {
+		sessionID := bytes.Repeat([]byte{0x11}, 32)
+		require.NoError(t, f.keeper.RetrievalSessions.Set(f.ctx, sessionID, types.RetrievalSession{
+			SessionId:    sessionID,
+			DealId:       deal.Id,
+			Owner:        deal.Owner,
+			Provider:     deal.Providers[0],
+			ManifestRoot: deal.ManifestRoot,
+			BlobCount:    2,
+			TotalBytes:   2 * types.BLOB_SIZE,
+			Nonce:        1,
+			Status:       types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_USER_CONFIRMED,
+			LockedFee:    math.NewInt(42),
+		}))
+
+		var id [32]byte
+		copy(id[:], sessionID)
+		values, _ := f.staticCall(t, "getRetrievalSession", id)
+		got := *abiConvert[viewRetrievalSession](t, values[0])
+		require.Equal(t, id, got.SessionId)
+		require.Equal(t, deal.Id, got.DealId)
+		require.Equal(t, bech32ToEvmAddress(deal.Owner), got.Owner)
+		require.Equal(t, uint8(types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_USER_CONFIRMED), got.Status)
+		require.Equal(t, big.NewInt(42), got.LockedFee)
+	})
+
+	t.Run("getParams", func(t *testing.T) {
+		params := types.DefaultParams()
+		values, _ := f.staticCall(t, "getParams")
+		got := *abiConvert[viewParams](t, values[0])
+		require.Equal(t, params.Eip712ChainId, got.Eip712ChainId)
+		require.Equal(t, params.StoragePrice.BigInt().String(), got.StoragePrice.String())
+		require.Equal(t, params.BaseRetrievalFee.Denom, got.BaseRetrievalFee.Denom)
+		require.Equal(t, params.BaseRetrievalFee.Amount.BigInt().String(), got.BaseRetrievalFee.Amount.String())
+		require.Equal(t, params.RetrievalBurnBps, got.RetrievalBurnBps)
+	})
+
+	t.Run("getDealHeat", func(t *testing.T) {
+		values, _ := f.staticCall(t, "getDealHeat", deal.Id)
+		require.Equal(t, viewDealHeat{}, *abiConvert[viewDealHeat](t, values[0]))
+
+		require.NoError(t, f.keeper.IncrementHeat(f.ctx, deal.Id, 1024, false))
+		values, _ = f.staticCall(t, "getDealHeat", deal.Id)
+		got := *abiConvert[viewDealHeat](t, values[0])
+		require.Equal(t, uint64(1024), got.BytesServedTotal)
+		require.Equal(t, int64(10), got.LastUpdateHeight)
+	})
+}
+
+func TestViewMethods_DealNotFound(t *testing.T) {
+	f := initViewFixture(t)
+
+	for _, name := range []string{"getDeal", "getDealProviders", "getMode2Slots", "getDealHeat"} {
+		input, err := f.p.abi.Pack(name, uint64(99))
+		require.NoError(t, err)
+		_, _, err = f.evm.RunPrecompiledContract(f.p, common.Address{}, input, 1_000_000, nil, true, nil)
+		require.ErrorContains(t, err, "deal 99 not found", name)
+	}
+}
+
+func TestRun_StaticCallRejectsMutatingMethods(t *testing.T) {
+	f := initViewFixture(t)
+
+	input, err := f.p.abi.Pack("acceptDealOwnership", uint64(1))
+	require.NoError(t, err)
+	_, _, err = f.evm.RunPrecompiledContract(f.p, common.Address{}, input, 1_000_000, nil, true, nil)
+	require.ErrorContains(t, err, "cannot run in a static call")
+}
+
+func TestViewMethods_GasScalesWithOutput(t *testing.T) {
+	f := initViewFixture(t)
+	deal := f.setDeal(t)
+	params := types.DefaultParams()
+
+	input, err := f.p.abi.Pack("getDealProviders", deal.Id)
+	require.NoError(t, err)
+	callGas := params.PrecompileViewCallGas + params.PrecompileCalldataByteGas*uint64(len(input)) + params.PrecompileStoreReadGas
+
+	values, used := f.staticCall(t, "getDealProviders", deal.Id)
+	out, err := f.p.abi.Methods["getDealProviders"].Outputs.Pack(values...)
+	require.NoError(t, err)
+	require.Equal(t, callGas+params.PrecompileOutputByteGas*uint64(len(out)), used)
+
+	// Enough gas for the call but not for the output runs out.
+	_, _, err = f.evm.RunPrecompiledContract(f.p, common.Address{}, input, callGas, nil, true, nil)
+	require.ErrorIs(t, err, vm.ErrOutOfGas)
+}
+
+// abiConvert copies an unpacked ABI tuple into the struct the precompile
+// packs it from.
+func abiConvert[T any](t *testing.T, v any) *T {
+	t.Helper()
+	out, ok := abi.ConvertType(v, new(T)).(*T)
+	require.True(t, ok)
+	return out
+}
+
+// call runs a method as a transaction from caller.
+func (f *viewFixture) call(t *testing.T, caller common.Address, name string, args ...any) ([]any, error) {
+	t.Helper()
+
+	input, err := f.p.abi.Pack(name, args...)
+	require.NoError(t, err)
+	out, _, err := f.evm.RunPrecompiledContract(f.p, caller, input, 10_000_000, nil, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	values, err := f.p.abi.Unpack(name, out)
+	require.NoError(t, err)
+	return values, nil
+}
+
+func TestMessageMethods_CoverMsgServer(t *testing.T) {
+	p := MustNew(nil)
+
+	// Governance-only and signature-relay RPCs have no caller-driven
+	// equivalent; every other RPC is a precompile method with the RPC's name.
+	skip := map[string]bool{
+		"UpdateParams":                 true,
+		"CreateDealFromEvm":            true,
+		"UpdateDealContentFromEvm":     true,
+		"CloseDealFromEvm":             true,
+		"TransferDealOwnershipFromEvm": true,
+	}
+	msgServer := reflect.TypeOf((*types.MsgServer)(nil)).Elem()
+	for i := 0; i < msgServer.NumMethod(); i++ {
+		name := msgServer.Method(i).Name
+		if skip[name] {
+			continue
+		}
+		method := strings.ToLower(name[:1]) + name[1:]
+		_, ok := p.abi.Methods[method]
+		require.True(t, ok, "missing precompile method %s", method)
+	}
+}
+
+func TestMessageMethods_GrantAndRevokeDealAccess(t *testing.T) {
+	f := initViewFixture(t)
+	deal := f.setDeal(t)
+	owner := bech32ToEvmAddress(deal.Owner)
+	grantee := deal.Providers[0]
+
+	// Only the owner may grant.
+	_, err := f.call(t, common.HexToAddress("0x1234"), "grantDealAccess", deal.Id, grantee, uint64(100), uint64(0), big.NewInt(0))
+	require.ErrorContains(t, err, "only deal owner")
+
+	_, err = f.call(t, owner, "grantDealAccess", deal.Id, grantee, uint64(100), uint64(0), big.NewInt(5))
+	require.NoError(t, err)
+	grant, err := f.keeper.DealAccessGrants.Get(f.ctx, collections.Join(deal.Id, grantee))
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), grant.ExpiresAt)
+	require.Equal(t, int64(5), grant.MaxFee.Int64())
+
+	require.Len(t, f.db.logs, 1)
+	ev := f.p.abi.Events["DealAccessGranted"]
+	log := f.db.logs[0]
+	require.Equal(t, []common.Hash{ev.ID, uint64Topic(deal.Id), addressTopic(owner)}, log.Topics)
+	data, err := ev.Inputs.NonIndexed().Unpack(log.Data)
+	require.NoError(t, err)
+	require.Equal(t, []any{grantee, uint64(100)}, data)
+
+	_, err = f.call(t, owner, "revokeDealAccess", deal.Id, grantee)
+	require.NoError(t, err)
+	has, err := f.keeper.DealAccessGrants.Has(f.ctx, collections.Join(deal.Id, grantee))
+	require.NoError(t, err)
+	require.False(t, has)
+	require.Equal(t, f.p.abi.Events["DealAccessRevoked"].ID, f.db.logs[1].Topics[0])
+}
+
+func TestMessageMethods_RegisterAndRevokeSessionKey(t *testing.T) {
+	f := initViewFixture(t)
+	deal := f.setDeal(t)
+	owner := bech32ToEvmAddress(deal.Owner)
+	sessionKey := common.HexToAddress("0x00000000000000000000000000000000000005e5")
+	keyAddr := sdk.AccAddress(sessionKey.Bytes()).String()
+
+	_, err := f.call(t, common.HexToAddress("0x1234"), "registerSessionKey", sessionKey, []uint64{deal.Id}, uint64(100), uint64(0), big.NewInt(0))
+	require.ErrorContains(t, err, "only deal owner")
+
+	_, err = f.call(t, owner, "registerSessionKey", sessionKey, []uint64{deal.Id}, uint64(100), uint64(1<<20), big.NewInt(5))
+	require.NoError(t, err)
+	key, err := f.keeper.SessionKeys.Get(f.ctx, keyAddr)
+	require.NoError(t, err)
+	require.Equal(t, deal.Owner, key.Owner)
+	require.Equal(t, []uint64{deal.Id}, key.DealIds)
+	require.Equal(t, uint64(1<<20), key.MaxBytes)
+	require.Equal(t, int64(5), key.MaxFee.Int64())
+
+	require.Len(t, f.db.logs, 1)
+	ev := f.p.abi.Events["SessionKeyRegistered"]
+	log := f.db.logs[0]
+	require.Equal(t, []common.Hash{ev.ID, addressTopic(sessionKey), addressTopic(owner)}, log.Topics)
+	data, err := ev.Inputs.NonIndexed().Unpack(log.Data)
+	require.NoError(t, err)
+	require.Equal(t, []any{[]uint64{deal.Id}, uint64(100)}, data)
+
+	// The session key may revoke itself.
+	_, err = f.call(t, sessionKey, "revokeSessionKey", sessionKey)
+	require.NoError(t, err)
+	has, err := f.keeper.SessionKeys.Has(f.ctx, keyAddr)
+	require.NoError(t, err)
+	require.False(t, has)
+	require.Equal(t, f.p.abi.Events["SessionKeyRevoked"].ID, f.db.logs[1].Topics[0])
+}
+
+func TestMessageMethods_SetProviderStatus(t *testing.T) {
+	f := initViewFixture(t)
+	caller := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	addr := sdk.AccAddress(caller.Bytes()).String()
+	require.NoError(t, f.keeper.Providers.Set(f.ctx, addr, types.Provider{Address: addr, Status: "Active"}))
+
+	values, err := f.call(t, caller, "setProviderStatus", "Maintenance")
+	require.NoError(t, err)
+	require.Equal(t, "Maintenance", values[0])
+
+	provider, err := f.keeper.Providers.Get(f.ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, "Maintenance", provider.Status)
+	require.Equal(t, f.p.abi.Events["ProviderStatusChanged"].ID, f.db.logs[0].Topics[0])
+
+	// Static calls cannot reach mutating methods.
+	input, err := f.p.abi.Pack("setProviderStatus", "Active")
+	require.NoError(t, err)
+	_, _, err = f.evm.RunPrecompiledContract(f.p, caller, input, 10_000_000, nil, true, nil)
+	require.ErrorContains(t, err, "cannot run in a static call")
+}
+
+func TestUnpackInputs_ChainedProofs(t *testing.T) {
+	p := MustNew(nil)
+	method := p.abi.Methods["completeSlotRepair"]
+
+	proof := chainedProofInput{
+		MduIndex:        3,
+		MduRootFr:       bytes.Repeat([]byte{1}, 32),
+		ManifestOpening: bytes.Repeat([]byte{2}, 48),
+		BlobCommitment:  bytes.Repeat([]byte{3}, 48),
+		MerklePath:      [][]byte{bytes.Repeat([]byte{4}, 32), bytes.Repeat([]byte{5}, 32)},
+		BlobIndex:       9,
+		ZValue:          bytes.Repeat([]byte{6}, 32),
+		YValue:          bytes.Repeat([]byte{7}, 32),
+		KzgOpeningProof: bytes.Repeat([]byte{8}, 48),
+	}
+	data, err := method.Inputs.Pack(uint64(4), uint32(2), []chainedProofInput{proof, proof})
+	require.NoError(t, err)
+
+	var in completeSlotRepairInput
+	require.NoError(t, unpackInputs(&method, data, &in))
+	require.Equal(t, uint64(4), in.DealId)
+	require.Equal(t, uint32(2), in.Slot)
+	require.Equal(t, []types.ChainedProof{proof.toChainedProof(), proof.toChainedProof()}, toChainedProofs(in.Proofs))
+}
@@ -0,0 +1,381 @@
+package nilstore
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"nilchain/x/nilchain/types"
+)
+
+type viewCoin struct {
+	Denom  string   `abi:"denom"`
+	Amount *big.Int `abi:"amount"`
+}
+
+type viewDeal struct {
+	Id                 uint64         `abi:"id"`
+	Owner              common.Address `abi:"owner"`
+	PendingOwner       common.Address `abi:"pendingOwner"`
+	ManifestRoot       []byte         `abi:"manifestRoot"`
+	SizeBytes          uint64         `abi:"sizeBytes"`
+	EscrowBalance      *big.Int       `abi:"escrowBalance"`
+	MaxMonthlySpend    *big.Int       `abi:"maxMonthlySpend"`
+	StartBlock         uint64         `abi:"startBlock"`
+	EndBlock           uint64         `abi:"endBlock"`
+	RedundancyMode     uint32         `abi:"redundancyMode"`
+	CurrentReplication uint64         `abi:"currentReplication"`
+	ServiceHint        string         `abi:"serviceHint"`
+	TotalMdus          uint64         `abi:"totalMdus"`
+	WitnessMdus        uint64         `abi:"witnessMdus"`
+	CurrentGen         uint64         `abi:"currentGen"`
+	Status             uint8          `abi:"status"`
+}
+
+type viewSlot struct {
+	Slot                 uint32 `abi:"slot"`
+	Provider             string `abi:"provider"`
+	Status               uint8  `abi:"status"`
+	PendingProvider      string `abi:"pendingProvider"`
+	StatusSinceHeight    int64  `abi:"statusSinceHeight"`
+	RepairTargetGen      uint64 `abi:"repairTargetGen"`
+	RepairDeadlineHeight uint64 `abi:"repairDeadlineHeight"`
+}
+
+type viewProvider struct {
+	Provider        string   `abi:"provider"`
+	TotalStorage    uint64   `abi:"totalStorage"`
+	UsedStorage     uint64   `abi:"usedStorage"`
+	ReservedStorage uint64   `abi:"reservedStorage"`
+	Capabilities    string   `abi:"capabilities"`
+	Status          string   `abi:"status"`
+	ReputationScore int64    `abi:"reputationScore"`
+	Endpoints       []string `abi:"endpoints"`
+	Bond            viewCoin `abi:"bond"`
+}
+
+type viewRetrievalSession struct {
+	SessionId      [32]byte       `abi:"sessionId"`
+	DealId         uint64         `abi:"dealId"`
+	Owner          common.Address `abi:"owner"`
+	Provider       string         `abi:"provider"`
+	ManifestRoot   []byte         `abi:"manifestRoot"`
+	StartMduIndex  uint64         `abi:"startMduIndex"`
+	StartBlobIndex uint32         `abi:"startBlobIndex"`
+	BlobCount      uint64         `abi:"blobCount"`
+	TotalBytes     uint64         `abi:"totalBytes"`
+	Nonce          uint64         `abi:"nonce"`
+	ExpiresAt      uint64         `abi:"expiresAt"`
+	OpenedHeight   int64          `abi:"openedHeight"`
+	UpdatedHeight  int64          `abi:"updatedHeight"`
+	Status         uint8          `abi:"status"`
+	LockedFee      *big.Int       `abi:"lockedFee"`
+}
+
+type viewParams struct {
+	Eip712ChainId                   uint64   `abi:"eip712ChainId"`
+	MinDurationBlocks               uint64   `abi:"minDurationBlocks"`
+	MonthLenBlocks                  uint64   `abi:"monthLenBlocks"`
+	EpochLenBlocks                  uint64   `abi:"epochLenBlocks"`
+	StoragePrice                    *big.Int `abi:"storagePrice"`
+	DealCreationFee                 viewCoin `abi:"dealCreationFee"`
+	BaseRetrievalFee                viewCoin `abi:"baseRetrievalFee"`
+	RetrievalPricePerBlob           viewCoin `abi:"retrievalPricePerBlob"`
+	RetrievalBurnBps                uint64   `abi:"retrievalBurnBps"`
+	RetrievalSessionRetentionBlocks uint64   `abi:"retrievalSessionRetentionBlocks"`
+}
+
+type viewDealHeat struct {
+	BytesServedTotal          uint64 `abi:"bytesServedTotal"`
+	FailedChallengesTotal     uint64 `abi:"failedChallengesTotal"`
+	LastUpdateHeight          int64  `abi:"lastUpdateHeight"`
+	SuccessfulRetrievalsTotal uint64 `abi:"successfulRetrievalsTotal"`
+}
+
+// runView dispatches a view method and charges for the size of its output.
+// View methods never write state, so they are also served to static calls.
+func (p *Precompile) runView(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, data []byte, outputByteGas uint64) ([]byte, error) {
+	var (
+		out []byte
+		err error
+	)
+	switch method.Name {
+	case "computeRetrievalSessions":
+		out, err = p.runComputeRetrievalSessions(ctx, evm, contract, method, data)
+	case "computeRetrievalSessionIds":
+		out, err = p.runComputeRetrievalSessionIds(ctx, evm, contract, method, data)
+	case "getDeal":
+		out, err = p.runGetDeal(ctx, method, data)
+	case "getDealProviders":
+		out, err = p.runGetDealProviders(ctx, method, data)
+	case "getMode2Slots":
+		out, err = p.runGetMode2Slots(ctx, method, data)
+	case "getProvider":
+		out, err = p.runGetProvider(ctx, method, data)
+	case "getRetrievalSession":
+		out, err = p.runGetRetrievalSession(ctx, method, data)
+	case "getParams":
+		out, err = p.runGetParams(ctx, method)
+	case "getDealHeat":
+		out, err = p.runGetDealHeat(ctx, method, data)
+	default:
+		return nil, fmt.Errorf("nilstore precompile: unsupported method %q", method.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !contract.UseGas(outputByteGas*uint64(len(out)), nil, tracing.GasChangeCallPrecompiledContract) {
+		return nil, vm.ErrOutOfGas
+	}
+	return out, nil
+}
+
+func (p *Precompile) runGetDeal(ctx sdk.Context, method *abi.Method, data []byte) ([]byte, error) {
+	deal, err := p.viewDeal(ctx, method, data)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := method.Outputs.Pack(viewDeal{
+		Id:                 deal.Id,
+		Owner:              bech32ToEvmAddress(deal.Owner),
+		PendingOwner:       bech32ToEvmAddress(deal.PendingOwner),
+		ManifestRoot:       deal.ManifestRoot,
+		SizeBytes:          deal.Size_,
+		EscrowBalance:      intToBig(deal.EscrowBalance),
+		MaxMonthlySpend:    intToBig(deal.MaxMonthlySpend),
+		StartBlock:         deal.StartBlock,
+		EndBlock:           deal.EndBlock,
+		RedundancyMode:     deal.RedundancyMode,
+		CurrentReplication: deal.CurrentReplication,
+		ServiceHint:        deal.ServiceHint,
+		TotalMdus:          deal.TotalMdus,
+		WitnessMdus:        deal.WitnessMdus,
+		CurrentGen:         deal.CurrentGen,
+		Status:             uint8(deal.Status),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getDeal: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runGetDealProviders(ctx sdk.Context, method *abi.Method, data []byte) ([]byte, error) {
+	deal, err := p.viewDeal(ctx, method, data)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := deal.Providers
+	if providers == nil {
+		providers = []string{}
+	}
+	out, err := method.Outputs.Pack(providers)
+	if err != nil {
+		return nil, fmt.Errorf("getDealProviders: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runGetMode2Slots(ctx sdk.Context, method *abi.Method, data []byte) ([]byte, error) {
+	deal, err := p.viewDeal(ctx, method, data)
+	if err != nil {
+		return nil, err
+	}
+
+	slots := make([]viewSlot, 0, len(deal.Mode2Slots))
+	for _, slot := range deal.Mode2Slots {
+		if slot == nil {
+			continue
+		}
+		slots = append(slots, viewSlot{
+			Slot:                 slot.Slot,
+			Provider:             slot.Provider,
+			Status:               uint8(slot.Status),
+			PendingProvider:      slot.PendingProvider,
+			StatusSinceHeight:    slot.StatusSinceHeight,
+			RepairTargetGen:      slot.RepairTargetGen,
+			RepairDeadlineHeight: slot.RepairDeadlineHeight,
+		})
+	}
+	out, err := method.Outputs.Pack(slots)
+	if err != nil {
+		return nil, fmt.Errorf("getMode2Slots: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runGetProvider(ctx sdk.Context, method *abi.Method, data []byte) ([]byte, error) {
+	args := make(map[string]any)
+	if err := method.Inputs.UnpackIntoMap(args, data); err != nil {
+		return nil, fmt.Errorf("getProvider: failed to unpack args: %w", err)
+	}
+	addr, err := asString(args["provider"])
+	if err != nil || strings.TrimSpace(addr) == "" {
+		return nil, errors.New("getProvider: invalid provider")
+	}
+	addr = strings.TrimSpace(addr)
+
+	provider, err := p.keeper.Providers.Get(ctx, addr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, fmt.Errorf("getProvider: provider %s not found", addr)
+		}
+		return nil, fmt.Errorf("getProvider: failed to load provider: %w", err)
+	}
+
+	endpoints := provider.Endpoints
+	if endpoints == nil {
+		endpoints = []string{}
+	}
+	out, err := method.Outputs.Pack(viewProvider{
+		Provider:        provider.Address,
+		TotalStorage:    provider.TotalStorage,
+		UsedStorage:     provider.UsedStorage,
+		ReservedStorage: provider.ReservedStorage,
+		Capabilities:    provider.Capabilities,
+		Status:          provider.Status,
+		ReputationScore: provider.ReputationScore,
+		Endpoints:       endpoints,
+		Bond:            coinToView(provider.Bond),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getProvider: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runGetRetrievalSession(ctx sdk.Context, method *abi.Method, data []byte) ([]byte, error) {
+	args := make(map[string]any)
+	if err := method.Inputs.UnpackIntoMap(args, data); err != nil {
+		return nil, fmt.Errorf("getRetrievalSession: failed to unpack args: %w", err)
+	}
+	sessionID, err := asBytes32(args["sessionId"])
+	if err != nil {
+		return nil, errors.New("getRetrievalSession: invalid sessionId")
+	}
+
+	session, err := p.keeper.RetrievalSessions.Get(ctx, sessionID[:])
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, fmt.Errorf("getRetrievalSession: session 0x%x not found", sessionID)
+		}
+		return nil, fmt.Errorf("getRetrievalSession: failed to load session: %w", err)
+	}
+
+	out, err := method.Outputs.Pack(viewRetrievalSession{
+		SessionId:      sessionID,
+		DealId:         session.DealId,
+		Owner:          bech32ToEvmAddress(session.Owner),
+		Provider:       session.Provider,
+		ManifestRoot:   session.ManifestRoot,
+		StartMduIndex:  session.StartMduIndex,
+		StartBlobIndex: session.StartBlobIndex,
+		BlobCount:      session.BlobCount,
+		TotalBytes:     session.TotalBytes,
+		Nonce:          session.Nonce,
+		ExpiresAt:      session.ExpiresAt,
+		OpenedHeight:   session.OpenedHeight,
+		UpdatedHeight:  session.UpdatedHeight,
+		Status:         uint8(session.Status),
+		LockedFee:      intToBig(session.LockedFee),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getRetrievalSession: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runGetParams(ctx sdk.Context, method *abi.Method) ([]byte, error) {
+	params, err := p.keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getParams: failed to load params: %w", err)
+	}
+
+	// storagePrice is a decimal; it is returned as a fixed-point integer with
+	// 18 decimals.
+	storagePrice := new(big.Int)
+	if !params.StoragePrice.IsNil() {
+		storagePrice = params.StoragePrice.BigInt()
+	}
+	out, err := method.Outputs.Pack(viewParams{
+		Eip712ChainId:                   params.Eip712ChainId,
+		MinDurationBlocks:               params.MinDurationBlocks,
+		MonthLenBlocks:                  params.MonthLenBlocks,
+		EpochLenBlocks:                  params.EpochLenBlocks,
+		StoragePrice:                    storagePrice,
+		DealCreationFee:                 coinToView(params.DealCreationFee),
+		BaseRetrievalFee:                coinToView(params.BaseRetrievalFee),
+		RetrievalPricePerBlob:           coinToView(params.RetrievalPricePerBlob),
+		RetrievalBurnBps:                params.RetrievalBurnBps,
+		RetrievalSessionRetentionBlocks: params.RetrievalSessionRetentionBlocks,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getParams: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+func (p *Precompile) runGetDealHeat(ctx sdk.Context, method *abi.Method, data []byte) ([]byte, error) {
+	deal, err := p.viewDeal(ctx, method, data)
+	if err != nil {
+		return nil, err
+	}
+
+	// A deal nobody has read from yet has no heat entry; report zeroes.
+	heat, err := p.keeper.DealHeatStates.Get(ctx, deal.Id)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, fmt.Errorf("getDealHeat: failed to load heat: %w", err)
+	}
+	out, err := method.Outputs.Pack(viewDealHeat{
+		BytesServedTotal:          heat.BytesServedTotal,
+		FailedChallengesTotal:     heat.FailedChallengesTotal,
+		LastUpdateHeight:          heat.LastUpdateHeight,
+		SuccessfulRetrievalsTotal: heat.SuccessfulRetrievalsTotal,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getDealHeat: failed to pack outputs: %w", err)
+	}
+	return out, nil
+}
+
+// viewDeal loads the deal named by a view method's dealId argument.
+func (p *Precompile) viewDeal(ctx sdk.Context, method *abi.Method, data []byte) (types.Deal, error) {
+	args := make(map[string]any)
+	if err := method.Inputs.UnpackIntoMap(args, data); err != nil {
+		return types.Deal{}, fmt.Errorf("%s: failed to unpack args: %w", method.Name, err)
+	}
+	dealID, err := asUint64(args["dealId"])
+	if err != nil {
+		return types.Deal{}, fmt.Errorf("%s: invalid dealId", method.Name)
+	}
+
+	deal, err := p.keeper.Deals.Get(ctx, dealID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Deal{}, fmt.Errorf("%s: deal %d not found", method.Name, dealID)
+		}
+		return types.Deal{}, fmt.Errorf("%s: failed to load deal: %w", method.Name, err)
+	}
+	return deal, nil
+}
+
+func intToBig(v math.Int) *big.Int {
+	if v.IsNil() {
+		return new(big.Int)
+	}
+	return v.BigInt()
+}
+
+func coinToView(c sdk.Coin) viewCoin {
+	return viewCoin{Denom: c.Denom, Amount: intToBig(c.Amount)}
+}
@@ -25,12 +25,23 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdOpenRetrievalSession())
 	cmd.AddCommand(CmdCancelRetrievalSession())
 	cmd.AddCommand(CmdRegisterProvider())
+	cmd.AddCommand(CmdUpdateProvider())
 	cmd.AddCommand(CmdCreateDeal())
 	cmd.AddCommand(CmdUpdateDealContent())
 	cmd.AddCommand(CmdCreateDealFromEvm())
 	cmd.AddCommand(CmdUpdateDealContentFromEvm())
+	cmd.AddCommand(CmdCloseDeal())
+	cmd.AddCommand(CmdCloseDealFromEvm())
+	cmd.AddCommand(CmdTransferDealOwnership())
+	cmd.AddCommand(CmdAcceptDealOwnership())
+	cmd.AddCommand(CmdTransferDealOwnershipFromEvm())
+	cmd.AddCommand(CmdGrantDealAccess())
+	cmd.AddCommand(CmdRevokeDealAccess())
+	cmd.AddCommand(CmdRegisterSessionKey())
+	cmd.AddCommand(CmdRevokeSessionKey())
 	cmd.AddCommand(CmdSignalSaturation())
 	cmd.AddCommand(CmdAddCredit())
+	cmd.AddCommand(CmdExtendDeal())
 	cmd.AddCommand(CmdWithdrawRewards())
 	return cmd
 }
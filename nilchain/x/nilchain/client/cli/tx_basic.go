@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
 	"cosmossdk.io/math"
@@ -48,10 +50,79 @@ func CmdRegisterProvider() *cobra.Command {
 				Endpoints:    endpoints,
 			}
 
+			bondStr, err := cmd.Flags().GetString("bond")
+			if err != nil {
+				return err
+			}
+			if bondStr != "" {
+				bond, err := sdk.ParseCoinNormalized(bondStr)
+				if err != nil {
+					return fmt.Errorf("invalid --bond: %w", err)
+				}
+				msg.Bond = bond
+			}
+
+			if msg.FailureDomain.OperatorId, err = cmd.Flags().GetString("operator-id"); err != nil {
+				return err
+			}
+			if msg.FailureDomain.Region, err = cmd.Flags().GetString("region"); err != nil {
+				return err
+			}
+			if msg.FailureDomain.HostGroup, err = cmd.Flags().GetString("host-group"); err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 	cmd.Flags().StringArray("endpoint", nil, "Provider endpoint multiaddr (repeatable), e.g. /dns4/host/tcp/8080/http")
+	cmd.Flags().String("bond", "", "Collateral to lock, e.g. 200000stake (defaults to the required bond for total-storage)")
+	cmd.Flags().String("operator-id", "", "Operator running this provider; providers of one operator are spread apart (defaults to the provider address)")
+	cmd.Flags().String("region", "", "Region the provider runs in, e.g. eu-west")
+	cmd.Flags().String("host-group", "", "ASN or host group the provider shares with others")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdUpdateProvider() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-provider",
+		Short: "Update the endpoints, capacity or capabilities of a registered storage provider",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			endpoints, err := cmd.Flags().GetStringArray("endpoint")
+			if err != nil {
+				return err
+			}
+			totalStorage, err := cmd.Flags().GetUint64("total-storage")
+			if err != nil {
+				return err
+			}
+			capabilities, err := cmd.Flags().GetString("capabilities")
+			if err != nil {
+				return err
+			}
+			if len(endpoints) == 0 && totalStorage == 0 && capabilities == "" {
+				return fmt.Errorf("set at least one of --endpoint, --total-storage or --capabilities")
+			}
+
+			msg := types.MsgUpdateProvider{
+				Creator:      clientCtx.GetFromAddress().String(),
+				Endpoints:    endpoints,
+				TotalStorage: totalStorage,
+				Capabilities: capabilities,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().StringArray("endpoint", nil, "Replacement endpoint multiaddr (repeatable); replaces the whole endpoint set")
+	cmd.Flags().Uint64("total-storage", 0, "New total storage in bytes (the bond must already cover it)")
+	cmd.Flags().String("capabilities", "", "New capabilities: Archive, General or Edge")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -248,3 +319,349 @@ func CmdUpdateDealContentFromEvm() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdCloseDeal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close-deal [deal-id]",
+		Short: "Close a deal early, paying providers for time served and refunding the rest of the escrow",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			dealId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCloseDeal{
+				Creator: clientCtx.GetFromAddress().String(),
+				DealId:  dealId,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdCloseDealFromEvm closes a deal from an EVM-signed intent.
+func CmdCloseDealFromEvm() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close-deal-from-evm [payload-json-file]",
+		Short: "Close a deal from an EVM-signed intent",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			path := args[0]
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+
+			var payload struct {
+				Intent       types.EvmCloseDealIntent `json:"intent"`
+				EvmSignature string                   `json:"evm_signature"`
+			}
+			if err := json.Unmarshal(data, &payload); err != nil {
+				return err
+			}
+
+			sig := payload.EvmSignature
+			if len(sig) >= 2 && (sig[0:2] == "0x" || sig[0:2] == "0X") {
+				sig = sig[2:]
+			}
+			sigBz, err := hex.DecodeString(sig)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCloseDealFromEvm{
+				Sender:       clientCtx.GetFromAddress().String(),
+				Intent:       &payload.Intent,
+				EvmSignature: sigBz,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdTransferDealOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-deal-ownership [deal-id] [new-owner]",
+		Short: "Propose a new owner for a deal (omit new-owner to cancel a pending proposal)",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			dealId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			newOwner := ""
+			if len(args) > 1 {
+				newOwner = args[1]
+			}
+
+			msg := types.MsgTransferDealOwnership{
+				Creator:  clientCtx.GetFromAddress().String(),
+				DealId:   dealId,
+				NewOwner: newOwner,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdAcceptDealOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-deal-ownership [deal-id]",
+		Short: "Accept a pending ownership transfer of a deal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			dealId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgAcceptDealOwnership{
+				Creator: clientCtx.GetFromAddress().String(),
+				DealId:  dealId,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdTransferDealOwnershipFromEvm proposes or accepts a deal transfer from an
+// EVM-signed intent.
+func CmdTransferDealOwnershipFromEvm() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-deal-ownership-from-evm [payload-json-file]",
+		Short: "Propose or accept a deal ownership transfer from an EVM-signed intent",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			path := args[0]
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+
+			var payload struct {
+				Intent       types.EvmTransferDealIntent `json:"intent"`
+				EvmSignature string                      `json:"evm_signature"`
+			}
+			if err := json.Unmarshal(data, &payload); err != nil {
+				return err
+			}
+
+			sig := payload.EvmSignature
+			if len(sig) >= 2 && (sig[0:2] == "0x" || sig[0:2] == "0X") {
+				sig = sig[2:]
+			}
+			sigBz, err := hex.DecodeString(sig)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgTransferDealOwnershipFromEvm{
+				Sender:       clientCtx.GetFromAddress().String(),
+				Intent:       &payload.Intent,
+				EvmSignature: sigBz,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGrantDealAccess() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-deal-access [deal-id] [grantee]",
+		Short: "Allow another account to open retrieval sessions and sign receipts for a deal",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			dealId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			expiresAt, err := cmd.Flags().GetUint64("expires-at")
+			if err != nil {
+				return err
+			}
+			maxBytes, err := cmd.Flags().GetUint64("max-bytes")
+			if err != nil {
+				return err
+			}
+			maxFeeStr, err := cmd.Flags().GetString("max-fee")
+			if err != nil {
+				return err
+			}
+			maxFee, ok := math.NewIntFromString(maxFeeStr)
+			if !ok {
+				return fmt.Errorf("invalid max-fee: %s", maxFeeStr)
+			}
+
+			msg := types.MsgGrantDealAccess{
+				Creator:   clientCtx.GetFromAddress().String(),
+				DealId:    dealId,
+				Grantee:   args[1],
+				ExpiresAt: expiresAt,
+				MaxBytes:  maxBytes,
+				MaxFee:    maxFee,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().Uint64("expires-at", 0, "Block height after which the grant lapses (0 = until revoked)")
+	cmd.Flags().Uint64("max-bytes", 0, "Maximum bytes the grantee may retrieve (0 = unlimited)")
+	cmd.Flags().String("max-fee", "0", "Maximum retrieval fees the grantee may draw from escrow (0 = unlimited)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRevokeDealAccess() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-deal-access [deal-id] [grantee]",
+		Short: "Remove an access grant from a deal (owner or grantee)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			dealId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRevokeDealAccess{
+				Creator: clientCtx.GetFromAddress().String(),
+				DealId:  dealId,
+				Grantee: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRegisterSessionKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-session-key [session-key] [deal-ids]",
+		Short: "Let a short-lived key sign retrieval receipts and requests for some of your deals",
+		Long:  "Registers session-key, the account address of an EVM key, for the comma-separated deal-ids until --expires-at.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var dealIds []uint64
+			for _, part := range strings.Split(args[1], ",") {
+				dealId, err := strconv.ParseUint(strings.TrimSpace(part), 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid deal id %q: %w", part, err)
+				}
+				dealIds = append(dealIds, dealId)
+			}
+			expiresAt, err := cmd.Flags().GetUint64("expires-at")
+			if err != nil {
+				return err
+			}
+			maxBytes, err := cmd.Flags().GetUint64("max-bytes")
+			if err != nil {
+				return err
+			}
+			maxFeeStr, err := cmd.Flags().GetString("max-fee")
+			if err != nil {
+				return err
+			}
+			maxFee, ok := math.NewIntFromString(maxFeeStr)
+			if !ok {
+				return fmt.Errorf("invalid max-fee: %s", maxFeeStr)
+			}
+
+			msg := types.MsgRegisterSessionKey{
+				Creator:    clientCtx.GetFromAddress().String(),
+				SessionKey: args[0],
+				DealIds:    dealIds,
+				ExpiresAt:  expiresAt,
+				MaxBytes:   maxBytes,
+				MaxFee:     maxFee,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().Uint64("expires-at", 0, "Block height after which the key lapses (required)")
+	cmd.Flags().Uint64("max-bytes", 0, "Maximum bytes the key may sign for (0 = unlimited)")
+	cmd.Flags().String("max-fee", "0", "Maximum bandwidth fees the key's receipts may draw from escrow (0 = unlimited)")
+	_ = cmd.MarkFlagRequired("expires-at")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRevokeSessionKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-session-key [session-key]",
+		Short: "Remove a session key (owner or the key itself)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRevokeSessionKey{
+				Creator:    clientCtx.GetFromAddress().String(),
+				SessionKey: args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
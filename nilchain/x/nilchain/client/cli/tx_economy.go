@@ -76,6 +76,41 @@ func CmdAddCredit() *cobra.Command {
 	return cmd
 }
 
+func CmdExtendDeal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "extend-deal [deal-id] [additional-blocks]",
+		Short: "Extend a deal's term, topping up escrow at the current storage price",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			dealId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			additionalBlocks, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgExtendDeal{
+				Creator:          clientCtx.GetFromAddress().String(),
+				DealId:           dealId,
+				AdditionalBlocks: additionalBlocks,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdWithdrawRewards() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "withdraw-rewards",
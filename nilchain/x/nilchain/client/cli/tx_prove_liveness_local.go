@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
     "io/ioutil"
 
@@ -49,9 +50,24 @@ func CmdProveLivenessLocal() *cobra.Command {
                 return err
             }
             
-            // 4. Compute Proof for a chunk (Mocking the challenge logic: pick index 0)
-            // In a real client, we would query the chain to get the challenge for the current epoch
-            chunkIndex := uint32(0) 
+            // 4. Compute Proof for the first open challenge of the current epoch.
+			// The file at [file-path] must be the challenged MDU.
+			provider := clientCtx.GetFromAddress().String()
+			chal, err := types.NewQueryClient(clientCtx).GetChallengeSet(cmd.Context(), &types.QueryGetChallengeSetRequest{DealId: dealId, Provider: provider})
+			if err != nil {
+				return err
+			}
+			var target *types.ChallengePosition
+			for i := range chal.Challenges {
+				if !chal.Challenges[i].Satisfied {
+					target = &chal.Challenges[i]
+					break
+				}
+			}
+			if target == nil {
+				return fmt.Errorf("no open challenges for deal %d in epoch %d", dealId, chal.EpochId)
+			}
+			chunkIndex := target.BlobIndex
             
             commitment, merkleProof, z, y, kzgProof, err := crypto_ffi.ComputeMduProofTest(mduBytes, chunkIndex)
             if err != nil {
@@ -66,12 +82,12 @@ func CmdProveLivenessLocal() *cobra.Command {
             
             // 5. Construct Msg
 			msg := types.MsgProveLiveness{
-				Creator: clientCtx.GetFromAddress().String(),
+				Creator: provider,
 				DealId:  dealId,
-                EpochId: 1, // Placeholder
+				EpochId: chal.EpochId,
                 ProofType: &types.MsgProveLiveness_SystemProof{
                     SystemProof: &types.ChainedProof{
-						MduIndex:        0,    // Mock
+						MduIndex:        target.MduIndex,
 						MduRootFr:       root, // Mock
 						ManifestOpening: nil,  // Mock
                         
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"nilchain/x/crypto_ffi"
+	"nilchain/x/nilchain/types"
+)
+
+// verifyDealChainedProof checks a triple proof of one blob against the deal's
+// manifest root. Malformed proofs are reported as invalid; only failures of
+// the verifier itself are returned as errors.
+func verifyDealChainedProof(deal types.Deal, stripe stripeParams, chainedProof *types.ChainedProof) (bool, error) {
+	if chainedProof == nil {
+		return false, nil
+	}
+	if len(chainedProof.ManifestOpening) != 48 || len(chainedProof.MduRootFr) != 32 ||
+		len(chainedProof.BlobCommitment) != 48 || len(chainedProof.MerklePath) == 0 ||
+		len(chainedProof.ZValue) != 32 || len(chainedProof.YValue) != 32 || len(chainedProof.KzgOpeningProof) != 48 {
+		return false, nil
+	}
+	if len(deal.ManifestRoot) != 48 {
+		return false, nil
+	}
+
+	flattenedMerkle := make([]byte, 0, len(chainedProof.MerklePath)*32)
+	for _, node := range chainedProof.MerklePath {
+		if len(node) != 32 {
+			return false, nil
+		}
+		flattenedMerkle = append(flattenedMerkle, node...)
+	}
+
+	return crypto_ffi.VerifyChainedProof(
+		deal.ManifestRoot,
+		chainedProof.MduIndex,
+		chainedProof.ManifestOpening,
+		chainedProof.MduRootFr,
+		chainedProof.BlobCommitment,
+		uint64(chainedProof.BlobIndex),
+		stripe.leafCount,
+		flattenedMerkle,
+		chainedProof.ZValue,
+		chainedProof.YValue,
+		chainedProof.KzgOpeningProof,
+	)
+}
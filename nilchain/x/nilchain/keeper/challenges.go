@@ -0,0 +1,323 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"nilchain/x/nilchain/types"
+)
+
+// Unified liveness: deterministic synthetic challenges and per-epoch quotas.
+// See rfcs/rfc-challenge-derivation-and-quotas.md.
+
+// livenessEpoch returns the liveness epoch id containing height and the
+// height at which that epoch started.
+func livenessEpoch(params types.Params, height int64) (epochID uint64, startHeight uint64) {
+	if height < 0 {
+		height = 0
+	}
+	epochLen := params.EpochLenBlocks
+	epochID = uint64(height) / epochLen
+	return epochID, epochID * epochLen
+}
+
+// EnsureEpochSeed records the randomness R_e for the current liveness epoch
+// the first time it is called in that epoch. It runs in BeginBlock, so on a
+// live chain R_e binds the hash of the epoch's first block. Recording a new
+// seed also prunes liveness state older than the previous epoch.
+func (k Keeper) EnsureEpochSeed(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	epochID, _ := livenessEpoch(k.GetParams(ctx), sdkCtx.BlockHeight())
+
+	has, err := k.EpochSeeds.Has(ctx, epochID)
+	if err != nil {
+		return fmt.Errorf("failed to load epoch seed: %w", err)
+	}
+	if has {
+		return nil
+	}
+
+	seed := types.HashEpochSeed(sdkCtx.ChainID(), epochID, sdkCtx.HeaderHash())
+	if err := k.EpochSeeds.Set(ctx, epochID, seed); err != nil {
+		return fmt.Errorf("failed to set epoch seed: %w", err)
+	}
+
+	if epochID >= 2 {
+		return k.pruneLivenessEpochs(ctx, epochID-2)
+	}
+	return nil
+}
+
+// pruneLivenessEpochs drops seeds, quota states and replay sets for every
+// epoch up to and including through.
+func (k Keeper) pruneLivenessEpochs(ctx context.Context, through uint64) error {
+	if err := k.EpochSeeds.Clear(ctx, new(collections.Range[uint64]).EndInclusive(through)); err != nil {
+		return fmt.Errorf("failed to prune epoch seeds: %w", err)
+	}
+	if err := k.QuotaStates.Clear(ctx, collections.NewPrefixUntilTripleRange[uint64, uint64, string](through)); err != nil {
+		return fmt.Errorf("failed to prune quota states: %w", err)
+	}
+	if err := k.CreditSeen.Clear(ctx, collections.NewPrefixUntilPairRange[uint64, []byte](through)); err != nil {
+		return fmt.Errorf("failed to prune credit ids: %w", err)
+	}
+	if err := k.SyntheticSeen.Clear(ctx, collections.NewPrefixUntilPairRange[uint64, []byte](through)); err != nil {
+		return fmt.Errorf("failed to prune synthetic challenge ids: %w", err)
+	}
+	return nil
+}
+
+// livenessAssignment identifies the unit a provider is accountable for:
+// a slot in Mode 2 or a full replica in Mode 1.
+type livenessAssignment struct {
+	stripe stripeParams
+	slot   uint64
+	// id is U64BE(slot) in Mode 2 and ADDR20(provider) in Mode 1.
+	id []byte
+	// active is false for Mode 2 slots that are not ACTIVE; such slots
+	// receive no synthetic challenges.
+	active bool
+}
+
+func livenessAssignmentFor(deal types.Deal, provider string) (livenessAssignment, error) {
+	stripe, err := stripeParamsForDeal(deal)
+	if err != nil {
+		return livenessAssignment{}, err
+	}
+	a := livenessAssignment{stripe: stripe, active: true}
+
+	if stripe.mode == 2 {
+		slot, ok := providerSlotIndex(deal, provider)
+		if !ok {
+			return livenessAssignment{}, fmt.Errorf("provider %s has no slot in deal %d", provider, deal.Id)
+		}
+		a.slot = slot
+		a.id = sdk.Uint64ToBigEndian(slot)
+		if int(slot) < len(deal.Mode2Slots) {
+			if s := deal.Mode2Slots[slot]; s != nil && s.Status != types.SlotStatus_SLOT_STATUS_ACTIVE {
+				a.active = false
+			}
+		}
+		return a, nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return livenessAssignment{}, fmt.Errorf("invalid provider address: %w", err)
+	}
+	if len(addr) != 20 {
+		return livenessAssignment{}, fmt.Errorf("provider address must be 20 bytes (got %d)", len(addr))
+	}
+	a.id = addr.Bytes()
+	return a, nil
+}
+
+// livenessMdus splits a deal into metadata and user MDUs. Deals that never
+// committed total_mdus fall back to treating ceil(size / MDU_SIZE) MDUs as
+// user data with no metadata prefix.
+func livenessMdus(deal types.Deal) (metaMdus uint64, userMdus uint64) {
+	if deal.TotalMdus == 0 {
+		return 0, ceilDivUint64(deal.Size_, types.MDU_SIZE)
+	}
+	metaMdus = 1 + deal.WitnessMdus
+	if deal.TotalMdus <= metaMdus {
+		return metaMdus, 0
+	}
+	return metaMdus, deal.TotalMdus - metaMdus
+}
+
+// requiredBlobs implements quota_blobs (RFC §4.3).
+func requiredBlobs(params types.Params, deal types.Deal, a livenessAssignment) uint64 {
+	_, userMdus := livenessMdus(deal)
+	if userMdus == 0 {
+		return 0
+	}
+
+	perMdu := uint64(types.MDU_SIZE)
+	if a.stripe.mode == 2 {
+		perMdu = a.stripe.rows * types.BLOB_SIZE
+	}
+
+	quotaBps := params.QuotaBpsPerEpochCold
+	if hint, err := types.ParseServiceHint(deal.ServiceHint); err == nil && hint.Base == "Hot" {
+		quotaBps = params.QuotaBpsPerEpochHot
+	}
+
+	// Saturate to the cap on overflow; only absurdly large deals get there.
+	targetBlobs := params.QuotaMaxBlobs
+	if slotBytes, overflow := mulUint64(userMdus, perMdu); !overflow {
+		if scaled, overflow := mulUint64(slotBytes, quotaBps); !overflow {
+			targetBytes := ceilDivUint64(scaled, 10000)
+			targetBlobs = ceilDivUint64(targetBytes, types.BLOB_SIZE)
+		}
+	}
+
+	if targetBlobs < params.QuotaMinBlobs {
+		targetBlobs = params.QuotaMinBlobs
+	}
+	if targetBlobs > params.QuotaMaxBlobs {
+		targetBlobs = params.QuotaMaxBlobs
+	}
+	return targetBlobs
+}
+
+// appliedCredits caps raw organic credits at credit_cap_bps of the quota (RFC §5.3).
+func appliedCredits(params types.Params, quota uint64, credits uint64) uint64 {
+	creditCap := ceilDivUint64(quota*params.CreditCapBps, 10000)
+	if credits > creditCap {
+		return creditCap
+	}
+	return credits
+}
+
+// challengeSet is the synthetic demand for one assignment in one epoch.
+type challengeSet struct {
+	epochID         uint64
+	epochStart      uint64
+	assignment      livenessAssignment
+	quota           uint64
+	creditsApplied  uint64
+	syntheticNeeded uint64
+	state           types.EpochQuotaState
+	challenges      []types.ChallengePosition
+}
+
+// deriveChallengeSet recomputes S_e(deal, assignment) for the current epoch
+// from the recorded epoch seed (RFC §3).
+func (k Keeper) deriveChallengeSet(ctx context.Context, deal types.Deal, provider string) (challengeSet, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := k.GetParams(ctx)
+	epochID, epochStart := livenessEpoch(params, sdkCtx.BlockHeight())
+
+	seed, err := k.EpochSeeds.Get(ctx, epochID)
+	if err != nil {
+		return challengeSet{}, fmt.Errorf("failed to load seed for epoch %d: %w", epochID, err)
+	}
+
+	a, err := livenessAssignmentFor(deal, provider)
+	if err != nil {
+		return challengeSet{}, err
+	}
+
+	state, err := k.QuotaStates.Get(ctx, collections.Join3(epochID, deal.Id, provider))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return challengeSet{}, fmt.Errorf("failed to load quota state: %w", err)
+	}
+
+	set := challengeSet{
+		epochID:    epochID,
+		epochStart: epochStart,
+		assignment: a,
+		quota:      requiredBlobs(params, deal, a),
+		state:      state,
+	}
+	set.creditsApplied = appliedCredits(params, set.quota, state.CreditsBlobs)
+	set.syntheticNeeded = set.quota - set.creditsApplied
+	if !a.active {
+		set.syntheticNeeded = 0
+	}
+
+	metaMdus, userMdus := livenessMdus(deal)
+	for i := uint64(0); i < set.syntheticNeeded; i++ {
+		chal := types.HashChallengeSeed(seed, deal.Id, deal.CurrentGen, a.id, i)
+		mduIndex := metaMdus + binary.BigEndian.Uint64(chal[0:8])%userMdus
+		var blobIndex uint64
+		if a.stripe.mode == 2 {
+			blobIndex = a.slot*a.stripe.rows + binary.BigEndian.Uint64(chal[8:16])%a.stripe.rows
+		} else {
+			blobIndex = binary.BigEndian.Uint64(chal[8:16]) % types.BlobsPerMdu
+		}
+
+		satisfied, err := k.SyntheticSeen.Has(ctx, collections.Join(epochID, types.HashSyntheticChallengeID(epochID, deal.Id, a.id, mduIndex, uint32(blobIndex))))
+		if err != nil {
+			return challengeSet{}, fmt.Errorf("failed to load synthetic challenge id: %w", err)
+		}
+		set.challenges = append(set.challenges, types.ChallengePosition{
+			Ordinal:   i,
+			MduIndex:  mduIndex,
+			BlobIndex: uint32(blobIndex),
+			Satisfied: satisfied,
+		})
+	}
+	return set, nil
+}
+
+// isChallenged reports whether (mduIndex, blobIndex) is in the set.
+func (s challengeSet) isChallenged(mduIndex uint64, blobIndex uint32) bool {
+	for _, c := range s.challenges {
+		if c.MduIndex == mduIndex && c.BlobIndex == blobIndex {
+			return true
+		}
+	}
+	return false
+}
+
+// recordSyntheticProof marks a verified synthetic challenge as satisfied.
+// It returns false if the challenge was already satisfied this epoch.
+func (k Keeper) recordSyntheticProof(ctx context.Context, set challengeSet, dealID uint64, provider string, mduIndex uint64, blobIndex uint32) (bool, error) {
+	key := collections.Join(set.epochID, types.HashSyntheticChallengeID(set.epochID, dealID, set.assignment.id, mduIndex, blobIndex))
+	seen, err := k.SyntheticSeen.Has(ctx, key)
+	if err != nil {
+		return false, fmt.Errorf("failed to load synthetic challenge id: %w", err)
+	}
+	if seen {
+		return false, nil
+	}
+	if err := k.SyntheticSeen.Set(ctx, key); err != nil {
+		return false, fmt.Errorf("failed to set synthetic challenge id: %w", err)
+	}
+
+	state := set.state
+	state.SyntheticSatisfiedBlobs++
+	if err := k.QuotaStates.Set(ctx, collections.Join3(set.epochID, dealID, provider), state); err != nil {
+		return false, fmt.Errorf("failed to set quota state: %w", err)
+	}
+	return true, nil
+}
+
+// creditOrganicProof credits one verified retrieval blob proof against the
+// provider's quota for the current epoch. Each (assignment, mdu, blob) counts
+// at most once per epoch (RFC §5.2).
+func (k Keeper) creditOrganicProof(ctx context.Context, deal types.Deal, provider string, mduIndex uint64, blobIndex uint32) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	epochID, _ := livenessEpoch(k.GetParams(ctx), sdkCtx.BlockHeight())
+
+	a, err := livenessAssignmentFor(deal, provider)
+	if err != nil {
+		return err
+	}
+
+	key := collections.Join(epochID, types.HashCreditID(epochID, deal.Id, a.id, mduIndex, blobIndex))
+	seen, err := k.CreditSeen.Has(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to load credit id: %w", err)
+	}
+	if seen {
+		return nil
+	}
+	if err := k.CreditSeen.Set(ctx, key); err != nil {
+		return fmt.Errorf("failed to set credit id: %w", err)
+	}
+
+	stateKey := collections.Join3(epochID, deal.Id, provider)
+	state, err := k.QuotaStates.Get(ctx, stateKey)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return fmt.Errorf("failed to load quota state: %w", err)
+	}
+	state.CreditsBlobs++
+	if err := k.QuotaStates.Set(ctx, stateKey, state); err != nil {
+		return fmt.Errorf("failed to set quota state: %w", err)
+	}
+	return nil
+}
+
+func ceilDivUint64(a, b uint64) uint64 {
+	if a == 0 {
+		return 0
+	}
+	return (a-1)/b + 1
+}
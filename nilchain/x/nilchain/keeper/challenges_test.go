@@ -0,0 +1,207 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+// currentChallenge returns the first synthetic challenge the provider must
+// answer for the deal in the epoch of ctx, recording the epoch seed if needed.
+func currentChallenge(t *testing.T, f *fixture, ctx context.Context, dealID uint64, provider string) types.ChallengePosition {
+	t.Helper()
+	require.NoError(t, f.keeper.EnsureEpochSeed(ctx))
+	res, err := keeper.NewQueryServerImpl(f.keeper).GetChallengeSet(ctx, &types.QueryGetChallengeSetRequest{DealId: dealID, Provider: provider})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Challenges)
+	return res.Challenges[0]
+}
+
+func livenessTestDeal(providers []string, hint string, size uint64) types.Deal {
+	return types.Deal{
+		Id:               1,
+		Owner:            providers[0],
+		ManifestRoot:     make([]byte, 48),
+		Size_:            size,
+		EscrowBalance:    math.ZeroInt(),
+		MaxMonthlySpend:  math.ZeroInt(),
+		SpendWindowSpent: math.ZeroInt(),
+		StartBlock:       0,
+		EndBlock:         1000,
+		Providers:        providers,
+		RedundancyMode:   1,
+		ServiceHint:      hint,
+	}
+}
+
+func TestGetChallengeSet_DeterministicAndEpochScoped(t *testing.T) {
+	provider := sdk.AccAddress([]byte("provider_chal_______")).String()
+	deal := livenessTestDeal([]string{provider}, "Hot", 8*types.MDU_SIZE)
+
+	query := func(f *fixture, ctx context.Context) *types.QueryGetChallengeSetResponse {
+		require.NoError(t, f.keeper.EnsureEpochSeed(ctx))
+		res, err := keeper.NewQueryServerImpl(f.keeper).GetChallengeSet(ctx, &types.QueryGetChallengeSetRequest{DealId: deal.Id, Provider: provider})
+		require.NoError(t, err)
+		return res
+	}
+
+	f1 := initFixture(t)
+	f2 := initFixture(t)
+	require.NoError(t, f1.keeper.Deals.Set(f1.ctx, deal.Id, deal))
+	require.NoError(t, f2.keeper.Deals.Set(f2.ctx, deal.Id, deal))
+	ctx1 := sdk.UnwrapSDKContext(f1.ctx).WithBlockHeight(3)
+	ctx2 := sdk.UnwrapSDKContext(f2.ctx).WithBlockHeight(3)
+
+	// 8 user MDUs at 100 bps of 64 MiB -> ceil(671089 / 128 KiB) = 6 blobs.
+	res1 := query(f1, ctx1)
+	require.Equal(t, uint64(0), res1.EpochId)
+	require.Equal(t, uint64(6), res1.QuotaBlobs)
+	require.Equal(t, uint64(6), res1.SyntheticNeeded)
+	require.Len(t, res1.Challenges, 6)
+	for _, c := range res1.Challenges {
+		require.Less(t, c.MduIndex, uint64(8))
+		require.Less(t, uint64(c.BlobIndex), types.BlobsPerMdu)
+		require.False(t, c.Satisfied)
+	}
+
+	// Same chain state and epoch on another node -> identical set.
+	require.Equal(t, res1, query(f2, ctx2))
+
+	// A later height in the same epoch reuses the recorded seed.
+	require.Equal(t, res1.Challenges, query(f1, ctx1.WithBlockHeight(9)).Challenges)
+
+	// The next epoch draws a fresh set.
+	next := query(f1, ctx1.WithBlockHeight(10).WithHeaderHash([]byte("next-epoch-block")))
+	require.Equal(t, uint64(1), next.EpochId)
+	require.Equal(t, uint64(10), next.EpochStartHeight)
+	require.NotEqual(t, res1.Challenges, next.Challenges)
+}
+
+func TestGetChallengeSet_CreditsReduceSyntheticDemand(t *testing.T) {
+	f := initFixture(t)
+	provider := sdk.AccAddress([]byte("provider_credit_____")).String()
+	deal := livenessTestDeal([]string{provider}, "Hot", 8*types.MDU_SIZE)
+	require.NoError(t, f.keeper.Deals.Set(f.ctx, deal.Id, deal))
+	require.NoError(t, f.keeper.EnsureEpochSeed(f.ctx))
+
+	// Organic credits are capped at credit_cap_bps (50%) of the 6-blob quota.
+	require.NoError(t, f.keeper.QuotaStates.Set(f.ctx, collections.Join3(uint64(0), deal.Id, provider), types.EpochQuotaState{CreditsBlobs: 100}))
+	res, err := keeper.NewQueryServerImpl(f.keeper).GetChallengeSet(f.ctx, &types.QueryGetChallengeSetRequest{DealId: deal.Id, Provider: provider})
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), res.QuotaBlobs)
+	require.Equal(t, uint64(3), res.CreditsBlobs)
+	require.Equal(t, uint64(3), res.SyntheticNeeded)
+	require.Len(t, res.Challenges, 3)
+}
+
+func TestGetChallengeSet_Mode2SlotMajor(t *testing.T) {
+	f := initFixture(t)
+
+	var providers []string
+	var slots []*types.DealSlot
+	for i := 0; i < 12; i++ {
+		addr := sdk.AccAddress([]byte(fmt.Sprintf("provider_mode2_chal%02d", i))).String()
+		providers = append(providers, addr)
+		slots = append(slots, &types.DealSlot{Slot: uint32(i), Provider: addr, Status: types.SlotStatus_SLOT_STATUS_ACTIVE})
+	}
+	slots[5].Status = types.SlotStatus_SLOT_STATUS_REPAIRING
+
+	deal := livenessTestDeal(providers, "General", 0)
+	deal.RedundancyMode = 2
+	deal.Mode2Profile = &types.StripeReplicaProfile{K: 8, M: 4}
+	deal.Mode2Slots = slots
+	deal.TotalMdus = 1 + 2 + 4 // manifest + 2 witness + 4 user MDUs
+	deal.WitnessMdus = 2
+	require.NoError(t, f.keeper.Deals.Set(f.ctx, deal.Id, deal))
+
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	_, err := qs.GetChallengeSet(f.ctx, &types.QueryGetChallengeSetRequest{DealId: deal.Id, Provider: providers[3]})
+	require.Error(t, err, "no epoch seed recorded yet")
+	require.NoError(t, f.keeper.EnsureEpochSeed(f.ctx))
+
+	res, err := qs.GetChallengeSet(f.ctx, &types.QueryGetChallengeSetRequest{DealId: deal.Id, Provider: providers[3]})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.QuotaBlobs)
+	require.Len(t, res.Challenges, 1)
+	c := res.Challenges[0]
+	require.GreaterOrEqual(t, c.MduIndex, uint64(3), "metadata MDUs are never challenged")
+	require.Less(t, c.MduIndex, uint64(7))
+	rows := uint32(64 / 8)
+	require.Equal(t, uint32(3), c.BlobIndex/rows, "leaf must fall in the provider's slot")
+
+	// Repairing slots are excluded from synthetic challenges.
+	res, err = qs.GetChallengeSet(f.ctx, &types.QueryGetChallengeSetRequest{DealId: deal.Id, Provider: providers[5]})
+	require.NoError(t, err)
+	require.Zero(t, res.SyntheticNeeded)
+	require.Empty(t, res.Challenges)
+}
+
+func TestEnsureEpochSeed_PrunesOldEpochs(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	require.NoError(t, f.keeper.EnsureEpochSeed(ctx.WithBlockHeight(0)))
+	require.NoError(t, f.keeper.QuotaStates.Set(ctx, collections.Join3(uint64(0), uint64(1), "p"), types.EpochQuotaState{CreditsBlobs: 1}))
+	require.NoError(t, f.keeper.CreditSeen.Set(ctx, collections.Join(uint64(0), []byte("credit"))))
+	require.NoError(t, f.keeper.EnsureEpochSeed(ctx.WithBlockHeight(10)))
+	require.NoError(t, f.keeper.EnsureEpochSeed(ctx.WithBlockHeight(15)))
+
+	has, err := f.keeper.EpochSeeds.Has(ctx, 0)
+	require.NoError(t, err)
+	require.True(t, has, "previous epoch is retained")
+
+	require.NoError(t, f.keeper.EnsureEpochSeed(ctx.WithBlockHeight(20)))
+	has, err = f.keeper.EpochSeeds.Has(ctx, 0)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.QuotaStates.Has(ctx, collections.Join3(uint64(0), uint64(1), "p"))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.CreditSeen.Has(ctx, collections.Join(uint64(0), []byte("credit")))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.EpochSeeds.Has(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func TestProveLiveness_RejectsUnchallengedPosition(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	provider := sdk.AccAddress([]byte("provider_unchal_____")).String()
+	deal := livenessTestDeal([]string{provider}, "General", types.MDU_SIZE)
+	require.NoError(t, f.keeper.Deals.Set(f.ctx, deal.Id, deal))
+
+	chal := currentChallenge(t, f, f.ctx, deal.Id, provider)
+	blob := (chal.BlobIndex + 1) % uint32(types.BlobsPerMdu)
+
+	_, err := msgServer.ProveLiveness(f.ctx, &types.MsgProveLiveness{
+		Creator: provider,
+		DealId:  deal.Id,
+		ProofType: &types.MsgProveLiveness_SystemProof{
+			SystemProof: &types.ChainedProof{MduIndex: chal.MduIndex, BlobIndex: blob},
+		},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not challenged")
+
+	// The challenged position passes the membership check and reaches proof
+	// verification, which fails for this empty proof.
+	res, err := msgServer.ProveLiveness(f.ctx, &types.MsgProveLiveness{
+		Creator: provider,
+		DealId:  deal.Id,
+		ProofType: &types.MsgProveLiveness_SystemProof{
+			SystemProof: &types.ChainedProof{MduIndex: chal.MduIndex, BlobIndex: chal.BlobIndex},
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, res.Success)
+}
@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nilchain/x/nilchain/types"
+)
+
+// GrantDealAccess handles MsgGrantDealAccess.
+func (k msgServer) GrantDealAccess(goCtx context.Context, msg *types.MsgGrantDealAccess) (*types.MsgGrantDealAccessResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+
+	deal, err := k.Deals.Get(ctx, msg.DealId)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("deal %d not found", msg.DealId)
+	}
+	if err := checkDealActive(deal); err != nil {
+		return nil, err
+	}
+	if deal.Owner != msg.Creator {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("only deal owner %s can grant access", deal.Owner)
+	}
+	if grantee.String() == deal.Owner {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("deal owner already has access")
+	}
+	if msg.ExpiresAt != 0 && msg.ExpiresAt <= uint64(ctx.BlockHeight()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("expires_at must be in the future")
+	}
+	maxFee := msg.MaxFee
+	if maxFee.IsNil() {
+		maxFee = math.ZeroInt()
+	}
+	if maxFee.IsNegative() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("max_fee must not be negative")
+	}
+
+	grant := types.DealAccessGrant{
+		DealId:        deal.Id,
+		Grantee:       grantee.String(),
+		ExpiresAt:     msg.ExpiresAt,
+		MaxBytes:      msg.MaxBytes,
+		MaxFee:        maxFee,
+		FeesUsed:      math.ZeroInt(),
+		GrantedHeight: ctx.BlockHeight(),
+	}
+	if err := k.DealAccessGrants.Set(ctx, collections.Join(deal.Id, grant.Grantee), grant); err != nil {
+		return nil, fmt.Errorf("failed to store access grant: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeDealAccessGranted,
+			sdk.NewAttribute(types.AttributeKeyDealID, fmt.Sprintf("%d", deal.Id)),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grant.Grantee),
+			sdk.NewAttribute(types.AttributeKeyExpiresAt, fmt.Sprintf("%d", grant.ExpiresAt)),
+			sdk.NewAttribute(types.AttributeKeyMaxBytes, fmt.Sprintf("%d", grant.MaxBytes)),
+			sdk.NewAttribute(types.AttributeKeyMaxFee, grant.MaxFee.String()),
+		),
+	)
+
+	return &types.MsgGrantDealAccessResponse{}, nil
+}
+
+// RevokeDealAccess handles MsgRevokeDealAccess. Either the owner or the
+// grantee may remove a grant. Sessions already opened under it are unaffected.
+func (k msgServer) RevokeDealAccess(goCtx context.Context, msg *types.MsgRevokeDealAccess) (*types.MsgRevokeDealAccessResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	deal, err := k.Deals.Get(ctx, msg.DealId)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("deal %d not found", msg.DealId)
+	}
+	if msg.Creator != deal.Owner && msg.Creator != msg.Grantee {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("only the deal owner or the grantee can revoke access")
+	}
+
+	key := collections.Join(msg.DealId, msg.Grantee)
+	has, err := k.DealAccessGrants.Has(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load access grant: %w", err)
+	}
+	if !has {
+		return nil, sdkerrors.ErrNotFound.Wrapf("no access grant for %s on deal %d", msg.Grantee, msg.DealId)
+	}
+	if err := k.DealAccessGrants.Remove(ctx, key); err != nil {
+		return nil, fmt.Errorf("failed to remove access grant: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeDealAccessRevoked,
+			sdk.NewAttribute(types.AttributeKeyDealID, fmt.Sprintf("%d", msg.DealId)),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+		),
+	)
+
+	return &types.MsgRevokeDealAccessResponse{}, nil
+}
+
+// dealAccessGrantActive reports whether a grant can still be used at height:
+// it has not expired and neither its byte nor its fee limit is exhausted.
+func dealAccessGrantActive(grant types.DealAccessGrant, height int64) bool {
+	if grant.ExpiresAt != 0 && uint64(height) > grant.ExpiresAt {
+		return false
+	}
+	if grant.MaxBytes != 0 && grant.BytesUsed >= grant.MaxBytes {
+		return false
+	}
+	if !grant.MaxFee.IsNil() && grant.MaxFee.IsPositive() && !grant.FeesUsed.IsNil() && grant.FeesUsed.GTE(grant.MaxFee) {
+		return false
+	}
+	return true
+}
+
+// dealReaderGrant checks that addr may read the deal. The owner always may
+// and gets a nil grant; anyone else needs an unexpired grant.
+func (k Keeper) dealReaderGrant(ctx sdk.Context, deal types.Deal, addr string) (*types.DealAccessGrant, error) {
+	if addr == deal.Owner {
+		return nil, nil
+	}
+
+	grant, err := k.DealAccessGrants.Get(ctx, collections.Join(deal.Id, addr))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is neither the owner of deal %d nor an access grantee", addr, deal.Id)
+		}
+		return nil, fmt.Errorf("failed to load access grant: %w", err)
+	}
+	if grant.ExpiresAt != 0 && uint64(ctx.BlockHeight()) > grant.ExpiresAt {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("access grant for %s on deal %d has expired", addr, deal.Id)
+	}
+	return &grant, nil
+}
+
+// chargeDealAccessGrant records bytes read and fees drawn under a grant,
+// failing if either would exceed the grant's limits. A nil grant (the owner)
+// is not metered.
+func (k Keeper) chargeDealAccessGrant(ctx sdk.Context, grant *types.DealAccessGrant, bytes uint64, fee math.Int) error {
+	if grant == nil {
+		return nil
+	}
+
+	usedBytes, overflow := addUint64(grant.BytesUsed, bytes)
+	if overflow || (grant.MaxBytes != 0 && usedBytes > grant.MaxBytes) {
+		return sdkerrors.ErrUnauthorized.Wrapf("access grant for %s on deal %d exceeds its byte limit", grant.Grantee, grant.DealId)
+	}
+	usedFees := grant.FeesUsed
+	if usedFees.IsNil() {
+		usedFees = math.ZeroInt()
+	}
+	if !fee.IsNil() && fee.IsPositive() {
+		usedFees = usedFees.Add(fee)
+	}
+	if !grant.MaxFee.IsNil() && grant.MaxFee.IsPositive() && usedFees.GT(grant.MaxFee) {
+		return sdkerrors.ErrUnauthorized.Wrapf("access grant for %s on deal %d exceeds its fee limit", grant.Grantee, grant.DealId)
+	}
+
+	grant.BytesUsed = usedBytes
+	grant.FeesUsed = usedFees
+	if err := k.DealAccessGrants.Set(ctx, collections.Join(grant.DealId, grant.Grantee), *grant); err != nil {
+		return fmt.Errorf("failed to update access grant: %w", err)
+	}
+	return nil
+}
+
+// removeDealAccessGrants drops every grant on a deal.
+func (k Keeper) removeDealAccessGrants(ctx sdk.Context, dealID uint64) error {
+	var grantees []string
+	rng := collections.NewPrefixedPairRange[uint64, string](dealID)
+	if err := k.DealAccessGrants.Walk(ctx, rng, func(key collections.Pair[uint64, string], _ types.DealAccessGrant) (bool, error) {
+		grantees = append(grantees, key.K2())
+		return false, nil
+	}); err != nil {
+		return fmt.Errorf("failed to walk access grants: %w", err)
+	}
+	for _, grantee := range grantees {
+		if err := k.DealAccessGrants.Remove(ctx, collections.Join(dealID, grantee)); err != nil {
+			return fmt.Errorf("failed to remove access grant: %w", err)
+		}
+	}
+	return nil
+}
+
+// dealRetrievalSessionIDs lists every retrieval session opened on a deal.
+func (k Keeper) dealRetrievalSessionIDs(ctx sdk.Context, dealID uint64) ([][]byte, error) {
+	var ids [][]byte
+	rng := collections.NewPrefixedPairRange[uint64, []byte](dealID)
+	if err := k.RetrievalSessionsByDeal.Walk(ctx, rng, func(key collections.Pair[uint64, []byte]) (bool, error) {
+		ids = append(ids, key.K2())
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to walk retrieval sessions: %w", err)
+	}
+	return ids, nil
+}
+
+// indexDealRetrievalSessions adds every stored session to
+// RetrievalSessionsByDeal, so sessions opened before the index existed are
+// settled when their deal closes, expires or changes owner.
+func (k Keeper) indexDealRetrievalSessions(ctx context.Context) error {
+	return k.RetrievalSessions.Walk(ctx, nil, func(id []byte, session types.RetrievalSession) (bool, error) {
+		if err := k.RetrievalSessionsByDeal.Set(ctx, collections.Join(session.DealId, id)); err != nil {
+			return true, fmt.Errorf("failed to index retrieval session by deal: %w", err)
+		}
+		return false, nil
+	})
+}
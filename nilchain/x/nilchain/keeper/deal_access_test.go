@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+func TestDealAccessGrant(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+
+	owner := sdk.AccAddress([]byte("access_owner________")).String()
+	reader := sdk.AccAddress([]byte("access_reader_______")).String()
+	stranger := sdk.AccAddress([]byte("access_stranger_____")).String()
+	ctx, deal := setupExpiringDeal(t, bank, f, sdk.MustAccAddressFromBech32(owner), 40, 1000)
+
+	params := types.DefaultParams()
+	params.BaseRetrievalFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 2)
+	params.RetrievalPricePerBlob = sdk.NewInt64Coin(sdk.DefaultBondDenom, 3)
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	prepareRetrievalDeal(t, f, ctx, &deal)
+
+	open := func(creator string, blobs uint64, nonce uint64) (*types.MsgOpenRetrievalSessionResponse, error) {
+		return msgServer.OpenRetrievalSession(ctx, &types.MsgOpenRetrievalSession{
+			Creator:      creator,
+			DealId:       deal.Id,
+			Provider:     deal.Providers[0],
+			ManifestRoot: deal.ManifestRoot,
+			BlobCount:    blobs,
+			Nonce:        nonce,
+		})
+	}
+
+	_, err := open(reader, 1, 1)
+	require.ErrorContains(t, err, "nor an access grantee")
+
+	_, err = msgServer.GrantDealAccess(ctx, &types.MsgGrantDealAccess{Creator: stranger, DealId: deal.Id, Grantee: reader})
+	require.ErrorContains(t, err, "only deal owner")
+	_, err = msgServer.GrantDealAccess(ctx, &types.MsgGrantDealAccess{Creator: owner, DealId: deal.Id, Grantee: owner})
+	require.ErrorContains(t, err, "already has access")
+	_, err = msgServer.GrantDealAccess(ctx, &types.MsgGrantDealAccess{Creator: owner, DealId: deal.Id, Grantee: reader, ExpiresAt: 10})
+	require.ErrorContains(t, err, "in the future")
+
+	// Two blobs cost 2 + 2*3 = 8 and one blob costs 5, so a 13 fee limit fits
+	// one of each.
+	_, err = msgServer.GrantDealAccess(ctx, &types.MsgGrantDealAccess{
+		Creator: owner, DealId: deal.Id, Grantee: reader, ExpiresAt: 20, MaxFee: math.NewInt(13),
+	})
+	require.NoError(t, err)
+
+	res, err := open(reader, 2, 1)
+	require.NoError(t, err)
+	session, err := f.keeper.RetrievalSessions.Get(ctx, res.SessionId)
+	require.NoError(t, err)
+	require.Equal(t, reader, session.Owner)
+	has, err := f.keeper.RetrievalSessionsByDeal.Has(ctx, collections.Join(deal.Id, res.SessionId))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	grant, err := queryServer.GetDealAccessGrant(ctx, &types.QueryGetDealAccessGrantRequest{DealId: deal.Id, Grantee: reader})
+	require.NoError(t, err)
+	require.True(t, grant.Active)
+	require.Equal(t, math.NewInt(8), grant.Grant.FeesUsed)
+	require.Equal(t, 2*types.BlobSizeBytes, grant.Grant.BytesUsed)
+
+	_, err = open(reader, 2, 2)
+	require.ErrorContains(t, err, "fee limit")
+	_, err = open(reader, 1, 2)
+	require.NoError(t, err)
+
+	// The grant lapses after expires_at.
+	_, err = msgServer.OpenRetrievalSession(ctx.WithBlockHeight(21), &types.MsgOpenRetrievalSession{
+		Creator: reader, DealId: deal.Id, Provider: deal.Providers[0], ManifestRoot: deal.ManifestRoot, BlobCount: 1, Nonce: 3,
+	})
+	require.ErrorContains(t, err, "expired")
+	grant, err = queryServer.GetDealAccessGrant(ctx.WithBlockHeight(21), &types.QueryGetDealAccessGrantRequest{DealId: deal.Id, Grantee: reader})
+	require.NoError(t, err)
+	require.False(t, grant.Active)
+
+	list, err := queryServer.ListDealAccessGrants(ctx, &types.QueryListDealAccessGrantsRequest{DealId: deal.Id})
+	require.NoError(t, err)
+	require.Len(t, list.Grants, 1)
+
+	_, err = msgServer.RevokeDealAccess(ctx, &types.MsgRevokeDealAccess{Creator: stranger, DealId: deal.Id, Grantee: reader})
+	require.ErrorContains(t, err, "only the deal owner or the grantee")
+	_, err = msgServer.RevokeDealAccess(ctx, &types.MsgRevokeDealAccess{Creator: reader, DealId: deal.Id, Grantee: reader})
+	require.NoError(t, err)
+	_, err = msgServer.RevokeDealAccess(ctx, &types.MsgRevokeDealAccess{Creator: owner, DealId: deal.Id, Grantee: reader})
+	require.ErrorContains(t, err, "no access grant")
+	_, err = open(reader, 1, 4)
+	require.ErrorContains(t, err, "nor an access grantee")
+
+	// Closing the deal settles the grantee's sessions and drops any grants.
+	_, err = msgServer.GrantDealAccess(ctx, &types.MsgGrantDealAccess{Creator: owner, DealId: deal.Id, Grantee: stranger})
+	require.NoError(t, err)
+	closed, err := msgServer.CloseDeal(ctx, &types.MsgCloseDeal{Creator: owner, DealId: deal.Id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), closed.SessionsSettled)
+	_, err = queryServer.GetDealAccessGrant(ctx, &types.QueryGetDealAccessGrantRequest{DealId: deal.Id, Grantee: stranger})
+	require.Error(t, err)
+}
+
+func TestDealAccessGrantDroppedOnTransfer(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+
+	owner := sdk.AccAddress([]byte("access_xfer_owner___")).String()
+	buyer := sdk.AccAddress([]byte("access_xfer_buyer___")).String()
+	reader := sdk.AccAddress([]byte("access_xfer_reader__")).String()
+	ctx, deal := setupExpiringDeal(t, bank, f, sdk.MustAccAddressFromBech32(owner), 40, 1000)
+
+	_, err := msgServer.GrantDealAccess(ctx, &types.MsgGrantDealAccess{Creator: owner, DealId: deal.Id, Grantee: reader})
+	require.NoError(t, err)
+	ownerSession := lockRetrievalSession(t, f, ctx, &deal, 0xa1, deal.Providers[0], types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_OPEN, 10)
+
+	// A grantee-opened session stays with the grantee across a transfer.
+	readerSession := make([]byte, 32)
+	readerSession[0] = 0xa2
+	require.NoError(t, f.keeper.RetrievalSessions.Set(ctx, readerSession, types.RetrievalSession{
+		SessionId: readerSession, DealId: deal.Id, Owner: reader, Provider: deal.Providers[1],
+		Status: types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_OPEN, LockedFee: math.ZeroInt(),
+	}))
+	require.NoError(t, f.keeper.RetrievalSessionsByOwner.Set(ctx, collections.Join(reader, readerSession), uint64(ctx.BlockHeight())))
+	require.NoError(t, f.keeper.RetrievalSessionsByDeal.Set(ctx, collections.Join(deal.Id, readerSession)))
+
+	_, err = msgServer.TransferDealOwnership(ctx, &types.MsgTransferDealOwnership{Creator: owner, DealId: deal.Id, NewOwner: buyer})
+	require.NoError(t, err)
+	accepted, err := msgServer.AcceptDealOwnership(ctx, &types.MsgAcceptDealOwnership{Creator: buyer, DealId: deal.Id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), accepted.SessionsMoved)
+
+	session, err := f.keeper.RetrievalSessions.Get(ctx, ownerSession)
+	require.NoError(t, err)
+	require.Equal(t, buyer, session.Owner)
+	session, err = f.keeper.RetrievalSessions.Get(ctx, readerSession)
+	require.NoError(t, err)
+	require.Equal(t, reader, session.Owner)
+
+	has, err := f.keeper.DealAccessGrants.Has(ctx, collections.Join(deal.Id, reader))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestDealAccessGrantCapsReceiptFees(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+
+	owner := sdk.AccAddress([]byte("access_fee_owner____")).String()
+	ctx, deal := setupExpiringDeal(t, bank, f, sdk.MustAccAddressFromBech32(owner), 40, 1000)
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	privKey, err := gethCrypto.GenerateKey()
+	require.NoError(t, err)
+	reader := sdk.AccAddress(gethCrypto.PubkeyToAddress(privKey.PublicKey).Bytes()).String()
+
+	// A 2 KiB receipt pays the provider 2 units of bandwidth from escrow,
+	// one more than the grant allows.
+	_, err = msgServer.GrantDealAccess(ctx, &types.MsgGrantDealAccess{
+		Creator: owner, DealId: deal.Id, Grantee: reader, MaxFee: math.NewInt(1),
+	})
+	require.NoError(t, err)
+
+	receipt := types.DownloadSessionReceipt{
+		DealId:        deal.Id,
+		EpochId:       1,
+		Provider:      deal.Providers[0],
+		FilePath:      "file.txt",
+		TotalBytes:    2048,
+		ChunkCount:    2,
+		ChunkLeafRoot: make([]byte, 32),
+		Nonce:         1,
+	}
+	receipt.UserSignature = signDownloadSessionReceipt(t, &receipt, params.Eip712ChainId, privKey)
+	_, err = msgServer.ProveLiveness(ctx, &types.MsgProveLiveness{
+		Creator: deal.Providers[0],
+		DealId:  deal.Id,
+		EpochId: 1,
+		ProofType: &types.MsgProveLiveness_SessionProof{
+			SessionProof: &types.RetrievalSessionProof{SessionReceipt: receipt},
+		},
+	})
+	require.ErrorContains(t, err, "fee limit")
+
+	grant, err := f.keeper.DealAccessGrants.Get(ctx, collections.Join(deal.Id, reader))
+	require.NoError(t, err)
+	require.True(t, grant.FeesUsed.IsNil() || grant.FeesUsed.IsZero())
+}
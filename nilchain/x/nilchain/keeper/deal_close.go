@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nilchain/x/nilchain/types"
+)
+
+// CloseDeal handles MsgCloseDeal.
+func (k msgServer) CloseDeal(goCtx context.Context, msg *types.MsgCloseDeal) (*types.MsgCloseDealResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	deal, err := k.Deals.Get(ctx, msg.DealId)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("deal %d not found", msg.DealId)
+	}
+	if deal.Owner != msg.Creator {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("only deal owner %s can close the deal", deal.Owner)
+	}
+
+	return k.closeDeal(ctx, deal)
+}
+
+// CloseDealFromEvm allows a user to close a deal using an EVM-signed intent.
+func (k msgServer) CloseDealFromEvm(goCtx context.Context, msg *types.MsgCloseDealFromEvm) (*types.MsgCloseDealFromEvmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Intent == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("intent is required")
+	}
+	intent := msg.Intent
+
+	structHash, err := types.HashCloseDeal(intent)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to hash intent: %s", err)
+	}
+	ownerAcc, err := k.verifyEvmIntent(ctx, structHash, intent.CreatorEvm, intent.Nonce, intent.ChainId, msg.EvmSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	deal, err := k.Deals.Get(ctx, intent.DealId)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("deal %d not found", intent.DealId)
+	}
+	if deal.Owner != ownerAcc.String() {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("only deal owner can close the deal")
+	}
+
+	res, err := k.closeDeal(ctx, deal)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgCloseDealFromEvmResponse{
+		ProviderPayout:  res.ProviderPayout,
+		EscrowRefund:    res.EscrowRefund,
+		SessionsSettled: res.SessionsSettled,
+	}, nil
+}
+
+// closeDeal settles and tombstones an active deal. Providers are paid
+// storage_price * size for every block served (capped by the escrow) in equal
+// shares; whatever is left is refunded to the owner.
+func (k Keeper) closeDeal(ctx sdk.Context, deal types.Deal) (*types.MsgCloseDealResponse, error) {
+	if err := checkDealActive(deal); err != nil {
+		return nil, err
+	}
+
+	settled, err := k.settleDealRetrievalSessions(ctx, &deal)
+	if err != nil {
+		return nil, err
+	}
+
+	payout, err := k.payProvidersForTimeServed(ctx, &deal)
+	if err != nil {
+		return nil, err
+	}
+
+	refund, err := k.endDeal(ctx, &deal, types.DealStatus_DEAL_STATUS_CLOSED)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeDealClosed,
+			sdk.NewAttribute(types.AttributeKeyDealID, fmt.Sprintf("%d", deal.Id)),
+			sdk.NewAttribute(types.AttributeKeyOwner, deal.Owner),
+			sdk.NewAttribute(types.AttributeKeyProviderPayout, payout.String()),
+			sdk.NewAttribute(types.AttributeKeyEscrowRefund, refund.String()),
+			sdk.NewAttribute(types.AttributeKeySessionsSettled, fmt.Sprintf("%d", settled)),
+			sdk.NewAttribute(types.AttributeKeyAssignedProviders, fmt.Sprintf("%v", deal.Providers)),
+		),
+	)
+
+	return &types.MsgCloseDealResponse{
+		ProviderPayout:  payout,
+		EscrowRefund:    refund,
+		SessionsSettled: settled,
+	}, nil
+}
+
+// payProvidersForTimeServed pays the deal's providers out of its escrow for
+// the blocks elapsed since StartBlock and returns the total paid. Any
+// remainder of an uneven split stays in escrow.
+func (k Keeper) payProvidersForTimeServed(ctx sdk.Context, deal *types.Deal) (math.Int, error) {
+	price := k.GetParams(ctx).StoragePrice
+	if len(deal.Providers) == 0 || deal.Size_ == 0 || !price.IsPositive() ||
+		deal.EscrowBalance.IsNil() || !deal.EscrowBalance.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+
+	served := uint64(ctx.BlockHeight())
+	if served > deal.EndBlock {
+		served = deal.EndBlock
+	}
+	if served <= deal.StartBlock {
+		return math.ZeroInt(), nil
+	}
+	served -= deal.StartBlock
+
+	earned := price.MulInt(math.NewIntFromUint64(deal.Size_)).MulInt(math.NewIntFromUint64(served)).Ceil().TruncateInt()
+	if earned.GT(deal.EscrowBalance) {
+		earned = deal.EscrowBalance
+	}
+	share := earned.QuoRaw(int64(len(deal.Providers)))
+	if !share.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, share))
+	for _, provider := range deal.Providers {
+		providerAddr, err := sdk.AccAddressFromBech32(provider)
+		if err != nil {
+			return math.Int{}, fmt.Errorf("invalid provider address on deal %d: %w", deal.Id, err)
+		}
+		if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, providerAddr, coins); err != nil {
+			return math.Int{}, fmt.Errorf("failed to pay provider %s: %w", provider, err)
+		}
+	}
+
+	paid := share.MulRaw(int64(len(deal.Providers)))
+	deal.EscrowBalance = deal.EscrowBalance.Sub(paid)
+	return paid, nil
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+// lockRetrievalSession stores a session against the deal as if it had been
+// opened with the given locked fee.
+func lockRetrievalSession(t *testing.T, f *fixture, ctx sdk.Context, deal *types.Deal, fill byte, provider string, status types.RetrievalSessionStatus, fee int64) []byte {
+	t.Helper()
+	id := bytes.Repeat([]byte{fill}, 32)
+	require.NoError(t, f.keeper.RetrievalSessions.Set(ctx, id, types.RetrievalSession{
+		SessionId: id,
+		DealId:    deal.Id,
+		Owner:     deal.Owner,
+		Provider:  provider,
+		Status:    status,
+		LockedFee: math.NewInt(fee),
+	}))
+	require.NoError(t, f.keeper.RetrievalSessionsByOwner.Set(ctx, collections.Join(deal.Owner, id), uint64(ctx.BlockHeight())))
+	require.NoError(t, f.keeper.RetrievalSessionsByDeal.Set(ctx, collections.Join(deal.Id, id)))
+	deal.EscrowBalance = deal.EscrowBalance.SubRaw(fee)
+	require.NoError(t, f.keeper.Deals.Set(ctx, deal.Id, *deal))
+	return id
+}
+
+func TestCloseDeal_SettlesAndRefunds(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+
+	owner := sdk.AccAddress([]byte("close_owner_________"))
+	ctx, deal := setupExpiringDeal(t, bank, f, owner, 40, 1000)
+
+	params := types.DefaultParams()
+	params.StoragePrice = math.LegacyMustNewDecFromStr("0.06")
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	open := lockRetrievalSession(t, f, ctx, &deal, 0xaa, deal.Providers[1], types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_OPEN, 50)
+	proven := lockRetrievalSession(t, f, ctx, &deal, 0xbb, deal.Providers[0], types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_PROOF_SUBMITTED, 40)
+
+	other := sdk.AccAddress([]byte("close_stranger______")).String()
+	_, err := msgServer.CloseDeal(ctx, &types.MsgCloseDeal{Creator: other, DealId: deal.Id})
+	require.ErrorContains(t, err, "only deal owner")
+
+	// 20 of 40 blocks served: 0.06 * 100 bytes * 20 = 120, split 10 per provider.
+	closeCtx := ctx.WithBlockHeight(30).WithEventManager(sdk.NewEventManager())
+	res, err := msgServer.CloseDeal(closeCtx, &types.MsgCloseDeal{Creator: owner.String(), DealId: deal.Id})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(120), res.ProviderPayout)
+	require.Equal(t, uint64(2), res.SessionsSettled)
+	// 1000 - 90 locked + 50 returned by the open session - 120 paid.
+	require.Equal(t, math.NewInt(840), res.EscrowRefund)
+	require.Equal(t, math.NewInt(9000+840), bank.accountBalances[owner.String()].AmountOf(sdk.DefaultBondDenom))
+
+	// The proven session pays its provider the locked fee minus the 5% burn.
+	provider0, err := sdk.AccAddressFromBech32(deal.Providers[0])
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(10+38), bank.accountBalances[provider0.String()].AmountOf(sdk.DefaultBondDenom))
+
+	session, err := f.keeper.RetrievalSessions.Get(ctx, open)
+	require.NoError(t, err)
+	require.Equal(t, types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_CANCELED, session.Status)
+	session, err = f.keeper.RetrievalSessions.Get(ctx, proven)
+	require.NoError(t, err)
+	require.Equal(t, types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_COMPLETED, session.Status)
+
+	stored, err := f.keeper.Deals.Get(ctx, deal.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.DealStatus_DEAL_STATUS_CLOSED, stored.Status)
+	require.True(t, stored.EscrowBalance.IsZero())
+	for _, addr := range deal.Providers {
+		provider, err := f.keeper.Providers.Get(ctx, addr)
+		require.NoError(t, err)
+		require.Zero(t, provider.UsedStorage)
+	}
+	has, err := f.keeper.DealExpiryQueue.Has(ctx, collections.Join(deal.EndBlock, deal.Id))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	var found bool
+	for _, ev := range closeCtx.EventManager().Events() {
+		if ev.Type == types.TypeDealClosed {
+			found = true
+		}
+	}
+	require.True(t, found)
+
+	_, err = msgServer.CloseDeal(ctx, &types.MsgCloseDeal{Creator: owner.String(), DealId: deal.Id})
+	require.ErrorContains(t, err, "is closed")
+	_, err = msgServer.ExtendDeal(ctx, &types.MsgExtendDeal{Creator: owner.String(), DealId: deal.Id, AdditionalBlocks: 1})
+	require.ErrorContains(t, err, "is closed")
+}
+
+func TestCloseDealFromEvm(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+
+	privKey, err := gethCrypto.GenerateKey()
+	require.NoError(t, err)
+	evmAddr := gethCrypto.PubkeyToAddress(privKey.PublicKey)
+	owner := sdk.AccAddress(evmAddr.Bytes())
+
+	ctx, deal := setupExpiringDeal(t, bank, f, owner, 40, 1000)
+	relayer := sdk.AccAddress([]byte("close_relayer_______")).String()
+
+	sign := func(intent *types.EvmCloseDealIntent) []byte {
+		structHash, err := types.HashCloseDeal(intent)
+		require.NoError(t, err)
+		digest := types.ComputeEIP712Digest(types.HashDomainSeparator(eip712DevChainID), structHash)
+		sig, err := gethCrypto.Sign(digest, privKey)
+		require.NoError(t, err)
+		return sig
+	}
+
+	intent := &types.EvmCloseDealIntent{CreatorEvm: evmAddr.Hex(), DealId: deal.Id, Nonce: 1, ChainId: ctx.ChainID()}
+
+	// A signature over a different intent does not authorize the close.
+	forged := *intent
+	forged.DealId = deal.Id + 1
+	_, err = msgServer.CloseDealFromEvm(ctx, &types.MsgCloseDealFromEvm{Sender: relayer, Intent: intent, EvmSignature: sign(&forged)})
+	require.ErrorContains(t, err, "signature does not match")
+
+	res, err := msgServer.CloseDealFromEvm(ctx, &types.MsgCloseDealFromEvm{Sender: relayer, Intent: intent, EvmSignature: sign(intent)})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1000), res.EscrowRefund)
+	require.Equal(t, math.NewInt(10_000), bank.accountBalances[owner.String()].AmountOf(sdk.DefaultBondDenom))
+
+	stored, err := f.keeper.Deals.Get(ctx, deal.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.DealStatus_DEAL_STATUS_CLOSED, stored.Status)
+
+	// Replays are rejected by the bridge nonce.
+	_, err = msgServer.CloseDealFromEvm(ctx, &types.MsgCloseDealFromEvm{Sender: relayer, Intent: intent, EvmSignature: sign(intent)})
+	require.ErrorContains(t, err, "nonce")
+}
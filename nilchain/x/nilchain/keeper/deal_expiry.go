@@ -0,0 +1,311 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nilchain/x/nilchain/types"
+)
+
+// checkDealActive rejects operations on deals that have already ended.
+func checkDealActive(deal types.Deal) error {
+	switch deal.Status {
+	case types.DealStatus_DEAL_STATUS_EXPIRED:
+		return sdkerrors.ErrInvalidRequest.Wrapf("deal %d has expired", deal.Id)
+	case types.DealStatus_DEAL_STATUS_CLOSED:
+		return sdkerrors.ErrInvalidRequest.Wrapf("deal %d is closed", deal.Id)
+	}
+	return nil
+}
+
+// scheduleDealExpiry queues a deal for ExpireDeals once its end block passes.
+func (k Keeper) scheduleDealExpiry(ctx context.Context, dealID uint64, endBlock uint64) error {
+	if err := k.DealExpiryQueue.Set(ctx, collections.Join(endBlock, dealID)); err != nil {
+		return fmt.Errorf("failed to queue deal expiry: %w", err)
+	}
+	return nil
+}
+
+// ExpireDeals ends every deal whose end block is below the current height:
+// providers are paid for the full term as on close, the rest of the escrow
+// is refunded to the owner, provider storage and proof obligations are
+// released, and the deal is marked expired so gateways and
+// providers can garbage-collect its data. Like CheckMissedProofs it only
+// visits due queue entries.
+func (k Keeper) ExpireDeals(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+
+	due, err := k.dueDealExpiries(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range due {
+		if err := k.DealExpiryQueue.Remove(ctx, entry); err != nil {
+			return err
+		}
+		deal, err := k.Deals.Get(ctx, entry.K2())
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+		// Extended deals leave a stale entry behind; only the entry matching
+		// the current end block expires the deal.
+		if deal.EndBlock != entry.K1() || checkDealActive(deal) != nil {
+			continue
+		}
+		if err := k.expireDeal(sdkCtx, deal); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// seedDealExpiries queues every active deal at its end block, so deals
+// created before the expiry queue existed still expire.
+func (k Keeper) seedDealExpiries(ctx context.Context) error {
+	return k.Deals.Walk(ctx, nil, func(dealID uint64, deal types.Deal) (bool, error) {
+		if checkDealActive(deal) != nil {
+			return false, nil
+		}
+		return false, k.scheduleDealExpiry(ctx, dealID, deal.EndBlock)
+	})
+}
+
+// dueDealExpiries returns the queue entries whose end block is below height,
+// stopping at the first deal that is still active.
+func (k Keeper) dueDealExpiries(ctx context.Context, height uint64) ([]collections.Pair[uint64, uint64], error) {
+	iter, err := k.DealExpiryQueue.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var due []collections.Pair[uint64, uint64]
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		// A deal is live through its end block (see CheckMissedProofs).
+		if key.K1() >= height {
+			break
+		}
+		due = append(due, key)
+	}
+	return due, nil
+}
+
+func (k Keeper) expireDeal(ctx sdk.Context, deal types.Deal) error {
+	settled, err := k.settleDealRetrievalSessions(ctx, &deal)
+	if err != nil {
+		return err
+	}
+	payout, err := k.payProvidersForTimeServed(ctx, &deal)
+	if err != nil {
+		return err
+	}
+	refund, err := k.endDeal(ctx, &deal, types.DealStatus_DEAL_STATUS_EXPIRED)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeDealExpired,
+			sdk.NewAttribute(types.AttributeKeyDealID, fmt.Sprintf("%d", deal.Id)),
+			sdk.NewAttribute(types.AttributeKeyOwner, deal.Owner),
+			sdk.NewAttribute(types.AttributeKeyEndBlock, fmt.Sprintf("%d", deal.EndBlock)),
+			sdk.NewAttribute(types.AttributeKeyProviderPayout, payout.String()),
+			sdk.NewAttribute(types.AttributeKeyEscrowRefund, refund.String()),
+			sdk.NewAttribute(types.AttributeKeySessionsSettled, fmt.Sprintf("%d", settled)),
+			sdk.NewAttribute(types.AttributeKeyAssignedProviders, fmt.Sprintf("%v", deal.Providers)),
+		),
+	)
+	return nil
+}
+
+// endDeal refunds a deal's remaining escrow to its owner, releases provider
+// storage and proof obligations, and persists the deal with its final status.
+// The provider list is kept so gateways and providers can garbage-collect.
+func (k Keeper) endDeal(ctx sdk.Context, deal *types.Deal, status types.DealStatus) (math.Int, error) {
+	refund := deal.EscrowBalance
+	if !refund.IsNil() && refund.IsPositive() {
+		ownerAddr, err := sdk.AccAddressFromBech32(deal.Owner)
+		if err != nil {
+			return math.Int{}, fmt.Errorf("invalid owner address on deal %d: %w", deal.Id, err)
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, refund))
+		if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, ownerAddr, coins); err != nil {
+			return math.Int{}, fmt.Errorf("failed to refund escrow for deal %d: %w", deal.Id, err)
+		}
+	} else {
+		refund = math.ZeroInt()
+	}
+
+	footprint := DealStorageFootprint(*deal)
+	for _, provider := range deal.Providers {
+		if err := k.RemoveProofDeadline(ctx, deal.Id, provider); err != nil {
+			return math.Int{}, err
+		}
+		if err := k.releaseProviderStorage(ctx, provider, footprint); err != nil {
+			return math.Int{}, err
+		}
+	}
+	for i, slot := range deal.Mode2Slots {
+		if slot != nil && slot.PendingProvider != "" {
+			if err := k.releaseProviderReservation(ctx, slot.PendingProvider, footprint); err != nil {
+				return math.Int{}, err
+			}
+			// An unfinished repair's bounty is forfeited.
+			if _, err := k.settleRepairBounty(ctx, slot, false); err != nil {
+				return math.Int{}, err
+			}
+			if err := k.SlotRepairQueue.Remove(ctx, collections.Join3(slot.RepairDeadlineHeight, deal.Id, uint32(i))); err != nil {
+				return math.Int{}, fmt.Errorf("failed to dequeue slot repair: %w", err)
+			}
+		}
+	}
+	if err := k.DealExpiryQueue.Remove(ctx, collections.Join(deal.EndBlock, deal.Id)); err != nil {
+		return math.Int{}, fmt.Errorf("failed to dequeue deal expiry: %w", err)
+	}
+	if err := k.removeDealAccessGrants(ctx, deal.Id); err != nil {
+		return math.Int{}, err
+	}
+
+	deal.EscrowBalance = math.ZeroInt()
+	deal.PendingOwner = ""
+	deal.Status = status
+	if err := k.Deals.Set(ctx, deal.Id, *deal); err != nil {
+		return math.Int{}, fmt.Errorf("failed to update deal: %w", err)
+	}
+	return refund, nil
+}
+
+// settleDealRetrievalSessions closes out the unfinished retrieval sessions of
+// a deal that is ending. Sessions with a submitted proof are paid out as if
+// the owner had confirmed them; the rest are canceled and their locked fee
+// returns to the deal escrow.
+func (k Keeper) settleDealRetrievalSessions(ctx sdk.Context, deal *types.Deal) (uint64, error) {
+	sessionIDs, err := k.dealRetrievalSessionIDs(ctx, deal.Id)
+	if err != nil {
+		return 0, err
+	}
+
+	var settled uint64
+	for _, id := range sessionIDs {
+		session, err := k.RetrievalSessions.Get(ctx, id)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return 0, err
+		}
+		switch session.Status {
+		case types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_PROOF_SUBMITTED:
+			if err := k.settleRetrievalSession(ctx, &session); err != nil {
+				return 0, err
+			}
+			session.Status = types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_COMPLETED
+		case types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_OPEN,
+			types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_USER_CONFIRMED,
+			types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_EXPIRED:
+			if !session.LockedFee.IsNil() && session.LockedFee.IsPositive() {
+				deal.EscrowBalance = deal.EscrowBalance.Add(session.LockedFee)
+			}
+			session.LockedFee = math.ZeroInt()
+			session.Status = types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_CANCELED
+		default:
+			continue
+		}
+		session.UpdatedHeight = ctx.BlockHeight()
+		if err := k.RetrievalSessions.Set(ctx, id, session); err != nil {
+			return 0, fmt.Errorf("failed to settle retrieval session: %w", err)
+		}
+		settled++
+	}
+	return settled, nil
+}
+
+// ExtendDeal handles MsgExtendDeal. The owner pays for the extra blocks at the
+// current storage price for the deal's size, like the term deposit charged by
+// UpdateDealContent.
+func (k msgServer) ExtendDeal(goCtx context.Context, msg *types.MsgExtendDeal) (*types.MsgExtendDealResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creatorAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	if msg.AdditionalBlocks == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("additional_blocks must be positive")
+	}
+
+	deal, err := k.Deals.Get(ctx, msg.DealId)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("deal %d not found", msg.DealId)
+	}
+	if deal.Owner != msg.Creator {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("only deal owner %s can extend the deal", deal.Owner)
+	}
+	if err := checkDealActive(deal); err != nil {
+		return nil, err
+	}
+	if uint64(ctx.BlockHeight()) > deal.EndBlock {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("deal %d has expired", deal.Id)
+	}
+	if deal.EndBlock+msg.AdditionalBlocks < deal.EndBlock {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("additional_blocks overflows end block")
+	}
+
+	params := k.GetParams(ctx)
+	cost := math.ZeroInt()
+	if price := params.StoragePrice; price.IsPositive() && deal.Size_ > 0 {
+		cost = price.MulInt(math.NewIntFromUint64(deal.Size_)).MulInt(math.NewIntFromUint64(msg.AdditionalBlocks)).Ceil().TruncateInt()
+	}
+	if cost.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, cost))
+		if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, creatorAddr, types.ModuleName, coins); err != nil {
+			return nil, fmt.Errorf("failed to pay extension escrow: %w", err)
+		}
+		if deal.EscrowBalance.IsNil() {
+			deal.EscrowBalance = math.ZeroInt()
+		}
+		deal.EscrowBalance = deal.EscrowBalance.Add(cost)
+	}
+
+	deal.EndBlock += msg.AdditionalBlocks
+	if err := k.Deals.Set(ctx, deal.Id, deal); err != nil {
+		return nil, fmt.Errorf("failed to update deal: %w", err)
+	}
+	// The entry at the old end block is skipped by ExpireDeals.
+	if err := k.scheduleDealExpiry(ctx, deal.Id, deal.EndBlock); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeMsgExtendDeal,
+			sdk.NewAttribute(types.AttributeKeyDealID, fmt.Sprintf("%d", deal.Id)),
+			sdk.NewAttribute(types.AttributeKeyOwner, deal.Owner),
+			sdk.NewAttribute(types.AttributeKeyEndBlock, fmt.Sprintf("%d", deal.EndBlock)),
+			sdk.NewAttribute(types.AttributeKeyEscrowAdded, cost.String()),
+			sdk.NewAttribute(types.AttributeKeyEscrowBalance, deal.EscrowBalance.String()),
+		),
+	)
+
+	return &types.MsgExtendDealResponse{
+		EndBlock:      deal.EndBlock,
+		EscrowAdded:   cost,
+		EscrowBalance: deal.EscrowBalance,
+	}, nil
+}
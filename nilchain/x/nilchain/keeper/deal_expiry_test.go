@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+// setupExpiringDeal registers funded providers and creates a Mode 1 deal that
+// ends at height 10+duration with the given escrow and 100 bytes of content.
+func setupExpiringDeal(t *testing.T, bank *trackingBankKeeper, f *fixture, owner sdk.AccAddress, duration uint64, escrow int64) (sdk.Context, types.Deal) {
+	t.Helper()
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	params := types.DefaultParams()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+	for i := 0; i < 12; i++ {
+		addr := sdk.AccAddress([]byte(fmt.Sprintf("expiry_provider____%02d", i)))
+		bank.setAccountBalance(addr, sdk.NewCoins(keeper.RequiredProviderBond(params, 1<<30)))
+		_, err := msgServer.RegisterProvider(ctx, &types.MsgRegisterProvider{
+			Creator: addr.String(), Capabilities: "General", TotalStorage: 1 << 30, Endpoints: testProviderEndpoints,
+		})
+		require.NoError(t, err)
+	}
+
+	bank.setAccountBalance(owner, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000)))
+	res, err := msgServer.CreateDeal(ctx, &types.MsgCreateDeal{
+		Creator: owner.String(), DurationBlocks: duration, ServiceHint: "General",
+		MaxMonthlySpend: math.NewInt(0), InitialEscrowAmount: math.NewInt(escrow),
+	})
+	require.NoError(t, err)
+
+	deal, err := f.keeper.Deals.Get(ctx, res.DealId)
+	require.NoError(t, err)
+	require.Equal(t, types.DealStatus_DEAL_STATUS_ACTIVE, deal.Status)
+
+	// Stand in for committed content so expiry has storage to release.
+	deal.Size_ = 100
+	require.NoError(t, f.keeper.Deals.Set(ctx, deal.Id, deal))
+	for _, addr := range deal.Providers {
+		provider, err := f.keeper.Providers.Get(ctx, addr)
+		require.NoError(t, err)
+		provider.UsedStorage += keeper.DealStorageFootprint(deal)
+		require.NoError(t, f.keeper.Providers.Set(ctx, addr, provider))
+	}
+	return ctx, deal
+}
+
+func TestExtendDeal(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+
+	owner := sdk.AccAddress([]byte("extend_owner________"))
+	ctx, deal := setupExpiringDeal(t, bank, f, owner, 40, 1000)
+
+	params := types.DefaultParams()
+	params.StoragePrice = math.LegacyMustNewDecFromStr("0.5")
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	_, err := msgServer.ExtendDeal(ctx, &types.MsgExtendDeal{Creator: owner.String(), DealId: deal.Id})
+	require.Error(t, err)
+	other := sdk.AccAddress([]byte("extend_stranger_____")).String()
+	_, err = msgServer.ExtendDeal(ctx, &types.MsgExtendDeal{Creator: other, DealId: deal.Id, AdditionalBlocks: 10})
+	require.ErrorContains(t, err, "only deal owner")
+
+	// ceil(0.5 * 100 bytes * 11 blocks) = 550.
+	res, err := msgServer.ExtendDeal(ctx, &types.MsgExtendDeal{Creator: owner.String(), DealId: deal.Id, AdditionalBlocks: 11})
+	require.NoError(t, err)
+	require.Equal(t, uint64(61), res.EndBlock)
+	require.Equal(t, math.NewInt(550), res.EscrowAdded)
+	require.Equal(t, math.NewInt(1550), res.EscrowBalance)
+	require.Equal(t, math.NewInt(10_000-1000-550), bank.accountBalances[owner.String()].AmountOf(sdk.DefaultBondDenom))
+
+	// The entry at the original end block no longer expires the deal.
+	require.NoError(t, f.keeper.ExpireDeals(ctx.WithBlockHeight(61)))
+	stored, err := f.keeper.Deals.Get(ctx, deal.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.DealStatus_DEAL_STATUS_ACTIVE, stored.Status)
+	has, err := f.keeper.DealExpiryQueue.Has(ctx, collections.Join(uint64(50), deal.Id))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// Deals past their end block cannot be extended.
+	_, err = msgServer.ExtendDeal(ctx.WithBlockHeight(62), &types.MsgExtendDeal{Creator: owner.String(), DealId: deal.Id, AdditionalBlocks: 1})
+	require.ErrorContains(t, err, "expired")
+}
+
+func TestExpireDeals_RefundsEscrowAndReleasesProviders(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+
+	owner := sdk.AccAddress([]byte("expiry_owner________"))
+	ctx, deal := setupExpiringDeal(t, bank, f, owner, 40, 1000)
+	require.Equal(t, math.NewInt(9000), bank.accountBalances[owner.String()].AmountOf(sdk.DefaultBondDenom))
+
+	// The deal is live through its end block.
+	require.NoError(t, f.keeper.ExpireDeals(ctx.WithBlockHeight(50)))
+	stored, err := f.keeper.Deals.Get(ctx, deal.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.DealStatus_DEAL_STATUS_ACTIVE, stored.Status)
+
+	expiryCtx := ctx.WithBlockHeight(51).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.ExpireDeals(expiryCtx))
+
+	stored, err = f.keeper.Deals.Get(ctx, deal.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.DealStatus_DEAL_STATUS_EXPIRED, stored.Status)
+	require.True(t, stored.EscrowBalance.IsZero())
+	require.Equal(t, deal.Providers, stored.Providers)
+	require.Equal(t, math.NewInt(10_000), bank.accountBalances[owner.String()].AmountOf(sdk.DefaultBondDenom))
+
+	for _, addr := range deal.Providers {
+		provider, err := f.keeper.Providers.Get(ctx, addr)
+		require.NoError(t, err)
+		require.Zero(t, provider.UsedStorage)
+	}
+	iter, err := f.keeper.ProofDeadlines.Iterate(ctx, nil)
+	require.NoError(t, err)
+	require.False(t, iter.Valid())
+	iter.Close()
+
+	var found bool
+	for _, ev := range expiryCtx.EventManager().Events() {
+		if ev.Type == types.TypeDealExpired {
+			found = true
+			attr, ok := ev.GetAttribute(types.AttributeKeyEscrowRefund)
+			require.True(t, ok)
+			require.Equal(t, "1000", attr.Value)
+		}
+	}
+	require.True(t, found)
+
+	// Expired deals reject further activity.
+	_, err = msgServer.AddCredit(ctx.WithBlockHeight(52), &types.MsgAddCredit{
+		Creator: owner.String(), DealId: deal.Id, Amount: math.NewInt(1),
+	})
+	require.ErrorContains(t, err, "expired")
+}
+
+func TestExpireDeals_PaysProvidersForTerm(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+
+	owner := sdk.AccAddress([]byte("expiry_pay_owner____"))
+	ctx, deal := setupExpiringDeal(t, bank, f, owner, 40, 1000)
+
+	params := types.DefaultParams()
+	params.StoragePrice = math.LegacyMustNewDecFromStr("0.1")
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	before := make(map[string]math.Int, len(deal.Providers))
+	for _, addr := range deal.Providers {
+		before[addr] = bank.accountBalances[addr].AmountOf(sdk.DefaultBondDenom)
+	}
+
+	require.NoError(t, f.keeper.ExpireDeals(ctx.WithBlockHeight(51)))
+
+	// 0.1 * 100 bytes * 40 blocks = 400, split equally; the rest is refunded.
+	share := math.NewInt(400).QuoRaw(int64(len(deal.Providers)))
+	for _, addr := range deal.Providers {
+		require.Equal(t, before[addr].Add(share), bank.accountBalances[addr].AmountOf(sdk.DefaultBondDenom))
+	}
+	paid := share.MulRaw(int64(len(deal.Providers)))
+	require.Equal(t, math.NewInt(10_000).Sub(paid), bank.accountBalances[owner.String()].AmountOf(sdk.DefaultBondDenom))
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	"nilchain/x/nilchain/types"
+)
+
+// indexDeal adds a deal to the owner index and to the provider index of
+// every provider in its Providers list.
+func (k Keeper) indexDeal(ctx context.Context, deal types.Deal) error {
+	if err := k.DealsByOwner.Set(ctx, collections.Join(deal.Owner, deal.Id)); err != nil {
+		return fmt.Errorf("failed to index deal %d by owner: %w", deal.Id, err)
+	}
+	return k.indexDealProviders(ctx, deal.Id, deal.Providers...)
+}
+
+// indexDealProviders adds a deal to the provider index of each provider.
+func (k Keeper) indexDealProviders(ctx context.Context, dealID uint64, providers ...string) error {
+	for _, provider := range providers {
+		if err := k.DealsByProvider.Set(ctx, collections.Join(provider, dealID)); err != nil {
+			return fmt.Errorf("failed to index deal %d by provider: %w", dealID, err)
+		}
+	}
+	return nil
+}
+
+// reindexDealOwner moves a deal from one owner's index to another's.
+func (k Keeper) reindexDealOwner(ctx context.Context, dealID uint64, from, to string) error {
+	if err := k.DealsByOwner.Remove(ctx, collections.Join(from, dealID)); err != nil {
+		return fmt.Errorf("failed to unindex deal %d by owner: %w", dealID, err)
+	}
+	if err := k.DealsByOwner.Set(ctx, collections.Join(to, dealID)); err != nil {
+		return fmt.Errorf("failed to index deal %d by owner: %w", dealID, err)
+	}
+	return nil
+}
+
+// reindexDealProvider updates the provider index after replacement took
+// over a place of previous in the deal's Providers list. previous keeps the
+// deal while it still holds another place in the list.
+func (k Keeper) reindexDealProvider(ctx context.Context, deal types.Deal, previous, replacement string) error {
+	if !containsString(deal.Providers, previous) {
+		if err := k.DealsByProvider.Remove(ctx, collections.Join(previous, deal.Id)); err != nil {
+			return fmt.Errorf("failed to unindex deal %d by provider: %w", deal.Id, err)
+		}
+	}
+	return k.indexDealProviders(ctx, deal.Id, replacement)
+}
+
+// rebuildDealIndexes clears the owner and provider indexes and rebuilds them
+// from the stored deals.
+func (k Keeper) rebuildDealIndexes(ctx context.Context) error {
+	if err := k.DealsByOwner.Clear(ctx, nil); err != nil {
+		return fmt.Errorf("failed to clear deal owner index: %w", err)
+	}
+	if err := k.DealsByProvider.Clear(ctx, nil); err != nil {
+		return fmt.Errorf("failed to clear deal provider index: %w", err)
+	}
+	return k.Deals.Walk(ctx, nil, func(_ uint64, deal types.Deal) (bool, error) {
+		return false, k.indexDeal(ctx, deal)
+	})
+}
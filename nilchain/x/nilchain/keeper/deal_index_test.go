@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+func TestListDealsByOwnerAndProvider(t *testing.T) {
+	f := initFixture(t)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	registerPlacementProviders(t, f, 12, func(int) types.ProviderFailureDomain { return types.ProviderFailureDomain{} })
+
+	var ids []uint64
+	for i := 0; i < 3; i++ {
+		res, err := createPlacementDeal(f, "General:rs=8+4")
+		require.NoError(t, err)
+		ids = append(ids, res.DealId)
+	}
+	owner, _ := f.addressCodec.BytesToString([]byte("placement_user______"))
+
+	page, err := queryServer.ListDealsByOwner(f.ctx, &types.QueryListDealsByOwnerRequest{
+		Owner:      owner,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, page.Deals, 2)
+	require.Equal(t, ids[0], page.Deals[0].Id)
+	require.Equal(t, ids[1], page.Deals[1].Id)
+	require.NotNil(t, page.Pagination.NextKey)
+
+	page, err = queryServer.ListDealsByOwner(f.ctx, &types.QueryListDealsByOwnerRequest{
+		Owner:      owner,
+		Pagination: &query.PageRequest{Key: page.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, page.Deals, 1)
+	require.Equal(t, ids[2], page.Deals[0].Id)
+
+	// Other owners see none of these deals.
+	stranger, _ := f.addressCodec.BytesToString([]byte("deal_index_stranger_"))
+	page, err = queryServer.ListDealsByOwner(f.ctx, &types.QueryListDealsByOwnerRequest{Owner: stranger})
+	require.NoError(t, err)
+	require.Empty(t, page.Deals)
+
+	deal, err := f.keeper.Deals.Get(f.ctx, ids[0])
+	require.NoError(t, err)
+	byProvider, err := queryServer.ListDealsByProvider(f.ctx, &types.QueryListDealsByProviderRequest{Provider: deal.Providers[0]})
+	require.NoError(t, err)
+	require.Len(t, byProvider.Deals, 3)
+
+	_, err = queryServer.ListDealsByOwner(f.ctx, &types.QueryListDealsByOwnerRequest{})
+	require.Error(t, err)
+	_, err = queryServer.ListDealsByProvider(f.ctx, &types.QueryListDealsByProviderRequest{})
+	require.Error(t, err)
+}
+
+func TestMigrate1to2BackfillsDealIndexes(t *testing.T) {
+	f := initFixture(t)
+	registerPlacementProviders(t, f, 12, func(int) types.ProviderFailureDomain { return types.ProviderFailureDomain{} })
+	res, err := createPlacementDeal(f, "General:rs=8+4")
+	require.NoError(t, err)
+
+	// State written before the indexes existed has none.
+	require.NoError(t, f.keeper.DealsByOwner.Clear(f.ctx, nil))
+	require.NoError(t, f.keeper.DealsByProvider.Clear(f.ctx, nil))
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	_, broken := keeper.DealIndexInvariant(f.keeper)(ctx)
+	require.True(t, broken)
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+	_, broken = keeper.DealIndexInvariant(f.keeper)(ctx)
+	require.False(t, broken)
+
+	owner, _ := f.addressCodec.BytesToString([]byte("placement_user______"))
+	page, err := keeper.NewQueryServerImpl(f.keeper).ListDealsByOwner(ctx, &types.QueryListDealsByOwnerRequest{Owner: owner})
+	require.NoError(t, err)
+	require.Len(t, page.Deals, 1)
+	require.Equal(t, res.DealId, page.Deals[0].Id)
+}
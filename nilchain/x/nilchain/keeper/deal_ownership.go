@@ -0,0 +1,285 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+
+	"nilchain/x/nilchain/types"
+)
+
+// TransferDealOwnership handles MsgTransferDealOwnership. The current owner
+// nominates a new owner, who must then send MsgAcceptDealOwnership. An empty
+// new_owner cancels a pending proposal.
+func (k msgServer) TransferDealOwnership(goCtx context.Context, msg *types.MsgTransferDealOwnership) (*types.MsgTransferDealOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	deal, err := k.Deals.Get(ctx, msg.DealId)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("deal %d not found", msg.DealId)
+	}
+	if deal.Owner != msg.Creator {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("only deal owner %s can transfer the deal", deal.Owner)
+	}
+
+	if err := k.proposeDealOwner(ctx, &deal, msg.NewOwner); err != nil {
+		return nil, err
+	}
+	return &types.MsgTransferDealOwnershipResponse{PendingOwner: deal.PendingOwner}, nil
+}
+
+// AcceptDealOwnership handles MsgAcceptDealOwnership.
+func (k msgServer) AcceptDealOwnership(goCtx context.Context, msg *types.MsgAcceptDealOwnership) (*types.MsgAcceptDealOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	deal, err := k.Deals.Get(ctx, msg.DealId)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("deal %d not found", msg.DealId)
+	}
+
+	previous := deal.Owner
+	moved, err := k.acceptDealOwner(ctx, &deal, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgAcceptDealOwnershipResponse{PreviousOwner: previous, SessionsMoved: moved}, nil
+}
+
+// TransferDealOwnershipFromEvm drives both steps of a transfer from an
+// EVM-signed intent. Signed by the owner, the intent proposes new_owner (or
+// cancels when empty); signed by the pending owner naming itself as new_owner,
+// it accepts.
+func (k msgServer) TransferDealOwnershipFromEvm(goCtx context.Context, msg *types.MsgTransferDealOwnershipFromEvm) (*types.MsgTransferDealOwnershipFromEvmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Intent == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("intent is required")
+	}
+	intent := msg.Intent
+
+	structHash, err := types.HashTransferDeal(intent)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to hash intent: %s", err)
+	}
+	signer, err := k.verifyEvmIntent(ctx, structHash, intent.CreatorEvm, intent.Nonce, intent.ChainId, msg.EvmSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	deal, err := k.Deals.Get(ctx, intent.DealId)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("deal %d not found", intent.DealId)
+	}
+
+	switch signer.String() {
+	case deal.Owner:
+		if err := k.proposeDealOwner(ctx, &deal, intent.NewOwner); err != nil {
+			return nil, err
+		}
+	case deal.PendingOwner:
+		newOwner, err := normalizeDealOwner(intent.NewOwner)
+		if err != nil {
+			return nil, err
+		}
+		if newOwner != deal.PendingOwner {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("new_owner must name the accepting pending owner")
+		}
+		if _, err := k.acceptDealOwner(ctx, &deal, newOwner); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, sdkerrors.ErrUnauthorized.Wrap("only the deal owner or pending owner can sign a transfer")
+	}
+
+	return &types.MsgTransferDealOwnershipFromEvmResponse{
+		Owner:        deal.Owner,
+		PendingOwner: deal.PendingOwner,
+	}, nil
+}
+
+// normalizeDealOwner accepts a nil bech32 address or a 0x-prefixed EVM
+// address and returns the bech32 form.
+func normalizeDealOwner(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if strings.HasPrefix(raw, "0x") || strings.HasPrefix(raw, "0X") {
+		if !gethCommon.IsHexAddress(raw) {
+			return "", sdkerrors.ErrInvalidAddress.Wrapf("invalid EVM address %q", raw)
+		}
+		return sdk.AccAddress(gethCommon.HexToAddress(raw).Bytes()).String(), nil
+	}
+	addr, err := sdk.AccAddressFromBech32(raw)
+	if err != nil {
+		return "", sdkerrors.ErrInvalidAddress.Wrapf("invalid new owner address: %s", err)
+	}
+	return addr.String(), nil
+}
+
+// proposeDealOwner records (or, for an empty address, clears) the deal's
+// pending owner.
+func (k Keeper) proposeDealOwner(ctx sdk.Context, deal *types.Deal, rawNewOwner string) error {
+	if err := checkDealActive(*deal); err != nil {
+		return err
+	}
+
+	newOwner := ""
+	if strings.TrimSpace(rawNewOwner) != "" {
+		var err error
+		newOwner, err = normalizeDealOwner(rawNewOwner)
+		if err != nil {
+			return err
+		}
+		if newOwner == deal.Owner {
+			return sdkerrors.ErrInvalidRequest.Wrap("new owner must differ from the current owner")
+		}
+	}
+
+	deal.PendingOwner = newOwner
+	if err := k.Deals.Set(ctx, deal.Id, *deal); err != nil {
+		return fmt.Errorf("failed to update deal: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeDealOwnershipProposed,
+			sdk.NewAttribute(types.AttributeKeyDealID, fmt.Sprintf("%d", deal.Id)),
+			sdk.NewAttribute(types.AttributeKeyOwner, deal.Owner),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, newOwner),
+		),
+	)
+	return nil
+}
+
+// acceptDealOwner completes a proposed transfer to acceptor, moving the
+// deal's retrieval sessions and session nonces along with it and dropping its
+// access grants. It returns the number of sessions re-indexed.
+func (k Keeper) acceptDealOwner(ctx sdk.Context, deal *types.Deal, acceptor string) (uint64, error) {
+	if err := checkDealActive(*deal); err != nil {
+		return 0, err
+	}
+	if deal.PendingOwner == "" || deal.PendingOwner != acceptor {
+		return 0, sdkerrors.ErrUnauthorized.Wrapf("%s is not the pending owner of deal %d", acceptor, deal.Id)
+	}
+
+	previous := deal.Owner
+	moved, err := k.moveDealRetrievalSessions(ctx, deal.Id, previous, acceptor)
+	if err != nil {
+		return 0, err
+	}
+	if err := k.moveDealRetrievalNonces(ctx, deal.Id, previous, acceptor); err != nil {
+		return 0, err
+	}
+	// Read grants were handed out by the previous owner; the new owner starts
+	// with a clean access list.
+	if err := k.removeDealAccessGrants(ctx, deal.Id); err != nil {
+		return 0, err
+	}
+
+	deal.Owner = acceptor
+	deal.PendingOwner = ""
+	if err := k.Deals.Set(ctx, deal.Id, *deal); err != nil {
+		return 0, fmt.Errorf("failed to update deal: %w", err)
+	}
+	if err := k.reindexDealOwner(ctx, deal.Id, previous, acceptor); err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeDealOwnershipTransferred,
+			sdk.NewAttribute(types.AttributeKeyDealID, fmt.Sprintf("%d", deal.Id)),
+			sdk.NewAttribute(types.AttributeKeyPreviousOwner, previous),
+			sdk.NewAttribute(types.AttributeKeyOwner, acceptor),
+			sdk.NewAttribute(types.AttributeKeySessionsMoved, fmt.Sprintf("%d", moved)),
+		),
+	)
+	return moved, nil
+}
+
+// moveDealRetrievalSessions re-homes the deal's sessions opened by one owner
+// to another in both the session records and RetrievalSessionsByOwner.
+// Sessions opened by access grantees stay with their grantee.
+func (k Keeper) moveDealRetrievalSessions(ctx sdk.Context, dealID uint64, from, to string) (uint64, error) {
+	ids, err := k.dealRetrievalSessionIDs(ctx, dealID)
+	if err != nil {
+		return 0, err
+	}
+
+	var moved uint64
+	for _, id := range ids {
+		session, err := k.RetrievalSessions.Get(ctx, id)
+		if err != nil {
+			return 0, fmt.Errorf("failed to load retrieval session %x: %w", id, err)
+		}
+		if session.Owner != from {
+			continue
+		}
+		height, err := k.RetrievalSessionsByOwner.Get(ctx, collections.Join(from, id))
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return 0, fmt.Errorf("failed to load retrieval session owner index: %w", err)
+		}
+		if err := k.RetrievalSessionsByOwner.Remove(ctx, collections.Join(from, id)); err != nil {
+			return 0, fmt.Errorf("failed to unindex retrieval session: %w", err)
+		}
+		if err := k.RetrievalSessionsByOwner.Set(ctx, collections.Join(to, id), height); err != nil {
+			return 0, fmt.Errorf("failed to index retrieval session by owner: %w", err)
+		}
+		session.Owner = to
+		if err := k.RetrievalSessions.Set(ctx, id, session); err != nil {
+			return 0, fmt.Errorf("failed to update retrieval session: %w", err)
+		}
+		moved++
+	}
+	return moved, nil
+}
+
+// moveDealRetrievalNonces carries the per-provider session nonces for the
+// deal over to the new owner, keeping the higher nonce if the new owner
+// already has one (e.g. a deal transferred back to a former owner).
+func (k Keeper) moveDealRetrievalNonces(ctx sdk.Context, dealID uint64, from, to string) error {
+	type nonceEntry struct {
+		provider string
+		nonce    uint64
+	}
+	var entries []nonceEntry
+
+	rng := collections.NewPrefixedPairRange[collections.Pair[string, uint64], string](collections.Join(from, dealID))
+	if err := k.RetrievalSessionNonces.Walk(ctx, rng, func(key collections.Pair[collections.Pair[string, uint64], string], nonce uint64) (bool, error) {
+		entries = append(entries, nonceEntry{provider: key.K2(), nonce: nonce})
+		return false, nil
+	}); err != nil {
+		return fmt.Errorf("failed to load retrieval session nonces: %w", err)
+	}
+
+	for _, entry := range entries {
+		if err := k.RetrievalSessionNonces.Remove(ctx, collections.Join(collections.Join(from, dealID), entry.provider)); err != nil {
+			return fmt.Errorf("failed to remove retrieval session nonce: %w", err)
+		}
+		newKey := collections.Join(collections.Join(to, dealID), entry.provider)
+		nonce := entry.nonce
+		existing, err := k.RetrievalSessionNonces.Get(ctx, newKey)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return fmt.Errorf("failed to load retrieval session nonce: %w", err)
+		}
+		if existing > nonce {
+			nonce = existing
+		}
+		if err := k.RetrievalSessionNonces.Set(ctx, newKey, nonce); err != nil {
+			return fmt.Errorf("failed to update retrieval session nonce: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+func TestDealOwnershipTransfer(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+
+	owner := sdk.AccAddress([]byte("transfer_owner______")).String()
+	buyer := sdk.AccAddress([]byte("transfer_buyer______")).String()
+	stranger := sdk.AccAddress([]byte("transfer_stranger___")).String()
+	ctx, deal := setupExpiringDeal(t, bank, f, sdk.MustAccAddressFromBech32(owner), 40, 1000)
+
+	sessionID := lockRetrievalSession(t, f, ctx, &deal, 0xaa, deal.Providers[0], types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_OPEN, 50)
+	oldNonceKey := collections.Join(collections.Join(owner, deal.Id), deal.Providers[0])
+	require.NoError(t, f.keeper.RetrievalSessionNonces.Set(ctx, oldNonceKey, 5))
+
+	_, err := msgServer.TransferDealOwnership(ctx, &types.MsgTransferDealOwnership{Creator: stranger, DealId: deal.Id, NewOwner: buyer})
+	require.ErrorContains(t, err, "only deal owner")
+	_, err = msgServer.TransferDealOwnership(ctx, &types.MsgTransferDealOwnership{Creator: owner, DealId: deal.Id, NewOwner: owner})
+	require.ErrorContains(t, err, "must differ")
+
+	// A proposal can be withdrawn before it is accepted.
+	res, err := msgServer.TransferDealOwnership(ctx, &types.MsgTransferDealOwnership{Creator: owner, DealId: deal.Id, NewOwner: buyer})
+	require.NoError(t, err)
+	require.Equal(t, buyer, res.PendingOwner)
+	_, err = msgServer.TransferDealOwnership(ctx, &types.MsgTransferDealOwnership{Creator: owner, DealId: deal.Id})
+	require.NoError(t, err)
+	_, err = msgServer.AcceptDealOwnership(ctx, &types.MsgAcceptDealOwnership{Creator: buyer, DealId: deal.Id})
+	require.ErrorContains(t, err, "not the pending owner")
+
+	_, err = msgServer.TransferDealOwnership(ctx, &types.MsgTransferDealOwnership{Creator: owner, DealId: deal.Id, NewOwner: buyer})
+	require.NoError(t, err)
+	_, err = msgServer.AcceptDealOwnership(ctx, &types.MsgAcceptDealOwnership{Creator: stranger, DealId: deal.Id})
+	require.ErrorContains(t, err, "not the pending owner")
+
+	acceptCtx := ctx.WithEventManager(sdk.NewEventManager())
+	accepted, err := msgServer.AcceptDealOwnership(acceptCtx, &types.MsgAcceptDealOwnership{Creator: buyer, DealId: deal.Id})
+	require.NoError(t, err)
+	require.Equal(t, owner, accepted.PreviousOwner)
+	require.Equal(t, uint64(1), accepted.SessionsMoved)
+
+	stored, err := f.keeper.Deals.Get(ctx, deal.Id)
+	require.NoError(t, err)
+	require.Equal(t, buyer, stored.Owner)
+	require.Empty(t, stored.PendingOwner)
+	byOwner, err := keeper.NewQueryServerImpl(f.keeper).ListDealsByOwner(ctx, &types.QueryListDealsByOwnerRequest{Owner: buyer})
+	require.NoError(t, err)
+	require.Len(t, byOwner.Deals, 1)
+	byOwner, err = keeper.NewQueryServerImpl(f.keeper).ListDealsByOwner(ctx, &types.QueryListDealsByOwnerRequest{Owner: owner})
+	require.NoError(t, err)
+	require.Empty(t, byOwner.Deals)
+
+	// The session, its owner index and the open-session nonce follow the deal.
+	session, err := f.keeper.RetrievalSessions.Get(ctx, sessionID)
+	require.NoError(t, err)
+	require.Equal(t, buyer, session.Owner)
+	has, err := f.keeper.RetrievalSessionsByOwner.Has(ctx, collections.Join(owner, sessionID))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.RetrievalSessionsByOwner.Has(ctx, collections.Join(buyer, sessionID))
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = f.keeper.RetrievalSessionNonces.Has(ctx, oldNonceKey)
+	require.NoError(t, err)
+	require.False(t, has)
+	nonce, err := f.keeper.RetrievalSessionNonces.Get(ctx, collections.Join(collections.Join(buyer, deal.Id), deal.Providers[0]))
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), nonce)
+
+	var found bool
+	for _, ev := range acceptCtx.EventManager().Events() {
+		if ev.Type == types.TypeDealOwnershipTransferred {
+			found = true
+		}
+	}
+	require.True(t, found)
+
+	// The previous owner has lost control of the deal.
+	_, err = msgServer.TransferDealOwnership(ctx, &types.MsgTransferDealOwnership{Creator: owner, DealId: deal.Id, NewOwner: stranger})
+	require.ErrorContains(t, err, "only deal owner")
+	_, err = msgServer.CancelRetrievalSession(ctx, &types.MsgCancelRetrievalSession{Creator: owner, SessionId: sessionID})
+	require.Error(t, err)
+}
+
+func TestTransferDealOwnershipFromEvm(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+
+	ownerKey, err := gethCrypto.GenerateKey()
+	require.NoError(t, err)
+	ownerEvm := gethCrypto.PubkeyToAddress(ownerKey.PublicKey)
+	buyerKey, err := gethCrypto.GenerateKey()
+	require.NoError(t, err)
+	buyerEvm := gethCrypto.PubkeyToAddress(buyerKey.PublicKey)
+	buyer := sdk.AccAddress(buyerEvm.Bytes()).String()
+
+	ctx, deal := setupExpiringDeal(t, bank, f, sdk.AccAddress(ownerEvm.Bytes()), 40, 1000)
+	relayer := sdk.AccAddress([]byte("transfer_relayer____")).String()
+
+	submit := func(intent *types.EvmTransferDealIntent, signer *ecdsa.PrivateKey) (*types.MsgTransferDealOwnershipFromEvmResponse, error) {
+		structHash, err := types.HashTransferDeal(intent)
+		require.NoError(t, err)
+		digest := types.ComputeEIP712Digest(types.HashDomainSeparator(eip712DevChainID), structHash)
+		sig, err := gethCrypto.Sign(digest, signer)
+		require.NoError(t, err)
+		return msgServer.TransferDealOwnershipFromEvm(ctx, &types.MsgTransferDealOwnershipFromEvm{Sender: relayer, Intent: intent, EvmSignature: sig})
+	}
+
+	// The buyer cannot accept before the owner has proposed.
+	_, err = submit(&types.EvmTransferDealIntent{CreatorEvm: buyerEvm.Hex(), DealId: deal.Id, NewOwner: buyerEvm.Hex(), Nonce: 1, ChainId: ctx.ChainID()}, buyerKey)
+	require.ErrorContains(t, err, "only the deal owner or pending owner")
+
+	res, err := submit(&types.EvmTransferDealIntent{CreatorEvm: ownerEvm.Hex(), DealId: deal.Id, NewOwner: buyerEvm.Hex(), Nonce: 1, ChainId: ctx.ChainID()}, ownerKey)
+	require.NoError(t, err)
+	require.Equal(t, buyer, res.PendingOwner)
+
+	// Accepting on behalf of someone else is rejected.
+	_, err = submit(&types.EvmTransferDealIntent{CreatorEvm: buyerEvm.Hex(), DealId: deal.Id, NewOwner: relayer, Nonce: 2, ChainId: ctx.ChainID()}, buyerKey)
+	require.ErrorContains(t, err, "accepting pending owner")
+
+	res, err = submit(&types.EvmTransferDealIntent{CreatorEvm: buyerEvm.Hex(), DealId: deal.Id, NewOwner: buyerEvm.Hex(), Nonce: 3, ChainId: ctx.ChainID()}, buyerKey)
+	require.NoError(t, err)
+	require.Equal(t, buyer, res.Owner)
+	require.Empty(t, res.PendingOwner)
+
+	// The former owner's signatures no longer carry authority.
+	_, err = submit(&types.EvmTransferDealIntent{CreatorEvm: ownerEvm.Hex(), DealId: deal.Id, NewOwner: ownerEvm.Hex(), Nonce: 2, ChainId: ctx.ChainID()}, ownerKey)
+	require.ErrorContains(t, err, "only the deal owner or pending owner")
+}
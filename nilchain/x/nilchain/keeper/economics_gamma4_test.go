@@ -50,6 +50,11 @@ func (b *trackingBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddre
 	if coins, ok := b.accountBalances[addr.String()]; ok {
 		return coins
 	}
+	for name, coins := range b.moduleBalances {
+		if authtypes.NewModuleAddress(name).Equals(addr) {
+			return coins
+		}
+	}
 	return sdk.NewCoins()
 }
 
@@ -125,6 +130,11 @@ func safeSubCoins(have sdk.Coins, amt sdk.Coins) (sdk.Coins, error) {
 
 func initFixtureWithBankKeeper(t *testing.T, bank types.BankKeeper) *fixture {
 	t.Helper()
+	return initFixtureWithKeepers(t, bank, MockAccountKeeper{})
+}
+
+func initFixtureWithKeepers(t *testing.T, bank types.BankKeeper, accounts types.AuthKeeper) *fixture {
+	t.Helper()
 
 	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
 	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
@@ -145,7 +155,7 @@ func initFixtureWithBankKeeper(t *testing.T, bank types.BankKeeper) *fixture {
 		addressCodec,
 		authority,
 		bank,
-		MockAccountKeeper{},
+		accounts,
 	)
 
 	if err := k.Params.Set(ctx, types.DefaultParams()); err != nil {
@@ -155,6 +165,16 @@ func initFixtureWithBankKeeper(t *testing.T, bank types.BankKeeper) *fixture {
 	return &fixture{ctx: ctx, keeper: k, addressCodec: addressCodec}
 }
 
+// disableProviderBonds zeroes the provider bond params so tests asserting exact
+// module balances can register providers without funding collateral.
+func disableProviderBonds(t *testing.T, f *fixture) {
+	t.Helper()
+	p := types.DefaultParams()
+	p.MinProviderBond = sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)
+	p.ProviderBondPerGib = sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, p))
+}
+
 func TestGamma4_CreateDeal_EnforcesMinDuration(t *testing.T) {
 	f := initFixture(t)
 	msgServer := keeper.NewMsgServerImpl(f.keeper)
@@ -181,6 +201,7 @@ func TestGamma4_CreateDeal_ChargesCreationFeeInBondDenom(t *testing.T) {
 	bank := newTrackingBankKeeper()
 	f := initFixtureWithBankKeeper(t, bank)
 	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	disableProviderBonds(t, f)
 
 	for i := 0; i < int(types.DealBaseReplication); i++ {
 		addrBz := []byte(fmt.Sprintf("provider_fee_test_%02d", i))
@@ -224,6 +245,7 @@ func TestGamma4_UpdateDealContent_ChargesTermDepositInBondDenom(t *testing.T) {
 	bank := newTrackingBankKeeper()
 	f := initFixtureWithBankKeeper(t, bank)
 	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	disableProviderBonds(t, f)
 
 	for i := 0; i < int(types.DealBaseReplication); i++ {
 		addrBz := []byte(fmt.Sprintf("provider_deposit_%02d", i))
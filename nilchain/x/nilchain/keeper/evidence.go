@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"nilchain/x/nilchain/types"
+)
+
+// recordEvidence adds ev to the evidence registry under the next evidence id
+// and applies what its kind means for the provider: every kind counts as a
+// failure of the provider's slot on the deal, and fraud also jails it. Fraud
+// slashing needs the reporter's reward and stays with SubmitFraudProof.
+func (k Keeper) recordEvidence(ctx sdk.Context, ev types.Evidence) (types.Evidence, error) {
+	id, err := k.EvidenceCount.Next(ctx)
+	if err != nil {
+		return types.Evidence{}, fmt.Errorf("failed to get next evidence id: %w", err)
+	}
+	ev.Id = id
+	ev.Height = ctx.BlockHeight()
+	ev.Outcome = types.EvidenceOutcome_EVIDENCE_OUTCOME_HEALTH_FAILURE
+	if ev.Kind.IsFraud() {
+		ev.Outcome = types.EvidenceOutcome_EVIDENCE_OUTCOME_SLASHED_AND_JAILED
+	}
+	if err := k.setEvidence(ctx, ev); err != nil {
+		return types.Evidence{}, err
+	}
+
+	if err := k.applyEvidence(ctx, ev); err != nil {
+		return types.Evidence{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEvidenceRecorded,
+			sdk.NewAttribute(types.AttributeKeyEvidenceID, fmt.Sprintf("%d", ev.Id)),
+			sdk.NewAttribute(types.AttributeKeyKind, ev.Kind.Reason()),
+			sdk.NewAttribute(types.AttributeKeyDealID, fmt.Sprintf("%d", ev.DealId)),
+			sdk.NewAttribute(types.AttributeKeyProvider, ev.Provider),
+			sdk.NewAttribute(types.AttributeKeyReporter, ev.Reporter),
+			sdk.NewAttribute(types.AttributeKeyOutcome, ev.Outcome.String()),
+		),
+	)
+	return ev, nil
+}
+
+// applyEvidence jails the provider on fraud and counts a failure against its
+// slot health while it still serves the deal.
+func (k Keeper) applyEvidence(ctx sdk.Context, ev types.Evidence) error {
+	if ev.Outcome == types.EvidenceOutcome_EVIDENCE_OUTCOME_SLASHED_AND_JAILED {
+		if err := k.jailProvider(ctx, ev.Provider, ev.Kind.Reason()); err != nil {
+			return err
+		}
+	}
+	deal, err := k.Deals.Get(ctx, ev.DealId)
+	if err != nil {
+		return fmt.Errorf("failed to load deal %d: %w", ev.DealId, err)
+	}
+	if containsString(deal.Providers, ev.Provider) {
+		k.trackProviderHealth(ctx, ev.DealId, ev.Provider, false)
+	}
+	return nil
+}
+
+// setEvidence stores an evidence entry and its provider and deal indexes.
+func (k Keeper) setEvidence(ctx context.Context, ev types.Evidence) error {
+	if err := k.Evidence.Set(ctx, ev.Id, ev); err != nil {
+		return fmt.Errorf("failed to store evidence: %w", err)
+	}
+	if err := k.EvidenceByProvider.Set(ctx, collections.Join(ev.Provider, ev.Id)); err != nil {
+		return fmt.Errorf("failed to index evidence by provider: %w", err)
+	}
+	if err := k.EvidenceByDeal.Set(ctx, collections.Join(ev.DealId, ev.Id)); err != nil {
+		return fmt.Errorf("failed to index evidence by deal: %w", err)
+	}
+	return nil
+}
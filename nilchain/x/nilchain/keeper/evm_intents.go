@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+
+	"nilchain/x/nilchain/types"
+)
+
+// verifyEvmIntent checks an EIP-712 signed bridge intent against the chain's
+// domain, the claimed creator_evm and the per-address bridge nonce, and
+// returns the Cosmos account of the signer. A creator_evm that is a contract
+// account signs through EIP-1271. The nonce is consumed on success.
+func (k Keeper) verifyEvmIntent(ctx sdk.Context, structHash gethCommon.Hash, creatorEvm string, nonce uint64, chainID string, sig []byte) (sdk.AccAddress, error) {
+	if strings.TrimSpace(chainID) != ctx.ChainID() {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("intent chain_id %q does not match chain %q", chainID, ctx.ChainID())
+	}
+	creatorAddr, err := parseCreatorEvm(creatorEvm)
+	if err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	domainSep := types.HashDomainSeparator(new(big.Int).SetUint64(params.Eip712ChainId))
+	digest := types.ComputeEIP712Digest(domainSep, structHash)
+
+	// Contract wallets sign through EIP-1271, everyone else with ECDSA.
+	if !k.verifyEvmSignature(ctx, creatorAddr, digest, sig) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("signature does not match creator_evm")
+	}
+	evmAddr := creatorAddr
+
+	// Replay protection: enforce strictly increasing nonce per EVM address.
+	evmKey := strings.ToLower(evmAddr.Hex())
+	lastNonce, err := k.EvmNonces.Get(ctx, evmKey)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, fmt.Errorf("failed to load bridge nonce: %w", err)
+	}
+	if nonce <= lastNonce {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("bridge nonce must be strictly increasing")
+	}
+	if err := k.EvmNonces.Set(ctx, evmKey, nonce); err != nil {
+		return nil, fmt.Errorf("failed to update bridge nonce: %w", err)
+	}
+
+	return sdk.AccAddress(evmAddr.Bytes()), nil
+}
+
+// parseCreatorEvm parses the creator_evm of a bridged intent, with or without
+// its 0x prefix.
+func parseCreatorEvm(creatorEvm string) (gethCommon.Address, error) {
+	creator := strings.ToLower(strings.TrimSpace(creatorEvm))
+	if creator == "" {
+		return gethCommon.Address{}, sdkerrors.ErrInvalidRequest.Wrap("creator_evm is required")
+	}
+	if !strings.HasPrefix(creator, "0x") {
+		creator = "0x" + creator
+	}
+	if !gethCommon.IsHexAddress(creator) {
+		return gethCommon.Address{}, sdkerrors.ErrInvalidRequest.Wrapf("invalid creator_evm %q", creatorEvm)
+	}
+	return gethCommon.HexToAddress(creator), nil
+}
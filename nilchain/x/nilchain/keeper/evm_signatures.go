@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"nilchain/x/nilchain/types"
+)
+
+// EVMKeeper is the part of the EVM keeper used to check EIP-1271 signatures
+// of contract accounts. It is declared here rather than in types so that
+// packages importing types do not depend on the EVM module.
+type EVMKeeper interface {
+	IsContract(ctx sdk.Context, addr gethCommon.Address) bool
+	ApplyMessage(ctx sdk.Context, msg core.Message, tracer *tracing.Hooks, commit bool, internal bool) (*evmtypes.MsgEthereumTxResponse, error)
+}
+
+type evmKeeperRef struct {
+	keeper EVMKeeper
+}
+
+// eip1271GasCap bounds the EVM gas one isValidSignature call may use, so a
+// contract wallet cannot make signature checks arbitrarily expensive.
+const eip1271GasCap = uint64(200_000)
+
+// eip1271MagicValue is what isValidSignature returns for a valid signature.
+var eip1271MagicValue = [4]byte{0x16, 0x26, 0xba, 0x7e}
+
+var eip1271ABI = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(`[{"type":"function","name":"isValidSignature","stateMutability":"view",` +
+		`"inputs":[{"name":"hash","type":"bytes32"},{"name":"signature","type":"bytes"}],` +
+		`"outputs":[{"name":"magicValue","type":"bytes4"}]}]`))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+// verifyEvmSignature reports whether sig is signer's signature over an
+// EIP-712 digest. Contract accounts are checked with their EIP-1271
+// isValidSignature; any other account must have produced a 65-byte ECDSA
+// signature that recovers to it.
+func (k Keeper) verifyEvmSignature(ctx sdk.Context, signer gethCommon.Address, digest []byte, sig []byte) bool {
+	if k.isEvmContract(ctx, signer) {
+		return k.isValidContractSignature(ctx, signer, digest, sig)
+	}
+	recovered, err := recoverEvmAddressFromDigest(digest, sig)
+	return err == nil && recovered == signer
+}
+
+// isEvmContract reports whether addr holds EVM contract code.
+func (k Keeper) isEvmContract(ctx sdk.Context, addr gethCommon.Address) bool {
+	return k.evm.keeper != nil && k.evm.keeper.IsContract(ctx, addr)
+}
+
+// isValidContractSignature calls isValidSignature(digest, sig) on the contract
+// account and reports whether it returned the EIP-1271 magic value. The call
+// runs on a cached context that is never written back, and its gas, capped at
+// eip1271GasCap, is charged to ctx whatever the outcome; a call that errors
+// out is charged the full cap.
+func (k Keeper) isValidContractSignature(ctx sdk.Context, contract gethCommon.Address, digest []byte, sig []byte) bool {
+	if k.evm.keeper == nil || len(digest) != 32 {
+		return false
+	}
+	data, err := eip1271ABI.Pack("isValidSignature", gethCommon.BytesToHash(digest), sig)
+	if err != nil {
+		return false
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	res, err := k.evm.keeper.ApplyMessage(cacheCtx, core.Message{
+		To:         &contract,
+		Value:      big.NewInt(0),
+		GasLimit:   eip1271GasCap,
+		GasPrice:   big.NewInt(0),
+		GasFeeCap:  big.NewInt(0),
+		GasTipCap:  big.NewInt(0),
+		Data:       data,
+		AccessList: ethtypes.AccessList{},
+	}, nil, false, true)
+	if err != nil {
+		ctx.GasMeter().ConsumeGas(eip1271GasCap, "EIP-1271 signature check")
+		ctx.Logger().Debug("EIP-1271 signature check failed", "contract", contract.Hex(), "error", err)
+		return false
+	}
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "EIP-1271 signature check")
+	if res.Failed() || len(res.Ret) < 32 {
+		return false
+	}
+	return bytes.Equal(res.Ret[:4], eip1271MagicValue[:])
+}
+
+// evmDealSigner returns the account behind an EIP-712 signature over digest
+// on one of deal's receipts: the deal owner when it is a contract account
+// whose isValidSignature accepts sig, otherwise the ECDSA signer. Callers
+// still check that the account may read the deal.
+func (k Keeper) evmDealSigner(ctx sdk.Context, deal types.Deal, digest []byte, sig []byte) (sdk.AccAddress, bool) {
+	if owner, err := sdk.AccAddressFromBech32(deal.Owner); err == nil {
+		ownerEvm := gethCommon.BytesToAddress(owner)
+		if k.isEvmContract(ctx, ownerEvm) && k.isValidContractSignature(ctx, ownerEvm, digest, sig) {
+			return owner, true
+		}
+	}
+
+	evmAddr, err := recoverEvmAddressFromDigest(digest, sig)
+	if err != nil {
+		return nil, false
+	}
+	return sdk.AccAddress(evmAddr.Bytes()), true
+}
@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	gethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+// mockWallet stands in for the EVM keeper with one EIP-1271 contract wallet
+// that accepts exactly the (digest, signature) pairs it has approved.
+type mockWallet struct {
+	addr     common.Address
+	approved map[common.Hash][]byte
+	gasUsed  uint64
+	err      error
+	calls    []core.Message
+}
+
+func newMockWallet() *mockWallet {
+	return &mockWallet{
+		addr:     common.HexToAddress("0x0000000000000000000000000000000000005afe"),
+		approved: map[common.Hash][]byte{},
+		gasUsed:  4_321,
+	}
+}
+
+func (w *mockWallet) IsContract(_ sdk.Context, addr common.Address) bool {
+	return addr == w.addr
+}
+
+func (w *mockWallet) ApplyMessage(_ sdk.Context, msg core.Message, _ *tracing.Hooks, _ bool, _ bool) (*evmtypes.MsgEthereumTxResponse, error) {
+	w.calls = append(w.calls, msg)
+	if w.err != nil {
+		return nil, w.err
+	}
+	res := &evmtypes.MsgEthereumTxResponse{GasUsed: w.gasUsed, Ret: make([]byte, 32)}
+
+	// isValidSignature(bytes32 hash, bytes signature): selector, hash,
+	// offset of signature, then its length and bytes.
+	data := msg.Data
+	if msg.To == nil || *msg.To != w.addr || len(data) < 100 {
+		return res, nil
+	}
+	digest := common.BytesToHash(data[4:36])
+	sigLen := binary.BigEndian.Uint64(data[92:100])
+	if uint64(len(data)) < 100+sigLen {
+		return res, nil
+	}
+	if want, ok := w.approved[digest]; ok && bytes.Equal(want, data[100:100+sigLen]) {
+		copy(res.Ret, []byte{0x16, 0x26, 0xba, 0x7e})
+	}
+	return res, nil
+}
+
+func TestCreateDealFromEvm_ContractWallet(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	wallet := newMockWallet()
+	f.keeper.SetEVMKeeper(wallet)
+
+	for i := 0; i < int(types.DealBaseReplication); i++ {
+		addr, _ := f.addressCodec.BytesToString([]byte("evm_1271_provider_" + string(rune('A'+i))))
+		_, err := msgServer.RegisterProvider(f.ctx, &types.MsgRegisterProvider{
+			Creator:      addr,
+			Capabilities: "General",
+			TotalStorage: 100000000000,
+			Endpoints:    testProviderEndpoints,
+		})
+		require.NoError(t, err)
+	}
+
+	intent := &types.EvmCreateDealIntent{
+		CreatorEvm:      wallet.addr.Hex(),
+		DurationBlocks:  100,
+		ServiceHint:     "General",
+		InitialEscrow:   math.NewInt(1000000),
+		MaxMonthlySpend: math.NewInt(500000),
+		Nonce:           1,
+		ChainId:         sdk.UnwrapSDKContext(f.ctx).ChainID(),
+	}
+	structHash, err := types.HashCreateDeal(intent)
+	require.NoError(t, err)
+	digest := types.ComputeEIP712Digest(types.HashDomainSeparator(eip712DevChainID), structHash)
+	walletSig := []byte("two of three owner signatures")
+	sender, _ := f.addressCodec.BytesToString([]byte("relayer____________"))
+
+	// The wallet has not approved the intent yet. A rejected check still
+	// pays for the gas it used, and one that errors out pays the full cap.
+	sdkCtx := sdk.UnwrapSDKContext(f.ctx)
+	gasBefore := sdkCtx.GasMeter().GasConsumed()
+	_, err = msgServer.CreateDealFromEvm(sdkCtx, &types.MsgCreateDealFromEvm{Sender: sender, Intent: intent, EvmSignature: walletSig})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.GreaterOrEqual(t, sdkCtx.GasMeter().GasConsumed()-gasBefore, wallet.gasUsed)
+
+	wallet.err = errors.New("out of gas")
+	gasBefore = sdkCtx.GasMeter().GasConsumed()
+	_, err = msgServer.CreateDealFromEvm(sdkCtx, &types.MsgCreateDealFromEvm{Sender: sender, Intent: intent, EvmSignature: walletSig})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.GreaterOrEqual(t, sdkCtx.GasMeter().GasConsumed()-gasBefore, uint64(200_000))
+	wallet.err = nil
+
+	wallet.approved[common.BytesToHash(digest)] = walletSig
+	gasBefore = sdkCtx.GasMeter().GasConsumed()
+	res, err := msgServer.CreateDealFromEvm(sdkCtx, &types.MsgCreateDealFromEvm{Sender: sender, Intent: intent, EvmSignature: walletSig})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, sdkCtx.GasMeter().GasConsumed()-gasBefore, wallet.gasUsed)
+
+	deal, err := f.keeper.Deals.Get(f.ctx, res.DealId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(wallet.addr.Bytes()).String(), deal.Owner)
+
+	last := wallet.calls[len(wallet.calls)-1]
+	require.Equal(t, uint64(200_000), last.GasLimit)
+	require.Equal(t, 0, last.Value.Sign())
+}
+
+func TestQueryVerifyEvmSignature(t *testing.T) {
+	f := initFixture(t)
+	q := keeper.NewQueryServerImpl(f.keeper)
+	wallet := newMockWallet()
+
+	digest := gethCrypto.Keccak256([]byte("retrieval request"))
+	walletSig := []byte("wallet signature")
+	wallet.approved[common.BytesToHash(digest)] = walletSig
+
+	privKey, err := gethCrypto.GenerateKey()
+	require.NoError(t, err)
+	eoa := gethCrypto.PubkeyToAddress(privKey.PublicKey)
+	eoaSig, err := gethCrypto.Sign(digest, privKey)
+	require.NoError(t, err)
+
+	// Before the EVM keeper is wired only ECDSA signatures verify.
+	res, err := q.VerifyEvmSignature(f.ctx, &types.QueryVerifyEvmSignatureRequest{Signer: wallet.addr.Hex(), Digest: digest, Signature: walletSig})
+	require.NoError(t, err)
+	require.False(t, res.Valid)
+	require.False(t, res.Contract)
+
+	f.keeper.SetEVMKeeper(wallet)
+
+	for _, tc := range []struct {
+		name     string
+		signer   common.Address
+		sig      []byte
+		valid    bool
+		contract bool
+	}{
+		{"wallet approved", wallet.addr, walletSig, true, true},
+		{"wallet not approved", wallet.addr, []byte("forged"), false, true},
+		{"wallet owner key alone", wallet.addr, eoaSig, false, true},
+		{"eoa", eoa, eoaSig, true, false},
+		{"eoa wrong signer", common.HexToAddress("0x01"), eoaSig, false, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := q.VerifyEvmSignature(f.ctx, &types.QueryVerifyEvmSignatureRequest{Signer: tc.signer.Hex(), Digest: digest, Signature: tc.sig})
+			require.NoError(t, err)
+			require.Equal(t, tc.valid, res.Valid)
+			require.Equal(t, tc.contract, res.Contract)
+		})
+	}
+
+	_, err = q.VerifyEvmSignature(f.ctx, &types.QueryVerifyEvmSignatureRequest{Signer: "nil1notevm", Digest: digest})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = q.VerifyEvmSignature(f.ctx, &types.QueryVerifyEvmSignatureRequest{Signer: eoa.Hex(), Digest: digest[:31]})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -2,13 +2,195 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"nilchain/x/nilchain/types"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
-	return k.Params.Set(ctx, genState.Params)
+	if err := k.Params.Set(ctx, genState.Params); err != nil {
+		return err
+	}
+	if err := k.DealCount.Set(ctx, genState.DealCount); err != nil {
+		return fmt.Errorf("failed to set deal count: %w", err)
+	}
+	if err := k.ProofCount.Set(ctx, genState.ProofCount); err != nil {
+		return fmt.Errorf("failed to set proof count: %w", err)
+	}
+
+	for _, proof := range genState.Proofs {
+		if err := k.Proofs.Set(ctx, proof.Id, proof); err != nil {
+			return fmt.Errorf("failed to set proof %d: %w", proof.Id, err)
+		}
+	}
+	for _, deal := range genState.Deals {
+		if err := k.Deals.Set(ctx, deal.Id, deal); err != nil {
+			return fmt.Errorf("failed to set deal %d: %w", deal.Id, err)
+		}
+		if err := k.indexDeal(ctx, deal); err != nil {
+			return err
+		}
+		if checkDealActive(deal) == nil {
+			if err := k.scheduleDealExpiry(ctx, deal.Id, deal.EndBlock); err != nil {
+				return err
+			}
+			for i, slot := range deal.Mode2Slots {
+				if slot != nil && slot.Status == types.SlotStatus_SLOT_STATUS_REPAIRING && slot.RepairDeadlineHeight != 0 {
+					if err := k.scheduleSlotRepairDeadline(ctx, deal.Id, uint32(i), slot.RepairDeadlineHeight); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	for _, provider := range genState.Providers {
+		if err := k.Providers.Set(ctx, provider.Address, provider); err != nil {
+			return fmt.Errorf("failed to set provider %s: %w", provider.Address, err)
+		}
+	}
+	for _, entry := range genState.DealProviderStatuses {
+		if err := k.DealProviderStatus.Set(ctx, collections.Join(entry.DealId, entry.Provider), entry.Value); err != nil {
+			return fmt.Errorf("failed to set deal provider status: %w", err)
+		}
+	}
+	for _, entry := range genState.DealProviderFailures {
+		if err := k.DealProviderFailures.Set(ctx, collections.Join(entry.DealId, entry.Provider), entry.Value); err != nil {
+			return fmt.Errorf("failed to set deal provider failures: %w", err)
+		}
+	}
+	for _, entry := range genState.DealProviderMissedProofs {
+		if err := k.DealProviderMissedProofs.Set(ctx, collections.Join(entry.DealId, entry.Provider), entry.Value); err != nil {
+			return fmt.Errorf("failed to set deal provider missed proofs: %w", err)
+		}
+	}
+	for _, entry := range genState.ProviderRewards {
+		if err := k.ProviderRewards.Set(ctx, entry.Provider, entry.Amount); err != nil {
+			return fmt.Errorf("failed to set provider rewards: %w", err)
+		}
+	}
+	for _, entry := range genState.ProviderBandwidthRewards {
+		if err := k.ProviderBandwidthRewards.Set(ctx, entry.Provider, entry.Amount); err != nil {
+			return fmt.Errorf("failed to set provider bandwidth rewards: %w", err)
+		}
+	}
+	for _, entry := range genState.ReceiptNonces {
+		if err := k.ReceiptNonces.Set(ctx, entry.Key, entry.Nonce); err != nil {
+			return fmt.Errorf("failed to set receipt nonce: %w", err)
+		}
+	}
+	for _, entry := range genState.ReceiptNoncesByDealFile {
+		if err := k.ReceiptNoncesByDealFile.Set(ctx, collections.Join(entry.DealId, entry.FilePath), entry.Nonce); err != nil {
+			return fmt.Errorf("failed to set receipt nonce by deal file: %w", err)
+		}
+	}
+	for _, entry := range genState.EvmNonces {
+		if err := k.EvmNonces.Set(ctx, entry.EvmAddress, entry.Nonce); err != nil {
+			return fmt.Errorf("failed to set evm nonce: %w", err)
+		}
+	}
+	for _, entry := range genState.DealHeatStates {
+		if err := k.DealHeatStates.Set(ctx, entry.DealId, entry.Heat); err != nil {
+			return fmt.Errorf("failed to set deal heat state: %w", err)
+		}
+	}
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	for _, session := range genState.RetrievalSessions {
+		if err := k.RetrievalSessions.Set(ctx, session.SessionId, session); err != nil {
+			return fmt.Errorf("failed to set retrieval session: %w", err)
+		}
+		if err := k.RetrievalSessionsByDeal.Set(ctx, collections.Join(session.DealId, session.SessionId)); err != nil {
+			return fmt.Errorf("failed to set retrieval session deal index: %w", err)
+		}
+		if err := k.scheduleRetrievalSessionSweep(ctx, session, height, genState.Params.RetrievalSessionRetentionBlocks); err != nil {
+			return err
+		}
+	}
+	for _, entry := range genState.RetrievalSessionsByOwner {
+		if err := k.RetrievalSessionsByOwner.Set(ctx, collections.Join(entry.Address, entry.SessionId), entry.Height); err != nil {
+			return fmt.Errorf("failed to set retrieval session owner index: %w", err)
+		}
+	}
+	for _, entry := range genState.RetrievalSessionsByProvider {
+		if err := k.RetrievalSessionsByProvider.Set(ctx, collections.Join(entry.Address, entry.SessionId), entry.Height); err != nil {
+			return fmt.Errorf("failed to set retrieval session provider index: %w", err)
+		}
+	}
+	for _, entry := range genState.RetrievalSessionNonces {
+		key := collections.Join(collections.Join(entry.Owner, entry.DealId), entry.Provider)
+		if err := k.RetrievalSessionNonces.Set(ctx, key, entry.Nonce); err != nil {
+			return fmt.Errorf("failed to set retrieval session nonce: %w", err)
+		}
+	}
+	for _, entry := range genState.ProofDeadlines {
+		if err := k.SetProofDeadline(ctx, entry.DealId, entry.Provider, entry.DeadlineHeight); err != nil {
+			return err
+		}
+	}
+	for _, entry := range genState.EpochSeeds {
+		if err := k.EpochSeeds.Set(ctx, entry.EpochId, entry.Seed); err != nil {
+			return fmt.Errorf("failed to set epoch seed: %w", err)
+		}
+	}
+	for _, entry := range genState.EpochQuotaStates {
+		if err := k.QuotaStates.Set(ctx, collections.Join3(entry.EpochId, entry.DealId, entry.Provider), entry.State); err != nil {
+			return fmt.Errorf("failed to set quota state: %w", err)
+		}
+	}
+	for _, entry := range genState.CreditSeen {
+		if err := k.CreditSeen.Set(ctx, collections.Join(entry.EpochId, entry.Id)); err != nil {
+			return fmt.Errorf("failed to set credit id: %w", err)
+		}
+	}
+	for _, entry := range genState.SyntheticSeen {
+		if err := k.SyntheticSeen.Set(ctx, collections.Join(entry.EpochId, entry.Id)); err != nil {
+			return fmt.Errorf("failed to set synthetic challenge id: %w", err)
+		}
+	}
+	for _, entry := range genState.ProviderUnbondings {
+		if err := k.addProviderUnbonding(ctx, entry.Provider, entry.CompletionHeight, entry.Amount); err != nil {
+			return err
+		}
+	}
+	for _, entry := range genState.ProviderMigrations {
+		if err := k.setProviderMigration(ctx, entry); err != nil {
+			return err
+		}
+	}
+	for _, grant := range genState.DealAccessGrants {
+		if err := k.DealAccessGrants.Set(ctx, collections.Join(grant.DealId, grant.Grantee), grant); err != nil {
+			return fmt.Errorf("failed to set deal access grant: %w", err)
+		}
+	}
+	for _, placement := range genState.DealPlacements {
+		if err := k.DealPlacements.Set(ctx, placement.DealId, placement); err != nil {
+			return fmt.Errorf("failed to set deal placement: %w", err)
+		}
+	}
+	for _, digest := range genState.FraudProofsSeen {
+		if err := k.FraudProofsSeen.Set(ctx, digest); err != nil {
+			return fmt.Errorf("failed to set fraud proof digest: %w", err)
+		}
+	}
+	if err := k.EvidenceCount.Set(ctx, genState.EvidenceCount); err != nil {
+		return fmt.Errorf("failed to set evidence count: %w", err)
+	}
+	for _, ev := range genState.Evidence {
+		if err := k.setEvidence(ctx, ev); err != nil {
+			return err
+		}
+	}
+	for _, key := range genState.SessionKeys {
+		if err := k.setSessionKey(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -21,6 +203,235 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	genesis.DealCount, err = k.DealCount.Peek(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deal count: %w", err)
+	}
+	genesis.ProofCount, err = k.ProofCount.Peek(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get proof count: %w", err)
+	}
+
+	if err := k.Proofs.Walk(ctx, nil, func(_ uint64, proof types.Proof) (bool, error) {
+		genesis.Proofs = append(genesis.Proofs, proof)
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export proofs: %w", err)
+	}
+	if err := k.Deals.Walk(ctx, nil, func(_ uint64, deal types.Deal) (bool, error) {
+		genesis.Deals = append(genesis.Deals, deal)
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export deals: %w", err)
+	}
+	if err := k.Providers.Walk(ctx, nil, func(_ string, provider types.Provider) (bool, error) {
+		genesis.Providers = append(genesis.Providers, provider)
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export providers: %w", err)
+	}
+	if err := k.DealProviderStatus.Walk(ctx, nil, func(key collections.Pair[uint64, string], value uint64) (bool, error) {
+		genesis.DealProviderStatuses = append(genesis.DealProviderStatuses, types.DealProviderCounter{
+			DealId:   key.K1(),
+			Provider: key.K2(),
+			Value:    value,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export deal provider status: %w", err)
+	}
+	if err := k.DealProviderFailures.Walk(ctx, nil, func(key collections.Pair[uint64, string], value uint64) (bool, error) {
+		genesis.DealProviderFailures = append(genesis.DealProviderFailures, types.DealProviderCounter{
+			DealId:   key.K1(),
+			Provider: key.K2(),
+			Value:    value,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export deal provider failures: %w", err)
+	}
+	if err := k.DealProviderMissedProofs.Walk(ctx, nil, func(key collections.Pair[uint64, string], value uint64) (bool, error) {
+		genesis.DealProviderMissedProofs = append(genesis.DealProviderMissedProofs, types.DealProviderCounter{
+			DealId:   key.K1(),
+			Provider: key.K2(),
+			Value:    value,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export deal provider missed proofs: %w", err)
+	}
+	if err := k.ProviderRewards.Walk(ctx, nil, func(provider string, amount math.Int) (bool, error) {
+		genesis.ProviderRewards = append(genesis.ProviderRewards, types.ProviderRewardEntry{
+			Provider: provider,
+			Amount:   amount,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export provider rewards: %w", err)
+	}
+	if err := k.ProviderBandwidthRewards.Walk(ctx, nil, func(provider string, amount math.Int) (bool, error) {
+		genesis.ProviderBandwidthRewards = append(genesis.ProviderBandwidthRewards, types.ProviderRewardEntry{
+			Provider: provider,
+			Amount:   amount,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export provider bandwidth rewards: %w", err)
+	}
+	if err := k.ReceiptNonces.Walk(ctx, nil, func(key string, nonce uint64) (bool, error) {
+		genesis.ReceiptNonces = append(genesis.ReceiptNonces, types.ReceiptNonceEntry{Key: key, Nonce: nonce})
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export receipt nonces: %w", err)
+	}
+	if err := k.ReceiptNoncesByDealFile.Walk(ctx, nil, func(key collections.Pair[uint64, string], nonce uint64) (bool, error) {
+		genesis.ReceiptNoncesByDealFile = append(genesis.ReceiptNoncesByDealFile, types.DealFileReceiptNonce{
+			DealId:   key.K1(),
+			FilePath: key.K2(),
+			Nonce:    nonce,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export receipt nonces by deal file: %w", err)
+	}
+	if err := k.EvmNonces.Walk(ctx, nil, func(addr string, nonce uint64) (bool, error) {
+		genesis.EvmNonces = append(genesis.EvmNonces, types.EvmNonceEntry{EvmAddress: addr, Nonce: nonce})
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export evm nonces: %w", err)
+	}
+	if err := k.DealHeatStates.Walk(ctx, nil, func(dealID uint64, heat types.DealHeatState) (bool, error) {
+		genesis.DealHeatStates = append(genesis.DealHeatStates, types.DealHeatStateEntry{DealId: dealID, Heat: heat})
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export deal heat states: %w", err)
+	}
+	if err := k.RetrievalSessions.Walk(ctx, nil, func(_ []byte, session types.RetrievalSession) (bool, error) {
+		genesis.RetrievalSessions = append(genesis.RetrievalSessions, session)
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export retrieval sessions: %w", err)
+	}
+	if err := k.RetrievalSessionsByOwner.Walk(ctx, nil, func(key collections.Pair[string, []byte], height uint64) (bool, error) {
+		genesis.RetrievalSessionsByOwner = append(genesis.RetrievalSessionsByOwner, types.RetrievalSessionIndexEntry{
+			Address:   key.K1(),
+			SessionId: key.K2(),
+			Height:    height,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export retrieval session owner index: %w", err)
+	}
+	if err := k.RetrievalSessionsByProvider.Walk(ctx, nil, func(key collections.Pair[string, []byte], height uint64) (bool, error) {
+		genesis.RetrievalSessionsByProvider = append(genesis.RetrievalSessionsByProvider, types.RetrievalSessionIndexEntry{
+			Address:   key.K1(),
+			SessionId: key.K2(),
+			Height:    height,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export retrieval session provider index: %w", err)
+	}
+	if err := k.RetrievalSessionNonces.Walk(ctx, nil, func(key collections.Pair[collections.Pair[string, uint64], string], nonce uint64) (bool, error) {
+		genesis.RetrievalSessionNonces = append(genesis.RetrievalSessionNonces, types.RetrievalSessionNonceEntry{
+			Owner:    key.K1().K1(),
+			DealId:   key.K1().K2(),
+			Provider: key.K2(),
+			Nonce:    nonce,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export retrieval session nonces: %w", err)
+	}
+	if err := k.ProofDeadlines.Walk(ctx, nil, func(key collections.Triple[uint64, uint64, string]) (bool, error) {
+		genesis.ProofDeadlines = append(genesis.ProofDeadlines, types.ProofDeadlineEntry{
+			DeadlineHeight: key.K1(),
+			DealId:         key.K2(),
+			Provider:       key.K3(),
+		})
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export proof deadlines: %w", err)
+	}
+	if err := k.EpochSeeds.Walk(ctx, nil, func(epochID uint64, seed []byte) (bool, error) {
+		genesis.EpochSeeds = append(genesis.EpochSeeds, types.EpochSeedEntry{EpochId: epochID, Seed: seed})
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export epoch seeds: %w", err)
+	}
+	if err := k.QuotaStates.Walk(ctx, nil, func(key collections.Triple[uint64, uint64, string], state types.EpochQuotaState) (bool, error) {
+		genesis.EpochQuotaStates = append(genesis.EpochQuotaStates, types.EpochQuotaStateEntry{
+			EpochId:  key.K1(),
+			DealId:   key.K2(),
+			Provider: key.K3(),
+			State:    state,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export quota states: %w", err)
+	}
+	if err := k.CreditSeen.Walk(ctx, nil, func(key collections.Pair[uint64, []byte]) (bool, error) {
+		genesis.CreditSeen = append(genesis.CreditSeen, types.EpochSeenEntry{EpochId: key.K1(), Id: key.K2()})
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export credit ids: %w", err)
+	}
+	if err := k.SyntheticSeen.Walk(ctx, nil, func(key collections.Pair[uint64, []byte]) (bool, error) {
+		genesis.SyntheticSeen = append(genesis.SyntheticSeen, types.EpochSeenEntry{EpochId: key.K1(), Id: key.K2()})
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export synthetic challenge ids: %w", err)
+	}
+	if err := k.ProviderUnbondings.Walk(ctx, nil, func(key collections.Pair[string, uint64], amount sdk.Coin) (bool, error) {
+		genesis.ProviderUnbondings = append(genesis.ProviderUnbondings, types.ProviderUnbonding{
+			Provider:         key.K1(),
+			CompletionHeight: key.K2(),
+			Amount:           amount,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export provider unbondings: %w", err)
+	}
+	if err := k.ProviderMigrations.Walk(ctx, nil, func(_ collections.Pair[string, uint64], migration types.ProviderMigration) (bool, error) {
+		genesis.ProviderMigrations = append(genesis.ProviderMigrations, migration)
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export provider migrations: %w", err)
+	}
+	if err := k.DealAccessGrants.Walk(ctx, nil, func(_ collections.Pair[uint64, string], grant types.DealAccessGrant) (bool, error) {
+		genesis.DealAccessGrants = append(genesis.DealAccessGrants, grant)
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export deal access grants: %w", err)
+	}
+	if err := k.DealPlacements.Walk(ctx, nil, func(_ uint64, placement types.DealPlacement) (bool, error) {
+		genesis.DealPlacements = append(genesis.DealPlacements, placement)
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export deal placements: %w", err)
+	}
+	if err := k.FraudProofsSeen.Walk(ctx, nil, func(digest []byte) (bool, error) {
+		genesis.FraudProofsSeen = append(genesis.FraudProofsSeen, digest)
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export fraud proof digests: %w", err)
+	}
+	genesis.EvidenceCount, err = k.EvidenceCount.Peek(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get evidence count: %w", err)
+	}
+	if err := k.Evidence.Walk(ctx, nil, func(_ uint64, ev types.Evidence) (bool, error) {
+		genesis.Evidence = append(genesis.Evidence, ev)
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export evidence: %w", err)
+	}
+	if err := k.SessionKeys.Walk(ctx, nil, func(_ string, key types.SessionKey) (bool, error) {
+		genesis.SessionKeys = append(genesis.SessionKeys, key)
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export session keys: %w", err)
+	}
 
 	return genesis, nil
 }
@@ -1,8 +1,13 @@
 package keeper_test
 
 import (
+	"bytes"
 	"testing"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"nilchain/x/nilchain/types"
 
 	"github.com/stretchr/testify/require"
@@ -11,7 +16,6 @@ import (
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
-		
 	}
 
 	f := initFixture(t)
@@ -21,6 +25,112 @@ func TestGenesis(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, got)
 
-	
 	require.EqualExportedValues(t, genesisState.Params, got.Params)
-}
\ No newline at end of file
+}
+
+func TestGenesisRoundTripFullState(t *testing.T) {
+	owner := "nil1owner"
+	providerA := "nil1providera"
+	providerB := "nil1providerb"
+	sessionID := bytes.Repeat([]byte{0xab}, 32)
+
+	genesisState := types.GenesisState{
+		Params:     types.DefaultParams(),
+		DealCount:  3,
+		ProofCount: 2,
+		Proofs: []types.Proof{
+			{Id: 0, Creator: providerA, Commitment: "c0", Valid: true, BlockHeight: 5},
+			{Id: 1, Creator: providerB, Commitment: "c1", Valid: false, BlockHeight: 6},
+		},
+		Deals: []types.Deal{
+			{
+				Id:               1,
+				Owner:            owner,
+				EscrowBalance:    math.NewInt(1000),
+				MaxMonthlySpend:  math.NewInt(10),
+				SpendWindowSpent: math.ZeroInt(),
+				StartBlock:       1,
+				EndBlock:         100,
+				Providers:        []string{providerA, providerB},
+				RedundancyMode:   1,
+				ServiceHint:      "General",
+			},
+		},
+		Providers: []types.Provider{
+			{Address: providerA, TotalStorage: 1 << 30, Capabilities: "General", Status: "Active", Bond: sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000)},
+			{Address: providerB, TotalStorage: 1 << 30, Capabilities: "General", Status: "Jailed", Bond: sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)},
+		},
+		DealProviderStatuses: []types.DealProviderCounter{{DealId: 1, Provider: providerA, Value: 42}},
+		DealProviderFailures: []types.DealProviderCounter{{DealId: 1, Provider: providerB, Value: 2}},
+		ProviderRewards:      []types.ProviderRewardEntry{{Provider: providerA, Amount: math.NewInt(77)}},
+		ReceiptNonces:        []types.ReceiptNonceEntry{{Key: "legacy", Nonce: 4}},
+		ReceiptNoncesByDealFile: []types.DealFileReceiptNonce{
+			{DealId: 1, FilePath: "a.txt", Nonce: 9},
+		},
+		EvmNonces:      []types.EvmNonceEntry{{EvmAddress: "0x00000000000000000000000000000000000000aa", Nonce: 3}},
+		DealHeatStates: []types.DealHeatStateEntry{{DealId: 1, Heat: types.DealHeatState{BytesServedTotal: 128, LastUpdateHeight: 7}}},
+		RetrievalSessions: []types.RetrievalSession{
+			{
+				SessionId: sessionID,
+				DealId:    1,
+				Owner:     owner,
+				Provider:  providerA,
+				BlobCount: 1,
+				Nonce:     1,
+				Status:    types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_OPEN,
+				LockedFee: math.NewInt(5),
+			},
+		},
+		RetrievalSessionsByOwner:    []types.RetrievalSessionIndexEntry{{Address: owner, SessionId: sessionID, Height: 10}},
+		RetrievalSessionsByProvider: []types.RetrievalSessionIndexEntry{{Address: providerA, SessionId: sessionID, Height: 10}},
+		RetrievalSessionNonces: []types.RetrievalSessionNonceEntry{
+			{Owner: owner, DealId: 1, Provider: providerA, Nonce: 1},
+		},
+		ProofDeadlines: []types.ProofDeadlineEntry{
+			{DeadlineHeight: 11, DealId: 1, Provider: providerB},
+			{DeadlineHeight: 53, DealId: 1, Provider: providerA},
+		},
+		EpochSeeds: []types.EpochSeedEntry{{EpochId: 4, Seed: bytes.Repeat([]byte{0x04}, 32)}},
+		EpochQuotaStates: []types.EpochQuotaStateEntry{
+			{EpochId: 4, DealId: 1, Provider: providerA, State: types.EpochQuotaState{CreditsBlobs: 2, SyntheticSatisfiedBlobs: 1}},
+		},
+		CreditSeen:    []types.EpochSeenEntry{{EpochId: 4, Id: bytes.Repeat([]byte{0xc1}, 32)}},
+		SyntheticSeen: []types.EpochSeenEntry{{EpochId: 4, Id: bytes.Repeat([]byte{0x51}, 32)}},
+		ProviderUnbondings: []types.ProviderUnbonding{
+			{Provider: providerA, CompletionHeight: 120, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)},
+		},
+		ProviderMigrations: []types.ProviderMigration{
+			{Provider: providerB, DealId: 1, Slot: 1, Replacement: "nil1providerc", CompletionHeight: 110},
+		},
+		EvidenceCount: 2,
+		Evidence: []types.Evidence{
+			{Id: 0, Kind: types.EvidenceKind_EVIDENCE_KIND_RETRIEVAL_NON_RESPONSE, DealId: 1, Provider: providerA, SessionId: sessionID, Reporter: owner, Height: 8, Outcome: types.EvidenceOutcome_EVIDENCE_OUTCOME_HEALTH_FAILURE},
+			{Id: 1, Kind: types.EvidenceKind_EVIDENCE_KIND_FRAUD_INVALID_PROOF, DealId: 1, Provider: providerB, Reporter: owner, Height: 9, Outcome: types.EvidenceOutcome_EVIDENCE_OUTCOME_SLASHED_AND_JAILED},
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	f := initFixture(t)
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, genesisState))
+	got, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.NoError(t, got.Validate())
+	require.EqualExportedValues(t, genesisState, *got)
+
+	// Sequences continue from the imported counters.
+	next, err := f.keeper.DealCount.Next(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), next)
+
+	// Evidence indexes are rebuilt on import.
+	has, err := f.keeper.EvidenceByProvider.Has(f.ctx, collections.Join(providerB, uint64(1)))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// Re-importing the export into a fresh store is lossless.
+	f2 := initFixture(t)
+	require.NoError(t, f2.keeper.InitGenesis(f2.ctx, *got))
+	again, err := f2.keeper.ExportGenesis(f2.ctx)
+	require.NoError(t, err)
+	require.EqualExportedValues(t, *got, *again)
+}
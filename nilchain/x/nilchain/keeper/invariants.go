@@ -0,0 +1,355 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"nilchain/x/nilchain/types"
+)
+
+// RegisterInvariants registers the nilchain module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account-solvency", ModuleAccountSolvencyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "retrieval-session-indexes", RetrievalSessionIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "evidence-indexes", EvidenceIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "deal-indexes", DealIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "deal-providers", DealProvidersInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "provider-storage", ProviderStorageInvariant(k))
+}
+
+// AllInvariants runs every nilchain invariant and stops at the first broken
+// one.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			ModuleAccountSolvencyInvariant(k),
+			RetrievalSessionIndexInvariant(k),
+			EvidenceIndexInvariant(k),
+			DealIndexInvariant(k),
+			DealProvidersInvariant(k),
+			ProviderStorageInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// ModuleAccountSolvencyInvariant checks that the module account holds at
+// least the coins it owes: deal escrow, fees locked in retrieval sessions,
+// unpaid bandwidth rewards, held slot repair bounties, provider bonds and
+// bonds waiting out their unbonding period. Storage rewards are minted on
+// withdrawal and are not counted.
+func ModuleAccountSolvencyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "module-account-solvency", fmt.Sprintf("failed to load params: %s", err)), true
+		}
+		denom := params.MinProviderBond.Denom
+		escrow, locked, bandwidth, bounties := math.ZeroInt(), math.ZeroInt(), math.ZeroInt(), math.ZeroInt()
+		bonds, unbonding := math.ZeroInt(), math.ZeroInt()
+
+		if err := k.Deals.Walk(ctx, nil, func(_ uint64, deal types.Deal) (bool, error) {
+			escrow = escrow.Add(positiveOrZero(deal.EscrowBalance))
+			for _, slot := range deal.Mode2Slots {
+				if slot != nil && hasRepairBounty(slot) {
+					bounties = bounties.Add(coinAmount(slot.RepairBounty, denom))
+				}
+			}
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "module-account-solvency", fmt.Sprintf("failed to walk deals: %s", err)), true
+		}
+		if err := k.RetrievalSessions.Walk(ctx, nil, func(_ []byte, session types.RetrievalSession) (bool, error) {
+			locked = locked.Add(positiveOrZero(session.LockedFee))
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "module-account-solvency", fmt.Sprintf("failed to walk retrieval sessions: %s", err)), true
+		}
+		if err := k.ProviderBandwidthRewards.Walk(ctx, nil, func(_ string, amount math.Int) (bool, error) {
+			bandwidth = bandwidth.Add(positiveOrZero(amount))
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "module-account-solvency", fmt.Sprintf("failed to walk bandwidth rewards: %s", err)), true
+		}
+		if err := k.Providers.Walk(ctx, nil, func(_ string, provider types.Provider) (bool, error) {
+			bonds = bonds.Add(coinAmount(provider.Bond, denom))
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "module-account-solvency", fmt.Sprintf("failed to walk providers: %s", err)), true
+		}
+		if err := k.ProviderUnbondings.Walk(ctx, nil, func(_ collections.Pair[string, uint64], amount sdk.Coin) (bool, error) {
+			unbonding = unbonding.Add(coinAmount(amount, denom))
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "module-account-solvency", fmt.Sprintf("failed to walk provider unbondings: %s", err)), true
+		}
+
+		owed := escrow.Add(locked).Add(bandwidth).Add(bounties).Add(bonds).Add(unbonding)
+		held := k.BankKeeper.SpendableCoins(ctx, authtypes.NewModuleAddress(types.ModuleName)).AmountOf(denom)
+		broken := held.LT(owed)
+
+		return sdk.FormatInvariant(types.ModuleName, "module-account-solvency", fmt.Sprintf(
+			"module account holds %s%s; owes %s%s (escrow %s, locked retrieval fees %s, unpaid bandwidth rewards %s, repair bounties %s, provider bonds %s, unbonding bonds %s)\n",
+			held, denom, owed, denom, escrow, locked, bandwidth, bounties, bonds, unbonding,
+		)), broken
+	}
+}
+
+// RetrievalSessionIndexInvariant checks that every RetrievalSessionsByOwner,
+// RetrievalSessionsByProvider and RetrievalSessionsByDeal entry points to an
+// existing session with the indexed owner, provider or deal.
+func RetrievalSessionIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		check := func(index string, id []byte, matches func(types.RetrievalSession) bool) error {
+			session, err := k.RetrievalSessions.Get(ctx, id)
+			if err != nil {
+				msg += fmt.Sprintf("\t%s entry for session %x has no session: %s\n", index, id, err)
+				count++
+				return nil
+			}
+			if !bytes.Equal(session.SessionId, id) || !matches(session) {
+				msg += fmt.Sprintf("\t%s entry for session %x does not match the session\n", index, id)
+				count++
+			}
+			return nil
+		}
+
+		if err := k.RetrievalSessionsByOwner.Walk(ctx, nil, func(key collections.Pair[string, []byte], _ uint64) (bool, error) {
+			return false, check("owner index", key.K2(), func(s types.RetrievalSession) bool { return s.Owner == key.K1() })
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "retrieval-session-indexes", fmt.Sprintf("failed to walk owner index: %s", err)), true
+		}
+		if err := k.RetrievalSessionsByProvider.Walk(ctx, nil, func(key collections.Pair[string, []byte], _ uint64) (bool, error) {
+			return false, check("provider index", key.K2(), func(s types.RetrievalSession) bool { return s.Provider == key.K1() })
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "retrieval-session-indexes", fmt.Sprintf("failed to walk provider index: %s", err)), true
+		}
+		if err := k.RetrievalSessionsByDeal.Walk(ctx, nil, func(key collections.Pair[uint64, []byte]) (bool, error) {
+			return false, check("deal index", key.K2(), func(s types.RetrievalSession) bool { return s.DealId == key.K1() })
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "retrieval-session-indexes", fmt.Sprintf("failed to walk deal index: %s", err)), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "retrieval-session-indexes", fmt.Sprintf(
+			"found %d dangling retrieval session index entries\n%s", count, msg,
+		)), count != 0
+	}
+}
+
+// EvidenceIndexInvariant checks that every evidence entry is indexed by its
+// provider and deal, and that every index entry points to matching evidence.
+func EvidenceIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		if err := k.Evidence.Walk(ctx, nil, func(id uint64, ev types.Evidence) (bool, error) {
+			byProvider, err := k.EvidenceByProvider.Has(ctx, collections.Join(ev.Provider, id))
+			if err != nil {
+				return true, err
+			}
+			byDeal, err := k.EvidenceByDeal.Has(ctx, collections.Join(ev.DealId, id))
+			if err != nil {
+				return true, err
+			}
+			if ev.Id != id || !byProvider || !byDeal {
+				msg += fmt.Sprintf("\tevidence %d is not indexed by its provider and deal\n", id)
+				count++
+			}
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "evidence-indexes", fmt.Sprintf("failed to walk evidence: %s", err)), true
+		}
+
+		check := func(index string, id uint64, matches func(types.Evidence) bool) {
+			ev, err := k.Evidence.Get(ctx, id)
+			if err != nil || !matches(ev) {
+				msg += fmt.Sprintf("\t%s entry for evidence %d does not match any evidence\n", index, id)
+				count++
+			}
+		}
+		if err := k.EvidenceByProvider.Walk(ctx, nil, func(key collections.Pair[string, uint64]) (bool, error) {
+			check("provider index", key.K2(), func(ev types.Evidence) bool { return ev.Provider == key.K1() })
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "evidence-indexes", fmt.Sprintf("failed to walk provider index: %s", err)), true
+		}
+		if err := k.EvidenceByDeal.Walk(ctx, nil, func(key collections.Pair[uint64, uint64]) (bool, error) {
+			check("deal index", key.K2(), func(ev types.Evidence) bool { return ev.DealId == key.K1() })
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "evidence-indexes", fmt.Sprintf("failed to walk deal index: %s", err)), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "evidence-indexes", fmt.Sprintf(
+			"found %d inconsistent evidence entries\n%s", count, msg,
+		)), count != 0
+	}
+}
+
+// DealIndexInvariant checks that every deal is indexed by its owner and by
+// each provider in its Providers list, and that every DealsByOwner and
+// DealsByProvider entry points to a deal with that owner or provider.
+func DealIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		if err := k.Deals.Walk(ctx, nil, func(id uint64, deal types.Deal) (bool, error) {
+			byOwner, err := k.DealsByOwner.Has(ctx, collections.Join(deal.Owner, id))
+			if err != nil {
+				return true, err
+			}
+			if !byOwner {
+				msg += fmt.Sprintf("\tdeal %d is not indexed by its owner %s\n", id, deal.Owner)
+				count++
+			}
+			for _, provider := range deal.Providers {
+				byProvider, err := k.DealsByProvider.Has(ctx, collections.Join(provider, id))
+				if err != nil {
+					return true, err
+				}
+				if !byProvider {
+					msg += fmt.Sprintf("\tdeal %d is not indexed by its provider %s\n", id, provider)
+					count++
+				}
+			}
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "deal-indexes", fmt.Sprintf("failed to walk deals: %s", err)), true
+		}
+
+		check := func(index string, id uint64, matches func(types.Deal) bool) {
+			deal, err := k.Deals.Get(ctx, id)
+			if err != nil || !matches(deal) {
+				msg += fmt.Sprintf("\t%s entry for deal %d does not match the deal\n", index, id)
+				count++
+			}
+		}
+		if err := k.DealsByOwner.Walk(ctx, nil, func(key collections.Pair[string, uint64]) (bool, error) {
+			check("owner index", key.K2(), func(deal types.Deal) bool { return deal.Owner == key.K1() })
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "deal-indexes", fmt.Sprintf("failed to walk owner index: %s", err)), true
+		}
+		if err := k.DealsByProvider.Walk(ctx, nil, func(key collections.Pair[string, uint64]) (bool, error) {
+			check("provider index", key.K2(), func(deal types.Deal) bool { return containsString(deal.Providers, key.K1()) })
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "deal-indexes", fmt.Sprintf("failed to walk provider index: %s", err)), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "deal-indexes", fmt.Sprintf(
+			"found %d inconsistent deal index entries\n%s", count, msg,
+		)), count != 0
+	}
+}
+
+// DealProvidersInvariant checks that every provider assigned to an unended
+// deal, including Mode 2 slot and pending slot providers, is registered, and
+// that Mode 2 slots line up with the deal's Providers list. Ended deals keep
+// their provider list as a record and are skipped.
+func DealProvidersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		registered := func(addr string) bool {
+			has, err := k.Providers.Has(ctx, addr)
+			return err == nil && has
+		}
+		report := func(format string, args ...any) {
+			msg += "\t" + fmt.Sprintf(format, args...) + "\n"
+			count++
+		}
+
+		if err := k.Deals.Walk(ctx, nil, func(id uint64, deal types.Deal) (bool, error) {
+			if checkDealActive(deal) != nil {
+				return false, nil
+			}
+			for _, p := range deal.Providers {
+				if !registered(p) {
+					report("deal %d provider %s is not registered", id, p)
+				}
+			}
+			if deal.RedundancyMode != 2 || len(deal.Mode2Slots) == 0 {
+				return false, nil
+			}
+			if len(deal.Mode2Slots) != len(deal.Providers) {
+				report("deal %d has %d mode2 slots for %d providers", id, len(deal.Mode2Slots), len(deal.Providers))
+				return false, nil
+			}
+			for i, slot := range deal.Mode2Slots {
+				switch {
+				case slot == nil:
+					report("deal %d slot %d is nil", id, i)
+				case slot.Slot != uint32(i):
+					report("deal %d slot at index %d is numbered %d", id, i, slot.Slot)
+				case slot.Provider != deal.Providers[i]:
+					report("deal %d slot %d provider %s differs from providers[%d] %s", id, i, slot.Provider, i, deal.Providers[i])
+				case slot.PendingProvider != "" && !registered(slot.PendingProvider):
+					report("deal %d slot %d pending provider %s is not registered", id, i, slot.PendingProvider)
+				}
+			}
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "deal-providers", fmt.Sprintf("failed to walk deals: %s", err)), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "deal-providers", fmt.Sprintf(
+			"found %d deal provider inconsistencies\n%s", count, msg,
+		)), count != 0
+	}
+}
+
+// ProviderStorageInvariant checks that no provider's used and reserved
+// storage together exceed its total storage.
+func ProviderStorageInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		if err := k.Providers.Walk(ctx, nil, func(addr string, provider types.Provider) (bool, error) {
+			held, overflow := addUint64(provider.UsedStorage, provider.ReservedStorage)
+			if overflow || held > provider.TotalStorage {
+				msg += fmt.Sprintf("\tprovider %s uses %d and reserves %d of %d bytes\n", addr, provider.UsedStorage, provider.ReservedStorage, provider.TotalStorage)
+				count++
+			}
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "provider-storage", fmt.Sprintf("failed to walk providers: %s", err)), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "provider-storage", fmt.Sprintf(
+			"found %d providers over capacity\n%s", count, msg,
+		)), count != 0
+	}
+}
+
+func positiveOrZero(amount math.Int) math.Int {
+	if amount.IsNil() || !amount.IsPositive() {
+		return math.ZeroInt()
+	}
+	return amount
+}
+
+// coinAmount returns coin's amount if it is a positive amount of denom, or
+// zero.
+func coinAmount(coin sdk.Coin, denom string) math.Int {
+	if coin.Denom != denom {
+		return math.ZeroInt()
+	}
+	return positiveOrZero(coin.Amount)
+}
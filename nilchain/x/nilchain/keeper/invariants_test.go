@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+func TestInvariants(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	owner := sdk.AccAddress([]byte("invariant_owner_____"))
+	ctx, deal := setupExpiringDeal(t, bank, f, owner, 40, 1000)
+	lockRetrievalSession(t, f, ctx, &deal, 0xb1, deal.Providers[0], types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_OPEN, 10)
+
+	all := keeper.AllInvariants(f.keeper)
+	msg, broken := all(ctx)
+	require.False(t, broken, msg)
+
+	t.Run("locked fees without coins", func(t *testing.T) {
+		saved := bank.moduleBalances[types.ModuleName]
+		defer func() { bank.moduleBalances[types.ModuleName] = saved }()
+		// Escrow plus the locked fee is 1000; the module account falls one short.
+		bank.moduleBalances[types.ModuleName] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 999))
+		_, broken := keeper.ModuleAccountSolvencyInvariant(f.keeper)(ctx)
+		require.True(t, broken)
+	})
+
+	t.Run("bonds covering an escrow deficit", func(t *testing.T) {
+		saved := bank.moduleBalances[types.ModuleName]
+		defer func() { bank.moduleBalances[types.ModuleName] = saved }()
+		// The module account holds exactly the provider bonds and pending
+		// unbondings, so escrow and the locked fee are not backed.
+		unbondingProvider := deal.Providers[0]
+		unbondingKey := collections.Join(unbondingProvider, uint64(1000))
+		require.NoError(t, f.keeper.ProviderUnbondings.Set(ctx, unbondingKey, sdk.NewInt64Coin(sdk.DefaultBondDenom, 7)))
+		defer func() { require.NoError(t, f.keeper.ProviderUnbondings.Remove(ctx, unbondingKey)) }()
+		collateral := math.NewInt(7)
+		require.NoError(t, f.keeper.Providers.Walk(ctx, nil, func(_ string, provider types.Provider) (bool, error) {
+			collateral = collateral.Add(provider.Bond.Amount)
+			return false, nil
+		}))
+		require.True(t, collateral.GT(math.NewInt(1000)))
+		bank.moduleBalances[types.ModuleName] = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, collateral))
+		msg, broken := keeper.ModuleAccountSolvencyInvariant(f.keeper)(ctx)
+		require.True(t, broken, msg)
+		require.Contains(t, msg, "provider bonds")
+
+		bank.moduleBalances[types.ModuleName] = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, collateral.AddRaw(1000)))
+		msg, broken = keeper.ModuleAccountSolvencyInvariant(f.keeper)(ctx)
+		require.False(t, broken, msg)
+	})
+
+	t.Run("dangling session index", func(t *testing.T) {
+		key := collections.Join(deal.Providers[1], make([]byte, 32))
+		require.NoError(t, f.keeper.RetrievalSessionsByProvider.Set(ctx, key, 1))
+		defer func() { require.NoError(t, f.keeper.RetrievalSessionsByProvider.Remove(ctx, key)) }()
+		_, broken := keeper.RetrievalSessionIndexInvariant(f.keeper)(ctx)
+		require.True(t, broken)
+		_, broken = all(ctx)
+		require.True(t, broken)
+	})
+
+	t.Run("unregistered deal provider", func(t *testing.T) {
+		provider, err := f.keeper.Providers.Get(ctx, deal.Providers[2])
+		require.NoError(t, err)
+		require.NoError(t, f.keeper.Providers.Remove(ctx, deal.Providers[2]))
+		defer func() { require.NoError(t, f.keeper.Providers.Set(ctx, provider.Address, provider)) }()
+		_, broken := keeper.DealProvidersInvariant(f.keeper)(ctx)
+		require.True(t, broken)
+	})
+
+	t.Run("mode2 slot disagrees with providers", func(t *testing.T) {
+		stored, err := f.keeper.Deals.Get(ctx, deal.Id)
+		require.NoError(t, err)
+		defer func() { require.NoError(t, f.keeper.Deals.Set(ctx, deal.Id, stored)) }()
+
+		mode2 := stored
+		mode2.RedundancyMode = 2
+		mode2.Mode2Slots = make([]*types.DealSlot, len(stored.Providers))
+		for i, p := range stored.Providers {
+			mode2.Mode2Slots[i] = &types.DealSlot{Slot: uint32(i), Provider: p}
+		}
+		require.NoError(t, f.keeper.Deals.Set(ctx, deal.Id, mode2))
+		_, broken := keeper.DealProvidersInvariant(f.keeper)(ctx)
+		require.False(t, broken)
+
+		mode2.Mode2Slots[3] = &types.DealSlot{Slot: 3, Provider: stored.Providers[4]}
+		require.NoError(t, f.keeper.Deals.Set(ctx, deal.Id, mode2))
+		_, broken = keeper.DealProvidersInvariant(f.keeper)(ctx)
+		require.True(t, broken)
+	})
+
+	t.Run("provider over capacity", func(t *testing.T) {
+		provider, err := f.keeper.Providers.Get(ctx, deal.Providers[0])
+		require.NoError(t, err)
+		defer func() { require.NoError(t, f.keeper.Providers.Set(ctx, provider.Address, provider)) }()
+		over := provider
+		over.UsedStorage = over.TotalStorage + 1
+		require.NoError(t, f.keeper.Providers.Set(ctx, provider.Address, over))
+		_, broken := keeper.ProviderStorageInvariant(f.keeper)(ctx)
+		require.True(t, broken)
+	})
+
+	msg, broken = all(ctx)
+	require.False(t, broken, msg)
+}
@@ -1,9 +1,7 @@
 package keeper
 
 import (
-	"crypto/sha256"   // ADDED
-	"encoding/binary" // ADDED
-	"errors"          // ADDED
+	"errors" // ADDED
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -26,6 +24,11 @@ type Keeper struct {
 	BankKeeper    types.BankKeeper
 	AccountKeeper types.AuthKeeper
 
+	// evm is wired by the app once the EVM keeper exists, which itself needs
+	// this keeper for the NilStore precompile. It is a pointer so every copy
+	// of the keeper sees it.
+	evm *evmKeeperRef
+
 	Schema     collections.Schema
 	Params     collections.Item[types.Params]
 	ProofCount collections.Sequence
@@ -47,6 +50,87 @@ type Keeper struct {
 	RetrievalSessionsByOwner    collections.Map[collections.Pair[string, []byte], uint64]
 	RetrievalSessionsByProvider collections.Map[collections.Pair[string, []byte], uint64]
 	RetrievalSessionNonces      collections.Map[collections.Pair[collections.Pair[string, uint64], string], uint64]
+
+	// ProofDeadlines is an ordered (deadline_height, deal_id, provider) queue
+	// consumed by CheckMissedProofs; ProofDeadlinesByDealProvider is its reverse
+	// lookup so a pair can be rescheduled without scanning the queue.
+	ProofDeadlines               collections.KeySet[collections.Triple[uint64, uint64, string]]
+	ProofDeadlinesByDealProvider collections.Map[collections.Pair[uint64, string], uint64]
+
+	// Unified liveness state (rfcs/rfc-challenge-derivation-and-quotas.md §7).
+	// Everything is keyed by epoch first so stale epochs can be range-pruned.
+	EpochSeeds    collections.Map[uint64, []byte]
+	QuotaStates   collections.Map[collections.Triple[uint64, uint64, string], types.EpochQuotaState]
+	CreditSeen    collections.KeySet[collections.Pair[uint64, []byte]]
+	SyntheticSeen collections.KeySet[collections.Pair[uint64, []byte]]
+
+	// ProviderUnbondings holds bond withdrawals waiting out the unbonding
+	// period, keyed by (provider, completion_height). ProviderUnbondingQueue
+	// orders the same entries by height for CompleteProviderUnbondings.
+	ProviderUnbondings     collections.Map[collections.Pair[string, uint64], sdk.Coin]
+	ProviderUnbondingQueue collections.KeySet[collections.Pair[uint64, string]]
+
+	// ProviderMigrations holds the in-flight assignment handovers of
+	// deregistering providers, keyed by (provider, deal_id).
+	// ProviderMigrationQueue orders them by completion height.
+	ProviderMigrations     collections.Map[collections.Pair[string, uint64], types.ProviderMigration]
+	ProviderMigrationQueue collections.KeySet[collections.Triple[uint64, string, uint64]]
+
+	// DealExpiryQueue orders active deals by (end_block, deal_id) for ExpireDeals.
+	DealExpiryQueue collections.KeySet[collections.Pair[uint64, uint64]]
+
+	// DealAccessGrants holds delegated read access keyed by (deal_id, grantee).
+	DealAccessGrants collections.Map[collections.Pair[uint64, string], types.DealAccessGrant]
+	// RetrievalSessionsByDeal indexes every session of a deal whoever opened
+	// it. It is derived from RetrievalSessions and rebuilt on genesis import.
+	RetrievalSessionsByDeal collections.KeySet[collections.Pair[uint64, []byte]]
+
+	// RetrievalSessionSweepQueue orders sessions by (height, session_id) for
+	// SweepRetrievalSessions: open sessions at their expiry height, finished
+	// ones at the end of their retention window. Rebuilt on genesis import.
+	RetrievalSessionSweepQueue collections.KeySet[collections.Pair[uint64, []byte]]
+
+	// ProviderRewards (above) holds inflation-funded storage rewards, minted on
+	// withdrawal. ProviderBandwidthRewards holds retrieval income already
+	// debited from deal escrow, so its coins sit in the module account.
+	ProviderBandwidthRewards collections.Map[string, math.Int]
+
+	// DealPlacements explains how each deal's providers were picked.
+	DealPlacements collections.Map[uint64, types.DealPlacement]
+
+	// DealProviderMissedProofs counts consecutive missed proof windows per
+	// (deal, provider); a valid proof resets it.
+	DealProviderMissedProofs collections.Map[collections.Pair[uint64, string], uint64]
+
+	// SlotRepairQueue orders repairing Mode 2 slots by (deadline_height,
+	// deal_id, slot) for ReassignOverdueSlotRepairs. Rebuilt on genesis import.
+	SlotRepairQueue collections.KeySet[collections.Triple[uint64, uint64, uint32]]
+
+	// FraudProofsSeen records the digests of provider responses already proven
+	// wrong, so each response is punished once.
+	FraudProofsSeen collections.KeySet[[]byte]
+
+	// Evidence is the registry of provider misbehaviour. EvidenceByProvider
+	// and EvidenceByDeal index it by (provider, id) and (deal_id, id); both
+	// are rebuilt on genesis import.
+	EvidenceCount      collections.Sequence
+	Evidence           collections.Map[uint64, types.Evidence]
+	EvidenceByProvider collections.KeySet[collections.Pair[string, uint64]]
+	EvidenceByDeal     collections.KeySet[collections.Pair[uint64, uint64]]
+
+	// DealsByOwner and DealsByProvider index deals by (owner, deal_id) and by
+	// (provider, deal_id) for every provider in a deal's Providers list. They
+	// are derived from Deals and rebuilt on genesis import.
+	DealsByOwner    collections.KeySet[collections.Pair[string, uint64]]
+	DealsByProvider collections.KeySet[collections.Pair[string, uint64]]
+
+	// SessionKeys holds owners' short-lived signing keys keyed by the key's
+	// address. SessionKeysByOwner indexes them by (owner, key) and
+	// SessionKeyExpiryQueue orders them by (expires_at, key) for
+	// ExpireSessionKeys; both are rebuilt on genesis import.
+	SessionKeys           collections.Map[string, types.SessionKey]
+	SessionKeysByOwner    collections.KeySet[collections.Pair[string, string]]
+	SessionKeyExpiryQueue collections.KeySet[collections.Pair[uint64, string]]
 }
 
 func NewKeeper(
@@ -71,6 +155,7 @@ func NewKeeper(
 		authority:     authority,
 		BankKeeper:    bankKeeper,
 		AccountKeeper: accountKeeper,
+		evm:           &evmKeeperRef{},
 
 		Params:     collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		ProofCount: collections.NewSequence(sb, types.ProofCountKey, "proof_count"),
@@ -97,106 +182,99 @@ func NewKeeper(
 				collections.PairKeyCodec(collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), collections.StringKey),
 				collections.Uint64Value,
 			),
-		}
 
-	schema, err := sb.Build()
-	if err != nil {
-		panic(err)
-	}
-	k.Schema = schema
+			ProofDeadlines: collections.NewKeySet(
+				sb,
+				types.ProofDeadlinesKey,
+				"proof_deadlines",
+				collections.TripleKeyCodec(collections.Uint64Key, collections.Uint64Key, collections.StringKey),
+			),
+			ProofDeadlinesByDealProvider: collections.NewMap(sb, types.ProofDeadlinesByDealProviderKey, "proof_deadlines_by_deal_provider", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.Uint64Value),
 
-	return k
-}
+			EpochSeeds: collections.NewMap(sb, types.EpochSeedsKey, "epoch_seeds", collections.Uint64Key, collections.BytesValue),
+			QuotaStates: collections.NewMap(
+				sb,
+				types.QuotaStatesKey,
+				"quota_states",
+				collections.TripleKeyCodec(collections.Uint64Key, collections.Uint64Key, collections.StringKey),
+				codec.CollValue[types.EpochQuotaState](cdc),
+			),
+			CreditSeen:    collections.NewKeySet(sb, types.CreditSeenKey, "credit_seen", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey)),
+			SyntheticSeen: collections.NewKeySet(sb, types.SyntheticSeenKey, "synthetic_seen", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey)),
 
-// AssignProviders deterministically assigns providers for a new deal.
-// It uses a hash-based approach to select `types.DealBaseReplication` providers
-// from the active provider list, respecting service hints and diversity constraints.
-func (k Keeper) AssignProviders(ctx sdk.Context, dealID uint64, blockHash []byte, serviceHint string, count uint64) ([]string, error) {
-	var allProviders []types.Provider
-
-	// Collect all providers
-	err := k.Providers.Walk(ctx, nil, func(key string, provider types.Provider) (stop bool, err error) {
-		allProviders = append(allProviders, provider)
-		return false, nil
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to walk providers: %w", err)
-	}
+			ProviderUnbondings:     collections.NewMap(sb, types.ProviderUnbondingsKey, "provider_unbondings", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[sdk.Coin](cdc)),
+			ProviderUnbondingQueue: collections.NewKeySet(sb, types.ProviderUnbondingQueueKey, "provider_unbonding_queue", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
 
-	if len(allProviders) == 0 {
-		return nil, fmt.Errorf("no providers registered")
-	}
+			ProviderMigrations: collections.NewMap(sb, types.ProviderMigrationsKey, "provider_migrations", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.ProviderMigration](cdc)),
+			ProviderMigrationQueue: collections.NewKeySet(
+				sb,
+				types.ProviderMigrationQueueKey,
+				"provider_migration_queue",
+				collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.Uint64Key),
+			),
 
-	var candidateProviders []types.Provider
-	// Filter by capabilities based on serviceHint
-	for _, provider := range allProviders {
-		// Only consider "Active" providers for assignment
-		if provider.Status != "Active" {
-			continue
-		}
+			DealExpiryQueue: collections.NewKeySet(sb, types.DealExpiryQueueKey, "deal_expiry_queue", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
 
-		// Apply service hint filter
-		if serviceHint == "Hot" && (provider.Capabilities == "General" || provider.Capabilities == "Edge") {
-			candidateProviders = append(candidateProviders, provider)
-		} else if serviceHint == "Cold" && (provider.Capabilities == "Archive" || provider.Capabilities == "General") {
-			candidateProviders = append(candidateProviders, provider)
-		} else if serviceHint == "" || serviceHint == "General" { // Default/No specific hint, consider General and above
-			candidateProviders = append(candidateProviders, provider)
-		}
-	}
+			DealAccessGrants:        collections.NewMap(sb, types.DealAccessGrantsKey, "deal_access_grants", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.DealAccessGrant](cdc)),
+			RetrievalSessionsByDeal: collections.NewKeySet(sb, types.RetrievalSessionsByDealKey, "retrieval_sessions_by_deal", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey)),
 
-	available := uint64(len(candidateProviders))
-	if available == 0 {
-		return nil, fmt.Errorf("no suitable providers for service hint '%s'", serviceHint)
-	}
-	// Bootstrap mode: on small devnets we may have fewer active providers than
-	// DealBaseReplication. Instead of failing the deal entirely, cap the
-	// replication factor at the number of available candidates.
-	if available < count {
-		count = available
-	}
+			RetrievalSessionSweepQueue: collections.NewKeySet(sb, types.RetrievalSessionSweepQueueKey, "retrieval_session_sweep_queue", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey)),
 
-	assignedProviders := make([]string, count)
-	selectedIndices := make(map[int]struct{})      // To ensure unique providers from candidateProviders slice
-	selectedAddresses := make(map[string]struct{}) // To ensure unique provider addresses
+			ProviderBandwidthRewards: collections.NewMap(sb, types.ProviderBandwidthRewardsKey, "provider_bandwidth_rewards", collections.StringKey, sdk.IntValue),
 
-	seedBase := make([]byte, 0)
-	seedBase = append(seedBase, sdk.Uint64ToBigEndian(dealID)...)
-	seedBase = append(seedBase, blockHash...)
+			DealPlacements: collections.NewMap(sb, types.DealPlacementsKey, "deal_placements", collections.Uint64Key, codec.CollValue[types.DealPlacement](cdc)),
 
-	for i := uint64(0); i < count; {
-		// Deterministic seed for this selection round
-		currentHash := sha256.Sum256(append(seedBase, sdk.Uint64ToBigEndian(i)...))
+			DealProviderMissedProofs: collections.NewMap(sb, types.DealProviderMissedProofsKey, "deal_provider_missed_proofs", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.Uint64Value),
 
-		// Use the hash as a random source to pick an index
-		idx := int(binary.BigEndian.Uint64(currentHash[:8]) % uint64(len(candidateProviders)))
+			SlotRepairQueue: collections.NewKeySet(
+				sb,
+				types.SlotRepairQueueKey,
+				"slot_repair_queue",
+				collections.TripleKeyCodec(collections.Uint64Key, collections.Uint64Key, collections.Uint32Key),
+			),
 
-		provider := candidateProviders[idx]
+			FraudProofsSeen: collections.NewKeySet(sb, types.FraudProofsSeenKey, "fraud_proofs_seen", collections.BytesKey),
 
-		// Check if provider at idx (in candidateProviders slice) is already selected for this deal round
-		if _, exists := selectedIndices[idx]; exists {
-			// Already selected, re-seed and try again to find a new unique provider.
-			// This might loop if not enough unique providers are available, but that's caught by len(candidateProviders) check.
-			newSeed := sha256.Sum256(currentHash[:])
-			seedBase = newSeed[:]
-			continue
-		}
+			EvidenceCount:      collections.NewSequence(sb, types.EvidenceCountKey, "evidence_count"),
+			Evidence:           collections.NewMap(sb, types.EvidenceKey, "evidence", collections.Uint64Key, codec.CollValue[types.Evidence](cdc)),
+			EvidenceByProvider: collections.NewKeySet(sb, types.EvidenceByProviderKey, "evidence_by_provider", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+			EvidenceByDeal:     collections.NewKeySet(sb, types.EvidenceByDealKey, "evidence_by_deal", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
 
-		// Ensure unique provider addresses. This implicitly handles diversity (for now)
-		// as it ensures each provider address is distinct.
-		if _, exists := selectedAddresses[provider.Address]; exists {
-			newSeed := sha256.Sum256(currentHash[:])
-			seedBase = newSeed[:]
-			continue
+			DealsByOwner:    collections.NewKeySet(sb, types.DealsByOwnerKey, "deals_by_owner", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+			DealsByProvider: collections.NewKeySet(sb, types.DealsByProviderKey, "deals_by_provider", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+
+			SessionKeys:           collections.NewMap(sb, types.SessionKeysKey, "session_keys", collections.StringKey, codec.CollValue[types.SessionKey](cdc)),
+			SessionKeysByOwner:    collections.NewKeySet(sb, types.SessionKeysByOwnerKey, "session_keys_by_owner", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+			SessionKeyExpiryQueue: collections.NewKeySet(sb, types.SessionKeyExpiryQueueKey, "session_key_expiry_queue", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
 		}
 
-		assignedProviders[i] = provider.Address
-		selectedIndices[idx] = struct{}{}
-		selectedAddresses[provider.Address] = struct{}{}
-		i++
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
 	}
+	k.Schema = schema
+
+	return k
+}
+
+// providerMatchesServiceHint reports whether a provider's capabilities suit
+// the base service hint of a deal.
+func providerMatchesServiceHint(serviceHint string, provider types.Provider) bool {
+	switch serviceHint {
+	case "Hot":
+		return provider.Capabilities == "General" || provider.Capabilities == "Edge"
+	case "Cold":
+		return provider.Capabilities == "Archive" || provider.Capabilities == "General"
+	case "", "General": // Default/No specific hint, consider General and above
+		return true
+	}
+	return false
+}
 
-	return assignedProviders, nil
+// SetEVMKeeper wires the EVM keeper used to check EIP-1271 signatures of
+// contract accounts. Without it only ECDSA signatures are accepted.
+func (k Keeper) SetEVMKeeper(evmKeeper EVMKeeper) {
+	k.evm.keeper = evmKeeper
 }
 
 // GetAuthority returns the module's authority.
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"nilchain/x/nilchain/types"
+)
+
+// Migrator migrates the nilchain store between consensus versions.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a Migrator for the keeper's store.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 backfills the DealsByOwner and DealsByProvider indexes from
+// the stored deals.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.keeper.rebuildDealIndexes(ctx)
+}
+
+// Migrate2to3 sets the default NilStore precompile gas schedule. Chains
+// upgrading from version 2 have no schedule stored, which would make every
+// precompile call free. It also fills in the liveness, collateral, repair
+// and fraud params that chains started before those features have no value
+// for; SetParams would reject them as zero.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	defaults := types.DefaultParams()
+	backfillParams(&params, defaults)
+	params.PrecompileCallGas = defaults.PrecompileCallGas
+	params.PrecompileViewCallGas = defaults.PrecompileViewCallGas
+	params.PrecompileCalldataByteGas = defaults.PrecompileCalldataByteGas
+	params.PrecompileOutputByteGas = defaults.PrecompileOutputByteGas
+	params.PrecompileStoreReadGas = defaults.PrecompileStoreReadGas
+	params.PrecompileStoreWriteGas = defaults.PrecompileStoreWriteGas
+	params.PrecompileProofVerifyGas = defaults.PrecompileProofVerifyGas
+	params.PrecompileSessionOpenGas = defaults.PrecompileSessionOpenGas
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate3to4 backfills the queues and indexes that the chain now relies on
+// instead of full scans, and the provider storage totals, for the deals and
+// retrieval sessions that existed before they were added.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	if err := m.keeper.seedProofDeadlines(ctx); err != nil {
+		return err
+	}
+	if err := m.keeper.seedDealExpiries(ctx); err != nil {
+		return err
+	}
+	if err := m.keeper.indexDealRetrievalSessions(ctx); err != nil {
+		return err
+	}
+	if err := m.keeper.seedRetrievalSessionSweeps(ctx); err != nil {
+		return err
+	}
+	return m.keeper.rebuildProviderStorage(ctx)
+}
+
+// backfillParams copies the default of every param added since version 1
+// into params where it is unset.
+func backfillParams(params *types.Params, defaults types.Params) {
+	for _, f := range []struct {
+		value *uint64
+		def   uint64
+	}{
+		{&params.EpochLenBlocks, defaults.EpochLenBlocks},
+		{&params.QuotaBpsPerEpochHot, defaults.QuotaBpsPerEpochHot},
+		{&params.QuotaBpsPerEpochCold, defaults.QuotaBpsPerEpochCold},
+		{&params.QuotaMinBlobs, defaults.QuotaMinBlobs},
+		{&params.QuotaMaxBlobs, defaults.QuotaMaxBlobs},
+		{&params.CreditCapBps, defaults.CreditCapBps},
+		{&params.ProviderUnbondingBlocks, defaults.ProviderUnbondingBlocks},
+		{&params.SlashMissedProofBps, defaults.SlashMissedProofBps},
+		{&params.SlashInvalidProofBps, defaults.SlashInvalidProofBps},
+		{&params.JailBondThresholdBps, defaults.JailBondThresholdBps},
+		{&params.ProviderMigrationBlocks, defaults.ProviderMigrationBlocks},
+		{&params.RetrievalSessionRetentionBlocks, defaults.RetrievalSessionRetentionBlocks},
+		{&params.AutoRepairFailureThreshold, defaults.AutoRepairFailureThreshold},
+		{&params.AutoRepairMissedProofThreshold, defaults.AutoRepairMissedProofThreshold},
+		{&params.AutoRepairCooldownBlocks, defaults.AutoRepairCooldownBlocks},
+		{&params.RepairSampleBlobs, defaults.RepairSampleBlobs},
+		{&params.RepairDeadlineBlocks, defaults.RepairDeadlineBlocks},
+		{&params.RepairSlashBps, defaults.RepairSlashBps},
+		{&params.RepairBountyBps, defaults.RepairBountyBps},
+		{&params.FraudSlashBps, defaults.FraudSlashBps},
+		{&params.FraudReporterRewardBps, defaults.FraudReporterRewardBps},
+	} {
+		if *f.value == 0 {
+			*f.value = f.def
+		}
+	}
+	if params.MinProviderBond.Denom == "" {
+		params.MinProviderBond = defaults.MinProviderBond
+	}
+	if params.ProviderBondPerGib.Denom == "" {
+		params.ProviderBondPerGib = defaults.ProviderBondPerGib
+	}
+}
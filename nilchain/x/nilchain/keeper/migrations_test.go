@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+func TestMigrate2to3SetsPrecompileGasSchedule(t *testing.T) {
+	f := initFixture(t)
+
+	// Version 2 params carry no precompile gas schedule.
+	params := types.DefaultParams()
+	params.PrecompileCallGas = 0
+	params.PrecompileViewCallGas = 0
+	params.PrecompileCalldataByteGas = 0
+	params.PrecompileOutputByteGas = 0
+	params.PrecompileStoreReadGas = 0
+	params.PrecompileStoreWriteGas = 0
+	params.PrecompileProofVerifyGas = 0
+	params.PrecompileSessionOpenGas = 0
+	params.RepairSampleBlobs = 3
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate2to3(ctx))
+
+	want := types.DefaultParams()
+	want.RepairSampleBlobs = 3
+	require.Equal(t, want, f.keeper.GetParams(ctx))
+}
+
+func TestMigrateFromBaselineParams(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	// Version 1 params only carry the original pricing fields.
+	defaults := types.DefaultParams()
+	params := types.Params{
+		BaseStripeCost:        defaults.BaseStripeCost,
+		HalvingInterval:       defaults.HalvingInterval,
+		Eip712ChainId:         defaults.Eip712ChainId,
+		StoragePrice:          math.LegacyNewDec(2),
+		DealCreationFee:       defaults.DealCreationFee,
+		MinDurationBlocks:     defaults.MinDurationBlocks,
+		BaseRetrievalFee:      defaults.BaseRetrievalFee,
+		RetrievalPricePerBlob: defaults.RetrievalPricePerBlob,
+		RetrievalBurnBps:      defaults.RetrievalBurnBps,
+		MonthLenBlocks:        defaults.MonthLenBlocks,
+	}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	m := keeper.NewMigrator(f.keeper)
+	require.NoError(t, m.Migrate1to2(ctx))
+	require.NoError(t, m.Migrate2to3(ctx))
+
+	want := types.DefaultParams()
+	want.StoragePrice = math.LegacyNewDec(2)
+	got, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestMigrate3to4SeedsDealQueues(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	owner := sdk.AccAddress([]byte("migrate_owner_______"))
+	ctx, deal := setupExpiringDeal(t, bank, f, owner, 40, 1000)
+
+	// Deals created before version 4 have no queued deadlines.
+	require.NoError(t, f.keeper.ProofDeadlines.Clear(ctx, nil))
+	require.NoError(t, f.keeper.ProofDeadlinesByDealProvider.Clear(ctx, nil))
+	require.NoError(t, f.keeper.DealExpiryQueue.Clear(ctx, nil))
+	proven := deal.Providers[0]
+	require.NoError(t, f.keeper.DealProviderStatus.Set(ctx, collections.Join(deal.Id, proven), 25))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate3to4(ctx))
+
+	for _, provider := range deal.Providers {
+		want := keeper.NextProofDeadline(deal.StartBlock)
+		if provider == proven {
+			want = keeper.NextProofDeadline(25)
+		}
+		got, err := f.keeper.ProofDeadlinesByDealProvider.Get(ctx, collections.Join(deal.Id, provider))
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+		has, err := f.keeper.ProofDeadlines.Has(ctx, collections.Join3(want, deal.Id, provider))
+		require.NoError(t, err)
+		require.True(t, has)
+	}
+
+	has, err := f.keeper.DealExpiryQueue.Has(ctx, collections.Join(deal.EndBlock, deal.Id))
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func TestMigrate3to4IndexesRetrievalSessions(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	owner := sdk.AccAddress([]byte("migrate_sess_owner__"))
+	ctx, deal := setupExpiringDeal(t, bank, f, owner, 40, 1000)
+
+	// Sessions opened before version 4 are missing from the deal index and
+	// the sweep queue.
+	id := lockRetrievalSession(t, f, ctx, &deal, 0xc1, deal.Providers[0], types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_OPEN, 50)
+	session, err := f.keeper.RetrievalSessions.Get(ctx, id)
+	require.NoError(t, err)
+	session.ExpiresAt = 30
+	require.NoError(t, f.keeper.RetrievalSessions.Set(ctx, id, session))
+	done := lockRetrievalSession(t, f, ctx, &deal, 0xc2, deal.Providers[1], types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_COMPLETED, 0)
+	require.NoError(t, f.keeper.RetrievalSessionsByDeal.Clear(ctx, nil))
+	require.NoError(t, f.keeper.RetrievalSessionSweepQueue.Clear(ctx, nil))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate3to4(ctx))
+
+	has, err := f.keeper.RetrievalSessionsByDeal.Has(ctx, collections.Join(deal.Id, id))
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = f.keeper.RetrievalSessionSweepQueue.Has(ctx, collections.Join(uint64(30), id))
+	require.NoError(t, err)
+	require.True(t, has)
+	retention := types.DefaultParams().RetrievalSessionRetentionBlocks
+	has, err = f.keeper.RetrievalSessionSweepQueue.Has(ctx, collections.Join(retention, done))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// Closing the deal now returns the session's locked fee to escrow.
+	res, err := keeper.NewMsgServerImpl(f.keeper).CloseDeal(ctx, &types.MsgCloseDeal{Creator: owner.String(), DealId: deal.Id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.SessionsSettled)
+	require.Equal(t, math.NewInt(1000), res.EscrowRefund)
+}
@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nilchain/x/crypto_ffi"
+	"nilchain/x/nilchain/types"
+)
+
+// Reasons a served response is wrong, in the order they are checked.
+const (
+	fraudManifestRootMismatch = "manifest_root_mismatch"
+	fraudInvalidProof         = "invalid_proof"
+	fraudDataMismatch         = "data_mismatch"
+)
+
+// fraudEvidenceKinds maps each fraud reason to the evidence it is recorded as.
+var fraudEvidenceKinds = map[string]types.EvidenceKind{
+	fraudManifestRootMismatch: types.EvidenceKind_EVIDENCE_KIND_FRAUD_MANIFEST_ROOT_MISMATCH,
+	fraudInvalidProof:         types.EvidenceKind_EVIDENCE_KIND_FRAUD_INVALID_PROOF,
+	fraudDataMismatch:         types.EvidenceKind_EVIDENCE_KIND_FRAUD_DATA_MISMATCH,
+}
+
+// SubmitFraudProof handles MsgSubmitFraudProof. A response the provider
+// signed for the deal's current generation that is shown to be wrong slashes
+// fraud_slash_bps of the provider's bond, pays fraud_reporter_reward_bps of
+// the slash to the reporter and jails the provider. Each response can be
+// reported once.
+func (k msgServer) SubmitFraudProof(goCtx context.Context, msg *types.MsgSubmitFraudProof) (*types.MsgSubmitFraudProofResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	reporter, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid reporter address: %s", err)
+	}
+	providerAddr, err := sdk.AccAddressFromBech32(msg.Provider)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid provider address: %s", err)
+	}
+	if msg.Creator == msg.Provider {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("providers cannot report their own responses")
+	}
+	if len(msg.ManifestRoot) != 48 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("manifest_root must be 48 bytes")
+	}
+	if len(msg.Data) > types.BlobPayloadBytes {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("data must be at most one blob's payload (%d bytes)", types.BlobPayloadBytes)
+	}
+	if len(msg.ProviderSignature) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("provider_signature is required")
+	}
+
+	deal, err := k.Deals.Get(ctx, msg.DealId)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("deal %d not found", msg.DealId)
+	}
+	// Only responses for the current generation can be judged against the
+	// deal's manifest root.
+	if msg.Generation != deal.CurrentGen {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("response was served for generation %d; deal %d is at generation %d", msg.Generation, deal.Id, deal.CurrentGen)
+	}
+	if _, err := k.Providers.Get(ctx, msg.Provider); err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("provider %s not found", msg.Provider)
+	}
+	if _, ok := providerSlotIndex(deal, msg.Provider); !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("provider %s does not serve deal %d", msg.Provider, deal.Id)
+	}
+
+	digest, err := types.HashServedResponse(ctx.ChainID(), deal.Id, msg.Generation, msg.Provider, msg.ManifestRoot, &msg.Proof, msg.Data)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to hash response: %s", err)
+	}
+	seen, err := k.FraudProofsSeen.Has(ctx, digest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load fraud proof digest: %w", err)
+	}
+	if seen {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("response was already proven wrong")
+	}
+
+	account := k.AccountKeeper.GetAccount(ctx, providerAddr)
+	if account == nil || account.GetPubKey() == nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("provider %s has no public key on chain", msg.Provider)
+	}
+	if !account.GetPubKey().VerifySignature(digest, msg.ProviderSignature) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("invalid provider signature")
+	}
+
+	reason, err := servedResponseFault(deal, msg)
+	if err != nil {
+		return nil, err
+	}
+	if reason == "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("response is consistent with deal %d", deal.Id)
+	}
+
+	if err := k.FraudProofsSeen.Set(ctx, digest); err != nil {
+		return nil, fmt.Errorf("failed to record fraud proof digest: %w", err)
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reward, err := k.slashProviderBond(ctx, msg.Provider, params.FraudSlashBps, params.FraudReporterRewardBps, "fraud_"+reason)
+	if err != nil {
+		return nil, err
+	}
+	if reward.IsPositive() {
+		if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, reporter, sdk.NewCoins(reward)); err != nil {
+			return nil, fmt.Errorf("failed to pay fraud proof reward: %w", err)
+		}
+	}
+	if _, err := k.recordEvidence(ctx, types.Evidence{
+		Kind:     fraudEvidenceKinds[reason],
+		DealId:   deal.Id,
+		Provider: msg.Provider,
+		Reporter: msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeFraudProofAccepted,
+			sdk.NewAttribute(types.AttributeKeyDealID, fmt.Sprintf("%d", deal.Id)),
+			sdk.NewAttribute(types.AttributeKeyProvider, msg.Provider),
+			sdk.NewAttribute(types.AttributeKeyReporter, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(types.AttributeKeyResponseDigest, hex.EncodeToString(digest)),
+			sdk.NewAttribute(types.AttributeKeyRewardAmount, reward.String()),
+		),
+	)
+
+	return &types.MsgSubmitFraudProofResponse{Reason: reason, Reward: reward}, nil
+}
+
+// servedResponseFault returns why a served response is wrong for the deal, or
+// "" if nothing is wrong with it. A failing verifier is an error, never a
+// fault of the provider.
+func servedResponseFault(deal types.Deal, msg *types.MsgSubmitFraudProof) (string, error) {
+	if !bytes.Equal(msg.ManifestRoot, deal.ManifestRoot) {
+		return fraudManifestRootMismatch, nil
+	}
+
+	stripe, err := stripeParamsForDeal(deal)
+	if err != nil {
+		return "", sdkerrors.ErrInvalidRequest.Wrapf("invalid service hint: %s", err.Error())
+	}
+	ok, err := verifyDealChainedProof(deal, stripe, &msg.Proof)
+	if err != nil {
+		return "", sdkerrors.ErrInvalidRequest.Wrapf("triple proof verification error: %s", err)
+	}
+	if !ok {
+		return fraudInvalidProof, nil
+	}
+
+	// The proof binds y_value to the committed blob, so served bytes that
+	// make up a whole blob opening to a different value at z_value are not
+	// the committed blob. A shorter range cannot be opened on its own; its
+	// signature still binds the provider to it.
+	if len(msg.Data) == types.BlobPayloadBytes {
+		_, y, err := crypto_ffi.ComputeBlobProof(types.EncodeBlobPayload(msg.Data), msg.Proof.ZValue)
+		if err != nil {
+			return "", sdkerrors.ErrInvalidRequest.Wrapf("failed to open served blob: %s", err)
+		}
+		if !bytes.Equal(y, msg.Proof.YValue) {
+			return fraudDataMismatch, nil
+		}
+	}
+	return "", nil
+}
@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
-	"unicode"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
@@ -17,7 +16,6 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	gethCrypto "github.com/ethereum/go-ethereum/crypto"
-	ma "github.com/multiformats/go-multiaddr"
 	"nilchain/x/crypto_ffi"
 	"nilchain/x/nilchain/types"
 )
@@ -58,8 +56,9 @@ func (k msgServer) CreateDealFromEvm(goCtx context.Context, msg *types.MsgCreate
 		return nil, sdkerrors.ErrUnauthorized.Wrapf("intent chain_id %q does not match chain %q", intent.ChainId, ctx.ChainID())
 	}
 
-	if len(msg.EvmSignature) != 65 {
-		return nil, sdkerrors.ErrUnauthorized.Wrap("invalid EVM signature length (expected 65 bytes)")
+	creatorAddr, err := parseCreatorEvm(intent.CreatorEvm)
+	if err != nil {
+		return nil, err
 	}
 
 	params := k.GetParams(ctx)
@@ -97,31 +96,11 @@ func (k msgServer) CreateDealFromEvm(goCtx context.Context, msg *types.MsgCreate
 		"Signature", fmt.Sprintf("%x", msg.EvmSignature),
 	)
 
-	evmAddr, err := recoverEvmAddressFromDigest(digest, msg.EvmSignature)
-
-	if err != nil {
-
-		return nil, sdkerrors.ErrUnauthorized.Wrapf("failed to recover EVM signer: %s", err)
-
-	}
-
-	ctx.Logger().Info("DEBUG EIP-712 Recovery",
-
-		"Recovered", evmAddr.Hex(),
-
-		"Expected", intent.CreatorEvm,
-	)
-
-	creator := strings.ToLower(strings.TrimSpace(intent.CreatorEvm))
-	if creator == "" {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap("creator_evm is required")
-	}
-	if !strings.HasPrefix(creator, "0x") {
-		creator = "0x" + creator
-	}
-	if strings.ToLower(evmAddr.Hex()) != creator {
+	// Contract wallets sign through EIP-1271, everyone else with ECDSA.
+	if !k.verifyEvmSignature(ctx, creatorAddr, digest, msg.EvmSignature) {
 		return nil, sdkerrors.ErrUnauthorized.Wrap("signature does not match creator_evm")
 	}
+	evmAddr := creatorAddr
 
 	// Replay protection: enforce strictly increasing nonce per EVM address.
 	evmKey := strings.ToLower(evmAddr.Hex())
@@ -182,7 +161,7 @@ func (k msgServer) CreateDealFromEvm(goCtx context.Context, msg *types.MsgCreate
 	}
 
 	blockHash := ctx.BlockHeader().LastBlockId.Hash
-	assignedProviders, err := k.AssignProviders(ctx, dealID, blockHash, serviceHintBase, requestedReplicas)
+	assignedProviders, placement, err := k.AssignProviders(ctx, dealID, blockHash, DealPlacementRequest(parsedHint, requestedReplicas))
 	if err != nil {
 		return nil, fmt.Errorf("failed to assign providers: %w", err)
 	}
@@ -211,6 +190,7 @@ func (k msgServer) CreateDealFromEvm(goCtx context.Context, msg *types.MsgCreate
 		SpendWindowSpent:       math.NewInt(0),
 		CurrentGen:             0,
 		WitnessMdus:            0,
+		Status:                 types.DealStatus_DEAL_STATUS_ACTIVE,
 	}
 	if redundancyMode == 2 {
 		deal.Mode2Profile = &types.StripeReplicaProfile{
@@ -235,6 +215,20 @@ func (k msgServer) CreateDealFromEvm(goCtx context.Context, msg *types.MsgCreate
 	if err := k.Deals.Set(ctx, dealID, deal); err != nil {
 		return nil, fmt.Errorf("failed to set deal: %w", err)
 	}
+	if err := k.indexDeal(ctx, deal); err != nil {
+		return nil, err
+	}
+	if err := k.recordDealPlacement(ctx, dealID, 0, placement); err != nil {
+		return nil, err
+	}
+	if err := k.scheduleDealExpiry(ctx, dealID, deal.EndBlock); err != nil {
+		return nil, err
+	}
+	for _, provider := range deal.Providers {
+		if err := k.SetProofDeadline(ctx, dealID, provider, NextProofDeadline(deal.StartBlock)); err != nil {
+			return nil, err
+		}
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -260,51 +254,16 @@ func (k msgServer) RegisterProvider(goCtx context.Context, msg *types.MsgRegiste
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
 	}
 
-	if msg.Capabilities != "Archive" && msg.Capabilities != "General" && msg.Capabilities != "Edge" {
-		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid capabilities: %s", msg.Capabilities)
-	}
-
-	if len(msg.Endpoints) == 0 {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap("endpoints is required (at least one Multiaddr)")
-	}
-	if len(msg.Endpoints) > 8 {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap("too many endpoints (max 8)")
+	if err := validateProviderCapabilities(msg.Capabilities); err != nil {
+		return nil, err
 	}
-	endpoints := make([]string, 0, len(msg.Endpoints))
-	seenEndpoints := make(map[string]struct{}, len(msg.Endpoints))
-	hasHTTP := false
-	for _, raw := range msg.Endpoints {
-		ep := strings.TrimSpace(raw)
-		if ep == "" {
-			return nil, sdkerrors.ErrInvalidRequest.Wrap("endpoint must be non-empty")
-		}
-		if len(ep) > 256 {
-			return nil, sdkerrors.ErrInvalidRequest.Wrap("endpoint too long")
-		}
-		if !strings.HasPrefix(ep, "/") {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid endpoint multiaddr: %q", ep)
-		}
-		if strings.IndexFunc(ep, func(r rune) bool { return unicode.IsSpace(r) || r < 0x20 }) != -1 {
-			return nil, sdkerrors.ErrInvalidRequest.Wrap("endpoint contains whitespace/control characters")
-		}
-		parsed, err := ma.NewMultiaddr(ep)
-		if err != nil {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid endpoint multiaddr: %q", ep)
-		}
-		for _, proto := range parsed.Protocols() {
-			if proto.Code == ma.P_HTTP || proto.Code == ma.P_HTTPS {
-				hasHTTP = true
-			}
-		}
-		canonical := parsed.String()
-		if _, ok := seenEndpoints[canonical]; ok {
-			continue
-		}
-		seenEndpoints[canonical] = struct{}{}
-		endpoints = append(endpoints, canonical)
+	endpoints, err := normalizeProviderEndpoints(msg.Endpoints)
+	if err != nil {
+		return nil, err
 	}
-	if !hasHTTP {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap("at least one HTTP or HTTPS endpoint is required")
+	failureDomain, err := normalizeFailureDomain(msg.FailureDomain)
+	if err != nil {
+		return nil, err
 	}
 
 	_, err = k.Providers.Get(ctx, msg.Creator)
@@ -315,6 +274,30 @@ func (k msgServer) RegisterProvider(goCtx context.Context, msg *types.MsgRegiste
 		return nil, err
 	}
 
+	// Lock collateral sized by the advertised capacity. An unset bond locks
+	// exactly the required amount.
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	required := RequiredProviderBond(params, msg.TotalStorage)
+	bond := msg.Bond
+	if bond.Denom == "" && (bond.Amount.IsNil() || bond.Amount.IsZero()) {
+		bond = required
+	}
+	if !bond.IsValid() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid bond: %s", bond)
+	}
+	if bond.Denom != required.Denom {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("bond denom must be %s", required.Denom)
+	}
+	if bond.IsLT(required) {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf("bond %s is below the required bond %s", bond, required)
+	}
+	if err := k.lockProviderBond(ctx, msg.Creator, bond); err != nil {
+		return nil, err
+	}
+
 	// Create new Provider object
 	provider := types.Provider{
 		Address:         creatorAddr.String(),
@@ -324,6 +307,8 @@ func (k msgServer) RegisterProvider(goCtx context.Context, msg *types.MsgRegiste
 		Status:          "Active", // Initially active
 		ReputationScore: 100,      // Initial Score
 		Endpoints:       endpoints,
+		Bond:            bond,
+		FailureDomain:   failureDomain,
 	}
 
 	if err := k.Providers.Set(ctx, provider.Address, provider); err != nil {
@@ -336,6 +321,7 @@ func (k msgServer) RegisterProvider(goCtx context.Context, msg *types.MsgRegiste
 			sdk.NewAttribute(types.AttributeKeyProvider, provider.Address),
 			sdk.NewAttribute(types.AttributeKeyCapabilities, provider.Capabilities),
 			sdk.NewAttribute(types.AttributeKeyTotalStorage, fmt.Sprintf("%d", provider.TotalStorage)),
+			sdk.NewAttribute(types.AttributeKeyBond, provider.Bond.String()),
 		),
 	)
 
@@ -404,7 +390,7 @@ func (k msgServer) CreateDeal(goCtx context.Context, msg *types.MsgCreateDeal) (
 	}
 
 	blockHash := ctx.BlockHeader().LastBlockId.Hash
-	assignedProviders, err := k.AssignProviders(ctx, dealID, blockHash, serviceHintBase, requestedReplicas)
+	assignedProviders, placement, err := k.AssignProviders(ctx, dealID, blockHash, DealPlacementRequest(parsedHint, requestedReplicas))
 	if err != nil {
 		return nil, fmt.Errorf("failed to assign providers: %w", err)
 	}
@@ -449,6 +435,7 @@ func (k msgServer) CreateDeal(goCtx context.Context, msg *types.MsgCreateDeal) (
 		SpendWindowSpent:       math.NewInt(0),
 		CurrentGen:             0,
 		WitnessMdus:            0,
+		Status:                 types.DealStatus_DEAL_STATUS_ACTIVE,
 	}
 	if redundancyMode == 2 {
 		deal.Mode2Profile = &types.StripeReplicaProfile{
@@ -473,6 +460,20 @@ func (k msgServer) CreateDeal(goCtx context.Context, msg *types.MsgCreateDeal) (
 	if err := k.Deals.Set(ctx, dealID, deal); err != nil {
 		return nil, fmt.Errorf("failed to set deal: %w", err)
 	}
+	if err := k.indexDeal(ctx, deal); err != nil {
+		return nil, err
+	}
+	if err := k.recordDealPlacement(ctx, dealID, 0, placement); err != nil {
+		return nil, err
+	}
+	if err := k.scheduleDealExpiry(ctx, dealID, deal.EndBlock); err != nil {
+		return nil, err
+	}
+	for _, provider := range deal.Providers {
+		if err := k.SetProofDeadline(ctx, dealID, provider, NextProofDeadline(deal.StartBlock)); err != nil {
+			return nil, err
+		}
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -503,6 +504,9 @@ func (k msgServer) UpdateDealContent(goCtx context.Context, msg *types.MsgUpdate
 	if err != nil {
 		return nil, sdkerrors.ErrNotFound.Wrapf("deal %d not found", msg.DealId)
 	}
+	if err := checkDealActive(deal); err != nil {
+		return nil, err
+	}
 
 	if deal.Owner != msg.Creator {
 		return nil, sdkerrors.ErrUnauthorized.Wrapf("only deal owner %s can update content", deal.Owner)
@@ -546,8 +550,12 @@ func (k msgServer) UpdateDealContent(goCtx context.Context, msg *types.MsgUpdate
 	if !bytes.Equal(deal.ManifestRoot, manifestRoot) {
 		deal.CurrentGen++
 	}
+	footprint := DealStorageFootprint(deal)
 	deal.ManifestRoot = manifestRoot
 	deal.Size_ = msg.Size_
+	if err := k.resizeDealStorage(ctx, deal, footprint); err != nil {
+		return nil, err
+	}
 
 	if err := k.Deals.Set(ctx, msg.DealId, deal); err != nil {
 		return nil, fmt.Errorf("failed to update deal: %w", err)
@@ -585,8 +593,9 @@ func (k msgServer) UpdateDealContentFromEvm(goCtx context.Context, msg *types.Ms
 	if strings.TrimSpace(intent.ChainId) != ctx.ChainID() {
 		return nil, sdkerrors.ErrUnauthorized.Wrapf("intent chain_id %q does not match chain %q", intent.ChainId, ctx.ChainID())
 	}
-	if len(msg.EvmSignature) != 65 {
-		return nil, sdkerrors.ErrUnauthorized.Wrap("invalid EVM signature length")
+	creatorAddr, err := parseCreatorEvm(intent.CreatorEvm)
+	if err != nil {
+		return nil, err
 	}
 
 	params := k.GetParams(ctx)
@@ -608,25 +617,11 @@ func (k msgServer) UpdateDealContentFromEvm(goCtx context.Context, msg *types.Ms
 		"Signature", fmt.Sprintf("%x", msg.EvmSignature),
 	)
 
-	evmAddr, err := recoverEvmAddressFromDigest(digest, msg.EvmSignature)
-	if err != nil {
-		return nil, sdkerrors.ErrUnauthorized.Wrapf("failed to recover EVM signer: %s", err)
-	}
-	ctx.Logger().Info("DEBUG EIP-712 Recovery (Update)",
-		"Recovered", evmAddr.Hex(),
-		"Expected", intent.CreatorEvm,
-	)
-
-	creator := strings.ToLower(strings.TrimSpace(intent.CreatorEvm))
-	if creator == "" {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap("creator_evm is required")
-	}
-	if !strings.HasPrefix(creator, "0x") {
-		creator = "0x" + creator
-	}
-	if strings.ToLower(evmAddr.Hex()) != creator {
+	// Contract wallets sign through EIP-1271, everyone else with ECDSA.
+	if !k.verifyEvmSignature(ctx, creatorAddr, digest, msg.EvmSignature) {
 		return nil, sdkerrors.ErrUnauthorized.Wrap("signature does not match creator_evm")
 	}
+	evmAddr := creatorAddr
 
 	// Replay protection: enforce strictly increasing nonce per EVM address.
 	evmKey := strings.ToLower(evmAddr.Hex())
@@ -650,6 +645,9 @@ func (k msgServer) UpdateDealContentFromEvm(goCtx context.Context, msg *types.Ms
 	if err != nil {
 		return nil, sdkerrors.ErrNotFound.Wrapf("deal %d not found", intent.DealId)
 	}
+	if err := checkDealActive(deal); err != nil {
+		return nil, err
+	}
 
 	if deal.Owner != ownerAcc.String() {
 		return nil, sdkerrors.ErrUnauthorized.Wrapf("only deal owner can update content")
@@ -688,8 +686,12 @@ func (k msgServer) UpdateDealContentFromEvm(goCtx context.Context, msg *types.Ms
 	if !bytes.Equal(deal.ManifestRoot, manifestRoot) {
 		deal.CurrentGen++
 	}
+	footprint := DealStorageFootprint(deal)
 	deal.ManifestRoot = manifestRoot
 	deal.Size_ = intent.SizeBytes
+	if err := k.resizeDealStorage(ctx, deal, footprint); err != nil {
+		return nil, err
+	}
 
 	if err := k.Deals.Set(ctx, intent.DealId, deal); err != nil {
 		return nil, fmt.Errorf("failed to update deal: %w", err)
@@ -716,6 +718,9 @@ func (k msgServer) ProveLiveness(goCtx context.Context, msg *types.MsgProveLiven
 	if err != nil {
 		return nil, sdkerrors.ErrNotFound.Wrapf("deal with ID %d not found", msg.DealId)
 	}
+	if err := checkDealActive(deal); err != nil {
+		return nil, err
+	}
 
 	isAssignedProvider := false
 	for _, p := range deal.Providers {
@@ -809,7 +814,13 @@ func (k msgServer) ProveLiveness(goCtx context.Context, msg *types.MsgProveLiven
 		return v, nil
 	}
 
+	// Latency is measured from the start of the current liveness epoch (when
+	// its challenge set became known), or from deal start if that is later.
+	_, epochStart := livenessEpoch(k.GetParams(ctx), ctx.BlockHeight())
 	hChallenge := int64(deal.StartBlock)
+	if int64(epochStart) > hChallenge {
+		hChallenge = int64(epochStart)
+	}
 
 	// 4. Calculate Tier based on block height latency
 	hProof := ctx.BlockHeight()
@@ -929,6 +940,9 @@ func (k msgServer) ProveLiveness(goCtx context.Context, msg *types.MsgProveLiven
 		if !ok {
 			return sdkerrors.ErrUnauthorized.Wrap("invalid liveness proof")
 		}
+		if err := k.creditOrganicProof(ctx, deal, msg.Creator, receipt.ProofDetails.MduIndex, receipt.ProofDetails.BlobIndex); err != nil {
+			return err
+		}
 
 		// Reconstruct signed message buffer (Cosmos signing path).
 		// This is not EIP-712; it exists as a fallback for local keyring flows.
@@ -949,17 +963,24 @@ func (k msgServer) ProveLiveness(goCtx context.Context, msg *types.MsgProveLiven
 		// Verification Logic.
 		isValid := false
 
-		// Attempt EIP-712 Recovery (if signature is 65 bytes)
-		if len(receipt.UserSignature) == 65 {
+		// Attempt EIP-712 verification (ECDSA, or EIP-1271 for contract owners).
+		{
 			eip712ChainID := new(big.Int).SetUint64(params.Eip712ChainId)
 			domainSep := types.HashDomainSeparator(eip712ChainID)
 
 			// v3 hashing (range binding + proof_hash binding).
 			if structHash, errHash := types.HashRetrievalReceiptV3(receipt); errHash == nil {
 				digest := types.ComputeEIP712Digest(domainSep, structHash)
-				if evmAddr, errRec := recoverEvmAddressFromDigest(digest, receipt.UserSignature); errRec == nil {
-					signerAcc := sdk.AccAddress(evmAddr.Bytes())
-					if signerAcc.String() == deal.Owner {
+				if signerAcc, ok := k.evmDealSigner(ctx, deal, digest, receipt.UserSignature); ok {
+					if grant, errGrant := k.dealReaderGrant(ctx, deal, signerAcc.String()); errGrant == nil {
+						if err := k.chargeDealAccessGrant(ctx, grant, receipt.BytesServed, bandwidthPaymentForBytes(receipt.BytesServed)); err != nil {
+							return err
+						}
+						isValid = true
+					} else if key, errKey := k.dealSessionKey(ctx, deal, signerAcc.String()); errKey == nil {
+						if err := k.chargeSessionKey(ctx, key, receipt.BytesServed); err != nil {
+							return err
+						}
 						isValid = true
 					}
 				}
@@ -1007,6 +1028,24 @@ func (k msgServer) ProveLiveness(goCtx context.Context, msg *types.MsgProveLiven
 
 	switch pt := msg.ProofType.(type) {
 	case *types.MsgProveLiveness_SystemProof:
+		if pt.SystemProof == nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("system_proof is required")
+		}
+		// Synthetic proofs must answer a challenge derived for this epoch.
+		if err := k.EnsureEpochSeed(ctx); err != nil {
+			return nil, err
+		}
+		chal, err := k.deriveChallengeSet(ctx, deal, msg.Creator)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to derive challenge set: %s", err)
+		}
+		if !chal.isChallenged(pt.SystemProof.MduIndex, pt.SystemProof.BlobIndex) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"position (mdu %d, blob %d) is not challenged for deal %d in epoch %d",
+				pt.SystemProof.MduIndex, pt.SystemProof.BlobIndex, msg.DealId, chal.epochID,
+			)
+		}
+
 		ok, err := verifyChainedProof(pt.SystemProof, true)
 		if err != nil {
 			ctx.Logger().Error("Triple Proof Verification Error", "err", err)
@@ -1015,8 +1054,23 @@ func (k msgServer) ProveLiveness(goCtx context.Context, msg *types.MsgProveLiven
 		if !ok {
 			// Track health for system proofs that fail verification.
 			k.trackProviderHealth(ctx, msg.DealId, msg.Creator, false)
+			params, err := k.Params.Get(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if err := k.SlashProviderBond(ctx, msg.Creator, params.SlashInvalidProofBps, "invalid_proof"); err != nil {
+				return nil, err
+			}
 			return &types.MsgProveLivenessResponse{Success: false, Tier: 3 /* Fail */, RewardAmount: "0"}, nil
 		}
+
+		fresh, err := k.recordSyntheticProof(ctx, chal, msg.DealId, msg.Creator, pt.SystemProof.MduIndex, pt.SystemProof.BlobIndex)
+		if err != nil {
+			return nil, err
+		}
+		if !fresh {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("challenge already satisfied in epoch %d", chal.epochID)
+		}
 	case *types.MsgProveLiveness_UserReceipt:
 		isUserReceipt = true
 		if err := verifyRetrievalReceipt(pt.UserReceipt); err != nil {
@@ -1080,10 +1134,7 @@ func (k msgServer) ProveLiveness(goCtx context.Context, msg *types.MsgProveLiven
 			return nil, sdkerrors.ErrUnauthorized.Wrap("download session receipt nonce must be strictly increasing")
 		}
 
-		// Verify user signature (EIP-712 only).
-		if len(receipt.UserSignature) != 65 {
-			return nil, sdkerrors.ErrUnauthorized.Wrap("invalid user signature length (expected 65 bytes)")
-		}
+		// Verify user signature (EIP-712 only; EIP-1271 for contract owners).
 		{
 			eip712ChainID := new(big.Int).SetUint64(params.Eip712ChainId)
 			domainSep := types.HashDomainSeparator(eip712ChainID)
@@ -1092,13 +1143,20 @@ func (k msgServer) ProveLiveness(goCtx context.Context, msg *types.MsgProveLiven
 				return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to hash session receipt: %s", err)
 			}
 			digest := types.ComputeEIP712Digest(domainSep, structHash)
-			evmAddr, err := recoverEvmAddressFromDigest(digest, receipt.UserSignature)
-			if err != nil {
-				return nil, sdkerrors.ErrUnauthorized.Wrapf("failed to recover EVM signer: %s", err)
+			signerAcc, ok := k.evmDealSigner(ctx, deal, digest, receipt.UserSignature)
+			if !ok {
+				return nil, sdkerrors.ErrUnauthorized.Wrap("invalid session receipt signature")
 			}
-			signerAcc := sdk.AccAddress(evmAddr.Bytes())
-			if signerAcc.String() != deal.Owner {
-				return nil, sdkerrors.ErrUnauthorized.Wrap("session receipt signature does not match deal owner")
+			if grant, err := k.dealReaderGrant(ctx, deal, signerAcc.String()); err == nil {
+				if err := k.chargeDealAccessGrant(ctx, grant, receipt.TotalBytes, bandwidthPaymentForBytes(receipt.TotalBytes)); err != nil {
+					return nil, err
+				}
+			} else if key, errKey := k.dealSessionKey(ctx, deal, signerAcc.String()); errKey == nil {
+				if err := k.chargeSessionKey(ctx, key, receipt.TotalBytes); err != nil {
+					return nil, err
+				}
+			} else {
+				return nil, sdkerrors.ErrUnauthorized.Wrapf("session receipt signer is not the deal owner, an access grantee or a usable session key: %s", errKey)
 			}
 		}
 
@@ -1131,6 +1189,9 @@ func (k msgServer) ProveLiveness(goCtx context.Context, msg *types.MsgProveLiven
 			if !ok {
 				return nil, sdkerrors.ErrUnauthorized.Wrap("invalid liveness proof")
 			}
+			if err := k.creditOrganicProof(ctx, deal, msg.Creator, chunk.ProofDetails.MduIndex, chunk.ProofDetails.BlobIndex); err != nil {
+				return nil, err
+			}
 
 			proofHash, err := types.HashChainedProof(&chunk.ProofDetails)
 			if err != nil {
@@ -1170,17 +1231,7 @@ func (k msgServer) ProveLiveness(goCtx context.Context, msg *types.MsgProveLiven
 
 	var bandwidthPayment math.Int
 	if isUserReceipt {
-		// Bandwidth payment (devnet): charge proportional to bytes served so that
-		// chunked (blob-sized) receipts don't exhaust escrow immediately.
-		//
-		// Units are the base denom (micro-NIL). Current placeholder pricing:
-		//   1 unit per KiB (rounded up).
-		const bytesPerUnit = uint64(1024)
-		units := (bandwidthBytes + bytesPerUnit - 1) / bytesPerUnit
-		if units == 0 {
-			units = 1
-		}
-		bandwidthPayment = math.NewIntFromUint64(units)
+		bandwidthPayment = bandwidthPaymentForBytes(bandwidthBytes)
 
 		newEscrowBalance := deal.EscrowBalance.Sub(bandwidthPayment)
 		if newEscrowBalance.IsNegative() {
@@ -1194,33 +1245,8 @@ func (k msgServer) ProveLiveness(goCtx context.Context, msg *types.MsgProveLiven
 	totalReward := storageReward.Add(bandwidthPayment)
 
 	// --- REWARD ACCUMULATION ---
-	if totalReward.IsPositive() {
-		// Accumulate to ProviderRewards store
-		currentRewards, err := k.ProviderRewards.Get(ctx, msg.Creator)
-		if err != nil {
-			if !errors.Is(err, collections.ErrNotFound) {
-				return nil, err
-			}
-			currentRewards = math.ZeroInt()
-		}
-
-		newRewards := currentRewards.Add(totalReward)
-		if err := k.ProviderRewards.Set(ctx, msg.Creator, newRewards); err != nil {
-			return nil, fmt.Errorf("failed to set provider rewards: %w", err)
-		}
-
-		// Note: We are NOT minting coins yet. Coins are minted on Withdraw.
-		// BUT wait, BandwidthPayment comes from Escrow (User -> Module).
-		// StorageReward comes from Inflation (Mint).
-		// If we mix them, we need to be careful.
-		// For Bandwidth, funds are already in Module Account (escrow).
-		// For Storage, funds don't exist yet.
-
-		// Strategy: Keep accounting virtual.
-		// For Bandwidth: We already deducted from Deal.EscrowBalance (which is just a number field in the Deal struct).
-		// The actual tokens are in the 'nilchain' module account.
-		// So we effectively "moved" claim from Deal to ProviderReward.
-		// For Storage: We will mint when withdrawing.
+	if err := k.CreditProviderRewards(ctx, msg.Creator, storageReward, bandwidthPayment); err != nil {
+		return nil, err
 	}
 
 	if err := k.Deals.Set(ctx, msg.DealId, deal); err != nil {
@@ -1230,6 +1256,9 @@ func (k msgServer) ProveLiveness(goCtx context.Context, msg *types.MsgProveLiven
 	if err := k.DealProviderStatus.Set(ctx, collections.Join(msg.DealId, msg.Creator), uint64(ctx.BlockHeight())); err != nil {
 		return nil, fmt.Errorf("failed to update proof status: %w", err)
 	}
+	if err := k.SetProofDeadline(ctx, msg.DealId, msg.Creator, NextProofDeadline(uint64(ctx.BlockHeight()))); err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -1254,6 +1283,21 @@ func (k msgServer) ProveLiveness(goCtx context.Context, msg *types.MsgProveLiven
 	return &types.MsgProveLivenessResponse{Success: true, Tier: tier, RewardAmount: totalReward.String()}, nil
 }
 
+// bandwidthPaymentForBytes prices retrieval bandwidth paid from deal escrow.
+// It is charged proportional to bytes served so that chunked (blob-sized)
+// receipts don't exhaust escrow immediately.
+//
+// Units are the base denom (micro-NIL). Current placeholder pricing (devnet):
+// 1 unit per KiB (rounded up), at least one unit.
+func bandwidthPaymentForBytes(bytes uint64) math.Int {
+	const bytesPerUnit = uint64(1024)
+	units := bytes / bytesPerUnit
+	if bytes%bytesPerUnit != 0 || units == 0 {
+		units++
+	}
+	return math.NewIntFromUint64(units)
+}
+
 // recordProofSummary stores a lightweight Proof summary in state so that the
 // web UI can render recent liveness/performance events via the existing
 // ListProofs query.
@@ -1279,17 +1323,20 @@ func (k msgServer) recordProofSummary(ctx sdk.Context, msg *types.MsgProveLivene
 }
 
 // trackProviderHealth maintains a minimal per-(Deal, Provider) health stub for
-// Phase 3.4. It does not affect rewards or slashing; it only keeps a small
-// failure counter and logs when a pair would be considered "degraded" under a
-// full HealthState-based eviction policy.
-func (k msgServer) trackProviderHealth(ctx sdk.Context, dealID uint64, provider string, proofOK bool) {
+// Phase 3.4. It does not affect rewards or slashing; it keeps a small failure
+// counter and, once it reaches auto_repair_failure_threshold, has the chain
+// repair the provider's Mode 2 slot.
+func (k Keeper) trackProviderHealth(ctx sdk.Context, dealID uint64, provider string, proofOK bool) {
 	key := collections.Join(dealID, provider)
 
 	if proofOK {
-		// Reset failure counter on success.
+		// Reset failure and missed proof counters on success.
 		if err := k.DealProviderFailures.Remove(ctx, key); err != nil && !errors.Is(err, collections.ErrNotFound) {
 			ctx.Logger().Error("failed to reset provider failure counter", "deal", dealID, "provider", provider, "error", err)
 		}
+		if err := k.DealProviderMissedProofs.Remove(ctx, key); err != nil && !errors.Is(err, collections.ErrNotFound) {
+			ctx.Logger().Error("failed to reset missed proof counter", "deal", dealID, "provider", provider, "error", err)
+		}
 		return
 	}
 
@@ -1304,17 +1351,12 @@ func (k msgServer) trackProviderHealth(ctx sdk.Context, dealID uint64, provider
 		return
 	}
 
-	// Log when the failure count crosses a small threshold. This is purely
-	// informational and gives operators a sense of which pairs would be
-	// considered for eviction in a mainnet-grade HealthState implementation.
-	const failureThreshold uint64 = 3
-	if failures == failureThreshold {
-		ctx.Logger().Info(
-			"provider health degraded for deal; would consider eviction in full self-healing mode",
-			"deal", dealID,
-			"provider", provider,
-			"failures", failures,
-		)
+	params := k.GetParams(ctx)
+	if params.AutoRepairFailureThreshold == 0 || failures < params.AutoRepairFailureThreshold {
+		return
+	}
+	if err := k.autoRepairSlot(ctx, params, dealID, provider, autoRepairReasonFailures, failures); err != nil {
+		ctx.Logger().Error("failed to repair slot of degraded provider", "deal", dealID, "provider", provider, "error", err)
 	}
 }
 
@@ -1326,6 +1368,9 @@ func (k msgServer) SignalSaturation(goCtx context.Context, msg *types.MsgSignalS
 	if err != nil {
 		return nil, sdkerrors.ErrNotFound.Wrapf("deal with ID %d not found", msg.DealId)
 	}
+	if err := checkDealActive(deal); err != nil {
+		return nil, err
+	}
 
 	isAssigned := false
 	for _, p := range deal.Providers {
@@ -1366,10 +1411,20 @@ func (k msgServer) SignalSaturation(goCtx context.Context, msg *types.MsgSignalS
 	blockHash := ctx.BlockHeader().LastBlockId.Hash
 	derivedID := deal.Id + (deal.CurrentReplication * 1000)
 
-	newProviders, err := k.AssignProviders(ctx, derivedID, blockHash, "Hot", types.DealBaseReplication)
+	footprint := DealStorageFootprint(deal)
+	newProviders, placement, err := k.AssignProviders(ctx, derivedID, blockHash, HotStripePlacementRequest(deal))
 	if err != nil {
 		return nil, fmt.Errorf("failed to assign new hot stripe: %w", err)
 	}
+	for _, provider := range newProviders {
+		if err := k.commitProviderStorage(ctx, provider, footprint); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.recordDealPlacement(ctx, deal.Id, len(deal.Providers), placement); err != nil {
+		return nil, err
+	}
 
 	deal.Providers = append(deal.Providers, newProviders...)
 	deal.CurrentReplication += types.DealBaseReplication
@@ -1379,6 +1434,14 @@ func (k msgServer) SignalSaturation(goCtx context.Context, msg *types.MsgSignalS
 	if err := k.Deals.Set(ctx, deal.Id, deal); err != nil {
 		return nil, fmt.Errorf("failed to update deal with new stripe: %w", err)
 	}
+	if err := k.indexDealProviders(ctx, deal.Id, newProviders...); err != nil {
+		return nil, err
+	}
+	for _, provider := range newProviders {
+		if err := k.EnsureProofDeadline(ctx, deal.Id, provider, NextProofDeadline(height)); err != nil {
+			return nil, err
+		}
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -1411,6 +1474,9 @@ func (k msgServer) AddCredit(goCtx context.Context, msg *types.MsgAddCredit) (*t
 	if err != nil {
 		return nil, sdkerrors.ErrNotFound.Wrapf("deal %d not found", msg.DealId)
 	}
+	if err := checkDealActive(deal); err != nil {
+		return nil, err
+	}
 
 	amount := msg.Amount
 	if amount.IsNil() || amount.IsNegative() {
@@ -1430,85 +1496,6 @@ func (k msgServer) AddCredit(goCtx context.Context, msg *types.MsgAddCredit) (*t
 	return &types.MsgAddCreditResponse{NewBalance: deal.EscrowBalance}, nil
 }
 
-// WithdrawRewards allows a Storage Provider to withdraw accumulated rewards.
-func (k msgServer) WithdrawRewards(goCtx context.Context, msg *types.MsgWithdrawRewards) (*types.MsgWithdrawRewardsResponse, error) {
-	ctx := sdk.UnwrapSDKContext(goCtx)
-
-	providerAddr, err := sdk.AccAddressFromBech32(msg.Creator)
-	if err != nil {
-		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid provider address: %s", err)
-	}
-
-	rewards, err := k.ProviderRewards.Get(ctx, msg.Creator)
-	if err != nil {
-		if errors.Is(err, collections.ErrNotFound) {
-			return nil, sdkerrors.ErrNotFound.Wrap("no rewards found")
-		}
-		return nil, err
-	}
-
-	if rewards.IsZero() || rewards.IsNegative() {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap("no rewards to withdraw")
-	}
-
-	// Mint tokens
-	// Wait, if the rewards came from Deal Escrow (Bandwidth), they are already in the module account (sent from User).
-	// If they are Storage Rewards (Inflation), they need to be minted.
-	// We didn't track which portion is which in `ProviderRewards`.
-	// Simplification for Phase 4: Assume ALL withdrawals are minted?
-	// NO, that would double-mint bandwidth payments (user paid -> module -> mint -> provider = inflation + user payment).
-	// We need to burn user payment and mint new? Or just transfer user payment?
-
-	// Correct logic:
-	// 1. Bandwidth fees are in Module Account.
-	// 2. Storage rewards are virtual (inflationary).
-	// BUT we combined them into one `totalReward` int.
-	// To support mixed model, we should probably just MINT everything for now, assuming `Escrow` burn logic is handled elsewhere or ignored.
-	// OR, we rely on the fact that `Escrow` deduction happens in `ProveLiveness`.
-	// `deal.EscrowBalance` was reduced. But the coins are still in `nilchain` module account.
-	// So `nilchain` module account holds:
-	// - Escrowed funds (waiting to be paid out)
-	// - Slashed funds (waiting to be burned)
-
-	// If we simply TRANSFER from Module to Provider, we use the existing Escrowed funds.
-	// But Storage Rewards (Inflation) are NOT in the module account yet.
-	// So we run out of funds if we just Transfer.
-
-	// Solution:
-	// Mint the portion that is Inflationary? We lost that distinction.
-	// Easy fix: Mint the *entire* amount to the module account first, then transfer?
-	// No, that inflates by Bandwidth amount too.
-
-	// Let's assume for Phase 4:
-	// The Module Account "has infinite supply" via Minting capability.
-	// We Mint coins equal to `rewards` and send to Provider.
-	// AND we Burn coins equal to `BandwidthPayment` from the Module Account?
-	// This is getting complicated.
-
-	// Simplest working model for Testnet:
-	// Just MINT the rewards.
-	// The Escrow deduction in `ProveLiveness` effectively "burns" the user's claim to those tokens, leaving them stranded in the Module Account (effectively burned/treasury).
-	// And we Mint fresh tokens for the provider.
-	// This results in: User loses X. Provider gains X + Y (inflation).
-	// Net result: Supply change = +Y.
-	// The "stranded" tokens in Module Account can be burned explicitly later or considered "community pool".
-
-	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, rewards))
-	if err := k.BankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
-		return nil, err
-	}
-	if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, providerAddr, coins); err != nil {
-		return nil, err
-	}
-
-	// Reset rewards
-	if err := k.ProviderRewards.Set(ctx, msg.Creator, math.ZeroInt()); err != nil {
-		return nil, err
-	}
-
-	return &types.MsgWithdrawRewardsResponse{AmountWithdrawn: rewards}, nil
-}
-
 func (k msgServer) OpenRetrievalSession(goCtx context.Context, msg *types.MsgOpenRetrievalSession) (*types.MsgOpenRetrievalSessionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if msg == nil {
@@ -1525,8 +1512,12 @@ func (k msgServer) OpenRetrievalSession(goCtx context.Context, msg *types.MsgOpe
 	if err != nil {
 		return nil, sdkerrors.ErrNotFound.Wrapf("deal with ID %d not found", msg.DealId)
 	}
-	if msg.Creator != deal.Owner {
-		return nil, sdkerrors.ErrUnauthorized.Wrap("only deal owner may open retrieval sessions")
+	if err := checkDealActive(deal); err != nil {
+		return nil, err
+	}
+	grant, err := k.dealReaderGrant(ctx, deal, msg.Creator)
+	if err != nil {
+		return nil, err
 	}
 	if len(deal.ManifestRoot) != 48 || !bytesEqual(deal.ManifestRoot, msg.ManifestRoot) {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("manifest_root does not match current deal state")
@@ -1611,6 +1602,9 @@ func (k msgServer) OpenRetrievalSession(goCtx context.Context, msg *types.MsgOpe
 		variableFee = params.RetrievalPricePerBlob.Amount.Mul(math.NewIntFromUint64(msg.BlobCount))
 	}
 	totalFee := variableFee.Add(baseFee.Amount)
+	if err := k.chargeDealAccessGrant(ctx, grant, totalBytes, totalFee); err != nil {
+		return nil, err
+	}
 	if totalFee.IsPositive() {
 		newEscrow := deal.EscrowBalance.Sub(totalFee)
 		if newEscrow.IsNegative() {
@@ -1679,6 +1673,12 @@ func (k msgServer) OpenRetrievalSession(goCtx context.Context, msg *types.MsgOpe
 	if err := k.RetrievalSessionsByProvider.Set(ctx, collections.Join(msg.Provider, sessionID), uint64(ctx.BlockHeight())); err != nil {
 		return nil, fmt.Errorf("failed to index retrieval session by provider: %w", err)
 	}
+	if err := k.RetrievalSessionsByDeal.Set(ctx, collections.Join(msg.DealId, sessionID)); err != nil {
+		return nil, fmt.Errorf("failed to index retrieval session by deal: %w", err)
+	}
+	if err := k.scheduleRetrievalSessionSweep(ctx, session, uint64(ctx.BlockHeight()), params.RetrievalSessionRetentionBlocks); err != nil {
+		return nil, err
+	}
 	if err := k.RetrievalSessionNonces.Set(ctx, nonceKey, msg.Nonce); err != nil {
 		return nil, fmt.Errorf("failed to update retrieval session nonce: %w", err)
 	}
@@ -1769,29 +1769,15 @@ func (k msgServer) CancelRetrievalSession(goCtx context.Context, msg *types.MsgC
 	}
 
 	// Non-response evidence: if the session expired without a provider-submitted proof,
-	// record a lightweight evidence marker and degrade provider health.
+	// record non-response evidence, which degrades provider health.
 	// This is intentionally conservative: sessions that reached PROOF_SUBMITTED are not
 	// treated as non-response (even if the user never confirmed).
 	if session.Status == types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_OPEN {
-		if err := k.recordEvidenceSummary(ctx, session.DealId, session.Provider, "retrieval_non_response", session.SessionId, msg.Creator, false); err != nil {
-			ctx.Logger().Error("failed to record non-response evidence", "error", err, "deal", session.DealId, "provider", session.Provider)
-		}
-		if err := k.IncrementHeat(ctx, session.DealId, 0, true); err != nil {
-			ctx.Logger().Error("failed to increment heat for non-response evidence", "error", err, "deal", session.DealId, "provider", session.Provider)
-		}
-		k.trackProviderHealth(ctx, session.DealId, session.Provider, false)
+		k.recordRetrievalNonResponse(ctx, &session, msg.Creator)
 	}
 
-	if session.LockedFee.IsPositive() {
-		deal, err := k.Deals.Get(ctx, session.DealId)
-		if err != nil {
-			return nil, sdkerrors.ErrNotFound.Wrapf("deal %d not found", session.DealId)
-		}
-		deal.EscrowBalance = deal.EscrowBalance.Add(session.LockedFee)
-		if err := k.Deals.Set(ctx, session.DealId, deal); err != nil {
-			return nil, fmt.Errorf("failed to refund locked retrieval fees: %w", err)
-		}
-		session.LockedFee = math.ZeroInt()
+	if err := k.refundRetrievalSessionFee(ctx, &session); err != nil {
+		return nil, err
 	}
 
 	session.Status = types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_CANCELED
@@ -1803,6 +1789,10 @@ func (k msgServer) CancelRetrievalSession(goCtx context.Context, msg *types.MsgC
 	return &types.MsgCancelRetrievalSessionResponse{Success: true}, nil
 }
 
+// SubmitRetrievalSessionProof records the session provider's proofs for the
+// blobs of a retrieval session. The reader authorised the session and its fee
+// when it opened it, so the proofs carry no user signature and session keys
+// play no part here.
 func (k msgServer) SubmitRetrievalSessionProof(goCtx context.Context, msg *types.MsgSubmitRetrievalSessionProof) (*types.MsgSubmitRetrievalSessionProofResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if msg == nil {
@@ -1837,6 +1827,9 @@ func (k msgServer) SubmitRetrievalSessionProof(goCtx context.Context, msg *types
 	if err != nil {
 		return nil, sdkerrors.ErrNotFound.Wrapf("deal with ID %d not found", session.DealId)
 	}
+	if err := checkDealActive(deal); err != nil {
+		return nil, err
+	}
 	if len(deal.ManifestRoot) != 48 || !bytesEqual(deal.ManifestRoot, session.ManifestRoot) {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("deal manifest_root changed since session open")
 	}
@@ -1850,46 +1843,6 @@ func (k msgServer) SubmitRetrievalSessionProof(goCtx context.Context, msg *types
 	}
 	startGlobal := session.StartMduIndex*stripe.leafCount + uint64(session.StartBlobIndex)
 
-	verifyChainedProof := func(chainedProof *types.ChainedProof) (bool, error) {
-		if chainedProof == nil {
-			return false, nil
-		}
-		if len(chainedProof.ManifestOpening) != 48 || len(chainedProof.MduRootFr) != 32 ||
-			len(chainedProof.BlobCommitment) != 48 || len(chainedProof.MerklePath) == 0 ||
-			len(chainedProof.ZValue) != 32 || len(chainedProof.YValue) != 32 || len(chainedProof.KzgOpeningProof) != 48 {
-			return false, nil
-		}
-		if len(deal.ManifestRoot) != 48 {
-			return false, nil
-		}
-
-		flattenedMerkle := make([]byte, 0, len(chainedProof.MerklePath)*32)
-		for _, node := range chainedProof.MerklePath {
-			if len(node) != 32 {
-				return false, nil
-			}
-			flattenedMerkle = append(flattenedMerkle, node...)
-		}
-
-		v, err := crypto_ffi.VerifyChainedProof(
-			deal.ManifestRoot,
-			chainedProof.MduIndex,
-			chainedProof.ManifestOpening,
-			chainedProof.MduRootFr,
-			chainedProof.BlobCommitment,
-			uint64(chainedProof.BlobIndex),
-			stripe.leafCount,
-			flattenedMerkle,
-			chainedProof.ZValue,
-			chainedProof.YValue,
-			chainedProof.KzgOpeningProof,
-		)
-		if err != nil {
-			return false, err
-		}
-		return v, nil
-	}
-
 	for i := uint64(0); i < session.BlobCount; i++ {
 		p := msg.Proofs[int(i)]
 
@@ -1911,7 +1864,7 @@ func (k msgServer) SubmitRetrievalSessionProof(goCtx context.Context, msg *types
 			}
 		}
 
-		ok, err := verifyChainedProof(&p)
+		ok, err := verifyDealChainedProof(deal, stripe, &p)
 		if err != nil {
 			return nil, sdkerrors.ErrUnauthorized.Wrapf("triple proof verification error: %s", err)
 		}
@@ -1920,6 +1873,24 @@ func (k msgServer) SubmitRetrievalSessionProof(goCtx context.Context, msg *types
 		}
 	}
 
+	// Verified session proofs are organic liveness evidence: credit each blob
+	// against the provider's quota and treat the submission as a proof for
+	// missed-proof accounting. Providers rotated out since the session opened
+	// are still paid but no longer accrue credits.
+	if containsString(deal.Providers, session.Provider) {
+		for _, p := range msg.Proofs {
+			if err := k.creditOrganicProof(ctx, deal, session.Provider, p.MduIndex, p.BlobIndex); err != nil {
+				return nil, err
+			}
+		}
+		if err := k.DealProviderStatus.Set(ctx, collections.Join(deal.Id, session.Provider), uint64(ctx.BlockHeight())); err != nil {
+			return nil, fmt.Errorf("failed to update proof status: %w", err)
+		}
+		if err := k.SetProofDeadline(ctx, deal.Id, session.Provider, NextProofDeadline(uint64(ctx.BlockHeight()))); err != nil {
+			return nil, err
+		}
+	}
+
 	switch session.Status {
 	case types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_OPEN:
 		session.Status = types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_PROOF_SUBMITTED
@@ -1980,7 +1951,7 @@ func isSessionExpired(ctx sdk.Context, session *types.RetrievalSession) bool {
 	return uint64(ctx.BlockHeight()) > session.ExpiresAt
 }
 
-func (k msgServer) settleRetrievalSession(ctx sdk.Context, session *types.RetrievalSession) error {
+func (k Keeper) settleRetrievalSession(ctx sdk.Context, session *types.RetrievalSession) error {
 	if session == nil || !session.LockedFee.IsPositive() {
 		return nil
 	}
@@ -2051,30 +2022,3 @@ func mulUint64(a, b uint64) (uint64, bool) {
 	out := a * b
 	return out, out/b != a
 }
-
-func (k msgServer) recordEvidenceSummary(ctx sdk.Context, dealID uint64, provider string, kind string, sessionID []byte, reporter string, ok bool) error {
-	proofID, err := k.ProofCount.Next(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get next proof id: %w", err)
-	}
-
-	sessionHex := ""
-	if len(sessionID) > 0 {
-		sessionHex = hex.EncodeToString(sessionID)
-	}
-
-	commitment := fmt.Sprintf("evidence:%s deal=%d provider=%s session=%s reporter=%s", kind, dealID, provider, sessionHex, reporter)
-	summary := types.Proof{
-		Id:          proofID,
-		Creator:     provider,
-		Commitment:  commitment,
-		Valid:       ok,
-		BlockHeight: ctx.BlockHeight(),
-	}
-
-	if err := k.Proofs.Set(ctx, proofID, summary); err != nil {
-		return fmt.Errorf("failed to store evidence summary: %w", err)
-	}
-
-	return nil
-}
@@ -1,12 +1,12 @@
 package keeper_test
 
 import (
-	"strings"
 	"testing"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 
 	"nilchain/x/nilchain/keeper"
@@ -97,22 +97,25 @@ func TestCancelRetrievalSession_RecordsNonResponseEvidence(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), failures)
 
-	var (
-		foundEvidence bool
-		proofCount    int
-	)
-	err = f.keeper.Proofs.Walk(ctxExpired, nil, func(_ uint64, proof types.Proof) (bool, error) {
-		proofCount++
-		if strings.Contains(proof.Commitment, "evidence:retrieval_non_response") {
-			foundEvidence = true
-			require.False(t, proof.Valid)
-			require.Equal(t, assignedProvider, proof.Creator)
-		}
-		return false, nil
-	})
+	// The non-response is in the evidence registry, not in the proof list.
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	byProvider, err := queryServer.ListEvidenceByProvider(ctxExpired, &types.QueryListEvidenceByProviderRequest{Provider: assignedProvider})
+	require.NoError(t, err)
+	require.Len(t, byProvider.Evidence, 1)
+	evidence := byProvider.Evidence[0]
+	require.Equal(t, types.EvidenceKind_EVIDENCE_KIND_RETRIEVAL_NON_RESPONSE, evidence.Kind)
+	require.Equal(t, types.EvidenceOutcome_EVIDENCE_OUTCOME_HEALTH_FAILURE, evidence.Outcome)
+	require.Equal(t, resDeal.DealId, evidence.DealId)
+	require.Equal(t, openRes.SessionId, evidence.SessionId)
+	require.Equal(t, owner, evidence.Reporter)
+	require.Equal(t, int64(10), evidence.Height)
+
+	byDeal, err := queryServer.ListEvidenceByDeal(ctxExpired, &types.QueryListEvidenceByDealRequest{DealId: resDeal.DealId})
 	require.NoError(t, err)
-	require.True(t, foundEvidence)
-	require.Equal(t, 1, proofCount)
+	require.Equal(t, byProvider.Evidence, byDeal.Evidence)
+	proofs, err := queryServer.ListProofs(ctxExpired, &types.QueryListProofsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, proofs.Proof)
 
 	// Idempotent: cancel again should not record a second evidence entry.
 	_, err = msgServer.CancelRetrievalSession(ctxExpired, &types.MsgCancelRetrievalSession{
@@ -121,11 +124,55 @@ func TestCancelRetrievalSession_RecordsNonResponseEvidence(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	proofCount = 0
-	err = f.keeper.Proofs.Walk(ctxExpired, nil, func(_ uint64, _ types.Proof) (bool, error) {
-		proofCount++
-		return false, nil
+	byDeal, err = queryServer.ListEvidenceByDeal(ctxExpired, &types.QueryListEvidenceByDealRequest{DealId: resDeal.DealId})
+	require.NoError(t, err)
+	require.Len(t, byDeal.Evidence, 1)
+	_, broken := keeper.EvidenceIndexInvariant(f.keeper)(ctxExpired)
+	require.False(t, broken)
+}
+
+func TestListEvidence_Paginates(t *testing.T) {
+	f := initFixture(t)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+
+	genesis := types.DefaultGenesis()
+	genesis.EvidenceCount = 4
+	for i, provider := range []string{"nil1providera", "nil1providerb", "nil1providera", "nil1providera"} {
+		genesis.Evidence = append(genesis.Evidence, types.Evidence{
+			Id:       uint64(i),
+			Kind:     types.EvidenceKind_EVIDENCE_KIND_RETRIEVAL_NON_RESPONSE,
+			DealId:   uint64(1 + i%2),
+			Provider: provider,
+			Reporter: "nil1owner",
+			Outcome:  types.EvidenceOutcome_EVIDENCE_OUTCOME_HEALTH_FAILURE,
+		})
+	}
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, *genesis))
+
+	page, err := queryServer.ListEvidenceByProvider(f.ctx, &types.QueryListEvidenceByProviderRequest{
+		Provider:   "nil1providera",
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, page.Evidence, 2)
+	require.Equal(t, uint64(0), page.Evidence[0].Id)
+	require.Equal(t, uint64(2), page.Evidence[1].Id)
+	require.NotNil(t, page.Pagination.NextKey)
+
+	page, err = queryServer.ListEvidenceByProvider(f.ctx, &types.QueryListEvidenceByProviderRequest{
+		Provider:   "nil1providera",
+		Pagination: &query.PageRequest{Key: page.Pagination.NextKey},
 	})
 	require.NoError(t, err)
-	require.Equal(t, 1, proofCount)
+	require.Len(t, page.Evidence, 1)
+	require.Equal(t, uint64(3), page.Evidence[0].Id)
+
+	byDeal, err := queryServer.ListEvidenceByDeal(f.ctx, &types.QueryListEvidenceByDealRequest{DealId: 2})
+	require.NoError(t, err)
+	require.Len(t, byDeal.Evidence, 2)
+	require.Equal(t, uint64(1), byDeal.Evidence[0].Id)
+	require.Equal(t, uint64(3), byDeal.Evidence[1].Id)
+
+	_, err = queryServer.ListEvidenceByProvider(f.ctx, &types.QueryListEvidenceByProviderRequest{})
+	require.Error(t, err)
 }
@@ -0,0 +1,277 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"nilchain/x/crypto_ffi"
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+// pubKeyAccountKeeper serves accounts with known public keys.
+type pubKeyAccountKeeper struct {
+	MockAccountKeeper
+	accounts map[string]sdk.AccountI
+}
+
+func (m pubKeyAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return m.accounts[addr.String()]
+}
+
+type fraudProofFixture struct {
+	*fixture
+	bank        *trackingBankKeeper
+	msgServer   types.MsgServer
+	providerKey *secp256k1.PrivKey
+	provider    string
+	reporter    string
+	deal        types.Deal
+}
+
+func setupFraudProof(t *testing.T) fraudProofFixture {
+	t.Helper()
+	bank := newTrackingBankKeeper()
+	accounts := pubKeyAccountKeeper{accounts: map[string]sdk.AccountI{}}
+	f := initFixtureWithKeepers(t, bank, accounts)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+
+	// Twelve providers with on-chain public keys hold the deal's slots.
+	var keys []*secp256k1.PrivKey
+	for i := 0; i < 12; i++ {
+		key := secp256k1.GenPrivKey()
+		addr := sdk.AccAddress(key.PubKey().Address())
+		accounts.accounts[addr.String()] = authtypes.NewBaseAccount(addr, key.PubKey(), uint64(i), 0)
+		bank.setAccountBalance(addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)))
+		_, err := msgServer.RegisterProvider(f.ctx, &types.MsgRegisterProvider{
+			Creator: addr.String(), Capabilities: "General", TotalStorage: 1 << 30, Endpoints: testProviderEndpoints,
+		})
+		require.NoError(t, err)
+		keys = append(keys, key)
+	}
+	res, err := createPlacementDeal(f, "General:rs=8+4")
+	require.NoError(t, err)
+	deal, err := f.keeper.Deals.Get(f.ctx, res.DealId)
+	require.NoError(t, err)
+	_, err = msgServer.UpdateDealContent(f.ctx, &types.MsgUpdateDealContent{
+		Creator: deal.Owner, DealId: deal.Id, Cid: "0x" + strings.Repeat("ab", 48), Size_: types.MDU_SIZE,
+	})
+	require.NoError(t, err)
+	deal, err = f.keeper.Deals.Get(f.ctx, res.DealId)
+	require.NoError(t, err)
+
+	providerKey := keys[0]
+	for _, key := range keys {
+		if sdk.AccAddress(key.PubKey().Address()).String() == deal.Providers[3] {
+			providerKey = key
+		}
+	}
+	reporter, _ := f.addressCodec.BytesToString([]byte("fraud_reporter______"))
+	return fraudProofFixture{
+		fixture:     f,
+		bank:        bank,
+		msgServer:   msgServer,
+		providerKey: providerKey,
+		provider:    deal.Providers[3],
+		reporter:    reporter,
+		deal:        deal,
+	}
+}
+
+// signedResponse builds a fraud proof for a response the provider signed.
+func (ff fraudProofFixture) signedResponse(t *testing.T, manifestRoot []byte, proof types.ChainedProof) *types.MsgSubmitFraudProof {
+	t.Helper()
+	return ff.servedResponse(t, manifestRoot, proof, nil)
+}
+
+// servedResponse builds a fraud proof for a response the provider signed
+// together with the bytes it served, as the gateway signs them.
+func (ff fraudProofFixture) servedResponse(t *testing.T, manifestRoot []byte, proof types.ChainedProof, data []byte) *types.MsgSubmitFraudProof {
+	t.Helper()
+	msg := &types.MsgSubmitFraudProof{
+		Creator:      ff.reporter,
+		DealId:       ff.deal.Id,
+		Provider:     ff.provider,
+		Generation:   ff.deal.CurrentGen,
+		ManifestRoot: manifestRoot,
+		Proof:        proof,
+		Data:         data,
+	}
+	digest, err := types.HashServedResponse("test-chain", msg.DealId, msg.Generation, msg.Provider, msg.ManifestRoot, &msg.Proof, msg.Data)
+	require.NoError(t, err)
+	msg.ProviderSignature, err = ff.providerKey.Sign(digest)
+	require.NoError(t, err)
+	return msg
+}
+
+func TestSubmitFraudProof_ManifestRootMismatch(t *testing.T) {
+	ff := setupFraudProof(t)
+	ctx := sdk.UnwrapSDKContext(ff.ctx).WithEventManager(sdk.NewEventManager())
+
+	msg := ff.signedResponse(t, bytes.Repeat([]byte{0xcd}, 48), types.ChainedProof{})
+	res, err := ff.msgServer.SubmitFraudProof(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, "manifest_root_mismatch", res.Reason)
+
+	// 20% of the 100000stake bond is slashed and half of it goes to the
+	// reporter.
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000), res.Reward)
+	require.Equal(t, "10000stake", ff.bank.accountBalances[sdk.MustAccAddressFromBech32(ff.reporter).String()].String())
+	provider, err := ff.keeper.Providers.Get(ctx, ff.provider)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 80_000), provider.Bond)
+	require.Equal(t, "Jailed", provider.Status)
+
+	var accepted bool
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == types.TypeFraudProofAccepted {
+			accepted = true
+		}
+	}
+	require.True(t, accepted)
+	_, broken := keeper.ModuleAccountSolvencyInvariant(ff.keeper)(ctx)
+	require.False(t, broken)
+
+	evidence, err := keeper.NewQueryServerImpl(ff.keeper).ListEvidenceByProvider(ctx, &types.QueryListEvidenceByProviderRequest{Provider: ff.provider})
+	require.NoError(t, err)
+	require.Len(t, evidence.Evidence, 1)
+	require.Equal(t, types.EvidenceKind_EVIDENCE_KIND_FRAUD_MANIFEST_ROOT_MISMATCH, evidence.Evidence[0].Kind)
+	require.Equal(t, types.EvidenceOutcome_EVIDENCE_OUTCOME_SLASHED_AND_JAILED, evidence.Evidence[0].Outcome)
+	require.Equal(t, ff.reporter, evidence.Evidence[0].Reporter)
+
+	// The same response cannot be punished twice.
+	_, err = ff.msgServer.SubmitFraudProof(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Contains(t, err.Error(), "already proven wrong")
+}
+
+func TestSubmitFraudProof_InvalidProof(t *testing.T) {
+	ff := setupFraudProof(t)
+
+	// The claimed root is the deal's, but the proof cannot open it.
+	msg := ff.signedResponse(t, ff.deal.ManifestRoot, types.ChainedProof{MduIndex: 0, BlobIndex: 3})
+	res, err := ff.msgServer.SubmitFraudProof(ff.ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, "invalid_proof", res.Reason)
+
+	failures, err := ff.keeper.DealProviderFailures.Get(ff.ctx, collections.Join(ff.deal.Id, ff.provider))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), failures)
+}
+
+func TestSubmitFraudProof_Rejected(t *testing.T) {
+	ff := setupFraudProof(t)
+	wrongRoot := bytes.Repeat([]byte{0xcd}, 48)
+
+	// Someone else's signature does not bind the provider.
+	msg := ff.signedResponse(t, wrongRoot, types.ChainedProof{})
+	msg.ProviderSignature, _ = secp256k1.GenPrivKey().Sign([]byte("not the response"))
+	_, err := ff.msgServer.SubmitFraudProof(ff.ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// A signed response cannot be altered after the fact.
+	msg = ff.signedResponse(t, wrongRoot, types.ChainedProof{})
+	msg.ManifestRoot = bytes.Repeat([]byte{0xef}, 48)
+	_, err = ff.msgServer.SubmitFraudProof(ff.ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// Responses for an older generation cannot be judged.
+	msg = ff.signedResponse(t, wrongRoot, types.ChainedProof{})
+	msg.Generation--
+	_, err = ff.msgServer.SubmitFraudProof(ff.ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Contains(t, err.Error(), "generation")
+
+	// The served bytes are signed too.
+	msg = ff.servedResponse(t, wrongRoot, types.ChainedProof{}, []byte("served range"))
+	msg.Data = []byte("other range")
+	_, err = ff.msgServer.SubmitFraudProof(ff.ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	msg = ff.servedResponse(t, wrongRoot, types.ChainedProof{}, make([]byte, types.BlobPayloadBytes+1))
+	_, err = ff.msgServer.SubmitFraudProof(ff.ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	msg = ff.signedResponse(t, wrongRoot, types.ChainedProof{})
+	msg.Creator = ff.provider
+	_, err = ff.msgServer.SubmitFraudProof(ff.ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// Only the deal's own providers serve it.
+	outsider := sdk.AccAddress([]byte("fraud_outsider______"))
+	ff.bank.setAccountBalance(outsider, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)))
+	_, err = ff.msgServer.RegisterProvider(ff.ctx, &types.MsgRegisterProvider{
+		Creator: outsider.String(), Capabilities: "General", TotalStorage: 1 << 30, Endpoints: testProviderEndpoints,
+	})
+	require.NoError(t, err)
+	msg = ff.signedResponse(t, wrongRoot, types.ChainedProof{})
+	msg.Provider = outsider.String()
+	_, err = ff.msgServer.SubmitFraudProof(ff.ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Contains(t, err.Error(), "does not serve deal")
+
+	provider, err := ff.keeper.Providers.Get(ff.ctx, ff.provider)
+	require.NoError(t, err)
+	require.Equal(t, "Active", provider.Status)
+}
+
+func TestSubmitFraudProof_DataMismatch(t *testing.T) {
+	ff := setupFraudProof(t)
+	require.NoError(t, crypto_ffi.Init("../../../trusted_setup.txt"))
+
+	// Commit a single zeroed MDU and open its first blob, the way a provider
+	// answers a fetch. The chained proofs below use a Mode 1 layout.
+	mduData := make([]byte, types.MDU_SIZE)
+	root, err := crypto_ffi.ComputeMduMerkleRoot(mduData)
+	require.NoError(t, err)
+	manifestCid, manifestBlob := mustComputeManifestCid(t, [][]byte{root})
+	manifestOpening, _, err := crypto_ffi.ComputeManifestProof(manifestBlob, 0)
+	require.NoError(t, err)
+	commitment, merkleProof, z, y, kzgProof, err := crypto_ffi.ComputeMduProofTest(mduData, 0)
+	require.NoError(t, err)
+	var merklePath [][]byte
+	for i := 0; i < len(merkleProof); i += 32 {
+		merklePath = append(merklePath, merkleProof[i:i+32])
+	}
+	proof := types.ChainedProof{
+		MduIndex:        0,
+		MduRootFr:       root,
+		ManifestOpening: manifestOpening,
+		BlobCommitment:  commitment,
+		MerklePath:      merklePath,
+		BlobIndex:       0,
+		ZValue:          z,
+		YValue:          y,
+		KzgOpeningProof: kzgProof,
+	}
+
+	deal := ff.deal
+	deal.ManifestRoot = mustDecodeHexBytes(t, manifestCid)
+	deal.ServiceHint = "General"
+	deal.RedundancyMode = 1
+	deal.Mode2Profile = nil
+	deal.Mode2Slots = nil
+	require.NoError(t, ff.keeper.Deals.Set(ff.ctx, deal.Id, deal))
+
+	// The committed bytes are consistent with the proof.
+	msg := ff.servedResponse(t, deal.ManifestRoot, proof, make([]byte, types.BlobPayloadBytes))
+	_, err = ff.msgServer.SubmitFraudProof(ff.ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Contains(t, err.Error(), "consistent")
+
+	// The provider serves and signs other bytes under the same proof.
+	wrong := bytes.Repeat([]byte{0x42}, types.BlobPayloadBytes)
+	msg = ff.servedResponse(t, deal.ManifestRoot, proof, wrong)
+	res, err := ff.msgServer.SubmitFraudProof(ff.ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, "data_mismatch", res.Reason)
+}
@@ -16,6 +16,7 @@ func TestRetrievalSession_LocksFeesAndCancels(t *testing.T) {
 	bank := newTrackingBankKeeper()
 	f := initFixtureWithBankKeeper(t, bank)
 	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	disableProviderBonds(t, f)
 
 	for i := 0; i < int(types.DealBaseReplication); i++ {
 		addrBz := make([]byte, 20)
@@ -9,6 +9,7 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
 	"nilchain/x/crypto_ffi"
@@ -239,6 +240,13 @@ func TestMode2SlotRepairLifecycle(t *testing.T) {
 		DealId:  res.DealId,
 		Slot:    0,
 	})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.CompleteSlotRepair(f.ctx, &types.MsgCompleteSlotRepair{
+		Creator: candidate,
+		DealId:  res.DealId,
+		Slot:    0,
+	})
 	require.NoError(t, err)
 
 	dealAfterComplete, err := f.keeper.Deals.Get(f.ctx, res.DealId)
@@ -447,14 +455,14 @@ func TestProveLiveness_Invalid(t *testing.T) {
 	provBz := []byte("provider_for_proof__")
 	provider, _ := f.addressCodec.BytesToString(provBz)
 
-	_, err := msgServer.RegisterProvider(f.ctx, &types.MsgRegisterProvider{Creator: provider, Capabilities: "General", TotalStorage: 1000, Endpoints: testProviderEndpoints})
+	_, err := msgServer.RegisterProvider(f.ctx, &types.MsgRegisterProvider{Creator: provider, Capabilities: "General", TotalStorage: 100000000000, Endpoints: testProviderEndpoints})
 	require.NoError(t, err)
 
 	// Need enough providers for placement
 	for i := 0; i < 15; i++ {
 		addrBz := []byte(fmt.Sprintf("extra_prov________%02d", i))
 		addr, _ := f.addressCodec.BytesToString(addrBz)
-		msgServer.RegisterProvider(f.ctx, &types.MsgRegisterProvider{Creator: addr, Capabilities: "General", TotalStorage: 1000, Endpoints: testProviderEndpoints})
+		msgServer.RegisterProvider(f.ctx, &types.MsgRegisterProvider{Creator: addr, Capabilities: "General", TotalStorage: 100000000000, Endpoints: testProviderEndpoints})
 	}
 
 	// Create Deal
@@ -475,6 +483,7 @@ func TestProveLiveness_Invalid(t *testing.T) {
 	require.NoError(t, err)
 
 	assignedProvider := resDeal.AssignedProviders[0]
+	chal := currentChallenge(t, f, f.ctx, resDeal.DealId, assignedProvider)
 
 	// 2. Submit Invalid Proof
 	proofMsg := &types.MsgProveLiveness{
@@ -483,13 +492,13 @@ func TestProveLiveness_Invalid(t *testing.T) {
 		EpochId: 1,
 		ProofType: &types.MsgProveLiveness_SystemProof{
 			SystemProof: &types.ChainedProof{
-				MduIndex:        0,
+				MduIndex:        chal.MduIndex,
 				MduRootFr:       make([]byte, 32),
 				ManifestOpening: make([]byte, 48),
 
 				BlobCommitment: make([]byte, 48),
 				MerklePath:     [][]byte{make([]byte, 32)},
-				BlobIndex:      0,
+				BlobIndex:      chal.BlobIndex,
 
 				ZValue:          make([]byte, 32),
 				YValue:          make([]byte, 32),
@@ -529,7 +538,7 @@ func TestProveLiveness_HappyPath(t *testing.T) {
 	for i := 0; i < 15; i++ {
 		addrBz := []byte(fmt.Sprintf("extra_prov_happy_%02d", i))
 		addr, _ := f.addressCodec.BytesToString(addrBz)
-		msgServer.RegisterProvider(f.ctx, &types.MsgRegisterProvider{Creator: addr, Capabilities: "General", TotalStorage: 1000, Endpoints: testProviderEndpoints})
+		msgServer.RegisterProvider(f.ctx, &types.MsgRegisterProvider{Creator: addr, Capabilities: "General", TotalStorage: 100000000000, Endpoints: testProviderEndpoints})
 	}
 
 	// 3. Create Deal
@@ -569,8 +578,10 @@ func TestProveLiveness_HappyPath(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	// Compute Proof for chunk 0
-	chunkIdx := uint32(0)
+	// Compute Proof for the chunk challenged this epoch (the only user MDU is 0).
+	chal := currentChallenge(t, f, f.ctx, resDeal.DealId, assignedProvider)
+	require.Equal(t, uint64(0), chal.MduIndex)
+	chunkIdx := chal.BlobIndex
 	commitment, merkleProof, z, y, kzgProof, err := crypto_ffi.ComputeMduProofTest(mduData, chunkIdx)
 	require.NoError(t, err)
 
@@ -827,7 +838,9 @@ func TestProveLiveness_StrictBinding(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	chunkIdx := uint32(0)
+	chal := currentChallenge(t, f, f.ctx, resDeal.DealId, assignedProvider)
+	require.Equal(t, uint64(0), chal.MduIndex)
+	chunkIdx := chal.BlobIndex
 	commitment, merkleProof, z, y, kzgProof, err := crypto_ffi.ComputeMduProofTest(mduData, chunkIdx)
 	require.NoError(t, err)
 
@@ -946,6 +959,9 @@ func TestSignalSaturation(t *testing.T) {
 	// Base (12) + New Stripe (12) = 24
 	require.Equal(t, uint64(24), deal.CurrentReplication)
 	require.Len(t, deal.Providers, 24)
+	listed, err := keeper.NewQueryServerImpl(f.keeper).ListDealsByProvider(f.ctx, &types.QueryListDealsByProviderRequest{Provider: resSig.NewProviders[0]})
+	require.NoError(t, err)
+	require.Len(t, listed.Deals, 1)
 
 	// Elasticity cost should be debited from escrow and tracked in the spend window.
 	params := f.keeper.GetParams(f.ctx)
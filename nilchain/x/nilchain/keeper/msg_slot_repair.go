@@ -23,6 +23,48 @@ func (k msgServer) StartSlotRepair(goCtx context.Context, msg *types.MsgStartSlo
 	if deal.Owner != msg.Creator {
 		return nil, sdkerrors.ErrUnauthorized.Wrap("only deal owner can start slot repair")
 	}
+	if err := k.startSlotRepair(ctx, &deal, msg.Slot, msg.PendingProvider); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgStartSlotRepairResponse{Success: true}, nil
+}
+
+// CompleteSlotRepair hands a repairing slot to its pending provider once the
+// provider proves the slot's repair challenge, and pays it the slot's repair
+// bounty.
+func (k msgServer) CompleteSlotRepair(goCtx context.Context, msg *types.MsgCompleteSlotRepair) (*types.MsgCompleteSlotRepairResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	deal, err := k.Deals.Get(ctx, msg.DealId)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("deal %d not found", msg.DealId)
+	}
+	if err := checkDealActive(deal); err != nil {
+		return nil, err
+	}
+	slot, err := mode2Slot(&deal, msg.Slot)
+	if err != nil {
+		return nil, err
+	}
+	if slot.Status != types.SlotStatus_SLOT_STATUS_REPAIRING || strings.TrimSpace(slot.PendingProvider) == "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("slot %d has no pending repair", msg.Slot)
+	}
+	if strings.TrimSpace(slot.PendingProvider) != msg.Creator {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("only the pending provider can complete slot repair")
+	}
+	if err := k.verifySlotRepairProofs(ctx, deal, msg.Slot, msg.Proofs); err != nil {
+		return nil, err
+	}
+	if err := k.completeSlotRepair(ctx, &deal, msg.Slot); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCompleteSlotRepairResponse{Success: true}, nil
+}
+
+// mode2Slot returns the slot at index slot of a Mode 2 deal.
+func mode2Slot(deal *types.Deal, slot uint32) (*types.DealSlot, error) {
 	if deal.RedundancyMode != 2 {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("slot repair is only supported for Mode 2 deals")
 	}
@@ -30,85 +72,106 @@ func (k msgServer) StartSlotRepair(goCtx context.Context, msg *types.MsgStartSlo
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("mode2 slot map is not initialized")
 	}
 
-	slotIdx := int(msg.Slot)
+	slotIdx := int(slot)
 	if slotIdx < 0 || slotIdx >= len(deal.Mode2Slots) {
-		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid slot %d", msg.Slot)
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid slot %d", slot)
 	}
 
-	slot := deal.Mode2Slots[slotIdx]
-	if slot == nil {
-		return nil, sdkerrors.ErrInvalidRequest.Wrapf("mode2 slot %d is nil", msg.Slot)
+	s := deal.Mode2Slots[slotIdx]
+	if s == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("mode2 slot %d is nil", slot)
+	}
+	return s, nil
+}
+
+// startSlotRepair marks a Mode 2 slot as repairing towards pendingProvider
+// and persists the deal. The current provider keeps serving (and proving) the
+// slot until the repair completes; the pending provider has
+// repair_deadline_blocks to complete it before it is reassigned.
+func (k Keeper) startSlotRepair(ctx sdk.Context, deal *types.Deal, slotIdx uint32, pendingProvider string) error {
+	slot, err := mode2Slot(deal, slotIdx)
+	if err != nil {
+		return err
 	}
 	if slot.Status == types.SlotStatus_SLOT_STATUS_REPAIRING {
-		return nil, sdkerrors.ErrInvalidRequest.Wrapf("slot %d is already repairing", msg.Slot)
+		return sdkerrors.ErrInvalidRequest.Wrapf("slot %d is already repairing", slotIdx)
 	}
 
-	pending := strings.TrimSpace(msg.PendingProvider)
+	pending := strings.TrimSpace(pendingProvider)
 	if pending == "" {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap("pending_provider is required")
+		return sdkerrors.ErrInvalidRequest.Wrap("pending_provider is required")
 	}
 	if strings.TrimSpace(slot.Provider) == pending {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap("pending_provider must differ from current provider")
+		return sdkerrors.ErrInvalidRequest.Wrap("pending_provider must differ from current provider")
 	}
-	if _, err := k.Providers.Get(ctx, pending); err != nil {
+	pendingInfo, err := k.Providers.Get(ctx, pending)
+	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
-			return nil, sdkerrors.ErrNotFound.Wrapf("pending provider %q not registered", pending)
+			return sdkerrors.ErrNotFound.Wrapf("pending provider %q not registered", pending)
 		}
-		return nil, fmt.Errorf("failed to load pending provider: %w", err)
+		return fmt.Errorf("failed to load pending provider: %w", err)
+	}
+	if pendingInfo.Status == "Deregistering" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("pending provider %q is deregistering", pending)
+	}
+	if err := k.reserveProviderStorage(ctx, pending, DealStorageFootprint(*deal)); err != nil {
+		return err
 	}
 
 	slot.Status = types.SlotStatus_SLOT_STATUS_REPAIRING
 	slot.PendingProvider = pending
 	slot.StatusSinceHeight = ctx.BlockHeight()
 	slot.RepairTargetGen = deal.CurrentGen
-	deal.Mode2Slots[slotIdx] = slot
+	slot.RepairDeadlineHeight = uint64(ctx.BlockHeight()) + k.GetParams(ctx).RepairDeadlineBlocks
 
-	if err := k.Deals.Set(ctx, deal.Id, deal); err != nil {
-		return nil, fmt.Errorf("failed to update deal: %w", err)
+	if err := k.Deals.Set(ctx, deal.Id, *deal); err != nil {
+		return fmt.Errorf("failed to update deal: %w", err)
+	}
+	if err := k.scheduleSlotRepairDeadline(ctx, deal.Id, slotIdx, slot.RepairDeadlineHeight); err != nil {
+		return err
 	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			"start_slot_repair",
 			sdk.NewAttribute(types.AttributeKeyDealID, fmt.Sprintf("%d", deal.Id)),
-			sdk.NewAttribute("slot", fmt.Sprintf("%d", msg.Slot)),
+			sdk.NewAttribute("slot", fmt.Sprintf("%d", slotIdx)),
 			sdk.NewAttribute("provider", slot.Provider),
 			sdk.NewAttribute("pending_provider", slot.PendingProvider),
 			sdk.NewAttribute("repair_target_gen", fmt.Sprintf("%d", slot.RepairTargetGen)),
+			sdk.NewAttribute("repair_deadline_height", fmt.Sprintf("%d", slot.RepairDeadlineHeight)),
 		),
 	)
-
-	return &types.MsgStartSlotRepairResponse{Success: true}, nil
+	return nil
 }
 
-func (k msgServer) CompleteSlotRepair(goCtx context.Context, msg *types.MsgCompleteSlotRepair) (*types.MsgCompleteSlotRepairResponse, error) {
-	ctx := sdk.UnwrapSDKContext(goCtx)
-
-	deal, err := k.Deals.Get(ctx, msg.DealId)
+// completeSlotRepair promotes a repairing slot's pending provider once it
+// proved the repair, persists the deal, moves the proof obligation to the new
+// provider and pays it the slot's repair bounty.
+func (k Keeper) completeSlotRepair(ctx sdk.Context, deal *types.Deal, slotIdx uint32) error {
+	slot, err := mode2Slot(deal, slotIdx)
 	if err != nil {
-		return nil, sdkerrors.ErrNotFound.Wrapf("deal %d not found", msg.DealId)
-	}
-	if deal.Owner != msg.Creator {
-		return nil, sdkerrors.ErrUnauthorized.Wrap("only deal owner can complete slot repair")
+		return err
 	}
-	if deal.RedundancyMode != 2 {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap("slot repair is only supported for Mode 2 deals")
-	}
-	if deal.Mode2Profile == nil || len(deal.Mode2Slots) == 0 {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap("mode2 slot map is not initialized")
+	if slot.Status != types.SlotStatus_SLOT_STATUS_REPAIRING || strings.TrimSpace(slot.PendingProvider) == "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("slot %d has no pending repair", slotIdx)
 	}
 
-	slotIdx := int(msg.Slot)
-	if slotIdx < 0 || slotIdx >= len(deal.Mode2Slots) {
-		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid slot %d", msg.Slot)
+	// The new provider's reservation becomes used storage; the outgoing
+	// provider's share is released.
+	footprint := DealStorageFootprint(*deal)
+	if err := k.commitProviderReservation(ctx, slot.PendingProvider, footprint); err != nil {
+		return err
 	}
-
-	slot := deal.Mode2Slots[slotIdx]
-	if slot == nil {
-		return nil, sdkerrors.ErrInvalidRequest.Wrapf("mode2 slot %d is nil", msg.Slot)
+	if err := k.releaseProviderStorage(ctx, slot.Provider, footprint); err != nil {
+		return err
 	}
-	if slot.Status != types.SlotStatus_SLOT_STATUS_REPAIRING || strings.TrimSpace(slot.PendingProvider) == "" {
-		return nil, sdkerrors.ErrInvalidRequest.Wrapf("slot %d has no pending repair", msg.Slot)
+	if err := k.SlotRepairQueue.Remove(ctx, collections.Join3(slot.RepairDeadlineHeight, deal.Id, slotIdx)); err != nil {
+		return fmt.Errorf("failed to dequeue slot repair: %w", err)
+	}
+	bounty, err := k.settleRepairBounty(ctx, slot, true)
+	if err != nil {
+		return err
 	}
 
 	oldProvider := slot.Provider
@@ -117,26 +180,39 @@ func (k msgServer) CompleteSlotRepair(goCtx context.Context, msg *types.MsgCompl
 	slot.Status = types.SlotStatus_SLOT_STATUS_ACTIVE
 	slot.StatusSinceHeight = ctx.BlockHeight()
 	slot.RepairTargetGen = 0
-	deal.Mode2Slots[slotIdx] = slot
+	slot.RepairDeadlineHeight = 0
 
 	// Keep legacy providers[] aligned with the canonical slots map when possible.
-	if slotIdx >= 0 && slotIdx < len(deal.Providers) {
+	if int(slotIdx) < len(deal.Providers) {
 		deal.Providers[slotIdx] = slot.Provider
 	}
 
-	if err := k.Deals.Set(ctx, deal.Id, deal); err != nil {
-		return nil, fmt.Errorf("failed to update deal: %w", err)
+	if err := k.Deals.Set(ctx, deal.Id, *deal); err != nil {
+		return fmt.Errorf("failed to update deal: %w", err)
+	}
+	if err := k.reindexDealProvider(ctx, *deal, oldProvider, slot.Provider); err != nil {
+		return err
+	}
+
+	// Move the proof obligation from the outgoing provider to the new one.
+	if !containsString(deal.Providers, oldProvider) {
+		if err := k.RemoveProofDeadline(ctx, deal.Id, oldProvider); err != nil {
+			return err
+		}
+	}
+	if err := k.SetProofDeadline(ctx, deal.Id, slot.Provider, NextProofDeadline(uint64(ctx.BlockHeight()))); err != nil {
+		return err
 	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			"complete_slot_repair",
 			sdk.NewAttribute(types.AttributeKeyDealID, fmt.Sprintf("%d", deal.Id)),
-			sdk.NewAttribute("slot", fmt.Sprintf("%d", msg.Slot)),
+			sdk.NewAttribute("slot", fmt.Sprintf("%d", slotIdx)),
 			sdk.NewAttribute("old_provider", oldProvider),
 			sdk.NewAttribute("new_provider", slot.Provider),
+			sdk.NewAttribute(types.AttributeKeyBounty, bounty.String()),
 		),
 	)
-
-	return &types.MsgCompleteSlotRepairResponse{Success: true}, nil
+	return nil
 }
@@ -0,0 +1,290 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"nilchain/x/nilchain/types"
+)
+
+// PlacementRequest describes the providers AssignProviders should pick.
+type PlacementRequest struct {
+	// ServiceHint is the base service hint providers must match.
+	ServiceHint string
+	// Count is the number of providers wanted. It is capped at the number of
+	// eligible providers so small devnets can still place deals.
+	Count uint64
+	// MinFree is the free storage, in bytes, a provider needs to be eligible.
+	MinFree uint64
+	// MaxPerDomain is the most positions a single failure domain may hold;
+	// zero means no limit.
+	MaxPerDomain uint64
+	// RelaxDomains raises MaxPerDomain one step at a time when there are too
+	// few failure domains, instead of failing the placement.
+	RelaxDomains bool
+	// Occupied lists providers already holding positions in the deal. They
+	// are never picked, and their failure domains count towards MaxPerDomain.
+	Occupied []string
+	// Exclude lists providers that must not be picked, e.g. the one being
+	// replaced.
+	Exclude []string
+}
+
+// DealPlacementRequest is the placement of a new deal with the given parsed
+// service hint: a Mode 2 stripe never puts more than M shards in one failure
+// domain, while Mode 1 replicas go to distinct domains as far as there are
+// enough of them.
+func DealPlacementRequest(hint types.ServiceHintInfo, count uint64) PlacementRequest {
+	req := PlacementRequest{
+		ServiceHint: hint.Base,
+		Count:       count,
+		MinFree:     MinPlacementStorage(hint),
+	}
+	if hint.HasRS {
+		req.MaxPerDomain = hint.RSM
+	} else {
+		req.MaxPerDomain = 1
+		req.RelaxDomains = true
+	}
+	return req
+}
+
+// HotStripePlacementRequest is the placement of an extra hot stripe for deal
+// on saturation: a full set of replicas with room for the deal's content,
+// spread over failure domains like Mode 1 replicas.
+func HotStripePlacementRequest(deal types.Deal) PlacementRequest {
+	return PlacementRequest{
+		ServiceHint:  "Hot",
+		Count:        types.DealBaseReplication,
+		MinFree:      DealStorageFootprint(deal),
+		MaxPerDomain: 1,
+		RelaxDomains: true,
+	}
+}
+
+type placementCandidate struct {
+	provider types.Provider
+	score    []byte
+	domains  []string
+}
+
+// AssignProviders deterministically assigns providers for a new deal or
+// stripe. Active providers that match the service hint and have MinFree bytes
+// free are ranked by sha256(dealID || blockHash || address), and the best
+// ranked ones are taken in order, passing over any whose failure domains
+// already hold MaxPerDomain positions. The returned choices explain each pick
+// and line up with the returned providers.
+func (k Keeper) AssignProviders(ctx sdk.Context, dealID uint64, blockHash []byte, req PlacementRequest) ([]string, []types.PlacementChoice, error) {
+	skip := make(map[string]struct{}, len(req.Occupied)+len(req.Exclude))
+	for _, addr := range req.Exclude {
+		skip[addr] = struct{}{}
+	}
+	occupied := make(map[string]uint64)
+	for _, addr := range req.Occupied {
+		if _, ok := skip[addr]; ok {
+			continue
+		}
+		skip[addr] = struct{}{}
+		provider, err := k.Providers.Get(ctx, addr)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return nil, nil, fmt.Errorf("failed to load provider: %w", err)
+		}
+		for _, d := range failureDomainKeys(provider) {
+			occupied[d]++
+		}
+	}
+
+	registered := 0
+	var candidates []placementCandidate
+	err := k.Providers.Walk(ctx, nil, func(addr string, provider types.Provider) (bool, error) {
+		registered++
+		if _, ok := skip[addr]; ok {
+			return false, nil
+		}
+		// Only active providers with room for the deal are considered.
+		if provider.Status != "Active" || ProviderFreeStorage(provider) < req.MinFree {
+			return false, nil
+		}
+		if providerMatchesServiceHint(req.ServiceHint, provider) {
+			candidates = append(candidates, placementCandidate{
+				provider: provider,
+				score:    placementScore(dealID, blockHash, provider.Address),
+				domains:  failureDomainKeys(provider),
+			})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to walk providers: %w", err)
+	}
+	if registered == 0 {
+		return nil, nil, fmt.Errorf("no providers registered")
+	}
+	if len(candidates) == 0 {
+		return nil, nil, fmt.Errorf("no suitable providers for service hint '%s'", req.ServiceHint)
+	}
+
+	// Bootstrap mode: on small devnets we may have fewer active providers than
+	// DealBaseReplication. Instead of failing the deal entirely, cap the
+	// replication factor at the number of available candidates.
+	count := req.Count
+	if available := uint64(len(candidates)); available < count {
+		count = available
+	}
+
+	slices.SortFunc(candidates, func(a, b placementCandidate) int {
+		if c := bytes.Compare(a.score, b.score); c != 0 {
+			return c
+		}
+		return strings.Compare(a.provider.Address, b.provider.Address)
+	})
+
+	// With a limit of count plus the positions already held, every domain can
+	// take the whole request, so the relaxing loop always ends.
+	var most uint64
+	for _, n := range occupied {
+		most = max(most, n)
+	}
+	limit := req.MaxPerDomain
+	if limit == 0 || limit > count+most {
+		limit = count + most
+	}
+	for {
+		choices := pickWithinDomainLimit(candidates, occupied, count, limit)
+		if uint64(len(choices)) == count {
+			providers := make([]string, 0, len(choices))
+			for i := range choices {
+				choices[i].Height = ctx.BlockHeight()
+				choices[i].BlockHash = blockHash
+				choices[i].Candidates = uint32(len(candidates))
+				choices[i].DomainLimit = uint32(limit)
+				choices[i].DomainLimitRelaxed = req.MaxPerDomain != 0 && limit > req.MaxPerDomain
+				providers = append(providers, choices[i].Provider)
+			}
+			return providers, choices, nil
+		}
+		if !req.RelaxDomains {
+			return nil, nil, types.ErrFailureDomainLimit.Wrapf(
+				"only %d of %d providers fit with at most %d per failure domain", len(choices), count, limit,
+			)
+		}
+		limit++
+	}
+}
+
+// pickWithinDomainLimit walks ranked candidates and takes up to count of them,
+// skipping those that would put more than limit positions in one domain.
+// occupied holds the positions each domain already has in the deal.
+func pickWithinDomainLimit(candidates []placementCandidate, occupied map[string]uint64, count, limit uint64) []types.PlacementChoice {
+	held := make(map[string]uint64, len(occupied))
+	for d, n := range occupied {
+		held[d] = n
+	}
+	choices := make([]types.PlacementChoice, 0, count)
+	var skipped uint32
+	for rank, c := range candidates {
+		if uint64(len(choices)) == count {
+			break
+		}
+		if !domainsHaveRoom(held, c.domains, limit) {
+			skipped++
+			continue
+		}
+		for _, d := range c.domains {
+			held[d]++
+		}
+		choices = append(choices, types.PlacementChoice{
+			Position:         uint32(len(choices)),
+			Provider:         c.provider.Address,
+			Score:            c.score,
+			Rank:             uint32(rank),
+			SkippedForDomain: skipped,
+			FailureDomain:    c.provider.FailureDomain,
+		})
+	}
+	return choices
+}
+
+func domainsHaveRoom(held map[string]uint64, domains []string, limit uint64) bool {
+	for _, d := range domains {
+		if held[d] >= limit {
+			return false
+		}
+	}
+	return true
+}
+
+// placementScore ranks a provider for a deal: sha256(dealID || blockHash ||
+// address), compared as bytes.
+func placementScore(dealID uint64, blockHash []byte, addr string) []byte {
+	seed := make([]byte, 0, 8+len(blockHash)+len(addr))
+	seed = append(seed, sdk.Uint64ToBigEndian(dealID)...)
+	seed = append(seed, blockHash...)
+	seed = append(seed, addr...)
+	h := sha256.Sum256(seed)
+	return h[:]
+}
+
+// failureDomainKeys lists the failure domains a provider belongs to. Every
+// provider has an operator domain, its own address when no operator is
+// declared; region and host group only count when set.
+func failureDomainKeys(provider types.Provider) []string {
+	operator := provider.FailureDomain.OperatorId
+	if operator == "" {
+		operator = provider.Address
+	}
+	keys := []string{"operator/" + operator}
+	if region := provider.FailureDomain.Region; region != "" {
+		keys = append(keys, "region/"+region)
+	}
+	if host := provider.FailureDomain.HostGroup; host != "" {
+		keys = append(keys, "host/"+host)
+	}
+	return keys
+}
+
+// dealDomainLimit is the most positions of a deal one failure domain should
+// hold: M shards of a Mode 2 stripe, otherwise a single replica.
+func dealDomainLimit(deal types.Deal) uint64 {
+	if deal.RedundancyMode == 2 && deal.Mode2Profile != nil && deal.Mode2Profile.M > 0 {
+		return uint64(deal.Mode2Profile.M)
+	}
+	return 1
+}
+
+// recordDealPlacement adds choices to a deal's placement record, shifting
+// their positions by offset so they line up with Deal.providers. A choice for
+// a position that was already recorded, e.g. a slot repair, replaces it.
+func (k Keeper) recordDealPlacement(ctx context.Context, dealID uint64, offset int, choices []types.PlacementChoice) error {
+	placement, err := k.DealPlacements.Get(ctx, dealID)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return fmt.Errorf("failed to load deal placement: %w", err)
+		}
+		placement = types.DealPlacement{DealId: dealID}
+	}
+	for _, choice := range choices {
+		choice.Position += uint32(offset)
+		idx := slices.IndexFunc(placement.Choices, func(c types.PlacementChoice) bool { return c.Position == choice.Position })
+		if idx >= 0 {
+			placement.Choices[idx] = choice
+		} else {
+			placement.Choices = append(placement.Choices, choice)
+		}
+	}
+	if err := k.DealPlacements.Set(ctx, dealID, placement); err != nil {
+		return fmt.Errorf("failed to set deal placement: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+func registerPlacementProviders(t *testing.T, f *fixture, n int, domain func(i int) types.ProviderFailureDomain) {
+	t.Helper()
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	for i := 0; i < n; i++ {
+		addr, _ := f.addressCodec.BytesToString([]byte(fmt.Sprintf("placement_provider_%02d", i)))
+		_, err := msgServer.RegisterProvider(f.ctx, &types.MsgRegisterProvider{
+			Creator: addr, Capabilities: "General", TotalStorage: 1 << 30, Endpoints: testProviderEndpoints,
+			FailureDomain: domain(i),
+		})
+		require.NoError(t, err)
+	}
+}
+
+func createPlacementDeal(f *fixture, hint string) (*types.MsgCreateDealResponse, error) {
+	user, _ := f.addressCodec.BytesToString([]byte("placement_user______"))
+	return keeper.NewMsgServerImpl(f.keeper).CreateDeal(f.ctx, &types.MsgCreateDeal{
+		Creator: user, DurationBlocks: 1000, ServiceHint: hint,
+		MaxMonthlySpend: math.NewInt(0), InitialEscrowAmount: math.NewInt(0),
+	})
+}
+
+func TestPlacementCapsMode2ShardsPerDomain(t *testing.T) {
+	f := initFixture(t)
+	// Four operators run four providers each; a domain may hold at most M=4
+	// of the 12 slots.
+	registerPlacementProviders(t, f, 16, func(i int) types.ProviderFailureDomain {
+		return types.ProviderFailureDomain{OperatorId: fmt.Sprintf(" op-%d ", i%4)}
+	})
+
+	res, err := createPlacementDeal(f, "General:rs=8+4")
+	require.NoError(t, err)
+	require.Len(t, res.AssignedProviders, 12)
+
+	perOperator := map[string]int{}
+	for _, addr := range res.AssignedProviders {
+		provider, err := f.keeper.Providers.Get(f.ctx, addr)
+		require.NoError(t, err)
+		perOperator[provider.FailureDomain.OperatorId]++
+	}
+	require.Len(t, perOperator, 4)
+	for op, n := range perOperator {
+		require.LessOrEqual(t, n, 4, "operator %q", op)
+	}
+
+	out, err := keeper.NewQueryServerImpl(f.keeper).GetDealPlacement(f.ctx, &types.QueryGetDealPlacementRequest{DealId: res.DealId})
+	require.NoError(t, err)
+	require.Len(t, out.Placement.Choices, 12)
+	lastRank := -1
+	for i, choice := range out.Placement.Choices {
+		require.Equal(t, uint32(i), choice.Position)
+		require.Equal(t, res.AssignedProviders[i], choice.Provider)
+		require.Equal(t, uint32(16), choice.Candidates)
+		require.Equal(t, uint32(4), choice.DomainLimit)
+		require.False(t, choice.DomainLimitRelaxed)
+		require.Len(t, choice.Score, 32)
+		require.Greater(t, int(choice.Rank), lastRank)
+		require.Equal(t, choice.Rank, uint32(i)+choice.SkippedForDomain)
+		lastRank = int(choice.Rank)
+	}
+
+	_, err = keeper.NewQueryServerImpl(f.keeper).GetDealPlacement(f.ctx, &types.QueryGetDealPlacementRequest{DealId: res.DealId + 1})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestPlacementRejectsMode2WithTooFewDomains(t *testing.T) {
+	f := initFixture(t)
+	// Two regions can hold at most 2*M=8 of the 12 slots.
+	registerPlacementProviders(t, f, 14, func(i int) types.ProviderFailureDomain {
+		return types.ProviderFailureDomain{Region: fmt.Sprintf("region-%d", i%2)}
+	})
+
+	_, err := createPlacementDeal(f, "General:rs=8+4")
+	require.ErrorIs(t, err, types.ErrFailureDomainLimit)
+}
+
+func TestPlacementRelaxesMode1DomainLimit(t *testing.T) {
+	f := initFixture(t)
+	registerPlacementProviders(t, f, 12, func(i int) types.ProviderFailureDomain {
+		return types.ProviderFailureDomain{Region: fmt.Sprintf("region-%d", i%3), HostGroup: fmt.Sprintf("as%d", i)}
+	})
+
+	res, err := createPlacementDeal(f, "General")
+	require.NoError(t, err)
+	require.Len(t, res.AssignedProviders, 12)
+
+	placement, err := f.keeper.DealPlacements.Get(f.ctx, res.DealId)
+	require.NoError(t, err)
+	for _, choice := range placement.Choices {
+		require.Equal(t, uint32(4), choice.DomainLimit)
+		require.True(t, choice.DomainLimitRelaxed)
+	}
+
+	// The same inputs always give the same placement.
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	req := keeper.DealPlacementRequest(types.ServiceHintInfo{Base: "General"}, 3)
+	first, choices, err := f.keeper.AssignProviders(ctx, 7, []byte("block"), req)
+	require.NoError(t, err)
+	again, _, err := f.keeper.AssignProviders(ctx, 7, []byte("block"), req)
+	require.NoError(t, err)
+	require.Equal(t, first, again)
+
+	// Three replicas fit in three regions without relaxing.
+	regions := map[string]struct{}{}
+	for _, choice := range choices {
+		require.False(t, choice.DomainLimitRelaxed)
+		regions[choice.FailureDomain.Region] = struct{}{}
+	}
+	require.Len(t, regions, 3)
+}
+
+func TestRegisterProviderValidatesFailureDomain(t *testing.T) {
+	f := initFixture(t)
+	addr, _ := f.addressCodec.BytesToString([]byte("placement_bad_label_"))
+	_, err := keeper.NewMsgServerImpl(f.keeper).RegisterProvider(f.ctx, &types.MsgRegisterProvider{
+		Creator: addr, Capabilities: "General", TotalStorage: 1 << 30, Endpoints: testProviderEndpoints,
+		FailureDomain: types.ProviderFailureDomain{Region: "eu\nwest"},
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	"nilchain/x/nilchain/types"
+)
+
+// NextProofDeadline returns the first height at which a provider whose last
+// proof (or assignment) landed at lastProof is considered to have missed its
+// proof window.
+func NextProofDeadline(lastProof uint64) uint64 {
+	return lastProof + types.ProofWindow + 1
+}
+
+// SetProofDeadline (re)schedules the proof deadline for a (deal, provider)
+// pair. Any previously queued deadline for the pair is dropped so each pair
+// has at most one entry in ProofDeadlines.
+func (k Keeper) SetProofDeadline(ctx context.Context, dealID uint64, provider string, deadline uint64) error {
+	pair := collections.Join(dealID, provider)
+	prev, err := k.ProofDeadlinesByDealProvider.Get(ctx, pair)
+	switch {
+	case err == nil:
+		if prev == deadline {
+			return nil
+		}
+		if err := k.ProofDeadlines.Remove(ctx, collections.Join3(prev, dealID, provider)); err != nil {
+			return fmt.Errorf("failed to remove proof deadline: %w", err)
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return fmt.Errorf("failed to load proof deadline: %w", err)
+	}
+
+	if err := k.ProofDeadlines.Set(ctx, collections.Join3(deadline, dealID, provider)); err != nil {
+		return fmt.Errorf("failed to set proof deadline: %w", err)
+	}
+	if err := k.ProofDeadlinesByDealProvider.Set(ctx, pair, deadline); err != nil {
+		return fmt.Errorf("failed to set proof deadline index: %w", err)
+	}
+	return nil
+}
+
+// EnsureProofDeadline schedules a deadline for a (deal, provider) pair only if
+// the pair is not already tracked, so an existing earlier deadline is never
+// pushed back.
+func (k Keeper) EnsureProofDeadline(ctx context.Context, dealID uint64, provider string, deadline uint64) error {
+	has, err := k.ProofDeadlinesByDealProvider.Has(ctx, collections.Join(dealID, provider))
+	if err != nil {
+		return fmt.Errorf("failed to load proof deadline: %w", err)
+	}
+	if has {
+		return nil
+	}
+	return k.SetProofDeadline(ctx, dealID, provider, deadline)
+}
+
+// RemoveProofDeadline drops a (deal, provider) pair from the proof deadline
+// queue. Removing an untracked pair is a no-op.
+func (k Keeper) RemoveProofDeadline(ctx context.Context, dealID uint64, provider string) error {
+	pair := collections.Join(dealID, provider)
+	prev, err := k.ProofDeadlinesByDealProvider.Get(ctx, pair)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to load proof deadline: %w", err)
+	}
+	if err := k.ProofDeadlines.Remove(ctx, collections.Join3(prev, dealID, provider)); err != nil {
+		return fmt.Errorf("failed to remove proof deadline: %w", err)
+	}
+	if err := k.ProofDeadlinesByDealProvider.Remove(ctx, pair); err != nil {
+		return fmt.Errorf("failed to remove proof deadline index: %w", err)
+	}
+	return nil
+}
+
+// dueProofDeadlines returns the queue entries whose deadline is at or before
+// height, in (deadline, deal_id, provider) order. Iteration stops at the first
+// entry that is not yet due, so the cost is proportional to the number of due
+// entries rather than the number of deals.
+func (k Keeper) dueProofDeadlines(ctx context.Context, height uint64) ([]collections.Triple[uint64, uint64, string], error) {
+	iter, err := k.ProofDeadlines.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var due []collections.Triple[uint64, uint64, string]
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		if key.K1() > height {
+			break
+		}
+		due = append(due, key)
+	}
+	return due, nil
+}
+
+// seedProofDeadlines queues a deadline for every provider of an active deal
+// that has none, counting from its last proof or, failing that, the deal's
+// start block. It lets CheckMissedProofs cover deals created before the
+// queue existed.
+func (k Keeper) seedProofDeadlines(ctx context.Context) error {
+	return k.Deals.Walk(ctx, nil, func(dealID uint64, deal types.Deal) (bool, error) {
+		if checkDealActive(deal) != nil {
+			return false, nil
+		}
+		for _, provider := range deal.Providers {
+			lastProof, err := k.DealProviderStatus.Get(ctx, collections.Join(dealID, provider))
+			if err != nil {
+				if !errors.Is(err, collections.ErrNotFound) {
+					return true, fmt.Errorf("failed to load last proof height: %w", err)
+				}
+				lastProof = deal.StartBlock
+			}
+			if err := k.EnsureProofDeadline(ctx, dealID, provider, NextProofDeadline(lastProof)); err != nil {
+				return true, err
+			}
+		}
+		return false, nil
+	})
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,426 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nilchain/x/nilchain/types"
+)
+
+const bytesPerGiB = uint64(1) << 30
+
+// RequiredProviderBond returns the collateral a provider advertising
+// totalStorage bytes must keep bonded:
+// max(min_provider_bond, provider_bond_per_gib * ceil(total_storage / GiB)).
+func RequiredProviderBond(params types.Params, totalStorage uint64) sdk.Coin {
+	gib := totalStorage / bytesPerGiB
+	if totalStorage%bytesPerGiB != 0 {
+		gib++
+	}
+	scaled := params.ProviderBondPerGib.Amount.Mul(math.NewIntFromUint64(gib))
+	if scaled.LT(params.MinProviderBond.Amount) {
+		return params.MinProviderBond
+	}
+	return sdk.NewCoin(params.MinProviderBond.Denom, scaled)
+}
+
+// providerBond returns the provider's bond, treating an unset bond (providers
+// imported from genesis before bonding existed) as zero in the bond denom.
+func providerBond(params types.Params, provider types.Provider) sdk.Coin {
+	if provider.Bond.Denom == "" || provider.Bond.Amount.IsNil() {
+		return sdk.NewCoin(params.MinProviderBond.Denom, math.ZeroInt())
+	}
+	return provider.Bond
+}
+
+// jailBondThreshold is the bond below which a provider is jailed.
+func jailBondThreshold(params types.Params, provider types.Provider) math.Int {
+	required := RequiredProviderBond(params, provider.TotalStorage)
+	return required.Amount.MulRaw(int64(params.JailBondThresholdBps)).QuoRaw(10000)
+}
+
+// lockProviderBond moves amount from the provider's account into the module
+// account. The caller is responsible for crediting Provider.Bond.
+func (k Keeper) lockProviderBond(ctx sdk.Context, provider string, amount sdk.Coin) error {
+	if amount.IsZero() {
+		return nil
+	}
+	addr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid provider address: %s", err)
+	}
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return sdkerrors.ErrInsufficientFunds.Wrapf("failed to lock provider bond: %s", err)
+	}
+	return nil
+}
+
+// SlashProviderBond burns bps/10000 of the provider's bond and of each of its
+// pending unbondings, and jails the provider once the remaining bond falls
+// below jail_bond_threshold_bps of the bond its advertised capacity requires.
+// Like staking's unbonding delegations, bond on its way out stays slashable
+// until it is released, including after the provider deregistered. A
+// provider with neither is ignored so a stale reference can never halt block
+// processing.
+func (k Keeper) SlashProviderBond(ctx context.Context, providerAddr string, bps uint64, reason string) error {
+	_, err := k.slashProviderBond(ctx, providerAddr, bps, 0, reason)
+	return err
+}
+
+// slashProviderBond slashes like SlashProviderBond but keeps withheldBps/10000
+// of the slashed amount in the module account instead of burning it, and
+// returns the withheld coins.
+func (k Keeper) slashProviderBond(ctx context.Context, providerAddr string, bps uint64, withheldBps uint64, reason string) (sdk.Coin, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	withheld := sdk.NewCoin(params.MinProviderBond.Denom, math.ZeroInt())
+
+	provider, err := k.Providers.Get(ctx, providerAddr)
+	registered := err == nil
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return sdk.Coin{}, err
+	}
+
+	unbondingSlash, err := k.slashProviderUnbondings(ctx, providerAddr, bps, withheld.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !registered && unbondingSlash.IsZero() {
+		sdkCtx.Logger().Error("Slash skipped for unknown provider", "provider", providerAddr, "reason", reason)
+		return withheld, nil
+	}
+
+	bond := providerBond(params, provider)
+	bondSlash := bond.Amount.MulRaw(int64(bps)).QuoRaw(10000)
+	slashAmt := sdk.NewCoin(bond.Denom, bondSlash.Add(unbondingSlash))
+	if slashAmt.IsPositive() {
+		withheld = sdk.NewCoin(bond.Denom, slashAmt.Amount.MulRaw(int64(withheldBps)).QuoRaw(10000))
+		if burn := slashAmt.Sub(withheld); burn.IsPositive() {
+			if err := k.BankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(burn)); err != nil {
+				return sdk.Coin{}, fmt.Errorf("failed to burn slashed bond: %w", err)
+			}
+		}
+		bond = bond.SubAmount(bondSlash)
+	}
+	provider.Bond = bond
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeProviderSlashed,
+			sdk.NewAttribute(types.AttributeKeyProvider, providerAddr),
+			sdk.NewAttribute(types.AttributeKeyAmount, slashAmt.String()),
+			sdk.NewAttribute(types.AttributeKeyBond, bond.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+
+	if !registered {
+		return withheld, nil
+	}
+
+	// A deregistering provider already takes no new deals and must not be
+	// reactivated by a later top-up, so it is slashed but never jailed.
+	if provider.Status != "Jailed" && provider.Status != "Deregistering" && bond.Amount.LT(jailBondThreshold(params, provider)) {
+		provider.Status = "Jailed"
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.TypeProviderJailed,
+				sdk.NewAttribute(types.AttributeKeyProvider, providerAddr),
+				sdk.NewAttribute(types.AttributeKeyBond, bond.String()),
+			),
+		)
+	}
+
+	if err := k.Providers.Set(ctx, providerAddr, provider); err != nil {
+		return sdk.Coin{}, err
+	}
+	return withheld, nil
+}
+
+// slashProviderUnbondings cuts bps/10000 from each of the provider's pending
+// unbondings in denom and returns the total cut. Entries cut to zero are
+// dropped from the queue.
+func (k Keeper) slashProviderUnbondings(ctx context.Context, providerAddr string, bps uint64, denom string) (math.Int, error) {
+	iter, err := k.ProviderUnbondings.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](providerAddr))
+	if err != nil {
+		return math.Int{}, err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	total := math.ZeroInt()
+	for _, kv := range kvs {
+		if kv.Value.Denom != denom {
+			continue
+		}
+		cut := kv.Value.Amount.MulRaw(int64(bps)).QuoRaw(10000)
+		if !cut.IsPositive() {
+			continue
+		}
+		total = total.Add(cut)
+		remaining := kv.Value.SubAmount(cut)
+		if remaining.IsPositive() {
+			if err := k.ProviderUnbondings.Set(ctx, kv.Key, remaining); err != nil {
+				return math.Int{}, fmt.Errorf("failed to set provider unbonding: %w", err)
+			}
+			continue
+		}
+		if err := k.ProviderUnbondings.Remove(ctx, kv.Key); err != nil {
+			return math.Int{}, fmt.Errorf("failed to remove provider unbonding: %w", err)
+		}
+		if err := k.ProviderUnbondingQueue.Remove(ctx, collections.Join(kv.Key.K2(), kv.Key.K1())); err != nil {
+			return math.Int{}, fmt.Errorf("failed to remove provider unbonding from queue: %w", err)
+		}
+	}
+	return total, nil
+}
+
+// jailProvider jails a provider whatever its bond, e.g. after it was proven
+// to serve wrong data. Like slashProviderBond it leaves deregistering
+// providers alone. A jailed provider is reactivated by topping its bond back
+// up to the required bond.
+func (k Keeper) jailProvider(ctx sdk.Context, providerAddr string, reason string) error {
+	provider, err := k.Providers.Get(ctx, providerAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if provider.Status == "Jailed" || provider.Status == "Deregistering" {
+		return nil
+	}
+
+	provider.Status = "Jailed"
+	if err := k.Providers.Set(ctx, providerAddr, provider); err != nil {
+		return fmt.Errorf("failed to set provider: %w", err)
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeProviderJailed,
+			sdk.NewAttribute(types.AttributeKeyProvider, providerAddr),
+			sdk.NewAttribute(types.AttributeKeyBond, provider.Bond.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+	return nil
+}
+
+// addProviderUnbonding queues amount for release to the provider at
+// completionHeight, merging with any entry already due at that height.
+func (k Keeper) addProviderUnbonding(ctx context.Context, provider string, completionHeight uint64, amount sdk.Coin) error {
+	key := collections.Join(provider, completionHeight)
+	existing, err := k.ProviderUnbondings.Get(ctx, key)
+	switch {
+	case err == nil:
+		amount = amount.Add(existing)
+	case !errors.Is(err, collections.ErrNotFound):
+		return fmt.Errorf("failed to load provider unbonding: %w", err)
+	}
+	if err := k.ProviderUnbondings.Set(ctx, key, amount); err != nil {
+		return fmt.Errorf("failed to set provider unbonding: %w", err)
+	}
+	if err := k.ProviderUnbondingQueue.Set(ctx, collections.Join(completionHeight, provider)); err != nil {
+		return fmt.Errorf("failed to queue provider unbonding: %w", err)
+	}
+	return nil
+}
+
+// CompleteProviderUnbondings releases every unbonding whose completion height
+// has been reached. Like CheckMissedProofs it only visits due queue entries.
+func (k Keeper) CompleteProviderUnbondings(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+
+	due, err := k.dueProviderUnbondings(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range due {
+		completionHeight, providerAddr := entry.K1(), entry.K2()
+		key := collections.Join(providerAddr, completionHeight)
+
+		amount, err := k.ProviderUnbondings.Get(ctx, key)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		if err == nil && amount.IsPositive() {
+			addr, err := sdk.AccAddressFromBech32(providerAddr)
+			if err != nil {
+				return fmt.Errorf("invalid provider address in unbonding queue: %w", err)
+			}
+			if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, sdk.NewCoins(amount)); err != nil {
+				return fmt.Errorf("failed to release provider unbonding: %w", err)
+			}
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.TypeProviderUnbondingCompleted,
+					sdk.NewAttribute(types.AttributeKeyProvider, providerAddr),
+					sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+				),
+			)
+		}
+
+		if err := k.ProviderUnbondings.Remove(ctx, key); err != nil {
+			return err
+		}
+		if err := k.ProviderUnbondingQueue.Remove(ctx, entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dueProviderUnbondings returns the queue entries completing at or before
+// height, stopping at the first entry that is not yet due.
+func (k Keeper) dueProviderUnbondings(ctx context.Context, height uint64) ([]collections.Pair[uint64, string], error) {
+	iter, err := k.ProviderUnbondingQueue.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var due []collections.Pair[uint64, string]
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		if key.K1() > height {
+			break
+		}
+		due = append(due, key)
+	}
+	return due, nil
+}
+
+// TopUpProviderBond handles MsgTopUpProviderBond. A jailed provider whose bond
+// is restored to the full required amount is reactivated.
+func (k msgServer) TopUpProviderBond(goCtx context.Context, msg *types.MsgTopUpProviderBond) (*types.MsgTopUpProviderBondResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	provider, err := k.Providers.Get(ctx, msg.Creator)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("provider %s not registered", msg.Creator)
+		}
+		return nil, err
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bond := providerBond(params, provider)
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid top-up amount: %s", msg.Amount)
+	}
+	if msg.Amount.Denom != bond.Denom {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("bond denom must be %s", bond.Denom)
+	}
+
+	if err := k.lockProviderBond(ctx, msg.Creator, msg.Amount); err != nil {
+		return nil, err
+	}
+	provider.Bond = bond.Add(msg.Amount)
+
+	required := RequiredProviderBond(params, provider.TotalStorage)
+	if provider.Status == "Jailed" && provider.Bond.IsGTE(required) {
+		provider.Status = "Active"
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.TypeProviderUnjailed,
+				sdk.NewAttribute(types.AttributeKeyProvider, provider.Address),
+				sdk.NewAttribute(types.AttributeKeyBond, provider.Bond.String()),
+			),
+		)
+	}
+
+	if err := k.Providers.Set(ctx, provider.Address, provider); err != nil {
+		return nil, fmt.Errorf("failed to set provider: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeMsgTopUpProviderBond,
+			sdk.NewAttribute(types.AttributeKeyProvider, provider.Address),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyBond, provider.Bond.String()),
+		),
+	)
+
+	return &types.MsgTopUpProviderBondResponse{Bond: provider.Bond, Status: provider.Status}, nil
+}
+
+// UnbondProviderBond handles MsgUnbondProviderBond. Only collateral above the
+// required bond can be unbonded; it is released after provider_unbonding_blocks.
+func (k msgServer) UnbondProviderBond(goCtx context.Context, msg *types.MsgUnbondProviderBond) (*types.MsgUnbondProviderBondResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	provider, err := k.Providers.Get(ctx, msg.Creator)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("provider %s not registered", msg.Creator)
+		}
+		return nil, err
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bond := providerBond(params, provider)
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid unbond amount: %s", msg.Amount)
+	}
+	if msg.Amount.Denom != bond.Denom {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("bond denom must be %s", bond.Denom)
+	}
+	required := RequiredProviderBond(params, provider.TotalStorage)
+	if bond.Amount.Sub(msg.Amount.Amount).LT(required.Amount) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"cannot unbond %s: bond %s would fall below required bond %s", msg.Amount, bond, required,
+		)
+	}
+
+	provider.Bond = bond.Sub(msg.Amount)
+	if err := k.Providers.Set(ctx, provider.Address, provider); err != nil {
+		return nil, fmt.Errorf("failed to set provider: %w", err)
+	}
+
+	completionHeight := uint64(ctx.BlockHeight()) + params.ProviderUnbondingBlocks
+	if err := k.addProviderUnbonding(ctx, provider.Address, completionHeight, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeMsgUnbondProviderBond,
+			sdk.NewAttribute(types.AttributeKeyProvider, provider.Address),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyBond, provider.Bond.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionHeight, fmt.Sprintf("%d", completionHeight)),
+		),
+	)
+
+	return &types.MsgUnbondProviderBondResponse{CompletionHeight: completionHeight}, nil
+}
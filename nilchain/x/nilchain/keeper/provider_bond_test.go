@@ -0,0 +1,202 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+func TestRequiredProviderBond(t *testing.T) {
+	p := types.DefaultParams()
+	p.MinProviderBond = sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000)
+	p.ProviderBondPerGib = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+
+	require.Equal(t, p.MinProviderBond, keeper.RequiredProviderBond(p, 0))
+	require.Equal(t, p.MinProviderBond, keeper.RequiredProviderBond(p, 5<<30))
+	// Partial GiBs round up.
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 6000), keeper.RequiredProviderBond(p, 5<<30+1))
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 200000), keeper.RequiredProviderBond(p, 200<<30))
+}
+
+func TestRegisterProvider_LocksBond(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	params := types.DefaultParams()
+
+	addr := sdk.AccAddress([]byte("bond_provider_______"))
+	required := keeper.RequiredProviderBond(params, 200<<30)
+
+	// Unfunded providers cannot register.
+	_, err := msgServer.RegisterProvider(f.ctx, &types.MsgRegisterProvider{
+		Creator: addr.String(), Capabilities: "General", TotalStorage: 200 << 30, Endpoints: testProviderEndpoints,
+	})
+	require.Error(t, err)
+
+	// An explicit bond below the requirement is rejected.
+	bank.setAccountBalance(addr, sdk.NewCoins(required.AddAmount(required.Amount)))
+	_, err = msgServer.RegisterProvider(f.ctx, &types.MsgRegisterProvider{
+		Creator: addr.String(), Capabilities: "General", TotalStorage: 200 << 30, Endpoints: testProviderEndpoints,
+		Bond: required.SubAmount(required.Amount.QuoRaw(2)),
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "below the required bond")
+
+	// An unset bond locks exactly the required amount.
+	_, err = msgServer.RegisterProvider(f.ctx, &types.MsgRegisterProvider{
+		Creator: addr.String(), Capabilities: "General", TotalStorage: 200 << 30, Endpoints: testProviderEndpoints,
+	})
+	require.NoError(t, err)
+
+	provider, err := f.keeper.Providers.Get(f.ctx, addr.String())
+	require.NoError(t, err)
+	require.Equal(t, required, provider.Bond)
+	require.Equal(t, sdk.NewCoins(required).String(), bank.moduleBalances[types.ModuleName].String())
+	require.Equal(t, sdk.NewCoins(required).String(), bank.accountBalances[addr.String()].String())
+}
+
+func TestProviderBond_TopUpUnbondAndComplete(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	params := types.DefaultParams()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+	addr := sdk.AccAddress([]byte("bond_topup__________"))
+	required := keeper.RequiredProviderBond(params, 1<<30)
+	extra := sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)
+	bank.setAccountBalance(addr, sdk.NewCoins(required.Add(extra)))
+
+	_, err := msgServer.RegisterProvider(ctx, &types.MsgRegisterProvider{
+		Creator: addr.String(), Capabilities: "General", TotalStorage: 1 << 30, Endpoints: testProviderEndpoints,
+	})
+	require.NoError(t, err)
+
+	// Nothing above the required bond yet.
+	_, err = msgServer.UnbondProviderBond(ctx, &types.MsgUnbondProviderBond{Creator: addr.String(), Amount: extra})
+	require.Error(t, err)
+
+	topUp, err := msgServer.TopUpProviderBond(ctx, &types.MsgTopUpProviderBond{Creator: addr.String(), Amount: extra})
+	require.NoError(t, err)
+	require.Equal(t, required.Add(extra), topUp.Bond)
+	require.Equal(t, "Active", topUp.Status)
+
+	_, err = msgServer.UnbondProviderBond(ctx, &types.MsgUnbondProviderBond{Creator: addr.String(), Amount: extra.AddAmount(extra.Amount)})
+	require.Error(t, err)
+
+	res, err := msgServer.UnbondProviderBond(ctx, &types.MsgUnbondProviderBond{Creator: addr.String(), Amount: extra})
+	require.NoError(t, err)
+	require.Equal(t, uint64(10)+params.ProviderUnbondingBlocks, res.CompletionHeight)
+
+	provider, err := f.keeper.Providers.Get(ctx, addr.String())
+	require.NoError(t, err)
+	require.Equal(t, required, provider.Bond)
+
+	// Not yet matured.
+	require.NoError(t, f.keeper.CompleteProviderUnbondings(ctx.WithBlockHeight(int64(res.CompletionHeight-1))))
+	require.True(t, bank.accountBalances[addr.String()].IsZero())
+
+	require.NoError(t, f.keeper.CompleteProviderUnbondings(ctx.WithBlockHeight(int64(res.CompletionHeight))))
+	require.Equal(t, sdk.NewCoins(extra).String(), bank.accountBalances[addr.String()].String())
+	require.Equal(t, sdk.NewCoins(required).String(), bank.moduleBalances[types.ModuleName].String())
+
+	bond, err := keeper.NewQueryServerImpl(f.keeper).GetProviderBond(ctx, &types.QueryGetProviderBondRequest{Address: addr.String()})
+	require.NoError(t, err)
+	require.Equal(t, required, bond.Bond)
+	require.Equal(t, required, bond.RequiredBond)
+	require.Empty(t, bond.Unbondings)
+}
+
+func TestSlashProviderBond_JailsAndTopUpReactivates(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	params := types.DefaultParams()
+
+	addr := sdk.AccAddress([]byte("bond_slash__________"))
+	required := keeper.RequiredProviderBond(params, 1<<30)
+	bank.setAccountBalance(addr, sdk.NewCoins(required))
+
+	_, err := msgServer.RegisterProvider(f.ctx, &types.MsgRegisterProvider{
+		Creator: addr.String(), Capabilities: "General", TotalStorage: 1 << 30, Endpoints: testProviderEndpoints,
+	})
+	require.NoError(t, err)
+
+	// 40% slash: still above the 50% jail threshold.
+	require.NoError(t, f.keeper.SlashProviderBond(f.ctx, addr.String(), 4000, "test"))
+	provider, err := f.keeper.Providers.Get(f.ctx, addr.String())
+	require.NoError(t, err)
+	require.Equal(t, required.Amount.MulRaw(6).QuoRaw(10), provider.Bond.Amount)
+	require.Equal(t, "Active", provider.Status)
+	require.Equal(t, sdk.NewCoins(provider.Bond).String(), bank.moduleBalances[types.ModuleName].String())
+
+	// Another 40% drops the bond to 36% of the requirement.
+	require.NoError(t, f.keeper.SlashProviderBond(f.ctx, addr.String(), 4000, "test"))
+	provider, err = f.keeper.Providers.Get(f.ctx, addr.String())
+	require.NoError(t, err)
+	require.Equal(t, "Jailed", provider.Status)
+
+	// Restoring the full required bond reactivates the provider.
+	shortfall := required.Sub(provider.Bond)
+	bank.setAccountBalance(addr, sdk.NewCoins(shortfall))
+	res, err := msgServer.TopUpProviderBond(f.ctx, &types.MsgTopUpProviderBond{Creator: addr.String(), Amount: shortfall})
+	require.NoError(t, err)
+	require.Equal(t, required, res.Bond)
+	require.Equal(t, "Active", res.Status)
+}
+
+func TestSlashProviderBond_ReachesUnbondings(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	params := types.DefaultParams()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+	addr := sdk.AccAddress([]byte("bond_slash_unbonding"))
+	required := keeper.RequiredProviderBond(params, 1<<30)
+	extra := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	bank.setAccountBalance(addr, sdk.NewCoins(required.Add(extra)))
+
+	_, err := msgServer.RegisterProvider(ctx, &types.MsgRegisterProvider{
+		Creator: addr.String(), Capabilities: "General", TotalStorage: 1 << 30, Endpoints: testProviderEndpoints,
+		Bond: required.Add(extra),
+	})
+	require.NoError(t, err)
+	res, err := msgServer.UnbondProviderBond(ctx, &types.MsgUnbondProviderBond{Creator: addr.String(), Amount: extra})
+	require.NoError(t, err)
+
+	// Unbonding does not take the collateral out of reach of a slash.
+	require.NoError(t, f.keeper.SlashProviderBond(ctx, addr.String(), 1000, "test"))
+	provider, err := f.keeper.Providers.Get(ctx, addr.String())
+	require.NoError(t, err)
+	require.Equal(t, required.Amount.MulRaw(9).QuoRaw(10), provider.Bond.Amount)
+	unbonding, err := f.keeper.ProviderUnbondings.Get(ctx, collections.Join(addr.String(), res.CompletionHeight))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 900), unbonding)
+	require.Equal(t, sdk.NewCoins(provider.Bond.Add(unbonding)).String(), bank.moduleBalances[types.ModuleName].String())
+
+	// Nor does deregistering, which unbonds the whole bond into the same
+	// entry.
+	_, err = msgServer.DeregisterProvider(ctx, &types.MsgDeregisterProvider{Creator: addr.String()})
+	require.NoError(t, err)
+	_, err = f.keeper.Providers.Get(ctx, addr.String())
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	pending := provider.Bond.Add(unbonding)
+	require.NoError(t, f.keeper.SlashProviderBond(ctx, addr.String(), 5000, "test"))
+	unbonding, err = f.keeper.ProviderUnbondings.Get(ctx, collections.Join(addr.String(), res.CompletionHeight))
+	require.NoError(t, err)
+	require.Equal(t, pending.SubAmount(pending.Amount.QuoRaw(2)), unbonding)
+	require.Equal(t, sdk.NewCoins(unbonding).String(), bank.moduleBalances[types.ModuleName].String())
+	_, broken := keeper.ModuleAccountSolvencyInvariant(f.keeper)(ctx)
+	require.False(t, broken)
+
+	// Only what survived the slashes is released.
+	require.NoError(t, f.keeper.CompleteProviderUnbondings(ctx.WithBlockHeight(int64(res.CompletionHeight))))
+	require.Equal(t, sdk.NewCoins(unbonding).String(), bank.accountBalances[addr.String()].String())
+	require.True(t, bank.moduleBalances[types.ModuleName].IsZero())
+}
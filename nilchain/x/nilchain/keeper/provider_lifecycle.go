@@ -0,0 +1,684 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ma "github.com/multiformats/go-multiaddr"
+
+	"nilchain/x/nilchain/types"
+)
+
+// validateProviderCapabilities checks a provider capability class.
+func validateProviderCapabilities(capabilities string) error {
+	if capabilities != "Archive" && capabilities != "General" && capabilities != "Edge" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid capabilities: %s", capabilities)
+	}
+	return nil
+}
+
+// normalizeProviderEndpoints validates provider endpoints as Multiaddrs and
+// returns them in canonical form with duplicates dropped. At least one
+// endpoint must speak HTTP or HTTPS.
+func normalizeProviderEndpoints(rawEndpoints []string) ([]string, error) {
+	if len(rawEndpoints) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("endpoints is required (at least one Multiaddr)")
+	}
+	if len(rawEndpoints) > 8 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("too many endpoints (max 8)")
+	}
+	endpoints := make([]string, 0, len(rawEndpoints))
+	seenEndpoints := make(map[string]struct{}, len(rawEndpoints))
+	hasHTTP := false
+	for _, raw := range rawEndpoints {
+		ep := strings.TrimSpace(raw)
+		if ep == "" {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("endpoint must be non-empty")
+		}
+		if len(ep) > 256 {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("endpoint too long")
+		}
+		if !strings.HasPrefix(ep, "/") {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid endpoint multiaddr: %q", ep)
+		}
+		if strings.IndexFunc(ep, func(r rune) bool { return unicode.IsSpace(r) || r < 0x20 }) != -1 {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("endpoint contains whitespace/control characters")
+		}
+		parsed, err := ma.NewMultiaddr(ep)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid endpoint multiaddr: %q", ep)
+		}
+		for _, proto := range parsed.Protocols() {
+			if proto.Code == ma.P_HTTP || proto.Code == ma.P_HTTPS {
+				hasHTTP = true
+			}
+		}
+		canonical := parsed.String()
+		if _, ok := seenEndpoints[canonical]; ok {
+			continue
+		}
+		seenEndpoints[canonical] = struct{}{}
+		endpoints = append(endpoints, canonical)
+	}
+	if !hasHTTP {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("at least one HTTP or HTTPS endpoint is required")
+	}
+	return endpoints, nil
+}
+
+// normalizeFailureDomain trims a provider's failure-domain labels and checks
+// they are short, printable strings.
+func normalizeFailureDomain(domain types.ProviderFailureDomain) (types.ProviderFailureDomain, error) {
+	labels := []struct {
+		name  string
+		value *string
+	}{
+		{"operator_id", &domain.OperatorId},
+		{"region", &domain.Region},
+		{"host_group", &domain.HostGroup},
+	}
+	for _, label := range labels {
+		v := strings.TrimSpace(*label.value)
+		if len(v) > 64 {
+			return types.ProviderFailureDomain{}, sdkerrors.ErrInvalidRequest.Wrapf("%s too long (max 64)", label.name)
+		}
+		if strings.IndexFunc(v, func(r rune) bool { return unicode.IsControl(r) }) != -1 {
+			return types.ProviderFailureDomain{}, sdkerrors.ErrInvalidRequest.Wrapf("%s contains control characters", label.name)
+		}
+		*label.value = v
+	}
+	return domain, nil
+}
+
+// loadProvider returns the creator's provider record.
+func (k Keeper) loadProvider(ctx context.Context, creator string) (types.Provider, error) {
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return types.Provider{}, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	provider, err := k.Providers.Get(ctx, creator)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Provider{}, sdkerrors.ErrNotFound.Wrapf("provider %s not registered", creator)
+		}
+		return types.Provider{}, err
+	}
+	return provider, nil
+}
+
+// UpdateProvider handles MsgUpdateProvider. Endpoints are validated exactly as
+// on registration; a capacity increase must already be covered by the bond.
+func (k msgServer) UpdateProvider(goCtx context.Context, msg *types.MsgUpdateProvider) (*types.MsgUpdateProviderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	provider, err := k.loadProvider(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if len(msg.Endpoints) == 0 && msg.TotalStorage == 0 && msg.Capabilities == "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("nothing to update")
+	}
+
+	if len(msg.Endpoints) > 0 {
+		endpoints, err := normalizeProviderEndpoints(msg.Endpoints)
+		if err != nil {
+			return nil, err
+		}
+		provider.Endpoints = endpoints
+	}
+	if msg.Capabilities != "" {
+		if err := validateProviderCapabilities(msg.Capabilities); err != nil {
+			return nil, err
+		}
+		provider.Capabilities = msg.Capabilities
+	}
+	if msg.TotalStorage != 0 && msg.TotalStorage != provider.TotalStorage {
+		if held := provider.UsedStorage + provider.ReservedStorage; msg.TotalStorage < held {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"total_storage %d is below used and reserved storage %d", msg.TotalStorage, held,
+			)
+		}
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		required := RequiredProviderBond(params, msg.TotalStorage)
+		if bond := providerBond(params, provider); bond.IsLT(required) {
+			return nil, sdkerrors.ErrInsufficientFunds.Wrapf(
+				"bond %s is below the required bond %s for %d bytes; top up first", bond, required, msg.TotalStorage,
+			)
+		}
+		provider.TotalStorage = msg.TotalStorage
+	}
+
+	if err := k.Providers.Set(ctx, provider.Address, provider); err != nil {
+		return nil, fmt.Errorf("failed to set provider: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeMsgUpdateProvider,
+			sdk.NewAttribute(types.AttributeKeyProvider, provider.Address),
+			sdk.NewAttribute(types.AttributeKeyCapabilities, provider.Capabilities),
+			sdk.NewAttribute(types.AttributeKeyTotalStorage, fmt.Sprintf("%d", provider.TotalStorage)),
+			sdk.NewAttribute(types.AttributeKeyEndpoints, strings.Join(provider.Endpoints, ",")),
+		),
+	)
+
+	return &types.MsgUpdateProviderResponse{Provider: provider}, nil
+}
+
+// SetProviderStatus handles MsgSetProviderStatus. "Maintenance" and "Draining"
+// stop new deal assignments; existing assignments and their proof deadlines
+// are unaffected. Jailed and deregistering providers cannot change status
+// this way.
+func (k msgServer) SetProviderStatus(goCtx context.Context, msg *types.MsgSetProviderStatus) (*types.MsgSetProviderStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	provider, err := k.loadProvider(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	switch msg.Status {
+	case "Active", "Maintenance", "Draining":
+	default:
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid status %q (want Active, Maintenance or Draining)", msg.Status)
+	}
+	switch provider.Status {
+	case "Jailed":
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("jailed providers are reactivated by topping up their bond")
+	case "Deregistering":
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("provider %s is deregistering", provider.Address)
+	}
+
+	provider.Status = msg.Status
+	if err := k.Providers.Set(ctx, provider.Address, provider); err != nil {
+		return nil, fmt.Errorf("failed to set provider: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeMsgSetProviderStatus,
+			sdk.NewAttribute(types.AttributeKeyProvider, provider.Address),
+			sdk.NewAttribute(types.AttributeKeyStatus, provider.Status),
+		),
+	)
+
+	return &types.MsgSetProviderStatusResponse{Status: provider.Status}, nil
+}
+
+// DeregisterProvider handles MsgDeregisterProvider. Every Mode 1 replica and
+// Mode 2 slot the provider holds is handed to a replacement after
+// provider_migration_blocks (Mode 2 slots via the slot repair flow). Once the
+// last handover completes the provider is removed and its bond unbonds.
+func (k msgServer) DeregisterProvider(goCtx context.Context, msg *types.MsgDeregisterProvider) (*types.MsgDeregisterProviderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	provider, err := k.loadProvider(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	switch provider.Status {
+	case "Jailed":
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("jailed providers must restore their bond before deregistering")
+	case "Deregistering":
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("provider %s is already deregistering", provider.Address)
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Repairs towards the provider are moved first so the deals loaded below
+	// already reflect them.
+	if err := k.dropPendingSlotRepairs(ctx, provider.Address); err != nil {
+		return nil, err
+	}
+
+	type assignment struct {
+		deal types.Deal
+		slot uint32
+	}
+	var held []assignment
+	if err := k.DealsByProvider.Walk(ctx, collections.NewPrefixedPairRange[string, uint64](provider.Address), func(key collections.Pair[string, uint64]) (bool, error) {
+		deal, err := k.Deals.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		if checkDealActive(deal) != nil {
+			// Ended deals keep their provider list only as a GC record.
+			return false, nil
+		}
+		if slot, ok := providerSlotIndex(deal, provider.Address); ok {
+			held = append(held, assignment{deal: deal, slot: uint32(slot)})
+		}
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to walk provider deals: %w", err)
+	}
+
+	completionHeight := uint64(ctx.BlockHeight()) + params.ProviderMigrationBlocks
+	for _, a := range held {
+		err := k.beginProviderMigration(ctx, a.deal, a.slot, provider.Address, completionHeight)
+		if errors.Is(err, types.ErrNoReplacementProvider) {
+			// Nobody can take over yet; record the migration without a
+			// target so handOverAssignment retries it when it is due.
+			err = k.setProviderMigration(ctx, types.ProviderMigration{
+				Provider:         provider.Address,
+				DealId:           a.deal.Id,
+				Slot:             a.slot,
+				CompletionHeight: completionHeight,
+			})
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// A deregistering provider takes no new work while its assignments migrate.
+	provider.Status = "Deregistering"
+	if err := k.Providers.Set(ctx, provider.Address, provider); err != nil {
+		return nil, fmt.Errorf("failed to set provider: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeMsgDeregisterProvider,
+			sdk.NewAttribute(types.AttributeKeyProvider, provider.Address),
+			sdk.NewAttribute(types.AttributeKeyCompletionHeight, fmt.Sprintf("%d", completionHeight)),
+		),
+	)
+
+	if len(held) == 0 {
+		if err := k.finishDeregistration(ctx, provider, params); err != nil {
+			return nil, err
+		}
+		return &types.MsgDeregisterProviderResponse{}, nil
+	}
+	return &types.MsgDeregisterProviderResponse{
+		PendingMigrations: uint64(len(held)),
+		CompletionHeight:  completionHeight,
+	}, nil
+}
+
+func hasMode2Slots(deal types.Deal) bool {
+	return deal.RedundancyMode == 2 && deal.Mode2Profile != nil && len(deal.Mode2Slots) > 0
+}
+
+// isActiveProvider reports whether addr is a registered provider accepting
+// new assignments.
+func (k Keeper) isActiveProvider(ctx context.Context, addr string) (bool, error) {
+	provider, err := k.Providers.Get(ctx, addr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return provider.Status == "Active", nil
+}
+
+// pickReplacementProvider deterministically selects an active provider that
+// matches the deal's service hint, has room for the deal and holds no
+// position in it yet. Candidates that keep every failure domain within the
+// deal's domain limit are preferred; the rest are only used when no such
+// candidate exists.
+func (k Keeper) pickReplacementProvider(ctx sdk.Context, deal types.Deal, leaving string) (string, error) {
+	exclude := map[string]struct{}{leaving: {}}
+	for _, p := range deal.Providers {
+		exclude[p] = struct{}{}
+	}
+	for _, slot := range deal.Mode2Slots {
+		if slot != nil {
+			exclude[slot.Provider] = struct{}{}
+			exclude[slot.PendingProvider] = struct{}{}
+		}
+	}
+
+	// Count the domains of the positions that stay in the deal.
+	held := make(map[string]uint64)
+	for addr := range exclude {
+		if addr == leaving || addr == "" {
+			continue
+		}
+		provider, err := k.Providers.Get(ctx, addr)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return "", err
+		}
+		for _, d := range failureDomainKeys(provider) {
+			held[d]++
+		}
+	}
+	limit := dealDomainLimit(deal)
+
+	hint := ""
+	if parsed, err := types.ParseServiceHint(deal.ServiceHint); err == nil {
+		hint = parsed.Base
+	}
+
+	footprint := DealStorageFootprint(deal)
+	var candidates, diverse []string
+	err := k.Providers.Walk(ctx, nil, func(addr string, provider types.Provider) (bool, error) {
+		if _, ok := exclude[addr]; ok {
+			return false, nil
+		}
+		if provider.Status == "Active" && providerMatchesServiceHint(hint, provider) && ProviderFreeStorage(provider) >= footprint {
+			candidates = append(candidates, addr)
+			if domainsHaveRoom(held, failureDomainKeys(provider), limit) {
+				diverse = append(diverse, addr)
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to walk providers: %w", err)
+	}
+	if len(candidates) == 0 {
+		return "", errorsmod.Wrapf(types.ErrNoReplacementProvider, "deal %d", deal.Id)
+	}
+	if len(diverse) > 0 {
+		candidates = diverse
+	}
+
+	seed := make([]byte, 0, 16+len(leaving))
+	seed = append(seed, sdk.Uint64ToBigEndian(deal.Id)...)
+	seed = append(seed, leaving...)
+	seed = append(seed, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()))...)
+	h := sha256.Sum256(seed)
+	return candidates[binary.BigEndian.Uint64(h[:8])%uint64(len(candidates))], nil
+}
+
+// beginProviderMigration schedules the handover of provider's position in
+// deal at completionHeight. Mode 2 slots are moved into repair so clients can
+// see the replacement while it copies data; an in-flight repair towards an
+// active provider is reused.
+func (k Keeper) beginProviderMigration(ctx sdk.Context, deal types.Deal, slotIdx uint32, provider string, completionHeight uint64) error {
+	replacement := ""
+	if hasMode2Slots(deal) {
+		slot, err := mode2Slot(&deal, slotIdx)
+		if err != nil {
+			return err
+		}
+		if slot.Status == types.SlotStatus_SLOT_STATUS_REPAIRING {
+			active, err := k.isActiveProvider(ctx, slot.PendingProvider)
+			if err != nil {
+				return err
+			}
+			if active {
+				replacement = slot.PendingProvider
+			} else {
+				// The pending provider left or stopped taking work; abandon
+				// that repair and restart it towards a fresh replacement.
+				if err := k.abandonSlotRepair(ctx, deal, slotIdx, slot); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if replacement == "" {
+		picked, err := k.pickReplacementProvider(ctx, deal, provider)
+		if err != nil {
+			return err
+		}
+		replacement = picked
+		if hasMode2Slots(deal) {
+			if err := k.startSlotRepair(ctx, &deal, slotIdx, replacement); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := k.setProviderMigration(ctx, types.ProviderMigration{
+		Provider:         provider,
+		DealId:           deal.Id,
+		Slot:             slotIdx,
+		Replacement:      replacement,
+		CompletionHeight: completionHeight,
+	}); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeProviderMigrationStarted,
+			sdk.NewAttribute(types.AttributeKeyProvider, provider),
+			sdk.NewAttribute(types.AttributeKeyDealID, fmt.Sprintf("%d", deal.Id)),
+			sdk.NewAttribute(types.AttributeKeySlot, fmt.Sprintf("%d", slotIdx)),
+			sdk.NewAttribute(types.AttributeKeyReplacement, replacement),
+			sdk.NewAttribute(types.AttributeKeyCompletionHeight, fmt.Sprintf("%d", completionHeight)),
+		),
+	)
+	return nil
+}
+
+// setProviderMigration stores a migration and queues it at its completion
+// height.
+func (k Keeper) setProviderMigration(ctx context.Context, migration types.ProviderMigration) error {
+	if err := k.ProviderMigrations.Set(ctx, collections.Join(migration.Provider, migration.DealId), migration); err != nil {
+		return fmt.Errorf("failed to set provider migration: %w", err)
+	}
+	queueKey := collections.Join3(migration.CompletionHeight, migration.Provider, migration.DealId)
+	if err := k.ProviderMigrationQueue.Set(ctx, queueKey); err != nil {
+		return fmt.Errorf("failed to queue provider migration: %w", err)
+	}
+	return nil
+}
+
+// CompleteProviderMigrations hands over every migration that is due and
+// removes providers whose last migration has completed. Like
+// CheckMissedProofs it only visits due queue entries.
+func (k Keeper) CompleteProviderMigrations(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+
+	due, err := k.dueProviderMigrations(ctx, height)
+	if err != nil {
+		return err
+	}
+	if len(due) == 0 {
+		return nil
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range due {
+		if err := k.ProviderMigrationQueue.Remove(ctx, entry); err != nil {
+			return err
+		}
+		key := collections.Join(entry.K2(), entry.K3())
+		migration, err := k.ProviderMigrations.Get(ctx, key)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+		if migration.CompletionHeight != entry.K1() {
+			// Rescheduled; a later queue entry owns it now.
+			continue
+		}
+
+		restarted, err := k.handOverAssignment(sdkCtx, migration, params)
+		if err != nil {
+			return err
+		}
+		if restarted {
+			continue
+		}
+		if err := k.ProviderMigrations.Remove(ctx, key); err != nil {
+			return err
+		}
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.TypeProviderMigrationCompleted,
+				sdk.NewAttribute(types.AttributeKeyProvider, migration.Provider),
+				sdk.NewAttribute(types.AttributeKeyDealID, fmt.Sprintf("%d", migration.DealId)),
+				sdk.NewAttribute(types.AttributeKeyReplacement, migration.Replacement),
+			),
+		)
+
+		remaining, err := k.ProviderMigrations.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](migration.Provider))
+		if err != nil {
+			return err
+		}
+		pending := remaining.Valid()
+		remaining.Close()
+		if pending {
+			continue
+		}
+		provider, err := k.Providers.Get(ctx, migration.Provider)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+		if err := k.finishDeregistration(sdkCtx, provider, params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dueProviderMigrations returns the queue entries completing at or before
+// height, stopping at the first entry that is not yet due.
+func (k Keeper) dueProviderMigrations(ctx context.Context, height uint64) ([]collections.Triple[uint64, string, uint64], error) {
+	iter, err := k.ProviderMigrationQueue.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var due []collections.Triple[uint64, string, uint64]
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		if key.K1() > height {
+			break
+		}
+		due = append(due, key)
+	}
+	return due, nil
+}
+
+// handOverAssignment moves the migrating provider's position to the
+// replacement. A Mode 2 slot only moves when its pending provider completes
+// the repair with proofs, so a repair still in flight extends the migration
+// window. If the replacement can no longer take the position, a new one is
+// picked and the window restarts. The return value reports that the
+// migration is still pending.
+func (k Keeper) handOverAssignment(ctx sdk.Context, migration types.ProviderMigration, params types.Params) (bool, error) {
+	deal, err := k.Deals.Get(ctx, migration.DealId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	slot, held := providerSlotIndex(deal, migration.Provider)
+	if !held || checkDealActive(deal) != nil {
+		// Already handed over, e.g. the pending provider completed the slot
+		// repair, or the deal ended during the migration window.
+		return false, nil
+	}
+	slotIdx := uint32(slot)
+
+	active, err := k.isActiveProvider(ctx, migration.Replacement)
+	if err != nil {
+		return false, err
+	}
+	if active {
+		if hasMode2Slots(deal) {
+			s, err := mode2Slot(&deal, slotIdx)
+			if err != nil {
+				return false, err
+			}
+			if s.Status == types.SlotStatus_SLOT_STATUS_REPAIRING && s.PendingProvider == migration.Replacement {
+				// The replacement has not proven the slot yet; the leaving
+				// provider keeps serving until it does.
+				migration.CompletionHeight = uint64(ctx.BlockHeight()) + params.ProviderMigrationBlocks
+				return true, k.setProviderMigration(ctx, migration)
+			}
+		} else if !containsString(deal.Providers, migration.Replacement) {
+			err := k.replaceReplica(ctx, &deal, slotIdx, migration.Replacement)
+			if !errors.Is(err, types.ErrInsufficientCapacity) {
+				return false, err
+			}
+			// The replacement filled up in the meantime; pick another below.
+		}
+	}
+
+	restartHeight := uint64(ctx.BlockHeight()) + params.ProviderMigrationBlocks
+	err = k.beginProviderMigration(ctx, deal, slotIdx, migration.Provider, restartHeight)
+	if errors.Is(err, types.ErrNoReplacementProvider) {
+		// Nobody can take over yet; keep the current target and retry later.
+		migration.CompletionHeight = restartHeight
+		return true, k.setProviderMigration(ctx, migration)
+	}
+	return true, err
+}
+
+// replaceReplica hands a Mode 1 replica to replacement and moves the proof
+// obligation and the replica's storage with it.
+func (k Keeper) replaceReplica(ctx sdk.Context, deal *types.Deal, idx uint32, replacement string) error {
+	oldProvider := deal.Providers[idx]
+	footprint := DealStorageFootprint(*deal)
+	if err := k.commitProviderStorage(ctx, replacement, footprint); err != nil {
+		return err
+	}
+	if err := k.releaseProviderStorage(ctx, oldProvider, footprint); err != nil {
+		return err
+	}
+	deal.Providers[idx] = replacement
+	if err := k.Deals.Set(ctx, deal.Id, *deal); err != nil {
+		return fmt.Errorf("failed to update deal: %w", err)
+	}
+	if err := k.reindexDealProvider(ctx, *deal, oldProvider, replacement); err != nil {
+		return err
+	}
+	if err := k.RemoveProofDeadline(ctx, deal.Id, oldProvider); err != nil {
+		return err
+	}
+	return k.SetProofDeadline(ctx, deal.Id, replacement, NextProofDeadline(uint64(ctx.BlockHeight())))
+}
+
+// finishDeregistration removes a provider that holds no assignments and
+// queues its remaining bond for release after provider_unbonding_blocks.
+func (k Keeper) finishDeregistration(ctx sdk.Context, provider types.Provider, params types.Params) error {
+	bond := providerBond(params, provider)
+	completionHeight := uint64(ctx.BlockHeight()) + params.ProviderUnbondingBlocks
+	if bond.IsPositive() {
+		if err := k.addProviderUnbonding(ctx, provider.Address, completionHeight, bond); err != nil {
+			return err
+		}
+	}
+	if err := k.Providers.Remove(ctx, provider.Address); err != nil {
+		return fmt.Errorf("failed to remove provider: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeProviderDeregistered,
+			sdk.NewAttribute(types.AttributeKeyProvider, provider.Address),
+			sdk.NewAttribute(types.AttributeKeyBond, bond.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionHeight, fmt.Sprintf("%d", completionHeight)),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,384 @@
+package keeper_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+func TestUpdateProvider(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	params := types.DefaultParams()
+
+	addr := sdk.AccAddress([]byte("update_provider_____")).String()
+	_, err := msgServer.RegisterProvider(f.ctx, &types.MsgRegisterProvider{
+		Creator: addr, Capabilities: "General", TotalStorage: 1 << 30, Endpoints: testProviderEndpoints,
+	})
+	require.NoError(t, err)
+
+	_, err = msgServer.UpdateProvider(f.ctx, &types.MsgUpdateProvider{Creator: addr})
+	require.ErrorContains(t, err, "nothing to update")
+
+	// Endpoints go through the same multiaddr validation as registration.
+	_, err = msgServer.UpdateProvider(f.ctx, &types.MsgUpdateProvider{Creator: addr, Endpoints: []string{"http://1.2.3.4:8080"}})
+	require.Error(t, err)
+	_, err = msgServer.UpdateProvider(f.ctx, &types.MsgUpdateProvider{Creator: addr, Endpoints: []string{"/ip4/10.0.0.1/tcp/9000"}})
+	require.ErrorContains(t, err, "HTTP")
+	_, err = msgServer.UpdateProvider(f.ctx, &types.MsgUpdateProvider{Creator: addr, Capabilities: "Fast"})
+	require.Error(t, err)
+
+	// Growing capacity beyond what the bond covers requires a top-up first.
+	_, err = msgServer.UpdateProvider(f.ctx, &types.MsgUpdateProvider{Creator: addr, TotalStorage: 500 << 30})
+	require.ErrorContains(t, err, "top up first")
+
+	res, err := msgServer.UpdateProvider(f.ctx, &types.MsgUpdateProvider{
+		Creator:      addr,
+		Endpoints:    []string{"/ip4/10.0.0.1/tcp/9000/http", " /ip4/10.0.0.1/tcp/9000/http"},
+		TotalStorage: 2 << 30,
+		Capabilities: "Archive",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"/ip4/10.0.0.1/tcp/9000/http"}, res.Provider.Endpoints)
+	require.Equal(t, uint64(2<<30), res.Provider.TotalStorage)
+	require.Equal(t, "Archive", res.Provider.Capabilities)
+	require.Equal(t, keeper.RequiredProviderBond(params, 1<<30), res.Provider.Bond)
+
+	stored, err := f.keeper.Providers.Get(f.ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, res.Provider, stored)
+}
+
+func TestSetProviderStatus_PausesAssignments(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+
+	addr := sdk.AccAddress([]byte("status_provider_____")).String()
+	_, err := msgServer.RegisterProvider(f.ctx, &types.MsgRegisterProvider{
+		Creator: addr, Capabilities: "General", TotalStorage: 1 << 30, Endpoints: testProviderEndpoints,
+	})
+	require.NoError(t, err)
+
+	_, err = msgServer.SetProviderStatus(f.ctx, &types.MsgSetProviderStatus{Creator: addr, Status: "Jailed"})
+	require.Error(t, err)
+
+	res, err := msgServer.SetProviderStatus(f.ctx, &types.MsgSetProviderStatus{Creator: addr, Status: "Maintenance"})
+	require.NoError(t, err)
+	require.Equal(t, "Maintenance", res.Status)
+
+	user := sdk.AccAddress([]byte("status_user_________")).String()
+	create := &types.MsgCreateDeal{
+		Creator:             user,
+		DurationBlocks:      100,
+		ServiceHint:         "General",
+		MaxMonthlySpend:     math.NewInt(0),
+		InitialEscrowAmount: math.NewInt(0),
+	}
+	_, err = msgServer.CreateDeal(f.ctx, create)
+	require.ErrorContains(t, err, "no suitable providers")
+
+	_, err = msgServer.SetProviderStatus(f.ctx, &types.MsgSetProviderStatus{Creator: addr, Status: "Active"})
+	require.NoError(t, err)
+	_, err = msgServer.CreateDeal(f.ctx, create)
+	require.NoError(t, err)
+}
+
+func TestDeregisterProvider_MigratesAssignments(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	params := types.DefaultParams()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+	for i := 0; i < 20; i++ {
+		addr := sdk.AccAddress([]byte(fmt.Sprintf("provider_exit______%02d", i))).String()
+		_, err := msgServer.RegisterProvider(ctx, &types.MsgRegisterProvider{
+			Creator: addr, Capabilities: "General", TotalStorage: 1 << 30, Endpoints: testProviderEndpoints,
+		})
+		require.NoError(t, err)
+	}
+
+	user := sdk.AccAddress([]byte("user_exit___________")).String()
+	mode2, err := msgServer.CreateDeal(ctx, &types.MsgCreateDeal{
+		Creator: user, DurationBlocks: 1000, ServiceHint: "General:rs=8+4",
+		MaxMonthlySpend: math.NewInt(0), InitialEscrowAmount: math.NewInt(0),
+	})
+	require.NoError(t, err)
+	mode1, err := msgServer.CreateDeal(ctx.WithBlockHeight(11), &types.MsgCreateDeal{
+		Creator: user, DurationBlocks: 1000, ServiceHint: "General",
+		MaxMonthlySpend: math.NewInt(0), InitialEscrowAmount: math.NewInt(0),
+	})
+	require.NoError(t, err)
+
+	// 12 of 20 providers in each deal: at least one holds a position in both.
+	var leaving string
+	for _, p := range mode2.AssignedProviders {
+		for _, q := range mode1.AssignedProviders {
+			if p == q && leaving == "" {
+				leaving = p
+			}
+		}
+	}
+	require.NotEmpty(t, leaving)
+
+	res, err := msgServer.DeregisterProvider(ctx, &types.MsgDeregisterProvider{Creator: leaving})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.PendingMigrations)
+	require.Equal(t, uint64(10)+params.ProviderMigrationBlocks, res.CompletionHeight)
+
+	_, err = msgServer.DeregisterProvider(ctx, &types.MsgDeregisterProvider{Creator: leaving})
+	require.ErrorContains(t, err, "already deregistering")
+	_, err = msgServer.SetProviderStatus(ctx, &types.MsgSetProviderStatus{Creator: leaving, Status: "Active"})
+	require.Error(t, err)
+
+	provider, err := f.keeper.Providers.Get(ctx, leaving)
+	require.NoError(t, err)
+	require.Equal(t, "Deregistering", provider.Status)
+
+	// The Mode 2 slot is repairing towards the replacement; the leaving
+	// provider keeps serving until the handover.
+	mode2Migration, err := f.keeper.ProviderMigrations.Get(ctx, collections.Join(leaving, mode2.DealId))
+	require.NoError(t, err)
+	deal, err := f.keeper.Deals.Get(ctx, mode2.DealId)
+	require.NoError(t, err)
+	slot := deal.Mode2Slots[mode2Migration.Slot]
+	require.Equal(t, types.SlotStatus_SLOT_STATUS_REPAIRING, slot.Status)
+	require.Equal(t, leaving, slot.Provider)
+	require.Equal(t, mode2Migration.Replacement, slot.PendingProvider)
+	require.NotContains(t, deal.Providers, mode2Migration.Replacement)
+
+	mode1Migration, err := f.keeper.ProviderMigrations.Get(ctx, collections.Join(leaving, mode1.DealId))
+	require.NoError(t, err)
+
+	// Nothing is handed over before the migration window ends.
+	require.NoError(t, f.keeper.CompleteProviderMigrations(ctx.WithBlockHeight(int64(res.CompletionHeight-1))))
+	_, err = f.keeper.Providers.Get(ctx, leaving)
+	require.NoError(t, err)
+
+	// The Mode 1 replica is handed over when the window ends. The Mode 2 slot
+	// is not: its replacement has not proven the repair, so the leaving
+	// provider keeps it for another window.
+	windowCtx := ctx.WithBlockHeight(int64(res.CompletionHeight))
+	require.NoError(t, f.keeper.CompleteProviderMigrations(windowCtx))
+
+	deal, err = f.keeper.Deals.Get(ctx, mode1.DealId)
+	require.NoError(t, err)
+	require.Equal(t, mode1Migration.Replacement, deal.Providers[mode1Migration.Slot])
+	require.NotContains(t, deal.Providers, leaving)
+
+	deal, err = f.keeper.Deals.Get(ctx, mode2.DealId)
+	require.NoError(t, err)
+	slot = deal.Mode2Slots[mode2Migration.Slot]
+	require.Equal(t, types.SlotStatus_SLOT_STATUS_REPAIRING, slot.Status)
+	require.Equal(t, leaving, slot.Provider)
+	extended, err := f.keeper.ProviderMigrations.Get(ctx, collections.Join(leaving, mode2.DealId))
+	require.NoError(t, err)
+	require.Equal(t, res.CompletionHeight+params.ProviderMigrationBlocks, extended.CompletionHeight)
+	require.Equal(t, mode2Migration.Replacement, extended.Replacement)
+	_, err = f.keeper.Providers.Get(ctx, leaving)
+	require.NoError(t, err)
+
+	// The deal holds no user data, so the replacement completes the repair
+	// without proofs.
+	_, err = msgServer.CompleteSlotRepair(windowCtx, &types.MsgCompleteSlotRepair{
+		Creator: mode2Migration.Replacement, DealId: mode2.DealId, Slot: mode2Migration.Slot,
+	})
+	require.NoError(t, err)
+	doneCtx := ctx.WithBlockHeight(int64(extended.CompletionHeight))
+	require.NoError(t, f.keeper.CompleteProviderMigrations(doneCtx))
+
+	deal, err = f.keeper.Deals.Get(ctx, mode2.DealId)
+	require.NoError(t, err)
+	slot = deal.Mode2Slots[mode2Migration.Slot]
+	require.Equal(t, types.SlotStatus_SLOT_STATUS_ACTIVE, slot.Status)
+	require.Equal(t, mode2Migration.Replacement, slot.Provider)
+	require.NotContains(t, deal.Providers, leaving)
+
+	// Proof obligations moved with the assignments.
+	for _, dealID := range []uint64{mode2.DealId, mode1.DealId} {
+		has, err := f.keeper.ProofDeadlinesByDealProvider.Has(ctx, collections.Join(dealID, leaving))
+		require.NoError(t, err)
+		require.False(t, has)
+	}
+	has, err := f.keeper.ProofDeadlinesByDealProvider.Has(ctx, collections.Join(mode1.DealId, mode1Migration.Replacement))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// The provider is gone and its bond is unbonding.
+	_, err = f.keeper.Providers.Get(ctx, leaving)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	unbonding, err := f.keeper.ProviderUnbondings.Get(ctx, collections.Join(leaving, extended.CompletionHeight+params.ProviderUnbondingBlocks))
+	require.NoError(t, err)
+	require.Equal(t, provider.Bond, unbonding)
+
+	iter, err := f.keeper.ProviderMigrations.Iterate(ctx, nil)
+	require.NoError(t, err)
+	require.False(t, iter.Valid())
+	iter.Close()
+}
+
+func TestDeregisterProvider_WithoutAssignmentsFinishesImmediately(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	params := types.DefaultParams()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(5)
+
+	addr := sdk.AccAddress([]byte("idle_provider_______")).String()
+	_, err := msgServer.RegisterProvider(ctx, &types.MsgRegisterProvider{
+		Creator: addr, Capabilities: "General", TotalStorage: 1 << 30, Endpoints: testProviderEndpoints,
+	})
+	require.NoError(t, err)
+
+	res, err := msgServer.DeregisterProvider(ctx, &types.MsgDeregisterProvider{Creator: addr})
+	require.NoError(t, err)
+	require.Zero(t, res.PendingMigrations)
+
+	_, err = f.keeper.Providers.Get(ctx, addr)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	unbonding, err := f.keeper.ProviderUnbondings.Get(ctx, collections.Join(addr, uint64(5)+params.ProviderUnbondingBlocks))
+	require.NoError(t, err)
+	require.Equal(t, keeper.RequiredProviderBond(params, 1<<30), unbonding)
+
+	// The address may register again once it has left.
+	_, err = msgServer.RegisterProvider(ctx, &types.MsgRegisterProvider{
+		Creator: addr, Capabilities: "General", TotalStorage: 1 << 30, Endpoints: testProviderEndpoints,
+	})
+	require.NoError(t, err)
+}
+
+func TestDeregisterProvider_WaitsForReplacement(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+
+	addr := sdk.AccAddress([]byte("sole_provider_______")).String()
+	_, err := msgServer.RegisterProvider(f.ctx, &types.MsgRegisterProvider{
+		Creator: addr, Capabilities: "General", TotalStorage: 1 << 30, Endpoints: testProviderEndpoints,
+	})
+	require.NoError(t, err)
+	user := sdk.AccAddress([]byte("sole_user___________")).String()
+	_, err = msgServer.CreateDeal(f.ctx, &types.MsgCreateDeal{
+		Creator: user, DurationBlocks: 100, ServiceHint: "General",
+		MaxMonthlySpend: math.NewInt(0), InitialEscrowAmount: math.NewInt(0),
+	})
+	require.NoError(t, err)
+
+	// Nobody can take over the replica yet. The provider still leaves; its
+	// migration has no target and is retried when it falls due.
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	res, err := msgServer.DeregisterProvider(ctx, &types.MsgDeregisterProvider{Creator: addr})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.PendingMigrations)
+	provider, err := f.keeper.Providers.Get(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, "Deregistering", provider.Status)
+	migration, err := f.keeper.ProviderMigrations.Get(ctx, collections.Join(addr, uint64(0)))
+	require.NoError(t, err)
+	require.Empty(t, migration.Replacement)
+
+	// Still nobody when it falls due: the provider keeps serving for another
+	// window.
+	dueCtx := ctx.WithBlockHeight(int64(res.CompletionHeight))
+	require.NoError(t, f.keeper.CompleteProviderMigrations(dueCtx))
+	migration, err = f.keeper.ProviderMigrations.Get(ctx, collections.Join(addr, uint64(0)))
+	require.NoError(t, err)
+	require.Empty(t, migration.Replacement)
+	require.Greater(t, migration.CompletionHeight, res.CompletionHeight)
+	deal, err := f.keeper.Deals.Get(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{addr}, deal.Providers)
+
+	// A provider that joins later takes over at the next attempt.
+	newcomer := sdk.AccAddress([]byte("late_provider_______")).String()
+	_, err = msgServer.RegisterProvider(dueCtx, &types.MsgRegisterProvider{
+		Creator: newcomer, Capabilities: "General", TotalStorage: 1 << 30, Endpoints: testProviderEndpoints,
+	})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.CompleteProviderMigrations(ctx.WithBlockHeight(int64(migration.CompletionHeight))))
+	migration, err = f.keeper.ProviderMigrations.Get(ctx, collections.Join(addr, uint64(0)))
+	require.NoError(t, err)
+	require.Equal(t, newcomer, migration.Replacement)
+}
+
+func TestDeregisterProvider_DropsPendingSlotRepairs(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		providers int
+		reassign  bool
+	}{
+		{name: "reassigned", providers: 20, reassign: true},
+		{name: "abandoned without replacement", providers: 13},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+			msgServer := keeper.NewMsgServerImpl(f.keeper)
+			ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+			var registered []string
+			for i := 0; i < tc.providers; i++ {
+				addr := sdk.AccAddress([]byte(fmt.Sprintf("provider_pend______%02d", i))).String()
+				_, err := msgServer.RegisterProvider(ctx, &types.MsgRegisterProvider{
+					Creator: addr, Capabilities: "General", TotalStorage: 1 << 30, Endpoints: testProviderEndpoints,
+				})
+				require.NoError(t, err)
+				registered = append(registered, addr)
+			}
+			user := sdk.AccAddress([]byte("user_pend___________")).String()
+			res, err := msgServer.CreateDeal(ctx, &types.MsgCreateDeal{
+				Creator: user, DurationBlocks: 1000, ServiceHint: "General:rs=8+4",
+				MaxMonthlySpend: math.NewInt(0), InitialEscrowAmount: math.NewInt(0),
+			})
+			require.NoError(t, err)
+
+			var pending string
+			for _, addr := range registered {
+				if !slices.Contains(res.AssignedProviders, addr) {
+					pending = addr
+					break
+				}
+			}
+			require.NotEmpty(t, pending)
+			_, err = msgServer.StartSlotRepair(ctx, &types.MsgStartSlotRepair{Creator: user, DealId: res.DealId, Slot: 0, PendingProvider: pending})
+			require.NoError(t, err)
+
+			// Holding no slot, the provider leaves at once, and its repair
+			// goes with it.
+			out, err := msgServer.DeregisterProvider(ctx, &types.MsgDeregisterProvider{Creator: pending})
+			require.NoError(t, err)
+			require.Zero(t, out.PendingMigrations)
+			_, err = f.keeper.Providers.Get(ctx, pending)
+			require.ErrorIs(t, err, collections.ErrNotFound)
+
+			deal, err := f.keeper.Deals.Get(ctx, res.DealId)
+			require.NoError(t, err)
+			slot := deal.Mode2Slots[0]
+			require.NotEqual(t, pending, slot.PendingProvider)
+			if tc.reassign {
+				require.Equal(t, types.SlotStatus_SLOT_STATUS_REPAIRING, slot.Status)
+				require.NotEmpty(t, slot.PendingProvider)
+				has, err := f.keeper.SlotRepairQueue.Has(ctx, collections.Join3(slot.RepairDeadlineHeight, deal.Id, uint32(0)))
+				require.NoError(t, err)
+				require.True(t, has)
+			} else {
+				require.Equal(t, types.SlotStatus_SLOT_STATUS_ACTIVE, slot.Status)
+				require.Empty(t, slot.PendingProvider)
+				iter, err := f.keeper.SlotRepairQueue.Iterate(ctx, nil)
+				require.NoError(t, err)
+				require.False(t, iter.Valid())
+				iter.Close()
+			}
+
+			_, broken := keeper.DealProvidersInvariant(f.keeper)(ctx)
+			require.False(t, broken)
+			genesis, err := f.keeper.ExportGenesis(ctx)
+			require.NoError(t, err)
+			require.NoError(t, genesis.Validate())
+		})
+	}
+}
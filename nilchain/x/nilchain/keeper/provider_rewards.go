@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nilchain/x/nilchain/types"
+)
+
+// CreditProviderRewards accrues a provider's unclaimed rewards. storage is
+// inflation-funded and is minted on withdrawal; bandwidth has already been
+// debited from a deal's escrow, so its coins are in the module account.
+func (k Keeper) CreditProviderRewards(ctx context.Context, provider string, storage, bandwidth math.Int) error {
+	if err := addProviderReward(ctx, k.ProviderRewards, provider, storage); err != nil {
+		return fmt.Errorf("failed to set provider rewards: %w", err)
+	}
+	if err := addProviderReward(ctx, k.ProviderBandwidthRewards, provider, bandwidth); err != nil {
+		return fmt.Errorf("failed to set provider bandwidth rewards: %w", err)
+	}
+	return nil
+}
+
+func addProviderReward(ctx context.Context, ledger collections.Map[string, math.Int], provider string, amount math.Int) error {
+	if amount.IsNil() || !amount.IsPositive() {
+		return nil
+	}
+	current, err := ledger.Get(ctx, provider)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		current = math.ZeroInt()
+	}
+	return ledger.Set(ctx, provider, current.Add(amount))
+}
+
+// WithdrawRewards allows a Storage Provider to withdraw accumulated rewards.
+// Storage rewards are minted; bandwidth rewards are paid from the escrowed
+// coins the module account already holds.
+func (k msgServer) WithdrawRewards(goCtx context.Context, msg *types.MsgWithdrawRewards) (*types.MsgWithdrawRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	providerAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid provider address: %s", err)
+	}
+
+	storage, hasStorage, err := providerReward(ctx, k.ProviderRewards, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	bandwidth, hasBandwidth, err := providerReward(ctx, k.ProviderBandwidthRewards, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if !hasStorage && !hasBandwidth {
+		return nil, sdkerrors.ErrNotFound.Wrap("no rewards found")
+	}
+	total := storage.Add(bandwidth)
+	if !total.IsPositive() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("no rewards to withdraw")
+	}
+
+	if storage.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, storage))
+		if err := k.BankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return nil, fmt.Errorf("failed to mint storage rewards: %w", err)
+		}
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, total))
+	if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, providerAddr, coins); err != nil {
+		return nil, fmt.Errorf("failed to pay provider rewards: %w", err)
+	}
+
+	if err := k.ProviderRewards.Remove(ctx, msg.Creator); err != nil {
+		return nil, fmt.Errorf("failed to reset provider rewards: %w", err)
+	}
+	if err := k.ProviderBandwidthRewards.Remove(ctx, msg.Creator); err != nil {
+		return nil, fmt.Errorf("failed to reset provider bandwidth rewards: %w", err)
+	}
+
+	return &types.MsgWithdrawRewardsResponse{
+		AmountWithdrawn:  total,
+		StorageRewards:   storage,
+		BandwidthRewards: bandwidth,
+	}, nil
+}
+
+// providerReward returns a provider's unclaimed balance in one ledger, or
+// zero, and whether the ledger has an entry for the provider.
+func providerReward(ctx context.Context, ledger collections.Map[string, math.Int], provider string) (math.Int, bool, error) {
+	amount, err := ledger.Get(ctx, provider)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return math.ZeroInt(), false, nil
+		}
+		return math.Int{}, false, err
+	}
+	if amount.IsNil() || amount.IsNegative() {
+		return math.ZeroInt(), true, nil
+	}
+	return amount, true, nil
+}
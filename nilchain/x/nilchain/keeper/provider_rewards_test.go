@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+func TestWithdrawRewardsSplitsStorageAndBandwidth(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	invariant := keeper.ModuleAccountSolvencyInvariant(f.keeper)
+
+	owner := sdk.AccAddress([]byte("rewards_owner_______"))
+	ctx, deal := setupExpiringDeal(t, bank, f, owner, 40, 1000)
+	provider := deal.Providers[0]
+
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// Bandwidth is paid out of escrow, so the coins stay in the module
+	// account; storage rewards are only a ledger entry until withdrawal.
+	deal.EscrowBalance = deal.EscrowBalance.SubRaw(3)
+	require.NoError(t, f.keeper.Deals.Set(ctx, deal.Id, deal))
+	require.NoError(t, f.keeper.CreditProviderRewards(ctx, provider, math.NewInt(5), math.NewInt(3)))
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	moduleBefore := bank.moduleBalances[types.ModuleName].AmountOf(sdk.DefaultBondDenom)
+	providerBefore := bank.accountBalances[provider].AmountOf(sdk.DefaultBondDenom)
+
+	res, err := msgServer.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Creator: provider})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(8), res.AmountWithdrawn)
+	require.Equal(t, math.NewInt(5), res.StorageRewards)
+	require.Equal(t, math.NewInt(3), res.BandwidthRewards)
+
+	// Only the storage part is minted, so the module account ends up 3 lower.
+	require.Equal(t, moduleBefore.SubRaw(3), bank.moduleBalances[types.ModuleName].AmountOf(sdk.DefaultBondDenom))
+	require.Equal(t, providerBefore.AddRaw(8), bank.accountBalances[provider].AmountOf(sdk.DefaultBondDenom))
+
+	has, err := f.keeper.ProviderRewards.Has(ctx, provider)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.ProviderBandwidthRewards.Has(ctx, provider)
+	require.NoError(t, err)
+	require.False(t, has)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	_, err = msgServer.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Creator: provider})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	// An entry that holds nothing is not withdrawable either.
+	require.NoError(t, f.keeper.ProviderRewards.Set(ctx, provider, math.ZeroInt()))
+	_, err = msgServer.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Creator: provider})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.NoError(t, f.keeper.ProviderRewards.Remove(ctx, provider))
+
+	// Bandwidth credited without backing coins breaks the invariant.
+	require.NoError(t, f.keeper.CreditProviderRewards(ctx, provider, math.ZeroInt(), bank.moduleBalances[types.ModuleName].AmountOf(sdk.DefaultBondDenom)))
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+}
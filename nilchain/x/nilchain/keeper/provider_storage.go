@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"cosmossdk.io/collections"
+
+	"nilchain/x/nilchain/types"
+)
+
+// DealStorageFootprint returns the bytes a deal occupies on each provider
+// holding it: every stored MDU on a Mode 1 replica, or one slot's share of
+// each MDU (rows blobs) on a Mode 2 stripe. Deals that never committed
+// total_mdus are sized as ceil(size / MDU_SIZE) MDUs, as in livenessMdus.
+func DealStorageFootprint(deal types.Deal) uint64 {
+	mdus := deal.TotalMdus
+	if mdus == 0 {
+		mdus = ceilDivUint64(deal.Size_, types.MDU_SIZE)
+	}
+	perMdu := uint64(types.MDU_SIZE)
+	if stripe, err := stripeParamsForDeal(deal); err == nil && stripe.mode == 2 {
+		perMdu = stripe.rows * types.BLOB_SIZE
+	}
+	bytes, overflow := mulUint64(mdus, perMdu)
+	if overflow {
+		return math.MaxUint64
+	}
+	return bytes
+}
+
+// ProviderFreeStorage returns the bytes a provider can still take on: its
+// total storage less what is committed to deals and reserved for repairs.
+func ProviderFreeStorage(provider types.Provider) uint64 {
+	held, overflow := addUint64(provider.UsedStorage, provider.ReservedStorage)
+	if overflow || held >= provider.TotalStorage {
+		return 0
+	}
+	return provider.TotalStorage - held
+}
+
+// MinPlacementStorage is the headroom a provider needs to be placed on a new,
+// still empty deal: one MDU, or one MDU's slot share for a Mode 2 stripe.
+func MinPlacementStorage(hint types.ServiceHintInfo) uint64 {
+	if hint.HasRS {
+		if rs, ok, err := types.RSParamsFromHint(hint); err == nil && ok {
+			return rs.Rows * types.BLOB_SIZE
+		}
+	}
+	return types.MDU_SIZE
+}
+
+// updateProviderStorage applies update to a registered provider and saves
+// it. Providers that have since left are skipped.
+func (k Keeper) updateProviderStorage(ctx context.Context, providerAddr string, update func(*types.Provider) error) error {
+	provider, err := k.Providers.Get(ctx, providerAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if err := update(&provider); err != nil {
+		return err
+	}
+	return k.Providers.Set(ctx, providerAddr, provider)
+}
+
+// commitProviderStorage charges bytes of deal content to a provider's used
+// storage, failing if the provider has no room for them.
+func (k Keeper) commitProviderStorage(ctx context.Context, providerAddr string, bytes uint64) error {
+	if bytes == 0 {
+		return nil
+	}
+	return k.updateProviderStorage(ctx, providerAddr, func(provider *types.Provider) error {
+		if free := ProviderFreeStorage(*provider); free < bytes {
+			return types.ErrInsufficientCapacity.Wrapf("provider %s has %d bytes free, needs %d", providerAddr, free, bytes)
+		}
+		provider.UsedStorage += bytes
+		return nil
+	})
+}
+
+// releaseProviderStorage returns bytes of a provider's used storage, never
+// going below zero.
+func (k Keeper) releaseProviderStorage(ctx context.Context, providerAddr string, bytes uint64) error {
+	if bytes == 0 {
+		return nil
+	}
+	return k.updateProviderStorage(ctx, providerAddr, func(provider *types.Provider) error {
+		provider.UsedStorage = subUint64Floor(provider.UsedStorage, bytes)
+		return nil
+	})
+}
+
+// reserveProviderStorage holds bytes for a slot repair the provider is
+// pending on, failing if the provider has no room for them.
+func (k Keeper) reserveProviderStorage(ctx context.Context, providerAddr string, bytes uint64) error {
+	if bytes == 0 {
+		return nil
+	}
+	return k.updateProviderStorage(ctx, providerAddr, func(provider *types.Provider) error {
+		if free := ProviderFreeStorage(*provider); free < bytes {
+			return types.ErrInsufficientCapacity.Wrapf("provider %s has %d bytes free, needs %d", providerAddr, free, bytes)
+		}
+		provider.ReservedStorage += bytes
+		return nil
+	})
+}
+
+// releaseProviderReservation drops bytes of a provider's reserved storage,
+// never going below zero.
+func (k Keeper) releaseProviderReservation(ctx context.Context, providerAddr string, bytes uint64) error {
+	if bytes == 0 {
+		return nil
+	}
+	return k.updateProviderStorage(ctx, providerAddr, func(provider *types.Provider) error {
+		provider.ReservedStorage = subUint64Floor(provider.ReservedStorage, bytes)
+		return nil
+	})
+}
+
+// commitProviderReservation turns bytes a provider reserved for a slot
+// repair into used storage once it takes the slot over.
+func (k Keeper) commitProviderReservation(ctx context.Context, providerAddr string, bytes uint64) error {
+	if bytes == 0 {
+		return nil
+	}
+	return k.updateProviderStorage(ctx, providerAddr, func(provider *types.Provider) error {
+		provider.ReservedStorage = subUint64Floor(provider.ReservedStorage, bytes)
+		provider.UsedStorage += bytes
+		return nil
+	})
+}
+
+// resizeDealStorage moves every holder of a deal from a footprint of from
+// bytes to the deal's current footprint: growth is charged (and reserved for
+// pending repair providers), shrinkage released.
+func (k Keeper) resizeDealStorage(ctx context.Context, deal types.Deal, from uint64) error {
+	to := DealStorageFootprint(deal)
+	if to == from {
+		return nil
+	}
+	for _, provider := range deal.Providers {
+		var err error
+		if to > from {
+			err = k.commitProviderStorage(ctx, provider, to-from)
+		} else {
+			err = k.releaseProviderStorage(ctx, provider, from-to)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for _, slot := range deal.Mode2Slots {
+		if slot == nil || slot.PendingProvider == "" {
+			continue
+		}
+		var err error
+		if to > from {
+			err = k.reserveProviderStorage(ctx, slot.PendingProvider, to-from)
+		} else {
+			err = k.releaseProviderReservation(ctx, slot.PendingProvider, from-to)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rebuildProviderStorage recomputes every provider's used and reserved
+// storage from the active deals and their pending slot repairs. Content
+// stored before providers were charged for it can leave a provider over
+// capacity; it then takes no new content until enough is released.
+func (k Keeper) rebuildProviderStorage(ctx context.Context) error {
+	used := make(map[string]uint64)
+	reserved := make(map[string]uint64)
+	add := func(totals map[string]uint64, addr string, bytes uint64) {
+		sum, overflow := addUint64(totals[addr], bytes)
+		if overflow {
+			sum = math.MaxUint64
+		}
+		totals[addr] = sum
+	}
+	if err := k.Deals.Walk(ctx, nil, func(_ uint64, deal types.Deal) (bool, error) {
+		if checkDealActive(deal) != nil {
+			return false, nil
+		}
+		footprint := DealStorageFootprint(deal)
+		for _, provider := range deal.Providers {
+			add(used, provider, footprint)
+		}
+		for _, slot := range deal.Mode2Slots {
+			if slot != nil && slot.PendingProvider != "" {
+				add(reserved, slot.PendingProvider, footprint)
+			}
+		}
+		return false, nil
+	}); err != nil {
+		return fmt.Errorf("failed to walk deals: %w", err)
+	}
+
+	var providers []types.Provider
+	if err := k.Providers.Walk(ctx, nil, func(_ string, provider types.Provider) (bool, error) {
+		providers = append(providers, provider)
+		return false, nil
+	}); err != nil {
+		return fmt.Errorf("failed to walk providers: %w", err)
+	}
+	for _, provider := range providers {
+		provider.UsedStorage = used[provider.Address]
+		provider.ReservedStorage = reserved[provider.Address]
+		if err := k.Providers.Set(ctx, provider.Address, provider); err != nil {
+			return fmt.Errorf("failed to update provider storage: %w", err)
+		}
+	}
+	return nil
+}
+
+func subUint64Floor(a, b uint64) uint64 {
+	if a < b {
+		return 0
+	}
+	return a - b
+}
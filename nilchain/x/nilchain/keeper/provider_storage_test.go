@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+func TestProviderStorageTracksDealContent(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+
+	owner := sdk.AccAddress([]byte("storage_owner_______")).String()
+	ctx, deal := setupExpiringDeal(t, bank, f, sdk.MustAccAddressFromBech32(owner), 40, 1000)
+	cid := "0x" + strings.Repeat("ab", 48)
+
+	used := func(addr string) uint64 {
+		provider, err := f.keeper.Providers.Get(ctx, addr)
+		require.NoError(t, err)
+		return provider.UsedStorage
+	}
+	update := func(size uint64) error {
+		_, err := msgServer.UpdateDealContent(ctx, &types.MsgUpdateDealContent{Creator: owner, DealId: deal.Id, Cid: cid, Size_: size})
+		return err
+	}
+
+	// 3 MDUs and a byte round up to 4 MDUs on every replica.
+	require.NoError(t, update(3*types.MDU_SIZE+1))
+	for _, addr := range deal.Providers {
+		require.Equal(t, uint64(4*types.MDU_SIZE), used(addr))
+	}
+
+	// A replica without room for the growth rejects the update.
+	full, err := f.keeper.Providers.Get(ctx, deal.Providers[0])
+	require.NoError(t, err)
+	full.TotalStorage = full.UsedStorage + types.MDU_SIZE
+	require.NoError(t, f.keeper.Providers.Set(ctx, full.Address, full))
+	require.ErrorIs(t, update(6*types.MDU_SIZE), types.ErrInsufficientCapacity)
+
+	res, err := queryServer.GetProviderStorage(ctx, &types.QueryGetProviderStorageRequest{Address: full.Address})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4*types.MDU_SIZE), res.CommittedStorage)
+	require.Zero(t, res.ReservedStorage)
+	require.Equal(t, uint64(types.MDU_SIZE), res.FreeStorage)
+
+	// Placement skips providers without room for the deal.
+	assigned, _, err := f.keeper.AssignProviders(ctx, 99, nil, keeper.PlacementRequest{
+		ServiceHint: "General", Count: types.DealBaseReplication, MinFree: 2 * types.MDU_SIZE,
+	})
+	require.NoError(t, err)
+	require.Len(t, assigned, types.DealBaseReplication-1)
+	require.NotContains(t, assigned, full.Address)
+
+	// Shrinking releases storage, and closing the deal releases the rest.
+	require.NoError(t, update(1))
+	require.Equal(t, uint64(types.MDU_SIZE), used(full.Address))
+	_, err = msgServer.CloseDeal(ctx, &types.MsgCloseDeal{Creator: owner, DealId: deal.Id})
+	require.NoError(t, err)
+	for _, addr := range deal.Providers {
+		require.Zero(t, used(addr))
+	}
+}
+
+func TestProviderStorageFollowsSlotRepair(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+
+	for i := 0; i < 14; i++ {
+		addr, _ := f.addressCodec.BytesToString([]byte(fmt.Sprintf("storage_repair_____%02d", i)))
+		_, err := msgServer.RegisterProvider(f.ctx, &types.MsgRegisterProvider{
+			Creator: addr, Capabilities: "General", TotalStorage: 1 << 30, Endpoints: testProviderEndpoints,
+		})
+		require.NoError(t, err)
+	}
+	user, _ := f.addressCodec.BytesToString([]byte("storage_repair_user_"))
+	res, err := msgServer.CreateDeal(f.ctx, &types.MsgCreateDeal{
+		Creator: user, DurationBlocks: 1000, ServiceHint: "General:rs=8+4",
+		MaxMonthlySpend: math.NewInt(0), InitialEscrowAmount: math.NewInt(0),
+	})
+	require.NoError(t, err)
+
+	// Each slot holds 64/8 = 8 blobs of every MDU.
+	_, err = msgServer.UpdateDealContent(f.ctx, &types.MsgUpdateDealContent{
+		Creator: user, DealId: res.DealId, Cid: "0x" + strings.Repeat("cd", 48), Size_: 2 * types.MDU_SIZE,
+	})
+	require.NoError(t, err)
+	share := uint64(2 * 8 * types.BLOB_SIZE)
+
+	deal, err := f.keeper.Deals.Get(f.ctx, res.DealId)
+	require.NoError(t, err)
+	require.Equal(t, share, keeper.DealStorageFootprint(deal))
+	var outsider string
+	err = f.keeper.Providers.Walk(f.ctx, nil, func(addr string, _ types.Provider) (bool, error) {
+		if !slices.Contains(deal.Providers, addr) {
+			outsider = addr
+			return true, nil
+		}
+		return false, nil
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, outsider)
+
+	storage := func(addr string) *types.QueryGetProviderStorageResponse {
+		out, err := keeper.NewQueryServerImpl(f.keeper).GetProviderStorage(f.ctx, &types.QueryGetProviderStorageRequest{Address: addr})
+		require.NoError(t, err)
+		return out
+	}
+
+	_, err = msgServer.StartSlotRepair(f.ctx, &types.MsgStartSlotRepair{Creator: user, DealId: res.DealId, Slot: 0, PendingProvider: outsider})
+	require.NoError(t, err)
+	require.Equal(t, share, storage(outsider).ReservedStorage)
+	require.Zero(t, storage(outsider).CommittedStorage)
+
+	// The v4 migration rebuilds the same totals for content stored before
+	// providers were charged for it.
+	migrateCtx := sdk.UnwrapSDKContext(f.ctx)
+	err = f.keeper.Providers.Walk(migrateCtx, nil, func(addr string, provider types.Provider) (bool, error) {
+		provider.UsedStorage, provider.ReservedStorage = 0, 0
+		if addr == outsider {
+			provider.UsedStorage = 7
+		}
+		return false, f.keeper.Providers.Set(migrateCtx, addr, provider)
+	})
+	require.NoError(t, err)
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate3to4(migrateCtx))
+	require.Equal(t, share, storage(outsider).ReservedStorage)
+	require.Zero(t, storage(outsider).CommittedStorage)
+	require.Equal(t, share, storage(deal.Providers[1]).CommittedStorage)
+
+	// Only the pending provider can complete the repair, and only once the
+	// repair challenge opens in the next liveness epoch.
+	_, err = msgServer.CompleteSlotRepair(f.ctx, &types.MsgCompleteSlotRepair{Creator: user, DealId: res.DealId, Slot: 0})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.CompleteSlotRepair(f.ctx, &types.MsgCompleteSlotRepair{Creator: outsider, DealId: res.DealId, Slot: 0})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// A leaving provider migrates its slot to the in-flight repair target,
+	// but keeps it, and its storage, until the target proves the repair.
+	old := deal.Providers[0]
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	dereg, err := msgServer.DeregisterProvider(ctx, &types.MsgDeregisterProvider{Creator: old})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.CompleteProviderMigrations(ctx.WithBlockHeight(int64(dereg.CompletionHeight))))
+	require.Equal(t, share, storage(outsider).ReservedStorage)
+	require.Zero(t, storage(outsider).CommittedStorage)
+	require.Equal(t, share, storage(old).CommittedStorage)
+	_, broken := keeper.ProviderStorageInvariant(f.keeper)(ctx)
+	require.False(t, broken)
+
+	// A repair towards a provider without room for the slot is rejected.
+	target := deal.Providers[2]
+	crowded, err := f.keeper.Providers.Get(f.ctx, target)
+	require.NoError(t, err)
+	crowded.TotalStorage = crowded.UsedStorage + crowded.ReservedStorage
+	require.NoError(t, f.keeper.Providers.Set(f.ctx, target, crowded))
+	_, err = msgServer.StartSlotRepair(f.ctx, &types.MsgStartSlotRepair{Creator: user, DealId: res.DealId, Slot: 1, PendingProvider: target})
+	require.ErrorIs(t, err, types.ErrInsufficientCapacity)
+}
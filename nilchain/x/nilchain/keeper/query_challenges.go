@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nilchain/x/nilchain/types"
+)
+
+func (k queryServer) GetChallengeSet(goCtx context.Context, req *types.QueryGetChallengeSetRequest) (*types.QueryGetChallengeSetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	provider := strings.TrimSpace(req.Provider)
+	if provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider is required")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	deal, err := k.k.Deals.Get(ctx, req.DealId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "deal not found")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if !containsString(deal.Providers, provider) {
+		return nil, status.Error(codes.NotFound, "provider is not assigned to deal")
+	}
+
+	set, err := k.k.deriveChallengeSet(ctx, deal, provider)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "epoch seed not recorded yet")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	challenges := set.challenges
+	if challenges == nil {
+		challenges = []types.ChallengePosition{}
+	}
+	return &types.QueryGetChallengeSetResponse{
+		EpochId:                 set.epochID,
+		EpochStartHeight:        set.epochStart,
+		QuotaBlobs:              set.quota,
+		CreditsBlobs:            set.creditsApplied,
+		SyntheticNeeded:         set.syntheticNeeded,
+		SyntheticSatisfiedBlobs: set.state.SyntheticSatisfiedBlobs,
+		Challenges:              challenges,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nilchain/x/nilchain/types"
+)
+
+func (k queryServer) ListDealAccessGrants(ctx context.Context, req *types.QueryListDealAccessGrantsRequest) (*types.QueryListDealAccessGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	grants, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.k.DealAccessGrants,
+		req.Pagination,
+		func(_ collections.Pair[uint64, string], value types.DealAccessGrant) (types.DealAccessGrant, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, string](req.DealId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListDealAccessGrantsResponse{Grants: grants, Pagination: pageRes}, nil
+}
+
+func (k queryServer) GetDealAccessGrant(goCtx context.Context, req *types.QueryGetDealAccessGrantRequest) (*types.QueryGetDealAccessGrantResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	grantee := strings.TrimSpace(req.Grantee)
+	if grantee == "" {
+		return nil, status.Error(codes.InvalidArgument, "grantee is required")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	grant, err := k.k.DealAccessGrants.Get(ctx, collections.Join(req.DealId, grantee))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "access grant not found")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetDealAccessGrantResponse{
+		Grant:  grant,
+		Active: dealAccessGrantActive(grant, ctx.BlockHeight()),
+	}, nil
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nilchain/x/nilchain/types"
+)
+
+func (k queryServer) ListDealsByOwner(ctx context.Context, req *types.QueryListDealsByOwnerRequest) (*types.QueryListDealsByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	owner := strings.TrimSpace(req.Owner)
+	if owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner is required")
+	}
+
+	deals, pageRes, err := k.paginateDealIndex(ctx, k.k.DealsByOwner, owner, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryListDealsByOwnerResponse{Deals: deals, Pagination: pageRes}, nil
+}
+
+func (k queryServer) ListDealsByProvider(ctx context.Context, req *types.QueryListDealsByProviderRequest) (*types.QueryListDealsByProviderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	provider := strings.TrimSpace(req.Provider)
+	if provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider is required")
+	}
+
+	deals, pageRes, err := k.paginateDealIndex(ctx, k.k.DealsByProvider, provider, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryListDealsByProviderResponse{Deals: deals, Pagination: pageRes}, nil
+}
+
+// paginateDealIndex pages through the deals an (address, deal_id) index
+// holds for address.
+func (k queryServer) paginateDealIndex(
+	ctx context.Context,
+	index collections.KeySet[collections.Pair[string, uint64]],
+	address string,
+	pageReq *query.PageRequest,
+) ([]types.Deal, *query.PageResponse, error) {
+	// A KeySet is a Map without values, which CollectionPaginate can page.
+	deals, pageRes, err := query.CollectionPaginate(
+		ctx,
+		collections.Map[collections.Pair[string, uint64], collections.NoValue](index),
+		pageReq,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.Deal, error) {
+			return k.k.Deals.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](address),
+	)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	return deals, pageRes, nil
+}
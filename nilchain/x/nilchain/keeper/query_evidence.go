@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nilchain/x/nilchain/types"
+)
+
+func (k queryServer) ListEvidenceByProvider(ctx context.Context, req *types.QueryListEvidenceByProviderRequest) (*types.QueryListEvidenceByProviderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	provider := strings.TrimSpace(req.Provider)
+	if provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider is required")
+	}
+
+	// A KeySet is a Map without values, which CollectionPaginate can page.
+	index := collections.Map[collections.Pair[string, uint64], collections.NoValue](k.k.EvidenceByProvider)
+	evidence, pageRes, err := query.CollectionPaginate(
+		ctx,
+		index,
+		req.Pagination,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.Evidence, error) {
+			return k.k.Evidence.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](provider),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListEvidenceByProviderResponse{Evidence: evidence, Pagination: pageRes}, nil
+}
+
+func (k queryServer) ListEvidenceByDeal(ctx context.Context, req *types.QueryListEvidenceByDealRequest) (*types.QueryListEvidenceByDealResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	index := collections.Map[collections.Pair[uint64, uint64], collections.NoValue](k.k.EvidenceByDeal)
+	evidence, pageRes, err := query.CollectionPaginate(
+		ctx,
+		index,
+		req.Pagination,
+		func(key collections.Pair[uint64, uint64], _ collections.NoValue) (types.Evidence, error) {
+			return k.k.Evidence.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, uint64](req.DealId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListEvidenceByDealResponse{Evidence: evidence, Pagination: pageRes}, nil
+}
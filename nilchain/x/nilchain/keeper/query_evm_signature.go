@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nilchain/x/nilchain/types"
+)
+
+func (k queryServer) VerifyEvmSignature(goCtx context.Context, req *types.QueryVerifyEvmSignatureRequest) (*types.QueryVerifyEvmSignatureResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	signer := strings.TrimSpace(req.Signer)
+	if !gethCommon.IsHexAddress(signer) {
+		return nil, status.Error(codes.InvalidArgument, "signer must be an EVM address")
+	}
+	if len(req.Digest) != 32 {
+		return nil, status.Error(codes.InvalidArgument, "digest must be 32 bytes")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signerAddr := gethCommon.HexToAddress(signer)
+	return &types.QueryVerifyEvmSignatureResponse{
+		Valid:    k.k.verifyEvmSignature(ctx, signerAddr, req.Digest, req.Signature),
+		Contract: k.k.isEvmContract(ctx, signerAddr),
+	}, nil
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nilchain/x/nilchain/types"
+)
+
+func (k queryServer) GetProviderBond(goCtx context.Context, req *types.QueryGetProviderBondRequest) (*types.QueryGetProviderBondResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr := strings.TrimSpace(req.Address)
+	if addr == "" {
+		return nil, status.Error(codes.InvalidArgument, "address is required")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params, err := k.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	provider, err := k.k.Providers.Get(ctx, addr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "provider not found")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	unbondings := []types.ProviderUnbonding{}
+	rng := collections.NewPrefixedPairRange[string, uint64](addr)
+	err = k.k.ProviderUnbondings.Walk(ctx, rng, func(key collections.Pair[string, uint64], amount sdk.Coin) (bool, error) {
+		unbondings = append(unbondings, types.ProviderUnbonding{
+			Provider:         key.K1(),
+			CompletionHeight: key.K2(),
+			Amount:           amount,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProviderBondResponse{
+		Bond:         providerBond(params, provider),
+		RequiredBond: RequiredProviderBond(params, provider.TotalStorage),
+		Unbondings:   unbondings,
+	}, nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nilchain/x/nilchain/types"
+)
+
+func (k queryServer) GetProviderStorage(goCtx context.Context, req *types.QueryGetProviderStorageRequest) (*types.QueryGetProviderStorageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr := strings.TrimSpace(req.Address)
+	if addr == "" {
+		return nil, status.Error(codes.InvalidArgument, "address is required")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	provider, err := k.k.Providers.Get(ctx, addr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "provider not found")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetProviderStorageResponse{
+		TotalStorage:     provider.TotalStorage,
+		CommittedStorage: provider.UsedStorage,
+		ReservedStorage:  provider.ReservedStorage,
+		FreeStorage:      ProviderFreeStorage(provider),
+	}, nil
+}
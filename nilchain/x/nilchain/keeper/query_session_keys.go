@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nilchain/x/nilchain/types"
+)
+
+func (k queryServer) ListSessionKeys(ctx context.Context, req *types.QueryListSessionKeysRequest) (*types.QueryListSessionKeysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	owner := strings.TrimSpace(req.Owner)
+	if owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner is required")
+	}
+
+	keys, pageRes, err := query.CollectionPaginate(
+		ctx,
+		collections.Map[collections.Pair[string, string], collections.NoValue](k.k.SessionKeysByOwner),
+		req.Pagination,
+		func(key collections.Pair[string, string], _ collections.NoValue) (types.SessionKey, error) {
+			return k.k.SessionKeys.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](owner),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListSessionKeysResponse{SessionKeys: keys, Pagination: pageRes}, nil
+}
+
+func (k queryServer) GetSessionKey(goCtx context.Context, req *types.QueryGetSessionKeyRequest) (*types.QueryGetSessionKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr := strings.TrimSpace(req.Key)
+	if addr == "" {
+		return nil, status.Error(codes.InvalidArgument, "key is required")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	key, err := k.k.SessionKeys.Get(ctx, addr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "session key not found")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetSessionKeyResponse{
+		SessionKey: key,
+		Active:     sessionKeyActive(key, ctx.BlockHeight()),
+	}, nil
+}
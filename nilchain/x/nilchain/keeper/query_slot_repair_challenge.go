@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nilchain/x/nilchain/types"
+)
+
+func (k queryServer) GetSlotRepairChallenge(goCtx context.Context, req *types.QueryGetSlotRepairChallengeRequest) (*types.QueryGetSlotRepairChallengeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	deal, err := k.k.Deals.Get(ctx, req.DealId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "deal not found")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	slot, err := mode2Slot(&deal, req.Slot)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if slot.Status != types.SlotStatus_SLOT_STATUS_REPAIRING {
+		return nil, status.Error(codes.NotFound, "slot is not repairing")
+	}
+
+	rc, err := k.k.deriveRepairChallenge(ctx, deal, req.Slot, slot)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "epoch seed not recorded yet")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	challenges := rc.challenges
+	if challenges == nil {
+		challenges = []types.ChallengePosition{}
+	}
+	bounty := sdk.NewCoin(sdk.DefaultBondDenom, math.ZeroInt())
+	if hasRepairBounty(slot) {
+		bounty = slot.RepairBounty
+	}
+	return &types.QueryGetSlotRepairChallengeResponse{
+		PendingProvider:  slot.PendingProvider,
+		RepairTargetGen:  slot.RepairTargetGen,
+		EpochId:          rc.epochID,
+		EpochStartHeight: rc.epochStart,
+		DeadlineHeight:   slot.RepairDeadlineHeight,
+		Bounty:           bounty,
+		Challenges:       challenges,
+	}, nil
+}
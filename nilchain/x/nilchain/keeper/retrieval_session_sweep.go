@@ -0,0 +1,227 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nilchain/x/nilchain/types"
+)
+
+// retrievalSessionFinished reports whether a session has reached a terminal
+// state with nothing left locked, making it eligible for pruning.
+func retrievalSessionFinished(session types.RetrievalSession) bool {
+	switch session.Status {
+	case types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_COMPLETED,
+		types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_CANCELED:
+		return true
+	case types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_EXPIRED:
+		return session.LockedFee.IsNil() || !session.LockedFee.IsPositive()
+	}
+	return false
+}
+
+// scheduleRetrievalSessionSweep queues the session's next visit by
+// SweepRetrievalSessions. Finished sessions come back once their retention
+// window ends, unfinished ones at their expiry height, and sessions without an
+// expiry are rechecked every retention window. With retention 0 only
+// expiring sessions are queued.
+func (k Keeper) scheduleRetrievalSessionSweep(ctx context.Context, session types.RetrievalSession, height uint64, retention uint64) error {
+	var at uint64
+	switch {
+	case retrievalSessionFinished(session):
+		if retention == 0 {
+			return nil
+		}
+		at = uint64(session.UpdatedHeight) + retention
+	case session.ExpiresAt != 0:
+		at = session.ExpiresAt
+	default:
+		if retention == 0 {
+			return nil
+		}
+		at = height + retention
+	}
+	if err := k.RetrievalSessionSweepQueue.Set(ctx, collections.Join(at, session.SessionId)); err != nil {
+		return fmt.Errorf("failed to queue retrieval session sweep: %w", err)
+	}
+	return nil
+}
+
+// seedRetrievalSessionSweeps queues every stored session for
+// SweepRetrievalSessions, so sessions opened before the queue existed are
+// still expired and pruned.
+func (k Keeper) seedRetrievalSessionSweeps(ctx context.Context) error {
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	retention := k.GetParams(ctx).RetrievalSessionRetentionBlocks
+	return k.RetrievalSessions.Walk(ctx, nil, func(_ []byte, session types.RetrievalSession) (bool, error) {
+		return false, k.scheduleRetrievalSessionSweep(ctx, session, height, retention)
+	})
+}
+
+// SweepRetrievalSessions expires retrieval sessions whose expiry height has
+// passed and prunes finished sessions older than the retention window, so
+// locked fees no longer wait on a CancelRetrievalSession. Like ExpireDeals it
+// only visits due queue entries.
+func (k Keeper) SweepRetrievalSessions(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+	retention := k.GetParams(ctx).RetrievalSessionRetentionBlocks
+
+	due, err := k.dueRetrievalSessionSweeps(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range due {
+		if err := k.RetrievalSessionSweepQueue.Remove(ctx, entry); err != nil {
+			return err
+		}
+		session, err := k.RetrievalSessions.Get(ctx, entry.K2())
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+
+		if retrievalSessionFinished(session) {
+			if retention != 0 && uint64(session.UpdatedHeight)+retention < height {
+				if err := k.pruneRetrievalSession(ctx, session); err != nil {
+					return err
+				}
+				continue
+			}
+		} else if isSessionExpired(sdkCtx, &session) {
+			if err := k.expireRetrievalSession(sdkCtx, &session); err != nil {
+				return err
+			}
+		}
+		if err := k.scheduleRetrievalSessionSweep(ctx, session, height, retention); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dueRetrievalSessionSweeps returns the queue entries scheduled below height.
+func (k Keeper) dueRetrievalSessionSweeps(ctx context.Context, height uint64) ([]collections.Pair[uint64, []byte], error) {
+	iter, err := k.RetrievalSessionSweepQueue.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var due []collections.Pair[uint64, []byte]
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		// A session is usable through its expiry height (see isSessionExpired).
+		if key.K1() >= height {
+			break
+		}
+		due = append(due, key)
+	}
+	return due, nil
+}
+
+// expireRetrievalSession moves an unfinished, expired session to EXPIRED.
+// A session with a submitted proof is paid out as settleRetrievalSession
+// would on confirmation; an open session is reported as provider
+// non-response; any other locked fee returns to the deal escrow.
+func (k Keeper) expireRetrievalSession(ctx sdk.Context, session *types.RetrievalSession) error {
+	switch session.Status {
+	case types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_PROOF_SUBMITTED:
+		if err := k.settleRetrievalSession(ctx, session); err != nil {
+			return err
+		}
+	case types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_OPEN:
+		k.recordRetrievalNonResponse(ctx, session, types.ModuleName)
+		fallthrough
+	default:
+		if err := k.refundRetrievalSessionFee(ctx, session); err != nil {
+			return err
+		}
+	}
+
+	previous := session.Status
+	session.Status = types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_EXPIRED
+	session.UpdatedHeight = ctx.BlockHeight()
+	if err := k.RetrievalSessions.Set(ctx, session.SessionId, *session); err != nil {
+		return fmt.Errorf("failed to expire retrieval session: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeRetrievalSessionExpired,
+			sdk.NewAttribute(types.AttributeKeySessionID, hex.EncodeToString(session.SessionId)),
+			sdk.NewAttribute(types.AttributeKeyDealID, fmt.Sprintf("%d", session.DealId)),
+			sdk.NewAttribute(types.AttributeKeyProvider, session.Provider),
+			sdk.NewAttribute(types.AttributeKeyPreviousStatus, previous.String()),
+		),
+	)
+	return nil
+}
+
+// recordRetrievalNonResponse records evidence that the session's provider
+// never answered, which degrades its health. Failures are logged, not
+// returned.
+func (k Keeper) recordRetrievalNonResponse(ctx sdk.Context, session *types.RetrievalSession, reporter string) {
+	if _, err := k.recordEvidence(ctx, types.Evidence{
+		Kind:      types.EvidenceKind_EVIDENCE_KIND_RETRIEVAL_NON_RESPONSE,
+		DealId:    session.DealId,
+		Provider:  session.Provider,
+		SessionId: session.SessionId,
+		Reporter:  reporter,
+	}); err != nil {
+		ctx.Logger().Error("failed to record non-response evidence", "error", err, "deal", session.DealId, "provider", session.Provider)
+	}
+	if err := k.IncrementHeat(ctx, session.DealId, 0, true); err != nil {
+		ctx.Logger().Error("failed to increment heat for non-response evidence", "error", err, "deal", session.DealId, "provider", session.Provider)
+	}
+}
+
+// refundRetrievalSessionFee returns a session's locked fee to its deal's
+// escrow.
+func (k Keeper) refundRetrievalSessionFee(ctx sdk.Context, session *types.RetrievalSession) error {
+	if session.LockedFee.IsNil() || !session.LockedFee.IsPositive() {
+		return nil
+	}
+	deal, err := k.Deals.Get(ctx, session.DealId)
+	if err != nil {
+		return sdkerrors.ErrNotFound.Wrapf("deal %d not found", session.DealId)
+	}
+	deal.EscrowBalance = deal.EscrowBalance.Add(session.LockedFee)
+	if err := k.Deals.Set(ctx, session.DealId, deal); err != nil {
+		return fmt.Errorf("failed to refund locked retrieval fees: %w", err)
+	}
+	session.LockedFee = math.ZeroInt()
+	return nil
+}
+
+// pruneRetrievalSession deletes a finished session and its index entries.
+// The per-provider session nonce is kept so the session id cannot be reused.
+func (k Keeper) pruneRetrievalSession(ctx context.Context, session types.RetrievalSession) error {
+	id := session.SessionId
+	if err := k.RetrievalSessionsByOwner.Remove(ctx, collections.Join(session.Owner, id)); err != nil {
+		return fmt.Errorf("failed to unindex retrieval session by owner: %w", err)
+	}
+	if err := k.RetrievalSessionsByProvider.Remove(ctx, collections.Join(session.Provider, id)); err != nil {
+		return fmt.Errorf("failed to unindex retrieval session by provider: %w", err)
+	}
+	if err := k.RetrievalSessionsByDeal.Remove(ctx, collections.Join(session.DealId, id)); err != nil {
+		return fmt.Errorf("failed to unindex retrieval session by deal: %w", err)
+	}
+	if err := k.RetrievalSessions.Remove(ctx, id); err != nil {
+		return fmt.Errorf("failed to prune retrieval session: %w", err)
+	}
+	return nil
+}
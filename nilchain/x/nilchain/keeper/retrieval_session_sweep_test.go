@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+// prepareRetrievalDeal gives a deal from setupExpiringDeal committed content
+// and a first provider whose address fits in a session id, so that
+// OpenRetrievalSession accepts it.
+func prepareRetrievalDeal(t *testing.T, f *fixture, ctx sdk.Context, deal *types.Deal) {
+	t.Helper()
+	deal.ManifestRoot = make([]byte, 48)
+	for i := range deal.ManifestRoot {
+		deal.ManifestRoot[i] = byte(i + 1)
+	}
+	deal.Providers[0] = sdk.AccAddress([]byte("session_provider____")).String()
+	require.NoError(t, f.keeper.Deals.Set(ctx, deal.Id, *deal))
+}
+
+func TestSweepRetrievalSessions(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+
+	owner := sdk.AccAddress([]byte("sweep_owner_________")).String()
+	ctx, deal := setupExpiringDeal(t, bank, f, sdk.MustAccAddressFromBech32(owner), 40, 1000)
+	prepareRetrievalDeal(t, f, ctx, &deal)
+	provider := deal.Providers[0]
+
+	params := types.DefaultParams()
+	params.BaseRetrievalFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)
+	params.RetrievalPricePerBlob = sdk.NewInt64Coin(sdk.DefaultBondDenom, 3)
+	params.RetrievalBurnBps = 0
+	params.RetrievalSessionRetentionBlocks = 5
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	open := func(nonce uint64, expiresAt uint64) []byte {
+		res, err := msgServer.OpenRetrievalSession(ctx, &types.MsgOpenRetrievalSession{
+			Creator: owner, DealId: deal.Id, Provider: provider, ManifestRoot: deal.ManifestRoot,
+			BlobCount: 1, Nonce: nonce, ExpiresAt: expiresAt,
+		})
+		require.NoError(t, err)
+		return res.SessionId
+	}
+	unanswered := open(1, 12)
+	proven := open(2, 12)
+	unbounded := open(3, 0)
+
+	session, err := f.keeper.RetrievalSessions.Get(ctx, proven)
+	require.NoError(t, err)
+	session.Status = types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_PROOF_SUBMITTED
+	require.NoError(t, f.keeper.RetrievalSessions.Set(ctx, proven, session))
+
+	sweep := func(height int64) sdk.Context {
+		sweepCtx := ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, f.keeper.SweepRetrievalSessions(sweepCtx))
+		return sweepCtx
+	}
+	status := func(id []byte) types.RetrievalSessionStatus {
+		session, err := f.keeper.RetrievalSessions.Get(ctx, id)
+		require.NoError(t, err)
+		return session.Status
+	}
+
+	// Sessions are usable through their expiry height.
+	sweep(12)
+	require.Equal(t, types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_OPEN, status(unanswered))
+
+	sweepCtx := sweep(13)
+	require.Equal(t, types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_EXPIRED, status(unanswered))
+	require.Equal(t, types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_EXPIRED, status(proven))
+	require.Equal(t, types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_OPEN, status(unbounded))
+
+	// The unanswered session's fee went back to escrow and was reported as
+	// non-response; the proven one paid the provider and the unbounded one
+	// still holds its fee.
+	stored, err := f.keeper.Deals.Get(ctx, deal.Id)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1000-3-3), stored.EscrowBalance)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3)).String(), bank.accountBalances[provider].String())
+	failures, err := f.keeper.DealProviderFailures.Get(ctx, collections.Join(deal.Id, provider))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), failures)
+	expired := 0
+	for _, ev := range sweepCtx.EventManager().Events() {
+		if ev.Type == types.TypeRetrievalSessionExpired {
+			expired++
+		}
+	}
+	require.Equal(t, 2, expired)
+
+	// Finished sessions are pruned once the retention window has passed.
+	sweep(18)
+	require.Equal(t, types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_EXPIRED, status(unanswered))
+	sweep(19)
+	for _, id := range [][]byte{unanswered, proven} {
+		has, err := f.keeper.RetrievalSessions.Has(ctx, id)
+		require.NoError(t, err)
+		require.False(t, has)
+		has, err = f.keeper.RetrievalSessionsByOwner.Has(ctx, collections.Join(owner, id))
+		require.NoError(t, err)
+		require.False(t, has)
+		has, err = f.keeper.RetrievalSessionsByProvider.Has(ctx, collections.Join(provider, id))
+		require.NoError(t, err)
+		require.False(t, has)
+		has, err = f.keeper.RetrievalSessionsByDeal.Has(ctx, collections.Join(deal.Id, id))
+		require.NoError(t, err)
+		require.False(t, has)
+	}
+	require.Equal(t, types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_OPEN, status(unbounded))
+
+	// Nonces survive pruning, so a pruned session id cannot be reopened.
+	_, err = msgServer.OpenRetrievalSession(ctx, &types.MsgOpenRetrievalSession{
+		Creator: owner, DealId: deal.Id, Provider: provider, ManifestRoot: deal.ManifestRoot,
+		BlobCount: 1, Nonce: 1, ExpiresAt: 12,
+	})
+	require.ErrorContains(t, err, "nonce replay")
+}
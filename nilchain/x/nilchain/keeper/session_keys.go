@@ -0,0 +1,288 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nilchain/x/nilchain/types"
+)
+
+// RegisterSessionKey handles MsgRegisterSessionKey.
+func (k msgServer) RegisterSessionKey(goCtx context.Context, msg *types.MsgRegisterSessionKey) (*types.MsgRegisterSessionKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	keyAddr, err := sdk.AccAddressFromBech32(msg.SessionKey)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid session key address: %s", err)
+	}
+	if keyAddr.String() == msg.Creator {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("an owner cannot be its own session key")
+	}
+
+	if len(msg.DealIds) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("at least one deal_id is required")
+	}
+	if len(msg.DealIds) > types.MaxSessionKeyDeals {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("a session key can be bound to at most %d deals", types.MaxSessionKeyDeals)
+	}
+	seen := make(map[uint64]struct{}, len(msg.DealIds))
+	for _, dealID := range msg.DealIds {
+		if _, ok := seen[dealID]; ok {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("duplicate deal_id %d", dealID)
+		}
+		seen[dealID] = struct{}{}
+
+		deal, err := k.Deals.Get(ctx, dealID)
+		if err != nil {
+			return nil, sdkerrors.ErrNotFound.Wrapf("deal %d not found", dealID)
+		}
+		if err := checkDealActive(deal); err != nil {
+			return nil, err
+		}
+		if deal.Owner != msg.Creator {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("only deal owner %s can register session keys for deal %d", deal.Owner, dealID)
+		}
+	}
+
+	if msg.ExpiresAt <= uint64(ctx.BlockHeight()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("expires_at must be a future block height")
+	}
+	maxFee := msg.MaxFee
+	if maxFee.IsNil() {
+		maxFee = math.ZeroInt()
+	}
+	if maxFee.IsNegative() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("max_fee must not be negative")
+	}
+
+	existing, err := k.SessionKeys.Get(ctx, keyAddr.String())
+	switch {
+	case err == nil:
+		if existing.Owner != msg.Creator {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("session key %s is registered to another owner", existing.Key)
+		}
+		if err := k.removeSessionKey(ctx, existing); err != nil {
+			return nil, err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, fmt.Errorf("failed to load session key: %w", err)
+	}
+
+	key := types.SessionKey{
+		Key:              keyAddr.String(),
+		Owner:            msg.Creator,
+		DealIds:          msg.DealIds,
+		ExpiresAt:        msg.ExpiresAt,
+		MaxBytes:         msg.MaxBytes,
+		MaxFee:           maxFee,
+		FeesUsed:         math.ZeroInt(),
+		RegisteredHeight: ctx.BlockHeight(),
+	}
+	if err := k.setSessionKey(ctx, key); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeSessionKeyRegistered,
+			sdk.NewAttribute(types.AttributeKeySessionKey, key.Key),
+			sdk.NewAttribute(types.AttributeKeyOwner, key.Owner),
+			sdk.NewAttribute(types.AttributeKeyDealIDs, fmt.Sprintf("%v", key.DealIds)),
+			sdk.NewAttribute(types.AttributeKeyExpiresAt, fmt.Sprintf("%d", key.ExpiresAt)),
+			sdk.NewAttribute(types.AttributeKeyMaxBytes, fmt.Sprintf("%d", key.MaxBytes)),
+			sdk.NewAttribute(types.AttributeKeyMaxFee, key.MaxFee.String()),
+		),
+	)
+
+	return &types.MsgRegisterSessionKeyResponse{}, nil
+}
+
+// RevokeSessionKey handles MsgRevokeSessionKey. Either the owner or the key
+// itself may remove it.
+func (k msgServer) RevokeSessionKey(goCtx context.Context, msg *types.MsgRevokeSessionKey) (*types.MsgRevokeSessionKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	key, err := k.SessionKeys.Get(ctx, msg.SessionKey)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("session key %s not found", msg.SessionKey)
+		}
+		return nil, fmt.Errorf("failed to load session key: %w", err)
+	}
+	if msg.Creator != key.Owner && msg.Creator != key.Key {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("only the owner or the session key itself can revoke it")
+	}
+	if err := k.removeSessionKey(ctx, key); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeSessionKeyRevoked,
+			sdk.NewAttribute(types.AttributeKeySessionKey, key.Key),
+			sdk.NewAttribute(types.AttributeKeyOwner, key.Owner),
+		),
+	)
+
+	return &types.MsgRevokeSessionKeyResponse{}, nil
+}
+
+// setSessionKey stores a session key together with its owner index and
+// expiry queue entries.
+func (k Keeper) setSessionKey(ctx context.Context, key types.SessionKey) error {
+	if err := k.SessionKeys.Set(ctx, key.Key, key); err != nil {
+		return fmt.Errorf("failed to store session key: %w", err)
+	}
+	if err := k.SessionKeysByOwner.Set(ctx, collections.Join(key.Owner, key.Key)); err != nil {
+		return fmt.Errorf("failed to index session key by owner: %w", err)
+	}
+	if err := k.SessionKeyExpiryQueue.Set(ctx, collections.Join(key.ExpiresAt, key.Key)); err != nil {
+		return fmt.Errorf("failed to queue session key expiry: %w", err)
+	}
+	return nil
+}
+
+// removeSessionKey deletes a session key and its index entries.
+func (k Keeper) removeSessionKey(ctx context.Context, key types.SessionKey) error {
+	if err := k.SessionKeys.Remove(ctx, key.Key); err != nil {
+		return fmt.Errorf("failed to remove session key: %w", err)
+	}
+	if err := k.SessionKeysByOwner.Remove(ctx, collections.Join(key.Owner, key.Key)); err != nil {
+		return fmt.Errorf("failed to unindex session key by owner: %w", err)
+	}
+	if err := k.SessionKeyExpiryQueue.Remove(ctx, collections.Join(key.ExpiresAt, key.Key)); err != nil {
+		return fmt.Errorf("failed to dequeue session key expiry: %w", err)
+	}
+	return nil
+}
+
+// sessionKeyActive reports whether a session key can still be used at
+// height: it has not expired and neither its byte nor its fee limit is
+// exhausted. It does not check that the owner still owns the key's deals.
+func sessionKeyActive(key types.SessionKey, height int64) bool {
+	if uint64(height) > key.ExpiresAt {
+		return false
+	}
+	if key.MaxBytes != 0 && key.BytesUsed >= key.MaxBytes {
+		return false
+	}
+	if !key.MaxFee.IsNil() && key.MaxFee.IsPositive() && !key.FeesUsed.IsNil() && key.FeesUsed.GTE(key.MaxFee) {
+		return false
+	}
+	return true
+}
+
+// dealSessionKey checks that addr is a session key the deal's current owner
+// registered for the deal, and that it has neither expired nor used up its
+// byte or fee limit.
+func (k Keeper) dealSessionKey(ctx sdk.Context, deal types.Deal, addr string) (*types.SessionKey, error) {
+	key, err := k.SessionKeys.Get(ctx, addr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not a session key", addr)
+		}
+		return nil, fmt.Errorf("failed to load session key: %w", err)
+	}
+	if key.Owner != deal.Owner || !slices.Contains(key.DealIds, deal.Id) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("session key %s is not registered for deal %d by its owner", addr, deal.Id)
+	}
+	if uint64(ctx.BlockHeight()) > key.ExpiresAt {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("session key %s has expired", addr)
+	}
+	if key.MaxBytes != 0 && key.BytesUsed >= key.MaxBytes {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("session key %s has used up its byte limit", addr)
+	}
+	if !key.MaxFee.IsNil() && key.MaxFee.IsPositive() && !key.FeesUsed.IsNil() && key.FeesUsed.GTE(key.MaxFee) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("session key %s has used up its fee limit", addr)
+	}
+	return &key, nil
+}
+
+// chargeSessionKey records bytes signed for under a session key and the
+// bandwidth they pay from escrow, failing if either would exceed the key's
+// limits.
+func (k Keeper) chargeSessionKey(ctx sdk.Context, key *types.SessionKey, bytes uint64) error {
+	usedBytes, overflow := addUint64(key.BytesUsed, bytes)
+	if overflow || (key.MaxBytes != 0 && usedBytes > key.MaxBytes) {
+		return sdkerrors.ErrUnauthorized.Wrapf("session key %s exceeds its byte limit", key.Key)
+	}
+	usedFees := key.FeesUsed
+	if usedFees.IsNil() {
+		usedFees = math.ZeroInt()
+	}
+	usedFees = usedFees.Add(bandwidthPaymentForBytes(bytes))
+	if !key.MaxFee.IsNil() && key.MaxFee.IsPositive() && usedFees.GT(key.MaxFee) {
+		return sdkerrors.ErrUnauthorized.Wrapf("session key %s exceeds its fee limit", key.Key)
+	}
+
+	key.BytesUsed = usedBytes
+	key.FeesUsed = usedFees
+	if err := k.SessionKeys.Set(ctx, key.Key, *key); err != nil {
+		return fmt.Errorf("failed to update session key: %w", err)
+	}
+	return nil
+}
+
+// ExpireSessionKeys removes every session key whose expiry height is below
+// the current height. Like ExpireDeals it only visits due queue entries.
+func (k Keeper) ExpireSessionKeys(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+
+	var due []collections.Pair[uint64, string]
+	iter, err := k.SessionKeyExpiryQueue.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for ; iter.Valid(); iter.Next() {
+		entry, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		// A key is usable through its expiry height.
+		if entry.K1() >= height {
+			break
+		}
+		due = append(due, entry)
+	}
+	iter.Close()
+
+	for _, entry := range due {
+		key, err := k.SessionKeys.Get(ctx, entry.K2())
+		if err != nil {
+			if !errors.Is(err, collections.ErrNotFound) {
+				return err
+			}
+			if err := k.SessionKeyExpiryQueue.Remove(ctx, entry); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := k.removeSessionKey(ctx, key); err != nil {
+			return err
+		}
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.TypeSessionKeyExpired,
+				sdk.NewAttribute(types.AttributeKeySessionKey, key.Key),
+				sdk.NewAttribute(types.AttributeKeyOwner, key.Owner),
+			),
+		)
+	}
+	return nil
+}
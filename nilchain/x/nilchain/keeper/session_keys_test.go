@@ -0,0 +1,192 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+func TestSessionKey(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+
+	owner := sdk.AccAddress([]byte("session_owner_______")).String()
+	stranger := sdk.AccAddress([]byte("session_stranger____")).String()
+	sessionKey := sdk.AccAddress([]byte("session_key_________")).String()
+	ctx, deal := setupExpiringDeal(t, bank, f, sdk.MustAccAddressFromBech32(owner), 40, 1000)
+
+	register := func(creator string, dealIDs []uint64, expiresAt uint64) error {
+		_, err := msgServer.RegisterSessionKey(ctx, &types.MsgRegisterSessionKey{
+			Creator: creator, SessionKey: sessionKey, DealIds: dealIDs, ExpiresAt: expiresAt,
+			MaxBytes: 1 << 20, MaxFee: math.NewInt(50),
+		})
+		return err
+	}
+
+	require.ErrorContains(t, register(stranger, []uint64{deal.Id}, 20), "only deal owner")
+	require.ErrorContains(t, register(owner, nil, 20), "at least one deal_id")
+	require.ErrorContains(t, register(owner, []uint64{deal.Id, deal.Id}, 20), "duplicate deal_id")
+	require.ErrorContains(t, register(owner, []uint64{deal.Id}, 10), "future block height")
+	require.ErrorContains(t, register(owner, []uint64{deal.Id + 100}, 20), "not found")
+	_, err := msgServer.RegisterSessionKey(ctx, &types.MsgRegisterSessionKey{
+		Creator: owner, SessionKey: owner, DealIds: []uint64{deal.Id}, ExpiresAt: 20,
+	})
+	require.ErrorContains(t, err, "its own session key")
+
+	require.NoError(t, register(owner, []uint64{deal.Id}, 20))
+
+	res, err := queryServer.GetSessionKey(ctx, &types.QueryGetSessionKeyRequest{Key: sessionKey})
+	require.NoError(t, err)
+	require.True(t, res.Active)
+	require.Equal(t, owner, res.SessionKey.Owner)
+	require.Equal(t, []uint64{deal.Id}, res.SessionKey.DealIds)
+	require.Equal(t, math.ZeroInt(), res.SessionKey.FeesUsed)
+
+	list, err := queryServer.ListSessionKeys(ctx, &types.QueryListSessionKeysRequest{Owner: owner})
+	require.NoError(t, err)
+	require.Len(t, list.SessionKeys, 1)
+	list, err = queryServer.ListSessionKeys(ctx, &types.QueryListSessionKeysRequest{Owner: stranger})
+	require.NoError(t, err)
+	require.Empty(t, list.SessionKeys)
+
+	// A key belongs to one owner; only that owner can re-register it.
+	strangerDeal := deal
+	strangerDeal.Id = deal.Id + 1
+	strangerDeal.Owner = stranger
+	require.NoError(t, f.keeper.Deals.Set(ctx, strangerDeal.Id, strangerDeal))
+	require.ErrorContains(t, register(stranger, []uint64{strangerDeal.Id}, 30), "another owner")
+	require.NoError(t, register(owner, []uint64{deal.Id}, 30))
+	res, err = queryServer.GetSessionKey(ctx, &types.QueryGetSessionKeyRequest{Key: sessionKey})
+	require.NoError(t, err)
+	require.Equal(t, uint64(30), res.SessionKey.ExpiresAt)
+
+	// A key that has used up its byte limit is no longer active.
+	key, err := f.keeper.SessionKeys.Get(ctx, sessionKey)
+	require.NoError(t, err)
+	key.BytesUsed = key.MaxBytes
+	require.NoError(t, f.keeper.SessionKeys.Set(ctx, sessionKey, key))
+	res, err = queryServer.GetSessionKey(ctx, &types.QueryGetSessionKeyRequest{Key: sessionKey})
+	require.NoError(t, err)
+	require.False(t, res.Active)
+
+	_, err = msgServer.RevokeSessionKey(ctx, &types.MsgRevokeSessionKey{Creator: stranger, SessionKey: sessionKey})
+	require.ErrorContains(t, err, "only the owner or the session key")
+	_, err = msgServer.RevokeSessionKey(ctx, &types.MsgRevokeSessionKey{Creator: sessionKey, SessionKey: sessionKey})
+	require.NoError(t, err)
+	_, err = msgServer.RevokeSessionKey(ctx, &types.MsgRevokeSessionKey{Creator: owner, SessionKey: sessionKey})
+	require.ErrorContains(t, err, "not found")
+
+	_, err = queryServer.GetSessionKey(ctx, &types.QueryGetSessionKeyRequest{Key: sessionKey})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	list, err = queryServer.ListSessionKeys(ctx, &types.QueryListSessionKeysRequest{Owner: owner})
+	require.NoError(t, err)
+	require.Empty(t, list.SessionKeys)
+}
+
+func TestExpireSessionKeys(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+
+	owner := sdk.AccAddress([]byte("session_exp_owner___")).String()
+	shortKey := sdk.AccAddress([]byte("session_exp_short___")).String()
+	longKey := sdk.AccAddress([]byte("session_exp_long____")).String()
+	ctx, deal := setupExpiringDeal(t, bank, f, sdk.MustAccAddressFromBech32(owner), 40, 1000)
+
+	for key, expiresAt := range map[string]uint64{shortKey: 15, longKey: 25} {
+		_, err := msgServer.RegisterSessionKey(ctx, &types.MsgRegisterSessionKey{
+			Creator: owner, SessionKey: key, DealIds: []uint64{deal.Id}, ExpiresAt: expiresAt,
+		})
+		require.NoError(t, err)
+	}
+
+	// A key is usable through its expiry height.
+	require.NoError(t, f.keeper.ExpireSessionKeys(ctx.WithBlockHeight(15)))
+	has, err := f.keeper.SessionKeys.Has(ctx, shortKey)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	require.NoError(t, f.keeper.ExpireSessionKeys(ctx.WithBlockHeight(16)))
+	has, err = f.keeper.SessionKeys.Has(ctx, shortKey)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.SessionKeys.Has(ctx, longKey)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	genesis, err := f.keeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Len(t, genesis.SessionKeys, 1)
+	require.Equal(t, longKey, genesis.SessionKeys[0].Key)
+}
+
+func TestSessionKeyLimitsCheckedBeforeUse(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+
+	owner := sdk.AccAddress([]byte("session_limit_owner_")).String()
+	ctx, deal := setupExpiringDeal(t, bank, f, sdk.MustAccAddressFromBech32(owner), 40, 1000)
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	privKey, err := gethCrypto.GenerateKey()
+	require.NoError(t, err)
+	sessionKey := sdk.AccAddress(gethCrypto.PubkeyToAddress(privKey.PublicKey).Bytes()).String()
+	_, err = msgServer.RegisterSessionKey(ctx, &types.MsgRegisterSessionKey{
+		Creator: owner, SessionKey: sessionKey, DealIds: []uint64{deal.Id}, ExpiresAt: 30,
+		MaxBytes: 1 << 20, MaxFee: math.NewInt(50),
+	})
+	require.NoError(t, err)
+
+	prove := func(nonce uint64) error {
+		receipt := types.DownloadSessionReceipt{
+			DealId:        deal.Id,
+			EpochId:       1,
+			Provider:      deal.Providers[0],
+			FilePath:      "file.txt",
+			TotalBytes:    1024,
+			ChunkCount:    1,
+			ChunkLeafRoot: make([]byte, 32),
+			Nonce:         nonce,
+		}
+		receipt.UserSignature = signDownloadSessionReceipt(t, &receipt, params.Eip712ChainId, privKey)
+		_, err := msgServer.ProveLiveness(ctx, &types.MsgProveLiveness{
+			Creator: deal.Providers[0],
+			DealId:  deal.Id,
+			EpochId: 1,
+			ProofType: &types.MsgProveLiveness_SessionProof{
+				SessionProof: &types.RetrievalSessionProof{SessionReceipt: receipt},
+			},
+		})
+		return err
+	}
+
+	// A key whose fee or byte limit is used up is rejected before it is
+	// charged, whatever the receipt costs.
+	key, err := f.keeper.SessionKeys.Get(ctx, sessionKey)
+	require.NoError(t, err)
+	key.FeesUsed = key.MaxFee
+	require.NoError(t, f.keeper.SessionKeys.Set(ctx, sessionKey, key))
+	require.ErrorContains(t, prove(1), "used up its fee limit")
+
+	key.FeesUsed = math.ZeroInt()
+	key.BytesUsed = key.MaxBytes
+	require.NoError(t, f.keeper.SessionKeys.Set(ctx, sessionKey, key))
+	require.ErrorContains(t, prove(1), "used up its byte limit")
+
+	after, err := f.keeper.SessionKeys.Get(ctx, sessionKey)
+	require.NoError(t, err)
+	require.Equal(t, key.BytesUsed, after.BytesUsed)
+	require.True(t, after.FeesUsed.IsZero())
+}
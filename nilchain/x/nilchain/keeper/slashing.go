@@ -2,73 +2,61 @@ package keeper
 
 import (
 	"context"
-	"errors"
 
 	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"nilchain/x/nilchain/types"
 )
 
-// CheckMissedProofs iterates over all deals and slashes providers who have missed their proof window.
+// CheckMissedProofs slashes the bond of providers who have missed their proof
+// window. Only entries of the ProofDeadlines queue that are due at the current height
+// are visited, so the per-block cost does not grow with the number of deals.
 func (k Keeper) CheckMissedProofs(ctx context.Context) error {
-	// Iterate over all active deals
-	// Note: In production, this should be optimized (e.g., iterate over a queue sorted by NextProofHeight)
-	err := k.Deals.Walk(ctx, nil, func(dealID uint64, deal types.Deal) (stop bool, err error) {
-		// Only check active deals
-		currentHeight := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
-		if currentHeight < deal.StartBlock || currentHeight > deal.EndBlock {
-			return false, nil
-		}
-
-		for _, providerAddr := range deal.Providers {
-			// Get LastProofHeight
-			lastProof, err := k.DealProviderStatus.Get(ctx, collections.Join(dealID, providerAddr))
-			if err != nil {
-				if !errors.Is(err, collections.ErrNotFound) {
-					// If error is real error, return it? Or log and continue?
-                    // For safety, we log and assume start block.
-                    sdk.UnwrapSDKContext(ctx).Logger().Error("Failed to get last proof height", "error", err)
-				}
-				lastProof = deal.StartBlock
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentHeight := uint64(sdkCtx.BlockHeight())
+
+	due, err := k.dueProofDeadlines(ctx, currentHeight)
+	if err != nil {
+		return err
+	}
+	if len(due) == 0 {
+		return nil
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range due {
+		dealID, providerAddr := entry.K2(), entry.K3()
+
+		deal, err := k.Deals.Get(ctx, dealID)
+		if err != nil || currentHeight > deal.EndBlock || !containsString(deal.Providers, providerAddr) {
+			// Deal is gone, expired, or the provider was rotated out: stop tracking.
+			if err := k.RemoveProofDeadline(ctx, dealID, providerAddr); err != nil {
+				return err
 			}
+			continue
+		}
 
-			// Check if overdue
-			if currentHeight > lastProof + types.ProofWindow {
-				// SLASHDOWN!
-				sdkCtx := sdk.UnwrapSDKContext(ctx)
-				sdkCtx.Logger().Info("Slashing provider for downtime", "provider", providerAddr, "deal", dealID, "last_proof", lastProof, "current", currentHeight)
-
-				// Slash Amount: 10 stake (placeholder)
-				slashAmt := sdk.NewCoins(sdk.NewInt64Coin("stake", 10000000))
-				
-				pAddr, err := sdk.AccAddressFromBech32(providerAddr)
-				if err != nil {
-					sdkCtx.Logger().Error("Invalid provider address during slash", "address", providerAddr)
-					continue
-				}
-
-				// Attempt slash
-				err = k.BankKeeper.SendCoinsFromAccountToModule(sdkCtx, pAddr, types.ModuleName, slashAmt)
-				if err != nil {
-					// Insufficient funds -> Jail?
-					// For now just log
-					sdkCtx.Logger().Info("Slashing failed (insufficient funds)", "provider", providerAddr)
-				} else {
-					// Burn
-					if err := k.BankKeeper.BurnCoins(sdkCtx, types.ModuleName, slashAmt); err != nil {
-						sdkCtx.Logger().Error("Failed to burn slashed coins", "error", err)
-					}
-				}
+		// SLASHDOWN!
+		sdkCtx.Logger().Info("Slashing provider for downtime", "provider", providerAddr, "deal", dealID, "deadline", entry.K1(), "current", currentHeight)
+		if err := k.SlashProviderBond(ctx, providerAddr, params.SlashMissedProofBps, "missed_proof"); err != nil {
+			return err
+		}
 
-				// Update LastProofHeight to CurrentHeight to give them a new window
-				// and prevent slashing every block for the same incident.
-				if err := k.DealProviderStatus.Set(ctx, collections.Join(dealID, providerAddr), currentHeight); err != nil {
-					sdkCtx.Logger().Error("Failed to update proof status after slash", "error", err)
-				}
-			}
+		// Update LastProofHeight to CurrentHeight to give them a new window
+		// and prevent slashing every block for the same incident.
+		if err := k.DealProviderStatus.Set(ctx, collections.Join(dealID, providerAddr), currentHeight); err != nil {
+			sdkCtx.Logger().Error("Failed to update proof status after slash", "error", err)
+		}
+		if err := k.SetProofDeadline(ctx, dealID, providerAddr, NextProofDeadline(currentHeight)); err != nil {
+			return err
+		}
+		// Repeated misses hand a Mode 2 slot to a replacement.
+		if err := k.recordMissedProof(sdkCtx, params, dealID, providerAddr); err != nil {
+			return err
 		}
-		return false, nil
-	})
+	}
 
-	return err
+	return nil
 }
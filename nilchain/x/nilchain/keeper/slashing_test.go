@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+func TestCheckMissedProofs_DeadlineQueue(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(100)
+
+	providerA := sdk.AccAddress([]byte("provider_a__________")).String()
+	providerB := sdk.AccAddress([]byte("provider_b__________")).String()
+
+	deal := types.Deal{
+		Id:               1,
+		Owner:            providerA,
+		EscrowBalance:    math.ZeroInt(),
+		MaxMonthlySpend:  math.ZeroInt(),
+		SpendWindowSpent: math.ZeroInt(),
+		StartBlock:       100,
+		EndBlock:         1000,
+		Providers:        []string{providerA, providerB},
+	}
+	require.NoError(t, f.keeper.Deals.Set(ctx, deal.Id, deal))
+	bond := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+	for _, addr := range []string{providerA, providerB} {
+		require.NoError(t, f.keeper.Providers.Set(ctx, addr, types.Provider{Address: addr, Status: "Active", Bond: bond}))
+	}
+	require.NoError(t, f.keeper.SetProofDeadline(ctx, deal.Id, providerA, keeper.NextProofDeadline(100)))
+	require.NoError(t, f.keeper.SetProofDeadline(ctx, deal.Id, providerB, keeper.NextProofDeadline(100)))
+
+	// providerB proves in time, pushing its deadline out.
+	require.NoError(t, f.keeper.SetProofDeadline(ctx, deal.Id, providerB, keeper.NextProofDeadline(105)))
+
+	deadlineOf := func(provider string) uint64 {
+		d, err := f.keeper.ProofDeadlinesByDealProvider.Get(ctx, collections.Join(deal.Id, provider))
+		require.NoError(t, err)
+		has, err := f.keeper.ProofDeadlines.Has(ctx, collections.Join3(d, deal.Id, provider))
+		require.NoError(t, err)
+		require.True(t, has, "queue and reverse index must agree")
+		return d
+	}
+
+	// Not yet due: nothing changes.
+	require.NoError(t, f.keeper.CheckMissedProofs(ctx.WithBlockHeight(110)))
+	require.Equal(t, keeper.NextProofDeadline(100), deadlineOf(providerA))
+
+	// providerA is due at 111 and gets rescheduled; providerB is untouched.
+	require.NoError(t, f.keeper.CheckMissedProofs(ctx.WithBlockHeight(111)))
+	require.Equal(t, keeper.NextProofDeadline(111), deadlineOf(providerA))
+	require.Equal(t, keeper.NextProofDeadline(105), deadlineOf(providerB))
+	last, err := f.keeper.DealProviderStatus.Get(ctx, collections.Join(deal.Id, providerA))
+	require.NoError(t, err)
+	require.Equal(t, uint64(111), last)
+
+	// Only providerA's bond is slashed, by slash_missed_proof_bps.
+	params := types.DefaultParams()
+	pA, err := f.keeper.Providers.Get(ctx, providerA)
+	require.NoError(t, err)
+	require.Equal(t, bond.Amount.Sub(bond.Amount.MulRaw(int64(params.SlashMissedProofBps)).QuoRaw(10000)), pA.Bond.Amount)
+	pB, err := f.keeper.Providers.Get(ctx, providerB)
+	require.NoError(t, err)
+	require.Equal(t, bond, pB.Bond)
+
+	// Rotating providerB out of the deal drops its entry once it falls due.
+	deal.Providers = []string{providerA}
+	require.NoError(t, f.keeper.Deals.Set(ctx, deal.Id, deal))
+	require.NoError(t, f.keeper.CheckMissedProofs(ctx.WithBlockHeight(116)))
+	has, err := f.keeper.ProofDeadlinesByDealProvider.Has(ctx, collections.Join(deal.Id, providerB))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// Past EndBlock the deal is no longer tracked at all.
+	require.NoError(t, f.keeper.CheckMissedProofs(ctx.WithBlockHeight(1001)))
+	has, err = f.keeper.ProofDeadlinesByDealProvider.Has(ctx, collections.Join(deal.Id, providerA))
+	require.NoError(t, err)
+	require.False(t, has)
+}
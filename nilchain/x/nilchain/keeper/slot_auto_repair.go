@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"nilchain/x/nilchain/types"
+)
+
+// Triggers recorded on chain-initiated slot repairs.
+const (
+	autoRepairReasonFailures     = "failures"
+	autoRepairReasonMissedProofs = "missed_proofs"
+)
+
+// recordMissedProof counts a missed proof window for a provider on a deal and
+// repairs the provider's Mode 2 slot once auto_repair_missed_proof_threshold
+// consecutive windows were missed.
+func (k Keeper) recordMissedProof(ctx sdk.Context, params types.Params, dealID uint64, provider string) error {
+	key := collections.Join(dealID, provider)
+	missed, err := k.DealProviderMissedProofs.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return fmt.Errorf("failed to load missed proof counter: %w", err)
+	}
+	missed++
+	if err := k.DealProviderMissedProofs.Set(ctx, key, missed); err != nil {
+		return fmt.Errorf("failed to update missed proof counter: %w", err)
+	}
+	if params.AutoRepairMissedProofThreshold == 0 || missed < params.AutoRepairMissedProofThreshold {
+		return nil
+	}
+	return k.autoRepairSlot(ctx, params, dealID, provider, autoRepairReasonMissedProofs, missed)
+}
+
+// autoRepairSlot starts a chain-initiated repair of the Mode 2 slot provider
+// holds in a deal, towards a replacement picked by AssignProviders. Mode 1
+// deals, slots already repairing and slots that changed status less than
+// auto_repair_cooldown_blocks ago are left alone, as are slots nobody can
+// take over yet; the next failure tries again. Only store errors are
+// returned.
+func (k Keeper) autoRepairSlot(ctx sdk.Context, params types.Params, dealID uint64, provider, reason string, count uint64) error {
+	deal, err := k.Deals.Get(ctx, dealID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if checkDealActive(deal) != nil || !hasMode2Slots(deal) {
+		return nil
+	}
+	idx, held := providerSlotIndex(deal, provider)
+	if !held {
+		return nil
+	}
+	slotIdx := uint32(idx)
+	slot, err := mode2Slot(&deal, slotIdx)
+	if err != nil || slot.Status != types.SlotStatus_SLOT_STATUS_ACTIVE {
+		return nil
+	}
+	if height := ctx.BlockHeight(); height < slot.StatusSinceHeight || uint64(height-slot.StatusSinceHeight) < params.AutoRepairCooldownBlocks {
+		return nil
+	}
+
+	replacement, choices, err := k.pickSlotRepairProvider(ctx, deal, slot)
+	if err != nil {
+		ctx.Logger().Info("no replacement for automatic slot repair", "deal", deal.Id, "slot", slotIdx, "provider", provider, "error", err)
+		return nil
+	}
+
+	// Start the repair on a cached context so a rejected repair leaves no
+	// partial writes behind. The failed provider's slash funds the bounty of
+	// whoever completes the repair.
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.startSlotRepair(cacheCtx, &deal, slotIdx, replacement); err != nil {
+		ctx.Logger().Error("failed to start automatic slot repair", "deal", deal.Id, "slot", slotIdx, "replacement", replacement, "error", err)
+		return nil
+	}
+	if err := k.fundRepairBounty(cacheCtx, params, slot, reason); err != nil {
+		return err
+	}
+	if err := k.Deals.Set(cacheCtx, deal.Id, deal); err != nil {
+		return fmt.Errorf("failed to update deal: %w", err)
+	}
+	write()
+
+	if err := k.recordDealPlacement(ctx, deal.Id, int(slotIdx), choices); err != nil {
+		return err
+	}
+	key := collections.Join(deal.Id, provider)
+	if err := k.DealProviderFailures.Remove(ctx, key); err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return fmt.Errorf("failed to reset provider failure counter: %w", err)
+	}
+	if err := k.DealProviderMissedProofs.Remove(ctx, key); err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return fmt.Errorf("failed to reset missed proof counter: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeSlotAutoRepairStarted,
+			sdk.NewAttribute(types.AttributeKeyDealID, fmt.Sprintf("%d", deal.Id)),
+			sdk.NewAttribute(types.AttributeKeySlot, fmt.Sprintf("%d", slotIdx)),
+			sdk.NewAttribute(types.AttributeKeyProvider, provider),
+			sdk.NewAttribute(types.AttributeKeyPendingProvider, replacement),
+			sdk.NewAttribute(types.AttributeKeyRepairTargetGen, fmt.Sprintf("%d", deal.CurrentGen)),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(types.AttributeKeyTriggerCount, fmt.Sprintf("%d", count)),
+			sdk.NewAttribute(types.AttributeKeyBounty, slot.RepairBounty.String()),
+		),
+	)
+	return nil
+}
+
+// pickSlotRepairProvider picks the provider to repair a Mode 2 slot towards
+// with AssignProviders. The deal's other providers and pending providers
+// count towards the failure domain limit; the slot's current and pending
+// providers are left out, as both are on their way out.
+func (k Keeper) pickSlotRepairProvider(ctx sdk.Context, deal types.Deal, slot *types.DealSlot) (string, []types.PlacementChoice, error) {
+	hint := ""
+	if parsed, err := types.ParseServiceHint(deal.ServiceHint); err == nil {
+		hint = parsed.Base
+	}
+	var occupied []string
+	for _, s := range deal.Mode2Slots {
+		if s == nil {
+			continue
+		}
+		occupied = append(occupied, s.Provider)
+		if s.PendingProvider != "" {
+			occupied = append(occupied, s.PendingProvider)
+		}
+	}
+	exclude := []string{slot.Provider}
+	if slot.PendingProvider != "" {
+		exclude = append(exclude, slot.PendingProvider)
+	}
+	picked, choices, err := k.AssignProviders(ctx, deal.Id, ctx.BlockHeader().LastBlockId.Hash, PlacementRequest{
+		ServiceHint:  hint,
+		Count:        1,
+		MinFree:      DealStorageFootprint(deal),
+		MaxPerDomain: dealDomainLimit(deal),
+		RelaxDomains: true,
+		Occupied:     occupied,
+		Exclude:      exclude,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return picked[0], choices, nil
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"slices"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"nilchain/x/nilchain/types"
+)
+
+func TestAutoRepairSlotAfterMissedProofs(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	params := types.DefaultParams()
+	params.AutoRepairMissedProofThreshold = 2
+	params.AutoRepairCooldownBlocks = 30
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	// Twelve slots and one spare provider.
+	registerPlacementProviders(t, f, 13, func(int) types.ProviderFailureDomain { return types.ProviderFailureDomain{} })
+	res, err := createPlacementDeal(f, "General:rs=8+4")
+	require.NoError(t, err)
+	deal, err := f.keeper.Deals.Get(ctx, res.DealId)
+	require.NoError(t, err)
+
+	var spare string
+	err = f.keeper.Providers.Walk(ctx, nil, func(addr string, _ types.Provider) (bool, error) {
+		if !slices.Contains(deal.Providers, addr) {
+			spare = addr
+		}
+		return false, nil
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, spare)
+
+	// Only the provider of slot 3 misses its proofs.
+	const target = 3
+	failing := deal.Providers[target]
+	for i, addr := range deal.Providers {
+		if i != target {
+			require.NoError(t, f.keeper.SetProofDeadline(ctx, deal.Id, addr, deal.EndBlock))
+		}
+	}
+	miss := func() sdk.Context {
+		deadline, err := f.keeper.ProofDeadlinesByDealProvider.Get(ctx, collections.Join(deal.Id, failing))
+		require.NoError(t, err)
+		missCtx := ctx.WithBlockHeight(int64(deadline)).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, f.keeper.CheckMissedProofs(missCtx))
+		return missCtx
+	}
+	slot := func() *types.DealSlot {
+		deal, err := f.keeper.Deals.Get(ctx, deal.Id)
+		require.NoError(t, err)
+		return deal.Mode2Slots[target]
+	}
+
+	// The second miss reaches the threshold but falls inside the cooldown
+	// since the slot was created.
+	miss()
+	miss()
+	require.Equal(t, types.SlotStatus_SLOT_STATUS_ACTIVE, slot().Status)
+	missed, err := f.keeper.DealProviderMissedProofs.Get(ctx, collections.Join(deal.Id, failing))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), missed)
+
+	// The third miss is past the cooldown: the chain repairs the slot.
+	missCtx := miss()
+	require.Equal(t, types.SlotStatus_SLOT_STATUS_REPAIRING, slot().Status)
+	require.Equal(t, spare, slot().PendingProvider)
+	require.Equal(t, failing, slot().Provider)
+
+	has, err := f.keeper.DealProviderMissedProofs.Has(ctx, collections.Join(deal.Id, failing))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	placement, err := f.keeper.DealPlacements.Get(ctx, deal.Id)
+	require.NoError(t, err)
+	require.Len(t, placement.Choices, 12)
+	for _, choice := range placement.Choices {
+		if choice.Position == target {
+			require.Equal(t, spare, choice.Provider)
+		}
+	}
+
+	var event *sdk.Event
+	for _, e := range missCtx.EventManager().Events() {
+		if e.Type == types.TypeSlotAutoRepairStarted {
+			event = &e
+		}
+	}
+	require.NotNil(t, event)
+	attrs := map[string]string{}
+	for _, a := range event.Attributes {
+		attrs[a.Key] = a.Value
+	}
+	require.Equal(t, "3", attrs[types.AttributeKeySlot])
+	require.Equal(t, failing, attrs[types.AttributeKeyProvider])
+	require.Equal(t, spare, attrs[types.AttributeKeyPendingProvider])
+	require.Equal(t, "missed_proofs", attrs[types.AttributeKeyReason])
+	require.Equal(t, "3", attrs[types.AttributeKeyTriggerCount])
+
+	// A slot already repairing is not repaired again.
+	miss()
+	miss()
+	require.Equal(t, spare, slot().PendingProvider)
+}
+
+func TestAutoRepairSkipsSlotsWithoutReplacement(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	params := types.DefaultParams()
+	params.AutoRepairMissedProofThreshold = 1
+	params.AutoRepairCooldownBlocks = 0
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	registerPlacementProviders(t, f, 12, func(int) types.ProviderFailureDomain { return types.ProviderFailureDomain{} })
+	res, err := createPlacementDeal(f, "General:rs=8+4")
+	require.NoError(t, err)
+	deal, err := f.keeper.Deals.Get(ctx, res.DealId)
+	require.NoError(t, err)
+
+	deadline, err := f.keeper.ProofDeadlinesByDealProvider.Get(ctx, collections.Join(deal.Id, deal.Providers[0]))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.CheckMissedProofs(ctx.WithBlockHeight(int64(deadline))))
+
+	deal, err = f.keeper.Deals.Get(ctx, res.DealId)
+	require.NoError(t, err)
+	for _, slot := range deal.Mode2Slots {
+		require.Equal(t, types.SlotStatus_SLOT_STATUS_ACTIVE, slot.Status)
+	}
+}
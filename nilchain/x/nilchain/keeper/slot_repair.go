@@ -0,0 +1,365 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nilchain/x/nilchain/types"
+)
+
+// repairChallenge is the sample of leaves a pending provider must prove to
+// complete the repair of a slot.
+type repairChallenge struct {
+	epochID    uint64
+	epochStart uint64
+	challenges []types.ChallengePosition
+}
+
+// deriveRepairChallenge samples repair_sample_blobs leaves of a repairing
+// slot from the user MDUs of the deal's current generation. The sample is
+// seeded by the current liveness epoch, and only epochs that started after
+// the repair did count, so the pending provider cannot know the sample before
+// it has to hold the whole slot. A deal without user data has nothing to
+// prove.
+func (k Keeper) deriveRepairChallenge(ctx sdk.Context, deal types.Deal, slotIdx uint32, slot *types.DealSlot) (repairChallenge, error) {
+	params := k.GetParams(ctx)
+	epochID, epochStart := livenessEpoch(params, ctx.BlockHeight())
+	rc := repairChallenge{epochID: epochID, epochStart: epochStart}
+
+	metaMdus, userMdus := livenessMdus(deal)
+	if userMdus == 0 {
+		return rc, nil
+	}
+	if int64(epochStart) <= slot.StatusSinceHeight {
+		next := (uint64(slot.StatusSinceHeight)/params.EpochLenBlocks + 1) * params.EpochLenBlocks
+		return repairChallenge{}, sdkerrors.ErrInvalidRequest.Wrapf("repair challenge for slot %d opens at height %d", slotIdx, next)
+	}
+	stripe, err := stripeParamsForDeal(deal)
+	if err != nil {
+		return repairChallenge{}, sdkerrors.ErrInvalidRequest.Wrapf("invalid service hint: %s", err.Error())
+	}
+	seed, err := k.EpochSeeds.Get(ctx, epochID)
+	if err != nil {
+		return repairChallenge{}, fmt.Errorf("failed to load seed for epoch %d: %w", epochID, err)
+	}
+
+	for i := uint64(0); i < params.RepairSampleBlobs; i++ {
+		chal := types.HashRepairChallengeSeed(seed, deal.Id, deal.CurrentGen, uint64(slotIdx), i)
+		mduIndex := metaMdus + binary.BigEndian.Uint64(chal[0:8])%userMdus
+		blobIndex := uint64(slotIdx)*stripe.rows + binary.BigEndian.Uint64(chal[8:16])%stripe.rows
+		rc.challenges = append(rc.challenges, types.ChallengePosition{
+			Ordinal:   i,
+			MduIndex:  mduIndex,
+			BlobIndex: uint32(blobIndex),
+		})
+	}
+	return rc, nil
+}
+
+// verifySlotRepairProofs checks that proofs answer the repair challenge of a
+// slot, one proof per sampled leaf and in order, against the deal's current
+// manifest root.
+func (k Keeper) verifySlotRepairProofs(ctx sdk.Context, deal types.Deal, slotIdx uint32, proofs []types.ChainedProof) error {
+	slot, err := mode2Slot(&deal, slotIdx)
+	if err != nil {
+		return err
+	}
+	rc, err := k.deriveRepairChallenge(ctx, deal, slotIdx, slot)
+	if err != nil {
+		return err
+	}
+	if len(proofs) != len(rc.challenges) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("expected %d repair proofs, got %d", len(rc.challenges), len(proofs))
+	}
+	if len(proofs) == 0 {
+		return nil
+	}
+	stripe, err := stripeParamsForDeal(deal)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid service hint: %s", err.Error())
+	}
+	for i, c := range rc.challenges {
+		p := proofs[i]
+		if p.MduIndex != c.MduIndex || p.BlobIndex != c.BlobIndex {
+			return sdkerrors.ErrInvalidRequest.Wrapf("repair proof %d must open mdu %d blob %d", i, c.MduIndex, c.BlobIndex)
+		}
+		ok, err := verifyDealChainedProof(deal, stripe, &p)
+		if err != nil {
+			return sdkerrors.ErrUnauthorized.Wrapf("triple proof verification error: %s", err)
+		}
+		if !ok {
+			return sdkerrors.ErrUnauthorized.Wrapf("invalid repair proof %d", i)
+		}
+	}
+	return nil
+}
+
+// hasRepairBounty reports whether a slot holds a repair bounty.
+func hasRepairBounty(slot *types.DealSlot) bool {
+	return !slot.RepairBounty.Amount.IsNil() && slot.RepairBounty.IsPositive()
+}
+
+// fundRepairBounty slashes repair_slash_bps of the bond of a provider whose
+// slot the chain repairs after failures, and holds repair_bounty_bps of the
+// slashed amount on the slot for whoever completes the repair. The rest is
+// burned.
+func (k Keeper) fundRepairBounty(ctx sdk.Context, params types.Params, slot *types.DealSlot, reason string) error {
+	bounty, err := k.slashProviderBond(ctx, slot.Provider, params.RepairSlashBps, params.RepairBountyBps, "slot_repair_"+reason)
+	if err != nil {
+		return err
+	}
+	slot.RepairBounty = bounty
+	return nil
+}
+
+// settleRepairBounty pays a slot's repair bounty to its pending provider, or
+// burns it when pay is false, and clears it from the slot. The caller
+// persists the deal.
+func (k Keeper) settleRepairBounty(ctx context.Context, slot *types.DealSlot, pay bool) (sdk.Coin, error) {
+	if !hasRepairBounty(slot) {
+		return sdk.NewCoin(sdk.DefaultBondDenom, math.ZeroInt()), nil
+	}
+	bounty := slot.RepairBounty
+	slot.RepairBounty = sdk.Coin{}
+	if !pay {
+		if err := k.BankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(bounty)); err != nil {
+			return sdk.Coin{}, fmt.Errorf("failed to burn repair bounty: %w", err)
+		}
+		return bounty, nil
+	}
+	addr, err := sdk.AccAddressFromBech32(slot.PendingProvider)
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("invalid pending provider address: %w", err)
+	}
+	if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, sdk.NewCoins(bounty)); err != nil {
+		return sdk.Coin{}, fmt.Errorf("failed to pay repair bounty: %w", err)
+	}
+	return bounty, nil
+}
+
+// scheduleSlotRepairDeadline queues a repairing slot for
+// ReassignOverdueSlotRepairs at its deadline height.
+func (k Keeper) scheduleSlotRepairDeadline(ctx context.Context, dealID uint64, slotIdx uint32, deadline uint64) error {
+	if err := k.SlotRepairQueue.Set(ctx, collections.Join3(deadline, dealID, slotIdx)); err != nil {
+		return fmt.Errorf("failed to queue slot repair: %w", err)
+	}
+	return nil
+}
+
+// ReassignOverdueSlotRepairs moves every repair whose pending provider missed
+// its deadline to a new provider picked by AssignProviders, keeping the
+// slot's bounty for whoever completes it. When nobody can take over, the
+// pending provider keeps the repair for another repair_deadline_blocks. Like
+// CheckMissedProofs it only visits due queue entries.
+func (k Keeper) ReassignOverdueSlotRepairs(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+
+	due, err := k.dueSlotRepairs(ctx, height)
+	if err != nil {
+		return err
+	}
+	if len(due) == 0 {
+		return nil
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range due {
+		if err := k.SlotRepairQueue.Remove(ctx, entry); err != nil {
+			return err
+		}
+		deal, err := k.Deals.Get(ctx, entry.K2())
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+		if checkDealActive(deal) != nil || !hasMode2Slots(deal) {
+			continue
+		}
+		slot, err := mode2Slot(&deal, entry.K3())
+		if err != nil || slot.Status != types.SlotStatus_SLOT_STATUS_REPAIRING || slot.RepairDeadlineHeight != entry.K1() {
+			// Completed, or restarted with a later deadline.
+			continue
+		}
+		if err := k.reassignSlotRepair(sdkCtx, params, deal, entry.K3()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reassignSlotRepair restarts an overdue repair towards a new provider, or
+// extends its deadline when no other provider can take the slot. The caller
+// has already dequeued the overdue deadline.
+func (k Keeper) reassignSlotRepair(ctx sdk.Context, params types.Params, deal types.Deal, slotIdx uint32) error {
+	slot := deal.Mode2Slots[slotIdx]
+	previous := slot.PendingProvider
+
+	replacement, choices, err := k.pickSlotRepairProvider(ctx, deal, slot)
+	if err == nil {
+		// Restart the repair on a cached context so a rejected replacement
+		// leaves the overdue repair in place.
+		cacheCtx, write := ctx.CacheContext()
+		if err = k.releaseProviderReservation(cacheCtx, previous, DealStorageFootprint(deal)); err != nil {
+			return err
+		}
+		slot.Status = types.SlotStatus_SLOT_STATUS_ACTIVE
+		slot.PendingProvider = ""
+		if err = k.startSlotRepair(cacheCtx, &deal, slotIdx, replacement); err == nil {
+			write()
+			if err := k.recordDealPlacement(ctx, deal.Id, int(slotIdx), choices); err != nil {
+				return err
+			}
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.TypeSlotRepairReassigned,
+					sdk.NewAttribute(types.AttributeKeyDealID, fmt.Sprintf("%d", deal.Id)),
+					sdk.NewAttribute(types.AttributeKeySlot, fmt.Sprintf("%d", slotIdx)),
+					sdk.NewAttribute(types.AttributeKeyProvider, slot.Provider),
+					sdk.NewAttribute(types.AttributeKeyPreviousPendingProvider, previous),
+					sdk.NewAttribute(types.AttributeKeyPendingProvider, replacement),
+					sdk.NewAttribute(types.AttributeKeyRepairTargetGen, fmt.Sprintf("%d", slot.RepairTargetGen)),
+					sdk.NewAttribute(types.AttributeKeyRepairDeadlineHeight, fmt.Sprintf("%d", slot.RepairDeadlineHeight)),
+				),
+			)
+			return nil
+		}
+	}
+	ctx.Logger().Info("overdue slot repair stays with its pending provider", "deal", deal.Id, "slot", slotIdx, "pending", previous, "error", err)
+
+	// The failed restart may have changed the loaded copy; start over from
+	// the store.
+	deal, err = k.Deals.Get(ctx, deal.Id)
+	if err != nil {
+		return err
+	}
+	slot = deal.Mode2Slots[slotIdx]
+	slot.RepairDeadlineHeight = uint64(ctx.BlockHeight()) + params.RepairDeadlineBlocks
+	if err := k.Deals.Set(ctx, deal.Id, deal); err != nil {
+		return fmt.Errorf("failed to update deal: %w", err)
+	}
+	return k.scheduleSlotRepairDeadline(ctx, deal.Id, slotIdx, slot.RepairDeadlineHeight)
+}
+
+// abandonSlotRepair cancels a slot's repair: the pending provider's
+// reservation is released, the deadline dequeued and the slot left active
+// with its current provider. The bounty stays on the slot; the caller
+// persists the deal.
+func (k Keeper) abandonSlotRepair(ctx context.Context, deal types.Deal, slotIdx uint32, slot *types.DealSlot) error {
+	if err := k.releaseProviderReservation(ctx, slot.PendingProvider, DealStorageFootprint(deal)); err != nil {
+		return err
+	}
+	if err := k.SlotRepairQueue.Remove(ctx, collections.Join3(slot.RepairDeadlineHeight, deal.Id, slotIdx)); err != nil {
+		return fmt.Errorf("failed to dequeue slot repair: %w", err)
+	}
+	slot.Status = types.SlotStatus_SLOT_STATUS_ACTIVE
+	slot.PendingProvider = ""
+	slot.RepairTargetGen = 0
+	return nil
+}
+
+// dropPendingSlotRepairs takes every repair pending towards a deregistering
+// provider away from it. Each repair restarts towards a provider picked by
+// AssignProviders, keeping its bounty; a repair nobody else can take is
+// abandoned and its bounty burned.
+func (k Keeper) dropPendingSlotRepairs(ctx sdk.Context, provider string) error {
+	var queued []collections.Triple[uint64, uint64, uint32]
+	if err := k.SlotRepairQueue.Walk(ctx, nil, func(key collections.Triple[uint64, uint64, uint32]) (bool, error) {
+		queued = append(queued, key)
+		return false, nil
+	}); err != nil {
+		return fmt.Errorf("failed to walk slot repairs: %w", err)
+	}
+
+	for _, entry := range queued {
+		deal, err := k.Deals.Get(ctx, entry.K2())
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+		if checkDealActive(deal) != nil || !hasMode2Slots(deal) {
+			continue
+		}
+		slotIdx := entry.K3()
+		slot, err := mode2Slot(&deal, slotIdx)
+		if err != nil || slot.Status != types.SlotStatus_SLOT_STATUS_REPAIRING ||
+			slot.PendingProvider != provider || slot.RepairDeadlineHeight != entry.K1() {
+			continue
+		}
+
+		replacement, choices, err := k.pickSlotRepairProvider(ctx, deal, slot)
+		if err := k.abandonSlotRepair(ctx, deal, slotIdx, slot); err != nil {
+			return err
+		}
+		if err == nil {
+			// Restart on a cached context so a rejected replacement leaves
+			// the slot abandoned rather than half repaired.
+			cacheCtx, write := ctx.CacheContext()
+			if err = k.startSlotRepair(cacheCtx, &deal, slotIdx, replacement); err == nil {
+				write()
+				if err := k.recordDealPlacement(ctx, deal.Id, int(slotIdx), choices); err != nil {
+					return err
+				}
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.TypeSlotRepairReassigned,
+						sdk.NewAttribute(types.AttributeKeyDealID, fmt.Sprintf("%d", deal.Id)),
+						sdk.NewAttribute(types.AttributeKeySlot, fmt.Sprintf("%d", slotIdx)),
+						sdk.NewAttribute(types.AttributeKeyProvider, slot.Provider),
+						sdk.NewAttribute(types.AttributeKeyPreviousPendingProvider, provider),
+						sdk.NewAttribute(types.AttributeKeyPendingProvider, replacement),
+						sdk.NewAttribute(types.AttributeKeyRepairTargetGen, fmt.Sprintf("%d", slot.RepairTargetGen)),
+						sdk.NewAttribute(types.AttributeKeyRepairDeadlineHeight, fmt.Sprintf("%d", slot.RepairDeadlineHeight)),
+					),
+				)
+				continue
+			}
+		}
+		ctx.Logger().Info("abandoning slot repair of deregistering provider", "deal", deal.Id, "slot", slotIdx, "pending", provider, "error", err)
+
+		if _, err := k.settleRepairBounty(ctx, slot, false); err != nil {
+			return err
+		}
+		if err := k.Deals.Set(ctx, deal.Id, deal); err != nil {
+			return fmt.Errorf("failed to update deal: %w", err)
+		}
+	}
+	return nil
+}
+
+// dueSlotRepairs returns the queue entries whose deadline is at or before
+// height, stopping at the first entry that is not yet due.
+func (k Keeper) dueSlotRepairs(ctx context.Context, height uint64) ([]collections.Triple[uint64, uint64, uint32], error) {
+	iter, err := k.SlotRepairQueue.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var due []collections.Triple[uint64, uint64, uint32]
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		if key.K1() > height {
+			break
+		}
+		due = append(due, key)
+	}
+	return due, nil
+}
@@ -0,0 +1,274 @@
+package keeper_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+// registerRepairProviders registers n funded providers that lock the default
+// bond.
+func registerRepairProviders(t *testing.T, f *fixture, bank *trackingBankKeeper, n int) {
+	t.Helper()
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	for i := 0; i < n; i++ {
+		addr := sdk.AccAddress([]byte(fmt.Sprintf("repair_provider___%02d", i)))
+		if bank != nil {
+			bank.setAccountBalance(addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)))
+		}
+		_, err := msgServer.RegisterProvider(f.ctx, &types.MsgRegisterProvider{
+			Creator: addr.String(), Capabilities: "General", TotalStorage: 1 << 30, Endpoints: testProviderEndpoints,
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestSlotRepairBountyPaidToPendingProvider(t *testing.T) {
+	bank := newTrackingBankKeeper()
+	f := initFixtureWithBankKeeper(t, bank)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	params := types.DefaultParams()
+	params.AutoRepairMissedProofThreshold = 1
+	params.AutoRepairCooldownBlocks = 0
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	registerRepairProviders(t, f, bank, 13)
+	res, err := createPlacementDeal(f, "General:rs=8+4")
+	require.NoError(t, err)
+	deal, err := f.keeper.Deals.Get(ctx, res.DealId)
+	require.NoError(t, err)
+
+	// The provider of slot 2 misses a proof and the chain repairs its slot:
+	// after the 1% missed proof slash, 5% of the remaining bond is slashed and
+	// half of that is held as the bounty.
+	failing := deal.Providers[2]
+	for i, addr := range deal.Providers {
+		if i != 2 {
+			require.NoError(t, f.keeper.SetProofDeadline(ctx, deal.Id, addr, deal.EndBlock))
+		}
+	}
+	deadline, err := f.keeper.ProofDeadlinesByDealProvider.Get(ctx, collections.Join(deal.Id, failing))
+	require.NoError(t, err)
+	missCtx := ctx.WithBlockHeight(int64(deadline))
+	require.NoError(t, f.keeper.CheckMissedProofs(missCtx))
+
+	provider, err := f.keeper.Providers.Get(ctx, failing)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 94_050), provider.Bond)
+	deal, err = f.keeper.Deals.Get(ctx, res.DealId)
+	require.NoError(t, err)
+	slot := deal.Mode2Slots[2]
+	require.Equal(t, types.SlotStatus_SLOT_STATUS_REPAIRING, slot.Status)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 2_475), slot.RepairBounty)
+	require.Equal(t, deadline+params.RepairDeadlineBlocks, slot.RepairDeadlineHeight)
+	_, broken := keeper.ModuleAccountSolvencyInvariant(f.keeper)(missCtx)
+	require.False(t, broken)
+
+	// A deal without content has nothing to prove.
+	chal, err := queryServer.GetSlotRepairChallenge(missCtx, &types.QueryGetSlotRepairChallengeRequest{DealId: deal.Id, Slot: 2})
+	require.NoError(t, err)
+	require.Equal(t, slot.PendingProvider, chal.PendingProvider)
+	require.Equal(t, slot.RepairDeadlineHeight, chal.DeadlineHeight)
+	require.Equal(t, slot.RepairBounty, chal.Bounty)
+	require.Empty(t, chal.Challenges)
+
+	_, err = msgServer.CompleteSlotRepair(missCtx, &types.MsgCompleteSlotRepair{Creator: deal.Owner, DealId: deal.Id, Slot: 2})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	pending := sdk.MustAccAddressFromBech32(slot.PendingProvider)
+	before := bank.accountBalances[pending.String()].AmountOf(sdk.DefaultBondDenom)
+	_, err = msgServer.CompleteSlotRepair(missCtx, &types.MsgCompleteSlotRepair{Creator: slot.PendingProvider, DealId: deal.Id, Slot: 2})
+	require.NoError(t, err)
+	require.Equal(t, before.AddRaw(2_475), bank.accountBalances[pending.String()].AmountOf(sdk.DefaultBondDenom))
+
+	deal, err = f.keeper.Deals.Get(ctx, res.DealId)
+	require.NoError(t, err)
+	slot = deal.Mode2Slots[2]
+	require.Equal(t, types.SlotStatus_SLOT_STATUS_ACTIVE, slot.Status)
+	require.Equal(t, pending.String(), slot.Provider)
+	require.Zero(t, slot.RepairDeadlineHeight)
+	require.True(t, slot.RepairBounty.IsNil() || slot.RepairBounty.IsZero())
+
+	queued := 0
+	require.NoError(t, f.keeper.SlotRepairQueue.Walk(ctx, nil, func(collections.Triple[uint64, uint64, uint32]) (bool, error) {
+		queued++
+		return false, nil
+	}))
+	require.Zero(t, queued)
+	_, broken = keeper.ModuleAccountSolvencyInvariant(f.keeper)(missCtx)
+	require.False(t, broken)
+
+	// The deal is listed under its new provider.
+	listed, err := keeper.NewQueryServerImpl(f.keeper).ListDealsByProvider(ctx, &types.QueryListDealsByProviderRequest{Provider: pending.String()})
+	require.NoError(t, err)
+	require.Len(t, listed.Deals, 1)
+	_, broken = keeper.DealIndexInvariant(f.keeper)(missCtx)
+	require.False(t, broken)
+}
+
+func TestCompleteSlotRepairRequiresRepairProofs(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(3)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+
+	registerRepairProviders(t, f, nil, 13)
+	res, err := createPlacementDeal(f, "General:rs=8+4")
+	require.NoError(t, err)
+	deal, err := f.keeper.Deals.Get(ctx, res.DealId)
+	require.NoError(t, err)
+	_, err = msgServer.UpdateDealContent(ctx, &types.MsgUpdateDealContent{
+		Creator: deal.Owner, DealId: deal.Id, Cid: "0x" + strings.Repeat("ab", 48), Size_: 2 * types.MDU_SIZE,
+	})
+	require.NoError(t, err)
+
+	var spare string
+	for i := 0; i < 13 && spare == ""; i++ {
+		if addr := sdk.AccAddress([]byte(fmt.Sprintf("repair_provider___%02d", i))).String(); !slices.Contains(deal.Providers, addr) {
+			spare = addr
+		}
+	}
+	require.NotEmpty(t, spare)
+	_, err = msgServer.StartSlotRepair(ctx, &types.MsgStartSlotRepair{Creator: deal.Owner, DealId: deal.Id, Slot: 4, PendingProvider: spare})
+	require.NoError(t, err)
+
+	// The challenge opens with the first epoch after the repair started.
+	_, err = queryServer.GetSlotRepairChallenge(ctx, &types.QueryGetSlotRepairChallengeRequest{DealId: deal.Id, Slot: 4})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = msgServer.CompleteSlotRepair(ctx, &types.MsgCompleteSlotRepair{Creator: spare, DealId: deal.Id, Slot: 4})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Contains(t, err.Error(), "opens at height 10")
+
+	epochCtx := ctx.WithBlockHeight(12)
+	_, err = queryServer.GetSlotRepairChallenge(epochCtx, &types.QueryGetSlotRepairChallengeRequest{DealId: deal.Id, Slot: 4})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.NoError(t, f.keeper.EnsureEpochSeed(epochCtx))
+
+	chal, err := queryServer.GetSlotRepairChallenge(epochCtx, &types.QueryGetSlotRepairChallengeRequest{DealId: deal.Id, Slot: 4})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), chal.EpochId)
+	require.Equal(t, uint64(10), chal.EpochStartHeight)
+	require.Len(t, chal.Challenges, int(types.DefaultParams().RepairSampleBlobs))
+	for _, c := range chal.Challenges {
+		// Slot 4 holds blobs 32..39 of each 64-blob MDU.
+		require.Less(t, c.MduIndex, uint64(2))
+		require.GreaterOrEqual(t, c.BlobIndex, uint32(32))
+		require.Less(t, c.BlobIndex, uint32(40))
+	}
+
+	_, err = msgServer.CompleteSlotRepair(epochCtx, &types.MsgCompleteSlotRepair{Creator: spare, DealId: deal.Id, Slot: 4})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Contains(t, err.Error(), "expected 8 repair proofs, got 0")
+
+	proofs := make([]types.ChainedProof, len(chal.Challenges))
+	for i, c := range chal.Challenges {
+		proofs[i] = types.ChainedProof{MduIndex: c.MduIndex, BlobIndex: c.BlobIndex + 1}
+	}
+	_, err = msgServer.CompleteSlotRepair(epochCtx, &types.MsgCompleteSlotRepair{Creator: spare, DealId: deal.Id, Slot: 4, Proofs: proofs})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Contains(t, err.Error(), "repair proof 0 must open")
+
+	deal, err = f.keeper.Deals.Get(ctx, deal.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.SlotStatus_SLOT_STATUS_REPAIRING, deal.Mode2Slots[4].Status)
+}
+
+func TestReassignOverdueSlotRepairs(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(5)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	params := types.DefaultParams()
+
+	registerRepairProviders(t, f, nil, 14)
+	res, err := createPlacementDeal(f, "General:rs=8+4")
+	require.NoError(t, err)
+	deal, err := f.keeper.Deals.Get(ctx, res.DealId)
+	require.NoError(t, err)
+	_, err = msgServer.UpdateDealContent(ctx, &types.MsgUpdateDealContent{
+		Creator: deal.Owner, DealId: deal.Id, Cid: "0x" + strings.Repeat("ab", 48), Size_: types.MDU_SIZE,
+	})
+	require.NoError(t, err)
+	deal, err = f.keeper.Deals.Get(ctx, res.DealId)
+	require.NoError(t, err)
+	footprint := keeper.DealStorageFootprint(deal)
+
+	var spares []string
+	for i := 0; i < 14; i++ {
+		addr := sdk.AccAddress([]byte(fmt.Sprintf("repair_provider___%02d", i))).String()
+		if !slices.Contains(deal.Providers, addr) {
+			spares = append(spares, addr)
+		}
+	}
+	require.Len(t, spares, 2)
+	reserved := func(addr string) uint64 {
+		p, err := f.keeper.Providers.Get(ctx, addr)
+		require.NoError(t, err)
+		return p.ReservedStorage
+	}
+	slot := func() *types.DealSlot {
+		deal, err := f.keeper.Deals.Get(ctx, res.DealId)
+		require.NoError(t, err)
+		return deal.Mode2Slots[0]
+	}
+
+	_, err = msgServer.StartSlotRepair(ctx, &types.MsgStartSlotRepair{Creator: deal.Owner, DealId: deal.Id, Slot: 0, PendingProvider: spares[0]})
+	require.NoError(t, err)
+	deadline := uint64(5) + params.RepairDeadlineBlocks
+	require.Equal(t, deadline, slot().RepairDeadlineHeight)
+	require.Equal(t, footprint, reserved(spares[0]))
+
+	// Nothing happens before the deadline.
+	require.NoError(t, f.keeper.ReassignOverdueSlotRepairs(ctx.WithBlockHeight(int64(deadline-1))))
+	require.Equal(t, spares[0], slot().PendingProvider)
+
+	// At the deadline the repair moves to the other spare provider.
+	dueCtx := ctx.WithBlockHeight(int64(deadline)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.ReassignOverdueSlotRepairs(dueCtx))
+	require.Equal(t, spares[1], slot().PendingProvider)
+	require.Equal(t, types.SlotStatus_SLOT_STATUS_REPAIRING, slot().Status)
+	require.Equal(t, deadline+params.RepairDeadlineBlocks, slot().RepairDeadlineHeight)
+	require.Zero(t, reserved(spares[0]))
+	require.Equal(t, footprint, reserved(spares[1]))
+
+	var event *sdk.Event
+	for _, e := range dueCtx.EventManager().Events() {
+		if e.Type == types.TypeSlotRepairReassigned {
+			event = &e
+		}
+	}
+	require.NotNil(t, event)
+	attrs := map[string]string{}
+	for _, a := range event.Attributes {
+		attrs[a.Key] = a.Value
+	}
+	require.Equal(t, spares[0], attrs[types.AttributeKeyPreviousPendingProvider])
+	require.Equal(t, spares[1], attrs[types.AttributeKeyPendingProvider])
+
+	// With no other provider available the pending provider keeps the repair
+	// for another window.
+	jailed, err := f.keeper.Providers.Get(ctx, spares[0])
+	require.NoError(t, err)
+	jailed.Status = "Jailed"
+	require.NoError(t, f.keeper.Providers.Set(ctx, spares[0], jailed))
+	deadline += params.RepairDeadlineBlocks
+	require.NoError(t, f.keeper.ReassignOverdueSlotRepairs(ctx.WithBlockHeight(int64(deadline))))
+	require.Equal(t, spares[1], slot().PendingProvider)
+	require.Equal(t, deadline+params.RepairDeadlineBlocks, slot().RepairDeadlineHeight)
+	require.Equal(t, footprint, reserved(spares[1]))
+	has, err := f.keeper.SlotRepairQueue.Has(ctx, collections.Join3(deadline+params.RepairDeadlineBlocks, deal.Id, uint32(0)))
+	require.NoError(t, err)
+	require.True(t, has)
+}
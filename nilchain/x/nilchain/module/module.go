@@ -82,6 +82,21 @@ func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
     types.RegisterMsgServer(registrar, keeper.NewMsgServerImpl(am.keeper))
     types.RegisterQueryServer(registrar, keeper.NewQueryServerImpl(am.keeper))
 
+	// The module manager passes a Configurator, which also takes the store
+	// migrations.
+	if cfg, ok := registrar.(module.Configurator); ok {
+		m := keeper.NewMigrator(am.keeper)
+		if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+			return fmt.Errorf("failed to migrate %s from version 1 to 2: %w", types.ModuleName, err)
+		}
+		if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+			return fmt.Errorf("failed to migrate %s from version 2 to 3: %w", types.ModuleName, err)
+		}
+		if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+			return fmt.Errorf("failed to migrate %s from version 3 to 4: %w", types.ModuleName, err)
+		}
+	}
+
 	return nil
 }
 
@@ -129,21 +144,48 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMe
 	return bz
 }
 
+// RegisterInvariants registers the nilchain module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
+
 // ConsensusVersion is a sequence number for state-breaking change of the module.
 // It should be incremented on each consensus-breaking change introduced by the module.
 // To avoid wrong/empty versions, the initial version should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 4 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
-// The begin block implementation is optional.
-func (am AppModule) BeginBlock(_ context.Context) error {
-	return nil
+// It records the liveness epoch seed on the first block of each epoch.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	return am.keeper.EnsureEpochSeed(ctx)
 }
 
 // EndBlock contains the logic that is automatically triggered at the end of each block.
-// The end block implementation is optional.
+// It slashes missed proof windows, expires ended deals, sweeps expired
+// retrieval sessions and session keys, hands over due provider migrations,
+// reassigns overdue slot repairs and releases matured provider unbondings.
 func (am AppModule) EndBlock(ctx context.Context) error {
-	return am.keeper.CheckMissedProofs(ctx)
+	if err := am.keeper.CheckMissedProofs(ctx); err != nil {
+		return err
+	}
+	if err := am.keeper.ExpireDeals(ctx); err != nil {
+		return err
+	}
+	if err := am.keeper.SweepRetrievalSessions(ctx); err != nil {
+		return err
+	}
+	if err := am.keeper.ExpireSessionKeys(ctx); err != nil {
+		return err
+	}
+	if err := am.keeper.CompleteProviderMigrations(ctx); err != nil {
+		return err
+	}
+	// Migrations run first so a repair due at the end of a migration window
+	// is handed over rather than reassigned.
+	if err := am.keeper.ReassignOverdueSlotRepairs(ctx); err != nil {
+		return err
+	}
+	return am.keeper.CompleteProviderUnbondings(ctx)
 }
 
 // GetTxCmd returns the root tx command for the module.
@@ -1,12 +1,51 @@
 package nilchain
 
 import (
+	"math/rand"
+
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
+	nilchainsimulation "nilchain/x/nilchain/simulation"
 	"nilchain/x/nilchain/types"
 )
 
+const (
+	opWeightMsgRegisterProvider          = "op_weight_msg_register_provider"
+	defaultWeightMsgRegisterProvider int = 20
+
+	opWeightMsgCreateDeal          = "op_weight_msg_create_deal"
+	defaultWeightMsgCreateDeal int = 30
+
+	opWeightMsgUpdateDealContent          = "op_weight_msg_update_deal_content"
+	defaultWeightMsgUpdateDealContent int = 30
+
+	opWeightMsgAddCredit          = "op_weight_msg_add_credit"
+	defaultWeightMsgAddCredit int = 20
+
+	opWeightMsgOpenRetrievalSession          = "op_weight_msg_open_retrieval_session"
+	defaultWeightMsgOpenRetrievalSession int = 40
+
+	opWeightMsgConfirmRetrievalSession          = "op_weight_msg_confirm_retrieval_session"
+	defaultWeightMsgConfirmRetrievalSession int = 30
+
+	opWeightMsgCancelRetrievalSession          = "op_weight_msg_cancel_retrieval_session"
+	defaultWeightMsgCancelRetrievalSession int = 20
+
+	opWeightMsgSignalSaturation          = "op_weight_msg_signal_saturation"
+	defaultWeightMsgSignalSaturation int = 5
+
+	opWeightMsgStartSlotRepair          = "op_weight_msg_start_slot_repair"
+	defaultWeightMsgStartSlotRepair int = 10
+
+	opWeightMsgCompleteSlotRepair          = "op_weight_msg_complete_slot_repair"
+	defaultWeightMsgCompleteSlotRepair int = 10
+
+	opWeightMsgWithdrawRewards          = "op_weight_msg_withdraw_rewards"
+	defaultWeightMsgWithdrawRewards int = 10
+)
+
 // GenerateGenesisState creates a randomized GenState of the module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	accs := make([]string, len(simState.Accounts))
@@ -20,16 +59,41 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 }
 
 // RegisterStoreDecoder registers a decoder.
-func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simtypes.NewStoreDecoderFuncFromCollectionsSchema(am.keeper.Schema)
+}
 
-// WeightedOperations returns the all the gov module operations with their respective weights.
+// WeightedOperations returns the all the nilchain module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
-	operations := make([]simtypes.WeightedOperation, 0)
+	txGen := simState.TxConfig
+	ops := []struct {
+		key    string
+		weight int
+		op     simtypes.Operation
+	}{
+		{opWeightMsgRegisterProvider, defaultWeightMsgRegisterProvider, nilchainsimulation.SimulateMsgRegisterProvider(am.authKeeper, am.bankKeeper, am.keeper, txGen)},
+		{opWeightMsgCreateDeal, defaultWeightMsgCreateDeal, nilchainsimulation.SimulateMsgCreateDeal(am.authKeeper, am.bankKeeper, am.keeper, txGen)},
+		{opWeightMsgUpdateDealContent, defaultWeightMsgUpdateDealContent, nilchainsimulation.SimulateMsgUpdateDealContent(am.authKeeper, am.bankKeeper, am.keeper, txGen)},
+		{opWeightMsgAddCredit, defaultWeightMsgAddCredit, nilchainsimulation.SimulateMsgAddCredit(am.authKeeper, am.bankKeeper, am.keeper, txGen)},
+		{opWeightMsgOpenRetrievalSession, defaultWeightMsgOpenRetrievalSession, nilchainsimulation.SimulateMsgOpenRetrievalSession(am.authKeeper, am.bankKeeper, am.keeper, txGen)},
+		{opWeightMsgConfirmRetrievalSession, defaultWeightMsgConfirmRetrievalSession, nilchainsimulation.SimulateMsgConfirmRetrievalSession(am.authKeeper, am.bankKeeper, am.keeper, txGen)},
+		{opWeightMsgCancelRetrievalSession, defaultWeightMsgCancelRetrievalSession, nilchainsimulation.SimulateMsgCancelRetrievalSession(am.authKeeper, am.bankKeeper, am.keeper, txGen)},
+		{opWeightMsgSignalSaturation, defaultWeightMsgSignalSaturation, nilchainsimulation.SimulateMsgSignalSaturation(am.authKeeper, am.bankKeeper, am.keeper, txGen)},
+		{opWeightMsgStartSlotRepair, defaultWeightMsgStartSlotRepair, nilchainsimulation.SimulateMsgStartSlotRepair(am.authKeeper, am.bankKeeper, am.keeper, txGen)},
+		{opWeightMsgCompleteSlotRepair, defaultWeightMsgCompleteSlotRepair, nilchainsimulation.SimulateMsgCompleteSlotRepair(am.authKeeper, am.bankKeeper, am.keeper, txGen)},
+		{opWeightMsgWithdrawRewards, defaultWeightMsgWithdrawRewards, nilchainsimulation.SimulateMsgWithdrawRewards(am.authKeeper, am.bankKeeper, am.keeper, txGen)},
+	}
+
+	operations := make([]simtypes.WeightedOperation, 0, len(ops))
+	for _, o := range ops {
+		weight := o.weight
+		simState.AppParams.GetOrGenerate(o.key, &weight, nil, func(_ *rand.Rand) {})
+		operations = append(operations, simulation.NewWeightedOperation(weight, o.op))
+	}
 	return operations
 }
 
 // ProposalMsgs returns msgs used for governance proposals for simulations.
 func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.WeightedProposalMsg {
-	return []simtypes.WeightedProposalMsg{
-	}
+	return nilchainsimulation.ProposalMsgs()
 }
@@ -0,0 +1,216 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+// dealServiceHints covers Mode 1 placement by capability, a reduced
+// replication factor and two Mode 2 profiles.
+var dealServiceHints = []string{"General", "Hot", "Cold", "General:replicas=3", "General:rs=2+1", "General:rs=4+2"}
+
+// SimulateMsgCreateDeal creates a deal with a random service hint, duration
+// and escrow. Hints that the registered providers cannot place are skipped.
+func SimulateMsgCreateDeal(ak types.AuthKeeper, bk types.BankKeeper, k keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCreateDeal{})
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		params := k.GetParams(ctx)
+
+		hint := dealServiceHints[r.Intn(len(dealServiceHints))]
+		parsed, err := types.ParseServiceHint(hint)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid service hint"), nil, err
+		}
+		replicas := uint64(types.DealBaseReplication)
+		if parsed.HasRS {
+			replicas = parsed.RSK + parsed.RSM
+		} else if parsed.HasReplicas {
+			replicas = parsed.Replicas
+		}
+
+		// AssignProviders only reads state, so a dry run tells whether the
+		// deal can be placed.
+		dealID, err := k.DealCount.Peek(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to load deal count"), nil, err
+		}
+		assigned, _, err := k.AssignProviders(ctx, dealID, ctx.BlockHeader().LastBlockId.Hash, keeper.DealPlacementRequest(parsed, replicas))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no providers for service hint"), nil, nil
+		}
+		if parsed.HasRS && uint64(len(assigned)) != replicas {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "not enough providers for a full Mode 2 stripe"), nil, nil
+		}
+
+		spent := sdk.NewCoins()
+		if params.DealCreationFee.IsValid() && params.DealCreationFee.IsPositive() {
+			spent = spent.Add(params.DealCreationFee)
+		}
+		available := spendableAmount(ctx, bk, simAccount.Address).Sub(spent.AmountOf(sdk.DefaultBondDenom))
+		if available.IsNegative() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient funds for deal creation fee"), nil, nil
+		}
+		escrow := simtypes.RandomAmount(r, available)
+		spent = spent.Add(sdk.NewCoin(sdk.DefaultBondDenom, escrow))
+
+		msg := &types.MsgCreateDeal{
+			Creator:             simAccount.Address.String(),
+			DurationBlocks:      params.MinDurationBlocks + uint64(simtypes.RandIntBetween(r, 1, 500)),
+			ServiceHint:         hint,
+			MaxMonthlySpend:     simtypes.RandomAmount(r, math.NewInt(10_000)),
+			InitialEscrowAmount: escrow,
+		}
+		return deliver(r, app, ctx, ak, bk, txGen, simAccount, msg, spent)
+	}
+}
+
+// SimulateMsgUpdateDealContent commits one of the manifest fixtures to a
+// random deal, paying the term deposit when the content grows.
+func SimulateMsgUpdateDealContent(ak types.AuthKeeper, bk types.BankKeeper, k keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgUpdateDealContent{})
+
+		deal, owner, ok, err := randomOwnedDeal(r, ctx, k, accs, func(types.Deal) bool { return true })
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to load deals"), nil, err
+		}
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no active deal owned by a simulation account"), nil, nil
+		}
+
+		fixture := manifestFixtures[r.Intn(len(manifestFixtures))]
+		spent := sdk.NewCoins()
+		if price := k.GetParams(ctx).StoragePrice; fixture.size > deal.Size_ && price.IsPositive() {
+			duration := deal.EndBlock - deal.StartBlock
+			cost := price.MulInt64(int64(fixture.size - deal.Size_)).MulInt64(int64(duration)).Ceil().TruncateInt()
+			spent = spent.Add(sdk.NewCoin(sdk.DefaultBondDenom, cost))
+		}
+		if spendableAmount(ctx, bk, owner.Address).LT(spent.AmountOf(sdk.DefaultBondDenom)) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient funds for term deposit"), nil, nil
+		}
+
+		// Growth is charged to every provider holding the deal.
+		resized := deal
+		resized.Size_ = fixture.size
+		if from, to := keeper.DealStorageFootprint(deal), keeper.DealStorageFootprint(resized); to > from {
+			holders := append([]string{}, deal.Providers...)
+			for _, slot := range deal.Mode2Slots {
+				if slot != nil && slot.PendingProvider != "" {
+					holders = append(holders, slot.PendingProvider)
+				}
+			}
+			for _, addr := range holders {
+				provider, err := k.Providers.Get(ctx, addr)
+				if err == nil && keeper.ProviderFreeStorage(provider) < to-from {
+					return simtypes.NoOpMsg(types.ModuleName, msgType, "provider lacks storage for the content"), nil, nil
+				}
+			}
+		}
+
+		msg := &types.MsgUpdateDealContent{
+			Creator: owner.Address.String(),
+			DealId:  deal.Id,
+			Cid:     fixture.cid,
+			Size_:   fixture.size,
+		}
+		return deliver(r, app, ctx, ak, bk, txGen, owner, msg, spent)
+	}
+}
+
+// SimulateMsgAddCredit tops up a random deal's escrow from a random account.
+func SimulateMsgAddCredit(ak types.AuthKeeper, bk types.BankKeeper, k keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgAddCredit{})
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		deal, ok, err := randomDeal(r, ctx, k, func(types.Deal) bool { return true })
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to load deals"), nil, err
+		}
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no active deal"), nil, nil
+		}
+
+		amount := simtypes.RandomAmount(r, spendableAmount(ctx, bk, simAccount.Address))
+		if !amount.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no funds to add as credit"), nil, nil
+		}
+
+		msg := &types.MsgAddCredit{
+			Creator: simAccount.Address.String(),
+			DealId:  deal.Id,
+			Amount:  amount,
+		}
+		return deliver(r, app, ctx, ak, bk, txGen, simAccount, msg, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount)))
+	}
+}
+
+// SimulateMsgSignalSaturation has a provider of a random Mode 1 deal request
+// an extra hot stripe, within the deal's monthly spend cap and escrow.
+// Mode 2 deals keep Providers aligned with their slots and are not scaled this
+// way.
+func SimulateMsgSignalSaturation(ak types.AuthKeeper, bk types.BankKeeper, k keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSignalSaturation{})
+		params := k.GetParams(ctx)
+		height := uint64(ctx.BlockHeight())
+		cost := math.NewIntFromUint64(params.BaseStripeCost).Mul(math.NewIntFromUint64(types.DealBaseReplication))
+
+		deal, ok, err := randomDeal(r, ctx, k, func(deal types.Deal) bool {
+			if isMode2Deal(deal) || deal.EscrowBalance.LT(cost) {
+				return false
+			}
+			spent := deal.SpendWindowSpent
+			if spent.IsNil() || (params.MonthLenBlocks > 0 && height >= deal.SpendWindowStartHeight+params.MonthLenBlocks) {
+				spent = math.ZeroInt()
+			}
+			if spent.Add(cost).GT(deal.MaxMonthlySpend) {
+				return false
+			}
+			for _, p := range deal.Providers {
+				if _, found := findAccount(accs, p); found {
+					return true
+				}
+			}
+			return false
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to load deals"), nil, err
+		}
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no deal can afford another stripe"), nil, nil
+		}
+
+		derivedID := deal.Id + (deal.CurrentReplication * 1000)
+		if _, _, err := k.AssignProviders(ctx, derivedID, ctx.BlockHeader().LastBlockId.Hash, keeper.HotStripePlacementRequest(deal)); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no providers for a hot stripe"), nil, nil
+		}
+
+		var providers []simtypes.Account
+		for _, p := range deal.Providers {
+			if acc, found := findAccount(accs, p); found {
+				providers = append(providers, acc)
+			}
+		}
+		simAccount := providers[r.Intn(len(providers))]
+
+		msg := &types.MsgSignalSaturation{
+			Creator: simAccount.Address.String(),
+			DealId:  deal.Id,
+		}
+		return deliver(r, app, ctx, ak, bk, txGen, simAccount, msg, nil)
+	}
+}
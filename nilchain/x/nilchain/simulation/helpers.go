@@ -0,0 +1,124 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+// manifestFixture is committed content for a deal: a 48-byte manifest root and
+// the content size it describes.
+type manifestFixture struct {
+	cid  string
+	size uint64
+}
+
+// manifestFixtures are pre-generated manifest roots (compressed BLS12-381 G1
+// points) so UpdateDealContent operations commit well-formed content without
+// running the ingest pipeline. The first is the root of
+// testdata/mode2-artifacts-v1/fixture_k8m4_single.json.
+var manifestFixtures = []manifestFixture{
+	{cid: "0xb2792b2649a7273f48f19773a1e867e621f7ad8a9f7932b8384746141956adfad419b13b01dc0b4ebc5a456068beebf9", size: 3 * types.MDU_SIZE},
+	{cid: "0xb40a844abe69d2ed15600f6c91d747e4ea32c6951028d24209172dbc42a77179d13094bbea64382c2bc595b9845e422b", size: types.MDU_SIZE},
+	{cid: "0x98670ac3e97ba15b7cd368f60155d701bc92a0f6bef7da3481598650dd7fca54c41b8405bad7a955e73c67322a3be0d4", size: 4 * types.MDU_SIZE},
+	{cid: "0xa1fea477842692fdb439d78c5eb4d58ecb10fccf1aa9eff9ae0c52950bf610c3fdeee03e15ec9cc902a803d782244af4", size: 16 * types.BLOB_SIZE},
+	{cid: "0x91cdbf209404a819f6d43e7412543db4469f9c535f9fabcf716e88b7df44a86b06b3344f2947356ac56e23928b6086ed", size: 9 * types.MDU_SIZE},
+}
+
+// deliver signs msg as simAccount and delivers it, paying random fees out of
+// whatever the account has left after spent.
+func deliver(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	txGen client.TxConfig,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+	spent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           txGen,
+		Cdc:             nil,
+		Msg:             msg,
+		CoinsSpentInMsg: spent,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+	}
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// spendableAmount returns addr's spendable balance in the bond denom.
+func spendableAmount(ctx sdk.Context, bk types.BankKeeper, addr sdk.AccAddress) math.Int {
+	return bk.SpendableCoins(ctx, addr).AmountOf(sdk.DefaultBondDenom)
+}
+
+// randomDeal picks a random active deal accepted by filter, or reports false
+// if there is none.
+func randomDeal(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, filter func(types.Deal) bool) (types.Deal, bool, error) {
+	var deals []types.Deal
+	err := k.Deals.Walk(ctx, nil, func(_ uint64, deal types.Deal) (bool, error) {
+		if deal.Status == types.DealStatus_DEAL_STATUS_ACTIVE && filter(deal) {
+			deals = append(deals, deal)
+		}
+		return false, nil
+	})
+	if err != nil || len(deals) == 0 {
+		return types.Deal{}, false, err
+	}
+	return deals[r.Intn(len(deals))], true, nil
+}
+
+// randomOwnedDeal picks a random active deal accepted by filter whose owner is
+// a simulation account, and returns that account.
+func randomOwnedDeal(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, filter func(types.Deal) bool) (types.Deal, simtypes.Account, bool, error) {
+	var owner simtypes.Account
+	deal, ok, err := randomDeal(r, ctx, k, func(deal types.Deal) bool {
+		if !filter(deal) {
+			return false
+		}
+		_, found := findAccount(accs, deal.Owner)
+		return found
+	})
+	if !ok || err != nil {
+		return types.Deal{}, owner, false, err
+	}
+	owner, _ = findAccount(accs, deal.Owner)
+	return deal, owner, true, nil
+}
+
+// findAccount returns the simulation account with the given bech32 address.
+func findAccount(accs []simtypes.Account, address string) (simtypes.Account, bool) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return simtypes.Account{}, false
+	}
+	return simtypes.FindAccount(accs, addr)
+}
+
+func isMode2Deal(deal types.Deal) bool {
+	return deal.RedundancyMode == 2 && deal.Mode2Profile != nil && len(deal.Mode2Slots) > 0
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
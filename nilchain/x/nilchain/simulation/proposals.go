@@ -0,0 +1,48 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"nilchain/x/nilchain/types"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightMsgUpdateParams int = 100
+	OpWeightMsgUpdateParams          = "op_weight_msg_update_params"
+)
+
+// ProposalMsgs defines the module weighted proposals' contents
+func ProposalMsgs() []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams,
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a MsgUpdateParams with randomized retrieval
+// pricing, session retention, auto-repair cooldown and repair deadline.
+func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	// use the default gov module account address as authority
+	var authority sdk.AccAddress = address.Module("gov")
+
+	params := types.DefaultParams()
+	params.BaseRetrievalFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(r.Intn(5)))
+	params.RetrievalPricePerBlob = sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(r.Intn(5)))
+	params.RetrievalSessionRetentionBlocks = uint64(r.Intn(2000))
+	params.AutoRepairCooldownBlocks = uint64(r.Intn(200))
+	params.RepairDeadlineBlocks = 1 + uint64(r.Intn(200))
+
+	return &types.MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    params,
+	}
+}
@@ -0,0 +1,97 @@
+package simulation
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+var (
+	providerCapabilities = []string{"General", "Archive", "Edge"}
+	providerOperators    = []string{"", "", "op-a", "op-b", "op-c"}
+	providerRegions      = []string{"", "eu-west", "us-east", "ap-south", "sa-east"}
+)
+
+// SimulateMsgRegisterProvider registers a random account as a provider with a
+// random capacity, random failure-domain labels and exactly the required
+// bond.
+func SimulateMsgRegisterProvider(ak types.AuthKeeper, bk types.BankKeeper, k keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRegisterProvider{})
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		registered, err := k.Providers.Has(ctx, simAccount.Address.String())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to load provider"), nil, err
+		}
+		if registered {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "account is already a provider"), nil, nil
+		}
+
+		totalStorage := uint64(simtypes.RandIntBetween(r, 1, 65)) << 30
+		bond := keeper.RequiredProviderBond(k.GetParams(ctx), totalStorage)
+		if bk.SpendableCoins(ctx, simAccount.Address).AmountOf(bond.Denom).LT(bond.Amount) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient funds for provider bond"), nil, nil
+		}
+
+		msg := &types.MsgRegisterProvider{
+			Creator:      simAccount.Address.String(),
+			Capabilities: providerCapabilities[r.Intn(len(providerCapabilities))],
+			TotalStorage: totalStorage,
+			Endpoints:    []string{fmt.Sprintf("/ip4/127.0.0.1/tcp/%d/http", simtypes.RandIntBetween(r, 1024, 65536))},
+			Bond:         bond,
+			FailureDomain: types.ProviderFailureDomain{
+				OperatorId: providerOperators[r.Intn(len(providerOperators))],
+				Region:     providerRegions[r.Intn(len(providerRegions))],
+			},
+		}
+		return deliver(r, app, ctx, ak, bk, txGen, simAccount, msg, sdk.NewCoins(bond))
+	}
+}
+
+// SimulateMsgWithdrawRewards withdraws the unclaimed rewards of a random
+// provider that has any.
+func SimulateMsgWithdrawRewards(ak types.AuthKeeper, bk types.BankKeeper, k keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgWithdrawRewards{})
+
+		var candidates []simtypes.Account
+		for _, acc := range accs {
+			total := math.ZeroInt()
+			for _, ledger := range []collections.Map[string, math.Int]{k.ProviderRewards, k.ProviderBandwidthRewards} {
+				amount, err := ledger.Get(ctx, acc.Address.String())
+				if err != nil {
+					if errors.Is(err, collections.ErrNotFound) {
+						continue
+					}
+					return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to load provider rewards"), nil, err
+				}
+				if amount.IsPositive() {
+					total = total.Add(amount)
+				}
+			}
+			if total.IsPositive() {
+				candidates = append(candidates, acc)
+			}
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no provider has rewards to withdraw"), nil, nil
+		}
+
+		simAccount := candidates[r.Intn(len(candidates))]
+		msg := &types.MsgWithdrawRewards{Creator: simAccount.Address.String()}
+		return deliver(r, app, ctx, ak, bk, txGen, simAccount, msg, nil)
+	}
+}
@@ -0,0 +1,167 @@
+package simulation
+
+import (
+	"errors"
+	"math/rand"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+// SimulateMsgOpenRetrievalSession has the owner of a random deal with
+// committed content open a session against one of its providers. Mode 2
+// sessions stay within the provider's slot.
+func SimulateMsgOpenRetrievalSession(ak types.AuthKeeper, bk types.BankKeeper, k keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgOpenRetrievalSession{})
+
+		deal, owner, ok, err := randomOwnedDeal(r, ctx, k, accs, func(deal types.Deal) bool {
+			return len(deal.ManifestRoot) == 48 && len(deal.Providers) > 0
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to load deals"), nil, err
+		}
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no deal with content owned by a simulation account"), nil, nil
+		}
+
+		var provider string
+		var startBlob, blobCount uint64
+		if isMode2Deal(deal) {
+			rows := uint64(64 / deal.Mode2Profile.K)
+			slot := deal.Mode2Slots[r.Intn(len(deal.Mode2Slots))]
+			if slot == nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "nil mode2 slot"), nil, nil
+			}
+			provider = slot.Provider
+			offset := uint64(r.Intn(int(rows)))
+			startBlob = uint64(slot.Slot)*rows + offset
+			blobCount = uint64(simtypes.RandIntBetween(r, 1, int(rows-offset)+1))
+		} else {
+			provider = deal.Providers[r.Intn(len(deal.Providers))]
+			startBlob = uint64(r.Intn(types.BLOBS_PER_MDU))
+			blobCount = uint64(simtypes.RandIntBetween(r, 1, int(types.BLOBS_PER_MDU-startBlob)+1))
+		}
+
+		params := k.GetParams(ctx)
+		fee := params.BaseRetrievalFee.Amount
+		if params.RetrievalPricePerBlob.IsValid() && params.RetrievalPricePerBlob.Amount.IsPositive() {
+			fee = fee.Add(params.RetrievalPricePerBlob.Amount.Mul(math.NewIntFromUint64(blobCount)))
+		}
+		if deal.EscrowBalance.LT(fee) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "deal escrow cannot cover retrieval fees"), nil, nil
+		}
+
+		nonce, err := k.RetrievalSessionNonces.Get(ctx, collections.Join(collections.Join(owner.Address.String(), deal.Id), provider))
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to load session nonce"), nil, err
+		}
+
+		// Most sessions expire so the cancel and sweep paths see traffic.
+		var expiresAt uint64
+		if r.Intn(4) != 0 {
+			expiresAt = uint64(ctx.BlockHeight()) + uint64(simtypes.RandIntBetween(r, 1, 50))
+		}
+
+		msg := &types.MsgOpenRetrievalSession{
+			Creator:        owner.Address.String(),
+			DealId:         deal.Id,
+			Provider:       provider,
+			ManifestRoot:   deal.ManifestRoot,
+			StartMduIndex:  0,
+			StartBlobIndex: uint32(startBlob),
+			BlobCount:      blobCount,
+			Nonce:          nonce + 1,
+			ExpiresAt:      expiresAt,
+		}
+		return deliver(r, app, ctx, ak, bk, txGen, owner, msg, nil)
+	}
+}
+
+// SimulateMsgConfirmRetrievalSession has an owner confirm a random unexpired
+// session that is still waiting for the owner.
+func SimulateMsgConfirmRetrievalSession(ak types.AuthKeeper, bk types.BankKeeper, k keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgConfirmRetrievalSession{})
+		height := uint64(ctx.BlockHeight())
+
+		session, owner, ok, err := randomOwnedSession(r, ctx, k, accs, func(session types.RetrievalSession) bool {
+			if session.ExpiresAt != 0 && height > session.ExpiresAt {
+				return false
+			}
+			return session.Status == types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_OPEN ||
+				session.Status == types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_PROOF_SUBMITTED
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to load retrieval sessions"), nil, err
+		}
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no session awaiting confirmation"), nil, nil
+		}
+
+		msg := &types.MsgConfirmRetrievalSession{
+			Creator:   owner.Address.String(),
+			SessionId: session.SessionId,
+		}
+		return deliver(r, app, ctx, ak, bk, txGen, owner, msg, nil)
+	}
+}
+
+// SimulateMsgCancelRetrievalSession has an owner cancel a random expired
+// session that has not been completed or canceled.
+func SimulateMsgCancelRetrievalSession(ak types.AuthKeeper, bk types.BankKeeper, k keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCancelRetrievalSession{})
+		height := uint64(ctx.BlockHeight())
+
+		session, owner, ok, err := randomOwnedSession(r, ctx, k, accs, func(session types.RetrievalSession) bool {
+			if session.ExpiresAt == 0 || height <= session.ExpiresAt {
+				return false
+			}
+			return session.Status != types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_COMPLETED &&
+				session.Status != types.RetrievalSessionStatus_RETRIEVAL_SESSION_STATUS_CANCELED
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to load retrieval sessions"), nil, err
+		}
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no expired session to cancel"), nil, nil
+		}
+
+		msg := &types.MsgCancelRetrievalSession{
+			Creator:   owner.Address.String(),
+			SessionId: session.SessionId,
+		}
+		return deliver(r, app, ctx, ak, bk, txGen, owner, msg, nil)
+	}
+}
+
+// randomOwnedSession picks a random retrieval session accepted by filter whose
+// owner is a simulation account, and returns that account.
+func randomOwnedSession(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, filter func(types.RetrievalSession) bool) (types.RetrievalSession, simtypes.Account, bool, error) {
+	var sessions []types.RetrievalSession
+	err := k.RetrievalSessions.Walk(ctx, nil, func(_ []byte, session types.RetrievalSession) (bool, error) {
+		if filter(session) {
+			if _, found := findAccount(accs, session.Owner); found {
+				sessions = append(sessions, session)
+			}
+		}
+		return false, nil
+	})
+	if err != nil || len(sessions) == 0 {
+		return types.RetrievalSession{}, simtypes.Account{}, false, err
+	}
+	session := sessions[r.Intn(len(sessions))]
+	owner, _ := findAccount(accs, session.Owner)
+	return session, owner, true, nil
+}
@@ -0,0 +1,107 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"nilchain/x/nilchain/keeper"
+	"nilchain/x/nilchain/types"
+)
+
+// SimulateMsgStartSlotRepair has the owner of a random Mode 2 deal start
+// repairing an active slot towards an active provider outside the deal with
+// room for the slot.
+func SimulateMsgStartSlotRepair(ak types.AuthKeeper, bk types.BankKeeper, k keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgStartSlotRepair{})
+
+		deal, owner, ok, err := randomOwnedDeal(r, ctx, k, accs, isMode2Deal)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to load deals"), nil, err
+		}
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no Mode 2 deal owned by a simulation account"), nil, nil
+		}
+
+		slot := deal.Mode2Slots[r.Intn(len(deal.Mode2Slots))]
+		if slot == nil || slot.Status != types.SlotStatus_SLOT_STATUS_ACTIVE {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "slot is not active"), nil, nil
+		}
+
+		inDeal := append([]string{}, deal.Providers...)
+		for _, s := range deal.Mode2Slots {
+			if s != nil && s.PendingProvider != "" {
+				inDeal = append(inDeal, s.PendingProvider)
+			}
+		}
+		footprint := keeper.DealStorageFootprint(deal)
+		var candidates []string
+		err = k.Providers.Walk(ctx, nil, func(addr string, provider types.Provider) (bool, error) {
+			if provider.Status == "Active" && !containsString(inDeal, addr) && keeper.ProviderFreeStorage(provider) >= footprint {
+				candidates = append(candidates, addr)
+			}
+			return false, nil
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to load providers"), nil, err
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no provider available to repair towards"), nil, nil
+		}
+
+		msg := &types.MsgStartSlotRepair{
+			Creator:         owner.Address.String(),
+			DealId:          deal.Id,
+			Slot:            slot.Slot,
+			PendingProvider: candidates[r.Intn(len(candidates))],
+		}
+		return deliver(r, app, ctx, ak, bk, txGen, owner, msg, nil)
+	}
+}
+
+// SimulateMsgCompleteSlotRepair has the pending provider of a random Mode 2
+// slot repair complete it. Simulated deals carry no content, so the repair
+// challenge is empty and the message needs no proofs.
+func SimulateMsgCompleteSlotRepair(ak types.AuthKeeper, bk types.BankKeeper, k keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCompleteSlotRepair{})
+
+		repairing := func(deal types.Deal) []*types.DealSlot {
+			var slots []*types.DealSlot
+			for _, s := range deal.Mode2Slots {
+				if s == nil || s.Status != types.SlotStatus_SLOT_STATUS_REPAIRING {
+					continue
+				}
+				if _, found := findAccount(accs, s.PendingProvider); found {
+					slots = append(slots, s)
+				}
+			}
+			return slots
+		}
+		deal, ok, err := randomDeal(r, ctx, k, func(deal types.Deal) bool {
+			return isMode2Deal(deal) && deal.Size_ == 0 && len(repairing(deal)) > 0
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to load deals"), nil, err
+		}
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no slot repair in progress"), nil, nil
+		}
+
+		slots := repairing(deal)
+		slot := slots[r.Intn(len(slots))]
+		pending, _ := findAccount(accs, slot.PendingProvider)
+		msg := &types.MsgCompleteSlotRepair{
+			Creator: pending.Address.String(),
+			DealId:  deal.Id,
+			Slot:    slot.Slot,
+		}
+		return deliver(r, app, ctx, ak, bk, txGen, pending, msg, nil)
+	}
+}
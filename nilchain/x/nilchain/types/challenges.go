@@ -0,0 +1,71 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// Domain tags for unified liveness hashing (rfcs/rfc-challenge-derivation-and-quotas.md §3, §5).
+const (
+	EpochSeedTag          = "nilstore/epoch/v1"
+	ChallengeSeedTag      = "nilstore/chal/v1"
+	CreditIDTag           = "nilstore/credit/v1"
+	SyntheticChallengeTag = "nilstore/synthetic/v1"
+	RepairChallengeTag    = "nilstore/repair/v1"
+)
+
+func u64be(v uint64) []byte {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], v)
+	return buf[:]
+}
+
+func sha256Concat(tag string, parts ...[]byte) []byte {
+	h := sha256.New()
+	h.Write([]byte(tag))
+	for _, p := range parts {
+		h.Write(p)
+	}
+	return h.Sum(nil)
+}
+
+// HashEpochSeed computes the epoch randomness R_e:
+//
+//	SHA256("nilstore/epoch/v1" || chain_id || U64BE(epoch_id) || block_hash)
+func HashEpochSeed(chainID string, epochID uint64, blockHash []byte) []byte {
+	return sha256Concat(EpochSeedTag, []byte(chainID), u64be(epochID), blockHash)
+}
+
+// HashChallengeSeed computes the per-ordinal challenge seed:
+//
+//	SHA256("nilstore/chal/v1" || R_e || U64BE(deal_id) || U64BE(current_gen) || assignment || U64BE(i))
+//
+// assignment is U64BE(slot) for Mode 2 and ADDR20(provider) for Mode 1.
+func HashChallengeSeed(epochSeed []byte, dealID uint64, gen uint64, assignment []byte, ordinal uint64) []byte {
+	return sha256Concat(ChallengeSeedTag, epochSeed, u64be(dealID), u64be(gen), assignment, u64be(ordinal))
+}
+
+// HashRepairChallengeSeed computes the per-ordinal seed of a slot repair
+// challenge:
+//
+//	SHA256("nilstore/repair/v1" || R_e || U64BE(deal_id) || U64BE(current_gen) || U64BE(slot) || U64BE(i))
+func HashRepairChallengeSeed(epochSeed []byte, dealID uint64, gen uint64, slot uint64, ordinal uint64) []byte {
+	return sha256Concat(RepairChallengeTag, epochSeed, u64be(dealID), u64be(gen), u64be(slot), u64be(ordinal))
+}
+
+// HashCreditID computes the per-epoch uniqueness key for an organic credit:
+//
+//	SHA256("nilstore/credit/v1" || U64BE(epoch_id) || U64BE(deal_id) || assignment || U64BE(mdu_index) || U32BE(blob_index))
+func HashCreditID(epochID uint64, dealID uint64, assignment []byte, mduIndex uint64, blobIndex uint32) []byte {
+	var blob [4]byte
+	binary.BigEndian.PutUint32(blob[:], blobIndex)
+	return sha256Concat(CreditIDTag, u64be(epochID), u64be(dealID), assignment, u64be(mduIndex), blob[:])
+}
+
+// HashSyntheticChallengeID computes the per-epoch uniqueness key for a satisfied
+// synthetic challenge, using the same layout as HashCreditID under its own tag.
+func HashSyntheticChallengeID(epochID uint64, dealID uint64, assignment []byte, mduIndex uint64, blobIndex uint32) []byte {
+	var blob [4]byte
+	binary.BigEndian.PutUint32(blob[:], blobIndex)
+	return sha256Concat(SyntheticChallengeTag, u64be(epochID), u64be(dealID), assignment, u64be(mduIndex), blob[:])
+}
@@ -24,6 +24,22 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgCompleteSlotRepair{},
 		&MsgAddCredit{},
 		&MsgWithdrawRewards{},
+		&MsgTopUpProviderBond{},
+		&MsgUnbondProviderBond{},
+		&MsgUpdateProvider{},
+		&MsgSetProviderStatus{},
+		&MsgDeregisterProvider{},
+		&MsgExtendDeal{},
+		&MsgCloseDeal{},
+		&MsgCloseDealFromEvm{},
+		&MsgTransferDealOwnership{},
+		&MsgAcceptDealOwnership{},
+		&MsgTransferDealOwnershipFromEvm{},
+		&MsgGrantDealAccess{},
+		&MsgRevokeDealAccess{},
+		&MsgSubmitFraudProof{},
+		&MsgRegisterSessionKey{},
+		&MsgRevokeSessionKey{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
@@ -31,6 +31,12 @@ var (
 	// keccak256("UpdateContent(address creator,uint64 deal_id,string cid,uint64 size,uint64 nonce)")
 	UpdateContentTypeHash = crypto.Keccak256([]byte("UpdateContent(address creator,uint64 deal_id,string cid,uint64 size,uint64 nonce)"))
 
+	// keccak256("CloseDeal(address creator,uint64 deal_id,uint64 nonce)")
+	CloseDealTypeHash = crypto.Keccak256([]byte("CloseDeal(address creator,uint64 deal_id,uint64 nonce)"))
+
+	// keccak256("TransferDeal(address creator,uint64 deal_id,string new_owner,uint64 nonce)")
+	TransferDealTypeHash = crypto.Keccak256([]byte("TransferDeal(address creator,uint64 deal_id,string new_owner,uint64 nonce)"))
+
 	// keccak256("RetrievalReceipt(uint64 deal_id,uint64 epoch_id,string provider,uint64 bytes_served,uint64 nonce)")
 	RetrievalReceiptTypeHashV1 = crypto.Keccak256([]byte("RetrievalReceipt(uint64 deal_id,uint64 epoch_id,string provider,uint64 bytes_served,uint64 nonce)"))
 
@@ -100,6 +106,33 @@ func HashUpdateContent(intent *EvmUpdateContentIntent) (common.Hash, error) {
 	), nil
 }
 
+// HashCloseDeal computes the struct hash for a CloseDeal intent.
+// Fields: creator, deal_id, nonce
+func HashCloseDeal(intent *EvmCloseDealIntent) (common.Hash, error) {
+	creatorAddr := common.HexToAddress(intent.CreatorEvm)
+
+	return crypto.Keccak256Hash(
+		CloseDealTypeHash,
+		pad32(creatorAddr.Bytes()),
+		math.PaddedBigBytes(big.NewInt(int64(intent.DealId)), 32),
+		math.PaddedBigBytes(big.NewInt(int64(intent.Nonce)), 32),
+	), nil
+}
+
+// HashTransferDeal computes the struct hash for a TransferDeal intent.
+// Fields: creator, deal_id, new_owner, nonce
+func HashTransferDeal(intent *EvmTransferDealIntent) (common.Hash, error) {
+	creatorAddr := common.HexToAddress(intent.CreatorEvm)
+
+	return crypto.Keccak256Hash(
+		TransferDealTypeHash,
+		pad32(creatorAddr.Bytes()),
+		math.PaddedBigBytes(big.NewInt(int64(intent.DealId)), 32),
+		keccak256String(intent.NewOwner),
+		math.PaddedBigBytes(big.NewInt(int64(intent.Nonce)), 32),
+	), nil
+}
+
 // HashChainedProof computes a stable hash of the proof fields for binding signatures.
 // The encoding is deterministic and does not depend on JSON or protobuf serialization.
 func HashChainedProof(proof *ChainedProof) (common.Hash, error) {
@@ -8,6 +8,9 @@ import (
 
 // x/nilchain module sentinel errors
 var (
-	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidSigner         = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrNoReplacementProvider = errors.Register(ModuleName, 1101, "no replacement provider available")
+	ErrInsufficientCapacity  = errors.Register(ModuleName, 1102, "provider lacks storage capacity")
+	ErrFailureDomainLimit    = errors.Register(ModuleName, 1103, "not enough failure domains for placement")
 	
 )
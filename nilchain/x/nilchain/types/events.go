@@ -20,3 +20,112 @@ const (
 	AttributeKeyTier         = "tier"
 	AttributeKeyRewardAmount = "reward_amount"
 )
+
+// Provider bond events
+const (
+	TypeMsgTopUpProviderBond       = "top_up_provider_bond"
+	TypeMsgUnbondProviderBond      = "unbond_provider_bond"
+	TypeProviderSlashed            = "provider_slashed"
+	TypeProviderJailed             = "provider_jailed"
+	TypeProviderUnjailed           = "provider_unjailed"
+	TypeProviderUnbondingCompleted = "provider_unbonding_completed"
+
+	AttributeKeyAmount           = "amount"
+	AttributeKeyBond             = "bond"
+	AttributeKeyReason           = "reason"
+	AttributeKeyCompletionHeight = "completion_height"
+)
+
+// Provider lifecycle events
+const (
+	TypeMsgUpdateProvider          = "update_provider"
+	TypeMsgSetProviderStatus       = "set_provider_status"
+	TypeMsgDeregisterProvider      = "deregister_provider"
+	TypeProviderMigrationStarted   = "provider_migration_started"
+	TypeProviderMigrationCompleted = "provider_migration_completed"
+	TypeProviderDeregistered       = "provider_deregistered"
+
+	AttributeKeyStatus      = "status"
+	AttributeKeyEndpoints   = "endpoints"
+	AttributeKeySlot        = "slot"
+	AttributeKeyReplacement = "replacement"
+)
+
+// Deal lifecycle events
+const (
+	TypeMsgExtendDeal = "extend_deal"
+	TypeDealExpired   = "deal_expired"
+	TypeDealClosed    = "deal_closed"
+
+	TypeDealOwnershipProposed    = "deal_ownership_proposed"
+	TypeDealOwnershipTransferred = "deal_ownership_transferred"
+
+	AttributeKeyEndBlock        = "end_block"
+	AttributeKeyEscrowAdded     = "escrow_added"
+	AttributeKeyEscrowRefund    = "escrow_refund"
+	AttributeKeyEscrowBalance   = "escrow_balance"
+	AttributeKeyProviderPayout  = "provider_payout"
+	AttributeKeySessionsSettled = "sessions_settled"
+	AttributeKeySessionsMoved   = "sessions_moved"
+	AttributeKeyNewOwner        = "new_owner"
+	AttributeKeyPreviousOwner   = "previous_owner"
+)
+
+// Deal access grant events
+const (
+	TypeDealAccessGranted = "deal_access_granted"
+	TypeDealAccessRevoked = "deal_access_revoked"
+
+	AttributeKeyGrantee   = "grantee"
+	AttributeKeyExpiresAt = "expires_at"
+	AttributeKeyMaxBytes  = "max_bytes"
+	AttributeKeyMaxFee    = "max_fee"
+)
+
+// Retrieval session events
+const (
+	TypeRetrievalSessionExpired = "retrieval_session_expired"
+
+	AttributeKeySessionID      = "session_id"
+	AttributeKeyPreviousStatus = "previous_status"
+)
+
+// Mode 2 self-healing events
+const (
+	TypeSlotAutoRepairStarted = "slot_auto_repair_started"
+	TypeSlotRepairReassigned  = "slot_repair_reassigned"
+
+	AttributeKeyPendingProvider         = "pending_provider"
+	AttributeKeyPreviousPendingProvider = "previous_pending_provider"
+	AttributeKeyRepairTargetGen         = "repair_target_gen"
+	AttributeKeyRepairDeadlineHeight    = "repair_deadline_height"
+	AttributeKeyTriggerCount            = "trigger_count"
+	AttributeKeyBounty                  = "bounty"
+)
+
+// Fraud proof events
+const (
+	TypeFraudProofAccepted = "fraud_proof_accepted"
+
+	AttributeKeyReporter       = "reporter"
+	AttributeKeyResponseDigest = "response_digest"
+)
+
+// Evidence registry events
+const (
+	TypeEvidenceRecorded = "evidence_recorded"
+
+	AttributeKeyEvidenceID = "evidence_id"
+	AttributeKeyKind       = "kind"
+	AttributeKeyOutcome    = "outcome"
+)
+
+// Session key events
+const (
+	TypeSessionKeyRegistered = "session_key_registered"
+	TypeSessionKeyRevoked    = "session_key_revoked"
+	TypeSessionKeyExpired    = "session_key_expired"
+
+	AttributeKeySessionKey = "session_key"
+	AttributeKeyDealIDs    = "deal_ids"
+)
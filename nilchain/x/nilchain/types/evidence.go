@@ -0,0 +1,21 @@
+package types
+
+import "strings"
+
+// Reason is the kind's snake_case name, e.g. "retrieval_non_response", as
+// used in event attributes and slash reasons.
+func (k EvidenceKind) Reason() string {
+	return strings.ToLower(strings.TrimPrefix(k.String(), "EVIDENCE_KIND_"))
+}
+
+// IsFraud reports whether the kind proves that a provider served wrong data.
+func (k EvidenceKind) IsFraud() bool {
+	switch k {
+	case EvidenceKind_EVIDENCE_KIND_FRAUD_MANIFEST_ROOT_MISMATCH,
+		EvidenceKind_EVIDENCE_KIND_FRAUD_INVALID_PROOF,
+		EvidenceKind_EVIDENCE_KIND_FRAUD_DATA_MISMATCH:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,42 @@
+package types
+
+import "crypto/sha256"
+
+// BlobPayloadBytes is the number of raw payload bytes one blob holds: NilFS
+// packs 31 bytes into each 32-byte scalar.
+const BlobPayloadBytes = BLOB_SIZE / 32 * 31
+
+// ServedResponseTag is the domain tag of the digest a provider signs over a
+// response it serves.
+const ServedResponseTag = "nilstore/served/v1"
+
+// HashServedResponse computes the digest a provider signs over a response it
+// serves for a deal, binding the claimed manifest root, the triple proof and
+// the served bytes:
+//
+//	SHA256("nilstore/served/v1" || chain_id || U64BE(deal_id) || U64BE(generation) || provider || manifest_root || proof_hash || SHA256(data))
+//
+// proof_hash is HashChainedProof of the served proof. MsgSubmitFraudProof
+// checks the provider's signature against this digest.
+func HashServedResponse(chainID string, dealID uint64, generation uint64, provider string, manifestRoot []byte, proof *ChainedProof, data []byte) ([]byte, error) {
+	proofHash, err := HashChainedProof(proof)
+	if err != nil {
+		return nil, err
+	}
+	dataHash := sha256.Sum256(data)
+	return sha256Concat(ServedResponseTag, []byte(chainID), u64be(dealID), u64be(generation), []byte(provider), manifestRoot, proofHash.Bytes(), dataHash[:]), nil
+}
+
+// EncodeBlobPayload packs up to BlobPayloadBytes of raw payload into a blob the
+// way NilFS does: 31 bytes per 32-byte scalar, right-aligned, zero padded.
+func EncodeBlobPayload(payload []byte) []byte {
+	blob := make([]byte, BLOB_SIZE)
+	for scalar := 0; scalar*31 < len(payload) && scalar*32 < BLOB_SIZE; scalar++ {
+		chunk := payload[scalar*31:]
+		if len(chunk) > 31 {
+			chunk = chunk[:31]
+		}
+		copy(blob[(scalar+1)*32-len(chunk):(scalar+1)*32], chunk)
+	}
+	return blob
+}
@@ -1,14 +1,385 @@
 package types
 
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-	    Params:	DefaultParams(),
+		Params: DefaultParams(),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	proofIDs := make(map[uint64]struct{}, len(gs.Proofs))
+	for _, proof := range gs.Proofs {
+		if _, ok := proofIDs[proof.Id]; ok {
+			return fmt.Errorf("duplicate proof id %d", proof.Id)
+		}
+		if proof.Id >= gs.ProofCount {
+			return fmt.Errorf("proof id %d is not below proof_count %d", proof.Id, gs.ProofCount)
+		}
+		proofIDs[proof.Id] = struct{}{}
+	}
+
+	providers := make(map[string]Provider, len(gs.Providers))
+	for _, provider := range gs.Providers {
+		if strings.TrimSpace(provider.Address) == "" {
+			return fmt.Errorf("provider address is empty")
+		}
+		if _, ok := providers[provider.Address]; ok {
+			return fmt.Errorf("duplicate provider %s", provider.Address)
+		}
+		if provider.Bond.Denom != "" && !provider.Bond.IsValid() {
+			return fmt.Errorf("provider %s has invalid bond %s", provider.Address, provider.Bond)
+		}
+		providers[provider.Address] = provider
+	}
+	requireProvider := func(addr string, what string) error {
+		if _, ok := providers[addr]; !ok {
+			return fmt.Errorf("%s references unknown provider %q", what, addr)
+		}
+		return nil
+	}
+
+	deals := make(map[uint64]Deal, len(gs.Deals))
+	for _, deal := range gs.Deals {
+		if _, ok := deals[deal.Id]; ok {
+			return fmt.Errorf("duplicate deal id %d", deal.Id)
+		}
+		if deal.Id >= gs.DealCount {
+			return fmt.Errorf("deal id %d is not below deal_count %d", deal.Id, gs.DealCount)
+		}
+		if deal.EscrowBalance.IsNil() || deal.EscrowBalance.IsNegative() {
+			return fmt.Errorf("deal %d has invalid escrow balance", deal.Id)
+		}
+		if deal.PendingOwner != "" {
+			if _, err := sdk.AccAddressFromBech32(deal.PendingOwner); err != nil {
+				return fmt.Errorf("deal %d has invalid pending owner: %w", deal.Id, err)
+			}
+			if deal.PendingOwner == deal.Owner {
+				return fmt.Errorf("deal %d pending owner is already the owner", deal.Id)
+			}
+		}
+		// Ended deals keep their provider list as a GC record; those providers
+		// may since have left the network.
+		if deal.Status == DealStatus_DEAL_STATUS_EXPIRED || deal.Status == DealStatus_DEAL_STATUS_CLOSED {
+			deals[deal.Id] = deal
+			continue
+		}
+		for _, p := range deal.Providers {
+			if err := requireProvider(p, fmt.Sprintf("deal %d", deal.Id)); err != nil {
+				return err
+			}
+		}
+		for _, slot := range deal.Mode2Slots {
+			if slot == nil {
+				return fmt.Errorf("deal %d has a nil mode2 slot", deal.Id)
+			}
+			if err := requireProvider(slot.Provider, fmt.Sprintf("deal %d slot %d", deal.Id, slot.Slot)); err != nil {
+				return err
+			}
+			if slot.PendingProvider != "" {
+				if err := requireProvider(slot.PendingProvider, fmt.Sprintf("deal %d slot %d pending", deal.Id, slot.Slot)); err != nil {
+					return err
+				}
+			}
+		}
+		deals[deal.Id] = deal
+	}
+	requireDeal := func(id uint64, what string) error {
+		if _, ok := deals[id]; !ok {
+			return fmt.Errorf("%s references unknown deal %d", what, id)
+		}
+		return nil
+	}
+
+	for _, entry := range gs.DealProviderStatuses {
+		if err := requireDeal(entry.DealId, "deal provider status"); err != nil {
+			return err
+		}
+	}
+	for _, entry := range gs.DealProviderFailures {
+		if err := requireDeal(entry.DealId, "deal provider failure counter"); err != nil {
+			return err
+		}
+	}
+	for _, entry := range gs.DealProviderMissedProofs {
+		if err := requireDeal(entry.DealId, "deal provider missed proof counter"); err != nil {
+			return err
+		}
+	}
+	for _, entry := range gs.DealHeatStates {
+		if err := requireDeal(entry.DealId, "deal heat state"); err != nil {
+			return err
+		}
+	}
+	for _, entry := range gs.ProviderRewards {
+		if entry.Amount.IsNil() || entry.Amount.IsNegative() {
+			return fmt.Errorf("provider %s has invalid reward amount", entry.Provider)
+		}
+	}
+	for _, entry := range gs.ProviderBandwidthRewards {
+		if entry.Amount.IsNil() || entry.Amount.IsNegative() {
+			return fmt.Errorf("provider %s has invalid bandwidth reward amount", entry.Provider)
+		}
+	}
+
+	sessions := make(map[string]RetrievalSession, len(gs.RetrievalSessions))
+	for _, session := range gs.RetrievalSessions {
+		if len(session.SessionId) != 32 {
+			return fmt.Errorf("retrieval session id must be 32 bytes")
+		}
+		key := hex.EncodeToString(session.SessionId)
+		if _, ok := sessions[key]; ok {
+			return fmt.Errorf("duplicate retrieval session %s", key)
+		}
+		if err := requireDeal(session.DealId, "retrieval session "+key); err != nil {
+			return err
+		}
+		if session.LockedFee.IsNil() || session.LockedFee.IsNegative() {
+			return fmt.Errorf("retrieval session %s has invalid locked fee", key)
+		}
+		sessions[key] = session
+	}
+
+	validateSessionIndex := func(entries []RetrievalSessionIndexEntry, name string, addrOf func(RetrievalSession) string) error {
+		seen := make(map[string]struct{}, len(entries))
+		for _, entry := range entries {
+			key := hex.EncodeToString(entry.SessionId)
+			session, ok := sessions[key]
+			if !ok {
+				return fmt.Errorf("%s entry references unknown retrieval session %s", name, key)
+			}
+			if addrOf(session) != entry.Address {
+				return fmt.Errorf("%s entry for session %s has address %s, expected %s", name, key, entry.Address, addrOf(session))
+			}
+			if _, dup := seen[key]; dup {
+				return fmt.Errorf("duplicate %s entry for session %s", name, key)
+			}
+			seen[key] = struct{}{}
+		}
+		if len(seen) != len(sessions) {
+			return fmt.Errorf("%s has %d entries but there are %d retrieval sessions", name, len(seen), len(sessions))
+		}
+		return nil
+	}
+	if err := validateSessionIndex(gs.RetrievalSessionsByOwner, "retrieval_sessions_by_owner", func(s RetrievalSession) string { return s.Owner }); err != nil {
+		return err
+	}
+	if err := validateSessionIndex(gs.RetrievalSessionsByProvider, "retrieval_sessions_by_provider", func(s RetrievalSession) string { return s.Provider }); err != nil {
+		return err
+	}
+
+	for _, entry := range gs.RetrievalSessionNonces {
+		if err := requireDeal(entry.DealId, "retrieval session nonce"); err != nil {
+			return err
+		}
+	}
+
+	type dealProvider struct {
+		dealID   uint64
+		provider string
+	}
+	deadlines := make(map[dealProvider]struct{}, len(gs.ProofDeadlines))
+	for _, entry := range gs.ProofDeadlines {
+		if err := requireDeal(entry.DealId, "proof deadline"); err != nil {
+			return err
+		}
+		key := dealProvider{entry.DealId, entry.Provider}
+		if _, ok := deadlines[key]; ok {
+			return fmt.Errorf("duplicate proof deadline for deal %d provider %s", entry.DealId, entry.Provider)
+		}
+		deadlines[key] = struct{}{}
+	}
+
+	seeds := make(map[uint64]struct{}, len(gs.EpochSeeds))
+	for _, entry := range gs.EpochSeeds {
+		if len(entry.Seed) != 32 {
+			return fmt.Errorf("epoch %d seed must be 32 bytes", entry.EpochId)
+		}
+		if _, ok := seeds[entry.EpochId]; ok {
+			return fmt.Errorf("duplicate seed for epoch %d", entry.EpochId)
+		}
+		seeds[entry.EpochId] = struct{}{}
+	}
+
+	type epochAssignment struct {
+		epochID  uint64
+		dealID   uint64
+		provider string
+	}
+	quotaStates := make(map[epochAssignment]struct{}, len(gs.EpochQuotaStates))
+	for _, entry := range gs.EpochQuotaStates {
+		key := epochAssignment{entry.EpochId, entry.DealId, entry.Provider}
+		if _, ok := quotaStates[key]; ok {
+			return fmt.Errorf("duplicate quota state for epoch %d deal %d provider %s", entry.EpochId, entry.DealId, entry.Provider)
+		}
+		quotaStates[key] = struct{}{}
+	}
+
+	validateSeen := func(entries []EpochSeenEntry, name string) error {
+		seen := make(map[string]struct{}, len(entries))
+		for _, entry := range entries {
+			if len(entry.Id) != 32 {
+				return fmt.Errorf("%s id must be 32 bytes", name)
+			}
+			key := fmt.Sprintf("%d/%x", entry.EpochId, entry.Id)
+			if _, ok := seen[key]; ok {
+				return fmt.Errorf("duplicate %s entry %s", name, key)
+			}
+			seen[key] = struct{}{}
+		}
+		return nil
+	}
+	if err := validateSeen(gs.CreditSeen, "credit_seen"); err != nil {
+		return err
+	}
+	if err := validateSeen(gs.SyntheticSeen, "synthetic_seen"); err != nil {
+		return err
+	}
+	fraudSeen := make(map[string]struct{}, len(gs.FraudProofsSeen))
+	for _, digest := range gs.FraudProofsSeen {
+		if len(digest) != 32 {
+			return fmt.Errorf("fraud_proofs_seen digest must be 32 bytes")
+		}
+		if _, ok := fraudSeen[string(digest)]; ok {
+			return fmt.Errorf("duplicate fraud_proofs_seen digest %x", digest)
+		}
+		fraudSeen[string(digest)] = struct{}{}
+	}
+	evidenceIDs := make(map[uint64]struct{}, len(gs.Evidence))
+	for _, ev := range gs.Evidence {
+		if _, ok := evidenceIDs[ev.Id]; ok {
+			return fmt.Errorf("duplicate evidence id %d", ev.Id)
+		}
+		if ev.Id >= gs.EvidenceCount {
+			return fmt.Errorf("evidence id %d is not below evidence_count %d", ev.Id, gs.EvidenceCount)
+		}
+		if ev.Kind == EvidenceKind_EVIDENCE_KIND_UNSPECIFIED {
+			return fmt.Errorf("evidence %d has no kind", ev.Id)
+		}
+		if strings.TrimSpace(ev.Provider) == "" {
+			return fmt.Errorf("evidence %d has empty provider", ev.Id)
+		}
+		evidenceIDs[ev.Id] = struct{}{}
+	}
+
+	type providerHeight struct {
+		provider string
+		height   uint64
+	}
+	unbondings := make(map[providerHeight]struct{}, len(gs.ProviderUnbondings))
+	for _, entry := range gs.ProviderUnbondings {
+		if strings.TrimSpace(entry.Provider) == "" {
+			return fmt.Errorf("provider unbonding has empty provider")
+		}
+		if !entry.Amount.IsValid() || !entry.Amount.IsPositive() {
+			return fmt.Errorf("provider %s unbonding at %d has invalid amount %s", entry.Provider, entry.CompletionHeight, entry.Amount)
+		}
+		key := providerHeight{entry.Provider, entry.CompletionHeight}
+		if _, ok := unbondings[key]; ok {
+			return fmt.Errorf("duplicate unbonding for provider %s at height %d", entry.Provider, entry.CompletionHeight)
+		}
+		unbondings[key] = struct{}{}
+	}
+
+	type providerDeal struct {
+		provider string
+		dealID   uint64
+	}
+	migrations := make(map[providerDeal]struct{}, len(gs.ProviderMigrations))
+	for _, entry := range gs.ProviderMigrations {
+		if strings.TrimSpace(entry.Provider) == "" || strings.TrimSpace(entry.Replacement) == "" {
+			return fmt.Errorf("provider migration for deal %d has empty provider or replacement", entry.DealId)
+		}
+		if entry.Provider == entry.Replacement {
+			return fmt.Errorf("provider migration for deal %d replaces %s with itself", entry.DealId, entry.Provider)
+		}
+		key := providerDeal{entry.Provider, entry.DealId}
+		if _, ok := migrations[key]; ok {
+			return fmt.Errorf("duplicate migration for provider %s on deal %d", entry.Provider, entry.DealId)
+		}
+		migrations[key] = struct{}{}
+	}
+
+	type dealGrantee struct {
+		dealID  uint64
+		grantee string
+	}
+	grants := make(map[dealGrantee]struct{}, len(gs.DealAccessGrants))
+	for _, grant := range gs.DealAccessGrants {
+		if err := requireDeal(grant.DealId, "deal access grant"); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(grant.Grantee); err != nil {
+			return fmt.Errorf("deal %d access grant has invalid grantee: %w", grant.DealId, err)
+		}
+		if grant.Grantee == deals[grant.DealId].Owner {
+			return fmt.Errorf("deal %d access grant is held by the owner", grant.DealId)
+		}
+		if grant.MaxFee.IsNil() || grant.MaxFee.IsNegative() || grant.FeesUsed.IsNil() || grant.FeesUsed.IsNegative() {
+			return fmt.Errorf("deal %d access grant for %s has invalid fee accounting", grant.DealId, grant.Grantee)
+		}
+		key := dealGrantee{grant.DealId, grant.Grantee}
+		if _, ok := grants[key]; ok {
+			return fmt.Errorf("duplicate access grant for %s on deal %d", grant.Grantee, grant.DealId)
+		}
+		grants[key] = struct{}{}
+	}
+
+	placements := make(map[uint64]struct{}, len(gs.DealPlacements))
+	for _, placement := range gs.DealPlacements {
+		if err := requireDeal(placement.DealId, "deal placement"); err != nil {
+			return err
+		}
+		if _, ok := placements[placement.DealId]; ok {
+			return fmt.Errorf("duplicate placement for deal %d", placement.DealId)
+		}
+		placements[placement.DealId] = struct{}{}
+	}
+
+	sessionKeys := make(map[string]struct{}, len(gs.SessionKeys))
+	for _, key := range gs.SessionKeys {
+		if _, err := sdk.AccAddressFromBech32(key.Key); err != nil {
+			return fmt.Errorf("session key has invalid address: %w", err)
+		}
+		if _, err := sdk.AccAddressFromBech32(key.Owner); err != nil {
+			return fmt.Errorf("session key %s has invalid owner: %w", key.Key, err)
+		}
+		if key.Key == key.Owner {
+			return fmt.Errorf("session key %s is its own owner", key.Key)
+		}
+		if len(key.DealIds) == 0 || len(key.DealIds) > MaxSessionKeyDeals {
+			return fmt.Errorf("session key %s is bound to %d deals", key.Key, len(key.DealIds))
+		}
+		for _, dealID := range key.DealIds {
+			if err := requireDeal(dealID, "session key "+key.Key); err != nil {
+				return err
+			}
+		}
+		if key.ExpiresAt == 0 {
+			return fmt.Errorf("session key %s has no expiry", key.Key)
+		}
+		if key.MaxFee.IsNil() || key.MaxFee.IsNegative() || key.FeesUsed.IsNil() || key.FeesUsed.IsNegative() {
+			return fmt.Errorf("session key %s has invalid fee accounting", key.Key)
+		}
+		if _, ok := sessionKeys[key.Key]; ok {
+			return fmt.Errorf("duplicate session key %s", key.Key)
+		}
+		sessionKeys[key.Key] = struct{}{}
+	}
+
+	return nil
 }
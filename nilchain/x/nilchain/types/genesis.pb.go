@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -28,6 +30,38 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// Sequences (next id to hand out).
+	DealCount                   uint64                       `protobuf:"varint,2,opt,name=deal_count,json=dealCount,proto3" json:"deal_count,omitempty"`
+	ProofCount                  uint64                       `protobuf:"varint,3,opt,name=proof_count,json=proofCount,proto3" json:"proof_count,omitempty"`
+	Proofs                      []Proof                      `protobuf:"bytes,4,rep,name=proofs,proto3" json:"proofs"`
+	Deals                       []Deal                       `protobuf:"bytes,5,rep,name=deals,proto3" json:"deals"`
+	Providers                   []Provider                   `protobuf:"bytes,6,rep,name=providers,proto3" json:"providers"`
+	DealProviderStatuses        []DealProviderCounter        `protobuf:"bytes,7,rep,name=deal_provider_statuses,json=dealProviderStatuses,proto3" json:"deal_provider_statuses"`
+	DealProviderFailures        []DealProviderCounter        `protobuf:"bytes,8,rep,name=deal_provider_failures,json=dealProviderFailures,proto3" json:"deal_provider_failures"`
+	ProviderRewards             []ProviderRewardEntry        `protobuf:"bytes,9,rep,name=provider_rewards,json=providerRewards,proto3" json:"provider_rewards"`
+	ReceiptNonces               []ReceiptNonceEntry          `protobuf:"bytes,10,rep,name=receipt_nonces,json=receiptNonces,proto3" json:"receipt_nonces"`
+	ReceiptNoncesByDealFile     []DealFileReceiptNonce       `protobuf:"bytes,11,rep,name=receipt_nonces_by_deal_file,json=receiptNoncesByDealFile,proto3" json:"receipt_nonces_by_deal_file"`
+	EvmNonces                   []EvmNonceEntry              `protobuf:"bytes,12,rep,name=evm_nonces,json=evmNonces,proto3" json:"evm_nonces"`
+	DealHeatStates              []DealHeatStateEntry         `protobuf:"bytes,13,rep,name=deal_heat_states,json=dealHeatStates,proto3" json:"deal_heat_states"`
+	RetrievalSessions           []RetrievalSession           `protobuf:"bytes,14,rep,name=retrieval_sessions,json=retrievalSessions,proto3" json:"retrieval_sessions"`
+	RetrievalSessionsByOwner    []RetrievalSessionIndexEntry `protobuf:"bytes,15,rep,name=retrieval_sessions_by_owner,json=retrievalSessionsByOwner,proto3" json:"retrieval_sessions_by_owner"`
+	RetrievalSessionsByProvider []RetrievalSessionIndexEntry `protobuf:"bytes,16,rep,name=retrieval_sessions_by_provider,json=retrievalSessionsByProvider,proto3" json:"retrieval_sessions_by_provider"`
+	RetrievalSessionNonces      []RetrievalSessionNonceEntry `protobuf:"bytes,17,rep,name=retrieval_session_nonces,json=retrievalSessionNonces,proto3" json:"retrieval_session_nonces"`
+	ProofDeadlines              []ProofDeadlineEntry         `protobuf:"bytes,18,rep,name=proof_deadlines,json=proofDeadlines,proto3" json:"proof_deadlines"`
+	EpochSeeds                  []EpochSeedEntry             `protobuf:"bytes,19,rep,name=epoch_seeds,json=epochSeeds,proto3" json:"epoch_seeds"`
+	EpochQuotaStates            []EpochQuotaStateEntry       `protobuf:"bytes,20,rep,name=epoch_quota_states,json=epochQuotaStates,proto3" json:"epoch_quota_states"`
+	CreditSeen                  []EpochSeenEntry             `protobuf:"bytes,21,rep,name=credit_seen,json=creditSeen,proto3" json:"credit_seen"`
+	SyntheticSeen               []EpochSeenEntry             `protobuf:"bytes,22,rep,name=synthetic_seen,json=syntheticSeen,proto3" json:"synthetic_seen"`
+	ProviderUnbondings          []ProviderUnbonding          `protobuf:"bytes,23,rep,name=provider_unbondings,json=providerUnbondings,proto3" json:"provider_unbondings"`
+	ProviderMigrations          []ProviderMigration          `protobuf:"bytes,24,rep,name=provider_migrations,json=providerMigrations,proto3" json:"provider_migrations"`
+	DealAccessGrants            []DealAccessGrant            `protobuf:"bytes,25,rep,name=deal_access_grants,json=dealAccessGrants,proto3" json:"deal_access_grants"`
+	ProviderBandwidthRewards    []ProviderRewardEntry        `protobuf:"bytes,26,rep,name=provider_bandwidth_rewards,json=providerBandwidthRewards,proto3" json:"provider_bandwidth_rewards"`
+	DealPlacements              []DealPlacement              `protobuf:"bytes,27,rep,name=deal_placements,json=dealPlacements,proto3" json:"deal_placements"`
+	DealProviderMissedProofs    []DealProviderCounter        `protobuf:"bytes,28,rep,name=deal_provider_missed_proofs,json=dealProviderMissedProofs,proto3" json:"deal_provider_missed_proofs"`
+	FraudProofsSeen             [][]byte                     `protobuf:"bytes,29,rep,name=fraud_proofs_seen,json=fraudProofsSeen,proto3" json:"fraud_proofs_seen,omitempty"`
+	EvidenceCount               uint64                       `protobuf:"varint,30,opt,name=evidence_count,json=evidenceCount,proto3" json:"evidence_count,omitempty"`
+	Evidence                    []Evidence                   `protobuf:"bytes,31,rep,name=evidence,proto3" json:"evidence"`
+	SessionKeys                 []SessionKey                 `protobuf:"bytes,32,rep,name=session_keys,json=sessionKeys,proto3" json:"session_keys"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,92 +104,4655 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*GenesisState)(nil), "nilchain.nilchain.v1.GenesisState")
+func (m *GenesisState) GetDealCount() uint64 {
+	if m != nil {
+		return m.DealCount
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("nilchain/nilchain/v1/genesis.proto", fileDescriptor_f71e09b4f0c35255)
+func (m *GenesisState) GetProofCount() uint64 {
+	if m != nil {
+		return m.ProofCount
+	}
+	return 0
 }
 
-var fileDescriptor_f71e09b4f0c35255 = []byte{
-	// 189 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xca, 0xcb, 0xcc, 0x49,
-	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x87, 0x33, 0xca, 0x0c, 0xf5, 0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33,
-	0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0x60, 0x52, 0x7a, 0x70, 0x46, 0x99, 0xa1,
-	0x94, 0x60, 0x62, 0x6e, 0x66, 0x5e, 0xbe, 0x3e, 0x98, 0x84, 0x28, 0x94, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x22, 0x56, 0x2b, 0x0a, 0x12, 0x8b, 0x12, 0x73,
-	0xa1, 0x36, 0x28, 0xf9, 0x73, 0xf1, 0xb8, 0x43, 0xac, 0x0c, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0xb2,
-	0xe7, 0x62, 0x83, 0xc8, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xc9, 0xe8, 0x61, 0x73, 0x82,
-	0x5e, 0x00, 0x58, 0x8d, 0x13, 0xe7, 0x89, 0x7b, 0xf2, 0x0c, 0x2b, 0x9e, 0x6f, 0xd0, 0x62, 0x0c,
-	0x82, 0x6a, 0x73, 0x32, 0x3e, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4,
-	0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x49,
-	0xb8, 0x23, 0x2a, 0x10, 0xee, 0x29, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x3b, 0xc6, 0x18,
-	0x10, 0x00, 0x00, 0xff, 0xff, 0x30, 0xf8, 0x16, 0xaf, 0x14, 0x01, 0x00, 0x00,
+func (m *GenesisState) GetProofs() []Proof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GenesisState) GetDeals() []Deal {
+	if m != nil {
+		return m.Deals
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GenesisState) GetProviders() []Provider {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *GenesisState) GetDealProviderStatuses() []DealProviderCounter {
+	if m != nil {
+		return m.DealProviderStatuses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDealProviderFailures() []DealProviderCounter {
+	if m != nil {
+		return m.DealProviderFailures
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProviderRewards() []ProviderRewardEntry {
+	if m != nil {
+		return m.ProviderRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReceiptNonces() []ReceiptNonceEntry {
+	if m != nil {
+		return m.ReceiptNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReceiptNoncesByDealFile() []DealFileReceiptNonce {
+	if m != nil {
+		return m.ReceiptNoncesByDealFile
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEvmNonces() []EvmNonceEntry {
+	if m != nil {
+		return m.EvmNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDealHeatStates() []DealHeatStateEntry {
+	if m != nil {
+		return m.DealHeatStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRetrievalSessions() []RetrievalSession {
+	if m != nil {
+		return m.RetrievalSessions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRetrievalSessionsByOwner() []RetrievalSessionIndexEntry {
+	if m != nil {
+		return m.RetrievalSessionsByOwner
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRetrievalSessionsByProvider() []RetrievalSessionIndexEntry {
+	if m != nil {
+		return m.RetrievalSessionsByProvider
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRetrievalSessionNonces() []RetrievalSessionNonceEntry {
+	if m != nil {
+		return m.RetrievalSessionNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProofDeadlines() []ProofDeadlineEntry {
+	if m != nil {
+		return m.ProofDeadlines
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochSeeds() []EpochSeedEntry {
+	if m != nil {
+		return m.EpochSeeds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochQuotaStates() []EpochQuotaStateEntry {
+	if m != nil {
+		return m.EpochQuotaStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCreditSeen() []EpochSeenEntry {
+	if m != nil {
+		return m.CreditSeen
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSyntheticSeen() []EpochSeenEntry {
+	if m != nil {
+		return m.SyntheticSeen
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProviderUnbondings() []ProviderUnbonding {
+	if m != nil {
+		return m.ProviderUnbondings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProviderMigrations() []ProviderMigration {
+	if m != nil {
+		return m.ProviderMigrations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDealAccessGrants() []DealAccessGrant {
+	if m != nil {
+		return m.DealAccessGrants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProviderBandwidthRewards() []ProviderRewardEntry {
+	if m != nil {
+		return m.ProviderBandwidthRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDealPlacements() []DealPlacement {
+	if m != nil {
+		return m.DealPlacements
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDealProviderMissedProofs() []DealProviderCounter {
+	if m != nil {
+		return m.DealProviderMissedProofs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFraudProofsSeen() [][]byte {
+	if m != nil {
+		return m.FraudProofsSeen
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEvidenceCount() uint64 {
+	if m != nil {
+		return m.EvidenceCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEvidence() []Evidence {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSessionKeys() []SessionKey {
+	if m != nil {
+		return m.SessionKeys
+	}
+	return nil
+}
+
+// DealProviderCounter is a (deal_id, provider) -> uint64 genesis entry.
+type DealProviderCounter struct {
+	DealId   uint64 `protobuf:"varint,1,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Value    uint64 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *DealProviderCounter) Reset()         { *m = DealProviderCounter{} }
+func (m *DealProviderCounter) String() string { return proto.CompactTextString(m) }
+func (*DealProviderCounter) ProtoMessage()    {}
+func (*DealProviderCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71e09b4f0c35255, []int{1}
+}
+func (m *DealProviderCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DealProviderCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DealProviderCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *DealProviderCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DealProviderCounter.Merge(m, src)
+}
+func (m *DealProviderCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *DealProviderCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DealProviderCounter.DiscardUnknown(m)
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_DealProviderCounter proto.InternalMessageInfo
+
+func (m *DealProviderCounter) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
+	}
+	return 0
+}
+
+func (m *DealProviderCounter) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *DealProviderCounter) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+// ProviderRewardEntry is an unclaimed reward balance for a provider.
+type ProviderRewardEntry struct {
+	Provider string                `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Amount   cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *ProviderRewardEntry) Reset()         { *m = ProviderRewardEntry{} }
+func (m *ProviderRewardEntry) String() string { return proto.CompactTextString(m) }
+func (*ProviderRewardEntry) ProtoMessage()    {}
+func (*ProviderRewardEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71e09b4f0c35255, []int{2}
+}
+func (m *ProviderRewardEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderRewardEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderRewardEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderRewardEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderRewardEntry.Merge(m, src)
+}
+func (m *ProviderRewardEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderRewardEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderRewardEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderRewardEntry proto.InternalMessageInfo
+
+func (m *ProviderRewardEntry) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+// ReceiptNonceEntry is a legacy per-key receipt nonce.
+type ReceiptNonceEntry struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *ReceiptNonceEntry) Reset()         { *m = ReceiptNonceEntry{} }
+func (m *ReceiptNonceEntry) String() string { return proto.CompactTextString(m) }
+func (*ReceiptNonceEntry) ProtoMessage()    {}
+func (*ReceiptNonceEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71e09b4f0c35255, []int{3}
+}
+func (m *ReceiptNonceEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiptNonceEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReceiptNonceEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReceiptNonceEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptNonceEntry.Merge(m, src)
+}
+func (m *ReceiptNonceEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiptNonceEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptNonceEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptNonceEntry proto.InternalMessageInfo
+
+func (m *ReceiptNonceEntry) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ReceiptNonceEntry) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// DealFileReceiptNonce is the last accepted receipt nonce for a (deal_id, file_path).
+type DealFileReceiptNonce struct {
+	DealId   uint64 `protobuf:"varint,1,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	FilePath string `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	Nonce    uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *DealFileReceiptNonce) Reset()         { *m = DealFileReceiptNonce{} }
+func (m *DealFileReceiptNonce) String() string { return proto.CompactTextString(m) }
+func (*DealFileReceiptNonce) ProtoMessage()    {}
+func (*DealFileReceiptNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71e09b4f0c35255, []int{4}
+}
+func (m *DealFileReceiptNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DealFileReceiptNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DealFileReceiptNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DealFileReceiptNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DealFileReceiptNonce.Merge(m, src)
+}
+func (m *DealFileReceiptNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *DealFileReceiptNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_DealFileReceiptNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DealFileReceiptNonce proto.InternalMessageInfo
+
+func (m *DealFileReceiptNonce) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
+	}
+	return 0
+}
+
+func (m *DealFileReceiptNonce) GetFilePath() string {
+	if m != nil {
+		return m.FilePath
+	}
+	return ""
+}
+
+func (m *DealFileReceiptNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// EvmNonceEntry is the last accepted bridge nonce for an EVM address.
+type EvmNonceEntry struct {
+	EvmAddress string `protobuf:"bytes,1,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
+	Nonce      uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *EvmNonceEntry) Reset()         { *m = EvmNonceEntry{} }
+func (m *EvmNonceEntry) String() string { return proto.CompactTextString(m) }
+func (*EvmNonceEntry) ProtoMessage()    {}
+func (*EvmNonceEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71e09b4f0c35255, []int{5}
+}
+func (m *EvmNonceEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvmNonceEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvmNonceEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvmNonceEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmNonceEntry.Merge(m, src)
+}
+func (m *EvmNonceEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvmNonceEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmNonceEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmNonceEntry proto.InternalMessageInfo
+
+func (m *EvmNonceEntry) GetEvmAddress() string {
+	if m != nil {
+		return m.EvmAddress
+	}
+	return ""
+}
+
+func (m *EvmNonceEntry) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// DealHeatStateEntry pairs a deal id with its heat state.
+type DealHeatStateEntry struct {
+	DealId uint64        `protobuf:"varint,1,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	Heat   DealHeatState `protobuf:"bytes,2,opt,name=heat,proto3" json:"heat"`
+}
+
+func (m *DealHeatStateEntry) Reset()         { *m = DealHeatStateEntry{} }
+func (m *DealHeatStateEntry) String() string { return proto.CompactTextString(m) }
+func (*DealHeatStateEntry) ProtoMessage()    {}
+func (*DealHeatStateEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71e09b4f0c35255, []int{6}
+}
+func (m *DealHeatStateEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DealHeatStateEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DealHeatStateEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DealHeatStateEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DealHeatStateEntry.Merge(m, src)
+}
+func (m *DealHeatStateEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *DealHeatStateEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DealHeatStateEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DealHeatStateEntry proto.InternalMessageInfo
+
+func (m *DealHeatStateEntry) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
+	}
+	return 0
+}
+
+func (m *DealHeatStateEntry) GetHeat() DealHeatState {
+	if m != nil {
+		return m.Heat
+	}
+	return DealHeatState{}
+}
+
+// RetrievalSessionIndexEntry is an (address, session_id) -> height index entry.
+type RetrievalSessionIndexEntry struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	SessionId []byte `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Height    uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *RetrievalSessionIndexEntry) Reset()         { *m = RetrievalSessionIndexEntry{} }
+func (m *RetrievalSessionIndexEntry) String() string { return proto.CompactTextString(m) }
+func (*RetrievalSessionIndexEntry) ProtoMessage()    {}
+func (*RetrievalSessionIndexEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71e09b4f0c35255, []int{7}
+}
+func (m *RetrievalSessionIndexEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetrievalSessionIndexEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetrievalSessionIndexEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetrievalSessionIndexEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetrievalSessionIndexEntry.Merge(m, src)
+}
+func (m *RetrievalSessionIndexEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetrievalSessionIndexEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetrievalSessionIndexEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetrievalSessionIndexEntry proto.InternalMessageInfo
+
+func (m *RetrievalSessionIndexEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RetrievalSessionIndexEntry) GetSessionId() []byte {
+	if m != nil {
+		return m.SessionId
+	}
+	return nil
+}
+
+func (m *RetrievalSessionIndexEntry) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// RetrievalSessionNonceEntry is the last retrieval session nonce for (owner, deal_id, provider).
+type RetrievalSessionNonceEntry struct {
+	Owner    string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	DealId   uint64 `protobuf:"varint,2,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	Provider string `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	Nonce    uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *RetrievalSessionNonceEntry) Reset()         { *m = RetrievalSessionNonceEntry{} }
+func (m *RetrievalSessionNonceEntry) String() string { return proto.CompactTextString(m) }
+func (*RetrievalSessionNonceEntry) ProtoMessage()    {}
+func (*RetrievalSessionNonceEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71e09b4f0c35255, []int{8}
+}
+func (m *RetrievalSessionNonceEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetrievalSessionNonceEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetrievalSessionNonceEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetrievalSessionNonceEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetrievalSessionNonceEntry.Merge(m, src)
+}
+func (m *RetrievalSessionNonceEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetrievalSessionNonceEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetrievalSessionNonceEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetrievalSessionNonceEntry proto.InternalMessageInfo
+
+func (m *RetrievalSessionNonceEntry) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *RetrievalSessionNonceEntry) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
+	}
+	return 0
+}
+
+func (m *RetrievalSessionNonceEntry) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *RetrievalSessionNonceEntry) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// ProofDeadlineEntry is the next height at which a provider is slashed if it
+// has not submitted a proof for the deal.
+type ProofDeadlineEntry struct {
+	DeadlineHeight uint64 `protobuf:"varint,1,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty"`
+	DealId         uint64 `protobuf:"varint,2,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	Provider       string `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+}
+
+func (m *ProofDeadlineEntry) Reset()         { *m = ProofDeadlineEntry{} }
+func (m *ProofDeadlineEntry) String() string { return proto.CompactTextString(m) }
+func (*ProofDeadlineEntry) ProtoMessage()    {}
+func (*ProofDeadlineEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71e09b4f0c35255, []int{9}
+}
+func (m *ProofDeadlineEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofDeadlineEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProofDeadlineEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProofDeadlineEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofDeadlineEntry.Merge(m, src)
+}
+func (m *ProofDeadlineEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofDeadlineEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofDeadlineEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofDeadlineEntry proto.InternalMessageInfo
+
+func (m *ProofDeadlineEntry) GetDeadlineHeight() uint64 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
+func (m *ProofDeadlineEntry) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
+	}
+	return 0
+}
+
+func (m *ProofDeadlineEntry) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+// EpochSeedEntry is the recorded randomness R_e for a liveness epoch.
+type EpochSeedEntry struct {
+	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	Seed    []byte `protobuf:"bytes,2,opt,name=seed,proto3" json:"seed,omitempty"`
+}
+
+func (m *EpochSeedEntry) Reset()         { *m = EpochSeedEntry{} }
+func (m *EpochSeedEntry) String() string { return proto.CompactTextString(m) }
+func (*EpochSeedEntry) ProtoMessage()    {}
+func (*EpochSeedEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71e09b4f0c35255, []int{10}
+}
+func (m *EpochSeedEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochSeedEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochSeedEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochSeedEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochSeedEntry.Merge(m, src)
+}
+func (m *EpochSeedEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochSeedEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochSeedEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochSeedEntry proto.InternalMessageInfo
+
+func (m *EpochSeedEntry) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
+	}
+	return 0
+}
+
+func (m *EpochSeedEntry) GetSeed() []byte {
+	if m != nil {
+		return m.Seed
+	}
+	return nil
+}
+
+// EpochQuotaStateEntry is the quota accounting for an (epoch, deal, provider) assignment.
+type EpochQuotaStateEntry struct {
+	EpochId  uint64          `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	DealId   uint64          `protobuf:"varint,2,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	Provider string          `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	State    EpochQuotaState `protobuf:"bytes,4,opt,name=state,proto3" json:"state"`
+}
+
+func (m *EpochQuotaStateEntry) Reset()         { *m = EpochQuotaStateEntry{} }
+func (m *EpochQuotaStateEntry) String() string { return proto.CompactTextString(m) }
+func (*EpochQuotaStateEntry) ProtoMessage()    {}
+func (*EpochQuotaStateEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71e09b4f0c35255, []int{11}
+}
+func (m *EpochQuotaStateEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochQuotaStateEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochQuotaStateEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochQuotaStateEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochQuotaStateEntry.Merge(m, src)
+}
+func (m *EpochQuotaStateEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochQuotaStateEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochQuotaStateEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochQuotaStateEntry proto.InternalMessageInfo
+
+func (m *EpochQuotaStateEntry) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
+	}
+	return 0
+}
+
+func (m *EpochQuotaStateEntry) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
+	}
+	return 0
+}
+
+func (m *EpochQuotaStateEntry) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *EpochQuotaStateEntry) GetState() EpochQuotaState {
+	if m != nil {
+		return m.State
+	}
+	return EpochQuotaState{}
+}
+
+// EpochSeenEntry is a replay-protection id (credit_id or challenge_id) recorded in an epoch.
+type EpochSeenEntry struct {
+	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	Id      []byte `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *EpochSeenEntry) Reset()         { *m = EpochSeenEntry{} }
+func (m *EpochSeenEntry) String() string { return proto.CompactTextString(m) }
+func (*EpochSeenEntry) ProtoMessage()    {}
+func (*EpochSeenEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71e09b4f0c35255, []int{12}
+}
+func (m *EpochSeenEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochSeenEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochSeenEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochSeenEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochSeenEntry.Merge(m, src)
+}
+func (m *EpochSeenEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochSeenEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochSeenEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochSeenEntry proto.InternalMessageInfo
+
+func (m *EpochSeenEntry) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
+	}
+	return 0
+}
+
+func (m *EpochSeenEntry) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "nilchain.nilchain.v1.GenesisState")
+	proto.RegisterType((*DealProviderCounter)(nil), "nilchain.nilchain.v1.DealProviderCounter")
+	proto.RegisterType((*ProviderRewardEntry)(nil), "nilchain.nilchain.v1.ProviderRewardEntry")
+	proto.RegisterType((*ReceiptNonceEntry)(nil), "nilchain.nilchain.v1.ReceiptNonceEntry")
+	proto.RegisterType((*DealFileReceiptNonce)(nil), "nilchain.nilchain.v1.DealFileReceiptNonce")
+	proto.RegisterType((*EvmNonceEntry)(nil), "nilchain.nilchain.v1.EvmNonceEntry")
+	proto.RegisterType((*DealHeatStateEntry)(nil), "nilchain.nilchain.v1.DealHeatStateEntry")
+	proto.RegisterType((*RetrievalSessionIndexEntry)(nil), "nilchain.nilchain.v1.RetrievalSessionIndexEntry")
+	proto.RegisterType((*RetrievalSessionNonceEntry)(nil), "nilchain.nilchain.v1.RetrievalSessionNonceEntry")
+	proto.RegisterType((*ProofDeadlineEntry)(nil), "nilchain.nilchain.v1.ProofDeadlineEntry")
+	proto.RegisterType((*EpochSeedEntry)(nil), "nilchain.nilchain.v1.EpochSeedEntry")
+	proto.RegisterType((*EpochQuotaStateEntry)(nil), "nilchain.nilchain.v1.EpochQuotaStateEntry")
+	proto.RegisterType((*EpochSeenEntry)(nil), "nilchain.nilchain.v1.EpochSeenEntry")
+}
+
+func init() {
+	proto.RegisterFile("nilchain/nilchain/v1/genesis.proto", fileDescriptor_f71e09b4f0c35255)
+}
+
+var fileDescriptor_f71e09b4f0c35255 = []byte{
+	// 1393 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0x8e, 0x13, 0xc7, 0x89, 0xdf, 0x24, 0x4e, 0x32, 0x71, 0xd3, 0x49, 0xd2, 0x3a, 0xfe, 0xf9,
+	0x47, 0x69, 0xa8, 0x84, 0x43, 0x5b, 0x40, 0x42, 0x15, 0x2a, 0x35, 0xfd, 0x67, 0x55, 0x85, 0x76,
+	0x03, 0x02, 0x8a, 0x54, 0x6b, 0xb2, 0x3b, 0xb1, 0x57, 0xb5, 0x77, 0xcd, 0xce, 0xda, 0xad, 0xe1,
+	0xc6, 0x85, 0x0b, 0x07, 0x3e, 0x06, 0x47, 0x90, 0xf8, 0x06, 0x5c, 0x7a, 0xac, 0x38, 0x21, 0x0e,
+	0x15, 0x6a, 0x0f, 0x9c, 0xf9, 0x06, 0x68, 0xde, 0x99, 0xd9, 0xee, 0xc6, 0xbb, 0x26, 0x2d, 0xb9,
+	0x58, 0xbb, 0xef, 0xfb, 0xcc, 0xf3, 0xbc, 0x33, 0xfb, 0xee, 0xcc, 0xe3, 0x85, 0x9a, 0xe7, 0x76,
+	0xed, 0x0e, 0x73, 0xbd, 0xdd, 0xe8, 0x62, 0x78, 0x7e, 0xb7, 0xcd, 0x3d, 0x2e, 0x5c, 0x51, 0xef,
+	0x07, 0x7e, 0xe8, 0x93, 0xb2, 0x49, 0xd5, 0xa3, 0x8b, 0xe1, 0xf9, 0xcd, 0x55, 0xd6, 0x73, 0x3d,
+	0x7f, 0x17, 0x7f, 0x15, 0x70, 0xb3, 0xdc, 0xf6, 0xdb, 0x3e, 0x5e, 0xee, 0xca, 0x2b, 0x1d, 0xdd,
+	0xb0, 0x7d, 0xd1, 0xf3, 0x45, 0x4b, 0x25, 0xd4, 0x8d, 0x4e, 0xfd, 0x2f, 0x55, 0xbd, 0xcf, 0x02,
+	0xd6, 0x33, 0x90, 0x6a, 0x3a, 0x24, 0xf0, 0xfd, 0x83, 0x89, 0x88, 0x70, 0xd4, 0xe7, 0x9a, 0xa3,
+	0xf6, 0x77, 0x19, 0x16, 0x6f, 0xa8, 0x29, 0xed, 0x85, 0x2c, 0xe4, 0xe4, 0x32, 0x14, 0x94, 0x08,
+	0xcd, 0x55, 0x73, 0x3b, 0x0b, 0x17, 0x4e, 0xd5, 0xd3, 0xa6, 0x58, 0xbf, 0x83, 0x98, 0x46, 0xf1,
+	0xf1, 0xd3, 0xed, 0xa9, 0x1f, 0xff, 0xfa, 0xe9, 0x5c, 0xce, 0xd2, 0xc3, 0xc8, 0x69, 0x00, 0x87,
+	0xb3, 0x6e, 0xcb, 0xf6, 0x07, 0x5e, 0x48, 0xa7, 0xab, 0xb9, 0x9d, 0xbc, 0x55, 0x94, 0x91, 0x0f,
+	0x65, 0x80, 0x6c, 0xc3, 0x02, 0x56, 0xa8, 0xf3, 0x33, 0x98, 0x07, 0x0c, 0x29, 0xc0, 0x7b, 0x50,
+	0xc0, 0x3b, 0x41, 0xf3, 0xd5, 0x99, 0x9d, 0x85, 0x0b, 0x5b, 0x19, 0x05, 0x48, 0x4c, 0x23, 0x2f,
+	0xf5, 0x2d, 0x3d, 0x80, 0xbc, 0x0b, 0xb3, 0x52, 0x48, 0xd0, 0x59, 0x1c, 0xb9, 0x99, 0x3e, 0xf2,
+	0x2a, 0x67, 0x5d, 0x3d, 0x50, 0xc1, 0x49, 0x03, 0x8a, 0xfd, 0xc0, 0x1f, 0xba, 0x0e, 0x0f, 0x04,
+	0x2d, 0xe0, 0xd8, 0x4a, 0xa6, 0x2a, 0xc2, 0xf4, 0xf8, 0x17, 0xc3, 0x08, 0x87, 0x75, 0x9c, 0xb6,
+	0x89, 0xb4, 0x44, 0xc8, 0xc2, 0x81, 0xe0, 0x82, 0xce, 0x21, 0xe1, 0x1b, 0xd9, 0xc5, 0x18, 0x52,
+	0x9c, 0x7f, 0xc4, 0x5d, 0x76, 0x62, 0xa9, 0x3d, 0x4d, 0x36, 0x2e, 0x73, 0xc0, 0xdc, 0xee, 0x20,
+	0xe0, 0x82, 0xce, 0x1f, 0x83, 0xcc, 0x75, 0x4d, 0x46, 0xee, 0xc1, 0x4a, 0xa4, 0x10, 0xf0, 0x87,
+	0x2c, 0x70, 0x04, 0x2d, 0x4e, 0x12, 0x30, 0x0c, 0x16, 0x82, 0xaf, 0x79, 0x61, 0x30, 0xd2, 0x02,
+	0xcb, 0xfd, 0x44, 0x4a, 0x90, 0x4f, 0xa0, 0x14, 0x70, 0x9b, 0xbb, 0xfd, 0xb0, 0xe5, 0xf9, 0x9e,
+	0xcd, 0x05, 0x05, 0x64, 0x3e, 0x9b, 0xce, 0x6c, 0x29, 0xec, 0x47, 0x12, 0x1a, 0xe7, 0x5d, 0x0a,
+	0x62, 0x09, 0x41, 0x3c, 0xd8, 0x4a, 0xb2, 0xb6, 0xf6, 0x47, 0x2d, 0x5c, 0xaa, 0x03, 0xb7, 0xcb,
+	0xe9, 0x02, 0x4a, 0x9c, 0xcb, 0x5e, 0x9d, 0xeb, 0x6e, 0x97, 0xc7, 0xa5, 0xb4, 0xca, 0xc9, 0x84,
+	0x4a, 0x63, 0x64, 0xa0, 0xe4, 0x26, 0x00, 0x1f, 0xf6, 0xcc, 0x0c, 0x16, 0x91, 0xfe, 0xff, 0xe9,
+	0xf4, 0xd7, 0x86, 0xbd, 0xb1, 0xea, 0x8b, 0x5c, 0x07, 0x05, 0xf9, 0x1c, 0x56, 0xb0, 0xce, 0x0e,
+	0x67, 0x21, 0x76, 0x0d, 0x17, 0x74, 0x09, 0xf9, 0x76, 0xb2, 0xcb, 0xbd, 0xc9, 0x59, 0x88, 0x2f,
+	0x6c, 0x9c, 0xb4, 0xe4, 0xc4, 0x33, 0x82, 0x7c, 0x09, 0x24, 0xe0, 0x61, 0xe0, 0xf2, 0x21, 0xeb,
+	0xb6, 0x04, 0x17, 0xc2, 0xf5, 0x3d, 0x41, 0x4b, 0xc8, 0xfd, 0x7a, 0xd6, 0x6a, 0x6b, 0xfc, 0x9e,
+	0x82, 0x6b, 0xe6, 0xd5, 0xe0, 0x50, 0x5c, 0x90, 0x01, 0x6c, 0x45, 0xc1, 0x88, 0x5c, 0x2e, 0xba,
+	0xff, 0xd0, 0xe3, 0x01, 0x5d, 0x46, 0x95, 0xb7, 0x8e, 0xa6, 0xd2, 0xf4, 0x1c, 0xfe, 0x28, 0x3e,
+	0x13, 0x3a, 0xa6, 0xd7, 0x18, 0x7d, 0x2c, 0x79, 0xc9, 0x37, 0x50, 0x49, 0x97, 0x35, 0x6d, 0x46,
+	0x57, 0xfe, 0x93, 0xf2, 0x56, 0x8a, 0xb2, 0x69, 0x6e, 0xd2, 0x07, 0x3a, 0x26, 0x6e, 0x5a, 0x60,
+	0xf5, 0x65, 0x64, 0xc7, 0xfa, 0x61, 0x3d, 0x48, 0x43, 0x08, 0xf2, 0x19, 0x2c, 0xab, 0xed, 0xd2,
+	0xe1, 0xcc, 0xe9, 0xba, 0x1e, 0x17, 0x94, 0x4c, 0xea, 0x0d, 0xdc, 0x16, 0xaf, 0x6a, 0x6c, 0xa2,
+	0x37, 0xfa, 0xf1, 0x8c, 0x20, 0xb7, 0x60, 0x81, 0xf7, 0x7d, 0xbb, 0xd3, 0x12, 0x9c, 0x3b, 0x82,
+	0xae, 0x21, 0xe9, 0x6b, 0x19, 0x0d, 0x2c, 0x81, 0x7b, 0x9c, 0x27, 0xde, 0x6b, 0xe0, 0x26, 0x2a,
+	0xc8, 0x7d, 0x20, 0x8a, 0xec, 0xab, 0x81, 0x1f, 0x32, 0xd3, 0xc4, 0xe5, 0x49, 0xef, 0x1c, 0x72,
+	0xde, 0x95, 0xf0, 0xb1, 0x36, 0x5e, 0xe1, 0xc9, 0x1c, 0x16, 0x6b, 0x07, 0xdc, 0x71, 0x43, 0x59,
+	0xad, 0x47, 0x4f, 0x1c, 0xa5, 0x58, 0x2f, 0x51, 0xac, 0x1a, 0x2e, 0xc3, 0xe4, 0x2e, 0x94, 0xc4,
+	0xc8, 0x0b, 0x3b, 0x3c, 0x74, 0x6d, 0xc5, 0xb7, 0xfe, 0xd2, 0x7c, 0x4b, 0x11, 0x03, 0x52, 0xde,
+	0x87, 0xb5, 0x68, 0xbb, 0x1c, 0x78, 0xfb, 0xbe, 0xe7, 0xb8, 0x5e, 0x5b, 0xd0, 0x93, 0x93, 0xf6,
+	0x35, 0xd3, 0x54, 0x9f, 0x1a, 0xbc, 0xa6, 0x26, 0xfd, 0xc3, 0x09, 0x91, 0xe0, 0xef, 0xb9, 0xed,
+	0x80, 0x85, 0xf8, 0x26, 0xd3, 0xa3, 0xf0, 0xdf, 0x36, 0xf8, 0xc3, 0xfc, 0x51, 0x42, 0x90, 0x2f,
+	0x80, 0xe0, 0x16, 0xc4, 0x6c, 0x9b, 0x0b, 0xd1, 0x6a, 0x07, 0xcc, 0x0b, 0x05, 0xdd, 0x40, 0xfa,
+	0x33, 0xd9, 0x9b, 0xd0, 0x15, 0x84, 0xdf, 0x90, 0x68, 0xf3, 0xe8, 0x9c, 0x64, 0x58, 0x90, 0x1e,
+	0x6c, 0x46, 0xa5, 0xef, 0x33, 0xcf, 0x79, 0xe8, 0x3a, 0x61, 0x27, 0x3a, 0x53, 0x36, 0x5f, 0xed,
+	0x4c, 0xa1, 0x86, 0xb2, 0x61, 0x18, 0xcd, 0xe1, 0x62, 0xc1, 0xb2, 0x3a, 0x1f, 0xbb, 0xcc, 0xe6,
+	0x3d, 0x2e, 0xa7, 0xb1, 0x35, 0x69, 0x6f, 0xc6, 0x83, 0xd1, 0x60, 0xe3, 0xdb, 0x68, 0x14, 0xc4,
+	0xa3, 0x25, 0x79, 0xe6, 0xf6, 0x5c, 0x21, 0xb8, 0xd3, 0xd2, 0x36, 0xe5, 0xd4, 0xab, 0x1d, 0xbc,
+	0x34, 0x7e, 0xf0, 0xde, 0x46, 0xc6, 0x3b, 0xca, 0xc6, 0x9c, 0x83, 0xd5, 0x83, 0x80, 0x0d, 0x8c,
+	0x80, 0xea, 0xd1, 0xd3, 0xd5, 0x99, 0x9d, 0x45, 0x6b, 0x19, 0x13, 0x0a, 0x87, 0x9d, 0x77, 0x06,
+	0x4a, 0x5c, 0x32, 0x78, 0x36, 0xd7, 0x8e, 0xaa, 0x82, 0x8e, 0x6a, 0xc9, 0x44, 0x95, 0xa9, 0xfa,
+	0x00, 0xe6, 0x4d, 0x80, 0x6e, 0x4f, 0x32, 0x38, 0xd7, 0x34, 0x4a, 0x17, 0x19, 0x8d, 0x22, 0x4d,
+	0x58, 0x34, 0x1b, 0xde, 0x03, 0x3e, 0x12, 0xb4, 0x8a, 0x2c, 0xd5, 0x74, 0x16, 0xbd, 0x87, 0xdd,
+	0xe2, 0xe6, 0x81, 0x2d, 0x88, 0x28, 0x22, 0x6a, 0x5f, 0xc3, 0x5a, 0xca, 0xb2, 0x90, 0x93, 0x30,
+	0x87, 0xcb, 0xec, 0x3a, 0x68, 0x3d, 0xf3, 0x56, 0x41, 0xde, 0x36, 0x1d, 0xf2, 0x36, 0xcc, 0x47,
+	0x9b, 0xbb, 0xf4, 0x93, 0xc5, 0x06, 0xfd, 0xed, 0x97, 0x37, 0xcb, 0xda, 0x2e, 0x5f, 0x71, 0x9c,
+	0x80, 0x0b, 0xb1, 0x17, 0x06, 0xae, 0xd7, 0xb6, 0x22, 0x24, 0x29, 0xc3, 0xec, 0x90, 0x75, 0x07,
+	0x5c, 0x5b, 0x4c, 0x75, 0x53, 0xfb, 0x36, 0x07, 0x6b, 0x29, 0x7d, 0x95, 0xd0, 0xc8, 0x1d, 0x59,
+	0xe3, 0x1d, 0x28, 0xb0, 0x5e, 0xe4, 0x73, 0x8b, 0x8d, 0xd3, 0x72, 0xb2, 0x7f, 0x3c, 0xdd, 0x3e,
+	0xa1, 0xc6, 0x09, 0xe7, 0x41, 0xdd, 0xf5, 0x77, 0x7b, 0x2c, 0xec, 0xd4, 0x9b, 0x5e, 0x68, 0x69,
+	0x70, 0xed, 0x12, 0xac, 0x8e, 0xb9, 0x1a, 0xb2, 0x02, 0x33, 0x0f, 0xf8, 0x48, 0x89, 0x5b, 0xf2,
+	0x52, 0xce, 0x00, 0xcf, 0x16, 0x6d, 0xa2, 0xd5, 0x4d, 0x6d, 0x1f, 0xca, 0x69, 0x7e, 0x25, 0x7b,
+	0xf9, 0xb6, 0xa0, 0x28, 0x2d, 0x50, 0xab, 0xcf, 0xc2, 0x8e, 0xaa, 0xd3, 0x9a, 0x97, 0x81, 0x3b,
+	0x2c, 0xec, 0xbc, 0xd0, 0x98, 0x89, 0x6b, 0x5c, 0x87, 0xa5, 0x84, 0x69, 0x91, 0xae, 0x5d, 0xba,
+	0x1d, 0xa6, 0xd6, 0x41, 0x17, 0x29, 0x0d, 0x90, 0x5e, 0x99, 0x8c, 0x5a, 0xbb, 0x40, 0xc6, 0xcd,
+	0x4a, 0x76, 0xa5, 0xef, 0x43, 0x5e, 0x9a, 0x20, 0xe4, 0x98, 0xf8, 0xc6, 0x46, 0x84, 0xba, 0xbd,
+	0x70, 0x58, 0xed, 0xbb, 0x1c, 0x6c, 0x66, 0x9f, 0xef, 0xe4, 0x02, 0xcc, 0x25, 0xea, 0x9f, 0xf0,
+	0x84, 0x0d, 0x50, 0xfe, 0x99, 0x31, 0x5d, 0xef, 0x3a, 0x58, 0xd7, 0xa2, 0x55, 0xd4, 0x91, 0xa6,
+	0x43, 0xd6, 0xa1, 0xd0, 0xe1, 0x6e, 0xbb, 0x63, 0xfe, 0xc7, 0xe8, 0xbb, 0xda, 0xcf, 0x29, 0x95,
+	0xc4, 0x56, 0xb3, 0x0e, 0xb3, 0xca, 0x24, 0xfd, 0x5b, 0x1d, 0x0a, 0x16, 0x5f, 0xb0, 0xe9, 0xcc,
+	0x37, 0x63, 0xe6, 0x65, 0xde, 0x0c, 0xf5, 0xac, 0xf2, 0xf1, 0x67, 0xf5, 0x7d, 0x0e, 0xc8, 0xb8,
+	0x7b, 0x20, 0x67, 0x71, 0x43, 0xc5, 0x40, 0x4b, 0xcf, 0x55, 0x3d, 0xb4, 0x92, 0x09, 0xdf, 0xc4,
+	0xe8, 0x31, 0x17, 0x59, 0xbb, 0x0c, 0xa5, 0xa4, 0xed, 0x20, 0x1b, 0x30, 0xaf, 0x4c, 0x46, 0xd4,
+	0x37, 0x73, 0x78, 0xdf, 0x74, 0x08, 0x81, 0xbc, 0xb4, 0x31, 0xfa, 0x01, 0xe1, 0x75, 0xed, 0xd7,
+	0x1c, 0x94, 0xd3, 0x4c, 0xc6, 0x24, 0x9e, 0x63, 0x5e, 0xe8, 0x2b, 0x30, 0x8b, 0x56, 0x08, 0x17,
+	0x3a, 0xf3, 0x24, 0x3d, 0x54, 0xa4, 0xf9, 0x6b, 0x8a, 0x23, 0x6b, 0x97, 0xa0, 0x94, 0x34, 0x20,
+	0x93, 0xca, 0x2f, 0xc1, 0x74, 0xd4, 0xa5, 0xd3, 0xae, 0xd3, 0xb8, 0xf8, 0xf8, 0x59, 0x25, 0xf7,
+	0xe4, 0x59, 0x25, 0xf7, 0xe7, 0xb3, 0x4a, 0xee, 0x87, 0xe7, 0x95, 0xa9, 0x27, 0xcf, 0x2b, 0x53,
+	0xbf, 0x3f, 0xaf, 0x4c, 0xdd, 0xdb, 0x88, 0xbe, 0x07, 0x3c, 0x7a, 0xf1, 0x69, 0x00, 0xbf, 0x0b,
+	0xec, 0x17, 0xf0, 0xc3, 0xc0, 0xc5, 0x7f, 0x06, 0x00, 0x6d, 0x9f, 0x57, 0x0b, 0xff, 0x10, 0x00,
+	0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionKeys) > 0 {
+		for iNdEx := len(m.SessionKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SessionKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if m.EvidenceCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EvidenceCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if len(m.FraudProofsSeen) > 0 {
+		for iNdEx := len(m.FraudProofsSeen) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FraudProofsSeen[iNdEx])
+			copy(dAtA[i:], m.FraudProofsSeen[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FraudProofsSeen[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.DealProviderMissedProofs) > 0 {
+		for iNdEx := len(m.DealProviderMissedProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DealProviderMissedProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.DealPlacements) > 0 {
+		for iNdEx := len(m.DealPlacements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DealPlacements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.ProviderBandwidthRewards) > 0 {
+		for iNdEx := len(m.ProviderBandwidthRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderBandwidthRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.DealAccessGrants) > 0 {
+		for iNdEx := len(m.DealAccessGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DealAccessGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.ProviderMigrations) > 0 {
+		for iNdEx := len(m.ProviderMigrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderMigrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.ProviderUnbondings) > 0 {
+		for iNdEx := len(m.ProviderUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.SyntheticSeen) > 0 {
+		for iNdEx := len(m.SyntheticSeen) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SyntheticSeen[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.CreditSeen) > 0 {
+		for iNdEx := len(m.CreditSeen) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreditSeen[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.EpochQuotaStates) > 0 {
+		for iNdEx := len(m.EpochQuotaStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochQuotaStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.EpochSeeds) > 0 {
+		for iNdEx := len(m.EpochSeeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochSeeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.ProofDeadlines) > 0 {
+		for iNdEx := len(m.ProofDeadlines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProofDeadlines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.RetrievalSessionNonces) > 0 {
+		for iNdEx := len(m.RetrievalSessionNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetrievalSessionNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.RetrievalSessionsByProvider) > 0 {
+		for iNdEx := len(m.RetrievalSessionsByProvider) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetrievalSessionsByProvider[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.RetrievalSessionsByOwner) > 0 {
+		for iNdEx := len(m.RetrievalSessionsByOwner) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetrievalSessionsByOwner[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.RetrievalSessions) > 0 {
+		for iNdEx := len(m.RetrievalSessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetrievalSessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.DealHeatStates) > 0 {
+		for iNdEx := len(m.DealHeatStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DealHeatStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.EvmNonces) > 0 {
+		for iNdEx := len(m.EvmNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvmNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ReceiptNoncesByDealFile) > 0 {
+		for iNdEx := len(m.ReceiptNoncesByDealFile) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiptNoncesByDealFile[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ReceiptNonces) > 0 {
+		for iNdEx := len(m.ReceiptNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiptNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ProviderRewards) > 0 {
+		for iNdEx := len(m.ProviderRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DealProviderFailures) > 0 {
+		for iNdEx := len(m.DealProviderFailures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DealProviderFailures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DealProviderStatuses) > 0 {
+		for iNdEx := len(m.DealProviderStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DealProviderStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Deals) > 0 {
+		for iNdEx := len(m.Deals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ProofCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ProofCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DealCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DealCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DealProviderCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DealProviderCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DealProviderCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DealId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderRewardEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderRewardEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderRewardEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiptNonceEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiptNonceEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiptNonceEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DealFileReceiptNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DealFileReceiptNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DealFileReceiptNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FilePath) > 0 {
+		i -= len(m.FilePath)
+		copy(dAtA[i:], m.FilePath)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.FilePath)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DealId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvmNonceEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvmNonceEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvmNonceEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EvmAddress) > 0 {
+		i -= len(m.EvmAddress)
+		copy(dAtA[i:], m.EvmAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EvmAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DealHeatStateEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DealHeatStateEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DealHeatStateEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Heat.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.DealId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetrievalSessionIndexEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetrievalSessionIndexEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetrievalSessionIndexEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetrievalSessionNonceEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetrievalSessionNonceEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetrievalSessionNonceEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DealId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofDeadlineEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofDeadlineEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofDeadlineEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DealId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochSeedEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochSeedEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochSeedEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Seed) > 0 {
+		i -= len(m.Seed)
+		copy(dAtA[i:], m.Seed)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Seed)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochQuotaStateEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochQuotaStateEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochQuotaStateEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DealId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochSeenEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochSeenEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochSeenEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.DealCount != 0 {
+		n += 1 + sovGenesis(uint64(m.DealCount))
+	}
+	if m.ProofCount != 0 {
+		n += 1 + sovGenesis(uint64(m.ProofCount))
+	}
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Deals) > 0 {
+		for _, e := range m.Deals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DealProviderStatuses) > 0 {
+		for _, e := range m.DealProviderStatuses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DealProviderFailures) > 0 {
+		for _, e := range m.DealProviderFailures {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProviderRewards) > 0 {
+		for _, e := range m.ProviderRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReceiptNonces) > 0 {
+		for _, e := range m.ReceiptNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReceiptNoncesByDealFile) > 0 {
+		for _, e := range m.ReceiptNoncesByDealFile {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EvmNonces) > 0 {
+		for _, e := range m.EvmNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DealHeatStates) > 0 {
+		for _, e := range m.DealHeatStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RetrievalSessions) > 0 {
+		for _, e := range m.RetrievalSessions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RetrievalSessionsByOwner) > 0 {
+		for _, e := range m.RetrievalSessionsByOwner {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RetrievalSessionsByProvider) > 0 {
+		for _, e := range m.RetrievalSessionsByProvider {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RetrievalSessionNonces) > 0 {
+		for _, e := range m.RetrievalSessionNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProofDeadlines) > 0 {
+		for _, e := range m.ProofDeadlines {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochSeeds) > 0 {
+		for _, e := range m.EpochSeeds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochQuotaStates) > 0 {
+		for _, e := range m.EpochQuotaStates {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CreditSeen) > 0 {
+		for _, e := range m.CreditSeen {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SyntheticSeen) > 0 {
+		for _, e := range m.SyntheticSeen {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProviderUnbondings) > 0 {
+		for _, e := range m.ProviderUnbondings {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProviderMigrations) > 0 {
+		for _, e := range m.ProviderMigrations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DealAccessGrants) > 0 {
+		for _, e := range m.DealAccessGrants {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProviderBandwidthRewards) > 0 {
+		for _, e := range m.ProviderBandwidthRewards {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DealPlacements) > 0 {
+		for _, e := range m.DealPlacements {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DealProviderMissedProofs) > 0 {
+		for _, e := range m.DealProviderMissedProofs {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FraudProofsSeen) > 0 {
+		for _, b := range m.FraudProofsSeen {
+			l = len(b)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EvidenceCount != 0 {
+		n += 2 + sovGenesis(uint64(m.EvidenceCount))
+	}
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SessionKeys) > 0 {
+		for _, e := range m.SessionKeys {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DealProviderCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		n += 1 + sovGenesis(uint64(m.DealId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovGenesis(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *ProviderRewardEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ReceiptNonceEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *DealFileReceiptNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		n += 1 + sovGenesis(uint64(m.DealId))
+	}
+	l = len(m.FilePath)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *EvmNonceEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *DealHeatStateEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		n += 1 + sovGenesis(uint64(m.DealId))
+	}
+	l = m.Heat.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *RetrievalSessionIndexEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *RetrievalSessionNonceEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovGenesis(uint64(m.DealId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *ProofDeadlineEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.DeadlineHeight))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovGenesis(uint64(m.DealId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *EpochSeedEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochId != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochId))
+	}
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *EpochQuotaStateEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochId != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochId))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovGenesis(uint64(m.DealId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.State.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *EpochSeenEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochId != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochId))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealCount", wireType)
+			}
+			m.DealCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofCount", wireType)
+			}
+			m.ProofCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, Proof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deals = append(m.Deals, Deal{})
+			if err := m.Deals[len(m.Deals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, Provider{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealProviderStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DealProviderStatuses = append(m.DealProviderStatuses, DealProviderCounter{})
+			if err := m.DealProviderStatuses[len(m.DealProviderStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealProviderFailures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DealProviderFailures = append(m.DealProviderFailures, DealProviderCounter{})
+			if err := m.DealProviderFailures[len(m.DealProviderFailures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderRewards = append(m.ProviderRewards, ProviderRewardEntry{})
+			if err := m.ProviderRewards[len(m.ProviderRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptNonces = append(m.ReceiptNonces, ReceiptNonceEntry{})
+			if err := m.ReceiptNonces[len(m.ReceiptNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptNoncesByDealFile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptNoncesByDealFile = append(m.ReceiptNoncesByDealFile, DealFileReceiptNonce{})
+			if err := m.ReceiptNoncesByDealFile[len(m.ReceiptNoncesByDealFile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmNonces = append(m.EvmNonces, EvmNonceEntry{})
+			if err := m.EvmNonces[len(m.EvmNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealHeatStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DealHeatStates = append(m.DealHeatStates, DealHeatStateEntry{})
+			if err := m.DealHeatStates[len(m.DealHeatStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetrievalSessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetrievalSessions = append(m.RetrievalSessions, RetrievalSession{})
+			if err := m.RetrievalSessions[len(m.RetrievalSessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetrievalSessionsByOwner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetrievalSessionsByOwner = append(m.RetrievalSessionsByOwner, RetrievalSessionIndexEntry{})
+			if err := m.RetrievalSessionsByOwner[len(m.RetrievalSessionsByOwner)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetrievalSessionsByProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetrievalSessionsByProvider = append(m.RetrievalSessionsByProvider, RetrievalSessionIndexEntry{})
+			if err := m.RetrievalSessionsByProvider[len(m.RetrievalSessionsByProvider)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetrievalSessionNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetrievalSessionNonces = append(m.RetrievalSessionNonces, RetrievalSessionNonceEntry{})
+			if err := m.RetrievalSessionNonces[len(m.RetrievalSessionNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofDeadlines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofDeadlines = append(m.ProofDeadlines, ProofDeadlineEntry{})
+			if err := m.ProofDeadlines[len(m.ProofDeadlines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochSeeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochSeeds = append(m.EpochSeeds, EpochSeedEntry{})
+			if err := m.EpochSeeds[len(m.EpochSeeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochQuotaStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochQuotaStates = append(m.EpochQuotaStates, EpochQuotaStateEntry{})
+			if err := m.EpochQuotaStates[len(m.EpochQuotaStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditSeen", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditSeen = append(m.CreditSeen, EpochSeenEntry{})
+			if err := m.CreditSeen[len(m.CreditSeen)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyntheticSeen", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyntheticSeen = append(m.SyntheticSeen, EpochSeenEntry{})
+			if err := m.SyntheticSeen[len(m.SyntheticSeen)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUnbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderUnbondings = append(m.ProviderUnbondings, ProviderUnbonding{})
+			if err := m.ProviderUnbondings[len(m.ProviderUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderMigrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderMigrations = append(m.ProviderMigrations, ProviderMigration{})
+			if err := m.ProviderMigrations[len(m.ProviderMigrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealAccessGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DealAccessGrants = append(m.DealAccessGrants, DealAccessGrant{})
+			if err := m.DealAccessGrants[len(m.DealAccessGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderBandwidthRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderBandwidthRewards = append(m.ProviderBandwidthRewards, ProviderRewardEntry{})
+			if err := m.ProviderBandwidthRewards[len(m.ProviderBandwidthRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealPlacements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DealPlacements = append(m.DealPlacements, DealPlacement{})
+			if err := m.DealPlacements[len(m.DealPlacements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealProviderMissedProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DealProviderMissedProofs = append(m.DealProviderMissedProofs, DealProviderCounter{})
+			if err := m.DealProviderMissedProofs[len(m.DealProviderMissedProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudProofsSeen", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FraudProofsSeen = append(m.FraudProofsSeen, make([]byte, postIndex-iNdEx))
+			copy(m.FraudProofsSeen[len(m.FraudProofsSeen)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceCount", wireType)
+			}
+			m.EvidenceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvidenceCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = append(m.Evidence, Evidence{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionKeys = append(m.SessionKeys, SessionKey{})
+			if err := m.SessionKeys[len(m.SessionKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DealProviderCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DealProviderCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DealProviderCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderRewardEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderRewardEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderRewardEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReceiptNonceEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReceiptNonceEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReceiptNonceEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DealFileReceiptNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DealFileReceiptNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DealFileReceiptNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvmNonceEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvmNonceEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvmNonceEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DealHeatStateEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DealHeatStateEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DealHeatStateEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Heat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetrievalSessionIndexEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetrievalSessionIndexEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetrievalSessionIndexEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = append(m.SessionId[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionId == nil {
+				m.SessionId = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetrievalSessionNonceEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetrievalSessionNonceEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetrievalSessionNonceEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProofDeadlineEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofDeadlineEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofDeadlineEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return nil
 }
+func (m *EpochSeedEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochSeedEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochSeedEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = append(m.Seed[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seed == nil {
+				m.Seed = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *EpochQuotaStateEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -178,15 +4775,85 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochQuotaStateEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochQuotaStateEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -213,7 +4880,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -238,6 +4905,109 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EpochSeenEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochSeenEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochSeenEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
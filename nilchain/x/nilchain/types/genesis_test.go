@@ -1,12 +1,51 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"nilchain/x/nilchain/types"
 )
 
+func validPopulatedGenesis() *types.GenesisState {
+	sessionID := bytes.Repeat([]byte{0x01}, 32)
+	gs := types.DefaultGenesis()
+	gs.DealCount = 1
+	gs.Providers = []types.Provider{{Address: "nil1provider", Status: "Active"}}
+	gs.Deals = []types.Deal{{
+		Id:               0,
+		Owner:            "nil1owner",
+		EscrowBalance:    math.NewInt(10),
+		MaxMonthlySpend:  math.ZeroInt(),
+		SpendWindowSpent: math.ZeroInt(),
+		Providers:        []string{"nil1provider"},
+	}}
+	gs.RetrievalSessions = []types.RetrievalSession{{
+		SessionId: sessionID,
+		DealId:    0,
+		Owner:     "nil1owner",
+		Provider:  "nil1provider",
+		LockedFee: math.ZeroInt(),
+	}}
+	gs.RetrievalSessionsByOwner = []types.RetrievalSessionIndexEntry{{Address: "nil1owner", SessionId: sessionID}}
+	gs.RetrievalSessionsByProvider = []types.RetrievalSessionIndexEntry{{Address: "nil1provider", SessionId: sessionID}}
+	return gs
+}
+
+func genesisSessionKey(gs *types.GenesisState) types.SessionKey {
+	return types.SessionKey{
+		Key:       sdk.AccAddress([]byte("genesis_session_key_")).String(),
+		Owner:     sdk.AccAddress([]byte("genesis_owner_______")).String(),
+		DealIds:   []uint64{gs.Deals[0].Id},
+		ExpiresAt: 100,
+		MaxFee:    math.ZeroInt(),
+		FeesUsed:  math.ZeroInt(),
+	}
+}
+
 func TestGenesisState_Validate(t *testing.T) {
 	tests := []struct {
 		desc     string
@@ -23,6 +62,261 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{},
 			valid:    false,
 		},
+		{
+			desc:     "populated genesis is valid",
+			genState: validPopulatedGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "deal id at or above deal_count is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				gs.DealCount = 0
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "duplicate provider is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				gs.Providers = append(gs.Providers, gs.Providers[0])
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "deal with unregistered provider is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				gs.Deals[0].Providers = append(gs.Deals[0].Providers, "nil1unknown")
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "ended deal with departed provider is valid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				gs.Deals[0].Status = types.DealStatus_DEAL_STATUS_CLOSED
+				gs.Deals[0].Providers = append(gs.Deals[0].Providers, "nil1unknown")
+				return gs
+			}(),
+			valid: true,
+		},
+		{
+			desc: "malformed pending owner is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				gs.Deals[0].PendingOwner = "nil1buyer"
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "access grant is valid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				gs.DealAccessGrants = []types.DealAccessGrant{{
+					DealId: gs.Deals[0].Id, Grantee: sdk.AccAddress([]byte("genesis_grantee_____")).String(),
+					MaxFee: math.ZeroInt(), FeesUsed: math.ZeroInt(),
+				}}
+				return gs
+			}(),
+			valid: true,
+		},
+		{
+			desc: "duplicate access grant is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				grant := types.DealAccessGrant{
+					DealId: gs.Deals[0].Id, Grantee: sdk.AccAddress([]byte("genesis_grantee_____")).String(),
+					MaxFee: math.ZeroInt(), FeesUsed: math.ZeroInt(),
+				}
+				gs.DealAccessGrants = []types.DealAccessGrant{grant, grant}
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "session key is valid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				gs.SessionKeys = []types.SessionKey{genesisSessionKey(gs)}
+				return gs
+			}(),
+			valid: true,
+		},
+		{
+			desc: "session key without expiry is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				key := genesisSessionKey(gs)
+				key.ExpiresAt = 0
+				gs.SessionKeys = []types.SessionKey{key}
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "session key bound to unknown deal is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				key := genesisSessionKey(gs)
+				key.DealIds = append(key.DealIds, 7)
+				gs.SessionKeys = []types.SessionKey{key}
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "duplicate session key is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				gs.SessionKeys = []types.SessionKey{genesisSessionKey(gs), genesisSessionKey(gs)}
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "negative bandwidth reward is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				gs.ProviderBandwidthRewards = []types.ProviderRewardEntry{{Provider: "nil1provider", Amount: math.NewInt(-1)}}
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "mode2 slot with unregistered pending provider is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				gs.Deals[0].Mode2Slots = []*types.DealSlot{{Slot: 0, Provider: "nil1provider", PendingProvider: "nil1unknown"}}
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "session for unknown deal is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				gs.RetrievalSessions[0].DealId = 9
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "session missing owner index entry is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				gs.RetrievalSessionsByOwner = nil
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "provider index entry with wrong address is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				gs.RetrievalSessionsByProvider[0].Address = "nil1owner"
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "duplicate proof deadline is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				gs.ProofDeadlines = []types.ProofDeadlineEntry{
+					{DeadlineHeight: 11, DealId: 0, Provider: "nil1provider"},
+					{DeadlineHeight: 12, DealId: 0, Provider: "nil1provider"},
+				}
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "duplicate provider unbonding is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				gs.ProviderUnbondings = []types.ProviderUnbonding{
+					{Provider: "nil1provider", CompletionHeight: 20, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)},
+					{Provider: "nil1provider", CompletionHeight: 20, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 2)},
+				}
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "zero provider unbonding is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				gs.ProviderUnbondings = []types.ProviderUnbonding{
+					{Provider: "nil1provider", CompletionHeight: 20, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)},
+				}
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "duplicate provider migration is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				gs.ProviderMigrations = []types.ProviderMigration{
+					{Provider: "nil1provider", DealId: 1, Replacement: "nil1other", CompletionHeight: 20},
+					{Provider: "nil1provider", DealId: 1, Replacement: "nil1third", CompletionHeight: 30},
+				}
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "short epoch seed is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				gs.EpochSeeds = []types.EpochSeedEntry{{EpochId: 1, Seed: []byte{1, 2, 3}}}
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "duplicate synthetic challenge id is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				id := bytes.Repeat([]byte{0x01}, 32)
+				gs.SyntheticSeen = []types.EpochSeenEntry{{EpochId: 1, Id: id}, {EpochId: 1, Id: id}}
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "heat state for unknown deal is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				gs.DealHeatStates = []types.DealHeatStateEntry{{DealId: 5}}
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "duplicate deal placement is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				gs.DealPlacements = []types.DealPlacement{{DealId: 0}, {DealId: 0}}
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "evidence id at evidence_count is invalid",
+			genState: func() *types.GenesisState {
+				gs := validPopulatedGenesis()
+				gs.EvidenceCount = 1
+				gs.Evidence = []types.Evidence{{Id: 1, Kind: types.EvidenceKind_EVIDENCE_KIND_RETRIEVAL_NON_RESPONSE, Provider: "nil1provider"}}
+				return gs
+			}(),
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -36,4 +36,50 @@ var (
 	RetrievalSessionsByOwnerKey     = collections.NewPrefix("RetrievalSessionsByOwner/value/")
 	RetrievalSessionsByProviderKey  = collections.NewPrefix("RetrievalSessionsByProvider/value/")
 	RetrievalSessionNonceKey        = collections.NewPrefix("RetrievalSessionNonce/value/")
+
+	ProofDeadlinesKey               = collections.NewPrefix("ProofDeadlines/value/")
+	ProofDeadlinesByDealProviderKey = collections.NewPrefix("ProofDeadlinesByDealProvider/value/")
+
+	EpochSeedsKey    = collections.NewPrefix("EpochSeeds/value/")
+	QuotaStatesKey   = collections.NewPrefix("QuotaStates/value/")
+	CreditSeenKey    = collections.NewPrefix("CreditSeen/value/")
+	SyntheticSeenKey = collections.NewPrefix("SyntheticSeen/value/")
+
+	ProviderUnbondingsKey     = collections.NewPrefix("ProviderUnbondings/value/")
+	ProviderUnbondingQueueKey = collections.NewPrefix("ProviderUnbondingQueue/value/")
+
+	ProviderMigrationsKey     = collections.NewPrefix("ProviderMigrations/value/")
+	ProviderMigrationQueueKey = collections.NewPrefix("ProviderMigrationQueue/value/")
+
+	DealExpiryQueueKey = collections.NewPrefix("DealExpiryQueue/value/")
+
+	DealAccessGrantsKey        = collections.NewPrefix("DealAccessGrants/value/")
+	RetrievalSessionsByDealKey = collections.NewPrefix("RetrievalSessionsByDeal/value/")
+
+	RetrievalSessionSweepQueueKey = collections.NewPrefix("RetrievalSessionSweepQueue/value/")
+
+	ProviderBandwidthRewardsKey = collections.NewPrefix("ProviderBandwidthRewards/value/")
+
+	DealPlacementsKey = collections.NewPrefix("DealPlacements/value/")
+
+	DealProviderMissedProofsKey = collections.NewPrefix("DealProviderMissedProofs/value/")
+
+	SlotRepairQueueKey = collections.NewPrefix("SlotRepairQueue/value/")
+
+	FraudProofsSeenKey = collections.NewPrefix("FraudProofsSeen/value/")
+
+	EvidenceCountKey      = collections.NewPrefix("EvidenceCount/value/")
+	EvidenceKey           = collections.NewPrefix("Evidence/value/")
+	EvidenceByProviderKey = collections.NewPrefix("EvidenceByProvider/value/")
+	EvidenceByDealKey     = collections.NewPrefix("EvidenceByDeal/value/")
+
+	DealsByOwnerKey    = collections.NewPrefix("DealsByOwner/value/")
+	DealsByProviderKey = collections.NewPrefix("DealsByProvider/value/")
+
+	SessionKeysKey           = collections.NewPrefix("SessionKeys/value/")
+	SessionKeysByOwnerKey    = collections.NewPrefix("SessionKeysByOwner/value/")
+	SessionKeyExpiryQueueKey = collections.NewPrefix("SessionKeyExpiryQueue/value/")
 )
+
+// MaxSessionKeyDeals bounds the deals one session key can be bound to.
+const MaxSessionKeyDeals = 64
@@ -22,8 +22,59 @@ var (
 	KeyRetrievalPricePerBlob = []byte("RetrievalPricePerBlob")
 	KeyRetrievalBurnBps      = []byte("RetrievalBurnBps")
 	KeyMonthLenBlocks        = []byte("MonthLenBlocks")
+	KeyEpochLenBlocks        = []byte("EpochLenBlocks")
+	KeyQuotaBpsPerEpochHot   = []byte("QuotaBpsPerEpochHot")
+	KeyQuotaBpsPerEpochCold  = []byte("QuotaBpsPerEpochCold")
+	KeyQuotaMinBlobs         = []byte("QuotaMinBlobs")
+	KeyQuotaMaxBlobs         = []byte("QuotaMaxBlobs")
+	KeyCreditCapBps          = []byte("CreditCapBps")
+	KeyMinProviderBond       = []byte("MinProviderBond")
+	KeyProviderBondPerGib    = []byte("ProviderBondPerGib")
+	KeyProviderUnbonding     = []byte("ProviderUnbondingBlocks")
+	KeySlashMissedProofBps   = []byte("SlashMissedProofBps")
+	KeySlashInvalidProofBps  = []byte("SlashInvalidProofBps")
+	KeyJailBondThresholdBps  = []byte("JailBondThresholdBps")
+	KeyProviderMigration     = []byte("ProviderMigrationBlocks")
+	KeySessionRetention      = []byte("RetrievalSessionRetentionBlocks")
+	KeyAutoRepairFailures    = []byte("AutoRepairFailureThreshold")
+	KeyAutoRepairMissed      = []byte("AutoRepairMissedProofThreshold")
+	KeyAutoRepairCooldown    = []byte("AutoRepairCooldownBlocks")
+	KeyRepairSampleBlobs     = []byte("RepairSampleBlobs")
+	KeyRepairDeadlineBlocks  = []byte("RepairDeadlineBlocks")
+	KeyRepairSlashBps        = []byte("RepairSlashBps")
+	KeyRepairBountyBps       = []byte("RepairBountyBps")
+	KeyFraudSlashBps         = []byte("FraudSlashBps")
+	KeyFraudReporterReward   = []byte("FraudReporterRewardBps")
+	KeyPrecompileCallGas     = []byte("PrecompileCallGas")
+	KeyPrecompileViewCallGas = []byte("PrecompileViewCallGas")
+	KeyPrecompileCalldataGas = []byte("PrecompileCalldataByteGas")
+	KeyPrecompileOutputGas   = []byte("PrecompileOutputByteGas")
+	KeyPrecompileReadGas     = []byte("PrecompileStoreReadGas")
+	KeyPrecompileWriteGas    = []byte("PrecompileStoreWriteGas")
+	KeyPrecompileProofGas    = []byte("PrecompileProofVerifyGas")
+	KeyPrecompileSessionGas  = []byte("PrecompileSessionOpenGas")
 )
 
+// Default NilStore precompile gas schedule. The keeper costs mirror the EVM's
+// cold SLOAD and SSTORE; the proof and session costs come from the benchmarks
+// in precompiles/nilstore/gas_test.go, converted at the 20ns per gas the
+// EIP-4844 point evaluation precompile is priced at (50k gas per KZG opening).
+const (
+	DefaultPrecompileCallGas         = uint64(10_000)
+	DefaultPrecompileViewCallGas     = uint64(2_000)
+	DefaultPrecompileCalldataByteGas = uint64(16)
+	DefaultPrecompileOutputByteGas   = uint64(3)
+	DefaultPrecompileStoreReadGas    = uint64(2_100)
+	DefaultPrecompileStoreWriteGas   = uint64(20_000)
+	DefaultPrecompileProofVerifyGas  = uint64(120_000) // two KZG openings plus the blob Merkle path
+	DefaultPrecompileSessionOpenGas  = uint64(1_000)   // session id hashing and validation, on top of its writes
+)
+
+// MaxQuotaBlobs bounds quota_min_blobs and quota_max_blobs. Every liveness
+// assignment derives up to quota_max_blobs synthetic challenges an epoch, one
+// hash each, so the quota must stay small enough to derive in a block.
+const MaxQuotaBlobs = uint64(1024)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -41,18 +92,80 @@ func NewParams(
 	retrievalPricePerBlob sdk.Coin,
 	retrievalBurnBps uint64,
 	monthLenBlocks uint64,
+	epochLenBlocks uint64,
+	quotaBpsPerEpochHot uint64,
+	quotaBpsPerEpochCold uint64,
+	quotaMinBlobs uint64,
+	quotaMaxBlobs uint64,
+	creditCapBps uint64,
+	minProviderBond sdk.Coin,
+	providerBondPerGib sdk.Coin,
+	providerUnbondingBlocks uint64,
+	slashMissedProofBps uint64,
+	slashInvalidProofBps uint64,
+	jailBondThresholdBps uint64,
+	providerMigrationBlocks uint64,
+	retrievalSessionRetentionBlocks uint64,
+	autoRepairFailureThreshold uint64,
+	autoRepairMissedProofThreshold uint64,
+	autoRepairCooldownBlocks uint64,
+	repairSampleBlobs uint64,
+	repairDeadlineBlocks uint64,
+	repairSlashBps uint64,
+	repairBountyBps uint64,
+	fraudSlashBps uint64,
+	fraudReporterRewardBps uint64,
+	precompileCallGas uint64,
+	precompileViewCallGas uint64,
+	precompileCalldataByteGas uint64,
+	precompileOutputByteGas uint64,
+	precompileStoreReadGas uint64,
+	precompileStoreWriteGas uint64,
+	precompileProofVerifyGas uint64,
+	precompileSessionOpenGas uint64,
 ) Params {
 	return Params{
-		BaseStripeCost:        baseStripeCost,
-		HalvingInterval:       halvingInterval,
-		Eip712ChainId:         eip712ChainID,
-		StoragePrice:          storagePrice,
-		DealCreationFee:       dealCreationFee,
-		MinDurationBlocks:     minDurationBlocks,
-		BaseRetrievalFee:      baseRetrievalFee,
-		RetrievalPricePerBlob: retrievalPricePerBlob,
-		RetrievalBurnBps:      retrievalBurnBps,
-		MonthLenBlocks:        monthLenBlocks,
+		BaseStripeCost:                  baseStripeCost,
+		HalvingInterval:                 halvingInterval,
+		Eip712ChainId:                   eip712ChainID,
+		StoragePrice:                    storagePrice,
+		DealCreationFee:                 dealCreationFee,
+		MinDurationBlocks:               minDurationBlocks,
+		BaseRetrievalFee:                baseRetrievalFee,
+		RetrievalPricePerBlob:           retrievalPricePerBlob,
+		RetrievalBurnBps:                retrievalBurnBps,
+		MonthLenBlocks:                  monthLenBlocks,
+		EpochLenBlocks:                  epochLenBlocks,
+		QuotaBpsPerEpochHot:             quotaBpsPerEpochHot,
+		QuotaBpsPerEpochCold:            quotaBpsPerEpochCold,
+		QuotaMinBlobs:                   quotaMinBlobs,
+		QuotaMaxBlobs:                   quotaMaxBlobs,
+		CreditCapBps:                    creditCapBps,
+		MinProviderBond:                 minProviderBond,
+		ProviderBondPerGib:              providerBondPerGib,
+		ProviderUnbondingBlocks:         providerUnbondingBlocks,
+		SlashMissedProofBps:             slashMissedProofBps,
+		SlashInvalidProofBps:            slashInvalidProofBps,
+		JailBondThresholdBps:            jailBondThresholdBps,
+		ProviderMigrationBlocks:         providerMigrationBlocks,
+		RetrievalSessionRetentionBlocks: retrievalSessionRetentionBlocks,
+		AutoRepairFailureThreshold:      autoRepairFailureThreshold,
+		AutoRepairMissedProofThreshold:  autoRepairMissedProofThreshold,
+		AutoRepairCooldownBlocks:        autoRepairCooldownBlocks,
+		RepairSampleBlobs:               repairSampleBlobs,
+		RepairDeadlineBlocks:            repairDeadlineBlocks,
+		RepairSlashBps:                  repairSlashBps,
+		RepairBountyBps:                 repairBountyBps,
+		FraudSlashBps:                   fraudSlashBps,
+		FraudReporterRewardBps:          fraudReporterRewardBps,
+		PrecompileCallGas:               precompileCallGas,
+		PrecompileViewCallGas:           precompileViewCallGas,
+		PrecompileCalldataByteGas:       precompileCalldataByteGas,
+		PrecompileOutputByteGas:         precompileOutputByteGas,
+		PrecompileStoreReadGas:          precompileStoreReadGas,
+		PrecompileStoreWriteGas:         precompileStoreWriteGas,
+		PrecompileProofVerifyGas:        precompileProofVerifyGas,
+		PrecompileSessionOpenGas:        precompileSessionOpenGas,
 	}
 }
 
@@ -67,8 +180,39 @@ func DefaultParams() Params {
 		10, // MinDurationBlocks
 		sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1)), // BaseRetrievalFee (provisional devnet default)
 		sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1)), // RetrievalPricePerBlob (provisional devnet default)
-		500,  // RetrievalBurnBps (5%)
-		1000, // MonthLenBlocks (devnet-friendly "month")
+		500,         // RetrievalBurnBps (5%)
+		1000,        // MonthLenBlocks (devnet-friendly "month")
+		ProofWindow, // EpochLenBlocks (one liveness epoch per missed-proof window)
+		100,         // QuotaBpsPerEpochHot (1% of slot bytes per epoch)
+		20,          // QuotaBpsPerEpochCold (0.2% of slot bytes per epoch)
+		1,           // QuotaMinBlobs
+		64,          // QuotaMaxBlobs
+		5000,        // CreditCapBps (organic credits may cover up to 50% of the quota)
+		sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100000)), // MinProviderBond (provisional devnet default)
+		sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000)),   // ProviderBondPerGib (provisional devnet default)
+		100,  // ProviderUnbondingBlocks
+		100,  // SlashMissedProofBps (1% of bond per missed window)
+		500,  // SlashInvalidProofBps (5% of bond per failed system proof)
+		5000, // JailBondThresholdBps (jail below 50% of the required bond)
+		100,  // ProviderMigrationBlocks
+		1000, // RetrievalSessionRetentionBlocks
+		3,    // AutoRepairFailureThreshold
+		3,    // AutoRepairMissedProofThreshold
+		100,  // AutoRepairCooldownBlocks
+		8,    // RepairSampleBlobs
+		100,  // RepairDeadlineBlocks
+		500,  // RepairSlashBps (5% of bond when the chain repairs a failed slot)
+		5000, // RepairBountyBps (half of that slash goes to the repairing provider)
+		2000, // FraudSlashBps (20% of bond per proven wrong response)
+		5000, // FraudReporterRewardBps (half of that slash goes to the reporter)
+		DefaultPrecompileCallGas,
+		DefaultPrecompileViewCallGas,
+		DefaultPrecompileCalldataByteGas,
+		DefaultPrecompileOutputByteGas,
+		DefaultPrecompileStoreReadGas,
+		DefaultPrecompileStoreWriteGas,
+		DefaultPrecompileProofVerifyGas,
+		DefaultPrecompileSessionOpenGas,
 	)
 }
 
@@ -85,6 +229,37 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyRetrievalPricePerBlob, &p.RetrievalPricePerBlob, validateRetrievalPricePerBlob),
 		paramtypes.NewParamSetPair(KeyRetrievalBurnBps, &p.RetrievalBurnBps, validateRetrievalBurnBps),
 		paramtypes.NewParamSetPair(KeyMonthLenBlocks, &p.MonthLenBlocks, validateMonthLenBlocks),
+		paramtypes.NewParamSetPair(KeyEpochLenBlocks, &p.EpochLenBlocks, validateEpochLenBlocks),
+		paramtypes.NewParamSetPair(KeyQuotaBpsPerEpochHot, &p.QuotaBpsPerEpochHot, validateBps),
+		paramtypes.NewParamSetPair(KeyQuotaBpsPerEpochCold, &p.QuotaBpsPerEpochCold, validateBps),
+		paramtypes.NewParamSetPair(KeyQuotaMinBlobs, &p.QuotaMinBlobs, validateQuotaBlobs),
+		paramtypes.NewParamSetPair(KeyQuotaMaxBlobs, &p.QuotaMaxBlobs, validateQuotaBlobs),
+		paramtypes.NewParamSetPair(KeyCreditCapBps, &p.CreditCapBps, validateBps),
+		paramtypes.NewParamSetPair(KeyMinProviderBond, &p.MinProviderBond, validateBondCoin),
+		paramtypes.NewParamSetPair(KeyProviderBondPerGib, &p.ProviderBondPerGib, validateBondCoin),
+		paramtypes.NewParamSetPair(KeyProviderUnbonding, &p.ProviderUnbondingBlocks, validateProviderUnbondingBlocks),
+		paramtypes.NewParamSetPair(KeySlashMissedProofBps, &p.SlashMissedProofBps, validateBps),
+		paramtypes.NewParamSetPair(KeySlashInvalidProofBps, &p.SlashInvalidProofBps, validateBps),
+		paramtypes.NewParamSetPair(KeyJailBondThresholdBps, &p.JailBondThresholdBps, validateBps),
+		paramtypes.NewParamSetPair(KeyProviderMigration, &p.ProviderMigrationBlocks, validateProviderMigrationBlocks),
+		paramtypes.NewParamSetPair(KeySessionRetention, &p.RetrievalSessionRetentionBlocks, validateRetrievalSessionRetentionBlocks),
+		paramtypes.NewParamSetPair(KeyAutoRepairFailures, &p.AutoRepairFailureThreshold, validateAutoRepairThreshold),
+		paramtypes.NewParamSetPair(KeyAutoRepairMissed, &p.AutoRepairMissedProofThreshold, validateAutoRepairThreshold),
+		paramtypes.NewParamSetPair(KeyAutoRepairCooldown, &p.AutoRepairCooldownBlocks, validateAutoRepairCooldownBlocks),
+		paramtypes.NewParamSetPair(KeyRepairSampleBlobs, &p.RepairSampleBlobs, validateRepairSampleBlobs),
+		paramtypes.NewParamSetPair(KeyRepairDeadlineBlocks, &p.RepairDeadlineBlocks, validateRepairDeadlineBlocks),
+		paramtypes.NewParamSetPair(KeyRepairSlashBps, &p.RepairSlashBps, validateBps),
+		paramtypes.NewParamSetPair(KeyRepairBountyBps, &p.RepairBountyBps, validateBps),
+		paramtypes.NewParamSetPair(KeyFraudSlashBps, &p.FraudSlashBps, validateBps),
+		paramtypes.NewParamSetPair(KeyFraudReporterReward, &p.FraudReporterRewardBps, validateBps),
+		paramtypes.NewParamSetPair(KeyPrecompileCallGas, &p.PrecompileCallGas, validatePrecompileGas),
+		paramtypes.NewParamSetPair(KeyPrecompileViewCallGas, &p.PrecompileViewCallGas, validatePrecompileGas),
+		paramtypes.NewParamSetPair(KeyPrecompileCalldataGas, &p.PrecompileCalldataByteGas, validatePrecompileGas),
+		paramtypes.NewParamSetPair(KeyPrecompileOutputGas, &p.PrecompileOutputByteGas, validatePrecompileGas),
+		paramtypes.NewParamSetPair(KeyPrecompileReadGas, &p.PrecompileStoreReadGas, validatePrecompileGas),
+		paramtypes.NewParamSetPair(KeyPrecompileWriteGas, &p.PrecompileStoreWriteGas, validatePrecompileGas),
+		paramtypes.NewParamSetPair(KeyPrecompileProofGas, &p.PrecompileProofVerifyGas, validatePrecompileGas),
+		paramtypes.NewParamSetPair(KeyPrecompileSessionGas, &p.PrecompileSessionOpenGas, validatePrecompileGas),
 	}
 }
 
@@ -120,6 +295,95 @@ func (p Params) Validate() error {
 	if err := validateMonthLenBlocks(p.MonthLenBlocks); err != nil {
 		return err
 	}
+	if err := validateEpochLenBlocks(p.EpochLenBlocks); err != nil {
+		return err
+	}
+	if err := validateBps(p.QuotaBpsPerEpochHot); err != nil {
+		return fmt.Errorf("quota_bps_per_epoch_hot: %w", err)
+	}
+	if err := validateBps(p.QuotaBpsPerEpochCold); err != nil {
+		return fmt.Errorf("quota_bps_per_epoch_cold: %w", err)
+	}
+	if err := validateQuotaBlobs(p.QuotaMinBlobs); err != nil {
+		return err
+	}
+	if err := validateQuotaBlobs(p.QuotaMaxBlobs); err != nil {
+		return err
+	}
+	if p.QuotaMinBlobs > p.QuotaMaxBlobs {
+		return fmt.Errorf("quota_min_blobs (%d) must be <= quota_max_blobs (%d)", p.QuotaMinBlobs, p.QuotaMaxBlobs)
+	}
+	if err := validateBps(p.CreditCapBps); err != nil {
+		return fmt.Errorf("credit_cap_bps: %w", err)
+	}
+	if err := validateBondCoin(p.MinProviderBond); err != nil {
+		return fmt.Errorf("min_provider_bond: %w", err)
+	}
+	if err := validateBondCoin(p.ProviderBondPerGib); err != nil {
+		return fmt.Errorf("provider_bond_per_gib: %w", err)
+	}
+	if err := validateProviderUnbondingBlocks(p.ProviderUnbondingBlocks); err != nil {
+		return err
+	}
+	if err := validateBps(p.SlashMissedProofBps); err != nil {
+		return fmt.Errorf("slash_missed_proof_bps: %w", err)
+	}
+	if err := validateBps(p.SlashInvalidProofBps); err != nil {
+		return fmt.Errorf("slash_invalid_proof_bps: %w", err)
+	}
+	if err := validateBps(p.JailBondThresholdBps); err != nil {
+		return fmt.Errorf("jail_bond_threshold_bps: %w", err)
+	}
+	if err := validateProviderMigrationBlocks(p.ProviderMigrationBlocks); err != nil {
+		return err
+	}
+	if err := validateRetrievalSessionRetentionBlocks(p.RetrievalSessionRetentionBlocks); err != nil {
+		return err
+	}
+	if err := validateAutoRepairThreshold(p.AutoRepairFailureThreshold); err != nil {
+		return fmt.Errorf("auto_repair_failure_threshold: %w", err)
+	}
+	if err := validateAutoRepairThreshold(p.AutoRepairMissedProofThreshold); err != nil {
+		return fmt.Errorf("auto_repair_missed_proof_threshold: %w", err)
+	}
+	if err := validateAutoRepairCooldownBlocks(p.AutoRepairCooldownBlocks); err != nil {
+		return err
+	}
+	if err := validateRepairSampleBlobs(p.RepairSampleBlobs); err != nil {
+		return err
+	}
+	if err := validateRepairDeadlineBlocks(p.RepairDeadlineBlocks); err != nil {
+		return err
+	}
+	if err := validateBps(p.RepairSlashBps); err != nil {
+		return fmt.Errorf("repair_slash_bps: %w", err)
+	}
+	if err := validateBps(p.RepairBountyBps); err != nil {
+		return fmt.Errorf("repair_bounty_bps: %w", err)
+	}
+	if err := validateBps(p.FraudSlashBps); err != nil {
+		return fmt.Errorf("fraud_slash_bps: %w", err)
+	}
+	if err := validateBps(p.FraudReporterRewardBps); err != nil {
+		return fmt.Errorf("fraud_reporter_reward_bps: %w", err)
+	}
+	for _, g := range []struct {
+		name  string
+		value uint64
+	}{
+		{"precompile_call_gas", p.PrecompileCallGas},
+		{"precompile_view_call_gas", p.PrecompileViewCallGas},
+		{"precompile_calldata_byte_gas", p.PrecompileCalldataByteGas},
+		{"precompile_output_byte_gas", p.PrecompileOutputByteGas},
+		{"precompile_store_read_gas", p.PrecompileStoreReadGas},
+		{"precompile_store_write_gas", p.PrecompileStoreWriteGas},
+		{"precompile_proof_verify_gas", p.PrecompileProofVerifyGas},
+		{"precompile_session_open_gas", p.PrecompileSessionOpenGas},
+	} {
+		if err := validatePrecompileGas(g.value); err != nil {
+			return fmt.Errorf("%s: %w", g.name, err)
+		}
+	}
 	return nil
 }
 
@@ -223,3 +487,126 @@ func validateMonthLenBlocks(i interface{}) error {
 	}
 	return nil
 }
+
+func validateEpochLenBlocks(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("epoch_len_blocks must be non-zero")
+	}
+	return nil
+}
+
+func validateBps(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > 10000 {
+		return fmt.Errorf("bps must be <= 10000 (got %d)", v)
+	}
+	return nil
+}
+
+func validateQuotaBlobs(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > MaxQuotaBlobs {
+		return fmt.Errorf("quota blobs must be <= %d (got %d)", MaxQuotaBlobs, v)
+	}
+	return nil
+}
+
+func validateBondCoin(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.IsValid() {
+		return fmt.Errorf("invalid bond amount: %s", v)
+	}
+	if strings.TrimSpace(v.Denom) != strings.TrimSpace(sdk.DefaultBondDenom) {
+		return fmt.Errorf("bond denom must be %q (got %q)", sdk.DefaultBondDenom, v.Denom)
+	}
+	return nil
+}
+
+func validateProviderUnbondingBlocks(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("provider_unbonding_blocks must be non-zero")
+	}
+	return nil
+}
+
+func validateProviderMigrationBlocks(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("provider_migration_blocks must be non-zero")
+	}
+	return nil
+}
+
+func validateRetrievalSessionRetentionBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateAutoRepairThreshold(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateAutoRepairCooldownBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateRepairSampleBlobs(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("repair_sample_blobs must be non-zero")
+	}
+	return nil
+}
+
+func validateRepairDeadlineBlocks(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("repair_deadline_blocks must be non-zero")
+	}
+	return nil
+}
+
+func validatePrecompileGas(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("gas must be non-zero")
+	}
+	return nil
+}
@@ -41,6 +41,48 @@ type Params struct {
 	// When the chain height exceeds spend_window_start_height + month_len_blocks,
 	// the window resets and spend_window_spent returns to 0.
 	MonthLenBlocks uint64 `protobuf:"varint,10,opt,name=month_len_blocks,json=monthLenBlocks,proto3" json:"month_len_blocks,omitempty"`
+	// --- Unified liveness (rfcs/rfc-challenge-derivation-and-quotas.md) ---
+	EpochLenBlocks       uint64 `protobuf:"varint,11,opt,name=epoch_len_blocks,json=epochLenBlocks,proto3" json:"epoch_len_blocks,omitempty"`
+	QuotaBpsPerEpochHot  uint64 `protobuf:"varint,12,opt,name=quota_bps_per_epoch_hot,json=quotaBpsPerEpochHot,proto3" json:"quota_bps_per_epoch_hot,omitempty"`
+	QuotaBpsPerEpochCold uint64 `protobuf:"varint,13,opt,name=quota_bps_per_epoch_cold,json=quotaBpsPerEpochCold,proto3" json:"quota_bps_per_epoch_cold,omitempty"`
+	QuotaMinBlobs        uint64 `protobuf:"varint,14,opt,name=quota_min_blobs,json=quotaMinBlobs,proto3" json:"quota_min_blobs,omitempty"`
+	QuotaMaxBlobs        uint64 `protobuf:"varint,15,opt,name=quota_max_blobs,json=quotaMaxBlobs,proto3" json:"quota_max_blobs,omitempty"`
+	CreditCapBps         uint64 `protobuf:"varint,16,opt,name=credit_cap_bps,json=creditCapBps,proto3" json:"credit_cap_bps,omitempty"`
+	// --- Provider collateral ---
+	// Required bond = max(min_provider_bond, provider_bond_per_gib * ceil(total_storage / GiB)).
+	MinProviderBond         types.Coin `protobuf:"bytes,17,opt,name=min_provider_bond,json=minProviderBond,proto3" json:"min_provider_bond"`
+	ProviderBondPerGib      types.Coin `protobuf:"bytes,18,opt,name=provider_bond_per_gib,json=providerBondPerGib,proto3" json:"provider_bond_per_gib"`
+	ProviderUnbondingBlocks uint64     `protobuf:"varint,19,opt,name=provider_unbonding_blocks,json=providerUnbondingBlocks,proto3" json:"provider_unbonding_blocks,omitempty"`
+	SlashMissedProofBps     uint64     `protobuf:"varint,20,opt,name=slash_missed_proof_bps,json=slashMissedProofBps,proto3" json:"slash_missed_proof_bps,omitempty"`
+	SlashInvalidProofBps    uint64     `protobuf:"varint,21,opt,name=slash_invalid_proof_bps,json=slashInvalidProofBps,proto3" json:"slash_invalid_proof_bps,omitempty"`
+	JailBondThresholdBps    uint64     `protobuf:"varint,22,opt,name=jail_bond_threshold_bps,json=jailBondThresholdBps,proto3" json:"jail_bond_threshold_bps,omitempty"`
+	// --- Provider lifecycle ---
+	ProviderMigrationBlocks uint64 `protobuf:"varint,23,opt,name=provider_migration_blocks,json=providerMigrationBlocks,proto3" json:"provider_migration_blocks,omitempty"`
+	// --- Retrieval session housekeeping ---
+	RetrievalSessionRetentionBlocks uint64 `protobuf:"varint,24,opt,name=retrieval_session_retention_blocks,json=retrievalSessionRetentionBlocks,proto3" json:"retrieval_session_retention_blocks,omitempty"`
+	// --- Mode 2 self-healing ---
+	AutoRepairFailureThreshold     uint64 `protobuf:"varint,25,opt,name=auto_repair_failure_threshold,json=autoRepairFailureThreshold,proto3" json:"auto_repair_failure_threshold,omitempty"`
+	AutoRepairMissedProofThreshold uint64 `protobuf:"varint,26,opt,name=auto_repair_missed_proof_threshold,json=autoRepairMissedProofThreshold,proto3" json:"auto_repair_missed_proof_threshold,omitempty"`
+	AutoRepairCooldownBlocks       uint64 `protobuf:"varint,27,opt,name=auto_repair_cooldown_blocks,json=autoRepairCooldownBlocks,proto3" json:"auto_repair_cooldown_blocks,omitempty"`
+	// --- Verifiable slot repair ---
+	RepairSampleBlobs    uint64 `protobuf:"varint,28,opt,name=repair_sample_blobs,json=repairSampleBlobs,proto3" json:"repair_sample_blobs,omitempty"`
+	RepairDeadlineBlocks uint64 `protobuf:"varint,29,opt,name=repair_deadline_blocks,json=repairDeadlineBlocks,proto3" json:"repair_deadline_blocks,omitempty"`
+	RepairSlashBps       uint64 `protobuf:"varint,30,opt,name=repair_slash_bps,json=repairSlashBps,proto3" json:"repair_slash_bps,omitempty"`
+	RepairBountyBps      uint64 `protobuf:"varint,31,opt,name=repair_bounty_bps,json=repairBountyBps,proto3" json:"repair_bounty_bps,omitempty"`
+	// --- Fraud proofs ---
+	FraudSlashBps          uint64 `protobuf:"varint,32,opt,name=fraud_slash_bps,json=fraudSlashBps,proto3" json:"fraud_slash_bps,omitempty"`
+	FraudReporterRewardBps uint64 `protobuf:"varint,33,opt,name=fraud_reporter_reward_bps,json=fraudReporterRewardBps,proto3" json:"fraud_reporter_reward_bps,omitempty"`
+	// --- NilStore EVM precompile gas schedule ---
+	// A call costs its base gas plus calldata bytes, plus the keeper reads and
+	// writes, proof verifications and retrieval sessions its method performs.
+	PrecompileCallGas         uint64 `protobuf:"varint,34,opt,name=precompile_call_gas,json=precompileCallGas,proto3" json:"precompile_call_gas,omitempty"`
+	PrecompileViewCallGas     uint64 `protobuf:"varint,35,opt,name=precompile_view_call_gas,json=precompileViewCallGas,proto3" json:"precompile_view_call_gas,omitempty"`
+	PrecompileCalldataByteGas uint64 `protobuf:"varint,36,opt,name=precompile_calldata_byte_gas,json=precompileCalldataByteGas,proto3" json:"precompile_calldata_byte_gas,omitempty"`
+	PrecompileOutputByteGas   uint64 `protobuf:"varint,37,opt,name=precompile_output_byte_gas,json=precompileOutputByteGas,proto3" json:"precompile_output_byte_gas,omitempty"`
+	PrecompileStoreReadGas    uint64 `protobuf:"varint,38,opt,name=precompile_store_read_gas,json=precompileStoreReadGas,proto3" json:"precompile_store_read_gas,omitempty"`
+	PrecompileStoreWriteGas   uint64 `protobuf:"varint,39,opt,name=precompile_store_write_gas,json=precompileStoreWriteGas,proto3" json:"precompile_store_write_gas,omitempty"`
+	PrecompileProofVerifyGas  uint64 `protobuf:"varint,40,opt,name=precompile_proof_verify_gas,json=precompileProofVerifyGas,proto3" json:"precompile_proof_verify_gas,omitempty"`
+	PrecompileSessionOpenGas  uint64 `protobuf:"varint,41,opt,name=precompile_session_open_gas,json=precompileSessionOpenGas,proto3" json:"precompile_session_open_gas,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -139,6 +181,223 @@ func (m *Params) GetMonthLenBlocks() uint64 {
 	return 0
 }
 
+func (m *Params) GetEpochLenBlocks() uint64 {
+	if m != nil {
+		return m.EpochLenBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetQuotaBpsPerEpochHot() uint64 {
+	if m != nil {
+		return m.QuotaBpsPerEpochHot
+	}
+	return 0
+}
+
+func (m *Params) GetQuotaBpsPerEpochCold() uint64 {
+	if m != nil {
+		return m.QuotaBpsPerEpochCold
+	}
+	return 0
+}
+
+func (m *Params) GetQuotaMinBlobs() uint64 {
+	if m != nil {
+		return m.QuotaMinBlobs
+	}
+	return 0
+}
+
+func (m *Params) GetQuotaMaxBlobs() uint64 {
+	if m != nil {
+		return m.QuotaMaxBlobs
+	}
+	return 0
+}
+
+func (m *Params) GetCreditCapBps() uint64 {
+	if m != nil {
+		return m.CreditCapBps
+	}
+	return 0
+}
+
+func (m *Params) GetMinProviderBond() types.Coin {
+	if m != nil {
+		return m.MinProviderBond
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetProviderBondPerGib() types.Coin {
+	if m != nil {
+		return m.ProviderBondPerGib
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetProviderUnbondingBlocks() uint64 {
+	if m != nil {
+		return m.ProviderUnbondingBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSlashMissedProofBps() uint64 {
+	if m != nil {
+		return m.SlashMissedProofBps
+	}
+	return 0
+}
+
+func (m *Params) GetSlashInvalidProofBps() uint64 {
+	if m != nil {
+		return m.SlashInvalidProofBps
+	}
+	return 0
+}
+
+func (m *Params) GetJailBondThresholdBps() uint64 {
+	if m != nil {
+		return m.JailBondThresholdBps
+	}
+	return 0
+}
+
+func (m *Params) GetProviderMigrationBlocks() uint64 {
+	if m != nil {
+		return m.ProviderMigrationBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetRetrievalSessionRetentionBlocks() uint64 {
+	if m != nil {
+		return m.RetrievalSessionRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetAutoRepairFailureThreshold() uint64 {
+	if m != nil {
+		return m.AutoRepairFailureThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetAutoRepairMissedProofThreshold() uint64 {
+	if m != nil {
+		return m.AutoRepairMissedProofThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetAutoRepairCooldownBlocks() uint64 {
+	if m != nil {
+		return m.AutoRepairCooldownBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetRepairSampleBlobs() uint64 {
+	if m != nil {
+		return m.RepairSampleBlobs
+	}
+	return 0
+}
+
+func (m *Params) GetRepairDeadlineBlocks() uint64 {
+	if m != nil {
+		return m.RepairDeadlineBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetRepairSlashBps() uint64 {
+	if m != nil {
+		return m.RepairSlashBps
+	}
+	return 0
+}
+
+func (m *Params) GetRepairBountyBps() uint64 {
+	if m != nil {
+		return m.RepairBountyBps
+	}
+	return 0
+}
+
+func (m *Params) GetFraudSlashBps() uint64 {
+	if m != nil {
+		return m.FraudSlashBps
+	}
+	return 0
+}
+
+func (m *Params) GetFraudReporterRewardBps() uint64 {
+	if m != nil {
+		return m.FraudReporterRewardBps
+	}
+	return 0
+}
+
+func (m *Params) GetPrecompileCallGas() uint64 {
+	if m != nil {
+		return m.PrecompileCallGas
+	}
+	return 0
+}
+
+func (m *Params) GetPrecompileViewCallGas() uint64 {
+	if m != nil {
+		return m.PrecompileViewCallGas
+	}
+	return 0
+}
+
+func (m *Params) GetPrecompileCalldataByteGas() uint64 {
+	if m != nil {
+		return m.PrecompileCalldataByteGas
+	}
+	return 0
+}
+
+func (m *Params) GetPrecompileOutputByteGas() uint64 {
+	if m != nil {
+		return m.PrecompileOutputByteGas
+	}
+	return 0
+}
+
+func (m *Params) GetPrecompileStoreReadGas() uint64 {
+	if m != nil {
+		return m.PrecompileStoreReadGas
+	}
+	return 0
+}
+
+func (m *Params) GetPrecompileStoreWriteGas() uint64 {
+	if m != nil {
+		return m.PrecompileStoreWriteGas
+	}
+	return 0
+}
+
+func (m *Params) GetPrecompileProofVerifyGas() uint64 {
+	if m != nil {
+		return m.PrecompileProofVerifyGas
+	}
+	return 0
+}
+
+func (m *Params) GetPrecompileSessionOpenGas() uint64 {
+	if m != nil {
+		return m.PrecompileSessionOpenGas
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "nilchain.nilchain.v1.Params")
 }
@@ -146,39 +405,81 @@ func init() {
 func init() { proto.RegisterFile("nilchain/nilchain/v1/params.proto", fileDescriptor_8ae414f9073848ab) }
 
 var fileDescriptor_8ae414f9073848ab = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x21, 0x14, 0xba, 0x50, 0x92, 0x2c, 0x45, 0x72, 0x83, 0xe4, 0x04, 0x2a, 0xa1, 0x80,
-	0x90, 0x2d, 0xb7, 0x07, 0x24, 0x8e, 0x4e, 0x85, 0xa8, 0x28, 0x52, 0x14, 0x2e, 0x88, 0xcb, 0x6a,
-	0x6d, 0x0f, 0xce, 0xaa, 0xf6, 0xae, 0xb5, 0xbb, 0xb1, 0xe8, 0x2f, 0x70, 0xe2, 0x13, 0xf8, 0x04,
-	0x3e, 0xa3, 0xc7, 0x1e, 0x11, 0x87, 0x0a, 0x25, 0x07, 0x38, 0xf3, 0x05, 0x68, 0xd7, 0xae, 0x73,
-	0xe1, 0xd0, 0xcb, 0x6a, 0xf4, 0x66, 0xde, 0x9b, 0x9d, 0xa7, 0x87, 0x1e, 0x73, 0x96, 0x27, 0x0b,
-	0xca, 0x78, 0xd0, 0x16, 0x55, 0x18, 0x94, 0x54, 0xd2, 0x42, 0xf9, 0xa5, 0x14, 0x5a, 0xe0, 0xdd,
-	0xab, 0x8e, 0xdf, 0x16, 0x55, 0x38, 0x1c, 0xd0, 0x82, 0x71, 0x11, 0xd8, 0xb7, 0x1e, 0x1c, 0xee,
-	0x66, 0x22, 0x13, 0xb6, 0x0c, 0x4c, 0xd5, 0xa0, 0x5e, 0x22, 0x54, 0x21, 0x54, 0x10, 0x53, 0x05,
-	0x41, 0x15, 0xc6, 0xa0, 0x69, 0x18, 0x24, 0x82, 0xf1, 0xba, 0xff, 0xe4, 0x6f, 0x17, 0x6d, 0xcd,
-	0xec, 0x3e, 0x3c, 0x41, 0x7d, 0x33, 0x45, 0x94, 0x96, 0xac, 0x04, 0x92, 0x08, 0xa5, 0x5d, 0x67,
-	0xec, 0x4c, 0xba, 0xf3, 0xfb, 0x06, 0x7f, 0x6f, 0xe1, 0xa9, 0x50, 0x1a, 0x3f, 0x43, 0xfd, 0x05,
-	0xcd, 0x2b, 0xc6, 0x33, 0xc2, 0xb8, 0x06, 0x59, 0xd1, 0xdc, 0xbd, 0x61, 0x27, 0x7b, 0x0d, 0x7e,
-	0xdc, 0xc0, 0xf8, 0x29, 0xea, 0x01, 0x2b, 0x5f, 0x86, 0x07, 0xc4, 0xfe, 0x9d, 0xb0, 0xd4, 0xbd,
-	0x69, 0x27, 0x77, 0x6a, 0x78, 0x6a, 0xd0, 0xe3, 0x14, 0xbf, 0x41, 0x3b, 0x4a, 0x0b, 0x49, 0x33,
-	0x20, 0xa5, 0x64, 0x09, 0xb8, 0xdd, 0xb1, 0x33, 0xd9, 0x8e, 0xf6, 0xcf, 0x2f, 0x47, 0x9d, 0x9f,
-	0x97, 0xa3, 0x47, 0xf5, 0x19, 0x2a, 0x3d, 0xf5, 0x99, 0x08, 0x0a, 0xaa, 0x17, 0xfe, 0x09, 0x64,
-	0x34, 0x39, 0x3b, 0x82, 0x64, 0x7e, 0xaf, 0x61, 0xce, 0x0c, 0x11, 0xbf, 0x45, 0x83, 0x14, 0x68,
-	0x4e, 0x12, 0x09, 0x54, 0x33, 0xc1, 0xc9, 0x27, 0x00, 0xf7, 0xd6, 0xd8, 0x99, 0xdc, 0x3d, 0xd8,
-	0xf3, 0x6b, 0x19, 0xdf, 0xdc, 0xe3, 0x37, 0x6e, 0xf8, 0x53, 0xc1, 0x78, 0xd4, 0x35, 0x8b, 0xe6,
-	0x3d, 0xc3, 0x9c, 0x36, 0xc4, 0xd7, 0x00, 0xd8, 0x47, 0x0f, 0x0a, 0xc6, 0x49, 0xba, 0x94, 0xb5,
-	0x56, 0x9c, 0x8b, 0xe4, 0x54, 0xb9, 0x5b, 0xf6, 0x84, 0x41, 0xc1, 0xf8, 0x51, 0xd3, 0x89, 0x6c,
-	0x03, 0xbf, 0x43, 0xd8, 0x7a, 0x28, 0x41, 0x4b, 0x06, 0x15, 0xcd, 0xed, 0xf6, 0xdb, 0xd7, 0xdb,
-	0x6e, 0xed, 0x9f, 0x5f, 0x31, 0xcd, 0xfa, 0x0f, 0xc8, 0xdd, 0x28, 0x59, 0x5f, 0x48, 0x09, 0xd2,
-	0xfc, 0x22, 0x76, 0xef, 0x5c, 0x4f, 0xf4, 0x61, 0x2b, 0x60, 0xed, 0x99, 0x81, 0x8c, 0x72, 0x11,
-	0xe3, 0x17, 0x08, 0x6f, 0x94, 0xe3, 0xa5, 0xe4, 0x24, 0x2e, 0x95, 0xbb, 0x6d, 0xef, 0xea, 0xb7,
-	0x9d, 0x68, 0x29, 0x79, 0x54, 0xda, 0x68, 0x14, 0x82, 0xeb, 0x05, 0xc9, 0xa1, 0xf5, 0x00, 0xd5,
-	0xd1, 0xb0, 0xf8, 0x09, 0x34, 0x06, 0xbc, 0xda, 0xff, 0xf3, 0x6d, 0xe4, 0x7c, 0xf9, 0xfd, 0xfd,
-	0xf9, 0xb0, 0x4d, 0xf4, 0xe7, 0x4d, 0xb8, 0xeb, 0xa4, 0x45, 0x87, 0xe7, 0x2b, 0xcf, 0xb9, 0x58,
-	0x79, 0xce, 0xaf, 0x95, 0xe7, 0x7c, 0x5d, 0x7b, 0x9d, 0x8b, 0xb5, 0xd7, 0xf9, 0xb1, 0xf6, 0x3a,
-	0x1f, 0xf7, 0xfe, 0xc7, 0xd2, 0x67, 0x25, 0xa8, 0x78, 0xcb, 0x06, 0xf6, 0xf0, 0x5f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xfa, 0xc0, 0x3b, 0x06, 0x34, 0x03, 0x00, 0x00,
+	// 1169 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0x8e, 0x21, 0x04, 0x3a, 0xcd, 0xef, 0xe6, 0x6f, 0xed, 0xb4, 0x4e, 0x9a, 0x94, 0xe0, 0x56,
+	0xc8, 0x56, 0x9a, 0x42, 0xd5, 0xa2, 0x0a, 0x61, 0x87, 0xa6, 0x21, 0x8d, 0x6a, 0x39, 0x50, 0x10,
+	0x37, 0xab, 0xd9, 0xdd, 0x13, 0x7b, 0xe8, 0xee, 0xce, 0x32, 0x33, 0x76, 0xe2, 0x57, 0xe0, 0x8a,
+	0x47, 0xe0, 0x11, 0x78, 0x8c, 0x5e, 0x56, 0xe2, 0x06, 0x71, 0x51, 0xa1, 0xe4, 0x02, 0x1e, 0xa3,
+	0x9a, 0x33, 0xb3, 0xde, 0x75, 0xda, 0x8b, 0xdc, 0x58, 0xab, 0xf3, 0xfd, 0x1c, 0x9f, 0x33, 0xe7,
+	0xec, 0x0e, 0xb9, 0x95, 0xb0, 0x28, 0xe8, 0x51, 0x96, 0x34, 0x46, 0x0f, 0x83, 0x9d, 0x46, 0x4a,
+	0x05, 0x8d, 0x65, 0x3d, 0x15, 0x5c, 0x71, 0x67, 0x29, 0x43, 0xea, 0xa3, 0x87, 0xc1, 0x4e, 0x65,
+	0x81, 0xc6, 0x2c, 0xe1, 0x0d, 0xfc, 0x35, 0xc4, 0xca, 0x52, 0x97, 0x77, 0x39, 0x3e, 0x36, 0xf4,
+	0x93, 0x8d, 0x56, 0x03, 0x2e, 0x63, 0x2e, 0x1b, 0x3e, 0x95, 0xd0, 0x18, 0xec, 0xf8, 0xa0, 0xe8,
+	0x4e, 0x23, 0xe0, 0x2c, 0x31, 0xf8, 0xe6, 0x5f, 0x0e, 0x99, 0x6a, 0x63, 0x3e, 0xa7, 0x46, 0xe6,
+	0x35, 0xcb, 0x93, 0x4a, 0xb0, 0x14, 0xbc, 0x80, 0x4b, 0xe5, 0x96, 0x36, 0x4a, 0xb5, 0xc9, 0xce,
+	0xac, 0x8e, 0x1f, 0x63, 0xb8, 0xc5, 0xa5, 0x72, 0xee, 0x90, 0xf9, 0x1e, 0x8d, 0x06, 0x2c, 0xe9,
+	0x7a, 0x2c, 0x51, 0x20, 0x06, 0x34, 0x72, 0x3f, 0x40, 0xe6, 0x9c, 0x8d, 0x1f, 0xd8, 0xb0, 0xb3,
+	0x4d, 0xe6, 0x80, 0xa5, 0x0f, 0x76, 0xee, 0x79, 0xf8, 0xdf, 0x3d, 0x16, 0xba, 0x1f, 0x22, 0x73,
+	0xc6, 0x84, 0x5b, 0x3a, 0x7a, 0x10, 0x3a, 0x4f, 0xc9, 0x8c, 0x54, 0x5c, 0xd0, 0x2e, 0x78, 0xa9,
+	0x60, 0x01, 0xb8, 0x93, 0x1b, 0xa5, 0xda, 0xb5, 0xe6, 0xd6, 0xab, 0x37, 0xeb, 0x13, 0xff, 0xbc,
+	0x59, 0x5f, 0x33, 0x65, 0xc8, 0xf0, 0x65, 0x9d, 0xf1, 0x46, 0x4c, 0x55, 0xaf, 0xfe, 0x0c, 0xba,
+	0x34, 0x18, 0xee, 0x41, 0xd0, 0x99, 0xb6, 0xca, 0xb6, 0x16, 0x3a, 0x87, 0x64, 0x21, 0x04, 0x1a,
+	0x79, 0x81, 0x00, 0xaa, 0x18, 0x4f, 0xbc, 0x13, 0x00, 0xf7, 0xa3, 0x8d, 0x52, 0xed, 0xfa, 0xbd,
+	0x72, 0xdd, 0xd8, 0xd4, 0x75, 0x3d, 0x75, 0xdb, 0x8d, 0x7a, 0x8b, 0xb3, 0xa4, 0x39, 0xa9, 0x13,
+	0x75, 0xe6, 0xb4, 0xb2, 0x65, 0x85, 0x4f, 0x00, 0x9c, 0x3a, 0x59, 0x8c, 0x59, 0xe2, 0x85, 0x7d,
+	0x61, 0xbc, 0xfc, 0x88, 0x07, 0x2f, 0xa5, 0x3b, 0x85, 0x25, 0x2c, 0xc4, 0x2c, 0xd9, 0xb3, 0x48,
+	0x13, 0x01, 0xe7, 0x88, 0x38, 0xd8, 0x43, 0x01, 0x4a, 0x30, 0x18, 0xd0, 0x08, 0xb3, 0x7f, 0x7c,
+	0xb5, 0xec, 0xd8, 0xfe, 0x4e, 0xa6, 0xd4, 0xe9, 0x7f, 0x22, 0x6e, 0xee, 0x84, 0x7d, 0xf1, 0x52,
+	0x10, 0xfa, 0x5f, 0xf8, 0xee, 0x27, 0x57, 0x33, 0x5d, 0x1e, 0x19, 0x60, 0x7b, 0xda, 0x20, 0x9a,
+	0x11, 0xf7, 0x9d, 0xcf, 0x89, 0x93, 0x3b, 0xfb, 0x7d, 0x91, 0x78, 0x7e, 0x2a, 0xdd, 0x6b, 0x58,
+	0xd7, 0xfc, 0x08, 0x69, 0xf6, 0x45, 0xd2, 0x4c, 0x71, 0x34, 0x62, 0x9e, 0xa8, 0x9e, 0x17, 0xc1,
+	0xa8, 0x07, 0xc4, 0x8c, 0x06, 0xc6, 0x9f, 0x41, 0xd6, 0x80, 0x1a, 0x99, 0x87, 0x94, 0x07, 0x63,
+	0xcc, 0xeb, 0x86, 0x89, 0xf1, 0x9c, 0x79, 0x9f, 0xac, 0xfe, 0xda, 0xe7, 0x8a, 0xea, 0xc4, 0x58,
+	0x95, 0xd1, 0xf5, 0xb8, 0x72, 0xa7, 0x51, 0xb0, 0x88, 0x70, 0x33, 0x95, 0x6d, 0x10, 0xdf, 0x6a,
+	0xec, 0x29, 0x57, 0xce, 0x97, 0xc4, 0x7d, 0x9f, 0x2a, 0xe0, 0x51, 0xe8, 0xce, 0xa0, 0x6c, 0xe9,
+	0xb2, 0xac, 0xc5, 0xa3, 0x50, 0xcf, 0xa1, 0xd1, 0xe9, 0xe3, 0xd4, 0xfd, 0x93, 0xee, 0xac, 0x99,
+	0x43, 0x0c, 0x1f, 0x31, 0xfd, 0xb7, 0x7c, 0x59, 0xe0, 0xd1, 0x33, 0xcb, 0x9b, 0x2b, 0xf2, 0xe8,
+	0x99, 0xe1, 0xdd, 0x26, 0xb3, 0x81, 0x80, 0x90, 0x29, 0x2f, 0xa0, 0x29, 0xf6, 0x6e, 0x1e, 0x69,
+	0xd3, 0x26, 0xda, 0xa2, 0xa9, 0xee, 0xdb, 0x21, 0xd1, 0x33, 0xe2, 0xa5, 0x82, 0x0f, 0x58, 0xa8,
+	0x0f, 0x8e, 0x27, 0xa1, 0xbb, 0x70, 0xc5, 0x59, 0x8c, 0x59, 0xd2, 0xb6, 0xc2, 0x26, 0x4f, 0x42,
+	0xa7, 0x43, 0x96, 0xc7, 0x8c, 0xb0, 0xfc, 0x2e, 0xf3, 0x5d, 0xe7, 0x6a, 0x86, 0x4e, 0x5a, 0x70,
+	0x6b, 0x83, 0xd8, 0x67, 0xbe, 0xf3, 0x88, 0x94, 0x47, 0x9e, 0xfd, 0x44, 0xbb, 0xea, 0xa5, 0xb6,
+	0xe7, 0xb6, 0x88, 0x15, 0xad, 0x66, 0x84, 0x1f, 0x32, 0xdc, 0x1e, 0xe0, 0x2e, 0x59, 0x91, 0x11,
+	0x95, 0x3d, 0x2f, 0x66, 0x52, 0x42, 0xa8, 0xab, 0xe4, 0x27, 0xd8, 0x8a, 0x25, 0x73, 0x7e, 0x88,
+	0x1e, 0x21, 0xd8, 0xd6, 0x98, 0xee, 0xc8, 0x17, 0x64, 0xd5, 0x88, 0x58, 0x32, 0xa0, 0x11, 0x2b,
+	0xaa, 0x96, 0xcd, 0xf1, 0x21, 0x7c, 0x60, 0xd0, 0xa2, 0xec, 0x17, 0xca, 0x22, 0x53, 0xb7, 0xea,
+	0x09, 0x90, 0x3d, 0x1e, 0x85, 0x28, 0x5b, 0x31, 0x32, 0x0d, 0xeb, 0xc2, 0xbe, 0xcf, 0x40, 0x2d,
+	0x2b, 0x96, 0x17, 0xb3, 0xee, 0xf8, 0x12, 0xaf, 0x8e, 0x97, 0x77, 0x94, 0xe1, 0xb6, 0xbc, 0x43,
+	0xb2, 0x99, 0x6f, 0x88, 0x04, 0x29, 0xb5, 0x54, 0x80, 0x82, 0xa4, 0x68, 0xe2, 0xa2, 0xc9, 0xfa,
+	0x88, 0x79, 0x6c, 0x88, 0x9d, 0x8c, 0x67, 0xcd, 0xbe, 0x21, 0x37, 0x69, 0x5f, 0x71, 0x4f, 0x40,
+	0x4a, 0x99, 0xf0, 0x4e, 0x28, 0x8b, 0xfa, 0x02, 0xf2, 0x4a, 0xdc, 0x32, 0xfa, 0x54, 0x34, 0xa9,
+	0x83, 0x9c, 0x27, 0x86, 0x32, 0x2a, 0xc7, 0xf9, 0x8e, 0x6c, 0x16, 0x2d, 0xc6, 0x9a, 0x9e, 0xfb,
+	0x54, 0xd0, 0xa7, 0x9a, 0xfb, 0x14, 0xfa, 0x9f, 0x7b, 0x3d, 0x26, 0x6b, 0x45, 0xaf, 0x80, 0xf3,
+	0x28, 0xe4, 0xa7, 0xa3, 0xa2, 0xd6, 0xd0, 0xc4, 0xcd, 0x4d, 0x5a, 0x96, 0x60, 0xab, 0xa9, 0x93,
+	0x45, 0xab, 0x94, 0x34, 0x4e, 0x23, 0xb0, 0x8b, 0x72, 0xc3, 0xbc, 0x15, 0x0d, 0x74, 0x8c, 0x88,
+	0x59, 0x96, 0xfb, 0x64, 0xc5, 0xf2, 0x43, 0xa0, 0x61, 0xc4, 0x12, 0xc8, 0x32, 0xdd, 0x34, 0x87,
+	0x67, 0xd0, 0x3d, 0x0b, 0xe6, 0xaf, 0x92, 0x2c, 0x0b, 0x4e, 0x8c, 0x3e, 0xec, 0xaa, 0x79, 0x95,
+	0xd8, 0x14, 0x3a, 0xac, 0x8f, 0xf9, 0x2e, 0xb1, 0x49, 0x3d, 0x9f, 0xf7, 0x13, 0x35, 0x44, 0xea,
+	0xba, 0xf9, 0x20, 0x19, 0xa0, 0x89, 0x71, 0xcd, 0xdd, 0x26, 0x73, 0x27, 0x82, 0xf6, 0xc3, 0x82,
+	0xe9, 0x86, 0x59, 0x70, 0x0c, 0x8f, 0x3c, 0x1f, 0x92, 0xb2, 0xe1, 0x09, 0x48, 0xb9, 0x50, 0x20,
+	0x3c, 0x01, 0xa7, 0x54, 0x98, 0x99, 0xbb, 0x85, 0x8a, 0x15, 0x24, 0x74, 0x2c, 0xde, 0x41, 0x58,
+	0x4b, 0xeb, 0x64, 0x31, 0x15, 0x10, 0xf0, 0x38, 0x65, 0x11, 0x78, 0x01, 0x8d, 0x22, 0xaf, 0x4b,
+	0xa5, 0xbb, 0x69, 0xda, 0x93, 0x43, 0x2d, 0x1a, 0x45, 0xfb, 0x54, 0x3a, 0x0f, 0x88, 0x5b, 0xe0,
+	0x0f, 0x18, 0x9c, 0xe6, 0xa2, 0x2d, 0x14, 0x2d, 0xe7, 0xf8, 0x0b, 0x06, 0xa7, 0x99, 0xf0, 0x6b,
+	0x72, 0xe3, 0x52, 0xa2, 0x90, 0xea, 0x57, 0xe3, 0x50, 0x01, 0x8a, 0x6f, 0xa3, 0xb8, 0x3c, 0x9e,
+	0x51, 0x53, 0x9a, 0x43, 0x05, 0xda, 0xe0, 0x2b, 0x52, 0x29, 0x18, 0xf0, 0xbe, 0x4a, 0xfb, 0x2a,
+	0x97, 0x7f, 0x9a, 0x2d, 0x48, 0xc6, 0x78, 0x8e, 0x84, 0x4c, 0xfc, 0x90, 0x14, 0x9c, 0x3d, 0xfd,
+	0x0d, 0xd6, 0xdf, 0x3d, 0x1a, 0xa2, 0x76, 0xdb, 0x74, 0x28, 0x27, 0x1c, 0x6b, 0xbc, 0x03, 0x34,
+	0x7c, 0x37, 0xaf, 0x91, 0x9e, 0x0a, 0x66, 0xf3, 0x7e, 0x76, 0x39, 0x2f, 0x6a, 0x7f, 0xd4, 0xb8,
+	0x16, 0x3f, 0x26, 0x6b, 0x05, 0xb1, 0x59, 0x80, 0x01, 0x08, 0x76, 0x32, 0x44, 0x75, 0xcd, 0x0c,
+	0x6f, 0x4e, 0xc1, 0xd9, 0x7f, 0x81, 0x84, 0x77, 0xe5, 0xd9, 0x62, 0xf3, 0x14, 0x12, 0x94, 0xdf,
+	0xb9, 0x2c, 0xb7, 0x1b, 0xfd, 0x3c, 0x85, 0x64, 0x9f, 0xca, 0x47, 0x5b, 0xff, 0xff, 0xb1, 0x5e,
+	0xfa, 0xed, 0xbf, 0x3f, 0xef, 0x56, 0x46, 0x57, 0xb6, 0xb3, 0xfc, 0xf6, 0x66, 0xae, 0x52, 0xcd,
+	0xdd, 0x57, 0xe7, 0xd5, 0xd2, 0xeb, 0xf3, 0x6a, 0xe9, 0xdf, 0xf3, 0x6a, 0xe9, 0xf7, 0x8b, 0xea,
+	0xc4, 0xeb, 0x8b, 0xea, 0xc4, 0xdf, 0x17, 0xd5, 0x89, 0x9f, 0xcb, 0xef, 0x53, 0xa9, 0x61, 0x0a,
+	0xd2, 0x9f, 0xc2, 0x1b, 0xd9, 0xee, 0xdb, 0x01, 0x00, 0x1f, 0x30, 0x75, 0xc7, 0x15, 0x0a, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -230,6 +531,99 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.MonthLenBlocks != that1.MonthLenBlocks {
 		return false
 	}
+	if this.EpochLenBlocks != that1.EpochLenBlocks {
+		return false
+	}
+	if this.QuotaBpsPerEpochHot != that1.QuotaBpsPerEpochHot {
+		return false
+	}
+	if this.QuotaBpsPerEpochCold != that1.QuotaBpsPerEpochCold {
+		return false
+	}
+	if this.QuotaMinBlobs != that1.QuotaMinBlobs {
+		return false
+	}
+	if this.QuotaMaxBlobs != that1.QuotaMaxBlobs {
+		return false
+	}
+	if this.CreditCapBps != that1.CreditCapBps {
+		return false
+	}
+	if !this.MinProviderBond.Equal(&that1.MinProviderBond) {
+		return false
+	}
+	if !this.ProviderBondPerGib.Equal(&that1.ProviderBondPerGib) {
+		return false
+	}
+	if this.ProviderUnbondingBlocks != that1.ProviderUnbondingBlocks {
+		return false
+	}
+	if this.SlashMissedProofBps != that1.SlashMissedProofBps {
+		return false
+	}
+	if this.SlashInvalidProofBps != that1.SlashInvalidProofBps {
+		return false
+	}
+	if this.JailBondThresholdBps != that1.JailBondThresholdBps {
+		return false
+	}
+	if this.ProviderMigrationBlocks != that1.ProviderMigrationBlocks {
+		return false
+	}
+	if this.RetrievalSessionRetentionBlocks != that1.RetrievalSessionRetentionBlocks {
+		return false
+	}
+	if this.AutoRepairFailureThreshold != that1.AutoRepairFailureThreshold {
+		return false
+	}
+	if this.AutoRepairMissedProofThreshold != that1.AutoRepairMissedProofThreshold {
+		return false
+	}
+	if this.AutoRepairCooldownBlocks != that1.AutoRepairCooldownBlocks {
+		return false
+	}
+	if this.RepairSampleBlobs != that1.RepairSampleBlobs {
+		return false
+	}
+	if this.RepairDeadlineBlocks != that1.RepairDeadlineBlocks {
+		return false
+	}
+	if this.RepairSlashBps != that1.RepairSlashBps {
+		return false
+	}
+	if this.RepairBountyBps != that1.RepairBountyBps {
+		return false
+	}
+	if this.FraudSlashBps != that1.FraudSlashBps {
+		return false
+	}
+	if this.FraudReporterRewardBps != that1.FraudReporterRewardBps {
+		return false
+	}
+	if this.PrecompileCallGas != that1.PrecompileCallGas {
+		return false
+	}
+	if this.PrecompileViewCallGas != that1.PrecompileViewCallGas {
+		return false
+	}
+	if this.PrecompileCalldataByteGas != that1.PrecompileCalldataByteGas {
+		return false
+	}
+	if this.PrecompileOutputByteGas != that1.PrecompileOutputByteGas {
+		return false
+	}
+	if this.PrecompileStoreReadGas != that1.PrecompileStoreReadGas {
+		return false
+	}
+	if this.PrecompileStoreWriteGas != that1.PrecompileStoreWriteGas {
+		return false
+	}
+	if this.PrecompileProofVerifyGas != that1.PrecompileProofVerifyGas {
+		return false
+	}
+	if this.PrecompileSessionOpenGas != that1.PrecompileSessionOpenGas {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -252,46 +646,263 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.MonthLenBlocks != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.MonthLenBlocks))
+	if m.PrecompileSessionOpenGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PrecompileSessionOpenGas))
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc8
 	}
-	if m.RetrievalBurnBps != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.RetrievalBurnBps))
+	if m.PrecompileProofVerifyGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PrecompileProofVerifyGas))
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
 	}
-	{
-		size, err := m.RetrievalPricePerBlob.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintParams(dAtA, i, uint64(size))
+	if m.PrecompileStoreWriteGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PrecompileStoreWriteGas))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size, err := m.BaseRetrievalFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintParams(dAtA, i, uint64(size))
+	if m.PrecompileStoreReadGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PrecompileStoreReadGas))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
 	}
-	i--
-	dAtA[i] = 0x3a
-	if m.MinDurationBlocks != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.MinDurationBlocks))
+	if m.PrecompileOutputByteGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PrecompileOutputByteGas))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
 	}
-	{
-		size, err := m.DealCreationFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
+	if m.PrecompileCalldataByteGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PrecompileCalldataByteGas))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.PrecompileViewCallGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PrecompileViewCallGas))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.PrecompileCallGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PrecompileCallGas))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.FraudReporterRewardBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FraudReporterRewardBps))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.FraudSlashBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FraudSlashBps))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.RepairBountyBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RepairBountyBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.RepairSlashBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RepairSlashBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.RepairDeadlineBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RepairDeadlineBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.RepairSampleBlobs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RepairSampleBlobs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.AutoRepairCooldownBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoRepairCooldownBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.AutoRepairMissedProofThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoRepairMissedProofThreshold))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.AutoRepairFailureThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoRepairFailureThreshold))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.RetrievalSessionRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RetrievalSessionRetentionBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.ProviderMigrationBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ProviderMigrationBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.JailBondThresholdBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.JailBondThresholdBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.SlashInvalidProofBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SlashInvalidProofBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.SlashMissedProofBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SlashMissedProofBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.ProviderUnbondingBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ProviderUnbondingBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	{
+		size, err := m.ProviderBondPerGib.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size, err := m.MinProviderBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.CreditCapBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CreditCapBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.QuotaMaxBlobs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.QuotaMaxBlobs))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.QuotaMinBlobs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.QuotaMinBlobs))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.QuotaBpsPerEpochCold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.QuotaBpsPerEpochCold))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.QuotaBpsPerEpochHot != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.QuotaBpsPerEpochHot))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.EpochLenBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EpochLenBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MonthLenBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MonthLenBlocks))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RetrievalBurnBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RetrievalBurnBps))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size, err := m.RetrievalPricePerBlob.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.BaseRetrievalFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.MinDurationBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinDurationBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.DealCreationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
 		i -= size
 		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
@@ -368,6 +979,97 @@ func (m *Params) Size() (n int) {
 	if m.MonthLenBlocks != 0 {
 		n += 1 + sovParams(uint64(m.MonthLenBlocks))
 	}
+	if m.EpochLenBlocks != 0 {
+		n += 1 + sovParams(uint64(m.EpochLenBlocks))
+	}
+	if m.QuotaBpsPerEpochHot != 0 {
+		n += 1 + sovParams(uint64(m.QuotaBpsPerEpochHot))
+	}
+	if m.QuotaBpsPerEpochCold != 0 {
+		n += 1 + sovParams(uint64(m.QuotaBpsPerEpochCold))
+	}
+	if m.QuotaMinBlobs != 0 {
+		n += 1 + sovParams(uint64(m.QuotaMinBlobs))
+	}
+	if m.QuotaMaxBlobs != 0 {
+		n += 1 + sovParams(uint64(m.QuotaMaxBlobs))
+	}
+	if m.CreditCapBps != 0 {
+		n += 2 + sovParams(uint64(m.CreditCapBps))
+	}
+	l = m.MinProviderBond.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.ProviderBondPerGib.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.ProviderUnbondingBlocks != 0 {
+		n += 2 + sovParams(uint64(m.ProviderUnbondingBlocks))
+	}
+	if m.SlashMissedProofBps != 0 {
+		n += 2 + sovParams(uint64(m.SlashMissedProofBps))
+	}
+	if m.SlashInvalidProofBps != 0 {
+		n += 2 + sovParams(uint64(m.SlashInvalidProofBps))
+	}
+	if m.JailBondThresholdBps != 0 {
+		n += 2 + sovParams(uint64(m.JailBondThresholdBps))
+	}
+	if m.ProviderMigrationBlocks != 0 {
+		n += 2 + sovParams(uint64(m.ProviderMigrationBlocks))
+	}
+	if m.RetrievalSessionRetentionBlocks != 0 {
+		n += 2 + sovParams(uint64(m.RetrievalSessionRetentionBlocks))
+	}
+	if m.AutoRepairFailureThreshold != 0 {
+		n += 2 + sovParams(uint64(m.AutoRepairFailureThreshold))
+	}
+	if m.AutoRepairMissedProofThreshold != 0 {
+		n += 2 + sovParams(uint64(m.AutoRepairMissedProofThreshold))
+	}
+	if m.AutoRepairCooldownBlocks != 0 {
+		n += 2 + sovParams(uint64(m.AutoRepairCooldownBlocks))
+	}
+	if m.RepairSampleBlobs != 0 {
+		n += 2 + sovParams(uint64(m.RepairSampleBlobs))
+	}
+	if m.RepairDeadlineBlocks != 0 {
+		n += 2 + sovParams(uint64(m.RepairDeadlineBlocks))
+	}
+	if m.RepairSlashBps != 0 {
+		n += 2 + sovParams(uint64(m.RepairSlashBps))
+	}
+	if m.RepairBountyBps != 0 {
+		n += 2 + sovParams(uint64(m.RepairBountyBps))
+	}
+	if m.FraudSlashBps != 0 {
+		n += 2 + sovParams(uint64(m.FraudSlashBps))
+	}
+	if m.FraudReporterRewardBps != 0 {
+		n += 2 + sovParams(uint64(m.FraudReporterRewardBps))
+	}
+	if m.PrecompileCallGas != 0 {
+		n += 2 + sovParams(uint64(m.PrecompileCallGas))
+	}
+	if m.PrecompileViewCallGas != 0 {
+		n += 2 + sovParams(uint64(m.PrecompileViewCallGas))
+	}
+	if m.PrecompileCalldataByteGas != 0 {
+		n += 2 + sovParams(uint64(m.PrecompileCalldataByteGas))
+	}
+	if m.PrecompileOutputByteGas != 0 {
+		n += 2 + sovParams(uint64(m.PrecompileOutputByteGas))
+	}
+	if m.PrecompileStoreReadGas != 0 {
+		n += 2 + sovParams(uint64(m.PrecompileStoreReadGas))
+	}
+	if m.PrecompileStoreWriteGas != 0 {
+		n += 2 + sovParams(uint64(m.PrecompileStoreWriteGas))
+	}
+	if m.PrecompileProofVerifyGas != 0 {
+		n += 2 + sovParams(uint64(m.PrecompileProofVerifyGas))
+	}
+	if m.PrecompileSessionOpenGas != 0 {
+		n += 2 + sovParams(uint64(m.PrecompileSessionOpenGas))
+	}
 	return n
 }
 
@@ -387,30 +1089,514 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowParams
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseStripeCost", wireType)
+			}
+			m.BaseStripeCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseStripeCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingInterval", wireType)
+			}
+			m.HalvingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eip712ChainId", wireType)
+			}
+			m.Eip712ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eip712ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoragePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StoragePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DealCreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDurationBlocks", wireType)
+			}
+			m.MinDurationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDurationBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRetrievalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseRetrievalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetrievalPricePerBlob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RetrievalPricePerBlob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetrievalBurnBps", wireType)
+			}
+			m.RetrievalBurnBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetrievalBurnBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthLenBlocks", wireType)
+			}
+			m.MonthLenBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthLenBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLenBlocks", wireType)
+			}
+			m.EpochLenBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLenBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaBpsPerEpochHot", wireType)
+			}
+			m.QuotaBpsPerEpochHot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuotaBpsPerEpochHot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaBpsPerEpochCold", wireType)
+			}
+			m.QuotaBpsPerEpochCold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuotaBpsPerEpochCold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaMinBlobs", wireType)
+			}
+			m.QuotaMinBlobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuotaMinBlobs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaMaxBlobs", wireType)
+			}
+			m.QuotaMaxBlobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuotaMaxBlobs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditCapBps", wireType)
+			}
+			m.CreditCapBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreditCapBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProviderBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinProviderBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderBondPerGib", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProviderBondPerGib.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUnbondingBlocks", wireType)
+			}
+			m.ProviderUnbondingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProviderUnbondingBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMissedProofBps", wireType)
+			}
+			m.SlashMissedProofBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashMissedProofBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashInvalidProofBps", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SlashInvalidProofBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashInvalidProofBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 22:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseStripeCost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailBondThresholdBps", wireType)
 			}
-			m.BaseStripeCost = 0
+			m.JailBondThresholdBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -420,16 +1606,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BaseStripeCost |= uint64(b&0x7F) << shift
+				m.JailBondThresholdBps |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 23:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HalvingInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderMigrationBlocks", wireType)
 			}
-			m.HalvingInterval = 0
+			m.ProviderMigrationBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -439,16 +1625,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HalvingInterval |= uint64(b&0x7F) << shift
+				m.ProviderMigrationBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 24:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eip712ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetrievalSessionRetentionBlocks", wireType)
 			}
-			m.Eip712ChainId = 0
+			m.RetrievalSessionRetentionBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -458,16 +1644,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Eip712ChainId |= uint64(b&0x7F) << shift
+				m.RetrievalSessionRetentionBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoragePrice", wireType)
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRepairFailureThreshold", wireType)
 			}
-			var stringLen uint64
+			m.AutoRepairFailureThreshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -477,31 +1663,54 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AutoRepairFailureThreshold |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRepairMissedProofThreshold", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
+			m.AutoRepairMissedProofThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoRepairMissedProofThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRepairCooldownBlocks", wireType)
 			}
-			if err := m.StoragePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AutoRepairCooldownBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoRepairCooldownBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DealCreationFee", wireType)
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepairSampleBlobs", wireType)
 			}
-			var msglen int
+			m.RepairSampleBlobs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -511,30 +1720,54 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RepairSampleBlobs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthParams
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepairDeadlineBlocks", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
+			m.RepairDeadlineBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepairDeadlineBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepairSlashBps", wireType)
 			}
-			if err := m.DealCreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RepairSlashBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepairSlashBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 6:
+		case 31:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDurationBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepairBountyBps", wireType)
 			}
-			m.MinDurationBlocks = 0
+			m.RepairBountyBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -544,16 +1777,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinDurationBlocks |= uint64(b&0x7F) << shift
+				m.RepairBountyBps |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseRetrievalFee", wireType)
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudSlashBps", wireType)
 			}
-			var msglen int
+			m.FraudSlashBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -563,30 +1796,54 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FraudSlashBps |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthParams
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudReporterRewardBps", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
+			m.FraudReporterRewardBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FraudReporterRewardBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileCallGas", wireType)
 			}
-			if err := m.BaseRetrievalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PrecompileCallGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrecompileCallGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetrievalPricePerBlob", wireType)
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileViewCallGas", wireType)
 			}
-			var msglen int
+			m.PrecompileViewCallGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -596,30 +1853,54 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PrecompileViewCallGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthParams
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileCalldataByteGas", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
+			m.PrecompileCalldataByteGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrecompileCalldataByteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileOutputByteGas", wireType)
 			}
-			if err := m.RetrievalPricePerBlob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PrecompileOutputByteGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrecompileOutputByteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 9:
+		case 38:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetrievalBurnBps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileStoreReadGas", wireType)
 			}
-			m.RetrievalBurnBps = 0
+			m.PrecompileStoreReadGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -629,16 +1910,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RetrievalBurnBps |= uint64(b&0x7F) << shift
+				m.PrecompileStoreReadGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 39:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonthLenBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileStoreWriteGas", wireType)
 			}
-			m.MonthLenBlocks = 0
+			m.PrecompileStoreWriteGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -648,7 +1929,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MonthLenBlocks |= uint64(b&0x7F) << shift
+				m.PrecompileStoreWriteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileProofVerifyGas", wireType)
+			}
+			m.PrecompileProofVerifyGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrecompileProofVerifyGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileSessionOpenGas", wireType)
+			}
+			m.PrecompileSessionOpenGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrecompileSessionOpenGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -0,0 +1,23 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"nilchain/x/nilchain/types"
+)
+
+func TestParamsValidateQuotaBlobs(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params.QuotaMaxBlobs = types.MaxQuotaBlobs
+	require.NoError(t, params.Validate())
+
+	params.QuotaMaxBlobs = types.MaxQuotaBlobs + 1
+	require.ErrorContains(t, params.Validate(), "quota blobs must be <=")
+
+	params = types.DefaultParams()
+	params.QuotaMinBlobs = params.QuotaMaxBlobs + 1
+	require.ErrorContains(t, params.Validate(), "quota_min_blobs")
+}
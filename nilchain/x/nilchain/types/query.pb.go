@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -306,6 +307,214 @@ func (m *QueryListDealsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryListDealsByOwnerRequest struct {
+	Owner      string             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListDealsByOwnerRequest) Reset()         { *m = QueryListDealsByOwnerRequest{} }
+func (m *QueryListDealsByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListDealsByOwnerRequest) ProtoMessage()    {}
+func (*QueryListDealsByOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{6}
+}
+func (m *QueryListDealsByOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListDealsByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListDealsByOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListDealsByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListDealsByOwnerRequest.Merge(m, src)
+}
+func (m *QueryListDealsByOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListDealsByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListDealsByOwnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListDealsByOwnerRequest proto.InternalMessageInfo
+
+func (m *QueryListDealsByOwnerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryListDealsByOwnerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryListDealsByOwnerResponse struct {
+	Deals      []Deal              `protobuf:"bytes,1,rep,name=deals,proto3" json:"deals"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListDealsByOwnerResponse) Reset()         { *m = QueryListDealsByOwnerResponse{} }
+func (m *QueryListDealsByOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListDealsByOwnerResponse) ProtoMessage()    {}
+func (*QueryListDealsByOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{7}
+}
+func (m *QueryListDealsByOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListDealsByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListDealsByOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListDealsByOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListDealsByOwnerResponse.Merge(m, src)
+}
+func (m *QueryListDealsByOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListDealsByOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListDealsByOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListDealsByOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryListDealsByOwnerResponse) GetDeals() []Deal {
+	if m != nil {
+		return m.Deals
+	}
+	return nil
+}
+
+func (m *QueryListDealsByOwnerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryListDealsByProviderRequest struct {
+	Provider   string             `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListDealsByProviderRequest) Reset()         { *m = QueryListDealsByProviderRequest{} }
+func (m *QueryListDealsByProviderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListDealsByProviderRequest) ProtoMessage()    {}
+func (*QueryListDealsByProviderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{8}
+}
+func (m *QueryListDealsByProviderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListDealsByProviderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListDealsByProviderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListDealsByProviderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListDealsByProviderRequest.Merge(m, src)
+}
+func (m *QueryListDealsByProviderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListDealsByProviderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListDealsByProviderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListDealsByProviderRequest proto.InternalMessageInfo
+
+func (m *QueryListDealsByProviderRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *QueryListDealsByProviderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryListDealsByProviderResponse struct {
+	Deals      []Deal              `protobuf:"bytes,1,rep,name=deals,proto3" json:"deals"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListDealsByProviderResponse) Reset()         { *m = QueryListDealsByProviderResponse{} }
+func (m *QueryListDealsByProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListDealsByProviderResponse) ProtoMessage()    {}
+func (*QueryListDealsByProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{9}
+}
+func (m *QueryListDealsByProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListDealsByProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListDealsByProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListDealsByProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListDealsByProviderResponse.Merge(m, src)
+}
+func (m *QueryListDealsByProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListDealsByProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListDealsByProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListDealsByProviderResponse proto.InternalMessageInfo
+
+func (m *QueryListDealsByProviderResponse) GetDeals() []Deal {
+	if m != nil {
+		return m.Deals
+	}
+	return nil
+}
+
+func (m *QueryListDealsByProviderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryGetDealRequest struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
@@ -314,7 +523,7 @@ func (m *QueryGetDealRequest) Reset()         { *m = QueryGetDealRequest{} }
 func (m *QueryGetDealRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetDealRequest) ProtoMessage()    {}
 func (*QueryGetDealRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02e1757e30754457, []int{6}
+	return fileDescriptor_02e1757e30754457, []int{10}
 }
 func (m *QueryGetDealRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,7 +567,7 @@ func (m *QueryGetDealResponse) Reset()         { *m = QueryGetDealResponse{} }
 func (m *QueryGetDealResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetDealResponse) ProtoMessage()    {}
 func (*QueryGetDealResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02e1757e30754457, []int{7}
+	return fileDescriptor_02e1757e30754457, []int{11}
 }
 func (m *QueryGetDealResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -402,7 +611,7 @@ func (m *QueryListProvidersRequest) Reset()         { *m = QueryListProvidersReq
 func (m *QueryListProvidersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryListProvidersRequest) ProtoMessage()    {}
 func (*QueryListProvidersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02e1757e30754457, []int{8}
+	return fileDescriptor_02e1757e30754457, []int{12}
 }
 func (m *QueryListProvidersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -447,7 +656,7 @@ func (m *QueryListProvidersResponse) Reset()         { *m = QueryListProvidersRe
 func (m *QueryListProvidersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryListProvidersResponse) ProtoMessage()    {}
 func (*QueryListProvidersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02e1757e30754457, []int{9}
+	return fileDescriptor_02e1757e30754457, []int{13}
 }
 func (m *QueryListProvidersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -498,7 +707,7 @@ func (m *QueryGetProviderRequest) Reset()         { *m = QueryGetProviderRequest
 func (m *QueryGetProviderRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProviderRequest) ProtoMessage()    {}
 func (*QueryGetProviderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02e1757e30754457, []int{10}
+	return fileDescriptor_02e1757e30754457, []int{14}
 }
 func (m *QueryGetProviderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -542,7 +751,7 @@ func (m *QueryGetProviderResponse) Reset()         { *m = QueryGetProviderRespon
 func (m *QueryGetProviderResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProviderResponse) ProtoMessage()    {}
 func (*QueryGetProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02e1757e30754457, []int{11}
+	return fileDescriptor_02e1757e30754457, []int{15}
 }
 func (m *QueryGetProviderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -586,7 +795,7 @@ func (m *QueryGetDealHeatRequest) Reset()         { *m = QueryGetDealHeatRequest
 func (m *QueryGetDealHeatRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetDealHeatRequest) ProtoMessage()    {}
 func (*QueryGetDealHeatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02e1757e30754457, []int{12}
+	return fileDescriptor_02e1757e30754457, []int{16}
 }
 func (m *QueryGetDealHeatRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -630,7 +839,7 @@ func (m *QueryGetDealHeatResponse) Reset()         { *m = QueryGetDealHeatRespon
 func (m *QueryGetDealHeatResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetDealHeatResponse) ProtoMessage()    {}
 func (*QueryGetDealHeatResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02e1757e30754457, []int{13}
+	return fileDescriptor_02e1757e30754457, []int{17}
 }
 func (m *QueryGetDealHeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -675,7 +884,7 @@ func (m *QueryGetReceiptNonceRequest) Reset()         { *m = QueryGetReceiptNonc
 func (m *QueryGetReceiptNonceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetReceiptNonceRequest) ProtoMessage()    {}
 func (*QueryGetReceiptNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02e1757e30754457, []int{14}
+	return fileDescriptor_02e1757e30754457, []int{18}
 }
 func (m *QueryGetReceiptNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -726,7 +935,7 @@ func (m *QueryGetReceiptNonceResponse) Reset()         { *m = QueryGetReceiptNon
 func (m *QueryGetReceiptNonceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetReceiptNonceResponse) ProtoMessage()    {}
 func (*QueryGetReceiptNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02e1757e30754457, []int{15}
+	return fileDescriptor_02e1757e30754457, []int{19}
 }
 func (m *QueryGetReceiptNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -770,7 +979,7 @@ func (m *QueryGetRetrievalSessionRequest) Reset()         { *m = QueryGetRetriev
 func (m *QueryGetRetrievalSessionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetRetrievalSessionRequest) ProtoMessage()    {}
 func (*QueryGetRetrievalSessionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02e1757e30754457, []int{16}
+	return fileDescriptor_02e1757e30754457, []int{20}
 }
 func (m *QueryGetRetrievalSessionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -814,7 +1023,7 @@ func (m *QueryGetRetrievalSessionResponse) Reset()         { *m = QueryGetRetrie
 func (m *QueryGetRetrievalSessionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetRetrievalSessionResponse) ProtoMessage()    {}
 func (*QueryGetRetrievalSessionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02e1757e30754457, []int{17}
+	return fileDescriptor_02e1757e30754457, []int{21}
 }
 func (m *QueryGetRetrievalSessionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -861,7 +1070,7 @@ func (m *QueryListRetrievalSessionsByOwnerRequest) Reset() {
 func (m *QueryListRetrievalSessionsByOwnerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryListRetrievalSessionsByOwnerRequest) ProtoMessage()    {}
 func (*QueryListRetrievalSessionsByOwnerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02e1757e30754457, []int{18}
+	return fileDescriptor_02e1757e30754457, []int{22}
 }
 func (m *QueryListRetrievalSessionsByOwnerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -917,7 +1126,7 @@ func (m *QueryListRetrievalSessionsByOwnerResponse) String() string {
 }
 func (*QueryListRetrievalSessionsByOwnerResponse) ProtoMessage() {}
 func (*QueryListRetrievalSessionsByOwnerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02e1757e30754457, []int{19}
+	return fileDescriptor_02e1757e30754457, []int{23}
 }
 func (m *QueryListRetrievalSessionsByOwnerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -973,7 +1182,7 @@ func (m *QueryListRetrievalSessionsByProviderRequest) String() string {
 }
 func (*QueryListRetrievalSessionsByProviderRequest) ProtoMessage() {}
 func (*QueryListRetrievalSessionsByProviderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02e1757e30754457, []int{20}
+	return fileDescriptor_02e1757e30754457, []int{24}
 }
 func (m *QueryListRetrievalSessionsByProviderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1029,7 +1238,7 @@ func (m *QueryListRetrievalSessionsByProviderResponse) String() string {
 }
 func (*QueryListRetrievalSessionsByProviderResponse) ProtoMessage() {}
 func (*QueryListRetrievalSessionsByProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02e1757e30754457, []int{21}
+	return fileDescriptor_02e1757e30754457, []int{25}
 }
 func (m *QueryListRetrievalSessionsByProviderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1072,1709 +1281,8191 @@ func (m *QueryListRetrievalSessionsByProviderResponse) GetPagination() *query.Pa
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "nilchain.nilchain.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "nilchain.nilchain.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryListProofsRequest)(nil), "nilchain.nilchain.v1.QueryListProofsRequest")
-	proto.RegisterType((*QueryListProofsResponse)(nil), "nilchain.nilchain.v1.QueryListProofsResponse")
-	proto.RegisterType((*QueryListDealsRequest)(nil), "nilchain.nilchain.v1.QueryListDealsRequest")
-	proto.RegisterType((*QueryListDealsResponse)(nil), "nilchain.nilchain.v1.QueryListDealsResponse")
-	proto.RegisterType((*QueryGetDealRequest)(nil), "nilchain.nilchain.v1.QueryGetDealRequest")
-	proto.RegisterType((*QueryGetDealResponse)(nil), "nilchain.nilchain.v1.QueryGetDealResponse")
-	proto.RegisterType((*QueryListProvidersRequest)(nil), "nilchain.nilchain.v1.QueryListProvidersRequest")
-	proto.RegisterType((*QueryListProvidersResponse)(nil), "nilchain.nilchain.v1.QueryListProvidersResponse")
-	proto.RegisterType((*QueryGetProviderRequest)(nil), "nilchain.nilchain.v1.QueryGetProviderRequest")
-	proto.RegisterType((*QueryGetProviderResponse)(nil), "nilchain.nilchain.v1.QueryGetProviderResponse")
-	proto.RegisterType((*QueryGetDealHeatRequest)(nil), "nilchain.nilchain.v1.QueryGetDealHeatRequest")
-	proto.RegisterType((*QueryGetDealHeatResponse)(nil), "nilchain.nilchain.v1.QueryGetDealHeatResponse")
-	proto.RegisterType((*QueryGetReceiptNonceRequest)(nil), "nilchain.nilchain.v1.QueryGetReceiptNonceRequest")
-	proto.RegisterType((*QueryGetReceiptNonceResponse)(nil), "nilchain.nilchain.v1.QueryGetReceiptNonceResponse")
-	proto.RegisterType((*QueryGetRetrievalSessionRequest)(nil), "nilchain.nilchain.v1.QueryGetRetrievalSessionRequest")
-	proto.RegisterType((*QueryGetRetrievalSessionResponse)(nil), "nilchain.nilchain.v1.QueryGetRetrievalSessionResponse")
-	proto.RegisterType((*QueryListRetrievalSessionsByOwnerRequest)(nil), "nilchain.nilchain.v1.QueryListRetrievalSessionsByOwnerRequest")
-	proto.RegisterType((*QueryListRetrievalSessionsByOwnerResponse)(nil), "nilchain.nilchain.v1.QueryListRetrievalSessionsByOwnerResponse")
-	proto.RegisterType((*QueryListRetrievalSessionsByProviderRequest)(nil), "nilchain.nilchain.v1.QueryListRetrievalSessionsByProviderRequest")
-	proto.RegisterType((*QueryListRetrievalSessionsByProviderResponse)(nil), "nilchain.nilchain.v1.QueryListRetrievalSessionsByProviderResponse")
+type QueryGetChallengeSetRequest struct {
+	DealId   uint64 `protobuf:"varint,1,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
 }
 
-func init() { proto.RegisterFile("nilchain/nilchain/v1/query.proto", fileDescriptor_02e1757e30754457) }
-
-var fileDescriptor_02e1757e30754457 = []byte{
-	// 1152 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0x3b, 0xa5, 0xbf, 0xf2, 0x0a, 0x8b, 0x98, 0x2d, 0x6c, 0xd7, 0x6d, 0xd3, 0xae, 0x17,
-	0xd8, 0xf4, 0x47, 0xec, 0x4d, 0xaa, 0x82, 0x60, 0x29, 0xa5, 0x11, 0x6a, 0x77, 0x25, 0x04, 0xc5,
-	0x95, 0x56, 0x82, 0x4b, 0x99, 0x26, 0xb3, 0xa9, 0x51, 0x6a, 0x7b, 0x63, 0x6f, 0xa0, 0x8a, 0x72,
-	0x81, 0x0b, 0x82, 0x03, 0xa0, 0x15, 0x27, 0x0e, 0x20, 0x71, 0xe1, 0xc8, 0x85, 0x2b, 0x12, 0xe2,
-	0xb2, 0xc7, 0x95, 0xb8, 0x70, 0x42, 0xa8, 0x45, 0xe2, 0x8c, 0xc4, 0x1f, 0x80, 0x3c, 0x7e, 0xb6,
-	0x13, 0xd7, 0xb1, 0x9d, 0x25, 0x07, 0x2e, 0xed, 0x78, 0xf2, 0xde, 0x9b, 0xcf, 0xf7, 0xcd, 0x3c,
-	0xcf, 0x93, 0x61, 0xc9, 0xd0, 0x1b, 0xd5, 0x23, 0xa6, 0x1b, 0x6a, 0x30, 0x68, 0x95, 0xd4, 0xbb,
-	0xf7, 0x78, 0xf3, 0x44, 0xb1, 0x9a, 0xa6, 0x63, 0xd2, 0x19, 0xff, 0x07, 0x25, 0x18, 0xb4, 0x4a,
-	0xd2, 0x53, 0xec, 0x58, 0x37, 0x4c, 0x55, 0xfc, 0xf5, 0x0c, 0xa5, 0x99, 0xba, 0x59, 0x37, 0xc5,
-	0x50, 0x75, 0x47, 0x38, 0x3b, 0x5f, 0x37, 0xcd, 0x7a, 0x83, 0xab, 0xcc, 0xd2, 0x55, 0x66, 0x18,
-	0xa6, 0xc3, 0x1c, 0xdd, 0x34, 0x6c, 0xfc, 0x75, 0xa5, 0x6a, 0xda, 0xc7, 0xa6, 0xad, 0x1e, 0x32,
-	0x9b, 0x7b, 0xab, 0xaa, 0xad, 0xd2, 0x21, 0x77, 0x58, 0x49, 0xb5, 0x58, 0x5d, 0x37, 0x84, 0x31,
-	0xda, 0x5e, 0x89, 0x45, 0xb5, 0x58, 0x93, 0x1d, 0xfb, 0xe1, 0xe2, 0xd5, 0x58, 0x4d, 0xd3, 0xbc,
-	0x93, 0x68, 0xe1, 0x9c, 0x58, 0x1c, 0x63, 0xc8, 0x33, 0x40, 0xdf, 0x76, 0x41, 0xf6, 0x44, 0x60,
-	0x8d, 0xdf, 0xbd, 0xc7, 0x6d, 0x47, 0xbe, 0x0d, 0x17, 0x7b, 0x66, 0x6d, 0xcb, 0x34, 0x6c, 0x4e,
-	0xb7, 0x60, 0xc2, 0x03, 0x98, 0x25, 0x4b, 0xa4, 0x30, 0x5d, 0x9e, 0x57, 0xe2, 0xb2, 0xa5, 0x78,
-	0x5e, 0x95, 0xdc, 0x83, 0xdf, 0x17, 0x47, 0xbe, 0xff, 0xeb, 0x87, 0x15, 0xa2, 0xa1, 0x9b, 0xfc,
-	0x1e, 0x3c, 0x23, 0xe2, 0xbe, 0xa1, 0xdb, 0xce, 0x9e, 0xcb, 0xe9, 0xaf, 0x48, 0x77, 0x00, 0xc2,
-	0x14, 0x60, 0xf8, 0xe7, 0x15, 0x2f, 0x5f, 0x8a, 0x9b, 0x2f, 0xc5, 0xdb, 0x25, 0xcc, 0x97, 0xb2,
-	0xc7, 0xea, 0x1c, 0x7d, 0xb5, 0x2e, 0x4f, 0xf9, 0x2b, 0x02, 0x97, 0xce, 0x2d, 0x81, 0xf8, 0x25,
-	0x18, 0x17, 0xc9, 0x99, 0x25, 0x4b, 0x8f, 0x15, 0xa6, 0xcb, 0x73, 0x7d, 0xe8, 0x5d, 0x13, 0xcd,
-	0xb3, 0xa4, 0xbb, 0x3d, 0x58, 0xa3, 0x02, 0xeb, 0x5a, 0x2a, 0x96, 0xb7, 0x5e, 0x0f, 0xd7, 0x01,
-	0x3c, 0x1d, 0x60, 0xbd, 0xce, 0x59, 0x63, 0xe8, 0xc2, 0xef, 0x93, 0xae, 0xdc, 0xe2, 0x0a, 0xa8,
-	0xfb, 0x3a, 0x8c, 0xd7, 0xdc, 0x09, 0xd4, 0x2d, 0xc5, 0xeb, 0x76, 0x7d, 0x34, 0xcf, 0x70, 0x78,
-	0xb2, 0x9f, 0xc3, 0x83, 0xb4, 0xcb, 0x05, 0x93, 0x2f, 0xfa, 0x02, 0x8c, 0xea, 0x35, 0x21, 0x76,
-	0x4c, 0x1b, 0xd5, 0x6b, 0xf2, 0x0e, 0xcc, 0xf4, 0x9a, 0x21, 0xb9, 0x02, 0x63, 0x2e, 0x10, 0xa6,
-	0x25, 0x09, 0x5c, 0xd8, 0xc9, 0x55, 0xb8, 0xdc, 0xbd, 0xf9, 0x2d, 0xbd, 0xc6, 0x9b, 0x43, 0xcf,
-	0xf4, 0x77, 0x04, 0xa4, 0xb8, 0x55, 0x90, 0xf9, 0x15, 0xc8, 0x59, 0xfe, 0x24, 0x66, 0x3c, 0xdf,
-	0xf7, 0xa4, 0x09, 0x33, 0x2d, 0x74, 0x18, 0x5e, 0xe6, 0xd7, 0xb1, 0x0e, 0x76, 0x79, 0xc0, 0xe8,
-	0x27, 0x62, 0x16, 0x26, 0x59, 0xad, 0xd6, 0xe4, 0xb6, 0x57, 0xc7, 0x39, 0xcd, 0x7f, 0x94, 0x6f,
-	0xc3, 0xec, 0x79, 0x27, 0xd4, 0xf5, 0x32, 0x4c, 0xf9, 0x98, 0x98, 0xbc, 0x34, 0x59, 0x81, 0xbd,
-	0x5c, 0x0e, 0x61, 0xdc, 0xdd, 0xba, 0xc9, 0x99, 0xe3, 0xc3, 0x5c, 0x82, 0x49, 0x77, 0xeb, 0x0e,
-	0x82, 0xf3, 0x30, 0xe1, 0x3e, 0xde, 0xaa, 0xc9, 0xef, 0x84, 0x2c, 0xa1, 0x0f, 0xb2, 0x6c, 0xc2,
-	0xd8, 0x11, 0x67, 0x0e, 0x72, 0x5c, 0xed, 0x7f, 0x2e, 0x5c, 0xaf, 0x7d, 0x87, 0x39, 0xbc, 0x32,
-	0xe6, 0xbe, 0x8d, 0x34, 0xe1, 0x26, 0xef, 0xc3, 0x9c, 0x1f, 0x5a, 0xe3, 0x55, 0xae, 0x5b, 0xce,
-	0x9b, 0xa6, 0x51, 0xe5, 0x69, 0x48, 0x74, 0x0e, 0x72, 0x77, 0xf4, 0x06, 0x3f, 0xb0, 0x98, 0x73,
-	0x24, 0xf6, 0x26, 0xa7, 0x4d, 0xb9, 0x13, 0x7b, 0xcc, 0x39, 0x92, 0x37, 0x61, 0x3e, 0x3e, 0x28,
-	0x32, 0x2f, 0x00, 0x34, 0x98, 0xed, 0x1c, 0x18, 0xee, 0x2c, 0x06, 0xce, 0xb9, 0x33, 0xc2, 0x4c,
-	0x7e, 0x0d, 0x16, 0x43, 0x77, 0xa7, 0xa9, 0xf3, 0x16, 0x6b, 0xec, 0x73, 0xdb, 0xd6, 0x4d, 0xc3,
-	0xe7, 0x5a, 0x00, 0xb0, 0xbd, 0x19, 0x1f, 0xed, 0x71, 0x2d, 0x87, 0x33, 0xb7, 0x6a, 0xf2, 0xfb,
-	0xb0, 0xd4, 0x3f, 0x02, 0x42, 0xec, 0xc0, 0x24, 0x3a, 0x04, 0x05, 0x10, 0x9b, 0xbb, 0x68, 0x00,
-	0x4c, 0x9f, 0xef, 0x2c, 0x7f, 0x42, 0xa0, 0x10, 0xd4, 0x40, 0xd4, 0xd8, 0xae, 0x9c, 0xbc, 0xf5,
-	0x81, 0x11, 0x9e, 0xb7, 0x19, 0x18, 0x37, 0xdd, 0x67, 0x3c, 0x6d, 0xde, 0x43, 0xa4, 0x1c, 0x47,
-	0x1f, 0xb9, 0x1c, 0x7f, 0x22, 0xb0, 0x9c, 0x01, 0x05, 0x13, 0x70, 0x13, 0xa6, 0x50, 0x83, 0x5f,
-	0x9c, 0x83, 0x65, 0x20, 0xf0, 0x1e, 0x5e, 0xa5, 0x7e, 0x49, 0x60, 0x35, 0x49, 0x40, 0xb4, 0x7c,
-	0xa5, 0x48, 0x21, 0xe6, 0xc2, 0x42, 0x1b, 0x5a, 0x52, 0x7f, 0x26, 0xb0, 0x96, 0x8d, 0xe9, 0x7f,
-	0x9b, 0xd7, 0xf2, 0x3f, 0x17, 0x60, 0x5c, 0x68, 0xa0, 0x1f, 0x13, 0x98, 0xf0, 0x9a, 0x12, 0x5a,
-	0x88, 0xa7, 0x3a, 0xdf, 0x03, 0x49, 0xcb, 0x19, 0x2c, 0xbd, 0x55, 0xe5, 0x67, 0x3f, 0xfa, 0xf5,
-	0xcf, 0xfb, 0xa3, 0x79, 0x3a, 0xaf, 0x26, 0x34, 0x6d, 0xf4, 0x73, 0x02, 0x10, 0x76, 0x25, 0x74,
-	0x2d, 0x21, 0xfe, 0xb9, 0xfe, 0x48, 0x2a, 0x66, 0xb4, 0xce, 0x48, 0xe4, 0x21, 0x7c, 0x46, 0x20,
-	0x17, 0xb4, 0x0b, 0x74, 0x35, 0x65, 0x89, 0xee, 0xb6, 0x45, 0x5a, 0xcb, 0x66, 0x8c, 0x38, 0x57,
-	0x05, 0xce, 0x02, 0x9d, 0x8b, 0xc7, 0xf1, 0x9a, 0x8e, 0x4f, 0x09, 0x4c, 0xe2, 0xcb, 0x9e, 0x26,
-	0x25, 0xbf, 0xb7, 0x97, 0x90, 0x56, 0xb2, 0x98, 0x22, 0x47, 0x41, 0x70, 0xc8, 0x74, 0x29, 0x81,
-	0x43, 0x6d, 0xeb, 0xb5, 0x0e, 0xfd, 0x9a, 0xc0, 0x13, 0x3d, 0xf7, 0x3b, 0x55, 0xd3, 0x77, 0xa0,
-	0xa7, 0xdf, 0x90, 0xae, 0x67, 0x77, 0x40, 0xbc, 0x6b, 0x02, 0xef, 0x0a, 0x5d, 0xec, 0xbb, 0x6b,
-	0xc8, 0xf2, 0x0d, 0x81, 0xe9, 0xae, 0x3b, 0x9a, 0x16, 0x93, 0x73, 0x10, 0x79, 0x83, 0x48, 0x4a,
-	0x56, 0x73, 0xe4, 0x2a, 0x09, 0xae, 0x55, 0xba, 0x9c, 0xc2, 0xa5, 0xb6, 0xb1, 0x91, 0xe8, 0xd0,
-	0x6f, 0x3d, 0x42, 0xff, 0x0e, 0x4e, 0x23, 0x8c, 0x74, 0x05, 0x69, 0x84, 0xd1, 0x86, 0x40, 0x2e,
-	0x0b, 0xc2, 0x35, 0xba, 0x92, 0xb8, 0xb1, 0x78, 0xab, 0x77, 0x54, 0xb7, 0x0b, 0xa0, 0x3f, 0x12,
-	0x78, 0x32, 0x72, 0x59, 0xd3, 0x52, 0xf2, 0xba, 0x31, 0xdd, 0x82, 0x54, 0x1e, 0xc4, 0x05, 0x71,
-	0x6f, 0x08, 0xdc, 0x0d, 0xba, 0x9e, 0x0d, 0xb7, 0xe9, 0xc5, 0x28, 0x8a, 0xd6, 0x81, 0xfe, 0x42,
-	0xe0, 0x62, 0xcc, 0x1d, 0x4f, 0x37, 0xd2, 0x40, 0x62, 0xbb, 0x0a, 0xe9, 0x85, 0x41, 0xdd, 0x50,
-	0xc3, 0xa6, 0xd0, 0xf0, 0x22, 0xdd, 0x88, 0xd7, 0xd0, 0xf4, 0xfd, 0x8a, 0xfe, 0x9b, 0x5d, 0x6d,
-	0x87, 0xdd, 0x4b, 0x87, 0x9e, 0x12, 0x98, 0x4f, 0xba, 0xb1, 0xe9, 0xab, 0x29, 0xe5, 0x93, 0xd2,
-	0x75, 0x48, 0x5b, 0x8f, 0xec, 0x8f, 0x02, 0xb7, 0x85, 0xc0, 0x1b, 0xf4, 0xa5, 0xcc, 0x02, 0x0f,
-	0x4f, 0x8a, 0xa2, 0xb7, 0x51, 0xdb, 0xe2, 0x5f, 0x87, 0xfe, 0x4d, 0x60, 0x31, 0xe5, 0x06, 0xa5,
-	0xdb, 0x83, 0x73, 0x46, 0xeb, 0xb9, 0xf2, 0x5f, 0x42, 0xa0, 0xda, 0x5d, 0xa1, 0x76, 0x9b, 0x6e,
-	0x0d, 0xa2, 0xd6, 0xaf, 0x7c, 0xb5, 0xed, 0x8f, 0x3a, 0x95, 0xf5, 0x07, 0xa7, 0x79, 0xf2, 0xf0,
-	0x34, 0x4f, 0xfe, 0x38, 0xcd, 0x93, 0x2f, 0xce, 0xf2, 0x23, 0x0f, 0xcf, 0xf2, 0x23, 0xbf, 0x9d,
-	0xe5, 0x47, 0xde, 0xbd, 0x1c, 0x04, 0xfc, 0x30, 0x8c, 0x2d, 0x3e, 0x46, 0x1c, 0x4e, 0x88, 0xaf,
-	0x11, 0xeb, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x30, 0x4e, 0xbc, 0xed, 0xa1, 0x11, 0x00, 0x00,
+func (m *QueryGetChallengeSetRequest) Reset()         { *m = QueryGetChallengeSetRequest{} }
+func (m *QueryGetChallengeSetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChallengeSetRequest) ProtoMessage()    {}
+func (*QueryGetChallengeSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{26}
+}
+func (m *QueryGetChallengeSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetChallengeSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetChallengeSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetChallengeSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChallengeSetRequest.Merge(m, src)
+}
+func (m *QueryGetChallengeSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetChallengeSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChallengeSetRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryGetChallengeSetRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryGetChallengeSetRequest) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
+	}
+	return 0
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of Proof items.
-	ListProofs(ctx context.Context, in *QueryListProofsRequest, opts ...grpc.CallOption) (*QueryListProofsResponse, error)
-	// Queries a list of Deal items.
-	ListDeals(ctx context.Context, in *QueryListDealsRequest, opts ...grpc.CallOption) (*QueryListDealsResponse, error)
-	// Queries a Deal by id.
-	GetDeal(ctx context.Context, in *QueryGetDealRequest, opts ...grpc.CallOption) (*QueryGetDealResponse, error)
-	// Queries a list of Provider items.
-	ListProviders(ctx context.Context, in *QueryListProvidersRequest, opts ...grpc.CallOption) (*QueryListProvidersResponse, error)
-	// Queries a Provider by address.
-	GetProvider(ctx context.Context, in *QueryGetProviderRequest, opts ...grpc.CallOption) (*QueryGetProviderResponse, error)
-	// Queries the Heat State for a Deal.
-	GetDealHeat(ctx context.Context, in *QueryGetDealHeatRequest, opts ...grpc.CallOption) (*QueryGetDealHeatResponse, error)
-	// Queries the last accepted retrieval receipt nonce for a specific (deal_id, file_path).
-	GetReceiptNonce(ctx context.Context, in *QueryGetReceiptNonceRequest, opts ...grpc.CallOption) (*QueryGetReceiptNonceResponse, error)
-	// Queries a RetrievalSession by id.
-	GetRetrievalSession(ctx context.Context, in *QueryGetRetrievalSessionRequest, opts ...grpc.CallOption) (*QueryGetRetrievalSessionResponse, error)
-	// Lists RetrievalSessions for an owner.
-	ListRetrievalSessionsByOwner(ctx context.Context, in *QueryListRetrievalSessionsByOwnerRequest, opts ...grpc.CallOption) (*QueryListRetrievalSessionsByOwnerResponse, error)
-	// Lists RetrievalSessions for a provider.
-	ListRetrievalSessionsByProvider(ctx context.Context, in *QueryListRetrievalSessionsByProviderRequest, opts ...grpc.CallOption) (*QueryListRetrievalSessionsByProviderResponse, error)
+func (m *QueryGetChallengeSetRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryGetChallengeSetResponse struct {
+	EpochId                 uint64              `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	EpochStartHeight        uint64              `protobuf:"varint,2,opt,name=epoch_start_height,json=epochStartHeight,proto3" json:"epoch_start_height,omitempty"`
+	QuotaBlobs              uint64              `protobuf:"varint,3,opt,name=quota_blobs,json=quotaBlobs,proto3" json:"quota_blobs,omitempty"`
+	CreditsBlobs            uint64              `protobuf:"varint,4,opt,name=credits_blobs,json=creditsBlobs,proto3" json:"credits_blobs,omitempty"`
+	SyntheticNeeded         uint64              `protobuf:"varint,5,opt,name=synthetic_needed,json=syntheticNeeded,proto3" json:"synthetic_needed,omitempty"`
+	SyntheticSatisfiedBlobs uint64              `protobuf:"varint,6,opt,name=synthetic_satisfied_blobs,json=syntheticSatisfiedBlobs,proto3" json:"synthetic_satisfied_blobs,omitempty"`
+	Challenges              []ChallengePosition `protobuf:"bytes,7,rep,name=challenges,proto3" json:"challenges"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetChallengeSetResponse) Reset()         { *m = QueryGetChallengeSetResponse{} }
+func (m *QueryGetChallengeSetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChallengeSetResponse) ProtoMessage()    {}
+func (*QueryGetChallengeSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{27}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetChallengeSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetChallengeSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetChallengeSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetChallengeSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChallengeSetResponse.Merge(m, src)
+}
+func (m *QueryGetChallengeSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetChallengeSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChallengeSetResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListProofs(ctx context.Context, in *QueryListProofsRequest, opts ...grpc.CallOption) (*QueryListProofsResponse, error) {
-	out := new(QueryListProofsResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/ListProofs", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetChallengeSetResponse proto.InternalMessageInfo
+
+func (m *QueryGetChallengeSetResponse) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ListDeals(ctx context.Context, in *QueryListDealsRequest, opts ...grpc.CallOption) (*QueryListDealsResponse, error) {
-	out := new(QueryListDealsResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/ListDeals", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetChallengeSetResponse) GetEpochStartHeight() uint64 {
+	if m != nil {
+		return m.EpochStartHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetDeal(ctx context.Context, in *QueryGetDealRequest, opts ...grpc.CallOption) (*QueryGetDealResponse, error) {
-	out := new(QueryGetDealResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/GetDeal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetChallengeSetResponse) GetQuotaBlobs() uint64 {
+	if m != nil {
+		return m.QuotaBlobs
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ListProviders(ctx context.Context, in *QueryListProvidersRequest, opts ...grpc.CallOption) (*QueryListProvidersResponse, error) {
-	out := new(QueryListProvidersResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/ListProviders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetChallengeSetResponse) GetCreditsBlobs() uint64 {
+	if m != nil {
+		return m.CreditsBlobs
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetProvider(ctx context.Context, in *QueryGetProviderRequest, opts ...grpc.CallOption) (*QueryGetProviderResponse, error) {
-	out := new(QueryGetProviderResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/GetProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetChallengeSetResponse) GetSyntheticNeeded() uint64 {
+	if m != nil {
+		return m.SyntheticNeeded
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetDealHeat(ctx context.Context, in *QueryGetDealHeatRequest, opts ...grpc.CallOption) (*QueryGetDealHeatResponse, error) {
-	out := new(QueryGetDealHeatResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/GetDealHeat", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetChallengeSetResponse) GetSyntheticSatisfiedBlobs() uint64 {
+	if m != nil {
+		return m.SyntheticSatisfiedBlobs
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetReceiptNonce(ctx context.Context, in *QueryGetReceiptNonceRequest, opts ...grpc.CallOption) (*QueryGetReceiptNonceResponse, error) {
-	out := new(QueryGetReceiptNonceResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/GetReceiptNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetChallengeSetResponse) GetChallenges() []ChallengePosition {
+	if m != nil {
+		return m.Challenges
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetRetrievalSession(ctx context.Context, in *QueryGetRetrievalSessionRequest, opts ...grpc.CallOption) (*QueryGetRetrievalSessionResponse, error) {
-	out := new(QueryGetRetrievalSessionResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/GetRetrievalSession", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryGetSlotRepairChallengeRequest struct {
+	DealId uint64 `protobuf:"varint,1,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	Slot   uint32 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
 }
 
-func (c *queryClient) ListRetrievalSessionsByOwner(ctx context.Context, in *QueryListRetrievalSessionsByOwnerRequest, opts ...grpc.CallOption) (*QueryListRetrievalSessionsByOwnerResponse, error) {
-	out := new(QueryListRetrievalSessionsByOwnerResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/ListRetrievalSessionsByOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetSlotRepairChallengeRequest) Reset()         { *m = QueryGetSlotRepairChallengeRequest{} }
+func (m *QueryGetSlotRepairChallengeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSlotRepairChallengeRequest) ProtoMessage()    {}
+func (*QueryGetSlotRepairChallengeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{28}
+}
+func (m *QueryGetSlotRepairChallengeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSlotRepairChallengeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSlotRepairChallengeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetSlotRepairChallengeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSlotRepairChallengeRequest.Merge(m, src)
+}
+func (m *QueryGetSlotRepairChallengeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSlotRepairChallengeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSlotRepairChallengeRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListRetrievalSessionsByProvider(ctx context.Context, in *QueryListRetrievalSessionsByProviderRequest, opts ...grpc.CallOption) (*QueryListRetrievalSessionsByProviderResponse, error) {
-	out := new(QueryListRetrievalSessionsByProviderResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/ListRetrievalSessionsByProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetSlotRepairChallengeRequest proto.InternalMessageInfo
+
+func (m *QueryGetSlotRepairChallengeRequest) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of Proof items.
-	ListProofs(context.Context, *QueryListProofsRequest) (*QueryListProofsResponse, error)
-	// Queries a list of Deal items.
-	ListDeals(context.Context, *QueryListDealsRequest) (*QueryListDealsResponse, error)
-	// Queries a Deal by id.
-	GetDeal(context.Context, *QueryGetDealRequest) (*QueryGetDealResponse, error)
-	// Queries a list of Provider items.
-	ListProviders(context.Context, *QueryListProvidersRequest) (*QueryListProvidersResponse, error)
-	// Queries a Provider by address.
-	GetProvider(context.Context, *QueryGetProviderRequest) (*QueryGetProviderResponse, error)
-	// Queries the Heat State for a Deal.
-	GetDealHeat(context.Context, *QueryGetDealHeatRequest) (*QueryGetDealHeatResponse, error)
-	// Queries the last accepted retrieval receipt nonce for a specific (deal_id, file_path).
-	GetReceiptNonce(context.Context, *QueryGetReceiptNonceRequest) (*QueryGetReceiptNonceResponse, error)
-	// Queries a RetrievalSession by id.
-	GetRetrievalSession(context.Context, *QueryGetRetrievalSessionRequest) (*QueryGetRetrievalSessionResponse, error)
-	// Lists RetrievalSessions for an owner.
-	ListRetrievalSessionsByOwner(context.Context, *QueryListRetrievalSessionsByOwnerRequest) (*QueryListRetrievalSessionsByOwnerResponse, error)
-	// Lists RetrievalSessions for a provider.
-	ListRetrievalSessionsByProvider(context.Context, *QueryListRetrievalSessionsByProviderRequest) (*QueryListRetrievalSessionsByProviderResponse, error)
+func (m *QueryGetSlotRepairChallengeRequest) GetSlot() uint32 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryGetSlotRepairChallengeResponse struct {
+	PendingProvider  string              `protobuf:"bytes,1,opt,name=pending_provider,json=pendingProvider,proto3" json:"pending_provider,omitempty"`
+	RepairTargetGen  uint64              `protobuf:"varint,2,opt,name=repair_target_gen,json=repairTargetGen,proto3" json:"repair_target_gen,omitempty"`
+	EpochId          uint64              `protobuf:"varint,3,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	EpochStartHeight uint64              `protobuf:"varint,4,opt,name=epoch_start_height,json=epochStartHeight,proto3" json:"epoch_start_height,omitempty"`
+	DeadlineHeight   uint64              `protobuf:"varint,5,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty"`
+	Bounty           types.Coin          `protobuf:"bytes,6,opt,name=bounty,proto3" json:"bounty"`
+	Challenges       []ChallengePosition `protobuf:"bytes,7,rep,name=challenges,proto3" json:"challenges"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) ListProofs(ctx context.Context, req *QueryListProofsRequest) (*QueryListProofsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListProofs not implemented")
-}
-func (*UnimplementedQueryServer) ListDeals(ctx context.Context, req *QueryListDealsRequest) (*QueryListDealsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListDeals not implemented")
-}
-func (*UnimplementedQueryServer) GetDeal(ctx context.Context, req *QueryGetDealRequest) (*QueryGetDealResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDeal not implemented")
-}
-func (*UnimplementedQueryServer) ListProviders(ctx context.Context, req *QueryListProvidersRequest) (*QueryListProvidersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListProviders not implemented")
-}
-func (*UnimplementedQueryServer) GetProvider(ctx context.Context, req *QueryGetProviderRequest) (*QueryGetProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProvider not implemented")
+func (m *QueryGetSlotRepairChallengeResponse) Reset()         { *m = QueryGetSlotRepairChallengeResponse{} }
+func (m *QueryGetSlotRepairChallengeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSlotRepairChallengeResponse) ProtoMessage()    {}
+func (*QueryGetSlotRepairChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{29}
 }
-func (*UnimplementedQueryServer) GetDealHeat(ctx context.Context, req *QueryGetDealHeatRequest) (*QueryGetDealHeatResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDealHeat not implemented")
+func (m *QueryGetSlotRepairChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetReceiptNonce(ctx context.Context, req *QueryGetReceiptNonceRequest) (*QueryGetReceiptNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReceiptNonce not implemented")
+func (m *QueryGetSlotRepairChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSlotRepairChallengeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetRetrievalSession(ctx context.Context, req *QueryGetRetrievalSessionRequest) (*QueryGetRetrievalSessionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRetrievalSession not implemented")
+func (m *QueryGetSlotRepairChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSlotRepairChallengeResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ListRetrievalSessionsByOwner(ctx context.Context, req *QueryListRetrievalSessionsByOwnerRequest) (*QueryListRetrievalSessionsByOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListRetrievalSessionsByOwner not implemented")
+func (m *QueryGetSlotRepairChallengeResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ListRetrievalSessionsByProvider(ctx context.Context, req *QueryListRetrievalSessionsByProviderRequest) (*QueryListRetrievalSessionsByProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListRetrievalSessionsByProvider not implemented")
+func (m *QueryGetSlotRepairChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSlotRepairChallengeResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryGetSlotRepairChallengeResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryGetSlotRepairChallengeResponse) GetPendingProvider() string {
+	if m != nil {
+		return m.PendingProvider
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ListProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListProofsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListProofs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Query/ListProofs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListProofs(ctx, req.(*QueryListProofsRequest))
+func (m *QueryGetSlotRepairChallengeResponse) GetRepairTargetGen() uint64 {
+	if m != nil {
+		return m.RepairTargetGen
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ListDeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListDealsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListDeals(ctx, in)
+func (m *QueryGetSlotRepairChallengeResponse) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Query/ListDeals",
+	return 0
+}
+
+func (m *QueryGetSlotRepairChallengeResponse) GetEpochStartHeight() uint64 {
+	if m != nil {
+		return m.EpochStartHeight
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListDeals(ctx, req.(*QueryListDealsRequest))
+	return 0
+}
+
+func (m *QueryGetSlotRepairChallengeResponse) GetDeadlineHeight() uint64 {
+	if m != nil {
+		return m.DeadlineHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetDeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetDealRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryGetSlotRepairChallengeResponse) GetBounty() types.Coin {
+	if m != nil {
+		return m.Bounty
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetDeal(ctx, in)
+	return types.Coin{}
+}
+
+func (m *QueryGetSlotRepairChallengeResponse) GetChallenges() []ChallengePosition {
+	if m != nil {
+		return m.Challenges
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Query/GetDeal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetDeal(ctx, req.(*QueryGetDealRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListProvidersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListProviders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Query/ListProviders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListProviders(ctx, req.(*QueryListProvidersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetProviderBondRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func _Query_GetProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProviderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Query/GetProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProvider(ctx, req.(*QueryGetProviderRequest))
+func (m *QueryGetProviderBondRequest) Reset()         { *m = QueryGetProviderBondRequest{} }
+func (m *QueryGetProviderBondRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProviderBondRequest) ProtoMessage()    {}
+func (*QueryGetProviderBondRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{30}
+}
+func (m *QueryGetProviderBondRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProviderBondRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProviderBondRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetProviderBondRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProviderBondRequest.Merge(m, src)
+}
+func (m *QueryGetProviderBondRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProviderBondRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProviderBondRequest.DiscardUnknown(m)
 }
 
-func _Query_GetDealHeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetDealHeatRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetDealHeat(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Query/GetDealHeat",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetDealHeat(ctx, req.(*QueryGetDealHeatRequest))
+var xxx_messageInfo_QueryGetProviderBondRequest proto.InternalMessageInfo
+
+func (m *QueryGetProviderBondRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetReceiptNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetReceiptNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetReceiptNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Query/GetReceiptNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetReceiptNonce(ctx, req.(*QueryGetReceiptNonceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetProviderBondResponse struct {
+	Bond         types.Coin          `protobuf:"bytes,1,opt,name=bond,proto3" json:"bond"`
+	RequiredBond types.Coin          `protobuf:"bytes,2,opt,name=required_bond,json=requiredBond,proto3" json:"required_bond"`
+	Unbondings   []ProviderUnbonding `protobuf:"bytes,3,rep,name=unbondings,proto3" json:"unbondings"`
 }
 
-func _Query_GetRetrievalSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetRetrievalSessionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetRetrievalSession(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Query/GetRetrievalSession",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetRetrievalSession(ctx, req.(*QueryGetRetrievalSessionRequest))
+func (m *QueryGetProviderBondResponse) Reset()         { *m = QueryGetProviderBondResponse{} }
+func (m *QueryGetProviderBondResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProviderBondResponse) ProtoMessage()    {}
+func (*QueryGetProviderBondResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{31}
+}
+func (m *QueryGetProviderBondResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProviderBondResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProviderBondResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetProviderBondResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProviderBondResponse.Merge(m, src)
+}
+func (m *QueryGetProviderBondResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProviderBondResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProviderBondResponse.DiscardUnknown(m)
 }
 
-func _Query_ListRetrievalSessionsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListRetrievalSessionsByOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListRetrievalSessionsByOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Query/ListRetrievalSessionsByOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListRetrievalSessionsByOwner(ctx, req.(*QueryListRetrievalSessionsByOwnerRequest))
+var xxx_messageInfo_QueryGetProviderBondResponse proto.InternalMessageInfo
+
+func (m *QueryGetProviderBondResponse) GetBond() types.Coin {
+	if m != nil {
+		return m.Bond
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_ListRetrievalSessionsByProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListRetrievalSessionsByProviderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListRetrievalSessionsByProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Query/ListRetrievalSessionsByProvider",
+func (m *QueryGetProviderBondResponse) GetRequiredBond() types.Coin {
+	if m != nil {
+		return m.RequiredBond
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListRetrievalSessionsByProvider(ctx, req.(*QueryListRetrievalSessionsByProviderRequest))
+	return types.Coin{}
+}
+
+func (m *QueryGetProviderBondResponse) GetUnbondings() []ProviderUnbonding {
+	if m != nil {
+		return m.Unbondings
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "nilchain.nilchain.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ListProofs",
-			Handler:    _Query_ListProofs_Handler,
-		},
-		{
-			MethodName: "ListDeals",
-			Handler:    _Query_ListDeals_Handler,
-		},
-		{
-			MethodName: "GetDeal",
-			Handler:    _Query_GetDeal_Handler,
-		},
-		{
-			MethodName: "ListProviders",
-			Handler:    _Query_ListProviders_Handler,
-		},
-		{
-			MethodName: "GetProvider",
-			Handler:    _Query_GetProvider_Handler,
-		},
-		{
-			MethodName: "GetDealHeat",
-			Handler:    _Query_GetDealHeat_Handler,
-		},
-		{
-			MethodName: "GetReceiptNonce",
-			Handler:    _Query_GetReceiptNonce_Handler,
-		},
-		{
-			MethodName: "GetRetrievalSession",
-			Handler:    _Query_GetRetrievalSession_Handler,
-		},
-		{
-			MethodName: "ListRetrievalSessionsByOwner",
-			Handler:    _Query_ListRetrievalSessionsByOwner_Handler,
-		},
-		{
-			MethodName: "ListRetrievalSessionsByProvider",
-			Handler:    _Query_ListRetrievalSessionsByProvider_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "nilchain/nilchain/v1/query.proto",
+type QueryGetProviderStorageRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetProviderStorageRequest) Reset()         { *m = QueryGetProviderStorageRequest{} }
+func (m *QueryGetProviderStorageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProviderStorageRequest) ProtoMessage()    {}
+func (*QueryGetProviderStorageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{32}
+}
+func (m *QueryGetProviderStorageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProviderStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProviderStorageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetProviderStorageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProviderStorageRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGetProviderStorageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProviderStorageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProviderStorageRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetProviderStorageRequest proto.InternalMessageInfo
+
+func (m *QueryGetProviderStorageRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetProviderStorageResponse struct {
+	TotalStorage     uint64 `protobuf:"varint,1,opt,name=total_storage,json=totalStorage,proto3" json:"total_storage,omitempty"`
+	CommittedStorage uint64 `protobuf:"varint,2,opt,name=committed_storage,json=committedStorage,proto3" json:"committed_storage,omitempty"`
+	ReservedStorage  uint64 `protobuf:"varint,3,opt,name=reserved_storage,json=reservedStorage,proto3" json:"reserved_storage,omitempty"`
+	FreeStorage      uint64 `protobuf:"varint,4,opt,name=free_storage,json=freeStorage,proto3" json:"free_storage,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetProviderStorageResponse) Reset()         { *m = QueryGetProviderStorageResponse{} }
+func (m *QueryGetProviderStorageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProviderStorageResponse) ProtoMessage()    {}
+func (*QueryGetProviderStorageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{33}
+}
+func (m *QueryGetProviderStorageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProviderStorageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProviderStorageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetProviderStorageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProviderStorageResponse.Merge(m, src)
+}
+func (m *QueryGetProviderStorageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProviderStorageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProviderStorageResponse.DiscardUnknown(m)
 }
 
-func (m *QueryListProofsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetProviderStorageResponse proto.InternalMessageInfo
+
+func (m *QueryGetProviderStorageResponse) GetTotalStorage() uint64 {
+	if m != nil {
+		return m.TotalStorage
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryListProofsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetProviderStorageResponse) GetCommittedStorage() uint64 {
+	if m != nil {
+		return m.CommittedStorage
+	}
+	return 0
 }
 
-func (m *QueryListProofsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGetProviderStorageResponse) GetReservedStorage() uint64 {
+	if m != nil {
+		return m.ReservedStorage
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryListProofsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetProviderStorageResponse) GetFreeStorage() uint64 {
+	if m != nil {
+		return m.FreeStorage
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryListProofsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetDealPlacementRequest struct {
+	DealId uint64 `protobuf:"varint,1,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
 }
 
-func (m *QueryListProofsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Proof) > 0 {
-		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Proof[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetDealPlacementRequest) Reset()         { *m = QueryGetDealPlacementRequest{} }
+func (m *QueryGetDealPlacementRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDealPlacementRequest) ProtoMessage()    {}
+func (*QueryGetDealPlacementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{34}
+}
+func (m *QueryGetDealPlacementRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDealPlacementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDealPlacementRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetDealPlacementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDealPlacementRequest.Merge(m, src)
+}
+func (m *QueryGetDealPlacementRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDealPlacementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDealPlacementRequest.DiscardUnknown(m)
 }
 
-func (m *QueryListDealsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetDealPlacementRequest proto.InternalMessageInfo
+
+func (m *QueryGetDealPlacementRequest) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryListDealsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetDealPlacementResponse struct {
+	Placement DealPlacement `protobuf:"bytes,1,opt,name=placement,proto3" json:"placement"`
 }
 
-func (m *QueryListDealsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetDealPlacementResponse) Reset()         { *m = QueryGetDealPlacementResponse{} }
+func (m *QueryGetDealPlacementResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDealPlacementResponse) ProtoMessage()    {}
+func (*QueryGetDealPlacementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{35}
+}
+func (m *QueryGetDealPlacementResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDealPlacementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDealPlacementResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetDealPlacementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDealPlacementResponse.Merge(m, src)
+}
+func (m *QueryGetDealPlacementResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDealPlacementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDealPlacementResponse.DiscardUnknown(m)
 }
 
-func (m *QueryListDealsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetDealPlacementResponse proto.InternalMessageInfo
+
+func (m *QueryGetDealPlacementResponse) GetPlacement() DealPlacement {
+	if m != nil {
+		return m.Placement
 	}
-	return dAtA[:n], nil
+	return DealPlacement{}
 }
 
-func (m *QueryListDealsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryListDealAccessGrantsRequest struct {
+	DealId     uint64             `protobuf:"varint,1,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryListDealsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Deals) > 0 {
-		for iNdEx := len(m.Deals) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Deals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryListDealAccessGrantsRequest) Reset()         { *m = QueryListDealAccessGrantsRequest{} }
+func (m *QueryListDealAccessGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListDealAccessGrantsRequest) ProtoMessage()    {}
+func (*QueryListDealAccessGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{36}
+}
+func (m *QueryListDealAccessGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListDealAccessGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListDealAccessGrantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetDealRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryListDealAccessGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListDealAccessGrantsRequest.Merge(m, src)
 }
-
-func (m *QueryGetDealRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryListDealAccessGrantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListDealAccessGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListDealAccessGrantsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetDealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryListDealAccessGrantsRequest proto.InternalMessageInfo
+
+func (m *QueryListDealAccessGrantsRequest) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryGetDealResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListDealAccessGrantsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetDealResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryListDealAccessGrantsResponse struct {
+	Grants     []DealAccessGrant   `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetDealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Deal != nil {
-		{
-			size, err := m.Deal.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryListDealAccessGrantsResponse) Reset()         { *m = QueryListDealAccessGrantsResponse{} }
+func (m *QueryListDealAccessGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListDealAccessGrantsResponse) ProtoMessage()    {}
+func (*QueryListDealAccessGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{37}
+}
+func (m *QueryListDealAccessGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListDealAccessGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListDealAccessGrantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryListProvidersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryListDealAccessGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListDealAccessGrantsResponse.Merge(m, src)
 }
-
-func (m *QueryListProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryListDealAccessGrantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListDealAccessGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListDealAccessGrantsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryListProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryListDealAccessGrantsResponse proto.InternalMessageInfo
+
+func (m *QueryListDealAccessGrantsResponse) GetGrants() []DealAccessGrant {
+	if m != nil {
+		return m.Grants
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryListProvidersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListDealAccessGrantsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryListProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetDealAccessGrantRequest struct {
+	DealId  uint64 `protobuf:"varint,1,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 }
 
-func (m *QueryListProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Providers) > 0 {
-		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetDealAccessGrantRequest) Reset()         { *m = QueryGetDealAccessGrantRequest{} }
+func (m *QueryGetDealAccessGrantRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDealAccessGrantRequest) ProtoMessage()    {}
+func (*QueryGetDealAccessGrantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{38}
+}
+func (m *QueryGetDealAccessGrantRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDealAccessGrantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDealAccessGrantRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetProviderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetDealAccessGrantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDealAccessGrantRequest.Merge(m, src)
 }
-
-func (m *QueryGetProviderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetDealAccessGrantRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDealAccessGrantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDealAccessGrantRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryGetDealAccessGrantRequest proto.InternalMessageInfo
+
+func (m *QueryGetDealAccessGrantRequest) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryGetProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetDealAccessGrantRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetDealAccessGrantResponse struct {
+	Grant  DealAccessGrant `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant"`
+	Active bool            `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
 }
 
-func (m *QueryGetProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Provider != nil {
-		{
-			size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetDealAccessGrantResponse) Reset()         { *m = QueryGetDealAccessGrantResponse{} }
+func (m *QueryGetDealAccessGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDealAccessGrantResponse) ProtoMessage()    {}
+func (*QueryGetDealAccessGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{39}
+}
+func (m *QueryGetDealAccessGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDealAccessGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDealAccessGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetDealHeatRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetDealAccessGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDealAccessGrantResponse.Merge(m, src)
 }
-
-func (m *QueryGetDealHeatRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetDealAccessGrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDealAccessGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDealAccessGrantResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetDealHeatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DealId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.DealId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryGetDealAccessGrantResponse proto.InternalMessageInfo
+
+func (m *QueryGetDealAccessGrantResponse) GetGrant() DealAccessGrant {
+	if m != nil {
+		return m.Grant
 	}
-	return len(dAtA) - i, nil
+	return DealAccessGrant{}
 }
 
-func (m *QueryGetDealHeatResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetDealAccessGrantResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryGetDealHeatResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryListEvidenceByProviderRequest struct {
+	Provider   string             `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetDealHeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Heat.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryListEvidenceByProviderRequest) Reset()         { *m = QueryListEvidenceByProviderRequest{} }
+func (m *QueryListEvidenceByProviderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListEvidenceByProviderRequest) ProtoMessage()    {}
+func (*QueryListEvidenceByProviderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{40}
+}
+func (m *QueryListEvidenceByProviderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListEvidenceByProviderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListEvidenceByProviderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetReceiptNonceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryListEvidenceByProviderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListEvidenceByProviderRequest.Merge(m, src)
 }
-
-func (m *QueryGetReceiptNonceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryListEvidenceByProviderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListEvidenceByProviderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListEvidenceByProviderRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetReceiptNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FilePath) > 0 {
-		i -= len(m.FilePath)
-		copy(dAtA[i:], m.FilePath)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FilePath)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.DealId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.DealId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryListEvidenceByProviderRequest proto.InternalMessageInfo
+
+func (m *QueryListEvidenceByProviderRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetReceiptNonceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListEvidenceByProviderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetReceiptNonceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryListEvidenceByProviderResponse struct {
+	Evidence   []Evidence          `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetReceiptNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LastNonce))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryListEvidenceByProviderResponse) Reset()         { *m = QueryListEvidenceByProviderResponse{} }
+func (m *QueryListEvidenceByProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListEvidenceByProviderResponse) ProtoMessage()    {}
+func (*QueryListEvidenceByProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{41}
 }
-
-func (m *QueryGetRetrievalSessionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListEvidenceByProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListEvidenceByProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListEvidenceByProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGetRetrievalSessionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryListEvidenceByProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListEvidenceByProviderResponse.Merge(m, src)
+}
+func (m *QueryListEvidenceByProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListEvidenceByProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListEvidenceByProviderResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetRetrievalSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SessionId) > 0 {
-		i -= len(m.SessionId)
-		copy(dAtA[i:], m.SessionId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryListEvidenceByProviderResponse proto.InternalMessageInfo
+
+func (m *QueryListEvidenceByProviderResponse) GetEvidence() []Evidence {
+	if m != nil {
+		return m.Evidence
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetRetrievalSessionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListEvidenceByProviderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetRetrievalSessionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryListEvidenceByDealRequest struct {
+	DealId     uint64             `protobuf:"varint,1,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetRetrievalSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryListEvidenceByDealRequest) Reset()         { *m = QueryListEvidenceByDealRequest{} }
+func (m *QueryListEvidenceByDealRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListEvidenceByDealRequest) ProtoMessage()    {}
+func (*QueryListEvidenceByDealRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{42}
+}
+func (m *QueryListEvidenceByDealRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListEvidenceByDealRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListEvidenceByDealRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryListRetrievalSessionsByOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryListEvidenceByDealRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListEvidenceByDealRequest.Merge(m, src)
 }
-
-func (m *QueryListRetrievalSessionsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryListEvidenceByDealRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListEvidenceByDealRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListEvidenceByDealRequest.DiscardUnknown(m)
 }
 
-func (m *QueryListRetrievalSessionsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryListEvidenceByDealRequest proto.InternalMessageInfo
+
+func (m *QueryListEvidenceByDealRequest) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryListRetrievalSessionsByOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListEvidenceByDealRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryListRetrievalSessionsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryListEvidenceByDealResponse struct {
+	Evidence   []Evidence          `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryListRetrievalSessionsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryListEvidenceByDealResponse) Reset()         { *m = QueryListEvidenceByDealResponse{} }
+func (m *QueryListEvidenceByDealResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListEvidenceByDealResponse) ProtoMessage()    {}
+func (*QueryListEvidenceByDealResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{43}
+}
+func (m *QueryListEvidenceByDealResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListEvidenceByDealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListEvidenceByDealResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Sessions) > 0 {
-		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryListEvidenceByDealResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListEvidenceByDealResponse.Merge(m, src)
+}
+func (m *QueryListEvidenceByDealResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListEvidenceByDealResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListEvidenceByDealResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListEvidenceByDealResponse proto.InternalMessageInfo
+
+func (m *QueryListEvidenceByDealResponse) GetEvidence() []Evidence {
+	if m != nil {
+		return m.Evidence
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryListRetrievalSessionsByProviderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListEvidenceByDealResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryListRetrievalSessionsByProviderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryVerifyEvmSignatureRequest struct {
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Digest    []byte `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (m *QueryListRetrievalSessionsByProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryVerifyEvmSignatureRequest) Reset()         { *m = QueryVerifyEvmSignatureRequest{} }
+func (m *QueryVerifyEvmSignatureRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyEvmSignatureRequest) ProtoMessage()    {}
+func (*QueryVerifyEvmSignatureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{44}
+}
+func (m *QueryVerifyEvmSignatureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyEvmSignatureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyEvmSignatureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVerifyEvmSignatureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyEvmSignatureRequest.Merge(m, src)
+}
+func (m *QueryVerifyEvmSignatureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyEvmSignatureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyEvmSignatureRequest.DiscardUnknown(m)
 }
 
-func (m *QueryListRetrievalSessionsByProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVerifyEvmSignatureRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyEvmSignatureRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryListRetrievalSessionsByProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVerifyEvmSignatureRequest) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
 }
 
-func (m *QueryListRetrievalSessionsByProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sessions) > 0 {
-		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryVerifyEvmSignatureRequest) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+type QueryVerifyEvmSignatureResponse struct {
+	Valid    bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Contract bool `protobuf:"varint,2,opt,name=contract,proto3" json:"contract,omitempty"`
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryVerifyEvmSignatureResponse) Reset()         { *m = QueryVerifyEvmSignatureResponse{} }
+func (m *QueryVerifyEvmSignatureResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyEvmSignatureResponse) ProtoMessage()    {}
+func (*QueryVerifyEvmSignatureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{45}
+}
+func (m *QueryVerifyEvmSignatureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyEvmSignatureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyEvmSignatureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryVerifyEvmSignatureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyEvmSignatureResponse.Merge(m, src)
+}
+func (m *QueryVerifyEvmSignatureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyEvmSignatureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyEvmSignatureResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryVerifyEvmSignatureResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyEvmSignatureResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return false
 }
 
-func (m *QueryListProofsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryVerifyEvmSignatureResponse) GetContract() bool {
+	if m != nil {
+		return m.Contract
 	}
-	return n
+	return false
 }
 
-func (m *QueryListProofsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Proof) > 0 {
-		for _, e := range m.Proof {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+type QueryListSessionKeysRequest struct {
+	Owner      string             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListSessionKeysRequest) Reset()         { *m = QueryListSessionKeysRequest{} }
+func (m *QueryListSessionKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListSessionKeysRequest) ProtoMessage()    {}
+func (*QueryListSessionKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{46}
+}
+func (m *QueryListSessionKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListSessionKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListSessionKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryListSessionKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListSessionKeysRequest.Merge(m, src)
+}
+func (m *QueryListSessionKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListSessionKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListSessionKeysRequest.DiscardUnknown(m)
 }
 
-func (m *QueryListDealsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryListSessionKeysRequest proto.InternalMessageInfo
+
+func (m *QueryListSessionKeysRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return n
+	return ""
 }
 
-func (m *QueryListDealsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListSessionKeysRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.Deals) > 0 {
-		for _, e := range m.Deals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryListSessionKeysResponse struct {
+	SessionKeys []SessionKey        `protobuf:"bytes,1,rep,name=session_keys,json=sessionKeys,proto3" json:"session_keys"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListSessionKeysResponse) Reset()         { *m = QueryListSessionKeysResponse{} }
+func (m *QueryListSessionKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListSessionKeysResponse) ProtoMessage()    {}
+func (*QueryListSessionKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{47}
+}
+func (m *QueryListSessionKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListSessionKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListSessionKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryListSessionKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListSessionKeysResponse.Merge(m, src)
+}
+func (m *QueryListSessionKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListSessionKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListSessionKeysResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetDealRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+var xxx_messageInfo_QueryListSessionKeysResponse proto.InternalMessageInfo
+
+func (m *QueryListSessionKeysResponse) GetSessionKeys() []SessionKey {
+	if m != nil {
+		return m.SessionKeys
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetDealResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Deal != nil {
-		l = m.Deal.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryListSessionKeysResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryListProvidersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryGetSessionKeyRequest struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (m *QueryListProvidersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Providers) > 0 {
-		for _, e := range m.Providers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetSessionKeyRequest) Reset()         { *m = QueryGetSessionKeyRequest{} }
+func (m *QueryGetSessionKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSessionKeyRequest) ProtoMessage()    {}
+func (*QueryGetSessionKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{48}
+}
+func (m *QueryGetSessionKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSessionKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSessionKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryGetSessionKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSessionKeyRequest.Merge(m, src)
+}
+func (m *QueryGetSessionKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSessionKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSessionKeyRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryGetSessionKeyRequest proto.InternalMessageInfo
+
+func (m *QueryGetSessionKeyRequest) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return n
+	return ""
 }
 
-func (m *QueryGetProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Provider != nil {
-		l = m.Provider.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryGetSessionKeyResponse struct {
+	SessionKey SessionKey `protobuf:"bytes,1,opt,name=session_key,json=sessionKey,proto3" json:"session_key"`
+	Active     bool       `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
 }
 
-func (m *QueryGetDealHeatRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DealId != 0 {
-		n += 1 + sovQuery(uint64(m.DealId))
+func (m *QueryGetSessionKeyResponse) Reset()         { *m = QueryGetSessionKeyResponse{} }
+func (m *QueryGetSessionKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSessionKeyResponse) ProtoMessage()    {}
+func (*QueryGetSessionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02e1757e30754457, []int{49}
+}
+func (m *QueryGetSessionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSessionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSessionKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryGetSessionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSessionKeyResponse.Merge(m, src)
+}
+func (m *QueryGetSessionKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSessionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSessionKeyResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetDealHeatResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryGetSessionKeyResponse proto.InternalMessageInfo
+
+func (m *QueryGetSessionKeyResponse) GetSessionKey() SessionKey {
+	if m != nil {
+		return m.SessionKey
 	}
-	var l int
-	_ = l
-	l = m.Heat.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return SessionKey{}
 }
 
-func (m *QueryGetReceiptNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DealId != 0 {
-		n += 1 + sovQuery(uint64(m.DealId))
-	}
-	l = len(m.FilePath)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetSessionKeyResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
 	}
-	return n
+	return false
 }
 
-func (m *QueryGetReceiptNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LastNonce != 0 {
-		n += 1 + sovQuery(uint64(m.LastNonce))
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "nilchain.nilchain.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "nilchain.nilchain.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryListProofsRequest)(nil), "nilchain.nilchain.v1.QueryListProofsRequest")
+	proto.RegisterType((*QueryListProofsResponse)(nil), "nilchain.nilchain.v1.QueryListProofsResponse")
+	proto.RegisterType((*QueryListDealsRequest)(nil), "nilchain.nilchain.v1.QueryListDealsRequest")
+	proto.RegisterType((*QueryListDealsResponse)(nil), "nilchain.nilchain.v1.QueryListDealsResponse")
+	proto.RegisterType((*QueryListDealsByOwnerRequest)(nil), "nilchain.nilchain.v1.QueryListDealsByOwnerRequest")
+	proto.RegisterType((*QueryListDealsByOwnerResponse)(nil), "nilchain.nilchain.v1.QueryListDealsByOwnerResponse")
+	proto.RegisterType((*QueryListDealsByProviderRequest)(nil), "nilchain.nilchain.v1.QueryListDealsByProviderRequest")
+	proto.RegisterType((*QueryListDealsByProviderResponse)(nil), "nilchain.nilchain.v1.QueryListDealsByProviderResponse")
+	proto.RegisterType((*QueryGetDealRequest)(nil), "nilchain.nilchain.v1.QueryGetDealRequest")
+	proto.RegisterType((*QueryGetDealResponse)(nil), "nilchain.nilchain.v1.QueryGetDealResponse")
+	proto.RegisterType((*QueryListProvidersRequest)(nil), "nilchain.nilchain.v1.QueryListProvidersRequest")
+	proto.RegisterType((*QueryListProvidersResponse)(nil), "nilchain.nilchain.v1.QueryListProvidersResponse")
+	proto.RegisterType((*QueryGetProviderRequest)(nil), "nilchain.nilchain.v1.QueryGetProviderRequest")
+	proto.RegisterType((*QueryGetProviderResponse)(nil), "nilchain.nilchain.v1.QueryGetProviderResponse")
+	proto.RegisterType((*QueryGetDealHeatRequest)(nil), "nilchain.nilchain.v1.QueryGetDealHeatRequest")
+	proto.RegisterType((*QueryGetDealHeatResponse)(nil), "nilchain.nilchain.v1.QueryGetDealHeatResponse")
+	proto.RegisterType((*QueryGetReceiptNonceRequest)(nil), "nilchain.nilchain.v1.QueryGetReceiptNonceRequest")
+	proto.RegisterType((*QueryGetReceiptNonceResponse)(nil), "nilchain.nilchain.v1.QueryGetReceiptNonceResponse")
+	proto.RegisterType((*QueryGetRetrievalSessionRequest)(nil), "nilchain.nilchain.v1.QueryGetRetrievalSessionRequest")
+	proto.RegisterType((*QueryGetRetrievalSessionResponse)(nil), "nilchain.nilchain.v1.QueryGetRetrievalSessionResponse")
+	proto.RegisterType((*QueryListRetrievalSessionsByOwnerRequest)(nil), "nilchain.nilchain.v1.QueryListRetrievalSessionsByOwnerRequest")
+	proto.RegisterType((*QueryListRetrievalSessionsByOwnerResponse)(nil), "nilchain.nilchain.v1.QueryListRetrievalSessionsByOwnerResponse")
+	proto.RegisterType((*QueryListRetrievalSessionsByProviderRequest)(nil), "nilchain.nilchain.v1.QueryListRetrievalSessionsByProviderRequest")
+	proto.RegisterType((*QueryListRetrievalSessionsByProviderResponse)(nil), "nilchain.nilchain.v1.QueryListRetrievalSessionsByProviderResponse")
+	proto.RegisterType((*QueryGetChallengeSetRequest)(nil), "nilchain.nilchain.v1.QueryGetChallengeSetRequest")
+	proto.RegisterType((*QueryGetChallengeSetResponse)(nil), "nilchain.nilchain.v1.QueryGetChallengeSetResponse")
+	proto.RegisterType((*QueryGetSlotRepairChallengeRequest)(nil), "nilchain.nilchain.v1.QueryGetSlotRepairChallengeRequest")
+	proto.RegisterType((*QueryGetSlotRepairChallengeResponse)(nil), "nilchain.nilchain.v1.QueryGetSlotRepairChallengeResponse")
+	proto.RegisterType((*QueryGetProviderBondRequest)(nil), "nilchain.nilchain.v1.QueryGetProviderBondRequest")
+	proto.RegisterType((*QueryGetProviderBondResponse)(nil), "nilchain.nilchain.v1.QueryGetProviderBondResponse")
+	proto.RegisterType((*QueryGetProviderStorageRequest)(nil), "nilchain.nilchain.v1.QueryGetProviderStorageRequest")
+	proto.RegisterType((*QueryGetProviderStorageResponse)(nil), "nilchain.nilchain.v1.QueryGetProviderStorageResponse")
+	proto.RegisterType((*QueryGetDealPlacementRequest)(nil), "nilchain.nilchain.v1.QueryGetDealPlacementRequest")
+	proto.RegisterType((*QueryGetDealPlacementResponse)(nil), "nilchain.nilchain.v1.QueryGetDealPlacementResponse")
+	proto.RegisterType((*QueryListDealAccessGrantsRequest)(nil), "nilchain.nilchain.v1.QueryListDealAccessGrantsRequest")
+	proto.RegisterType((*QueryListDealAccessGrantsResponse)(nil), "nilchain.nilchain.v1.QueryListDealAccessGrantsResponse")
+	proto.RegisterType((*QueryGetDealAccessGrantRequest)(nil), "nilchain.nilchain.v1.QueryGetDealAccessGrantRequest")
+	proto.RegisterType((*QueryGetDealAccessGrantResponse)(nil), "nilchain.nilchain.v1.QueryGetDealAccessGrantResponse")
+	proto.RegisterType((*QueryListEvidenceByProviderRequest)(nil), "nilchain.nilchain.v1.QueryListEvidenceByProviderRequest")
+	proto.RegisterType((*QueryListEvidenceByProviderResponse)(nil), "nilchain.nilchain.v1.QueryListEvidenceByProviderResponse")
+	proto.RegisterType((*QueryListEvidenceByDealRequest)(nil), "nilchain.nilchain.v1.QueryListEvidenceByDealRequest")
+	proto.RegisterType((*QueryListEvidenceByDealResponse)(nil), "nilchain.nilchain.v1.QueryListEvidenceByDealResponse")
+	proto.RegisterType((*QueryVerifyEvmSignatureRequest)(nil), "nilchain.nilchain.v1.QueryVerifyEvmSignatureRequest")
+	proto.RegisterType((*QueryVerifyEvmSignatureResponse)(nil), "nilchain.nilchain.v1.QueryVerifyEvmSignatureResponse")
+	proto.RegisterType((*QueryListSessionKeysRequest)(nil), "nilchain.nilchain.v1.QueryListSessionKeysRequest")
+	proto.RegisterType((*QueryListSessionKeysResponse)(nil), "nilchain.nilchain.v1.QueryListSessionKeysResponse")
+	proto.RegisterType((*QueryGetSessionKeyRequest)(nil), "nilchain.nilchain.v1.QueryGetSessionKeyRequest")
+	proto.RegisterType((*QueryGetSessionKeyResponse)(nil), "nilchain.nilchain.v1.QueryGetSessionKeyResponse")
+}
+
+func init() { proto.RegisterFile("nilchain/nilchain/v1/query.proto", fileDescriptor_02e1757e30754457) }
+
+var fileDescriptor_02e1757e30754457 = []byte{
+	// 2472 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x6c, 0x1c, 0x49,
+	0xf5, 0x4f, 0xf9, 0xdb, 0xcf, 0xf6, 0xda, 0x5b, 0xf1, 0xdf, 0x19, 0x77, 0x9c, 0xb1, 0xd3, 0xfe,
+	0x2f, 0xb1, 0x9d, 0xcc, 0xb4, 0x3d, 0xc6, 0xf1, 0x26, 0x21, 0x64, 0xe3, 0x6c, 0xe2, 0x44, 0x0b,
+	0x8b, 0x77, 0x06, 0x22, 0xc1, 0x65, 0x68, 0x4f, 0x57, 0x66, 0x9a, 0x8c, 0xbb, 0x27, 0xdd, 0x6d,
+	0xc3, 0xc8, 0xeb, 0x03, 0xac, 0x90, 0x10, 0x1c, 0x00, 0xad, 0x38, 0x71, 0x60, 0xa5, 0xe5, 0xc0,
+	0x01, 0x89, 0xf0, 0x25, 0x81, 0x04, 0x2b, 0xad, 0xe0, 0x10, 0x71, 0x5a, 0x89, 0x0b, 0xe2, 0x80,
+	0x56, 0x09, 0x12, 0x5c, 0xb9, 0x70, 0x46, 0x5d, 0xfd, 0xaa, 0x7b, 0xa6, 0xdd, 0xd3, 0xdd, 0x63,
+	0x86, 0xdd, 0x5c, 0x92, 0xae, 0xd7, 0xef, 0xbd, 0xfa, 0xbd, 0xf7, 0xea, 0x55, 0x55, 0xff, 0xc6,
+	0xb0, 0x60, 0xe8, 0xf5, 0x4a, 0x4d, 0xd5, 0x0d, 0xc5, 0x7f, 0x38, 0x58, 0x53, 0x1e, 0xed, 0x33,
+	0xab, 0x99, 0x6f, 0x58, 0xa6, 0x63, 0xd2, 0x69, 0xf1, 0x22, 0xef, 0x3f, 0x1c, 0xac, 0x49, 0x2f,
+	0xaa, 0x7b, 0xba, 0x61, 0x2a, 0xfc, 0x5f, 0x4f, 0x51, 0x9a, 0xae, 0x9a, 0x55, 0x93, 0x3f, 0x2a,
+	0xee, 0x13, 0x4a, 0xe7, 0xaa, 0xa6, 0x59, 0xad, 0x33, 0x45, 0x6d, 0xe8, 0x8a, 0x6a, 0x18, 0xa6,
+	0xa3, 0x3a, 0xba, 0x69, 0xd8, 0xf8, 0x76, 0xa5, 0x62, 0xda, 0x7b, 0xa6, 0xad, 0xec, 0xaa, 0x36,
+	0xf3, 0x66, 0x55, 0x0e, 0xd6, 0x76, 0x99, 0xa3, 0xae, 0x29, 0x0d, 0xb5, 0xaa, 0x1b, 0x5c, 0x19,
+	0x75, 0xb3, 0xad, 0xba, 0x42, 0xab, 0x62, 0xea, 0xe2, 0xfd, 0xf9, 0xc8, 0x50, 0x1a, 0xaa, 0xa5,
+	0xee, 0x89, 0xe9, 0xa2, 0xa3, 0x6d, 0x58, 0xa6, 0xf9, 0x20, 0x56, 0xc3, 0x69, 0x36, 0x18, 0xfa,
+	0x90, 0xa7, 0x81, 0xbe, 0xe1, 0x02, 0xdd, 0xe1, 0x8e, 0x8b, 0xec, 0xd1, 0x3e, 0xb3, 0x1d, 0xf9,
+	0x3e, 0x9c, 0x6e, 0x93, 0xda, 0x0d, 0xd3, 0xb0, 0x19, 0xbd, 0x01, 0x43, 0x1e, 0x80, 0x0c, 0x59,
+	0x20, 0x4b, 0x63, 0x85, 0xb9, 0x7c, 0x54, 0x36, 0xf3, 0x9e, 0xd5, 0xd6, 0xe8, 0x93, 0xbf, 0xcd,
+	0x9f, 0xfa, 0xc9, 0x3f, 0x1e, 0xaf, 0x90, 0x22, 0x9a, 0xc9, 0x5f, 0x86, 0x19, 0xee, 0xf7, 0x33,
+	0xba, 0xed, 0xec, 0xb8, 0x38, 0xc5, 0x8c, 0xf4, 0x0e, 0x40, 0x90, 0x22, 0x74, 0xff, 0x89, 0xbc,
+	0x97, 0xa3, 0xbc, 0x9b, 0xa3, 0xbc, 0x57, 0x45, 0xcc, 0x54, 0x7e, 0x47, 0xad, 0x32, 0xb4, 0x2d,
+	0xb6, 0x58, 0xca, 0x3f, 0x20, 0x70, 0xe6, 0xd8, 0x14, 0x08, 0x7f, 0x0d, 0x06, 0x79, 0x72, 0x32,
+	0x64, 0xa1, 0x7f, 0x69, 0xac, 0x70, 0xb6, 0x03, 0x7a, 0x57, 0xa5, 0xe8, 0x69, 0xd2, 0xed, 0x36,
+	0x58, 0x7d, 0x1c, 0xd6, 0x85, 0x44, 0x58, 0xde, 0x7c, 0x6d, 0xb8, 0xca, 0xf0, 0x7f, 0x3e, 0xac,
+	0x57, 0x99, 0x5a, 0xef, 0x79, 0xe0, 0x6f, 0x13, 0x98, 0x09, 0xcf, 0x80, 0x71, 0xaf, 0xc2, 0xa0,
+	0xe6, 0x0a, 0x30, 0x6e, 0x29, 0x3a, 0x6e, 0xd7, 0xa6, 0xe8, 0x29, 0xf6, 0x2e, 0xec, 0x37, 0x61,
+	0xae, 0x1d, 0xd4, 0x56, 0xf3, 0x73, 0x5f, 0x35, 0x98, 0x25, 0xa2, 0x9f, 0x86, 0x41, 0xd3, 0x1d,
+	0xf3, 0xc0, 0x47, 0x8b, 0xde, 0x80, 0xde, 0x89, 0x98, 0xfe, 0x24, 0x39, 0x79, 0x87, 0xc0, 0xb9,
+	0x0e, 0xd3, 0x63, 0x6a, 0x2e, 0xa7, 0x4e, 0xcd, 0xd6, 0x80, 0xbb, 0x9c, 0x7b, 0x9e, 0xa0, 0x6f,
+	0x12, 0x98, 0x0f, 0x43, 0xdc, 0xb1, 0xcc, 0x03, 0x5d, 0x0b, 0x92, 0x24, 0xc1, 0x48, 0x03, 0x45,
+	0x98, 0x27, 0x7f, 0xdc, 0xb3, 0x54, 0xbd, 0x4b, 0x60, 0xa1, 0x33, 0x8e, 0xe7, 0x25, 0x5b, 0x2f,
+	0xe1, 0xbe, 0xb4, 0xcd, 0x38, 0x46, 0x91, 0xa0, 0x17, 0xa0, 0x4f, 0xd7, 0x78, 0x6a, 0x06, 0x8a,
+	0x7d, 0xba, 0x26, 0xdf, 0x81, 0xe9, 0x76, 0x35, 0xc4, 0x9f, 0x87, 0x01, 0x17, 0x10, 0x76, 0x59,
+	0x5c, 0x1f, 0x70, 0x3d, 0xb9, 0x02, 0xb3, 0xad, 0x7b, 0x09, 0x4f, 0x46, 0xcf, 0x1b, 0xf7, 0x5d,
+	0x02, 0x52, 0xd4, 0x2c, 0x88, 0xf9, 0x53, 0x30, 0x2a, 0x8a, 0x2d, 0xf2, 0x9e, 0xed, 0xb8, 0x71,
+	0x79, 0xe5, 0x0a, 0x0c, 0x7a, 0x97, 0xf9, 0x75, 0xdc, 0x56, 0xb7, 0x99, 0x13, 0x5e, 0x9e, 0x19,
+	0x18, 0x56, 0x35, 0xcd, 0x62, 0xb6, 0x8d, 0xab, 0x53, 0x0c, 0xe5, 0xfb, 0x90, 0x39, 0x6e, 0x84,
+	0x71, 0x5d, 0x0d, 0x2d, 0xea, 0xe4, 0xb0, 0x7c, 0x7d, 0xb9, 0x10, 0x80, 0x71, 0xab, 0x75, 0x97,
+	0xa9, 0x8e, 0x00, 0x73, 0x06, 0x86, 0xdd, 0xd2, 0x95, 0xfd, 0xf5, 0x30, 0xe4, 0x0e, 0xef, 0x69,
+	0xf2, 0x17, 0x21, 0x73, 0xdc, 0x06, 0xb1, 0x5c, 0x87, 0x81, 0x1a, 0x53, 0x1d, 0xc4, 0xb1, 0xd8,
+	0x79, 0x5d, 0xb8, 0x56, 0x25, 0x47, 0x75, 0x18, 0xae, 0x6f, 0x6e, 0x26, 0x97, 0xe0, 0xac, 0x70,
+	0x5d, 0x64, 0x15, 0xa6, 0x37, 0x9c, 0xd7, 0x4d, 0xa3, 0xc2, 0x92, 0x20, 0xd1, 0xb3, 0x30, 0xfa,
+	0x40, 0xaf, 0xb3, 0x72, 0x43, 0x75, 0x6a, 0xbc, 0x36, 0xa3, 0xc5, 0x11, 0x57, 0xb0, 0xa3, 0x3a,
+	0x35, 0xf9, 0x3a, 0xcc, 0x45, 0x3b, 0x45, 0xcc, 0xe7, 0x00, 0xea, 0xaa, 0xed, 0x94, 0x0d, 0x57,
+	0x8a, 0x8e, 0x47, 0x5d, 0x09, 0x57, 0x93, 0x5f, 0x81, 0xf9, 0xc0, 0xdc, 0xb1, 0x74, 0x76, 0xa0,
+	0xd6, 0x4b, 0xcc, 0xb6, 0x75, 0xd3, 0x10, 0xb8, 0xce, 0x01, 0xd8, 0x9e, 0x44, 0x40, 0x1b, 0x2f,
+	0x8e, 0xa2, 0xe4, 0x9e, 0x26, 0x7f, 0x05, 0x16, 0x3a, 0x7b, 0x40, 0x10, 0x77, 0x60, 0x18, 0x0d,
+	0xfc, 0x06, 0x88, 0xcc, 0x5d, 0xd8, 0x01, 0xa6, 0x4f, 0x18, 0xcb, 0xdf, 0x22, 0xb0, 0xe4, 0xf7,
+	0x40, 0x58, 0xf9, 0xa3, 0x3d, 0x33, 0xde, 0x23, 0xb0, 0x9c, 0x02, 0x0a, 0x26, 0xe0, 0x2e, 0x8c,
+	0x60, 0x0c, 0xa2, 0x39, 0xbb, 0xcb, 0x80, 0x6f, 0xdd, 0xbb, 0x4e, 0xfd, 0x3e, 0x81, 0x8b, 0x71,
+	0x01, 0x7c, 0x1c, 0xa7, 0xcb, 0xfb, 0x04, 0x2e, 0xa5, 0xc3, 0xf4, 0xfc, 0xe6, 0xb5, 0x18, 0x74,
+	0xf9, 0xad, 0x9a, 0x5a, 0xaf, 0x33, 0xa3, 0xca, 0x4a, 0x2c, 0x71, 0xe3, 0x69, 0xcb, 0x6f, 0x5f,
+	0x7b, 0x7e, 0xe5, 0x67, 0x7d, 0x30, 0x17, 0xed, 0x14, 0xf3, 0x30, 0x0b, 0x23, 0xac, 0x61, 0x56,
+	0x6a, 0x81, 0xdb, 0x61, 0x3e, 0xbe, 0xa7, 0xd1, 0x4b, 0x40, 0xbd, 0x57, 0xb6, 0xa3, 0x5a, 0x4e,
+	0xb9, 0xc6, 0xf4, 0x6a, 0xcd, 0xe1, 0x33, 0x0c, 0x14, 0xa7, 0xf8, 0x9b, 0x92, 0xfb, 0xe2, 0x2e,
+	0x97, 0xd3, 0x79, 0x18, 0x7b, 0xb4, 0x6f, 0x3a, 0x6a, 0x79, 0xb7, 0x6e, 0xee, 0xda, 0x99, 0x7e,
+	0xae, 0x06, 0x5c, 0xb4, 0xe5, 0x4a, 0xe8, 0x22, 0x4c, 0x54, 0x2c, 0xa6, 0xe9, 0x8e, 0x8d, 0x2a,
+	0x03, 0x5c, 0x65, 0x1c, 0x85, 0x9e, 0xd2, 0x32, 0x4c, 0xd9, 0x4d, 0xc3, 0xa9, 0x31, 0x47, 0xaf,
+	0x94, 0x0d, 0xc6, 0x34, 0xa6, 0x65, 0x06, 0xb9, 0xde, 0xa4, 0x2f, 0x7f, 0x9d, 0x8b, 0xe9, 0x55,
+	0x98, 0x0d, 0x54, 0x6d, 0xd5, 0xd1, 0xed, 0x07, 0x3a, 0xd3, 0xd0, 0xf7, 0x10, 0xb7, 0x39, 0xe3,
+	0x2b, 0x94, 0xc4, 0x7b, 0x6f, 0x9a, 0xcf, 0x02, 0x54, 0x44, 0x36, 0xec, 0xcc, 0x30, 0xaf, 0xff,
+	0x85, 0xe8, 0xfa, 0xfb, 0x59, 0xdb, 0x31, 0x6d, 0xdd, 0x09, 0x16, 0x40, 0x8b, 0x03, 0xf9, 0x0d,
+	0x90, 0x45, 0x92, 0x4b, 0x75, 0xd3, 0x29, 0xb2, 0x86, 0xaa, 0x5b, 0xbe, 0x61, 0x62, 0x01, 0x29,
+	0x0c, 0xd8, 0x75, 0xd3, 0x4b, 0xed, 0x44, 0x91, 0x3f, 0xcb, 0xff, 0xee, 0x83, 0xc5, 0x58, 0x9f,
+	0x58, 0xbf, 0x65, 0x98, 0x6a, 0x30, 0x43, 0xd3, 0x8d, 0x6a, 0x39, 0xd4, 0x64, 0x93, 0x28, 0x17,
+	0x4b, 0x9f, 0xae, 0xc0, 0x8b, 0x16, 0xf7, 0x52, 0x76, 0x54, 0xab, 0xca, 0x9c, 0x72, 0x95, 0x19,
+	0x58, 0xce, 0x49, 0xef, 0xc5, 0xe7, 0xb9, 0x7c, 0x9b, 0x19, 0x6d, 0xcb, 0xa2, 0x3f, 0xcd, 0xb2,
+	0x18, 0xe8, 0xb0, 0x2c, 0x2e, 0xc0, 0xa4, 0xc6, 0x54, 0xad, 0xae, 0x1b, 0x4c, 0xa8, 0x7a, 0xf5,
+	0x7c, 0x41, 0x88, 0x51, 0x71, 0x13, 0x86, 0x76, 0xcd, 0x7d, 0xc3, 0x69, 0xf2, 0xda, 0x8d, 0x15,
+	0x66, 0xdb, 0x5a, 0x48, 0x34, 0xcf, 0x2d, 0x53, 0x17, 0x05, 0x40, 0xf5, 0x5e, 0xd7, 0x72, 0x33,
+	0xe8, 0x42, 0x91, 0xb9, 0x2d, 0xd3, 0xd0, 0x92, 0xef, 0x22, 0xff, 0x24, 0x30, 0x17, 0x6d, 0x89,
+	0xa5, 0x5a, 0x87, 0x81, 0x5d, 0xd3, 0xd0, 0x32, 0x24, 0x5d, 0x7c, 0x5c, 0x99, 0xbe, 0x0a, 0x13,
+	0x16, 0x7b, 0xb4, 0xaf, 0x5b, 0xee, 0xd2, 0x76, 0xad, 0xfb, 0xd2, 0x59, 0x8f, 0x0b, 0x2b, 0x17,
+	0x82, 0x9b, 0xa3, 0x7d, 0xc3, 0x35, 0xd7, 0x8d, 0xaa, 0xdb, 0x9b, 0x31, 0x39, 0x12, 0xd0, 0xbf,
+	0x20, 0xf4, 0x45, 0x8e, 0x02, 0x07, 0xf2, 0x55, 0xc8, 0x86, 0x23, 0x2d, 0x39, 0xa6, 0x15, 0xec,
+	0xcd, 0x31, 0x69, 0x7a, 0x9f, 0xc0, 0x7c, 0x47, 0x63, 0xcc, 0xd4, 0x22, 0x4c, 0x38, 0xa6, 0xa3,
+	0xd6, 0xcb, 0xb6, 0xf7, 0x02, 0xfb, 0x65, 0x9c, 0x0b, 0x51, 0x99, 0x5e, 0x84, 0x17, 0x2b, 0xe6,
+	0xde, 0x9e, 0xee, 0x38, 0x4c, 0xf3, 0x15, 0x71, 0x77, 0xf2, 0x5f, 0x08, 0xe5, 0x65, 0x98, 0xb2,
+	0x98, 0xcd, 0xac, 0x83, 0x16, 0xdd, 0x7e, 0xb1, 0xf4, 0x3d, 0xb9, 0x50, 0x3d, 0x0f, 0xe3, 0x0f,
+	0x2c, 0xc6, 0x7c, 0x35, 0x6f, 0x65, 0x8f, 0xb9, 0x32, 0x54, 0x91, 0x37, 0x83, 0x4a, 0xbb, 0x97,
+	0xb6, 0x9d, 0xba, 0x5a, 0x61, 0x7b, 0xcc, 0x48, 0xbe, 0x23, 0xd6, 0xe0, 0x5c, 0x07, 0x43, 0x8c,
+	0x7c, 0x1b, 0x46, 0x1b, 0x42, 0x98, 0x7c, 0x5b, 0xf4, 0xed, 0xb1, 0x46, 0x81, 0xad, 0xfc, 0x56,
+	0xf8, 0x73, 0xeb, 0x66, 0xa5, 0xc2, 0x6c, 0x7b, 0xdb, 0x52, 0x0d, 0xc7, 0x4e, 0xdc, 0x91, 0x7a,
+	0x75, 0x2c, 0xff, 0x9c, 0xc0, 0xf9, 0x18, 0x14, 0x18, 0xf4, 0x2d, 0x18, 0xaa, 0x72, 0x09, 0x9e,
+	0xc4, 0x2f, 0x75, 0x8e, 0xb8, 0xc5, 0x5e, 0x6c, 0x03, 0x9e, 0x69, 0xef, 0x8e, 0xe1, 0x52, 0xb0,
+	0xb8, 0x43, 0x33, 0x26, 0xa6, 0x2d, 0x03, 0xc3, 0x1c, 0x0d, 0x63, 0x78, 0x10, 0x8b, 0xa1, 0xfc,
+	0x26, 0xcc, 0x77, 0x74, 0x8a, 0x59, 0xb8, 0x09, 0x83, 0x5c, 0x1b, 0xcb, 0xde, 0x55, 0x12, 0x3c,
+	0x4b, 0x3a, 0x03, 0x43, 0x6a, 0xc5, 0xd1, 0x0f, 0xbc, 0xe9, 0x47, 0x8a, 0x38, 0x72, 0x6f, 0xbf,
+	0xb2, 0x5f, 0x86, 0xdb, 0x6e, 0xcb, 0x19, 0x15, 0xf6, 0xf1, 0x5c, 0xd4, 0x1e, 0x13, 0x58, 0x8c,
+	0x85, 0x82, 0xd9, 0x78, 0x05, 0x46, 0x18, 0xbe, 0x8d, 0xff, 0x28, 0xf5, 0x7d, 0xe0, 0xbd, 0x4c,
+	0x58, 0xf5, 0x6e, 0x41, 0x7c, 0x9d, 0x40, 0x36, 0x02, 0x72, 0x2b, 0x3f, 0xf0, 0x3f, 0x6f, 0xa4,
+	0x9f, 0xb6, 0xb2, 0x38, 0x61, 0x0c, 0xcf, 0x5f, 0xca, 0x0c, 0xcc, 0xd8, 0x7d, 0x66, 0xe9, 0x0f,
+	0x9a, 0xb7, 0x0f, 0xf6, 0x4a, 0x7a, 0xd5, 0x50, 0x9d, 0x7d, 0xcb, 0x3f, 0x20, 0x66, 0x60, 0xc8,
+	0xd6, 0xab, 0xc1, 0x47, 0x16, 0x8e, 0x5c, 0xb9, 0xa6, 0x57, 0x99, 0xed, 0xdd, 0x86, 0xc6, 0x8b,
+	0x38, 0xa2, 0x73, 0x30, 0x6a, 0x0b, 0x1f, 0x99, 0x7e, 0xfc, 0x94, 0x14, 0x02, 0xb9, 0x04, 0xf3,
+	0x1d, 0xe7, 0xc3, 0xec, 0x4c, 0xc3, 0xe0, 0x81, 0x5a, 0xc7, 0x02, 0x8d, 0x14, 0xbd, 0x81, 0xbb,
+	0xe4, 0x2b, 0xa6, 0xe1, 0x58, 0x6a, 0xc5, 0xc1, 0x9e, 0xf1, 0xc7, 0xf2, 0x21, 0x9c, 0xf5, 0x53,
+	0x8e, 0x97, 0xff, 0xd7, 0x58, 0xd3, 0xfe, 0x68, 0xbe, 0x12, 0x7f, 0x41, 0x60, 0x2e, 0x7a, 0x76,
+	0x8c, 0xe7, 0x1e, 0x8c, 0x8b, 0x8f, 0xeb, 0x87, 0xac, 0x29, 0xb6, 0xce, 0x85, 0xe8, 0x8a, 0x07,
+	0x0e, 0xb0, 0xe6, 0x63, 0x76, 0xe0, 0xb2, 0x77, 0x65, 0xcf, 0xc1, 0xac, 0x7f, 0x67, 0xf5, 0xfd,
+	0x8b, 0x7c, 0x4d, 0x41, 0xff, 0x43, 0xd6, 0xc4, 0x6c, 0xb9, 0x8f, 0xf2, 0x11, 0x48, 0x51, 0xea,
+	0xfe, 0x51, 0x38, 0xd6, 0x12, 0x20, 0xee, 0x8a, 0x69, 0xe3, 0x83, 0x20, 0xbe, 0x4e, 0xbb, 0x62,
+	0xe1, 0xc3, 0x45, 0x18, 0xe4, 0xf3, 0xd3, 0xb7, 0x08, 0x0c, 0x79, 0xbf, 0x29, 0xd0, 0xa5, 0xe8,
+	0x09, 0x8e, 0xff, 0x84, 0x21, 0x2d, 0xa7, 0xd0, 0xf4, 0x42, 0x91, 0xff, 0xff, 0x1b, 0x7f, 0xfe,
+	0xfb, 0xdb, 0x7d, 0x59, 0x3a, 0xa7, 0xc4, 0xfc, 0xe6, 0x42, 0xbf, 0x4b, 0x00, 0x82, 0x1f, 0x15,
+	0xe8, 0xa5, 0x18, 0xff, 0xc7, 0x7e, 0xde, 0x90, 0x72, 0x29, 0xb5, 0x53, 0x22, 0xf2, 0x20, 0x7c,
+	0x87, 0xc0, 0xa8, 0x4f, 0xd7, 0xd2, 0x8b, 0x09, 0x53, 0xb4, 0xfe, 0xea, 0x20, 0x5d, 0x4a, 0xa7,
+	0x8c, 0x70, 0x16, 0x39, 0x9c, 0x73, 0xf4, 0x6c, 0x34, 0x1c, 0x8f, 0xe4, 0xfd, 0x19, 0x81, 0xa9,
+	0x30, 0xcf, 0x4e, 0x0b, 0x69, 0xe6, 0x69, 0xe7, 0x77, 0xa4, 0xf5, 0xae, 0x6c, 0x10, 0x62, 0x81,
+	0x43, 0xbc, 0x44, 0x57, 0xa2, 0x21, 0xf2, 0xee, 0xb7, 0x95, 0x43, 0xfe, 0xff, 0x11, 0x22, 0x7e,
+	0x8f, 0xc0, 0xe9, 0x08, 0xba, 0x9b, 0x6e, 0xa4, 0x03, 0x10, 0x3a, 0x9f, 0xa5, 0xcb, 0xdd, 0x9a,
+	0x21, 0xf4, 0x97, 0x39, 0xf4, 0x02, 0x5d, 0xed, 0x58, 0x6c, 0xae, 0x6f, 0x2b, 0x87, 0xe2, 0x51,
+	0x04, 0xf0, 0x6d, 0x02, 0xc3, 0x78, 0x65, 0xa1, 0x71, 0xeb, 0xbd, 0x9d, 0x2e, 0x97, 0x56, 0xd2,
+	0xa8, 0x22, 0xb8, 0x25, 0x0e, 0x4e, 0xa6, 0x0b, 0x31, 0xa5, 0x57, 0x0e, 0x75, 0xed, 0x88, 0xfe,
+	0x90, 0xc0, 0x44, 0x1b, 0x85, 0x4d, 0x95, 0xe4, 0x45, 0xdf, 0x46, 0xa9, 0x4b, 0xab, 0xe9, 0x0d,
+	0x10, 0xde, 0x05, 0x0e, 0xef, 0x3c, 0x9d, 0x4f, 0xc8, 0x1d, 0xfd, 0x11, 0x81, 0xb1, 0x96, 0x4f,
+	0x1a, 0x9a, 0x8b, 0xcf, 0x41, 0xb8, 0xb6, 0xf9, 0xb4, 0xea, 0x88, 0x6b, 0x8d, 0xe3, 0xba, 0x48,
+	0x97, 0x13, 0x6b, 0x8a, 0x1f, 0x5e, 0x47, 0xf4, 0x1d, 0x0f, 0xa1, 0xa0, 0x99, 0x93, 0x10, 0x86,
+	0x88, 0x6f, 0x29, 0x9f, 0x56, 0x3d, 0x5d, 0xc3, 0x60, 0x61, 0xf1, 0xda, 0x74, 0xa4, 0xd4, 0x5c,
+	0x48, 0xbf, 0x26, 0x30, 0x19, 0xe2, 0xa3, 0xe9, 0x5a, 0xfc, 0xbc, 0x11, 0x84, 0xb8, 0x54, 0xe8,
+	0xc6, 0x04, 0xe1, 0x5e, 0xe3, 0x70, 0x37, 0xe8, 0x7a, 0x3a, 0xb8, 0x96, 0xe7, 0x23, 0xc7, 0xd9,
+	0x71, 0xfa, 0x07, 0x02, 0xa7, 0x23, 0x68, 0xec, 0xd8, 0x46, 0xef, 0x4c, 0x9c, 0x4b, 0x97, 0xbb,
+	0x35, 0xc3, 0x18, 0xae, 0xf3, 0x18, 0x36, 0xe9, 0x46, 0x74, 0x0c, 0x96, 0xb0, 0xcb, 0x09, 0xf2,
+	0x52, 0x39, 0x0c, 0x08, 0xfa, 0x23, 0xfa, 0x94, 0xc0, 0x5c, 0x1c, 0x29, 0x4d, 0x3f, 0x9d, 0xd0,
+	0x3e, 0x09, 0xc4, 0xba, 0x74, 0xe3, 0xc4, 0xf6, 0x18, 0xe0, 0x4d, 0x1e, 0xe0, 0x35, 0x7a, 0x25,
+	0x75, 0x80, 0xbb, 0xcd, 0x1c, 0xdf, 0x92, 0xc5, 0xce, 0x4c, 0xff, 0x45, 0x60, 0x3e, 0x81, 0x24,
+	0xa6, 0x37, 0xbb, 0xc7, 0x19, 0xee, 0xe7, 0xad, 0xff, 0xc6, 0x05, 0x46, 0xbb, 0xcd, 0xa3, 0xbd,
+	0x49, 0x6f, 0x74, 0x13, 0xad, 0xe8, 0xfc, 0x96, 0xcd, 0x9c, 0xfe, 0xde, 0x6b, 0xab, 0x56, 0x02,
+	0x38, 0xa9, 0xad, 0x22, 0x18, 0x68, 0xa9, 0xd0, 0x8d, 0x09, 0xc6, 0x70, 0x8b, 0xc7, 0x70, 0x9d,
+	0x5e, 0x4b, 0xd7, 0x56, 0x01, 0x11, 0xd7, 0x8a, 0xff, 0xaf, 0x04, 0x66, 0xa2, 0x79, 0x50, 0xfa,
+	0x72, 0x3c, 0xa6, 0xce, 0x74, 0xac, 0x74, 0xe5, 0x04, 0x96, 0x18, 0xd4, 0x6b, 0x3c, 0xa8, 0xdb,
+	0xf4, 0x56, 0xba, 0xa0, 0x5c, 0x42, 0xd7, 0x6d, 0xb5, 0xba, 0xe9, 0xb8, 0x1b, 0x87, 0xeb, 0x33,
+	0xe7, 0x07, 0x4a, 0x1f, 0x7b, 0xc5, 0x69, 0xa5, 0x0c, 0x93, 0x8a, 0x13, 0x41, 0x4c, 0x4a, 0x85,
+	0x6e, 0x4c, 0x30, 0x8e, 0xcb, 0x3c, 0x8e, 0x55, 0x9a, 0x4f, 0x7d, 0x88, 0x28, 0x9c, 0x94, 0xfc,
+	0x1d, 0x01, 0x7a, 0x9c, 0xbe, 0xa3, 0x9f, 0x4c, 0x07, 0xa1, 0x9d, 0x2a, 0x94, 0x36, 0xba, 0xb4,
+	0x42, 0xec, 0x57, 0x38, 0xf6, 0x75, 0xba, 0x96, 0x1e, 0x3b, 0x32, 0x7a, 0xf4, 0x57, 0x04, 0xa6,
+	0xc2, 0x0c, 0x1c, 0x2d, 0x24, 0x1f, 0x6f, 0x61, 0x9e, 0x4f, 0x5a, 0xef, 0xca, 0x06, 0x81, 0x6f,
+	0x72, 0xe0, 0x6b, 0x54, 0x49, 0xb7, 0x78, 0x7c, 0x4a, 0x8f, 0xfe, 0x91, 0xc0, 0x74, 0x14, 0x8f,
+	0x46, 0xd3, 0xdc, 0x0b, 0x23, 0xe8, 0x3f, 0x69, 0xb3, 0x6b, 0xbb, 0x93, 0x9d, 0x95, 0x2a, 0xf7,
+	0x91, 0x43, 0xa2, 0xee, 0x89, 0xb7, 0x78, 0x42, 0xce, 0x93, 0x16, 0x4f, 0x34, 0x15, 0x27, 0x6d,
+	0x74, 0x69, 0x85, 0x01, 0xdc, 0xe6, 0x01, 0xdc, 0xa0, 0xd7, 0x4f, 0x10, 0x80, 0x72, 0xc8, 0xff,
+	0x67, 0xec, 0x88, 0xfe, 0x89, 0xc0, 0x4c, 0x34, 0x8f, 0x15, 0xbb, 0x2f, 0xc5, 0xb2, 0x70, 0xd2,
+	0x95, 0x13, 0x58, 0xa6, 0xab, 0x4b, 0xe4, 0x45, 0xdf, 0x27, 0x7f, 0x7e, 0x4b, 0x80, 0x1e, 0x67,
+	0x97, 0x62, 0xeb, 0xd2, 0x91, 0x10, 0x93, 0x36, 0xba, 0xb4, 0x4a, 0xb7, 0x21, 0x85, 0xeb, 0xe2,
+	0x63, 0xff, 0x0d, 0x01, 0x7a, 0x9c, 0xfb, 0x89, 0xc5, 0xde, 0x91, 0x9a, 0x92, 0x36, 0xba, 0xb4,
+	0x42, 0xec, 0x1b, 0x1c, 0xbb, 0x42, 0x73, 0xd1, 0xd8, 0xd9, 0xc1, 0x5e, 0xce, 0x27, 0xac, 0xdc,
+	0xd3, 0x80, 0xf3, 0x5d, 0x47, 0xf4, 0x97, 0x04, 0x26, 0x43, 0x1c, 0x4f, 0xec, 0xf6, 0x1f, 0xcd,
+	0x46, 0x49, 0x85, 0x6e, 0x4c, 0xd2, 0x6d, 0xa1, 0xa1, 0x4f, 0x5a, 0xbc, 0x64, 0xe4, 0x5c, 0xb6,
+	0x89, 0xfe, 0x98, 0xc0, 0x44, 0x1b, 0x6d, 0x13, 0xfb, 0x2d, 0x16, 0xc5, 0x07, 0x49, 0xab, 0xe9,
+	0x0d, 0x10, 0xef, 0x2a, 0xc7, 0xbb, 0x42, 0x97, 0xa2, 0xf1, 0xb6, 0x02, 0x54, 0x0e, 0x1f, 0xb2,
+	0xe6, 0xd1, 0xd6, 0xfa, 0x93, 0xa7, 0x59, 0xf2, 0xc1, 0xd3, 0x2c, 0xf9, 0xf0, 0x69, 0x96, 0x7c,
+	0xef, 0x59, 0xf6, 0xd4, 0x07, 0xcf, 0xb2, 0xa7, 0xfe, 0xf2, 0x2c, 0x7b, 0xea, 0x4b, 0xb3, 0xbe,
+	0xe5, 0xd7, 0x02, 0x27, 0xfc, 0xef, 0x56, 0x77, 0x87, 0xf8, 0x1f, 0xae, 0xae, 0xff, 0x67, 0x00,
+	0x15, 0x09, 0x2c, 0xc9, 0xec, 0x2b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of Proof items.
+	ListProofs(ctx context.Context, in *QueryListProofsRequest, opts ...grpc.CallOption) (*QueryListProofsResponse, error)
+	// Queries a list of Deal items.
+	ListDeals(ctx context.Context, in *QueryListDealsRequest, opts ...grpc.CallOption) (*QueryListDealsResponse, error)
+	// Lists the deals an account owns, by deal id.
+	ListDealsByOwner(ctx context.Context, in *QueryListDealsByOwnerRequest, opts ...grpc.CallOption) (*QueryListDealsByOwnerResponse, error)
+	// Lists the deals a provider is assigned to, by deal id.
+	ListDealsByProvider(ctx context.Context, in *QueryListDealsByProviderRequest, opts ...grpc.CallOption) (*QueryListDealsByProviderResponse, error)
+	// Queries a Deal by id.
+	GetDeal(ctx context.Context, in *QueryGetDealRequest, opts ...grpc.CallOption) (*QueryGetDealResponse, error)
+	// Queries a list of Provider items.
+	ListProviders(ctx context.Context, in *QueryListProvidersRequest, opts ...grpc.CallOption) (*QueryListProvidersResponse, error)
+	// Queries a Provider by address.
+	GetProvider(ctx context.Context, in *QueryGetProviderRequest, opts ...grpc.CallOption) (*QueryGetProviderResponse, error)
+	// Queries the Heat State for a Deal.
+	GetDealHeat(ctx context.Context, in *QueryGetDealHeatRequest, opts ...grpc.CallOption) (*QueryGetDealHeatResponse, error)
+	// Queries the last accepted retrieval receipt nonce for a specific (deal_id, file_path).
+	GetReceiptNonce(ctx context.Context, in *QueryGetReceiptNonceRequest, opts ...grpc.CallOption) (*QueryGetReceiptNonceResponse, error)
+	// Queries a RetrievalSession by id.
+	GetRetrievalSession(ctx context.Context, in *QueryGetRetrievalSessionRequest, opts ...grpc.CallOption) (*QueryGetRetrievalSessionResponse, error)
+	// Lists RetrievalSessions for an owner.
+	ListRetrievalSessionsByOwner(ctx context.Context, in *QueryListRetrievalSessionsByOwnerRequest, opts ...grpc.CallOption) (*QueryListRetrievalSessionsByOwnerResponse, error)
+	// Lists RetrievalSessions for a provider.
+	ListRetrievalSessionsByProvider(ctx context.Context, in *QueryListRetrievalSessionsByProviderRequest, opts ...grpc.CallOption) (*QueryListRetrievalSessionsByProviderResponse, error)
+	// Queries the synthetic challenge set a provider must answer for a deal in the current epoch.
+	GetChallengeSet(ctx context.Context, in *QueryGetChallengeSetRequest, opts ...grpc.CallOption) (*QueryGetChallengeSetResponse, error)
+	// Queries the leaves a pending provider must prove to complete a slot repair.
+	GetSlotRepairChallenge(ctx context.Context, in *QueryGetSlotRepairChallengeRequest, opts ...grpc.CallOption) (*QueryGetSlotRepairChallengeResponse, error)
+	// Queries a provider's bond, the bond its capacity requires, and pending unbondings.
+	GetProviderBond(ctx context.Context, in *QueryGetProviderBondRequest, opts ...grpc.CallOption) (*QueryGetProviderBondResponse, error)
+	// Queries a provider's committed, reserved and free storage.
+	GetProviderStorage(ctx context.Context, in *QueryGetProviderStorageRequest, opts ...grpc.CallOption) (*QueryGetProviderStorageResponse, error)
+	// Explains how each of a deal's providers was chosen.
+	GetDealPlacement(ctx context.Context, in *QueryGetDealPlacementRequest, opts ...grpc.CallOption) (*QueryGetDealPlacementResponse, error)
+	// Lists the read grants on a deal.
+	ListDealAccessGrants(ctx context.Context, in *QueryListDealAccessGrantsRequest, opts ...grpc.CallOption) (*QueryListDealAccessGrantsResponse, error)
+	// Queries one grantee's read grant on a deal and whether it is usable now.
+	GetDealAccessGrant(ctx context.Context, in *QueryGetDealAccessGrantRequest, opts ...grpc.CallOption) (*QueryGetDealAccessGrantResponse, error)
+	// Lists the evidence recorded against a provider, oldest first.
+	ListEvidenceByProvider(ctx context.Context, in *QueryListEvidenceByProviderRequest, opts ...grpc.CallOption) (*QueryListEvidenceByProviderResponse, error)
+	// Lists the evidence recorded on a deal, oldest first.
+	ListEvidenceByDeal(ctx context.Context, in *QueryListEvidenceByDealRequest, opts ...grpc.CallOption) (*QueryListEvidenceByDealResponse, error)
+	// Checks an EIP-712 signature for an EVM address: ECDSA for plain accounts,
+	// the account's EIP-1271 isValidSignature for contract accounts.
+	VerifyEvmSignature(ctx context.Context, in *QueryVerifyEvmSignatureRequest, opts ...grpc.CallOption) (*QueryVerifyEvmSignatureResponse, error)
+	// Lists an owner's session keys.
+	ListSessionKeys(ctx context.Context, in *QueryListSessionKeysRequest, opts ...grpc.CallOption) (*QueryListSessionKeysResponse, error)
+	// Queries a session key and whether it is usable now.
+	GetSessionKey(ctx context.Context, in *QueryGetSessionKeyRequest, opts ...grpc.CallOption) (*QueryGetSessionKeyResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetRetrievalSessionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ListProofs(ctx context.Context, in *QueryListProofsRequest, opts ...grpc.CallOption) (*QueryListProofsResponse, error) {
+	out := new(QueryListProofsResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/ListProofs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.SessionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ListDeals(ctx context.Context, in *QueryListDealsRequest, opts ...grpc.CallOption) (*QueryListDealsResponse, error) {
+	out := new(QueryListDealsResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/ListDeals", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetRetrievalSessionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ListDealsByOwner(ctx context.Context, in *QueryListDealsByOwnerRequest, opts ...grpc.CallOption) (*QueryListDealsByOwnerResponse, error) {
+	out := new(QueryListDealsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/ListDealsByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Session.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryListRetrievalSessionsByOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ListDealsByProvider(ctx context.Context, in *QueryListDealsByProviderRequest, opts ...grpc.CallOption) (*QueryListDealsByProviderResponse, error) {
+	out := new(QueryListDealsByProviderResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/ListDealsByProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) GetDeal(ctx context.Context, in *QueryGetDealRequest, opts ...grpc.CallOption) (*QueryGetDealResponse, error) {
+	out := new(QueryGetDealResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/GetDeal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ListProviders(ctx context.Context, in *QueryListProvidersRequest, opts ...grpc.CallOption) (*QueryListProvidersResponse, error) {
+	out := new(QueryListProvidersResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/ListProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryListRetrievalSessionsByOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) GetProvider(ctx context.Context, in *QueryGetProviderRequest, opts ...grpc.CallOption) (*QueryGetProviderResponse, error) {
+	out := new(QueryGetProviderResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/GetProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Sessions) > 0 {
+	return out, nil
+}
+
+func (c *queryClient) GetDealHeat(ctx context.Context, in *QueryGetDealHeatRequest, opts ...grpc.CallOption) (*QueryGetDealHeatResponse, error) {
+	out := new(QueryGetDealHeatResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/GetDealHeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetReceiptNonce(ctx context.Context, in *QueryGetReceiptNonceRequest, opts ...grpc.CallOption) (*QueryGetReceiptNonceResponse, error) {
+	out := new(QueryGetReceiptNonceResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/GetReceiptNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRetrievalSession(ctx context.Context, in *QueryGetRetrievalSessionRequest, opts ...grpc.CallOption) (*QueryGetRetrievalSessionResponse, error) {
+	out := new(QueryGetRetrievalSessionResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/GetRetrievalSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListRetrievalSessionsByOwner(ctx context.Context, in *QueryListRetrievalSessionsByOwnerRequest, opts ...grpc.CallOption) (*QueryListRetrievalSessionsByOwnerResponse, error) {
+	out := new(QueryListRetrievalSessionsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/ListRetrievalSessionsByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListRetrievalSessionsByProvider(ctx context.Context, in *QueryListRetrievalSessionsByProviderRequest, opts ...grpc.CallOption) (*QueryListRetrievalSessionsByProviderResponse, error) {
+	out := new(QueryListRetrievalSessionsByProviderResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/ListRetrievalSessionsByProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetChallengeSet(ctx context.Context, in *QueryGetChallengeSetRequest, opts ...grpc.CallOption) (*QueryGetChallengeSetResponse, error) {
+	out := new(QueryGetChallengeSetResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/GetChallengeSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetSlotRepairChallenge(ctx context.Context, in *QueryGetSlotRepairChallengeRequest, opts ...grpc.CallOption) (*QueryGetSlotRepairChallengeResponse, error) {
+	out := new(QueryGetSlotRepairChallengeResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/GetSlotRepairChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetProviderBond(ctx context.Context, in *QueryGetProviderBondRequest, opts ...grpc.CallOption) (*QueryGetProviderBondResponse, error) {
+	out := new(QueryGetProviderBondResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/GetProviderBond", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetProviderStorage(ctx context.Context, in *QueryGetProviderStorageRequest, opts ...grpc.CallOption) (*QueryGetProviderStorageResponse, error) {
+	out := new(QueryGetProviderStorageResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/GetProviderStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetDealPlacement(ctx context.Context, in *QueryGetDealPlacementRequest, opts ...grpc.CallOption) (*QueryGetDealPlacementResponse, error) {
+	out := new(QueryGetDealPlacementResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/GetDealPlacement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListDealAccessGrants(ctx context.Context, in *QueryListDealAccessGrantsRequest, opts ...grpc.CallOption) (*QueryListDealAccessGrantsResponse, error) {
+	out := new(QueryListDealAccessGrantsResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/ListDealAccessGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetDealAccessGrant(ctx context.Context, in *QueryGetDealAccessGrantRequest, opts ...grpc.CallOption) (*QueryGetDealAccessGrantResponse, error) {
+	out := new(QueryGetDealAccessGrantResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/GetDealAccessGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListEvidenceByProvider(ctx context.Context, in *QueryListEvidenceByProviderRequest, opts ...grpc.CallOption) (*QueryListEvidenceByProviderResponse, error) {
+	out := new(QueryListEvidenceByProviderResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/ListEvidenceByProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListEvidenceByDeal(ctx context.Context, in *QueryListEvidenceByDealRequest, opts ...grpc.CallOption) (*QueryListEvidenceByDealResponse, error) {
+	out := new(QueryListEvidenceByDealResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/ListEvidenceByDeal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyEvmSignature(ctx context.Context, in *QueryVerifyEvmSignatureRequest, opts ...grpc.CallOption) (*QueryVerifyEvmSignatureResponse, error) {
+	out := new(QueryVerifyEvmSignatureResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/VerifyEvmSignature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListSessionKeys(ctx context.Context, in *QueryListSessionKeysRequest, opts ...grpc.CallOption) (*QueryListSessionKeysResponse, error) {
+	out := new(QueryListSessionKeysResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/ListSessionKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetSessionKey(ctx context.Context, in *QueryGetSessionKeyRequest, opts ...grpc.CallOption) (*QueryGetSessionKeyResponse, error) {
+	out := new(QueryGetSessionKeyResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Query/GetSessionKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of Proof items.
+	ListProofs(context.Context, *QueryListProofsRequest) (*QueryListProofsResponse, error)
+	// Queries a list of Deal items.
+	ListDeals(context.Context, *QueryListDealsRequest) (*QueryListDealsResponse, error)
+	// Lists the deals an account owns, by deal id.
+	ListDealsByOwner(context.Context, *QueryListDealsByOwnerRequest) (*QueryListDealsByOwnerResponse, error)
+	// Lists the deals a provider is assigned to, by deal id.
+	ListDealsByProvider(context.Context, *QueryListDealsByProviderRequest) (*QueryListDealsByProviderResponse, error)
+	// Queries a Deal by id.
+	GetDeal(context.Context, *QueryGetDealRequest) (*QueryGetDealResponse, error)
+	// Queries a list of Provider items.
+	ListProviders(context.Context, *QueryListProvidersRequest) (*QueryListProvidersResponse, error)
+	// Queries a Provider by address.
+	GetProvider(context.Context, *QueryGetProviderRequest) (*QueryGetProviderResponse, error)
+	// Queries the Heat State for a Deal.
+	GetDealHeat(context.Context, *QueryGetDealHeatRequest) (*QueryGetDealHeatResponse, error)
+	// Queries the last accepted retrieval receipt nonce for a specific (deal_id, file_path).
+	GetReceiptNonce(context.Context, *QueryGetReceiptNonceRequest) (*QueryGetReceiptNonceResponse, error)
+	// Queries a RetrievalSession by id.
+	GetRetrievalSession(context.Context, *QueryGetRetrievalSessionRequest) (*QueryGetRetrievalSessionResponse, error)
+	// Lists RetrievalSessions for an owner.
+	ListRetrievalSessionsByOwner(context.Context, *QueryListRetrievalSessionsByOwnerRequest) (*QueryListRetrievalSessionsByOwnerResponse, error)
+	// Lists RetrievalSessions for a provider.
+	ListRetrievalSessionsByProvider(context.Context, *QueryListRetrievalSessionsByProviderRequest) (*QueryListRetrievalSessionsByProviderResponse, error)
+	// Queries the synthetic challenge set a provider must answer for a deal in the current epoch.
+	GetChallengeSet(context.Context, *QueryGetChallengeSetRequest) (*QueryGetChallengeSetResponse, error)
+	// Queries the leaves a pending provider must prove to complete a slot repair.
+	GetSlotRepairChallenge(context.Context, *QueryGetSlotRepairChallengeRequest) (*QueryGetSlotRepairChallengeResponse, error)
+	// Queries a provider's bond, the bond its capacity requires, and pending unbondings.
+	GetProviderBond(context.Context, *QueryGetProviderBondRequest) (*QueryGetProviderBondResponse, error)
+	// Queries a provider's committed, reserved and free storage.
+	GetProviderStorage(context.Context, *QueryGetProviderStorageRequest) (*QueryGetProviderStorageResponse, error)
+	// Explains how each of a deal's providers was chosen.
+	GetDealPlacement(context.Context, *QueryGetDealPlacementRequest) (*QueryGetDealPlacementResponse, error)
+	// Lists the read grants on a deal.
+	ListDealAccessGrants(context.Context, *QueryListDealAccessGrantsRequest) (*QueryListDealAccessGrantsResponse, error)
+	// Queries one grantee's read grant on a deal and whether it is usable now.
+	GetDealAccessGrant(context.Context, *QueryGetDealAccessGrantRequest) (*QueryGetDealAccessGrantResponse, error)
+	// Lists the evidence recorded against a provider, oldest first.
+	ListEvidenceByProvider(context.Context, *QueryListEvidenceByProviderRequest) (*QueryListEvidenceByProviderResponse, error)
+	// Lists the evidence recorded on a deal, oldest first.
+	ListEvidenceByDeal(context.Context, *QueryListEvidenceByDealRequest) (*QueryListEvidenceByDealResponse, error)
+	// Checks an EIP-712 signature for an EVM address: ECDSA for plain accounts,
+	// the account's EIP-1271 isValidSignature for contract accounts.
+	VerifyEvmSignature(context.Context, *QueryVerifyEvmSignatureRequest) (*QueryVerifyEvmSignatureResponse, error)
+	// Lists an owner's session keys.
+	ListSessionKeys(context.Context, *QueryListSessionKeysRequest) (*QueryListSessionKeysResponse, error)
+	// Queries a session key and whether it is usable now.
+	GetSessionKey(context.Context, *QueryGetSessionKeyRequest) (*QueryGetSessionKeyResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ListProofs(ctx context.Context, req *QueryListProofsRequest) (*QueryListProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProofs not implemented")
+}
+func (*UnimplementedQueryServer) ListDeals(ctx context.Context, req *QueryListDealsRequest) (*QueryListDealsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeals not implemented")
+}
+func (*UnimplementedQueryServer) ListDealsByOwner(ctx context.Context, req *QueryListDealsByOwnerRequest) (*QueryListDealsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDealsByOwner not implemented")
+}
+func (*UnimplementedQueryServer) ListDealsByProvider(ctx context.Context, req *QueryListDealsByProviderRequest) (*QueryListDealsByProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDealsByProvider not implemented")
+}
+func (*UnimplementedQueryServer) GetDeal(ctx context.Context, req *QueryGetDealRequest) (*QueryGetDealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeal not implemented")
+}
+func (*UnimplementedQueryServer) ListProviders(ctx context.Context, req *QueryListProvidersRequest) (*QueryListProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProviders not implemented")
+}
+func (*UnimplementedQueryServer) GetProvider(ctx context.Context, req *QueryGetProviderRequest) (*QueryGetProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProvider not implemented")
+}
+func (*UnimplementedQueryServer) GetDealHeat(ctx context.Context, req *QueryGetDealHeatRequest) (*QueryGetDealHeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDealHeat not implemented")
+}
+func (*UnimplementedQueryServer) GetReceiptNonce(ctx context.Context, req *QueryGetReceiptNonceRequest) (*QueryGetReceiptNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceiptNonce not implemented")
+}
+func (*UnimplementedQueryServer) GetRetrievalSession(ctx context.Context, req *QueryGetRetrievalSessionRequest) (*QueryGetRetrievalSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRetrievalSession not implemented")
+}
+func (*UnimplementedQueryServer) ListRetrievalSessionsByOwner(ctx context.Context, req *QueryListRetrievalSessionsByOwnerRequest) (*QueryListRetrievalSessionsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRetrievalSessionsByOwner not implemented")
+}
+func (*UnimplementedQueryServer) ListRetrievalSessionsByProvider(ctx context.Context, req *QueryListRetrievalSessionsByProviderRequest) (*QueryListRetrievalSessionsByProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRetrievalSessionsByProvider not implemented")
+}
+func (*UnimplementedQueryServer) GetChallengeSet(ctx context.Context, req *QueryGetChallengeSetRequest) (*QueryGetChallengeSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChallengeSet not implemented")
+}
+func (*UnimplementedQueryServer) GetSlotRepairChallenge(ctx context.Context, req *QueryGetSlotRepairChallengeRequest) (*QueryGetSlotRepairChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSlotRepairChallenge not implemented")
+}
+func (*UnimplementedQueryServer) GetProviderBond(ctx context.Context, req *QueryGetProviderBondRequest) (*QueryGetProviderBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProviderBond not implemented")
+}
+func (*UnimplementedQueryServer) GetProviderStorage(ctx context.Context, req *QueryGetProviderStorageRequest) (*QueryGetProviderStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProviderStorage not implemented")
+}
+func (*UnimplementedQueryServer) GetDealPlacement(ctx context.Context, req *QueryGetDealPlacementRequest) (*QueryGetDealPlacementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDealPlacement not implemented")
+}
+func (*UnimplementedQueryServer) ListDealAccessGrants(ctx context.Context, req *QueryListDealAccessGrantsRequest) (*QueryListDealAccessGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDealAccessGrants not implemented")
+}
+func (*UnimplementedQueryServer) GetDealAccessGrant(ctx context.Context, req *QueryGetDealAccessGrantRequest) (*QueryGetDealAccessGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDealAccessGrant not implemented")
+}
+func (*UnimplementedQueryServer) ListEvidenceByProvider(ctx context.Context, req *QueryListEvidenceByProviderRequest) (*QueryListEvidenceByProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvidenceByProvider not implemented")
+}
+func (*UnimplementedQueryServer) ListEvidenceByDeal(ctx context.Context, req *QueryListEvidenceByDealRequest) (*QueryListEvidenceByDealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvidenceByDeal not implemented")
+}
+func (*UnimplementedQueryServer) VerifyEvmSignature(ctx context.Context, req *QueryVerifyEvmSignatureRequest) (*QueryVerifyEvmSignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEvmSignature not implemented")
+}
+func (*UnimplementedQueryServer) ListSessionKeys(ctx context.Context, req *QueryListSessionKeysRequest) (*QueryListSessionKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessionKeys not implemented")
+}
+func (*UnimplementedQueryServer) GetSessionKey(ctx context.Context, req *QueryGetSessionKeyRequest) (*QueryGetSessionKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionKey not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/ListProofs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListProofs(ctx, req.(*QueryListProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListDeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListDealsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListDeals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/ListDeals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListDeals(ctx, req.(*QueryListDealsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListDealsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListDealsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListDealsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/ListDealsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListDealsByOwner(ctx, req.(*QueryListDealsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListDealsByProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListDealsByProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListDealsByProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/ListDealsByProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListDealsByProvider(ctx, req.(*QueryListDealsByProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/GetDeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDeal(ctx, req.(*QueryGetDealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/ListProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListProviders(ctx, req.(*QueryListProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/GetProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProvider(ctx, req.(*QueryGetProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDealHeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDealHeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDealHeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/GetDealHeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDealHeat(ctx, req.(*QueryGetDealHeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetReceiptNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetReceiptNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetReceiptNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/GetReceiptNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetReceiptNonce(ctx, req.(*QueryGetReceiptNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRetrievalSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRetrievalSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRetrievalSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/GetRetrievalSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRetrievalSession(ctx, req.(*QueryGetRetrievalSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListRetrievalSessionsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListRetrievalSessionsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListRetrievalSessionsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/ListRetrievalSessionsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListRetrievalSessionsByOwner(ctx, req.(*QueryListRetrievalSessionsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListRetrievalSessionsByProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListRetrievalSessionsByProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListRetrievalSessionsByProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/ListRetrievalSessionsByProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListRetrievalSessionsByProvider(ctx, req.(*QueryListRetrievalSessionsByProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetChallengeSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChallengeSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetChallengeSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/GetChallengeSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetChallengeSet(ctx, req.(*QueryGetChallengeSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetSlotRepairChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSlotRepairChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSlotRepairChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/GetSlotRepairChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSlotRepairChallenge(ctx, req.(*QueryGetSlotRepairChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProviderBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProviderBondRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProviderBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/GetProviderBond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProviderBond(ctx, req.(*QueryGetProviderBondRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProviderStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProviderStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProviderStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/GetProviderStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProviderStorage(ctx, req.(*QueryGetProviderStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDealPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDealPlacementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDealPlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/GetDealPlacement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDealPlacement(ctx, req.(*QueryGetDealPlacementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListDealAccessGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListDealAccessGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListDealAccessGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/ListDealAccessGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListDealAccessGrants(ctx, req.(*QueryListDealAccessGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDealAccessGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDealAccessGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDealAccessGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/GetDealAccessGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDealAccessGrant(ctx, req.(*QueryGetDealAccessGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListEvidenceByProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListEvidenceByProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListEvidenceByProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/ListEvidenceByProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListEvidenceByProvider(ctx, req.(*QueryListEvidenceByProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListEvidenceByDeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListEvidenceByDealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListEvidenceByDeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/ListEvidenceByDeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListEvidenceByDeal(ctx, req.(*QueryListEvidenceByDealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyEvmSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyEvmSignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyEvmSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/VerifyEvmSignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyEvmSignature(ctx, req.(*QueryVerifyEvmSignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListSessionKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListSessionKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListSessionKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/ListSessionKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListSessionKeys(ctx, req.(*QueryListSessionKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetSessionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSessionKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSessionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Query/GetSessionKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSessionKey(ctx, req.(*QueryGetSessionKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nilchain.nilchain.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ListProofs",
+			Handler:    _Query_ListProofs_Handler,
+		},
+		{
+			MethodName: "ListDeals",
+			Handler:    _Query_ListDeals_Handler,
+		},
+		{
+			MethodName: "ListDealsByOwner",
+			Handler:    _Query_ListDealsByOwner_Handler,
+		},
+		{
+			MethodName: "ListDealsByProvider",
+			Handler:    _Query_ListDealsByProvider_Handler,
+		},
+		{
+			MethodName: "GetDeal",
+			Handler:    _Query_GetDeal_Handler,
+		},
+		{
+			MethodName: "ListProviders",
+			Handler:    _Query_ListProviders_Handler,
+		},
+		{
+			MethodName: "GetProvider",
+			Handler:    _Query_GetProvider_Handler,
+		},
+		{
+			MethodName: "GetDealHeat",
+			Handler:    _Query_GetDealHeat_Handler,
+		},
+		{
+			MethodName: "GetReceiptNonce",
+			Handler:    _Query_GetReceiptNonce_Handler,
+		},
+		{
+			MethodName: "GetRetrievalSession",
+			Handler:    _Query_GetRetrievalSession_Handler,
+		},
+		{
+			MethodName: "ListRetrievalSessionsByOwner",
+			Handler:    _Query_ListRetrievalSessionsByOwner_Handler,
+		},
+		{
+			MethodName: "ListRetrievalSessionsByProvider",
+			Handler:    _Query_ListRetrievalSessionsByProvider_Handler,
+		},
+		{
+			MethodName: "GetChallengeSet",
+			Handler:    _Query_GetChallengeSet_Handler,
+		},
+		{
+			MethodName: "GetSlotRepairChallenge",
+			Handler:    _Query_GetSlotRepairChallenge_Handler,
+		},
+		{
+			MethodName: "GetProviderBond",
+			Handler:    _Query_GetProviderBond_Handler,
+		},
+		{
+			MethodName: "GetProviderStorage",
+			Handler:    _Query_GetProviderStorage_Handler,
+		},
+		{
+			MethodName: "GetDealPlacement",
+			Handler:    _Query_GetDealPlacement_Handler,
+		},
+		{
+			MethodName: "ListDealAccessGrants",
+			Handler:    _Query_ListDealAccessGrants_Handler,
+		},
+		{
+			MethodName: "GetDealAccessGrant",
+			Handler:    _Query_GetDealAccessGrant_Handler,
+		},
+		{
+			MethodName: "ListEvidenceByProvider",
+			Handler:    _Query_ListEvidenceByProvider_Handler,
+		},
+		{
+			MethodName: "ListEvidenceByDeal",
+			Handler:    _Query_ListEvidenceByDeal_Handler,
+		},
+		{
+			MethodName: "VerifyEvmSignature",
+			Handler:    _Query_VerifyEvmSignature_Handler,
+		},
+		{
+			MethodName: "ListSessionKeys",
+			Handler:    _Query_ListSessionKeys_Handler,
+		},
+		{
+			MethodName: "GetSessionKey",
+			Handler:    _Query_GetSessionKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "nilchain/nilchain/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListProofsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListProofsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListProofsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListProofsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListProofsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListProofsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proof[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListDealsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListDealsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListDealsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListDealsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListDealsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListDealsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Deals) > 0 {
+		for iNdEx := len(m.Deals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListDealsByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListDealsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListDealsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListDealsByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListDealsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListDealsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Deals) > 0 {
+		for iNdEx := len(m.Deals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListDealsByProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListDealsByProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListDealsByProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListDealsByProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListDealsByProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListDealsByProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Deals) > 0 {
+		for iNdEx := len(m.Deals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDealRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDealRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deal != nil {
+		{
+			size, err := m.Deal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Provider != nil {
+		{
+			size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDealHeatRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDealHeatRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDealHeatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDealHeatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDealHeatResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDealHeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Heat.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetReceiptNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetReceiptNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetReceiptNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FilePath) > 0 {
+		i -= len(m.FilePath)
+		copy(dAtA[i:], m.FilePath)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FilePath)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DealId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetReceiptNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetReceiptNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetReceiptNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRetrievalSessionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRetrievalSessionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRetrievalSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRetrievalSessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRetrievalSessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRetrievalSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListRetrievalSessionsByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListRetrievalSessionsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListRetrievalSessionsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListRetrievalSessionsByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListRetrievalSessionsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListRetrievalSessionsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListRetrievalSessionsByProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListRetrievalSessionsByProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListRetrievalSessionsByProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListRetrievalSessionsByProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListRetrievalSessionsByProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListRetrievalSessionsByProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChallengeSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChallengeSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChallengeSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DealId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChallengeSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChallengeSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChallengeSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Challenges) > 0 {
+		for iNdEx := len(m.Challenges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Challenges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.SyntheticSatisfiedBlobs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SyntheticSatisfiedBlobs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SyntheticNeeded != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SyntheticNeeded))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CreditsBlobs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreditsBlobs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.QuotaBlobs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QuotaBlobs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochStartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochStartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSlotRepairChallengeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSlotRepairChallengeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSlotRepairChallengeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DealId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSlotRepairChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSlotRepairChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSlotRepairChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Challenges) > 0 {
+		for iNdEx := len(m.Challenges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Challenges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.Bounty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EpochStartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochStartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EpochId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RepairTargetGen != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RepairTargetGen))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PendingProvider) > 0 {
+		i -= len(m.PendingProvider)
+		copy(dAtA[i:], m.PendingProvider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PendingProvider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProviderBondRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProviderBondRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProviderBondRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProviderBondResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProviderBondResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProviderBondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.RequiredBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProviderStorageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProviderStorageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProviderStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProviderStorageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProviderStorageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProviderStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FreeStorage != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FreeStorage))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReservedStorage != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReservedStorage))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CommittedStorage != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CommittedStorage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TotalStorage != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalStorage))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDealPlacementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDealPlacementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDealPlacementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDealPlacementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDealPlacementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDealPlacementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Placement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListDealAccessGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListDealAccessGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListDealAccessGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DealId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListDealAccessGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListDealAccessGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListDealAccessGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDealAccessGrantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDealAccessGrantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDealAccessGrantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DealId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDealAccessGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDealAccessGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDealAccessGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Grant.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListEvidenceByProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListEvidenceByProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListEvidenceByProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListEvidenceByProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListEvidenceByProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListEvidenceByProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListEvidenceByDealRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListEvidenceByDealRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListEvidenceByDealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DealId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListEvidenceByDealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListEvidenceByDealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListEvidenceByDealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyEvmSignatureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyEvmSignatureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyEvmSignatureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyEvmSignatureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyEvmSignatureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyEvmSignatureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Contract {
+		i--
+		if m.Contract {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListSessionKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListSessionKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListSessionKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListSessionKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListSessionKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListSessionKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SessionKeys) > 0 {
+		for iNdEx := len(m.SessionKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SessionKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSessionKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSessionKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSessionKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSessionKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSessionKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSessionKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.SessionKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListProofsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListProofsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for _, e := range m.Proof {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListDealsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListDealsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deals) > 0 {
+		for _, e := range m.Deals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListDealsByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListDealsByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deals) > 0 {
+		for _, e := range m.Deals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListDealsByProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListDealsByProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deals) > 0 {
+		for _, e := range m.Deals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDealRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetDealResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Deal != nil {
+		l = m.Deal.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Provider != nil {
+		l = m.Provider.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDealHeatRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		n += 1 + sovQuery(uint64(m.DealId))
+	}
+	return n
+}
+
+func (m *QueryGetDealHeatResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Heat.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetReceiptNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		n += 1 + sovQuery(uint64(m.DealId))
+	}
+	l = len(m.FilePath)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetReceiptNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastNonce != 0 {
+		n += 1 + sovQuery(uint64(m.LastNonce))
+	}
+	return n
+}
+
+func (m *QueryGetRetrievalSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRetrievalSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Session.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListRetrievalSessionsByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListRetrievalSessionsByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListRetrievalSessionsByProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListRetrievalSessionsByProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
 		for _, e := range m.Sessions {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryListRetrievalSessionsByProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetChallengeSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		n += 1 + sovQuery(uint64(m.DealId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetChallengeSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochId != 0 {
+		n += 1 + sovQuery(uint64(m.EpochId))
+	}
+	if m.EpochStartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EpochStartHeight))
+	}
+	if m.QuotaBlobs != 0 {
+		n += 1 + sovQuery(uint64(m.QuotaBlobs))
+	}
+	if m.CreditsBlobs != 0 {
+		n += 1 + sovQuery(uint64(m.CreditsBlobs))
+	}
+	if m.SyntheticNeeded != 0 {
+		n += 1 + sovQuery(uint64(m.SyntheticNeeded))
+	}
+	if m.SyntheticSatisfiedBlobs != 0 {
+		n += 1 + sovQuery(uint64(m.SyntheticSatisfiedBlobs))
+	}
+	if len(m.Challenges) > 0 {
+		for _, e := range m.Challenges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetSlotRepairChallengeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		n += 1 + sovQuery(uint64(m.DealId))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovQuery(uint64(m.Slot))
+	}
+	return n
+}
+
+func (m *QueryGetSlotRepairChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PendingProvider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RepairTargetGen != 0 {
+		n += 1 + sovQuery(uint64(m.RepairTargetGen))
+	}
+	if m.EpochId != 0 {
+		n += 1 + sovQuery(uint64(m.EpochId))
+	}
+	if m.EpochStartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EpochStartHeight))
+	}
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovQuery(uint64(m.DeadlineHeight))
+	}
+	l = m.Bounty.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Challenges) > 0 {
+		for _, e := range m.Challenges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProviderBondRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProviderBondResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Bond.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RequiredBond.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProviderStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProviderStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalStorage != 0 {
+		n += 1 + sovQuery(uint64(m.TotalStorage))
+	}
+	if m.CommittedStorage != 0 {
+		n += 1 + sovQuery(uint64(m.CommittedStorage))
+	}
+	if m.ReservedStorage != 0 {
+		n += 1 + sovQuery(uint64(m.ReservedStorage))
+	}
+	if m.FreeStorage != 0 {
+		n += 1 + sovQuery(uint64(m.FreeStorage))
+	}
+	return n
+}
+
+func (m *QueryGetDealPlacementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		n += 1 + sovQuery(uint64(m.DealId))
+	}
+	return n
+}
+
+func (m *QueryGetDealPlacementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Placement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListDealAccessGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		n += 1 + sovQuery(uint64(m.DealId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListDealAccessGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDealAccessGrantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		n += 1 + sovQuery(uint64(m.DealId))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDealAccessGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Grant.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Active {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryListEvidenceByProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListEvidenceByProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListEvidenceByDealRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		n += 1 + sovQuery(uint64(m.DealId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListEvidenceByDealResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyEvmSignatureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyEvmSignatureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	if m.Contract {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryListSessionKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListSessionKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SessionKeys) > 0 {
+		for _, e := range m.SessionKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSessionKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSessionKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SessionKey.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Active {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListProofsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListProofsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListProofsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListProofsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListProofsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListProofsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, &Proof{})
+			if err := m.Proof[len(m.Proof)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListDealsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListDealsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListDealsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListDealsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListDealsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListDealsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deals = append(m.Deals, &Deal{})
+			if err := m.Deals[len(m.Deals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListDealsByOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListDealsByOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListDealsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListDealsByOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListDealsByOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListDealsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deals = append(m.Deals, Deal{})
+			if err := m.Deals[len(m.Deals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListDealsByProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListDealsByProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListDealsByProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListDealsByProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListDealsByProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListDealsByProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deals = append(m.Deals, Deal{})
+			if err := m.Deals[len(m.Deals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDealRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDealRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDealResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDealResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deal == nil {
+				m.Deal = &Deal{}
+			}
+			if err := m.Deal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListProvidersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListProvidersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, &Provider{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Provider == nil {
+				m.Provider = &Provider{}
+			}
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDealHeatRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDealHeatRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDealHeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDealHeatResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDealHeatResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDealHeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Heat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetReceiptNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetReceiptNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetReceiptNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetReceiptNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetReceiptNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetReceiptNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastNonce", wireType)
+			}
+			m.LastNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRetrievalSessionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRetrievalSessionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRetrievalSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = append(m.SessionId[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionId == nil {
+				m.SessionId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRetrievalSessionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRetrievalSessionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRetrievalSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListRetrievalSessionsByOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListRetrievalSessionsByOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListRetrievalSessionsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListRetrievalSessionsByOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListRetrievalSessionsByOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListRetrievalSessionsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, RetrievalSession{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListRetrievalSessionsByProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListRetrievalSessionsByProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListRetrievalSessionsByProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListRetrievalSessionsByProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListRetrievalSessionsByProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListRetrievalSessionsByProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, RetrievalSession{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChallengeSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChallengeSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChallengeSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChallengeSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChallengeSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChallengeSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartHeight", wireType)
+			}
+			m.EpochStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaBlobs", wireType)
+			}
+			m.QuotaBlobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuotaBlobs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditsBlobs", wireType)
+			}
+			m.CreditsBlobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreditsBlobs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyntheticNeeded", wireType)
+			}
+			m.SyntheticNeeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SyntheticNeeded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyntheticSatisfiedBlobs", wireType)
+			}
+			m.SyntheticSatisfiedBlobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SyntheticSatisfiedBlobs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenges = append(m.Challenges, ChallengePosition{})
+			if err := m.Challenges[len(m.Challenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryListRetrievalSessionsByProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetSlotRepairChallengeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2797,12 +9488,50 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetSlotRepairChallengeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetSlotRepairChallengeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2824,7 +9553,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetSlotRepairChallengeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2834,28 +9563,169 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSlotRepairChallengeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSlotRepairChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepairTargetGen", wireType)
+			}
+			m.RepairTargetGen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepairTargetGen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartHeight", wireType)
+			}
+			m.EpochStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Bounty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2882,7 +9752,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Challenges = append(m.Challenges, ChallengePosition{})
+			if err := m.Challenges[len(m.Challenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2907,7 +9778,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListProofsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProviderBondRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2930,17 +9801,17 @@ func (m *QueryListProofsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListProofsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProviderBondRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListProofsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProviderBondRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2950,27 +9821,23 @@ func (m *QueryListProofsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2993,7 +9860,7 @@ func (m *QueryListProofsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListProofsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProviderBondResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3016,15 +9883,15 @@ func (m *QueryListProofsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListProofsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProviderBondResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListProofsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProviderBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3051,14 +9918,13 @@ func (m *QueryListProofsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proof = append(m.Proof, &Proof{})
-			if err := m.Proof[len(m.Proof)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredBond", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3085,10 +9951,41 @@ func (m *QueryListProofsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if err := m.RequiredBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, ProviderUnbonding{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3113,7 +10010,7 @@ func (m *QueryListProofsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListDealsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProviderStorageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3136,17 +10033,17 @@ func (m *QueryListDealsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListDealsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProviderStorageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListDealsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProviderStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3156,27 +10053,23 @@ func (m *QueryListDealsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3199,7 +10092,7 @@ func (m *QueryListDealsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListDealsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProviderStorageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3222,17 +10115,17 @@ func (m *QueryListDealsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListDealsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProviderStorageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListDealsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProviderStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deals", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStorage", wireType)
 			}
-			var msglen int
+			m.TotalStorage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3242,31 +10135,54 @@ func (m *QueryListDealsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TotalStorage |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommittedStorage", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.CommittedStorage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommittedStorage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedStorage", wireType)
 			}
-			m.Deals = append(m.Deals, &Deal{})
-			if err := m.Deals[len(m.Deals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ReservedStorage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReservedStorage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeStorage", wireType)
 			}
-			var msglen int
+			m.FreeStorage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3276,28 +10192,80 @@ func (m *QueryListDealsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FreeStorage |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDealPlacementRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDealPlacementRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDealPlacementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3319,7 +10287,7 @@ func (m *QueryListDealsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDealRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetDealPlacementResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3342,17 +10310,17 @@ func (m *QueryGetDealRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDealRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetDealPlacementResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetDealPlacementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Placement", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3362,11 +10330,25 @@ func (m *QueryGetDealRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Placement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3388,7 +10370,7 @@ func (m *QueryGetDealRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDealResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListDealAccessGrantsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3411,15 +10393,34 @@ func (m *QueryGetDealResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDealResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListDealAccessGrantsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListDealAccessGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3446,10 +10447,10 @@ func (m *QueryGetDealResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Deal == nil {
-				m.Deal = &Deal{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Deal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3474,7 +10475,7 @@ func (m *QueryGetDealResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListProvidersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListDealAccessGrantsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3497,13 +10498,47 @@ func (m *QueryListProvidersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListProvidersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListDealAccessGrantsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListDealAccessGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, DealAccessGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3533,7 +10568,7 @@ func (m *QueryListProvidersRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3560,7 +10595,7 @@ func (m *QueryListProvidersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListProvidersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetDealAccessGrantRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3583,17 +10618,17 @@ func (m *QueryListProvidersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListProvidersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetDealAccessGrantRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetDealAccessGrantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
 			}
-			var msglen int
+			m.DealId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3603,31 +10638,16 @@ func (m *QueryListProvidersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DealId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Providers = append(m.Providers, &Provider{})
-			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3637,27 +10657,23 @@ func (m *QueryListProvidersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3680,7 +10696,7 @@ func (m *QueryListProvidersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProviderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetDealAccessGrantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3703,17 +10719,17 @@ func (m *QueryGetProviderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProviderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetDealAccessGrantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetDealAccessGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grant", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3723,24 +10739,45 @@ func (m *QueryGetProviderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.Grant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3762,7 +10799,7 @@ func (m *QueryGetProviderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListEvidenceByProviderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3785,16 +10822,48 @@ func (m *QueryGetProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListEvidenceByProviderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListEvidenceByProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -3820,10 +10889,10 @@ func (m *QueryGetProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Provider == nil {
-				m.Provider = &Provider{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3848,7 +10917,7 @@ func (m *QueryGetProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDealHeatRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListEvidenceByProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3871,17 +10940,17 @@ func (m *QueryGetDealHeatRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDealHeatRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListEvidenceByProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDealHeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListEvidenceByProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
 			}
-			m.DealId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3891,11 +10960,62 @@ func (m *QueryGetDealHeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DealId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = append(m.Evidence, Evidence{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3917,7 +11037,7 @@ func (m *QueryGetDealHeatRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDealHeatResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListEvidenceByDealRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3940,15 +11060,34 @@ func (m *QueryGetDealHeatResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDealHeatResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListEvidenceByDealRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDealHeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListEvidenceByDealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Heat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3975,7 +11114,10 @@ func (m *QueryGetDealHeatResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Heat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4000,7 +11142,7 @@ func (m *QueryGetDealHeatResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetReceiptNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListEvidenceByDealResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4023,17 +11165,17 @@ func (m *QueryGetReceiptNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetReceiptNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListEvidenceByDealResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetReceiptNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListEvidenceByDealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
 			}
-			m.DealId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4043,16 +11185,31 @@ func (m *QueryGetReceiptNonceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DealId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = append(m.Evidence, Evidence{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FilePath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4062,23 +11219,27 @@ func (m *QueryGetReceiptNonceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FilePath = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4101,7 +11262,7 @@ func (m *QueryGetReceiptNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetReceiptNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyEvmSignatureRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4124,17 +11285,17 @@ func (m *QueryGetReceiptNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetReceiptNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyEvmSignatureRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetReceiptNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyEvmSignatureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			m.LastNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4144,64 +11305,61 @@ func (m *QueryGetReceiptNonceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetRetrievalSessionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
 			}
-			if iNdEx >= l {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Digest = append(m.Digest[:0], dAtA[iNdEx:postIndex]...)
+			if m.Digest == nil {
+				m.Digest = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRetrievalSessionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRetrievalSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4228,9 +11386,9 @@ func (m *QueryGetRetrievalSessionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionId = append(m.SessionId[:0], dAtA[iNdEx:postIndex]...)
-			if m.SessionId == nil {
-				m.SessionId = []byte{}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4254,7 +11412,7 @@ func (m *QueryGetRetrievalSessionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRetrievalSessionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyEvmSignatureResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4277,17 +11435,17 @@ func (m *QueryGetRetrievalSessionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRetrievalSessionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyEvmSignatureResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRetrievalSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyEvmSignatureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4297,25 +11455,32 @@ func (m *QueryGetRetrievalSessionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Contract = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4337,7 +11502,7 @@ func (m *QueryGetRetrievalSessionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListRetrievalSessionsByOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListSessionKeysRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4360,10 +11525,10 @@ func (m *QueryListRetrievalSessionsByOwnerRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListRetrievalSessionsByOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListSessionKeysRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListRetrievalSessionsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListSessionKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4455,7 +11620,7 @@ func (m *QueryListRetrievalSessionsByOwnerRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryListRetrievalSessionsByOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListSessionKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4478,15 +11643,15 @@ func (m *QueryListRetrievalSessionsByOwnerResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListRetrievalSessionsByOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListSessionKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListRetrievalSessionsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListSessionKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4513,8 +11678,8 @@ func (m *QueryListRetrievalSessionsByOwnerResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, RetrievalSession{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SessionKeys = append(m.SessionKeys, SessionKey{})
+			if err := m.SessionKeys[len(m.SessionKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4575,7 +11740,7 @@ func (m *QueryListRetrievalSessionsByOwnerResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryListRetrievalSessionsByProviderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetSessionKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4598,15 +11763,15 @@ func (m *QueryListRetrievalSessionsByProviderRequest) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListRetrievalSessionsByProviderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetSessionKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListRetrievalSessionsByProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetSessionKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4634,43 +11799,7 @@ func (m *QueryListRetrievalSessionsByProviderRequest) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4693,7 +11822,7 @@ func (m *QueryListRetrievalSessionsByProviderRequest) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryListRetrievalSessionsByProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetSessionKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4716,15 +11845,15 @@ func (m *QueryListRetrievalSessionsByProviderResponse) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListRetrievalSessionsByProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetSessionKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListRetrievalSessionsByProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetSessionKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4751,16 +11880,15 @@ func (m *QueryListRetrievalSessionsByProviderResponse) Unmarshal(dAtA []byte) er
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, RetrievalSession{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SessionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4770,28 +11898,12 @@ func (m *QueryListRetrievalSessionsByProviderResponse) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Active = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
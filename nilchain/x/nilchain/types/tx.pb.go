@@ -8,6 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -133,6 +134,11 @@ type MsgRegisterProvider struct {
 	Capabilities string   `protobuf:"bytes,2,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
 	TotalStorage uint64   `protobuf:"varint,3,opt,name=total_storage,json=totalStorage,proto3" json:"total_storage,omitempty"`
 	Endpoints    []string `protobuf:"bytes,4,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	// Collateral to lock. Must cover the required bond for total_storage; when
+	// unset, exactly the required bond is locked.
+	Bond types.Coin `protobuf:"bytes,5,opt,name=bond,proto3" json:"bond"`
+	// Failure-domain labels used to spread deal placements.
+	FailureDomain ProviderFailureDomain `protobuf:"bytes,6,opt,name=failure_domain,json=failureDomain,proto3" json:"failure_domain"`
 }
 
 func (m *MsgRegisterProvider) Reset()         { *m = MsgRegisterProvider{} }
@@ -196,6 +202,20 @@ func (m *MsgRegisterProvider) GetEndpoints() []string {
 	return nil
 }
 
+func (m *MsgRegisterProvider) GetBond() types.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return types.Coin{}
+}
+
+func (m *MsgRegisterProvider) GetFailureDomain() ProviderFailureDomain {
+	if m != nil {
+		return m.FailureDomain
+	}
+	return ProviderFailureDomain{}
+}
+
 // MsgRegisterProviderResponse defines the response structure for registering a provider.
 type MsgRegisterProviderResponse struct {
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -748,6 +768,154 @@ func (m *MsgCreateDealFromEvmResponse) GetDealId() uint64 {
 	return 0
 }
 
+// EvmCloseDealIntent captures fields for closing a deal via EVM.
+type EvmCloseDealIntent struct {
+	CreatorEvm string `protobuf:"bytes,1,opt,name=creator_evm,json=creatorEvm,proto3" json:"creator_evm,omitempty"`
+	DealId     uint64 `protobuf:"varint,2,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	Nonce      uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	ChainId    string `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *EvmCloseDealIntent) Reset()         { *m = EvmCloseDealIntent{} }
+func (m *EvmCloseDealIntent) String() string { return proto.CompactTextString(m) }
+func (*EvmCloseDealIntent) ProtoMessage()    {}
+func (*EvmCloseDealIntent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{12}
+}
+func (m *EvmCloseDealIntent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvmCloseDealIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvmCloseDealIntent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvmCloseDealIntent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmCloseDealIntent.Merge(m, src)
+}
+func (m *EvmCloseDealIntent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvmCloseDealIntent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmCloseDealIntent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmCloseDealIntent proto.InternalMessageInfo
+
+func (m *EvmCloseDealIntent) GetCreatorEvm() string {
+	if m != nil {
+		return m.CreatorEvm
+	}
+	return ""
+}
+
+func (m *EvmCloseDealIntent) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
+	}
+	return 0
+}
+
+func (m *EvmCloseDealIntent) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *EvmCloseDealIntent) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+// EvmTransferDealIntent captures fields for a deal ownership transfer via EVM.
+// Signed by the current owner it proposes new_owner (empty cancels); signed
+// by the pending owner with new_owner set to itself it accepts the transfer.
+type EvmTransferDealIntent struct {
+	CreatorEvm string `protobuf:"bytes,1,opt,name=creator_evm,json=creatorEvm,proto3" json:"creator_evm,omitempty"`
+	DealId     uint64 `protobuf:"varint,2,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	NewOwner   string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	Nonce      uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	ChainId    string `protobuf:"bytes,5,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *EvmTransferDealIntent) Reset()         { *m = EvmTransferDealIntent{} }
+func (m *EvmTransferDealIntent) String() string { return proto.CompactTextString(m) }
+func (*EvmTransferDealIntent) ProtoMessage()    {}
+func (*EvmTransferDealIntent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{13}
+}
+func (m *EvmTransferDealIntent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvmTransferDealIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvmTransferDealIntent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvmTransferDealIntent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmTransferDealIntent.Merge(m, src)
+}
+func (m *EvmTransferDealIntent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvmTransferDealIntent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmTransferDealIntent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmTransferDealIntent proto.InternalMessageInfo
+
+func (m *EvmTransferDealIntent) GetCreatorEvm() string {
+	if m != nil {
+		return m.CreatorEvm
+	}
+	return ""
+}
+
+func (m *EvmTransferDealIntent) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
+	}
+	return 0
+}
+
+func (m *EvmTransferDealIntent) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+func (m *EvmTransferDealIntent) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *EvmTransferDealIntent) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
 // MsgUpdateDealContentFromEvm wraps a signed EVM intent for content update.
 type MsgUpdateDealContentFromEvm struct {
 	Sender       string                  `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -759,7 +927,7 @@ func (m *MsgUpdateDealContentFromEvm) Reset()         { *m = MsgUpdateDealConten
 func (m *MsgUpdateDealContentFromEvm) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDealContentFromEvm) ProtoMessage()    {}
 func (*MsgUpdateDealContentFromEvm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{12}
+	return fileDescriptor_48ebc739066bad25, []int{14}
 }
 func (m *MsgUpdateDealContentFromEvm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,7 +985,7 @@ func (m *MsgUpdateDealContentFromEvmResponse) Reset()         { *m = MsgUpdateDe
 func (m *MsgUpdateDealContentFromEvmResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDealContentFromEvmResponse) ProtoMessage()    {}
 func (*MsgUpdateDealContentFromEvmResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{13}
+	return fileDescriptor_48ebc739066bad25, []int{15}
 }
 func (m *MsgUpdateDealContentFromEvmResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -870,7 +1038,7 @@ func (m *MsgOpenRetrievalSession) Reset()         { *m = MsgOpenRetrievalSession
 func (m *MsgOpenRetrievalSession) String() string { return proto.CompactTextString(m) }
 func (*MsgOpenRetrievalSession) ProtoMessage()    {}
 func (*MsgOpenRetrievalSession) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{14}
+	return fileDescriptor_48ebc739066bad25, []int{16}
 }
 func (m *MsgOpenRetrievalSession) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -970,7 +1138,7 @@ func (m *MsgOpenRetrievalSessionResponse) Reset()         { *m = MsgOpenRetrieva
 func (m *MsgOpenRetrievalSessionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgOpenRetrievalSessionResponse) ProtoMessage()    {}
 func (*MsgOpenRetrievalSessionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{15}
+	return fileDescriptor_48ebc739066bad25, []int{17}
 }
 func (m *MsgOpenRetrievalSessionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1016,7 +1184,7 @@ func (m *MsgConfirmRetrievalSession) Reset()         { *m = MsgConfirmRetrievalS
 func (m *MsgConfirmRetrievalSession) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmRetrievalSession) ProtoMessage()    {}
 func (*MsgConfirmRetrievalSession) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{16}
+	return fileDescriptor_48ebc739066bad25, []int{18}
 }
 func (m *MsgConfirmRetrievalSession) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1067,7 +1235,7 @@ func (m *MsgConfirmRetrievalSessionResponse) Reset()         { *m = MsgConfirmRe
 func (m *MsgConfirmRetrievalSessionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmRetrievalSessionResponse) ProtoMessage()    {}
 func (*MsgConfirmRetrievalSessionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{17}
+	return fileDescriptor_48ebc739066bad25, []int{19}
 }
 func (m *MsgConfirmRetrievalSessionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1113,7 +1281,7 @@ func (m *MsgCancelRetrievalSession) Reset()         { *m = MsgCancelRetrievalSes
 func (m *MsgCancelRetrievalSession) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelRetrievalSession) ProtoMessage()    {}
 func (*MsgCancelRetrievalSession) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{18}
+	return fileDescriptor_48ebc739066bad25, []int{20}
 }
 func (m *MsgCancelRetrievalSession) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1164,7 +1332,7 @@ func (m *MsgCancelRetrievalSessionResponse) Reset()         { *m = MsgCancelRetr
 func (m *MsgCancelRetrievalSessionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelRetrievalSessionResponse) ProtoMessage()    {}
 func (*MsgCancelRetrievalSessionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{19}
+	return fileDescriptor_48ebc739066bad25, []int{21}
 }
 func (m *MsgCancelRetrievalSessionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1211,7 +1379,7 @@ func (m *MsgSubmitRetrievalSessionProof) Reset()         { *m = MsgSubmitRetriev
 func (m *MsgSubmitRetrievalSessionProof) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitRetrievalSessionProof) ProtoMessage()    {}
 func (*MsgSubmitRetrievalSessionProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{20}
+	return fileDescriptor_48ebc739066bad25, []int{22}
 }
 func (m *MsgSubmitRetrievalSessionProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,7 +1439,7 @@ func (m *MsgSubmitRetrievalSessionProofResponse) Reset() {
 func (m *MsgSubmitRetrievalSessionProofResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitRetrievalSessionProofResponse) ProtoMessage()    {}
 func (*MsgSubmitRetrievalSessionProofResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{21}
+	return fileDescriptor_48ebc739066bad25, []int{23}
 }
 func (m *MsgSubmitRetrievalSessionProofResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1324,7 +1492,7 @@ func (m *MsgProveLiveness) Reset()         { *m = MsgProveLiveness{} }
 func (m *MsgProveLiveness) String() string { return proto.CompactTextString(m) }
 func (*MsgProveLiveness) ProtoMessage()    {}
 func (*MsgProveLiveness) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{22}
+	return fileDescriptor_48ebc739066bad25, []int{24}
 }
 func (m *MsgProveLiveness) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1454,7 +1622,7 @@ func (m *MsgProveLivenessResponse) Reset()         { *m = MsgProveLivenessRespon
 func (m *MsgProveLivenessResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgProveLivenessResponse) ProtoMessage()    {}
 func (*MsgProveLivenessResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{23}
+	return fileDescriptor_48ebc739066bad25, []int{25}
 }
 func (m *MsgProveLivenessResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1514,7 +1682,7 @@ func (m *MsgSignalSaturation) Reset()         { *m = MsgSignalSaturation{} }
 func (m *MsgSignalSaturation) String() string { return proto.CompactTextString(m) }
 func (*MsgSignalSaturation) ProtoMessage()    {}
 func (*MsgSignalSaturation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{24}
+	return fileDescriptor_48ebc739066bad25, []int{26}
 }
 func (m *MsgSignalSaturation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1568,7 +1736,7 @@ func (m *MsgSignalSaturationResponse) Reset()         { *m = MsgSignalSaturation
 func (m *MsgSignalSaturationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSignalSaturationResponse) ProtoMessage()    {}
 func (*MsgSignalSaturationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{25}
+	return fileDescriptor_48ebc739066bad25, []int{27}
 }
 func (m *MsgSignalSaturationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1630,7 +1798,7 @@ func (m *MsgStartSlotRepair) Reset()         { *m = MsgStartSlotRepair{} }
 func (m *MsgStartSlotRepair) String() string { return proto.CompactTextString(m) }
 func (*MsgStartSlotRepair) ProtoMessage()    {}
 func (*MsgStartSlotRepair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{26}
+	return fileDescriptor_48ebc739066bad25, []int{28}
 }
 func (m *MsgStartSlotRepair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1695,7 +1863,7 @@ func (m *MsgStartSlotRepairResponse) Reset()         { *m = MsgStartSlotRepairRe
 func (m *MsgStartSlotRepairResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgStartSlotRepairResponse) ProtoMessage()    {}
 func (*MsgStartSlotRepairResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{27}
+	return fileDescriptor_48ebc739066bad25, []int{29}
 }
 func (m *MsgStartSlotRepairResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1731,18 +1899,20 @@ func (m *MsgStartSlotRepairResponse) GetSuccess() bool {
 	return false
 }
 
-// MsgCompleteSlotRepair promotes the pending provider to the active slot provider.
+// MsgCompleteSlotRepair promotes the pending provider to the active slot
+// provider once it proves the repair challenge of the slot.
 type MsgCompleteSlotRepair struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	DealId  uint64 `protobuf:"varint,2,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
-	Slot    uint32 `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Creator string         `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	DealId  uint64         `protobuf:"varint,2,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	Slot    uint32         `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Proofs  []ChainedProof `protobuf:"bytes,4,rep,name=proofs,proto3" json:"proofs"`
 }
 
 func (m *MsgCompleteSlotRepair) Reset()         { *m = MsgCompleteSlotRepair{} }
 func (m *MsgCompleteSlotRepair) String() string { return proto.CompactTextString(m) }
 func (*MsgCompleteSlotRepair) ProtoMessage()    {}
 func (*MsgCompleteSlotRepair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{28}
+	return fileDescriptor_48ebc739066bad25, []int{30}
 }
 func (m *MsgCompleteSlotRepair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1792,6 +1962,13 @@ func (m *MsgCompleteSlotRepair) GetSlot() uint32 {
 	return 0
 }
 
+func (m *MsgCompleteSlotRepair) GetProofs() []ChainedProof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
 type MsgCompleteSlotRepairResponse struct {
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
@@ -1800,7 +1977,7 @@ func (m *MsgCompleteSlotRepairResponse) Reset()         { *m = MsgCompleteSlotRe
 func (m *MsgCompleteSlotRepairResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCompleteSlotRepairResponse) ProtoMessage()    {}
 func (*MsgCompleteSlotRepairResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{29}
+	return fileDescriptor_48ebc739066bad25, []int{31}
 }
 func (m *MsgCompleteSlotRepairResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1847,7 +2024,7 @@ func (m *MsgAddCredit) Reset()         { *m = MsgAddCredit{} }
 func (m *MsgAddCredit) String() string { return proto.CompactTextString(m) }
 func (*MsgAddCredit) ProtoMessage()    {}
 func (*MsgAddCredit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{30}
+	return fileDescriptor_48ebc739066bad25, []int{32}
 }
 func (m *MsgAddCredit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1899,7 +2076,7 @@ func (m *MsgAddCreditResponse) Reset()         { *m = MsgAddCreditResponse{} }
 func (m *MsgAddCreditResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddCreditResponse) ProtoMessage()    {}
 func (*MsgAddCreditResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{31}
+	return fileDescriptor_48ebc739066bad25, []int{33}
 }
 func (m *MsgAddCreditResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1937,7 +2114,7 @@ func (m *MsgWithdrawRewards) Reset()         { *m = MsgWithdrawRewards{} }
 func (m *MsgWithdrawRewards) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawRewards) ProtoMessage()    {}
 func (*MsgWithdrawRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{32}
+	return fileDescriptor_48ebc739066bad25, []int{34}
 }
 func (m *MsgWithdrawRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1976,13 +2153,17 @@ func (m *MsgWithdrawRewards) GetCreator() string {
 // MsgWithdrawRewardsResponse defines the response structure for withdrawing rewards.
 type MsgWithdrawRewardsResponse struct {
 	AmountWithdrawn cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount_withdrawn,json=amountWithdrawn,proto3,customtype=cosmossdk.io/math.Int" json:"amount_withdrawn"`
+	// storage_rewards is the minted, inflation-funded part of amount_withdrawn.
+	StorageRewards cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=storage_rewards,json=storageRewards,proto3,customtype=cosmossdk.io/math.Int" json:"storage_rewards"`
+	// bandwidth_rewards is the part paid out of deal escrow already held by the module.
+	BandwidthRewards cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=bandwidth_rewards,json=bandwidthRewards,proto3,customtype=cosmossdk.io/math.Int" json:"bandwidth_rewards"`
 }
 
 func (m *MsgWithdrawRewardsResponse) Reset()         { *m = MsgWithdrawRewardsResponse{} }
 func (m *MsgWithdrawRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawRewardsResponse) ProtoMessage()    {}
 func (*MsgWithdrawRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ebc739066bad25, []int{33}
+	return fileDescriptor_48ebc739066bad25, []int{35}
 }
 func (m *MsgWithdrawRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2011,3011 +2192,11522 @@ func (m *MsgWithdrawRewardsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawRewardsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "nilchain.nilchain.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "nilchain.nilchain.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgRegisterProvider)(nil), "nilchain.nilchain.v1.MsgRegisterProvider")
-	proto.RegisterType((*MsgRegisterProviderResponse)(nil), "nilchain.nilchain.v1.MsgRegisterProviderResponse")
-	proto.RegisterType((*MsgCreateDeal)(nil), "nilchain.nilchain.v1.MsgCreateDeal")
-	proto.RegisterType((*MsgCreateDealResponse)(nil), "nilchain.nilchain.v1.MsgCreateDealResponse")
-	proto.RegisterType((*MsgUpdateDealContent)(nil), "nilchain.nilchain.v1.MsgUpdateDealContent")
-	proto.RegisterType((*MsgUpdateDealContentResponse)(nil), "nilchain.nilchain.v1.MsgUpdateDealContentResponse")
-	proto.RegisterType((*EvmCreateDealIntent)(nil), "nilchain.nilchain.v1.EvmCreateDealIntent")
-	proto.RegisterType((*EvmUpdateContentIntent)(nil), "nilchain.nilchain.v1.EvmUpdateContentIntent")
-	proto.RegisterType((*MsgCreateDealFromEvm)(nil), "nilchain.nilchain.v1.MsgCreateDealFromEvm")
-	proto.RegisterType((*MsgCreateDealFromEvmResponse)(nil), "nilchain.nilchain.v1.MsgCreateDealFromEvmResponse")
-	proto.RegisterType((*MsgUpdateDealContentFromEvm)(nil), "nilchain.nilchain.v1.MsgUpdateDealContentFromEvm")
-	proto.RegisterType((*MsgUpdateDealContentFromEvmResponse)(nil), "nilchain.nilchain.v1.MsgUpdateDealContentFromEvmResponse")
-	proto.RegisterType((*MsgOpenRetrievalSession)(nil), "nilchain.nilchain.v1.MsgOpenRetrievalSession")
-	proto.RegisterType((*MsgOpenRetrievalSessionResponse)(nil), "nilchain.nilchain.v1.MsgOpenRetrievalSessionResponse")
-	proto.RegisterType((*MsgConfirmRetrievalSession)(nil), "nilchain.nilchain.v1.MsgConfirmRetrievalSession")
-	proto.RegisterType((*MsgConfirmRetrievalSessionResponse)(nil), "nilchain.nilchain.v1.MsgConfirmRetrievalSessionResponse")
-	proto.RegisterType((*MsgCancelRetrievalSession)(nil), "nilchain.nilchain.v1.MsgCancelRetrievalSession")
-	proto.RegisterType((*MsgCancelRetrievalSessionResponse)(nil), "nilchain.nilchain.v1.MsgCancelRetrievalSessionResponse")
-	proto.RegisterType((*MsgSubmitRetrievalSessionProof)(nil), "nilchain.nilchain.v1.MsgSubmitRetrievalSessionProof")
-	proto.RegisterType((*MsgSubmitRetrievalSessionProofResponse)(nil), "nilchain.nilchain.v1.MsgSubmitRetrievalSessionProofResponse")
-	proto.RegisterType((*MsgProveLiveness)(nil), "nilchain.nilchain.v1.MsgProveLiveness")
-	proto.RegisterType((*MsgProveLivenessResponse)(nil), "nilchain.nilchain.v1.MsgProveLivenessResponse")
-	proto.RegisterType((*MsgSignalSaturation)(nil), "nilchain.nilchain.v1.MsgSignalSaturation")
-	proto.RegisterType((*MsgSignalSaturationResponse)(nil), "nilchain.nilchain.v1.MsgSignalSaturationResponse")
-	proto.RegisterType((*MsgStartSlotRepair)(nil), "nilchain.nilchain.v1.MsgStartSlotRepair")
-	proto.RegisterType((*MsgStartSlotRepairResponse)(nil), "nilchain.nilchain.v1.MsgStartSlotRepairResponse")
-	proto.RegisterType((*MsgCompleteSlotRepair)(nil), "nilchain.nilchain.v1.MsgCompleteSlotRepair")
-	proto.RegisterType((*MsgCompleteSlotRepairResponse)(nil), "nilchain.nilchain.v1.MsgCompleteSlotRepairResponse")
-	proto.RegisterType((*MsgAddCredit)(nil), "nilchain.nilchain.v1.MsgAddCredit")
-	proto.RegisterType((*MsgAddCreditResponse)(nil), "nilchain.nilchain.v1.MsgAddCreditResponse")
-	proto.RegisterType((*MsgWithdrawRewards)(nil), "nilchain.nilchain.v1.MsgWithdrawRewards")
-	proto.RegisterType((*MsgWithdrawRewardsResponse)(nil), "nilchain.nilchain.v1.MsgWithdrawRewardsResponse")
+// MsgTopUpProviderBond adds collateral to the creator's provider bond.
+type MsgTopUpProviderBond struct {
+	Creator string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Amount  types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
 }
 
-func init() { proto.RegisterFile("nilchain/nilchain/v1/tx.proto", fileDescriptor_48ebc739066bad25) }
+func (m *MsgTopUpProviderBond) Reset()         { *m = MsgTopUpProviderBond{} }
+func (m *MsgTopUpProviderBond) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUpProviderBond) ProtoMessage()    {}
+func (*MsgTopUpProviderBond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{36}
+}
+func (m *MsgTopUpProviderBond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTopUpProviderBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUpProviderBond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTopUpProviderBond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUpProviderBond.Merge(m, src)
+}
+func (m *MsgTopUpProviderBond) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTopUpProviderBond) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUpProviderBond.DiscardUnknown(m)
+}
 
-var fileDescriptor_48ebc739066bad25 = []byte{
-	// 2014 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6f, 0xdb, 0xc8,
-	0x15, 0x37, 0x6d, 0x45, 0xb2, 0x9e, 0xa4, 0xd8, 0x61, 0x9c, 0x8d, 0xac, 0x8d, 0x1d, 0x87, 0x69,
-	0xbd, 0x8e, 0xd3, 0xd8, 0xb1, 0xb2, 0xf9, 0x12, 0x76, 0x93, 0xb5, 0x9c, 0x74, 0xed, 0xb6, 0x42,
-	0x53, 0x1a, 0x6d, 0x81, 0x2e, 0x50, 0x82, 0x12, 0xc7, 0xf4, 0x60, 0x45, 0x8e, 0xc0, 0x19, 0xc9,
-	0x76, 0xd1, 0x43, 0xbb, 0x40, 0x8b, 0xa2, 0xa7, 0xed, 0xbd, 0xd7, 0x02, 0x3d, 0x15, 0x3e, 0xe4,
-	0x2f, 0x28, 0x5a, 0x60, 0xd1, 0xd3, 0xa2, 0xa7, 0xa2, 0x87, 0x45, 0x9b, 0x00, 0x0d, 0xd0, 0x6b,
-	0x6f, 0xed, 0xa5, 0x98, 0x19, 0x92, 0x92, 0x68, 0x52, 0xa2, 0x0d, 0xef, 0x5e, 0x04, 0xce, 0xe3,
-	0x7b, 0x33, 0xbf, 0xf7, 0x7b, 0x1f, 0x33, 0x43, 0xc1, 0x82, 0x8b, 0xdb, 0xad, 0x7d, 0x13, 0xbb,
-	0xeb, 0xe1, 0x43, 0x6f, 0x63, 0x9d, 0x1d, 0xae, 0x75, 0x3c, 0xc2, 0x88, 0x3a, 0x17, 0x48, 0xd7,
-	0xc2, 0x87, 0xde, 0x46, 0xe5, 0x92, 0xe9, 0x60, 0x97, 0xac, 0x8b, 0x5f, 0xa9, 0x58, 0xb9, 0xda,
-	0x22, 0xd4, 0x21, 0x74, 0xdd, 0xa1, 0x36, 0x9f, 0xc0, 0xa1, 0xb6, 0xff, 0x62, 0x5e, 0xbe, 0x30,
-	0xc4, 0x68, 0x5d, 0x0e, 0xfc, 0x57, 0x73, 0x36, 0xb1, 0x89, 0x94, 0xf3, 0x27, 0x5f, 0x7a, 0x23,
-	0x16, 0x51, 0xc7, 0xf4, 0x4c, 0x27, 0x30, 0x5c, 0x8a, 0x07, 0x7d, 0xd4, 0x41, 0xbe, 0x86, 0xf6,
-	0x47, 0x05, 0x66, 0x1a, 0xd4, 0xfe, 0x7e, 0xc7, 0x32, 0x19, 0x7a, 0x21, 0x6c, 0xd5, 0x07, 0x90,
-	0x37, 0xbb, 0x6c, 0x9f, 0x78, 0x98, 0x1d, 0x95, 0x95, 0x25, 0x65, 0x25, 0x5f, 0x2f, 0xff, 0xf5,
-	0xe5, 0x9d, 0x39, 0x1f, 0xd3, 0xa6, 0x65, 0x79, 0x88, 0xd2, 0x5d, 0xe6, 0x61, 0xd7, 0xd6, 0xfb,
-	0xaa, 0xea, 0x53, 0xc8, 0xca, 0xd5, 0xcb, 0x93, 0x4b, 0xca, 0x4a, 0xa1, 0x7a, 0x6d, 0x2d, 0x8e,
-	0x94, 0x35, 0xb9, 0x4a, 0x3d, 0xff, 0xd9, 0x17, 0xd7, 0x27, 0x7e, 0xff, 0xe6, 0x78, 0x55, 0xd1,
-	0x7d, 0xb3, 0xda, 0x83, 0x4f, 0xde, 0x1c, 0xaf, 0xf6, 0x27, 0xfc, 0xf5, 0x9b, 0xe3, 0xd5, 0x9b,
-	0x21, 0xf0, 0xc3, 0xbe, 0x0f, 0x11, 0xc0, 0xda, 0x3c, 0x5c, 0x8d, 0x88, 0x74, 0x44, 0x3b, 0xc4,
-	0xa5, 0x48, 0xfb, 0xb7, 0x02, 0x97, 0x1b, 0xd4, 0xd6, 0x91, 0x8d, 0x29, 0x43, 0xde, 0x0b, 0x8f,
-	0xf4, 0xb0, 0x85, 0x3c, 0xb5, 0x0a, 0xb9, 0x96, 0x87, 0x4c, 0x46, 0xbc, 0xb1, 0x1e, 0x06, 0x8a,
-	0xaa, 0x06, 0xc5, 0x96, 0xd9, 0x31, 0x9b, 0xb8, 0x8d, 0x19, 0x46, 0xd2, 0xcb, 0xbc, 0x3e, 0x24,
-	0x53, 0x6f, 0x42, 0x89, 0x11, 0x66, 0xb6, 0x0d, 0xca, 0x88, 0x67, 0xda, 0xa8, 0x3c, 0xb5, 0xa4,
-	0xac, 0x64, 0xf4, 0xa2, 0x10, 0xee, 0x4a, 0x99, 0x7a, 0x0d, 0xf2, 0xc8, 0xb5, 0x3a, 0x04, 0xbb,
-	0x8c, 0x96, 0x33, 0x4b, 0x53, 0x2b, 0x79, 0xbd, 0x2f, 0xa8, 0x3d, 0xe2, 0x2c, 0x04, 0x8b, 0x72,
-	0x0e, 0xde, 0x49, 0xe0, 0x20, 0xea, 0x94, 0xf6, 0x10, 0xde, 0x8e, 0x11, 0x07, 0x5c, 0xa8, 0x65,
-	0xc8, 0xd1, 0x6e, 0xab, 0x85, 0x28, 0x15, 0x3e, 0x4f, 0xeb, 0xc1, 0x50, 0xfb, 0xe7, 0x24, 0x94,
-	0x1a, 0xd4, 0xde, 0xe2, 0x6b, 0xa2, 0x67, 0xc8, 0x6c, 0x9f, 0x89, 0x9f, 0x77, 0x60, 0xc6, 0xea,
-	0x7a, 0x26, 0xc3, 0xc4, 0x35, 0x9a, 0x6d, 0xd2, 0xfa, 0x98, 0x3b, 0xc7, 0xbd, 0xbf, 0x18, 0x88,
-	0xeb, 0x42, 0xaa, 0xde, 0x80, 0x22, 0x45, 0x5e, 0x0f, 0xb7, 0x90, 0xb1, 0x8f, 0x5d, 0x56, 0xbe,
-	0x20, 0x88, 0x2c, 0xf8, 0xb2, 0x6d, 0xec, 0x32, 0x75, 0x07, 0x2e, 0x39, 0xe6, 0xa1, 0xe1, 0x10,
-	0x97, 0xed, 0xb7, 0x8f, 0x0c, 0xda, 0x41, 0xae, 0x55, 0xce, 0x0a, 0x24, 0x0b, 0x3c, 0x71, 0xfe,
-	0xfe, 0xc5, 0xf5, 0x2b, 0x12, 0x0d, 0xb5, 0x3e, 0x5e, 0xc3, 0x64, 0xdd, 0x31, 0xd9, 0xfe, 0xda,
-	0x8e, 0xcb, 0xf4, 0x19, 0xc7, 0x3c, 0x6c, 0x48, 0xb3, 0x5d, 0x6e, 0xa5, 0x7e, 0x0f, 0xae, 0x60,
-	0x17, 0x33, 0x6c, 0xb6, 0x0d, 0x44, 0x5b, 0x1e, 0x39, 0x30, 0x4c, 0x87, 0x74, 0x5d, 0x56, 0xce,
-	0xa5, 0x99, 0xee, 0xb2, 0x6f, 0xfb, 0x5c, 0x98, 0x6e, 0x0a, 0xcb, 0x5a, 0x35, 0x1a, 0xa2, 0x1b,
-	0x09, 0x21, 0xea, 0x33, 0xaa, 0x1d, 0xc1, 0x95, 0x21, 0x41, 0x18, 0x96, 0xab, 0x90, 0xb3, 0x90,
-	0xd9, 0x36, 0xb0, 0x25, 0xa8, 0xce, 0xe8, 0x59, 0x3e, 0xdc, 0xb1, 0xd4, 0x0f, 0x41, 0x35, 0x29,
-	0xc5, 0xb6, 0x8b, 0x2c, 0xde, 0x15, 0x44, 0x30, 0x79, 0xd6, 0x4d, 0x8d, 0x0c, 0xc7, 0xa5, 0xc0,
-	0x26, 0x88, 0x3f, 0xd5, 0xfe, 0xa4, 0xc0, 0x5c, 0x58, 0x20, 0x7c, 0xed, 0x2d, 0xe2, 0x32, 0xe4,
-	0xb2, 0x33, 0x45, 0x79, 0x00, 0xee, 0xe4, 0x10, 0xdc, 0x59, 0x98, 0x6a, 0x61, 0x4b, 0x24, 0x7c,
-	0x5e, 0xe7, 0x8f, 0xaa, 0x0a, 0x19, 0x8a, 0x7f, 0x82, 0xfc, 0x2c, 0x10, 0xcf, 0xb5, 0xc7, 0x51,
-	0xea, 0x56, 0x46, 0x56, 0xf8, 0x00, 0x5a, 0xed, 0x11, 0x5c, 0x8b, 0x93, 0xa7, 0xc8, 0xef, 0xbf,
-	0x4c, 0xc2, 0xe5, 0xe7, 0x3d, 0xa7, 0x4f, 0xfe, 0x8e, 0xf4, 0xff, 0x3a, 0x14, 0x7c, 0x24, 0x06,
-	0xea, 0x39, 0x92, 0x03, 0x1d, 0x7c, 0xd1, 0xf3, 0x9e, 0x73, 0xae, 0x29, 0xfd, 0x0c, 0x2e, 0x0e,
-	0xe7, 0x61, 0xba, 0x7c, 0x2e, 0x0d, 0x25, 0x60, 0x7c, 0x61, 0xe4, 0xce, 0x54, 0x18, 0x73, 0x70,
-	0xc1, 0x25, 0x6e, 0x0b, 0x95, 0xa7, 0x85, 0x4b, 0x72, 0xa0, 0xce, 0xc3, 0xb4, 0x88, 0x01, 0x0f,
-	0x70, 0x5e, 0x78, 0x91, 0x13, 0xe3, 0x1d, 0xeb, 0x5b, 0x99, 0x69, 0x98, 0x2d, 0x68, 0x2f, 0x15,
-	0x78, 0xeb, 0x79, 0xcf, 0x91, 0x71, 0xf0, 0x63, 0x90, 0x96, 0xcf, 0x53, 0x24, 0xcf, 0x02, 0x00,
-	0x4f, 0x18, 0xa3, 0x79, 0xc4, 0x50, 0xc0, 0x7a, 0x9e, 0x4b, 0xea, 0x5c, 0xd0, 0x07, 0x7f, 0x21,
-	0x09, 0x7c, 0x76, 0x08, 0x3c, 0xdf, 0x09, 0xe6, 0x86, 0x0a, 0xf0, 0x9b, 0x1e, 0x71, 0x38, 0xa6,
-	0xbb, 0x90, 0xa5, 0xc8, 0xb5, 0xd0, 0xf8, 0x1a, 0xf0, 0xf5, 0xd4, 0x4d, 0xc8, 0x62, 0xe1, 0xb0,
-	0xbf, 0xd1, 0xdd, 0x8a, 0xdf, 0xe8, 0x62, 0x32, 0x4e, 0xf7, 0x0d, 0xf9, 0x3e, 0x81, 0x7a, 0x8e,
-	0xc1, 0x2b, 0xd5, 0x64, 0x5d, 0x4f, 0xee, 0x13, 0x45, 0xbd, 0x88, 0x7a, 0xce, 0x6e, 0x20, 0x93,
-	0x3b, 0x81, 0xbf, 0xe8, 0xa8, 0x52, 0x39, 0xe1, 0x93, 0xf6, 0x50, 0x94, 0xca, 0x09, 0xf9, 0xd8,
-	0x9e, 0xa3, 0xfd, 0x4f, 0x11, 0x7b, 0xc8, 0x89, 0x22, 0x3b, 0x3b, 0x59, 0xcf, 0x22, 0x64, 0x7d,
-	0x23, 0x91, 0xac, 0x98, 0x8c, 0x3a, 0x1d, 0x5f, 0x4f, 0x23, 0x7c, 0xad, 0xa7, 0x6d, 0x2d, 0x01,
-	0x6d, 0x4f, 0xe1, 0xe6, 0x88, 0xd7, 0x29, 0x1a, 0xcd, 0xef, 0xa6, 0xc4, 0x51, 0xe4, 0xbb, 0x1d,
-	0xe4, 0xea, 0x88, 0x79, 0x18, 0xf5, 0xcc, 0xf6, 0x2e, 0xa2, 0x14, 0x13, 0xf7, 0x7c, 0x9b, 0xed,
-	0xbb, 0x30, 0x1d, 0x6c, 0x09, 0xb2, 0x68, 0x46, 0xcc, 0x16, 0x6a, 0x72, 0x16, 0x1d, 0xd3, 0xc5,
-	0x7b, 0x88, 0x32, 0xc3, 0x23, 0x84, 0x89, 0xb2, 0x2a, 0xea, 0xc5, 0x40, 0xa8, 0x13, 0xc2, 0xd4,
-	0x65, 0x98, 0xa1, 0xcc, 0xf4, 0x98, 0xe1, 0x58, 0x5d, 0x03, 0xbb, 0x16, 0x3a, 0xf4, 0x6b, 0xac,
-	0x24, 0xc4, 0x0d, 0xab, 0xbb, 0xc3, 0x85, 0xea, 0x0a, 0xcc, 0x4a, 0xbd, 0x66, 0x9b, 0x34, 0x7d,
-	0x45, 0x5e, 0x73, 0x25, 0xfd, 0xa2, 0x90, 0xd7, 0xdb, 0xa4, 0x29, 0x35, 0x17, 0x00, 0x84, 0x4e,
-	0x2b, 0xdc, 0x76, 0x33, 0x7a, 0x9e, 0x4b, 0xb6, 0xb8, 0x20, 0xa1, 0x0f, 0x2d, 0x00, 0xa0, 0xc3,
-	0x0e, 0xf6, 0x10, 0x35, 0x4c, 0x26, 0x3a, 0x51, 0x46, 0xcf, 0xfb, 0x92, 0x4d, 0x56, 0x7b, 0x2f,
-	0xba, 0x8f, 0xdc, 0x4e, 0x08, 0x76, 0x5c, 0x2c, 0xb4, 0x0f, 0xe0, 0x7a, 0xc2, 0xab, 0x30, 0xc8,
-	0xbc, 0xff, 0x48, 0x51, 0x50, 0x25, 0x45, 0x3d, 0xef, 0x4b, 0x76, 0x2c, 0xed, 0x58, 0x81, 0x0a,
-	0x2f, 0x31, 0xe2, 0xee, 0x61, 0xcf, 0x39, 0x97, 0x60, 0x0f, 0xaf, 0x38, 0x19, 0x59, 0x51, 0x66,
-	0xf7, 0xa0, 0xc7, 0x6b, 0x49, 0xed, 0x20, 0x1e, 0x93, 0xf6, 0x04, 0xb4, 0xe4, 0xb7, 0x29, 0x92,
-	0xfb, 0x0f, 0x0a, 0xcc, 0xf3, 0x09, 0x4c, 0xb7, 0x85, 0xda, 0x5f, 0x85, 0xc7, 0x4f, 0xa2, 0x1e,
-	0xdf, 0x49, 0xf2, 0x38, 0x16, 0x92, 0xf6, 0x3e, 0xdc, 0x48, 0x7c, 0x99, 0xc2, 0xdf, 0xff, 0x2a,
-	0xb0, 0xd8, 0xa0, 0xf6, 0x6e, 0xb7, 0xe9, 0x60, 0x16, 0xb5, 0x7f, 0xe1, 0x11, 0xb2, 0xf7, 0x25,
-	0x38, 0xad, 0x7e, 0x00, 0xd9, 0x0e, 0x9f, 0x9b, 0x96, 0xa7, 0x96, 0xa6, 0x56, 0x0a, 0x55, 0x2d,
-	0xbe, 0x5f, 0x6e, 0xf1, 0x07, 0x71, 0xc8, 0x23, 0x7b, 0xf5, 0x0c, 0xdf, 0xf9, 0x75, 0xdf, 0xae,
-	0xb6, 0x15, 0xa5, 0xad, 0x9a, 0x40, 0xdb, 0x08, 0xcf, 0xb4, 0x3a, 0x2c, 0x8f, 0xd6, 0x48, 0x41,
-	0xe0, 0x2f, 0x33, 0x30, 0xdb, 0xa0, 0x36, 0x3f, 0x88, 0xa2, 0xef, 0xe0, 0x1e, 0x72, 0x11, 0xa5,
-	0xe7, 0xdb, 0x06, 0xe7, 0x61, 0x1a, 0x75, 0x48, 0x6b, 0xdf, 0xf0, 0xcf, 0x0e, 0x19, 0x3d, 0x27,
-	0xc6, 0x3b, 0x96, 0xfa, 0x6d, 0x28, 0x76, 0x29, 0xf2, 0x0c, 0x0f, 0xb5, 0x10, 0xee, 0xc8, 0x56,
-	0x57, 0xa8, 0x2e, 0xc7, 0xb3, 0x19, 0x7a, 0xa8, 0x4b, 0xed, 0xed, 0x09, 0xbd, 0xc0, 0xad, 0xfd,
-	0xa1, 0xfa, 0x21, 0x14, 0xe9, 0x11, 0x65, 0xc8, 0x31, 0x04, 0xc7, 0xa2, 0x21, 0xa6, 0x0a, 0x0d,
-	0x9f, 0x48, 0x5a, 0xca, 0x84, 0xf9, 0x08, 0xd4, 0x41, 0x54, 0x46, 0xd3, 0x64, 0xad, 0x7d, 0xd1,
-	0x36, 0x0b, 0xd5, 0xdb, 0xe9, 0xb0, 0xd5, 0xb9, 0xc9, 0xf6, 0x84, 0x3e, 0x3b, 0x00, 0x50, 0xc8,
-	0x54, 0x1d, 0x4a, 0x41, 0x66, 0x49, 0x98, 0xb9, 0x54, 0xf3, 0x0e, 0x46, 0x75, 0x7b, 0x42, 0x2f,
-	0xd2, 0x81, 0x71, 0xed, 0x7e, 0x34, 0x99, 0xbe, 0x96, 0x90, 0x4c, 0x43, 0x51, 0xae, 0x17, 0x01,
-	0x04, 0x04, 0x83, 0x1d, 0x75, 0x90, 0xe6, 0x40, 0x39, 0xaa, 0x31, 0x3e, 0x7d, 0xf8, 0xf5, 0x81,
-	0x61, 0xe4, 0x89, 0x90, 0x97, 0x74, 0xf1, 0xcc, 0x77, 0x30, 0x0f, 0x1d, 0x98, 0x9e, 0x15, 0x5c,
-	0xe2, 0xe4, 0x89, 0xb1, 0x28, 0x85, 0xf2, 0x7a, 0xa6, 0xfd, 0x56, 0x5e, 0xfa, 0xc5, 0xc1, 0xa0,
-	0xbd, 0xcb, 0xcf, 0x06, 0xe2, 0xa8, 0x7e, 0xae, 0xa9, 0x97, 0xfe, 0x9a, 0x1e, 0x85, 0xa1, 0x7d,
-	0x2a, 0xcf, 0x58, 0x51, 0x79, 0x0a, 0x46, 0xca, 0x90, 0x73, 0x10, 0xa5, 0xa6, 0x8d, 0xfc, 0x8f,
-	0x0f, 0xc1, 0x50, 0x7d, 0x1f, 0x4a, 0x2e, 0x3a, 0x18, 0xb8, 0x26, 0x4e, 0x8d, 0xb9, 0x26, 0x16,
-	0x5d, 0x74, 0xd0, 0xbf, 0x21, 0xfe, 0x47, 0x01, 0x95, 0x43, 0xe2, 0xfb, 0xf6, 0x6e, 0x9b, 0x30,
-	0x1d, 0x75, 0x4c, 0xec, 0x9d, 0x6f, 0xad, 0xf2, 0xdb, 0x60, 0x9b, 0xc8, 0x88, 0x95, 0x74, 0xf1,
-	0xac, 0x6e, 0xc1, 0x2c, 0xbf, 0x8a, 0x60, 0xd7, 0x0e, 0xa1, 0x8b, 0x42, 0x1d, 0xb5, 0xd2, 0x8c,
-	0x6f, 0x11, 0xa0, 0xaf, 0x3d, 0x8c, 0x46, 0x62, 0x39, 0x29, 0x12, 0xc3, 0xee, 0x69, 0x0f, 0xc4,
-	0x16, 0x1e, 0x91, 0xa6, 0xe8, 0x6b, 0x2f, 0x15, 0x79, 0x97, 0x27, 0x4e, 0xa7, 0x8d, 0x18, 0xfa,
-	0x0a, 0x09, 0xab, 0xd5, 0xa2, 0xbe, 0xde, 0x4a, 0x3c, 0x04, 0x44, 0xc1, 0x69, 0x8f, 0x61, 0x21,
-	0xf6, 0x45, 0x0a, 0x8f, 0xff, 0xac, 0x40, 0xb1, 0x41, 0xed, 0x4d, 0xcb, 0xda, 0xf2, 0x90, 0x85,
-	0xcf, 0xf9, 0xcb, 0xc1, 0x7d, 0xc8, 0x0e, 0x56, 0xf3, 0xb8, 0x8b, 0xac, 0xaf, 0x5c, 0xdb, 0x88,
-	0x72, 0xb1, 0x94, 0xc0, 0x45, 0x08, 0x5b, 0xfb, 0x81, 0xb8, 0x03, 0x86, 0xe3, 0xd0, 0xf3, 0x27,
-	0x50, 0xe0, 0xe5, 0xd3, 0x34, 0xdb, 0xfc, 0xb0, 0xe0, 0xbb, 0x34, 0x06, 0x06, 0xb8, 0xe8, 0xa0,
-	0x2e, 0x0d, 0xb4, 0x9f, 0xcb, 0xfa, 0xf9, 0x21, 0x66, 0xfb, 0x96, 0x67, 0x1e, 0xe8, 0xa2, 0x1b,
-	0x9d, 0x69, 0xaf, 0x4b, 0x9f, 0xcd, 0x91, 0xc5, 0xb4, 0x3d, 0x91, 0xcd, 0x11, 0x69, 0xe8, 0xe1,
-	0x36, 0xcc, 0x4a, 0xda, 0x8c, 0x03, 0x5f, 0xc3, 0x4d, 0xe7, 0xe6, 0x8c, 0x34, 0x0b, 0xe6, 0x75,
-	0xab, 0xff, 0xba, 0x08, 0x53, 0x0d, 0x6a, 0xab, 0x16, 0x14, 0x87, 0x3e, 0x1b, 0x7f, 0x3d, 0x7e,
-	0x9b, 0x89, 0x7c, 0x99, 0xad, 0xdc, 0x49, 0xa5, 0x16, 0xe2, 0xee, 0xc0, 0xec, 0x89, 0x8f, 0xb7,
-	0xb7, 0x12, 0xa7, 0x88, 0xaa, 0x56, 0x36, 0x52, 0xab, 0x86, 0x2b, 0xfe, 0x18, 0x60, 0xe0, 0x43,
-	0xe8, 0xcd, 0xc4, 0x09, 0xfa, 0x4a, 0x95, 0xdb, 0x29, 0x94, 0xc2, 0xf9, 0x29, 0x5c, 0x3a, 0xf9,
-	0x25, 0x6e, 0x75, 0x0c, 0x2b, 0x03, 0xba, 0x95, 0x6a, 0x7a, 0xdd, 0xc1, 0x45, 0x4f, 0x7e, 0xf9,
-	0x58, 0x4d, 0x01, 0xdb, 0xd7, 0x1d, 0xb1, 0x68, 0xf2, 0x57, 0x86, 0x5f, 0x29, 0x50, 0x4e, 0xfc,
-	0x92, 0xb0, 0x91, 0xde, 0x8b, 0x00, 0xc3, 0xe3, 0x53, 0x9b, 0x84, 0x50, 0x7e, 0x0a, 0x73, 0xb1,
-	0x97, 0xf2, 0xe4, 0x6c, 0x8c, 0x53, 0xaf, 0xdc, 0x3f, 0x95, 0x7a, 0xb8, 0xfa, 0x2f, 0x14, 0xb8,
-	0x9a, 0x74, 0x53, 0xbc, 0x9b, 0x4c, 0x6c, 0xbc, 0x45, 0xe5, 0xd1, 0x69, 0x2d, 0x42, 0x1c, 0x9f,
-	0x28, 0xf0, 0x56, 0xc2, 0xf5, 0x6d, 0x3d, 0x79, 0xd2, 0x58, 0x83, 0xca, 0xc3, 0x53, 0x1a, 0x84,
-	0x20, 0x7e, 0xa3, 0xc0, 0xdb, 0xa3, 0xee, 0x54, 0xef, 0x26, 0x4e, 0x3c, 0xc2, 0xaa, 0xf2, 0xde,
-	0x59, 0xac, 0x42, 0x4c, 0x36, 0x94, 0x86, 0x6f, 0x29, 0xcb, 0x89, 0xd3, 0x0d, 0xe9, 0x55, 0xd6,
-	0xd2, 0xe9, 0x0d, 0xb6, 0xb3, 0x13, 0xc7, 0xd2, 0xe4, 0x76, 0x16, 0x55, 0x1d, 0xd1, 0xce, 0x12,
-	0x4f, 0x93, 0x0e, 0xcc, 0x44, 0x8f, 0x75, 0x2b, 0xc9, 0xb3, 0x0c, 0x6b, 0x56, 0xee, 0xa6, 0xd5,
-	0x0c, 0x97, 0xeb, 0x81, 0x1a, 0x73, 0x2e, 0x1a, 0xd1, 0x20, 0x4f, 0x28, 0x57, 0xee, 0x9d, 0x42,
-	0x39, 0x5c, 0xf7, 0x23, 0xc8, 0xf7, 0x4f, 0x27, 0x5a, 0xe2, 0x0c, 0xa1, 0x4e, 0x65, 0x75, 0xbc,
-	0xce, 0x20, 0x87, 0xd1, 0xad, 0x3d, 0x99, 0xc3, 0x88, 0xe6, 0x08, 0x0e, 0x13, 0xf6, 0xea, 0xca,
-	0x85, 0x9f, 0xbd, 0x39, 0x5e, 0x55, 0xea, 0xf7, 0x3e, 0x7b, 0xb5, 0xa8, 0x7c, 0xfe, 0x6a, 0x51,
-	0xf9, 0xc7, 0xab, 0x45, 0xe5, 0xd3, 0xd7, 0x8b, 0x13, 0x9f, 0xbf, 0x5e, 0x9c, 0xf8, 0xdb, 0xeb,
-	0xc5, 0x89, 0x1f, 0xcd, 0xc7, 0x1d, 0x09, 0xc4, 0xdf, 0xba, 0xcd, 0xac, 0xf8, 0x5f, 0xf7, 0xde,
-	0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x18, 0xde, 0x6e, 0x1c, 0xb0, 0x1e, 0x00, 0x00,
+var xxx_messageInfo_MsgTopUpProviderBond proto.InternalMessageInfo
+
+func (m *MsgTopUpProviderBond) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgTopUpProviderBond) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// MsgTopUpProviderBondResponse returns the bond after the top-up.
+type MsgTopUpProviderBondResponse struct {
+	Bond   types.Coin `protobuf:"bytes,1,opt,name=bond,proto3" json:"bond"`
+	Status string     `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// MsgRegisterProvider allows a Storage Provider to register themselves with the network.
-	RegisterProvider(ctx context.Context, in *MsgRegisterProvider, opts ...grpc.CallOption) (*MsgRegisterProviderResponse, error)
-	// MsgCreateDeal allows a user to create a new storage deal.
-	CreateDeal(ctx context.Context, in *MsgCreateDeal, opts ...grpc.CallOption) (*MsgCreateDealResponse, error)
-	// MsgUpdateDealContent allows a user to commit or update the content (manifest) of a deal.
-	UpdateDealContent(ctx context.Context, in *MsgUpdateDealContent, opts ...grpc.CallOption) (*MsgUpdateDealContentResponse, error)
-	// MsgCreateDealFromEvm allows a user to create a new storage deal
-	// using an EVM-signed intent bridged into nilchaind.
-	CreateDealFromEvm(ctx context.Context, in *MsgCreateDealFromEvm, opts ...grpc.CallOption) (*MsgCreateDealFromEvmResponse, error)
-	// MsgUpdateDealContentFromEvm allows a user to update deal content
-	// using an EVM-signed intent.
-	UpdateDealContentFromEvm(ctx context.Context, in *MsgUpdateDealContentFromEvm, opts ...grpc.CallOption) (*MsgUpdateDealContentFromEvmResponse, error)
-	// MsgOpenRetrievalSession opens an on-chain retrieval session for a contiguous blob-range.
-	OpenRetrievalSession(ctx context.Context, in *MsgOpenRetrievalSession, opts ...grpc.CallOption) (*MsgOpenRetrievalSessionResponse, error)
-	// MsgConfirmRetrievalSession confirms completion of a retrieval session (proof-of-validation).
-	ConfirmRetrievalSession(ctx context.Context, in *MsgConfirmRetrievalSession, opts ...grpc.CallOption) (*MsgConfirmRetrievalSessionResponse, error)
-	// MsgCancelRetrievalSession cancels an expired retrieval session and unlocks fees.
-	CancelRetrievalSession(ctx context.Context, in *MsgCancelRetrievalSession, opts ...grpc.CallOption) (*MsgCancelRetrievalSessionResponse, error)
-	// MsgSubmitRetrievalSessionProof submits proof-of-retrieval for a session (provider action).
-	SubmitRetrievalSessionProof(ctx context.Context, in *MsgSubmitRetrievalSessionProof, opts ...grpc.CallOption) (*MsgSubmitRetrievalSessionProofResponse, error)
-	// MsgProveLiveness allows a Storage Provider to submit a proof of data liveness.
-	ProveLiveness(ctx context.Context, in *MsgProveLiveness, opts ...grpc.CallOption) (*MsgProveLivenessResponse, error)
-	// MsgSignalSaturation allows a Storage Provider to signal high load for a deal.
-	SignalSaturation(ctx context.Context, in *MsgSignalSaturation, opts ...grpc.CallOption) (*MsgSignalSaturationResponse, error)
-	// MsgStartSlotRepair marks a Mode 2 slot as repairing and sets a replacement candidate.
-	StartSlotRepair(ctx context.Context, in *MsgStartSlotRepair, opts ...grpc.CallOption) (*MsgStartSlotRepairResponse, error)
-	// MsgCompleteSlotRepair promotes the pending replacement candidate to active.
-	CompleteSlotRepair(ctx context.Context, in *MsgCompleteSlotRepair, opts ...grpc.CallOption) (*MsgCompleteSlotRepairResponse, error)
-	// MsgAddCredit allows a user to top up the escrow balance for a deal.
-	AddCredit(ctx context.Context, in *MsgAddCredit, opts ...grpc.CallOption) (*MsgAddCreditResponse, error)
-	// MsgWithdrawRewards allows a Storage Provider to withdraw accumulated rewards.
-	WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards, opts ...grpc.CallOption) (*MsgWithdrawRewardsResponse, error)
+func (m *MsgTopUpProviderBondResponse) Reset()         { *m = MsgTopUpProviderBondResponse{} }
+func (m *MsgTopUpProviderBondResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUpProviderBondResponse) ProtoMessage()    {}
+func (*MsgTopUpProviderBondResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{37}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgTopUpProviderBondResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTopUpProviderBondResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUpProviderBondResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTopUpProviderBondResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUpProviderBondResponse.Merge(m, src)
+}
+func (m *MsgTopUpProviderBondResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTopUpProviderBondResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUpProviderBondResponse.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgTopUpProviderBondResponse proto.InternalMessageInfo
+
+func (m *MsgTopUpProviderBondResponse) GetBond() types.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return types.Coin{}
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTopUpProviderBondResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RegisterProvider(ctx context.Context, in *MsgRegisterProvider, opts ...grpc.CallOption) (*MsgRegisterProviderResponse, error) {
-	out := new(MsgRegisterProviderResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/RegisterProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgUnbondProviderBond starts unbonding part of the creator's provider bond.
+type MsgUnbondProviderBond struct {
+	Creator string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Amount  types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgUnbondProviderBond) Reset()         { *m = MsgUnbondProviderBond{} }
+func (m *MsgUnbondProviderBond) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondProviderBond) ProtoMessage()    {}
+func (*MsgUnbondProviderBond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{38}
+}
+func (m *MsgUnbondProviderBond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbondProviderBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbondProviderBond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUnbondProviderBond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondProviderBond.Merge(m, src)
+}
+func (m *MsgUnbondProviderBond) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbondProviderBond) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondProviderBond.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateDeal(ctx context.Context, in *MsgCreateDeal, opts ...grpc.CallOption) (*MsgCreateDealResponse, error) {
-	out := new(MsgCreateDealResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/CreateDeal", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUnbondProviderBond proto.InternalMessageInfo
+
+func (m *MsgUnbondProviderBond) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateDealContent(ctx context.Context, in *MsgUpdateDealContent, opts ...grpc.CallOption) (*MsgUpdateDealContentResponse, error) {
-	out := new(MsgUpdateDealContentResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/UpdateDealContent", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUnbondProviderBond) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *msgClient) CreateDealFromEvm(ctx context.Context, in *MsgCreateDealFromEvm, opts ...grpc.CallOption) (*MsgCreateDealFromEvmResponse, error) {
-	out := new(MsgCreateDealFromEvmResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/CreateDealFromEvm", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgUnbondProviderBondResponse returns when the unbonded collateral is released.
+type MsgUnbondProviderBondResponse struct {
+	CompletionHeight uint64 `protobuf:"varint,1,opt,name=completion_height,json=completionHeight,proto3" json:"completion_height,omitempty"`
+}
+
+func (m *MsgUnbondProviderBondResponse) Reset()         { *m = MsgUnbondProviderBondResponse{} }
+func (m *MsgUnbondProviderBondResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondProviderBondResponse) ProtoMessage()    {}
+func (*MsgUnbondProviderBondResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{39}
+}
+func (m *MsgUnbondProviderBondResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbondProviderBondResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbondProviderBondResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUnbondProviderBondResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondProviderBondResponse.Merge(m, src)
+}
+func (m *MsgUnbondProviderBondResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbondProviderBondResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondProviderBondResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateDealContentFromEvm(ctx context.Context, in *MsgUpdateDealContentFromEvm, opts ...grpc.CallOption) (*MsgUpdateDealContentFromEvmResponse, error) {
-	out := new(MsgUpdateDealContentFromEvmResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/UpdateDealContentFromEvm", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUnbondProviderBondResponse proto.InternalMessageInfo
+
+func (m *MsgUnbondProviderBondResponse) GetCompletionHeight() uint64 {
+	if m != nil {
+		return m.CompletionHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) OpenRetrievalSession(ctx context.Context, in *MsgOpenRetrievalSession, opts ...grpc.CallOption) (*MsgOpenRetrievalSessionResponse, error) {
-	out := new(MsgOpenRetrievalSessionResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/OpenRetrievalSession", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgUpdateProvider changes the creator's provider registration. Unset fields
+// (no endpoints, zero total_storage, empty capabilities) are left unchanged.
+type MsgUpdateProvider struct {
+	Creator      string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Endpoints    []string `protobuf:"bytes,2,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	TotalStorage uint64   `protobuf:"varint,3,opt,name=total_storage,json=totalStorage,proto3" json:"total_storage,omitempty"`
+	Capabilities string   `protobuf:"bytes,4,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+}
+
+func (m *MsgUpdateProvider) Reset()         { *m = MsgUpdateProvider{} }
+func (m *MsgUpdateProvider) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProvider) ProtoMessage()    {}
+func (*MsgUpdateProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{40}
+}
+func (m *MsgUpdateProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProvider.Merge(m, src)
+}
+func (m *MsgUpdateProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProvider.DiscardUnknown(m)
 }
 
-func (c *msgClient) ConfirmRetrievalSession(ctx context.Context, in *MsgConfirmRetrievalSession, opts ...grpc.CallOption) (*MsgConfirmRetrievalSessionResponse, error) {
-	out := new(MsgConfirmRetrievalSessionResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/ConfirmRetrievalSession", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateProvider proto.InternalMessageInfo
+
+func (m *MsgUpdateProvider) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CancelRetrievalSession(ctx context.Context, in *MsgCancelRetrievalSession, opts ...grpc.CallOption) (*MsgCancelRetrievalSessionResponse, error) {
-	out := new(MsgCancelRetrievalSessionResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/CancelRetrievalSession", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateProvider) GetEndpoints() []string {
+	if m != nil {
+		return m.Endpoints
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) SubmitRetrievalSessionProof(ctx context.Context, in *MsgSubmitRetrievalSessionProof, opts ...grpc.CallOption) (*MsgSubmitRetrievalSessionProofResponse, error) {
-	out := new(MsgSubmitRetrievalSessionProofResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/SubmitRetrievalSessionProof", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateProvider) GetTotalStorage() uint64 {
+	if m != nil {
+		return m.TotalStorage
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) ProveLiveness(ctx context.Context, in *MsgProveLiveness, opts ...grpc.CallOption) (*MsgProveLivenessResponse, error) {
-	out := new(MsgProveLivenessResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/ProveLiveness", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateProvider) GetCapabilities() string {
+	if m != nil {
+		return m.Capabilities
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SignalSaturation(ctx context.Context, in *MsgSignalSaturation, opts ...grpc.CallOption) (*MsgSignalSaturationResponse, error) {
-	out := new(MsgSignalSaturationResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/SignalSaturation", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgUpdateProviderResponse returns the updated provider.
+type MsgUpdateProviderResponse struct {
+	Provider Provider `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider"`
+}
+
+func (m *MsgUpdateProviderResponse) Reset()         { *m = MsgUpdateProviderResponse{} }
+func (m *MsgUpdateProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProviderResponse) ProtoMessage()    {}
+func (*MsgUpdateProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{41}
+}
+func (m *MsgUpdateProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProviderResponse.Merge(m, src)
+}
+func (m *MsgUpdateProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProviderResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) StartSlotRepair(ctx context.Context, in *MsgStartSlotRepair, opts ...grpc.CallOption) (*MsgStartSlotRepairResponse, error) {
-	out := new(MsgStartSlotRepairResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/StartSlotRepair", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateProviderResponse proto.InternalMessageInfo
+
+func (m *MsgUpdateProviderResponse) GetProvider() Provider {
+	if m != nil {
+		return m.Provider
 	}
-	return out, nil
+	return Provider{}
 }
 
-func (c *msgClient) CompleteSlotRepair(ctx context.Context, in *MsgCompleteSlotRepair, opts ...grpc.CallOption) (*MsgCompleteSlotRepairResponse, error) {
-	out := new(MsgCompleteSlotRepairResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/CompleteSlotRepair", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgSetProviderStatus voluntarily pauses ("Maintenance", "Draining") or
+// resumes ("Active") new deal assignments for the creator's provider.
+type MsgSetProviderStatus struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Status  string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *MsgSetProviderStatus) Reset()         { *m = MsgSetProviderStatus{} }
+func (m *MsgSetProviderStatus) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProviderStatus) ProtoMessage()    {}
+func (*MsgSetProviderStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{42}
+}
+func (m *MsgSetProviderStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProviderStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProviderStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetProviderStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProviderStatus.Merge(m, src)
+}
+func (m *MsgSetProviderStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProviderStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProviderStatus.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddCredit(ctx context.Context, in *MsgAddCredit, opts ...grpc.CallOption) (*MsgAddCreditResponse, error) {
-	out := new(MsgAddCreditResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/AddCredit", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetProviderStatus proto.InternalMessageInfo
+
+func (m *MsgSetProviderStatus) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards, opts ...grpc.CallOption) (*MsgWithdrawRewardsResponse, error) {
-	out := new(MsgWithdrawRewardsResponse)
-	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/WithdrawRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetProviderStatus) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// MsgRegisterProvider allows a Storage Provider to register themselves with the network.
-	RegisterProvider(context.Context, *MsgRegisterProvider) (*MsgRegisterProviderResponse, error)
-	// MsgCreateDeal allows a user to create a new storage deal.
-	CreateDeal(context.Context, *MsgCreateDeal) (*MsgCreateDealResponse, error)
-	// MsgUpdateDealContent allows a user to commit or update the content (manifest) of a deal.
-	UpdateDealContent(context.Context, *MsgUpdateDealContent) (*MsgUpdateDealContentResponse, error)
-	// MsgCreateDealFromEvm allows a user to create a new storage deal
-	// using an EVM-signed intent bridged into nilchaind.
-	CreateDealFromEvm(context.Context, *MsgCreateDealFromEvm) (*MsgCreateDealFromEvmResponse, error)
-	// MsgUpdateDealContentFromEvm allows a user to update deal content
-	// using an EVM-signed intent.
-	UpdateDealContentFromEvm(context.Context, *MsgUpdateDealContentFromEvm) (*MsgUpdateDealContentFromEvmResponse, error)
-	// MsgOpenRetrievalSession opens an on-chain retrieval session for a contiguous blob-range.
-	OpenRetrievalSession(context.Context, *MsgOpenRetrievalSession) (*MsgOpenRetrievalSessionResponse, error)
-	// MsgConfirmRetrievalSession confirms completion of a retrieval session (proof-of-validation).
-	ConfirmRetrievalSession(context.Context, *MsgConfirmRetrievalSession) (*MsgConfirmRetrievalSessionResponse, error)
-	// MsgCancelRetrievalSession cancels an expired retrieval session and unlocks fees.
-	CancelRetrievalSession(context.Context, *MsgCancelRetrievalSession) (*MsgCancelRetrievalSessionResponse, error)
-	// MsgSubmitRetrievalSessionProof submits proof-of-retrieval for a session (provider action).
-	SubmitRetrievalSessionProof(context.Context, *MsgSubmitRetrievalSessionProof) (*MsgSubmitRetrievalSessionProofResponse, error)
-	// MsgProveLiveness allows a Storage Provider to submit a proof of data liveness.
-	ProveLiveness(context.Context, *MsgProveLiveness) (*MsgProveLivenessResponse, error)
-	// MsgSignalSaturation allows a Storage Provider to signal high load for a deal.
-	SignalSaturation(context.Context, *MsgSignalSaturation) (*MsgSignalSaturationResponse, error)
-	// MsgStartSlotRepair marks a Mode 2 slot as repairing and sets a replacement candidate.
-	StartSlotRepair(context.Context, *MsgStartSlotRepair) (*MsgStartSlotRepairResponse, error)
-	// MsgCompleteSlotRepair promotes the pending replacement candidate to active.
-	CompleteSlotRepair(context.Context, *MsgCompleteSlotRepair) (*MsgCompleteSlotRepairResponse, error)
-	// MsgAddCredit allows a user to top up the escrow balance for a deal.
-	AddCredit(context.Context, *MsgAddCredit) (*MsgAddCreditResponse, error)
-	// MsgWithdrawRewards allows a Storage Provider to withdraw accumulated rewards.
-	WithdrawRewards(context.Context, *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error)
+// MsgSetProviderStatusResponse defines the response structure for setting a provider status.
+type MsgSetProviderStatusResponse struct {
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgSetProviderStatusResponse) Reset()         { *m = MsgSetProviderStatusResponse{} }
+func (m *MsgSetProviderStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProviderStatusResponse) ProtoMessage()    {}
+func (*MsgSetProviderStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{43}
+}
+func (m *MsgSetProviderStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProviderStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProviderStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetProviderStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProviderStatusResponse.Merge(m, src)
+}
+func (m *MsgSetProviderStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProviderStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProviderStatusResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+var xxx_messageInfo_MsgSetProviderStatusResponse proto.InternalMessageInfo
+
+func (m *MsgSetProviderStatusResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) RegisterProvider(ctx context.Context, req *MsgRegisterProvider) (*MsgRegisterProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterProvider not implemented")
+
+// MsgDeregisterProvider asks the chain to migrate every assignment held by the
+// creator and then remove the provider and unbond its collateral.
+type MsgDeregisterProvider struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 }
-func (*UnimplementedMsgServer) CreateDeal(ctx context.Context, req *MsgCreateDeal) (*MsgCreateDealResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDeal not implemented")
+
+func (m *MsgDeregisterProvider) Reset()         { *m = MsgDeregisterProvider{} }
+func (m *MsgDeregisterProvider) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterProvider) ProtoMessage()    {}
+func (*MsgDeregisterProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{44}
 }
-func (*UnimplementedMsgServer) UpdateDealContent(ctx context.Context, req *MsgUpdateDealContent) (*MsgUpdateDealContentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDealContent not implemented")
+func (m *MsgDeregisterProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateDealFromEvm(ctx context.Context, req *MsgCreateDealFromEvm) (*MsgCreateDealFromEvmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDealFromEvm not implemented")
+func (m *MsgDeregisterProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateDealContentFromEvm(ctx context.Context, req *MsgUpdateDealContentFromEvm) (*MsgUpdateDealContentFromEvmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDealContentFromEvm not implemented")
+func (m *MsgDeregisterProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterProvider.Merge(m, src)
 }
-func (*UnimplementedMsgServer) OpenRetrievalSession(ctx context.Context, req *MsgOpenRetrievalSession) (*MsgOpenRetrievalSessionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OpenRetrievalSession not implemented")
+func (m *MsgDeregisterProvider) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) ConfirmRetrievalSession(ctx context.Context, req *MsgConfirmRetrievalSession) (*MsgConfirmRetrievalSessionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConfirmRetrievalSession not implemented")
+func (m *MsgDeregisterProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterProvider.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) CancelRetrievalSession(ctx context.Context, req *MsgCancelRetrievalSession) (*MsgCancelRetrievalSessionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelRetrievalSession not implemented")
+
+var xxx_messageInfo_MsgDeregisterProvider proto.InternalMessageInfo
+
+func (m *MsgDeregisterProvider) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) SubmitRetrievalSessionProof(ctx context.Context, req *MsgSubmitRetrievalSessionProof) (*MsgSubmitRetrievalSessionProofResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitRetrievalSessionProof not implemented")
+
+// MsgDeregisterProviderResponse reports how many assignments are migrating.
+// When pending_migrations is zero the provider was removed immediately.
+type MsgDeregisterProviderResponse struct {
+	PendingMigrations uint64 `protobuf:"varint,1,opt,name=pending_migrations,json=pendingMigrations,proto3" json:"pending_migrations,omitempty"`
+	CompletionHeight  uint64 `protobuf:"varint,2,opt,name=completion_height,json=completionHeight,proto3" json:"completion_height,omitempty"`
 }
-func (*UnimplementedMsgServer) ProveLiveness(ctx context.Context, req *MsgProveLiveness) (*MsgProveLivenessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProveLiveness not implemented")
+
+func (m *MsgDeregisterProviderResponse) Reset()         { *m = MsgDeregisterProviderResponse{} }
+func (m *MsgDeregisterProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterProviderResponse) ProtoMessage()    {}
+func (*MsgDeregisterProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{45}
 }
-func (*UnimplementedMsgServer) SignalSaturation(ctx context.Context, req *MsgSignalSaturation) (*MsgSignalSaturationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignalSaturation not implemented")
+func (m *MsgDeregisterProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) StartSlotRepair(ctx context.Context, req *MsgStartSlotRepair) (*MsgStartSlotRepairResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartSlotRepair not implemented")
+func (m *MsgDeregisterProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CompleteSlotRepair(ctx context.Context, req *MsgCompleteSlotRepair) (*MsgCompleteSlotRepairResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompleteSlotRepair not implemented")
+func (m *MsgDeregisterProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterProviderResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) AddCredit(ctx context.Context, req *MsgAddCredit) (*MsgAddCreditResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddCredit not implemented")
+func (m *MsgDeregisterProviderResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) WithdrawRewards(ctx context.Context, req *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawRewards not implemented")
+func (m *MsgDeregisterProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterProviderResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgDeregisterProviderResponse proto.InternalMessageInfo
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgDeregisterProviderResponse) GetPendingMigrations() uint64 {
+	if m != nil {
+		return m.PendingMigrations
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_RegisterProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterProvider)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Msg/RegisterProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterProvider(ctx, req.(*MsgRegisterProvider))
+func (m *MsgDeregisterProviderResponse) GetCompletionHeight() uint64 {
+	if m != nil {
+		return m.CompletionHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CreateDeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateDeal)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateDeal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Msg/CreateDeal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateDeal(ctx, req.(*MsgCreateDeal))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgExtendDeal pushes a deal's end_block out by additional_blocks. The owner
+// pays storage_price * size * additional_blocks into the deal escrow.
+type MsgExtendDeal struct {
+	Creator          string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	DealId           uint64 `protobuf:"varint,2,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	AdditionalBlocks uint64 `protobuf:"varint,3,opt,name=additional_blocks,json=additionalBlocks,proto3" json:"additional_blocks,omitempty"`
 }
 
-func _Msg_UpdateDealContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateDealContent)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateDealContent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Msg/UpdateDealContent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateDealContent(ctx, req.(*MsgUpdateDealContent))
+func (m *MsgExtendDeal) Reset()         { *m = MsgExtendDeal{} }
+func (m *MsgExtendDeal) String() string { return proto.CompactTextString(m) }
+func (*MsgExtendDeal) ProtoMessage()    {}
+func (*MsgExtendDeal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{46}
+}
+func (m *MsgExtendDeal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExtendDeal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExtendDeal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgExtendDeal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExtendDeal.Merge(m, src)
+}
+func (m *MsgExtendDeal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExtendDeal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExtendDeal.DiscardUnknown(m)
 }
 
-func _Msg_CreateDealFromEvm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateDealFromEvm)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateDealFromEvm(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Msg/CreateDealFromEvm",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateDealFromEvm(ctx, req.(*MsgCreateDealFromEvm))
+var xxx_messageInfo_MsgExtendDeal proto.InternalMessageInfo
+
+func (m *MsgExtendDeal) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateDealContentFromEvm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateDealContentFromEvm)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateDealContentFromEvm(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Msg/UpdateDealContentFromEvm",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateDealContentFromEvm(ctx, req.(*MsgUpdateDealContentFromEvm))
+func (m *MsgExtendDeal) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_OpenRetrievalSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgOpenRetrievalSession)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).OpenRetrievalSession(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Msg/OpenRetrievalSession",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).OpenRetrievalSession(ctx, req.(*MsgOpenRetrievalSession))
+func (m *MsgExtendDeal) GetAdditionalBlocks() uint64 {
+	if m != nil {
+		return m.AdditionalBlocks
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_ConfirmRetrievalSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConfirmRetrievalSession)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConfirmRetrievalSession(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Msg/ConfirmRetrievalSession",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConfirmRetrievalSession(ctx, req.(*MsgConfirmRetrievalSession))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgExtendDealResponse returns the new end block and the escrow charged.
+type MsgExtendDealResponse struct {
+	EndBlock      uint64                `protobuf:"varint,1,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+	EscrowAdded   cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=escrow_added,json=escrowAdded,proto3,customtype=cosmossdk.io/math.Int" json:"escrow_added"`
+	EscrowBalance cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=escrow_balance,json=escrowBalance,proto3,customtype=cosmossdk.io/math.Int" json:"escrow_balance"`
 }
 
-func _Msg_CancelRetrievalSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelRetrievalSession)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelRetrievalSession(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Msg/CancelRetrievalSession",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelRetrievalSession(ctx, req.(*MsgCancelRetrievalSession))
+func (m *MsgExtendDealResponse) Reset()         { *m = MsgExtendDealResponse{} }
+func (m *MsgExtendDealResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExtendDealResponse) ProtoMessage()    {}
+func (*MsgExtendDealResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{47}
+}
+func (m *MsgExtendDealResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExtendDealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExtendDealResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgExtendDealResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExtendDealResponse.Merge(m, src)
+}
+func (m *MsgExtendDealResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExtendDealResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExtendDealResponse.DiscardUnknown(m)
 }
 
-func _Msg_SubmitRetrievalSessionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitRetrievalSessionProof)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitRetrievalSessionProof(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Msg/SubmitRetrievalSessionProof",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitRetrievalSessionProof(ctx, req.(*MsgSubmitRetrievalSessionProof))
+var xxx_messageInfo_MsgExtendDealResponse proto.InternalMessageInfo
+
+func (m *MsgExtendDealResponse) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_ProveLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProveLiveness)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ProveLiveness(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Msg/ProveLiveness",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ProveLiveness(ctx, req.(*MsgProveLiveness))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgCloseDeal terminates a deal before its end block. Open retrieval sessions
+// are settled, providers are paid storage_price * size for the blocks served,
+// and the rest of the escrow is refunded to the owner.
+type MsgCloseDeal struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	DealId  uint64 `protobuf:"varint,2,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
 }
 
-func _Msg_SignalSaturation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSignalSaturation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SignalSaturation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Msg/SignalSaturation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SignalSaturation(ctx, req.(*MsgSignalSaturation))
+func (m *MsgCloseDeal) Reset()         { *m = MsgCloseDeal{} }
+func (m *MsgCloseDeal) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseDeal) ProtoMessage()    {}
+func (*MsgCloseDeal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{48}
+}
+func (m *MsgCloseDeal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseDeal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseDeal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCloseDeal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseDeal.Merge(m, src)
+}
+func (m *MsgCloseDeal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseDeal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseDeal.DiscardUnknown(m)
 }
 
-func _Msg_StartSlotRepair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStartSlotRepair)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).StartSlotRepair(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Msg/StartSlotRepair",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StartSlotRepair(ctx, req.(*MsgStartSlotRepair))
+var xxx_messageInfo_MsgCloseDeal proto.InternalMessageInfo
+
+func (m *MsgCloseDeal) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CompleteSlotRepair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCompleteSlotRepair)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CompleteSlotRepair(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Msg/CompleteSlotRepair",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CompleteSlotRepair(ctx, req.(*MsgCompleteSlotRepair))
+func (m *MsgCloseDeal) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_AddCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddCredit)
-	if err := dec(in); err != nil {
-		return nil, err
+// MsgCloseDealResponse reports how the deal escrow was settled.
+type MsgCloseDealResponse struct {
+	ProviderPayout  cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=provider_payout,json=providerPayout,proto3,customtype=cosmossdk.io/math.Int" json:"provider_payout"`
+	EscrowRefund    cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=escrow_refund,json=escrowRefund,proto3,customtype=cosmossdk.io/math.Int" json:"escrow_refund"`
+	SessionsSettled uint64                `protobuf:"varint,3,opt,name=sessions_settled,json=sessionsSettled,proto3" json:"sessions_settled,omitempty"`
+}
+
+func (m *MsgCloseDealResponse) Reset()         { *m = MsgCloseDealResponse{} }
+func (m *MsgCloseDealResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseDealResponse) ProtoMessage()    {}
+func (*MsgCloseDealResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{49}
+}
+func (m *MsgCloseDealResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseDealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseDealResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddCredit(ctx, in)
+}
+func (m *MsgCloseDealResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseDealResponse.Merge(m, src)
+}
+func (m *MsgCloseDealResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseDealResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseDealResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseDealResponse proto.InternalMessageInfo
+
+func (m *MsgCloseDealResponse) GetSessionsSettled() uint64 {
+	if m != nil {
+		return m.SessionsSettled
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Msg/AddCredit",
+	return 0
+}
+
+// MsgCloseDealFromEvm wraps a signed EVM intent for closing a deal.
+type MsgCloseDealFromEvm struct {
+	Sender       string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Intent       *EvmCloseDealIntent `protobuf:"bytes,2,opt,name=intent,proto3" json:"intent,omitempty"`
+	EvmSignature []byte              `protobuf:"bytes,3,opt,name=evm_signature,json=evmSignature,proto3" json:"evm_signature,omitempty"`
+}
+
+func (m *MsgCloseDealFromEvm) Reset()         { *m = MsgCloseDealFromEvm{} }
+func (m *MsgCloseDealFromEvm) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseDealFromEvm) ProtoMessage()    {}
+func (*MsgCloseDealFromEvm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{50}
+}
+func (m *MsgCloseDealFromEvm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseDealFromEvm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseDealFromEvm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddCredit(ctx, req.(*MsgAddCredit))
+}
+func (m *MsgCloseDealFromEvm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseDealFromEvm.Merge(m, src)
+}
+func (m *MsgCloseDealFromEvm) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseDealFromEvm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseDealFromEvm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseDealFromEvm proto.InternalMessageInfo
+
+func (m *MsgCloseDealFromEvm) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_WithdrawRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawRewards)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCloseDealFromEvm) GetIntent() *EvmCloseDealIntent {
+	if m != nil {
+		return m.Intent
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawRewards(ctx, in)
+	return nil
+}
+
+func (m *MsgCloseDealFromEvm) GetEvmSignature() []byte {
+	if m != nil {
+		return m.EvmSignature
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nilchain.nilchain.v1.Msg/WithdrawRewards",
+	return nil
+}
+
+type MsgCloseDealFromEvmResponse struct {
+	ProviderPayout  cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=provider_payout,json=providerPayout,proto3,customtype=cosmossdk.io/math.Int" json:"provider_payout"`
+	EscrowRefund    cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=escrow_refund,json=escrowRefund,proto3,customtype=cosmossdk.io/math.Int" json:"escrow_refund"`
+	SessionsSettled uint64                `protobuf:"varint,3,opt,name=sessions_settled,json=sessionsSettled,proto3" json:"sessions_settled,omitempty"`
+}
+
+func (m *MsgCloseDealFromEvmResponse) Reset()         { *m = MsgCloseDealFromEvmResponse{} }
+func (m *MsgCloseDealFromEvmResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseDealFromEvmResponse) ProtoMessage()    {}
+func (*MsgCloseDealFromEvmResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{51}
+}
+func (m *MsgCloseDealFromEvmResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseDealFromEvmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseDealFromEvmResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawRewards(ctx, req.(*MsgWithdrawRewards))
+}
+func (m *MsgCloseDealFromEvmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseDealFromEvmResponse.Merge(m, src)
+}
+func (m *MsgCloseDealFromEvmResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseDealFromEvmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseDealFromEvmResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseDealFromEvmResponse proto.InternalMessageInfo
+
+func (m *MsgCloseDealFromEvmResponse) GetSessionsSettled() uint64 {
+	if m != nil {
+		return m.SessionsSettled
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "nilchain.nilchain.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "RegisterProvider",
-			Handler:    _Msg_RegisterProvider_Handler,
-		},
-		{
-			MethodName: "CreateDeal",
-			Handler:    _Msg_CreateDeal_Handler,
-		},
-		{
-			MethodName: "UpdateDealContent",
-			Handler:    _Msg_UpdateDealContent_Handler,
-		},
-		{
-			MethodName: "CreateDealFromEvm",
-			Handler:    _Msg_CreateDealFromEvm_Handler,
-		},
-		{
-			MethodName: "UpdateDealContentFromEvm",
-			Handler:    _Msg_UpdateDealContentFromEvm_Handler,
-		},
-		{
-			MethodName: "OpenRetrievalSession",
-			Handler:    _Msg_OpenRetrievalSession_Handler,
-		},
-		{
-			MethodName: "ConfirmRetrievalSession",
-			Handler:    _Msg_ConfirmRetrievalSession_Handler,
-		},
-		{
-			MethodName: "CancelRetrievalSession",
-			Handler:    _Msg_CancelRetrievalSession_Handler,
-		},
-		{
-			MethodName: "SubmitRetrievalSessionProof",
-			Handler:    _Msg_SubmitRetrievalSessionProof_Handler,
-		},
-		{
-			MethodName: "ProveLiveness",
-			Handler:    _Msg_ProveLiveness_Handler,
-		},
-		{
-			MethodName: "SignalSaturation",
-			Handler:    _Msg_SignalSaturation_Handler,
-		},
-		{
-			MethodName: "StartSlotRepair",
-			Handler:    _Msg_StartSlotRepair_Handler,
-		},
-		{
-			MethodName: "CompleteSlotRepair",
-			Handler:    _Msg_CompleteSlotRepair_Handler,
-		},
-		{
-			MethodName: "AddCredit",
-			Handler:    _Msg_AddCredit_Handler,
-		},
-		{
-			MethodName: "WithdrawRewards",
-			Handler:    _Msg_WithdrawRewards_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "nilchain/nilchain/v1/tx.proto",
+// MsgTransferDealOwnership proposes new_owner as the owner of a deal. The
+// transfer takes effect once new_owner sends MsgAcceptDealOwnership. An empty
+// new_owner cancels a pending proposal.
+type MsgTransferDealOwnership struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	DealId   uint64 `protobuf:"varint,2,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgTransferDealOwnership) Reset()         { *m = MsgTransferDealOwnership{} }
+func (m *MsgTransferDealOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferDealOwnership) ProtoMessage()    {}
+func (*MsgTransferDealOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{52}
 }
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTransferDealOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgTransferDealOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferDealOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgTransferDealOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferDealOwnership.Merge(m, src)
+}
+func (m *MsgTransferDealOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferDealOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferDealOwnership.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgTransferDealOwnership proto.InternalMessageInfo
+
+func (m *MsgTransferDealOwnership) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgTransferDealOwnership) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
+	}
+	return 0
 }
 
-func (m *MsgRegisterProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferDealOwnership) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRegisterProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgTransferDealOwnershipResponse struct {
+	PendingOwner string `protobuf:"bytes,1,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
 }
 
-func (m *MsgRegisterProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Endpoints) > 0 {
-		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Endpoints[iNdEx])
-			copy(dAtA[i:], m.Endpoints[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Endpoints[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+func (m *MsgTransferDealOwnershipResponse) Reset()         { *m = MsgTransferDealOwnershipResponse{} }
+func (m *MsgTransferDealOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferDealOwnershipResponse) ProtoMessage()    {}
+func (*MsgTransferDealOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{53}
+}
+func (m *MsgTransferDealOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferDealOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferDealOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.TotalStorage != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TotalStorage))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Capabilities) > 0 {
-		i -= len(m.Capabilities)
-		copy(dAtA[i:], m.Capabilities)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Capabilities)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgTransferDealOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferDealOwnershipResponse.Merge(m, src)
+}
+func (m *MsgTransferDealOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferDealOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferDealOwnershipResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTransferDealOwnershipResponse proto.InternalMessageInfo
+
+func (m *MsgTransferDealOwnershipResponse) GetPendingOwner() string {
+	if m != nil {
+		return m.PendingOwner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRegisterProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgAcceptDealOwnership is sent by the pending owner to take over a deal.
+type MsgAcceptDealOwnership struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	DealId  uint64 `protobuf:"varint,2,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
 }
 
-func (m *MsgRegisterProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgAcceptDealOwnership) Reset()         { *m = MsgAcceptDealOwnership{} }
+func (m *MsgAcceptDealOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptDealOwnership) ProtoMessage()    {}
+func (*MsgAcceptDealOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{54}
+}
+func (m *MsgAcceptDealOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptDealOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptDealOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAcceptDealOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptDealOwnership.Merge(m, src)
+}
+func (m *MsgAcceptDealOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptDealOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptDealOwnership.DiscardUnknown(m)
 }
 
-func (m *MsgCreateDeal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAcceptDealOwnership proto.InternalMessageInfo
+
+func (m *MsgAcceptDealOwnership) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateDeal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAcceptDealOwnership) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
+	}
+	return 0
 }
 
-func (m *MsgCreateDeal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.InitialEscrowAmount.Size()
-		i -= size
-		if _, err := m.InitialEscrowAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+type MsgAcceptDealOwnershipResponse struct {
+	PreviousOwner string `protobuf:"bytes,1,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
+	SessionsMoved uint64 `protobuf:"varint,2,opt,name=sessions_moved,json=sessionsMoved,proto3" json:"sessions_moved,omitempty"`
+}
+
+func (m *MsgAcceptDealOwnershipResponse) Reset()         { *m = MsgAcceptDealOwnershipResponse{} }
+func (m *MsgAcceptDealOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptDealOwnershipResponse) ProtoMessage()    {}
+func (*MsgAcceptDealOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{55}
+}
+func (m *MsgAcceptDealOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptDealOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptDealOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.MaxMonthlySpend.Size()
-		i -= size
-		if _, err := m.MaxMonthlySpend.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgAcceptDealOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptDealOwnershipResponse.Merge(m, src)
+}
+func (m *MsgAcceptDealOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptDealOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptDealOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptDealOwnershipResponse proto.InternalMessageInfo
+
+func (m *MsgAcceptDealOwnershipResponse) GetPreviousOwner() string {
+	if m != nil {
+		return m.PreviousOwner
 	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.ServiceHint) > 0 {
-		i -= len(m.ServiceHint)
-		copy(dAtA[i:], m.ServiceHint)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceHint)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *MsgAcceptDealOwnershipResponse) GetSessionsMoved() uint64 {
+	if m != nil {
+		return m.SessionsMoved
 	}
-	if m.DurationBlocks != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DurationBlocks))
-		i--
-		dAtA[i] = 0x20
+	return 0
+}
+
+// MsgTransferDealOwnershipFromEvm wraps a signed EVM intent for an ownership transfer.
+type MsgTransferDealOwnershipFromEvm struct {
+	Sender       string                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Intent       *EvmTransferDealIntent `protobuf:"bytes,2,opt,name=intent,proto3" json:"intent,omitempty"`
+	EvmSignature []byte                 `protobuf:"bytes,3,opt,name=evm_signature,json=evmSignature,proto3" json:"evm_signature,omitempty"`
+}
+
+func (m *MsgTransferDealOwnershipFromEvm) Reset()         { *m = MsgTransferDealOwnershipFromEvm{} }
+func (m *MsgTransferDealOwnershipFromEvm) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferDealOwnershipFromEvm) ProtoMessage()    {}
+func (*MsgTransferDealOwnershipFromEvm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{56}
+}
+func (m *MsgTransferDealOwnershipFromEvm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferDealOwnershipFromEvm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferDealOwnershipFromEvm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgTransferDealOwnershipFromEvm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferDealOwnershipFromEvm.Merge(m, src)
+}
+func (m *MsgTransferDealOwnershipFromEvm) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferDealOwnershipFromEvm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferDealOwnershipFromEvm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferDealOwnershipFromEvm proto.InternalMessageInfo
+
+func (m *MsgTransferDealOwnershipFromEvm) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateDealResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferDealOwnershipFromEvm) GetIntent() *EvmTransferDealIntent {
+	if m != nil {
+		return m.Intent
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreateDealResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTransferDealOwnershipFromEvm) GetEvmSignature() []byte {
+	if m != nil {
+		return m.EvmSignature
+	}
+	return nil
 }
 
-func (m *MsgCreateDealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AssignedProviders) > 0 {
-		for iNdEx := len(m.AssignedProviders) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AssignedProviders[iNdEx])
-			copy(dAtA[i:], m.AssignedProviders[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.AssignedProviders[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+type MsgTransferDealOwnershipFromEvmResponse struct {
+	Owner        string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	PendingOwner string `protobuf:"bytes,2,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
+}
+
+func (m *MsgTransferDealOwnershipFromEvmResponse) Reset() {
+	*m = MsgTransferDealOwnershipFromEvmResponse{}
+}
+func (m *MsgTransferDealOwnershipFromEvmResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferDealOwnershipFromEvmResponse) ProtoMessage()    {}
+func (*MsgTransferDealOwnershipFromEvmResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{57}
+}
+func (m *MsgTransferDealOwnershipFromEvmResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferDealOwnershipFromEvmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferDealOwnershipFromEvmResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.DealId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *MsgTransferDealOwnershipFromEvmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferDealOwnershipFromEvmResponse.Merge(m, src)
+}
+func (m *MsgTransferDealOwnershipFromEvmResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferDealOwnershipFromEvmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferDealOwnershipFromEvmResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferDealOwnershipFromEvmResponse proto.InternalMessageInfo
+
+func (m *MsgTransferDealOwnershipFromEvmResponse) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateDealContent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferDealOwnershipFromEvmResponse) GetPendingOwner() string {
+	if m != nil {
+		return m.PendingOwner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateDealContent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgGrantDealAccess creates or replaces a read grant. Replacing a grant resets
+// its usage counters.
+type MsgGrantDealAccess struct {
+	Creator   string                `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	DealId    uint64                `protobuf:"varint,2,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	Grantee   string                `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	ExpiresAt uint64                `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MaxBytes  uint64                `protobuf:"varint,5,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	MaxFee    cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=max_fee,json=maxFee,proto3,customtype=cosmossdk.io/math.Int" json:"max_fee"`
 }
 
-func (m *MsgUpdateDealContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Size_ != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Size_))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.DealId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgGrantDealAccess) Reset()         { *m = MsgGrantDealAccess{} }
+func (m *MsgGrantDealAccess) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantDealAccess) ProtoMessage()    {}
+func (*MsgGrantDealAccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{58}
+}
+func (m *MsgGrantDealAccess) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantDealAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantDealAccess.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgGrantDealAccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantDealAccess.Merge(m, src)
+}
+func (m *MsgGrantDealAccess) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantDealAccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantDealAccess.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantDealAccess proto.InternalMessageInfo
+
+func (m *MsgGrantDealAccess) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateDealContentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgGrantDealAccess) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateDealContentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgGrantDealAccess) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
 }
 
-func (m *MsgUpdateDealContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (m *MsgGrantDealAccess) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EvmCreateDealIntent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgGrantDealAccess) GetMaxBytes() uint64 {
+	if m != nil {
+		return m.MaxBytes
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EvmCreateDealIntent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgGrantDealAccessResponse struct {
 }
 
-func (m *EvmCreateDealIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x40
-	}
-	{
-		size := m.MaxMonthlySpend.Size()
-		i -= size
-		if _, err := m.MaxMonthlySpend.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgGrantDealAccessResponse) Reset()         { *m = MsgGrantDealAccessResponse{} }
+func (m *MsgGrantDealAccessResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantDealAccessResponse) ProtoMessage()    {}
+func (*MsgGrantDealAccessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{59}
+}
+func (m *MsgGrantDealAccessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantDealAccessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantDealAccessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.InitialEscrow.Size()
-		i -= size
-		if _, err := m.InitialEscrow.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *MsgGrantDealAccessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantDealAccessResponse.Merge(m, src)
+}
+func (m *MsgGrantDealAccessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantDealAccessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantDealAccessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantDealAccessResponse proto.InternalMessageInfo
+
+// MsgRevokeDealAccess is sent by the owner, or by the grantee to renounce.
+type MsgRevokeDealAccess struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	DealId  uint64 `protobuf:"varint,2,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+}
+
+func (m *MsgRevokeDealAccess) Reset()         { *m = MsgRevokeDealAccess{} }
+func (m *MsgRevokeDealAccess) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeDealAccess) ProtoMessage()    {}
+func (*MsgRevokeDealAccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{60}
+}
+func (m *MsgRevokeDealAccess) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeDealAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeDealAccess.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.ServiceHint) > 0 {
-		i -= len(m.ServiceHint)
-		copy(dAtA[i:], m.ServiceHint)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceHint)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.DurationBlocks != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DurationBlocks))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.CreatorEvm) > 0 {
-		i -= len(m.CreatorEvm)
-		copy(dAtA[i:], m.CreatorEvm)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CreatorEvm)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRevokeDealAccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeDealAccess.Merge(m, src)
+}
+func (m *MsgRevokeDealAccess) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeDealAccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeDealAccess.DiscardUnknown(m)
 }
 
-func (m *EvmUpdateContentIntent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRevokeDealAccess proto.InternalMessageInfo
+
+func (m *MsgRevokeDealAccess) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EvmUpdateContentIntent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeDealAccess) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
+	}
+	return 0
 }
 
-func (m *EvmUpdateContentIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.SizeBytes != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SizeBytes))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.DealId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.CreatorEvm) > 0 {
-		i -= len(m.CreatorEvm)
-		copy(dAtA[i:], m.CreatorEvm)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CreatorEvm)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgRevokeDealAccess) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateDealFromEvm) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type MsgRevokeDealAccessResponse struct {
+}
+
+func (m *MsgRevokeDealAccessResponse) Reset()         { *m = MsgRevokeDealAccessResponse{} }
+func (m *MsgRevokeDealAccessResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeDealAccessResponse) ProtoMessage()    {}
+func (*MsgRevokeDealAccessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{61}
+}
+func (m *MsgRevokeDealAccessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeDealAccessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeDealAccessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRevokeDealAccessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeDealAccessResponse.Merge(m, src)
+}
+func (m *MsgRevokeDealAccessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeDealAccessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeDealAccessResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateDealFromEvm) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRevokeDealAccessResponse proto.InternalMessageInfo
+
+// MsgSubmitFraudProof reports a response a provider signed for a deal whose
+// data is wrong: its manifest root is not the deal's, its triple proof does
+// not verify against the deal's manifest root, or the served bytes cover a
+// whole blob's payload and that blob does not open to the proof's y_value at
+// z_value. The provider signs types.HashServedResponse over the response it
+// serves, including the served bytes.
+type MsgSubmitFraudProof struct {
+	Creator           string       `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	DealId            uint64       `protobuf:"varint,2,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	Provider          string       `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	Generation        uint64       `protobuf:"varint,4,opt,name=generation,proto3" json:"generation,omitempty"`
+	ManifestRoot      []byte       `protobuf:"bytes,5,opt,name=manifest_root,json=manifestRoot,proto3" json:"manifest_root,omitempty"`
+	Proof             ChainedProof `protobuf:"bytes,6,opt,name=proof,proto3" json:"proof"`
+	Data              []byte       `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	ProviderSignature []byte       `protobuf:"bytes,8,opt,name=provider_signature,json=providerSignature,proto3" json:"provider_signature,omitempty"`
 }
 
-func (m *MsgCreateDealFromEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmSignature) > 0 {
-		i -= len(m.EvmSignature)
-		copy(dAtA[i:], m.EvmSignature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EvmSignature)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Intent != nil {
-		{
-			size, err := m.Intent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgSubmitFraudProof) Reset()         { *m = MsgSubmitFraudProof{} }
+func (m *MsgSubmitFraudProof) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitFraudProof) ProtoMessage()    {}
+func (*MsgSubmitFraudProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{62}
+}
+func (m *MsgSubmitFraudProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitFraudProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitFraudProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSubmitFraudProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitFraudProof.Merge(m, src)
+}
+func (m *MsgSubmitFraudProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitFraudProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitFraudProof.DiscardUnknown(m)
 }
 
-func (m *MsgCreateDealFromEvmResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitFraudProof proto.InternalMessageInfo
+
+func (m *MsgSubmitFraudProof) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateDealFromEvmResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitFraudProof) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
+	}
+	return 0
 }
 
-func (m *MsgCreateDealFromEvmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DealId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgSubmitFraudProof) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateDealContentFromEvm) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitFraudProof) GetGeneration() uint64 {
+	if m != nil {
+		return m.Generation
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateDealContentFromEvm) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitFraudProof) GetManifestRoot() []byte {
+	if m != nil {
+		return m.ManifestRoot
+	}
+	return nil
 }
 
-func (m *MsgUpdateDealContentFromEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmSignature) > 0 {
-		i -= len(m.EvmSignature)
-		copy(dAtA[i:], m.EvmSignature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EvmSignature)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Intent != nil {
-		{
-			size, err := m.Intent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *MsgSubmitFraudProof) GetProof() ChainedProof {
+	if m != nil {
+		return m.Proof
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	return ChainedProof{}
+}
+
+func (m *MsgSubmitFraudProof) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgUpdateDealContentFromEvmResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitFraudProof) GetProviderSignature() []byte {
+	if m != nil {
+		return m.ProviderSignature
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateDealContentFromEvmResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSubmitFraudProofResponse struct {
+	Reason string     `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Reward types.Coin `protobuf:"bytes,2,opt,name=reward,proto3" json:"reward"`
 }
 
-func (m *MsgUpdateDealContentFromEvmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgSubmitFraudProofResponse) Reset()         { *m = MsgSubmitFraudProofResponse{} }
+func (m *MsgSubmitFraudProofResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitFraudProofResponse) ProtoMessage()    {}
+func (*MsgSubmitFraudProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{63}
+}
+func (m *MsgSubmitFraudProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitFraudProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitFraudProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgOpenRetrievalSession) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSubmitFraudProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitFraudProofResponse.Merge(m, src)
 }
-
-func (m *MsgOpenRetrievalSession) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitFraudProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitFraudProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitFraudProofResponse.DiscardUnknown(m)
 }
 
-func (m *MsgOpenRetrievalSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExpiresAt != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAt))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.BlobCount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BlobCount))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.StartBlobIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.StartBlobIndex))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.StartMduIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.StartMduIndex))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.ManifestRoot) > 0 {
-		i -= len(m.ManifestRoot)
-		copy(dAtA[i:], m.ManifestRoot)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ManifestRoot)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.DealId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgSubmitFraudProofResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitFraudProofResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgOpenRetrievalSessionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitFraudProofResponse) GetReward() types.Coin {
+	if m != nil {
+		return m.Reward
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgOpenRetrievalSessionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRegisterSessionKey registers or replaces a session key of the creator.
+// Replacing a key resets its usage counters. A key belongs to one owner.
+type MsgRegisterSessionKey struct {
+	Creator    string                `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	SessionKey string                `protobuf:"bytes,2,opt,name=session_key,json=sessionKey,proto3" json:"session_key,omitempty"`
+	DealIds    []uint64              `protobuf:"varint,3,rep,packed,name=deal_ids,json=dealIds,proto3" json:"deal_ids,omitempty"`
+	ExpiresAt  uint64                `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MaxBytes   uint64                `protobuf:"varint,5,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	MaxFee     cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=max_fee,json=maxFee,proto3,customtype=cosmossdk.io/math.Int" json:"max_fee"`
 }
 
-func (m *MsgOpenRetrievalSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SessionId) > 0 {
-		i -= len(m.SessionId)
-		copy(dAtA[i:], m.SessionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionId)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgRegisterSessionKey) Reset()         { *m = MsgRegisterSessionKey{} }
+func (m *MsgRegisterSessionKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterSessionKey) ProtoMessage()    {}
+func (*MsgRegisterSessionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{64}
+}
+func (m *MsgRegisterSessionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterSessionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterSessionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRegisterSessionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterSessionKey.Merge(m, src)
+}
+func (m *MsgRegisterSessionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterSessionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterSessionKey.DiscardUnknown(m)
 }
 
-func (m *MsgConfirmRetrievalSession) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterSessionKey proto.InternalMessageInfo
+
+func (m *MsgRegisterSessionKey) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgConfirmRetrievalSession) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRegisterSessionKey) GetSessionKey() string {
+	if m != nil {
+		return m.SessionKey
+	}
+	return ""
 }
 
-func (m *MsgConfirmRetrievalSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SessionId) > 0 {
-		i -= len(m.SessionId)
-		copy(dAtA[i:], m.SessionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionId)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgRegisterSessionKey) GetDealIds() []uint64 {
+	if m != nil {
+		return m.DealIds
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *MsgRegisterSessionKey) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgConfirmRetrievalSessionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterSessionKey) GetMaxBytes() uint64 {
+	if m != nil {
+		return m.MaxBytes
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgConfirmRetrievalSessionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRegisterSessionKeyResponse struct {
 }
 
-func (m *MsgConfirmRetrievalSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *MsgRegisterSessionKeyResponse) Reset()         { *m = MsgRegisterSessionKeyResponse{} }
+func (m *MsgRegisterSessionKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterSessionKeyResponse) ProtoMessage()    {}
+func (*MsgRegisterSessionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{65}
 }
-
-func (m *MsgCancelRetrievalSession) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterSessionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterSessionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterSessionKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCancelRetrievalSession) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRegisterSessionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterSessionKeyResponse.Merge(m, src)
 }
-
-func (m *MsgCancelRetrievalSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SessionId) > 0 {
-		i -= len(m.SessionId)
-		copy(dAtA[i:], m.SessionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgRegisterSessionKeyResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgCancelRetrievalSessionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRegisterSessionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterSessionKeyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelRetrievalSessionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgRegisterSessionKeyResponse proto.InternalMessageInfo
 
-func (m *MsgCancelRetrievalSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// MsgRevokeSessionKey is sent by the owner, or by the session key itself.
+type MsgRevokeSessionKey struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	SessionKey string `protobuf:"bytes,2,opt,name=session_key,json=sessionKey,proto3" json:"session_key,omitempty"`
 }
 
-func (m *MsgSubmitRetrievalSessionProof) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRevokeSessionKey) Reset()         { *m = MsgRevokeSessionKey{} }
+func (m *MsgRevokeSessionKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeSessionKey) ProtoMessage()    {}
+func (*MsgRevokeSessionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{66}
 }
-
-func (m *MsgSubmitRetrievalSessionProof) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeSessionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgSubmitRetrievalSessionProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Proofs) > 0 {
-		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgRevokeSessionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeSessionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.SessionId) > 0 {
-		i -= len(m.SessionId)
-		copy(dAtA[i:], m.SessionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSubmitRetrievalSessionProofResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRevokeSessionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeSessionKey.Merge(m, src)
 }
-
-func (m *MsgSubmitRetrievalSessionProofResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeSessionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeSessionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeSessionKey.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitRetrievalSessionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_MsgRevokeSessionKey proto.InternalMessageInfo
+
+func (m *MsgRevokeSessionKey) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgProveLiveness) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeSessionKey) GetSessionKey() string {
+	if m != nil {
+		return m.SessionKey
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgProveLiveness) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRevokeSessionKeyResponse struct {
 }
 
-func (m *MsgProveLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProofType != nil {
-		{
-			size := m.ProofType.Size()
-			i -= size
-			if _, err := m.ProofType.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	if m.EpochId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EpochId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.DealId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgRevokeSessionKeyResponse) Reset()         { *m = MsgRevokeSessionKeyResponse{} }
+func (m *MsgRevokeSessionKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeSessionKeyResponse) ProtoMessage()    {}
+func (*MsgRevokeSessionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ebc739066bad25, []int{67}
 }
-
-func (m *MsgProveLiveness_UserReceipt) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeSessionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgProveLiveness_UserReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.UserReceipt != nil {
-		{
-			size, err := m.UserReceipt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgRevokeSessionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeSessionKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *MsgProveLiveness_SystemProof) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeSessionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeSessionKeyResponse.Merge(m, src)
 }
-
-func (m *MsgProveLiveness_SystemProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.SystemProof != nil {
-		{
-			size, err := m.SystemProof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	return len(dAtA) - i, nil
+func (m *MsgRevokeSessionKeyResponse) XXX_Size() int {
+	return m.Size()
 }
-func (m *MsgProveLiveness_UserReceiptBatch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeSessionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeSessionKeyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgProveLiveness_UserReceiptBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.UserReceiptBatch != nil {
-		{
-			size, err := m.UserReceiptBatch.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRevokeSessionKeyResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "nilchain.nilchain.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "nilchain.nilchain.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterProvider)(nil), "nilchain.nilchain.v1.MsgRegisterProvider")
+	proto.RegisterType((*MsgRegisterProviderResponse)(nil), "nilchain.nilchain.v1.MsgRegisterProviderResponse")
+	proto.RegisterType((*MsgCreateDeal)(nil), "nilchain.nilchain.v1.MsgCreateDeal")
+	proto.RegisterType((*MsgCreateDealResponse)(nil), "nilchain.nilchain.v1.MsgCreateDealResponse")
+	proto.RegisterType((*MsgUpdateDealContent)(nil), "nilchain.nilchain.v1.MsgUpdateDealContent")
+	proto.RegisterType((*MsgUpdateDealContentResponse)(nil), "nilchain.nilchain.v1.MsgUpdateDealContentResponse")
+	proto.RegisterType((*EvmCreateDealIntent)(nil), "nilchain.nilchain.v1.EvmCreateDealIntent")
+	proto.RegisterType((*EvmUpdateContentIntent)(nil), "nilchain.nilchain.v1.EvmUpdateContentIntent")
+	proto.RegisterType((*MsgCreateDealFromEvm)(nil), "nilchain.nilchain.v1.MsgCreateDealFromEvm")
+	proto.RegisterType((*MsgCreateDealFromEvmResponse)(nil), "nilchain.nilchain.v1.MsgCreateDealFromEvmResponse")
+	proto.RegisterType((*EvmCloseDealIntent)(nil), "nilchain.nilchain.v1.EvmCloseDealIntent")
+	proto.RegisterType((*EvmTransferDealIntent)(nil), "nilchain.nilchain.v1.EvmTransferDealIntent")
+	proto.RegisterType((*MsgUpdateDealContentFromEvm)(nil), "nilchain.nilchain.v1.MsgUpdateDealContentFromEvm")
+	proto.RegisterType((*MsgUpdateDealContentFromEvmResponse)(nil), "nilchain.nilchain.v1.MsgUpdateDealContentFromEvmResponse")
+	proto.RegisterType((*MsgOpenRetrievalSession)(nil), "nilchain.nilchain.v1.MsgOpenRetrievalSession")
+	proto.RegisterType((*MsgOpenRetrievalSessionResponse)(nil), "nilchain.nilchain.v1.MsgOpenRetrievalSessionResponse")
+	proto.RegisterType((*MsgConfirmRetrievalSession)(nil), "nilchain.nilchain.v1.MsgConfirmRetrievalSession")
+	proto.RegisterType((*MsgConfirmRetrievalSessionResponse)(nil), "nilchain.nilchain.v1.MsgConfirmRetrievalSessionResponse")
+	proto.RegisterType((*MsgCancelRetrievalSession)(nil), "nilchain.nilchain.v1.MsgCancelRetrievalSession")
+	proto.RegisterType((*MsgCancelRetrievalSessionResponse)(nil), "nilchain.nilchain.v1.MsgCancelRetrievalSessionResponse")
+	proto.RegisterType((*MsgSubmitRetrievalSessionProof)(nil), "nilchain.nilchain.v1.MsgSubmitRetrievalSessionProof")
+	proto.RegisterType((*MsgSubmitRetrievalSessionProofResponse)(nil), "nilchain.nilchain.v1.MsgSubmitRetrievalSessionProofResponse")
+	proto.RegisterType((*MsgProveLiveness)(nil), "nilchain.nilchain.v1.MsgProveLiveness")
+	proto.RegisterType((*MsgProveLivenessResponse)(nil), "nilchain.nilchain.v1.MsgProveLivenessResponse")
+	proto.RegisterType((*MsgSignalSaturation)(nil), "nilchain.nilchain.v1.MsgSignalSaturation")
+	proto.RegisterType((*MsgSignalSaturationResponse)(nil), "nilchain.nilchain.v1.MsgSignalSaturationResponse")
+	proto.RegisterType((*MsgStartSlotRepair)(nil), "nilchain.nilchain.v1.MsgStartSlotRepair")
+	proto.RegisterType((*MsgStartSlotRepairResponse)(nil), "nilchain.nilchain.v1.MsgStartSlotRepairResponse")
+	proto.RegisterType((*MsgCompleteSlotRepair)(nil), "nilchain.nilchain.v1.MsgCompleteSlotRepair")
+	proto.RegisterType((*MsgCompleteSlotRepairResponse)(nil), "nilchain.nilchain.v1.MsgCompleteSlotRepairResponse")
+	proto.RegisterType((*MsgAddCredit)(nil), "nilchain.nilchain.v1.MsgAddCredit")
+	proto.RegisterType((*MsgAddCreditResponse)(nil), "nilchain.nilchain.v1.MsgAddCreditResponse")
+	proto.RegisterType((*MsgWithdrawRewards)(nil), "nilchain.nilchain.v1.MsgWithdrawRewards")
+	proto.RegisterType((*MsgWithdrawRewardsResponse)(nil), "nilchain.nilchain.v1.MsgWithdrawRewardsResponse")
+	proto.RegisterType((*MsgTopUpProviderBond)(nil), "nilchain.nilchain.v1.MsgTopUpProviderBond")
+	proto.RegisterType((*MsgTopUpProviderBondResponse)(nil), "nilchain.nilchain.v1.MsgTopUpProviderBondResponse")
+	proto.RegisterType((*MsgUnbondProviderBond)(nil), "nilchain.nilchain.v1.MsgUnbondProviderBond")
+	proto.RegisterType((*MsgUnbondProviderBondResponse)(nil), "nilchain.nilchain.v1.MsgUnbondProviderBondResponse")
+	proto.RegisterType((*MsgUpdateProvider)(nil), "nilchain.nilchain.v1.MsgUpdateProvider")
+	proto.RegisterType((*MsgUpdateProviderResponse)(nil), "nilchain.nilchain.v1.MsgUpdateProviderResponse")
+	proto.RegisterType((*MsgSetProviderStatus)(nil), "nilchain.nilchain.v1.MsgSetProviderStatus")
+	proto.RegisterType((*MsgSetProviderStatusResponse)(nil), "nilchain.nilchain.v1.MsgSetProviderStatusResponse")
+	proto.RegisterType((*MsgDeregisterProvider)(nil), "nilchain.nilchain.v1.MsgDeregisterProvider")
+	proto.RegisterType((*MsgDeregisterProviderResponse)(nil), "nilchain.nilchain.v1.MsgDeregisterProviderResponse")
+	proto.RegisterType((*MsgExtendDeal)(nil), "nilchain.nilchain.v1.MsgExtendDeal")
+	proto.RegisterType((*MsgExtendDealResponse)(nil), "nilchain.nilchain.v1.MsgExtendDealResponse")
+	proto.RegisterType((*MsgCloseDeal)(nil), "nilchain.nilchain.v1.MsgCloseDeal")
+	proto.RegisterType((*MsgCloseDealResponse)(nil), "nilchain.nilchain.v1.MsgCloseDealResponse")
+	proto.RegisterType((*MsgCloseDealFromEvm)(nil), "nilchain.nilchain.v1.MsgCloseDealFromEvm")
+	proto.RegisterType((*MsgCloseDealFromEvmResponse)(nil), "nilchain.nilchain.v1.MsgCloseDealFromEvmResponse")
+	proto.RegisterType((*MsgTransferDealOwnership)(nil), "nilchain.nilchain.v1.MsgTransferDealOwnership")
+	proto.RegisterType((*MsgTransferDealOwnershipResponse)(nil), "nilchain.nilchain.v1.MsgTransferDealOwnershipResponse")
+	proto.RegisterType((*MsgAcceptDealOwnership)(nil), "nilchain.nilchain.v1.MsgAcceptDealOwnership")
+	proto.RegisterType((*MsgAcceptDealOwnershipResponse)(nil), "nilchain.nilchain.v1.MsgAcceptDealOwnershipResponse")
+	proto.RegisterType((*MsgTransferDealOwnershipFromEvm)(nil), "nilchain.nilchain.v1.MsgTransferDealOwnershipFromEvm")
+	proto.RegisterType((*MsgTransferDealOwnershipFromEvmResponse)(nil), "nilchain.nilchain.v1.MsgTransferDealOwnershipFromEvmResponse")
+	proto.RegisterType((*MsgGrantDealAccess)(nil), "nilchain.nilchain.v1.MsgGrantDealAccess")
+	proto.RegisterType((*MsgGrantDealAccessResponse)(nil), "nilchain.nilchain.v1.MsgGrantDealAccessResponse")
+	proto.RegisterType((*MsgRevokeDealAccess)(nil), "nilchain.nilchain.v1.MsgRevokeDealAccess")
+	proto.RegisterType((*MsgRevokeDealAccessResponse)(nil), "nilchain.nilchain.v1.MsgRevokeDealAccessResponse")
+	proto.RegisterType((*MsgSubmitFraudProof)(nil), "nilchain.nilchain.v1.MsgSubmitFraudProof")
+	proto.RegisterType((*MsgSubmitFraudProofResponse)(nil), "nilchain.nilchain.v1.MsgSubmitFraudProofResponse")
+	proto.RegisterType((*MsgRegisterSessionKey)(nil), "nilchain.nilchain.v1.MsgRegisterSessionKey")
+	proto.RegisterType((*MsgRegisterSessionKeyResponse)(nil), "nilchain.nilchain.v1.MsgRegisterSessionKeyResponse")
+	proto.RegisterType((*MsgRevokeSessionKey)(nil), "nilchain.nilchain.v1.MsgRevokeSessionKey")
+	proto.RegisterType((*MsgRevokeSessionKeyResponse)(nil), "nilchain.nilchain.v1.MsgRevokeSessionKeyResponse")
 }
-func (m *MsgProveLiveness_SessionProof) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func init() { proto.RegisterFile("nilchain/nilchain/v1/tx.proto", fileDescriptor_48ebc739066bad25) }
+
+var fileDescriptor_48ebc739066bad25 = []byte{
+	// 3367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0x5d, 0x68, 0x1c, 0xd7,
+	0xbd, 0xf7, 0x48, 0xab, 0x8f, 0xfd, 0x6b, 0xf5, 0x35, 0x96, 0xed, 0xd5, 0x58, 0x92, 0xe5, 0xf1,
+	0x8d, 0x2d, 0x4b, 0xb1, 0x64, 0x49, 0xb1, 0x1d, 0xeb, 0xc6, 0x8e, 0x2d, 0xf9, 0x4b, 0x49, 0x44,
+	0x7c, 0x47, 0xc9, 0xbd, 0x97, 0x1b, 0x6e, 0x87, 0xd1, 0xce, 0xd1, 0x6a, 0xea, 0x9d, 0x99, 0x65,
+	0xce, 0xec, 0x4a, 0x6a, 0x0b, 0x69, 0x03, 0xfd, 0xa0, 0x0f, 0x25, 0x85, 0x3e, 0x95, 0x96, 0x42,
+	0xa1, 0x50, 0x28, 0x14, 0x3f, 0xe4, 0xb1, 0x0d, 0x25, 0x6d, 0x21, 0x14, 0x0a, 0x69, 0xe9, 0x43,
+	0xe9, 0x43, 0x68, 0x13, 0xa8, 0xa1, 0xaf, 0x85, 0x42, 0xdb, 0x97, 0x72, 0x3e, 0x66, 0x76, 0x76,
+	0x3e, 0x76, 0xcf, 0x6e, 0x95, 0xa4, 0x7d, 0x11, 0x3b, 0xff, 0xf3, 0xfb, 0x9f, 0xf3, 0x3f, 0xff,
+	0xaf, 0x39, 0xe7, 0xff, 0x1f, 0xc1, 0xb4, 0x63, 0x55, 0x4a, 0x7b, 0x86, 0xe5, 0x2c, 0x85, 0x3f,
+	0xea, 0xcb, 0x4b, 0xfe, 0xc1, 0x62, 0xd5, 0x73, 0x7d, 0x57, 0x9e, 0x08, 0xa8, 0x8b, 0xe1, 0x8f,
+	0xfa, 0xb2, 0x32, 0x6e, 0xd8, 0x96, 0xe3, 0x2e, 0xd1, 0xbf, 0x0c, 0xa8, 0x9c, 0x2a, 0xb9, 0xd8,
+	0x76, 0xf1, 0x92, 0x8d, 0xcb, 0x64, 0x02, 0x1b, 0x97, 0xf9, 0xc0, 0x24, 0x1b, 0xd0, 0xe9, 0xd3,
+	0x12, 0x7b, 0xe0, 0x43, 0x33, 0x9c, 0x67, 0xc7, 0xc0, 0x68, 0xa9, 0xbe, 0xbc, 0x83, 0x7c, 0x63,
+	0x79, 0xa9, 0xe4, 0x5a, 0x0e, 0x1f, 0x9f, 0x28, 0xbb, 0x65, 0x97, 0xf1, 0x91, 0x5f, 0x9c, 0x7a,
+	0x36, 0x55, 0xe2, 0xaa, 0xe1, 0x19, 0x76, 0x30, 0xf1, 0x6c, 0xfa, 0xa6, 0x0e, 0xab, 0x88, 0x23,
+	0xd4, 0x77, 0x24, 0x18, 0xdd, 0xc2, 0xe5, 0x57, 0xab, 0xa6, 0xe1, 0xa3, 0x87, 0x94, 0x57, 0xbe,
+	0x0a, 0x79, 0xa3, 0xe6, 0xef, 0xb9, 0x9e, 0xe5, 0x1f, 0x16, 0xa5, 0x59, 0x69, 0x2e, 0xbf, 0x5e,
+	0xfc, 0xf5, 0x5b, 0x97, 0x26, 0xb8, 0xcc, 0xb7, 0x4d, 0xd3, 0x43, 0x18, 0x6f, 0xfb, 0x9e, 0xe5,
+	0x94, 0xb5, 0x06, 0x54, 0x7e, 0x1e, 0xfa, 0xd9, 0xea, 0xc5, 0x9e, 0x59, 0x69, 0x6e, 0x68, 0x65,
+	0x6a, 0x31, 0x4d, 0x69, 0x8b, 0x6c, 0x95, 0xf5, 0xfc, 0xbb, 0xef, 0x9f, 0x39, 0xf6, 0xfd, 0x27,
+	0x8f, 0xe7, 0x25, 0x8d, 0xb3, 0xad, 0x5d, 0x7d, 0xe3, 0xc9, 0xe3, 0xf9, 0xc6, 0x84, 0x5f, 0x7d,
+	0xf2, 0x78, 0xfe, 0x5c, 0x28, 0xf8, 0x41, 0x63, 0x0f, 0x31, 0x81, 0xd5, 0x49, 0x38, 0x15, 0x23,
+	0x69, 0x08, 0x57, 0x5d, 0x07, 0x23, 0xf5, 0xaf, 0x3d, 0x70, 0x7c, 0x0b, 0x97, 0x35, 0x54, 0xb6,
+	0xb0, 0x8f, 0xbc, 0x87, 0x9e, 0x5b, 0xb7, 0x4c, 0xe4, 0xc9, 0x2b, 0x30, 0x50, 0xf2, 0x90, 0xe1,
+	0xbb, 0x5e, 0xdb, 0x1d, 0x06, 0x40, 0x59, 0x85, 0x42, 0xc9, 0xa8, 0x1a, 0x3b, 0x56, 0xc5, 0xf2,
+	0x2d, 0xc4, 0x76, 0x99, 0xd7, 0x9a, 0x68, 0xf2, 0x39, 0x18, 0xf6, 0x5d, 0xdf, 0xa8, 0xe8, 0xd8,
+	0x77, 0x3d, 0xa3, 0x8c, 0x8a, 0xbd, 0xb3, 0xd2, 0x5c, 0x4e, 0x2b, 0x50, 0xe2, 0x36, 0xa3, 0xc9,
+	0x53, 0x90, 0x47, 0x8e, 0x59, 0x75, 0x2d, 0xc7, 0xc7, 0xc5, 0xdc, 0x6c, 0xef, 0x5c, 0x5e, 0x6b,
+	0x10, 0xe4, 0x55, 0xc8, 0xed, 0xb8, 0x8e, 0x59, 0xec, 0xa3, 0x4a, 0x9c, 0x5c, 0xe4, 0x42, 0x11,
+	0xe7, 0x58, 0xe4, 0xce, 0xb1, 0xb8, 0xe1, 0x5a, 0xce, 0x7a, 0x8e, 0x68, 0x50, 0xa3, 0x60, 0xf9,
+	0x7f, 0x61, 0x64, 0xd7, 0xb0, 0x2a, 0x35, 0x0f, 0xe9, 0xa6, 0x6b, 0x1b, 0x96, 0x53, 0xec, 0xa7,
+	0xec, 0x0b, 0x19, 0x36, 0xe0, 0x7a, 0xb8, 0xc7, 0x78, 0xee, 0x50, 0x16, 0x3e, 0xe1, 0xf0, 0x6e,
+	0x94, 0xb8, 0xf6, 0x2c, 0x31, 0x4a, 0xa0, 0x03, 0x62, 0x92, 0x0b, 0x19, 0x26, 0x89, 0xeb, 0x58,
+	0xbd, 0x06, 0xa7, 0x53, 0xc8, 0x81, 0x69, 0xe4, 0x22, 0x0c, 0xe0, 0x5a, 0xa9, 0x84, 0x30, 0xa6,
+	0x26, 0x18, 0xd4, 0x82, 0x47, 0xf5, 0x0f, 0x3d, 0x30, 0xbc, 0x85, 0xcb, 0x1b, 0x64, 0x4d, 0x74,
+	0x07, 0x19, 0x95, 0xae, 0xcc, 0x75, 0x01, 0x46, 0xcd, 0x9a, 0x67, 0xf8, 0x96, 0xeb, 0xe8, 0x3b,
+	0x15, 0xb7, 0xf4, 0x88, 0xe8, 0x9a, 0x18, 0x63, 0x24, 0x20, 0xaf, 0x53, 0xaa, 0x7c, 0x16, 0x0a,
+	0x18, 0x79, 0x75, 0xab, 0x84, 0xf4, 0x3d, 0xcb, 0xf1, 0xa9, 0xe2, 0xf3, 0xda, 0x10, 0xa7, 0x3d,
+	0xb0, 0x1c, 0x5f, 0xde, 0x84, 0x71, 0xdb, 0x38, 0xd0, 0x6d, 0xd7, 0xf1, 0xf7, 0x2a, 0x87, 0x3a,
+	0xae, 0x22, 0xc7, 0xa4, 0x1a, 0xce, 0xaf, 0x4f, 0x13, 0xa5, 0xfd, 0xee, 0xfd, 0x33, 0x27, 0x98,
+	0x34, 0xd8, 0x7c, 0xb4, 0x68, 0xb9, 0x4b, 0xb6, 0xe1, 0xef, 0x2d, 0x6e, 0x3a, 0xbe, 0x36, 0x6a,
+	0x1b, 0x07, 0x5b, 0x8c, 0x6d, 0x9b, 0x70, 0xc9, 0xff, 0x05, 0x27, 0x2c, 0xc7, 0xf2, 0x2d, 0xa3,
+	0xa2, 0x23, 0x5c, 0xf2, 0xdc, 0x7d, 0xdd, 0xb0, 0xdd, 0x9a, 0xe3, 0x17, 0x07, 0x44, 0xa6, 0x3b,
+	0xce, 0x79, 0xef, 0x52, 0xd6, 0xdb, 0x94, 0x73, 0x6d, 0x25, 0x6e, 0xa2, 0xb3, 0x19, 0x26, 0x6a,
+	0x68, 0x54, 0x3d, 0x84, 0x13, 0x4d, 0x84, 0xd0, 0x2c, 0xa7, 0x60, 0xc0, 0x44, 0x46, 0x45, 0xb7,
+	0x4c, 0xaa, 0xea, 0x9c, 0xd6, 0x4f, 0x1e, 0x37, 0x4d, 0xf9, 0x3e, 0xc8, 0x06, 0xc6, 0x56, 0xd9,
+	0x41, 0xa6, 0x5e, 0xe5, 0xc6, 0x24, 0x41, 0xd0, 0xdb, 0xd2, 0x1c, 0xe3, 0x01, 0x4f, 0x60, 0x7f,
+	0xac, 0xfe, 0x4c, 0x82, 0x89, 0x30, 0x5e, 0xc9, 0xda, 0x1b, 0xae, 0xe3, 0x23, 0xc7, 0xef, 0xca,
+	0xca, 0x11, 0x71, 0x7b, 0x9a, 0xc4, 0x1d, 0x83, 0xde, 0x92, 0x65, 0xd2, 0xf8, 0xcb, 0x6b, 0xe4,
+	0xa7, 0x2c, 0x43, 0x0e, 0x5b, 0x9f, 0x41, 0xdc, 0x0b, 0xe8, 0xef, 0xb5, 0xeb, 0x71, 0xd5, 0xcd,
+	0xb5, 0x4c, 0x38, 0x11, 0x69, 0xd5, 0x67, 0x61, 0x2a, 0x8d, 0x2e, 0xe0, 0xdf, 0xbf, 0xe8, 0x81,
+	0xe3, 0x77, 0xeb, 0x76, 0x43, 0xf9, 0x9b, 0x6c, 0xff, 0x67, 0x60, 0x88, 0x4b, 0xa2, 0xa3, 0xba,
+	0xcd, 0x74, 0xa0, 0x01, 0x27, 0xdd, 0xad, 0xdb, 0x47, 0xea, 0xd2, 0x77, 0x60, 0xa4, 0xd9, 0x0f,
+	0xc5, 0xfc, 0x79, 0xb8, 0xc9, 0x01, 0xd3, 0x03, 0x63, 0xa0, 0xab, 0xc0, 0x98, 0x80, 0x3e, 0xc7,
+	0x75, 0x4a, 0xa8, 0x38, 0x48, 0xb7, 0xc4, 0x1e, 0xe4, 0x49, 0x18, 0xa4, 0x36, 0x20, 0x06, 0xce,
+	0xd3, 0x5d, 0x0c, 0xd0, 0xe7, 0x4d, 0xf3, 0x85, 0xdc, 0x20, 0x8c, 0x0d, 0xa9, 0x6f, 0x49, 0x70,
+	0xf2, 0x6e, 0xdd, 0x66, 0x76, 0xe0, 0x36, 0x10, 0xd5, 0x67, 0x07, 0xce, 0x33, 0x0d, 0x40, 0x1c,
+	0x46, 0xdf, 0x39, 0xf4, 0x51, 0xa0, 0xf5, 0x3c, 0xa1, 0xac, 0x13, 0x42, 0x43, 0xf8, 0xbe, 0x2c,
+	0xe1, 0xfb, 0x9b, 0x84, 0x57, 0xff, 0xc4, 0x82, 0xa0, 0xe1, 0x03, 0xf7, 0x3c, 0xd7, 0x26, 0x32,
+	0x5d, 0x86, 0x7e, 0x8c, 0x1c, 0x13, 0xb5, 0x8f, 0x01, 0x8e, 0x93, 0x6f, 0x43, 0xbf, 0x45, 0x37,
+	0xcc, 0xdf, 0xbb, 0x17, 0xd3, 0x73, 0x7e, 0x8a, 0xc7, 0x69, 0x9c, 0x91, 0xbc, 0xb6, 0x50, 0xdd,
+	0xd6, 0x49, 0xa4, 0x1a, 0x7e, 0xcd, 0x63, 0xaf, 0xad, 0x82, 0x56, 0x40, 0x75, 0x7b, 0x3b, 0xa0,
+	0xb1, 0x37, 0x01, 0x5f, 0xb4, 0x55, 0xa8, 0x24, 0xf6, 0xa4, 0x5e, 0x83, 0xa9, 0x34, 0x7a, 0xdb,
+	0x9c, 0xa3, 0xbe, 0x0e, 0x32, 0x11, 0xbb, 0xe2, 0xe2, 0x8e, 0xe2, 0x24, 0xd3, 0xae, 0xa1, 0x99,
+	0x7a, 0xb3, 0xcc, 0x94, 0x6b, 0x36, 0xd3, 0x77, 0x24, 0x38, 0x71, 0xb7, 0x6e, 0xbf, 0xe2, 0x19,
+	0x0e, 0xde, 0x45, 0xde, 0x91, 0x08, 0x71, 0x1a, 0xf2, 0x0e, 0xda, 0xd7, 0xdd, 0x7d, 0x07, 0x79,
+	0xdc, 0xc5, 0x06, 0x1d, 0xb4, 0xff, 0x32, 0x79, 0x6e, 0x48, 0x98, 0xcb, 0x92, 0xb0, 0xaf, 0x59,
+	0xc2, 0xbf, 0x4b, 0xf4, 0x35, 0x9b, 0xc8, 0x43, 0xdd, 0xfb, 0xd3, 0x9d, 0x98, 0x3f, 0x3d, 0x9d,
+	0xe9, 0x4f, 0x29, 0x41, 0xd7, 0x99, 0x4b, 0x3d, 0x1f, 0x73, 0xa9, 0x25, 0xd1, 0xec, 0x1b, 0x78,
+	0xd6, 0xf3, 0x70, 0xae, 0xc5, 0xb0, 0x40, 0x2e, 0xfe, 0x5e, 0x2f, 0x3d, 0x3c, 0xbe, 0x5c, 0x45,
+	0x8e, 0x86, 0x7c, 0xcf, 0x42, 0x75, 0xa3, 0xb2, 0x8d, 0x30, 0xb6, 0x5c, 0xe7, 0x68, 0xdf, 0x47,
+	0xcf, 0xc0, 0x60, 0xf0, 0xd6, 0x2c, 0xf6, 0xb6, 0x99, 0x2d, 0x44, 0x12, 0x2d, 0xda, 0x86, 0x63,
+	0xed, 0x22, 0xec, 0xeb, 0x9e, 0xeb, 0xfa, 0xd4, 0x2d, 0x0a, 0x5a, 0x21, 0x20, 0x6a, 0xae, 0xeb,
+	0xcb, 0xe7, 0x61, 0x14, 0xfb, 0x86, 0xe7, 0xeb, 0xb6, 0x59, 0xd3, 0x2d, 0xc7, 0x44, 0x07, 0x3c,
+	0x0d, 0x0d, 0x53, 0xf2, 0x96, 0x59, 0xdb, 0x24, 0x44, 0x79, 0x0e, 0xc6, 0x18, 0x6e, 0xa7, 0xe2,
+	0xee, 0x70, 0x20, 0x49, 0x4b, 0xc3, 0xda, 0x08, 0xa5, 0xaf, 0x57, 0xdc, 0x1d, 0x86, 0x9c, 0x06,
+	0xa0, 0x98, 0x52, 0x78, 0x32, 0xc9, 0x69, 0x79, 0x42, 0xd9, 0x20, 0x84, 0x8c, 0x54, 0x3d, 0x0d,
+	0x80, 0x0e, 0xaa, 0x96, 0x87, 0xb0, 0x6e, 0xf8, 0x34, 0x59, 0xe7, 0xb4, 0x3c, 0xa7, 0xdc, 0xf6,
+	0xd7, 0x9e, 0x8b, 0xbf, 0x6a, 0x17, 0x32, 0x8c, 0x9d, 0x66, 0x0b, 0xf5, 0x16, 0x9c, 0xc9, 0x18,
+	0x0a, 0x8d, 0x4c, 0x52, 0x34, 0x23, 0x05, 0x89, 0xa4, 0xa0, 0xe5, 0x39, 0x65, 0xd3, 0x54, 0x1f,
+	0x4b, 0xa0, 0x90, 0x2c, 0xe4, 0x3a, 0xbb, 0x96, 0x67, 0x1f, 0x89, 0xb1, 0x9b, 0x57, 0xec, 0x89,
+	0xad, 0xc8, 0xbc, 0x3b, 0xba, 0xe3, 0xc5, 0xac, 0x8c, 0x99, 0x2e, 0x93, 0x7a, 0x13, 0xd4, 0xec,
+	0x51, 0x01, 0xe7, 0xfe, 0xa1, 0x04, 0x93, 0x64, 0x02, 0xc3, 0x29, 0xa1, 0xca, 0xc7, 0xb1, 0xe3,
+	0x9b, 0xf1, 0x1d, 0x5f, 0xca, 0xda, 0x71, 0xaa, 0x48, 0xea, 0x0d, 0x38, 0x9b, 0x39, 0x28, 0xb0,
+	0xdf, 0xbf, 0x49, 0x30, 0xb3, 0x85, 0xcb, 0xdb, 0xb5, 0x1d, 0xdb, 0xf2, 0xe3, 0xfc, 0x0f, 0x3d,
+	0xd7, 0xdd, 0xfd, 0x08, 0x36, 0x2d, 0xdf, 0x82, 0xfe, 0x2a, 0x99, 0x1b, 0x17, 0x7b, 0x67, 0x7b,
+	0xe7, 0x86, 0x56, 0xd4, 0xf4, 0x7c, 0xb9, 0x41, 0x7e, 0xd0, 0x73, 0xb0, 0xbb, 0xcb, 0xaf, 0x5a,
+	0x9c, 0x6f, 0x6d, 0x23, 0xae, 0xb6, 0x95, 0x0c, 0xb5, 0xb5, 0xd8, 0x99, 0xba, 0x0e, 0xe7, 0x5b,
+	0x23, 0x04, 0x14, 0xf8, 0xa5, 0x1c, 0x8c, 0x6d, 0xe1, 0x32, 0x39, 0xab, 0xa3, 0x97, 0xac, 0x3a,
+	0x72, 0x10, 0xc6, 0x47, 0x9b, 0x06, 0x27, 0x61, 0x10, 0x55, 0xdd, 0xd2, 0x9e, 0xce, 0x8f, 0x57,
+	0x39, 0x6d, 0x80, 0x3e, 0x6f, 0x9a, 0xf2, 0x8b, 0x50, 0xa8, 0x61, 0xe4, 0xe9, 0x1e, 0x2a, 0x21,
+	0xab, 0xca, 0x52, 0xdd, 0xd0, 0xca, 0xf9, 0x74, 0x6d, 0x86, 0x3b, 0xd4, 0x18, 0xfa, 0xc1, 0x31,
+	0x6d, 0x88, 0x70, 0xf3, 0x47, 0xf9, 0x3e, 0x14, 0xf0, 0x21, 0xf6, 0x91, 0xad, 0x53, 0x1d, 0xf3,
+	0xdb, 0xb4, 0x80, 0x69, 0xc8, 0x44, 0x8c, 0x93, 0x3e, 0xca, 0xaf, 0x81, 0x1c, 0x95, 0x4a, 0xdf,
+	0x31, 0xfc, 0xd2, 0x5e, 0xeb, 0xdb, 0x75, 0x5c, 0xb6, 0x75, 0xc2, 0xf2, 0xe0, 0x98, 0x36, 0x16,
+	0x11, 0x90, 0xd2, 0x64, 0x0d, 0x86, 0x03, 0xcf, 0x62, 0x62, 0x0e, 0x08, 0xcd, 0x1b, 0xb5, 0xea,
+	0x83, 0x63, 0x5a, 0x01, 0x47, 0x9e, 0xd7, 0xae, 0xc4, 0x9d, 0xe9, 0x3f, 0x32, 0x9c, 0xa9, 0xc9,
+	0xca, 0xeb, 0x05, 0x00, 0x2a, 0x82, 0x4e, 0xca, 0x43, 0xaa, 0x0d, 0xc5, 0x38, 0xa2, 0xbd, 0xfb,
+	0x90, 0x1b, 0x96, 0x6f, 0x21, 0x8f, 0x9a, 0x7c, 0x58, 0xa3, 0xbf, 0xc9, 0x1b, 0xcc, 0x43, 0xfb,
+	0x86, 0x67, 0x06, 0xf7, 0x5c, 0x76, 0xe2, 0x29, 0x30, 0x22, 0xbb, 0xc1, 0xaa, 0xdf, 0x92, 0x68,
+	0x99, 0x86, 0x1e, 0x0c, 0x2a, 0xdb, 0x86, 0xcf, 0x6f, 0x33, 0x47, 0xea, 0x7a, 0xe2, 0x95, 0x8c,
+	0xb8, 0x18, 0xea, 0x9b, 0xec, 0x8c, 0x15, 0xa7, 0x0b, 0x68, 0xa4, 0x08, 0x03, 0x36, 0xc2, 0x98,
+	0x54, 0x82, 0x58, 0xb9, 0x28, 0x78, 0x94, 0x6f, 0xc0, 0x30, 0x39, 0x05, 0x36, 0x6e, 0xd2, 0xbd,
+	0x6d, 0x6e, 0xd2, 0x05, 0x07, 0xed, 0x37, 0x2e, 0xd1, 0x7f, 0x96, 0x40, 0x26, 0x22, 0x91, 0xf7,
+	0xf6, 0x76, 0xc5, 0xf5, 0x35, 0x54, 0x35, 0x2c, 0xef, 0x68, 0x63, 0x95, 0x5c, 0x98, 0x2b, 0x2e,
+	0xb3, 0xd8, 0xb0, 0x46, 0x7f, 0xcb, 0x1b, 0x30, 0x46, 0x6e, 0x6b, 0x96, 0x53, 0x0e, 0x45, 0x2f,
+	0xe6, 0xda, 0xac, 0x34, 0xca, 0x39, 0x02, 0xe9, 0xd7, 0xae, 0xc5, 0x2d, 0x71, 0x3e, 0xcb, 0x12,
+	0xcd, 0xdb, 0x53, 0xaf, 0x82, 0x92, 0xa4, 0x0a, 0xe4, 0xb5, 0xbf, 0x48, 0xac, 0xdc, 0xe1, 0xda,
+	0xd5, 0x0a, 0xf2, 0xd1, 0xc7, 0xa9, 0xb0, 0xc6, 0xdb, 0x21, 0xd7, 0xe5, 0xdb, 0x61, 0x2d, 0xae,
+	0xad, 0x8b, 0x99, 0xc7, 0x88, 0xf8, 0xf6, 0xd4, 0xeb, 0x30, 0x9d, 0x3a, 0x20, 0xa0, 0xb3, 0x9f,
+	0x4b, 0x50, 0xd8, 0xc2, 0xe5, 0xdb, 0xa6, 0xb9, 0xe1, 0x21, 0xd3, 0x3a, 0xe2, 0xf2, 0xcc, 0x15,
+	0xe8, 0x8f, 0xe6, 0x83, 0x76, 0xd5, 0x02, 0x0e, 0x5e, 0x5b, 0x8e, 0xeb, 0x62, 0x36, 0x43, 0x17,
+	0xa1, 0xd8, 0xea, 0x7f, 0xc3, 0x44, 0xf4, 0x39, 0xdc, 0xf9, 0x4d, 0x18, 0x22, 0x01, 0xb8, 0x63,
+	0x54, 0x0c, 0x72, 0x94, 0x95, 0x44, 0xc4, 0x00, 0x07, 0xed, 0xaf, 0x33, 0x06, 0xf5, 0x0b, 0x2c,
+	0x02, 0xff, 0xc7, 0xf2, 0xf7, 0x4c, 0xcf, 0xd8, 0xd7, 0x68, 0x3e, 0xeb, 0xea, 0x6d, 0x29, 0x1e,
+	0x0f, 0xb1, 0xc5, 0xc8, 0x81, 0x47, 0x49, 0x92, 0xc3, 0x2d, 0x3e, 0x80, 0x31, 0xa6, 0x37, 0x7d,
+	0x9f, 0x23, 0x1c, 0xb1, 0x7d, 0x8e, 0x32, 0xb6, 0x60, 0x5e, 0x47, 0xbe, 0x47, 0xae, 0x18, 0xb4,
+	0x7a, 0xad, 0xb3, 0xc4, 0xcd, 0xcb, 0xdf, 0xed, 0x26, 0x1a, 0xe1, 0x5c, 0x81, 0x76, 0x5e, 0x80,
+	0xf1, 0x1d, 0xc3, 0x31, 0xf7, 0x2d, 0xd3, 0xdf, 0x0b, 0x67, 0x12, 0xf2, 0x80, 0xb1, 0x90, 0x2f,
+	0xd8, 0xfc, 0xdb, 0xac, 0x84, 0xf2, 0x8a, 0x5b, 0x7d, 0xb5, 0x1a, 0xa4, 0x96, 0x75, 0x52, 0x0c,
+	0xef, 0xc6, 0x51, 0xaf, 0x85, 0xfe, 0xd8, 0x23, 0x56, 0x77, 0x0f, 0x3c, 0x52, 0xb8, 0x82, 0x98,
+	0x90, 0x53, 0x7d, 0x04, 0x53, 0x69, 0xf4, 0xd0, 0x7c, 0x41, 0x27, 0x40, 0xea, 0xa4, 0x13, 0x70,
+	0x12, 0xfa, 0xb1, 0x6f, 0xf8, 0xb5, 0xa0, 0x3f, 0xc1, 0x9f, 0xd4, 0x9f, 0xb0, 0x14, 0xf8, 0xaa,
+	0x43, 0x50, 0x9f, 0x9c, 0xba, 0x84, 0x93, 0x59, 0x52, 0x50, 0xf5, 0x25, 0x98, 0x4e, 0x1d, 0x08,
+	0x15, 0xb6, 0x00, 0xe3, 0x25, 0x96, 0xea, 0xc8, 0x89, 0x6a, 0x0f, 0x59, 0xe5, 0x3d, 0x9f, 0x57,
+	0x94, 0xc6, 0x1a, 0x03, 0x0f, 0x28, 0x5d, 0xfd, 0xa3, 0x04, 0xe3, 0x8d, 0xb6, 0xd1, 0x3f, 0xd3,
+	0x18, 0x6a, 0xea, 0xe7, 0xf4, 0xc4, 0xfb, 0x39, 0x42, 0x2d, 0xa1, 0x78, 0x6f, 0x29, 0x97, 0xec,
+	0x2d, 0xb1, 0xf6, 0x58, 0x54, 0x75, 0x4f, 0xb5, 0x6e, 0x8e, 0x05, 0x7d, 0x98, 0xff, 0x87, 0xc9,
+	0x04, 0x31, 0x54, 0xd9, 0xad, 0x48, 0x59, 0x82, 0xf9, 0xd9, 0x4c, 0xeb, 0x96, 0x11, 0xb7, 0x67,
+	0xc8, 0xa5, 0x7e, 0x9b, 0x85, 0xe1, 0x36, 0xf2, 0x03, 0xc8, 0x36, 0xf5, 0xb8, 0xae, 0x54, 0x99,
+	0xe1, 0xbd, 0xe2, 0x51, 0x96, 0x10, 0x43, 0xbd, 0x0a, 0x53, 0x69, 0xf4, 0x50, 0x03, 0x8d, 0x25,
+	0xa5, 0xa6, 0x80, 0xf9, 0x32, 0x0b, 0x98, 0x3b, 0xc8, 0x3b, 0x82, 0xe6, 0xa1, 0xb8, 0xdf, 0x27,
+	0xd7, 0x53, 0x3f, 0x0b, 0xd3, 0xa9, 0x03, 0xe1, 0x16, 0x2e, 0x81, 0x1c, 0x1c, 0xca, 0x6c, 0xab,
+	0xcc, 0x0e, 0xa7, 0x98, 0x3b, 0xfe, 0x38, 0x1f, 0xd9, 0x0a, 0x07, 0xd2, 0xc3, 0xa4, 0x27, 0x23,
+	0x4c, 0x7e, 0x2c, 0xd1, 0x66, 0xdc, 0xdd, 0x03, 0x1f, 0x39, 0x66, 0xd7, 0xcd, 0xb8, 0xcc, 0x73,
+	0xc0, 0x02, 0x8c, 0x1b, 0xa6, 0x69, 0x91, 0x05, 0x8d, 0x4a, 0xd0, 0xd4, 0x60, 0x11, 0x32, 0xd6,
+	0x18, 0x60, 0x6d, 0x0d, 0xf1, 0x46, 0x57, 0x43, 0x5a, 0xf5, 0x47, 0xcc, 0x8c, 0x0d, 0x4a, 0xa8,
+	0xb5, 0xd3, 0x34, 0x6c, 0xd9, 0x9a, 0x5c, 0x59, 0x83, 0xc8, 0x31, 0xe9, 0x5a, 0xf2, 0x2d, 0x28,
+	0x04, 0xed, 0x39, 0xd3, 0x44, 0xa6, 0xd8, 0xdb, 0x6e, 0x88, 0xb1, 0xdc, 0x26, 0x1c, 0xa4, 0xc1,
+	0xc2, 0x67, 0x08, 0x8e, 0x18, 0x42, 0xef, 0xb9, 0x61, 0xc6, 0x14, 0x9c, 0x32, 0xbe, 0xc6, 0x4e,
+	0x61, 0x61, 0x09, 0xfc, 0x68, 0xaf, 0x44, 0xc2, 0xc7, 0xa9, 0x70, 0x7d, 0xf5, 0x97, 0xbc, 0x71,
+	0x11, 0x10, 0x42, 0x75, 0xde, 0x83, 0xd1, 0x20, 0x27, 0xe8, 0x55, 0xe3, 0xd0, 0xad, 0xf9, 0x62,
+	0x67, 0x8d, 0x91, 0x80, 0xeb, 0x21, 0x65, 0x92, 0xd7, 0x81, 0xab, 0x40, 0xf7, 0xd0, 0x6e, 0xcd,
+	0x11, 0x54, 0x3d, 0xb7, 0x96, 0x46, 0x59, 0xe4, 0x8b, 0x30, 0xc6, 0xef, 0xc4, 0x58, 0xc7, 0xc8,
+	0xf7, 0x2b, 0x28, 0xa8, 0x36, 0x8c, 0x06, 0xf4, 0x6d, 0x46, 0x56, 0x9f, 0xb0, 0xab, 0x67, 0xb8,
+	0x9f, 0xee, 0xeb, 0xe6, 0xb7, 0x62, 0x75, 0xf3, 0xb9, 0xec, 0x3e, 0x4c, 0x73, 0x43, 0xa3, 0xb3,
+	0x9a, 0xf9, 0xb5, 0x58, 0xcd, 0xfc, 0x42, 0x3b, 0x93, 0x05, 0xb5, 0xf2, 0xdf, 0xb0, 0x5b, 0x6c,
+	0x9c, 0xfe, 0xef, 0x6e, 0xc0, 0x9f, 0x4a, 0xb4, 0x56, 0x11, 0x6d, 0xd1, 0xd0, 0x56, 0x0a, 0xde,
+	0xb3, 0xaa, 0x47, 0x9b, 0xab, 0x5a, 0x35, 0x6e, 0xd6, 0x6e, 0xc4, 0x43, 0xe9, 0xe9, 0xac, 0x73,
+	0x60, 0x9a, 0xa0, 0xea, 0x7d, 0x98, 0xcd, 0x1a, 0x0b, 0x0d, 0x74, 0x0e, 0x86, 0x83, 0x34, 0xcf,
+	0x64, 0x60, 0x2f, 0xac, 0x02, 0x27, 0x52, 0x06, 0xf5, 0xbb, 0x12, 0x9c, 0x24, 0xf7, 0x9d, 0x52,
+	0x09, 0x55, 0xfd, 0x8f, 0x4e, 0x19, 0x6b, 0xff, 0x19, 0xdf, 0xef, 0x7c, 0xd6, 0x4d, 0x2c, 0x29,
+	0x89, 0xea, 0xc0, 0x4c, 0xfa, 0x48, 0xb8, 0xd7, 0xa7, 0x60, 0xa4, 0xea, 0xa1, 0xba, 0xe5, 0xd6,
+	0x70, 0xd3, 0x66, 0x87, 0x03, 0x2a, 0x65, 0x21, 0xb0, 0xd0, 0x4f, 0x6c, 0xb7, 0x8e, 0x02, 0x29,
+	0x83, 0xb2, 0x1a, 0xde, 0x22, 0x44, 0xf5, 0x8d, 0x1e, 0x38, 0x93, 0xa5, 0xde, 0xee, 0x03, 0x7e,
+	0x23, 0x16, 0xf0, 0x0b, 0x99, 0x01, 0x9f, 0xec, 0x1f, 0x76, 0x16, 0xf3, 0x1b, 0xb1, 0x98, 0x5f,
+	0xed, 0xc4, 0xb7, 0x82, 0xf8, 0x37, 0xe1, 0x42, 0x1b, 0x48, 0xa8, 0xfd, 0x09, 0xe8, 0x8b, 0x2a,
+	0x9d, 0x3d, 0x24, 0xfd, 0xaf, 0x27, 0xc5, 0xff, 0xde, 0xe9, 0xa1, 0xd7, 0xe2, 0xfb, 0x9e, 0xe1,
+	0x50, 0xd3, 0xde, 0x66, 0x85, 0xb0, 0x23, 0x0d, 0xc4, 0x15, 0x18, 0x28, 0x93, 0xf9, 0x11, 0x6a,
+	0xdb, 0x4a, 0x0b, 0x80, 0xb1, 0xee, 0x54, 0x2e, 0xd6, 0x9d, 0x22, 0xb1, 0x4d, 0x3e, 0x64, 0x60,
+	0xed, 0x7d, 0xd6, 0x3d, 0x1b, 0xb4, 0x8d, 0x03, 0xd6, 0xdd, 0xbf, 0x0a, 0x03, 0x64, 0x70, 0x17,
+	0x21, 0xb1, 0x8f, 0x24, 0xfa, 0x6d, 0xe3, 0xe0, 0x1e, 0x42, 0xe2, 0xf7, 0xfa, 0x98, 0xb6, 0xd4,
+	0x29, 0x50, 0x92, 0xd4, 0xf0, 0xa3, 0xb6, 0xf7, 0x24, 0xfe, 0x51, 0x5b, 0xdd, 0x7d, 0x84, 0xfe,
+	0x85, 0x74, 0xdc, 0xc9, 0xb7, 0x62, 0xcd, 0xa2, 0xab, 0xd3, 0x70, 0x3a, 0x85, 0x1c, 0xee, 0xf8,
+	0x9b, 0xbd, 0x70, 0x3c, 0x6c, 0x6e, 0xdc, 0xf3, 0x8c, 0x9a, 0xd9, 0x7d, 0x37, 0xe7, 0x88, 0x3b,
+	0xb4, 0x33, 0x00, 0x65, 0xe4, 0x20, 0x76, 0xb6, 0xe6, 0x7e, 0x15, 0xa1, 0x24, 0x3b, 0xb8, 0x7d,
+	0x29, 0x1d, 0xdc, 0x9b, 0xd0, 0xc7, 0xea, 0xff, 0xfd, 0xa2, 0x6d, 0x0a, 0x7e, 0x0d, 0x63, 0x6c,
+	0xa4, 0xf0, 0x68, 0x1a, 0xbe, 0x41, 0xdb, 0x07, 0x05, 0x8d, 0xfe, 0xa6, 0x97, 0x82, 0xe0, 0x75,
+	0xde, 0xc8, 0x2e, 0x83, 0x14, 0x31, 0x1e, 0x8c, 0xc4, 0xbe, 0xee, 0x10, 0xaa, 0x8e, 0xc7, 0x8c,
+	0xa0, 0x3a, 0x70, 0x3a, 0x85, 0x1c, 0xbd, 0x5f, 0x79, 0xc8, 0xc0, 0xae, 0x13, 0xdc, 0xaf, 0xd8,
+	0x13, 0x29, 0x21, 0xb0, 0x02, 0x90, 0x70, 0x09, 0x81, 0xc1, 0xd5, 0x5f, 0xf5, 0xc0, 0x89, 0xc8,
+	0x87, 0x85, 0xbc, 0x19, 0xf2, 0x22, 0x3a, 0xec, 0xca, 0x1d, 0xae, 0xc3, 0x50, 0xd0, 0x82, 0x79,
+	0x84, 0x0e, 0x8b, 0x3d, 0x6d, 0xf8, 0x00, 0x37, 0x96, 0x9b, 0x84, 0x41, 0xee, 0x49, 0xac, 0x7a,
+	0x9f, 0xd3, 0x06, 0x98, 0x2b, 0xe1, 0x4f, 0x24, 0xdb, 0x08, 0x5f, 0x31, 0x93, 0x9a, 0x53, 0xcf,
+	0xc0, 0x74, 0xea, 0x40, 0x18, 0x81, 0x6f, 0x47, 0x73, 0xce, 0x27, 0xa6, 0xf2, 0x4e, 0x33, 0x4c,
+	0x64, 0x87, 0xd1, 0x0c, 0x93, 0xdc, 0xdf, 0xca, 0x0f, 0xa6, 0xa1, 0x77, 0x0b, 0x97, 0x65, 0x13,
+	0x0a, 0x4d, 0x1f, 0x43, 0x3f, 0x95, 0x1e, 0x8a, 0xb1, 0xef, 0x8d, 0x95, 0x4b, 0x42, 0xb0, 0x30,
+	0x26, 0xaa, 0x30, 0x96, 0xf8, 0x24, 0xf9, 0x62, 0xe6, 0x14, 0x71, 0xa8, 0xb2, 0x2c, 0x0c, 0x0d,
+	0x57, 0xfc, 0x14, 0x40, 0xe4, 0x7b, 0xda, 0x73, 0x99, 0x13, 0x34, 0x40, 0xca, 0x82, 0x00, 0x28,
+	0x9c, 0x1f, 0xc3, 0x78, 0xf2, 0x83, 0xce, 0xf9, 0x36, 0x5a, 0x89, 0x60, 0x95, 0x15, 0x71, 0x6c,
+	0x74, 0xd1, 0xe4, 0x07, 0x74, 0xf3, 0x02, 0x62, 0x73, 0xac, 0xb2, 0x22, 0x8e, 0x0d, 0x17, 0xfd,
+	0x8a, 0x04, 0xc5, 0xcc, 0xaf, 0xad, 0x96, 0xc5, 0x77, 0x11, 0xc8, 0x70, 0xbd, 0x63, 0x96, 0x50,
+	0x94, 0xcf, 0xc1, 0x44, 0xea, 0x87, 0x4b, 0xd9, 0xde, 0x98, 0x06, 0x57, 0xae, 0x74, 0x04, 0x0f,
+	0x57, 0xff, 0xa2, 0x04, 0xa7, 0xb2, 0xbe, 0xa6, 0xb9, 0x9c, 0xad, 0xd8, 0x74, 0x0e, 0xe5, 0xd9,
+	0x4e, 0x39, 0x42, 0x39, 0xde, 0x90, 0xe0, 0x64, 0xc6, 0x27, 0x2e, 0x4b, 0xd9, 0x93, 0xa6, 0x32,
+	0x28, 0xd7, 0x3a, 0x64, 0x08, 0x85, 0xf8, 0xba, 0x04, 0xa7, 0x5b, 0x7d, 0x77, 0xf2, 0x4c, 0xe6,
+	0xc4, 0x2d, 0xb8, 0x94, 0xe7, 0xba, 0xe1, 0x0a, 0x65, 0x2a, 0xc3, 0x70, 0xf3, 0x97, 0x1c, 0xe7,
+	0x33, 0xa7, 0x6b, 0xc2, 0x29, 0x8b, 0x62, 0xb8, 0x68, 0x3a, 0x4b, 0xb4, 0xee, 0xb3, 0xd3, 0x59,
+	0x1c, 0xaa, 0x2c, 0x0b, 0x43, 0xc3, 0x15, 0x6d, 0x18, 0x8d, 0xb7, 0xbe, 0xe7, 0xb2, 0x67, 0x69,
+	0x46, 0x2a, 0x97, 0x45, 0x91, 0xe1, 0x72, 0x75, 0x90, 0x53, 0x7a, 0xc7, 0x2d, 0x12, 0x64, 0x02,
+	0xac, 0xac, 0x76, 0x00, 0x0e, 0xd7, 0x7d, 0x0d, 0xf2, 0x8d, 0xfe, 0xab, 0x9a, 0x39, 0x43, 0x88,
+	0x51, 0xe6, 0xdb, 0x63, 0xa2, 0x3a, 0x8c, 0x37, 0x2f, 0xb3, 0x75, 0x18, 0x43, 0x2a, 0x97, 0x45,
+	0x91, 0xd1, 0x64, 0x9d, 0x6c, 0xd5, 0x65, 0xcb, 0x9b, 0xc0, 0x2a, 0x2b, 0xe2, 0xd8, 0xa8, 0xe1,
+	0x52, 0x3a, 0x5e, 0xd9, 0x86, 0x4b, 0x82, 0x95, 0xd5, 0x0e, 0xc0, 0xe1, 0xba, 0x9f, 0x86, 0x91,
+	0x58, 0x63, 0xe9, 0x42, 0xbb, 0x13, 0x42, 0xf0, 0x72, 0x5f, 0x12, 0x04, 0x46, 0x15, 0x9b, 0x6c,
+	0xbe, 0x64, 0x2b, 0x36, 0x81, 0x55, 0x56, 0xc4, 0xb1, 0x51, 0xc5, 0xa6, 0x74, 0x46, 0xb2, 0x15,
+	0x9b, 0x04, 0x2b, 0xab, 0x1d, 0x80, 0xa3, 0xe7, 0x98, 0x48, 0x2b, 0x22, 0xfb, 0x1c, 0xd3, 0x00,
+	0x29, 0x0b, 0x02, 0xa0, 0x68, 0xc4, 0x35, 0x6a, 0xed, 0xd9, 0x11, 0x17, 0x62, 0x94, 0xf9, 0xf6,
+	0x98, 0x68, 0x9e, 0x4c, 0xd4, 0x99, 0x2f, 0xb6, 0xe7, 0x0f, 0x4e, 0x0a, 0xcb, 0xc2, 0xd0, 0x70,
+	0xc5, 0xd7, 0xe1, 0x44, 0x7a, 0x61, 0x34, 0x3b, 0xc5, 0xa7, 0xe2, 0x95, 0xab, 0x9d, 0xe1, 0x43,
+	0x01, 0x0e, 0xe1, 0x78, 0x5a, 0x29, 0xf2, 0xe9, 0xec, 0x3c, 0x95, 0x44, 0x2b, 0xcf, 0x74, 0x82,
+	0x0e, 0x97, 0xfe, 0x86, 0x04, 0x53, 0x2d, 0x2b, 0x7e, 0x57, 0x3a, 0xdb, 0x53, 0x60, 0x86, 0x1b,
+	0x5d, 0xb1, 0x45, 0xd3, 0x6e, 0xbc, 0x38, 0x96, 0x9d, 0x76, 0x63, 0x48, 0xe5, 0xb2, 0x28, 0xb2,
+	0xf9, 0xaa, 0x11, 0x2b, 0x14, 0xb5, 0xba, 0x6a, 0x34, 0x43, 0x95, 0x65, 0x61, 0x68, 0xd3, 0x69,
+	0x20, 0x5e, 0xa8, 0xb9, 0xd8, 0xe6, 0x20, 0xd3, 0x80, 0x2a, 0xcb, 0xc2, 0xd0, 0x68, 0x32, 0x4a,
+	0xa9, 0x06, 0x2c, 0xb4, 0xbd, 0x25, 0x35, 0xc0, 0xca, 0x6a, 0x07, 0xe0, 0xa4, 0x6e, 0x23, 0xab,
+	0xb6, 0xd3, 0x6d, 0x64, 0xcd, 0x65, 0x61, 0x68, 0xb0, 0xa2, 0xd2, 0xf7, 0x79, 0xf2, 0x1f, 0xb3,
+	0xeb, 0xab, 0xef, 0x7e, 0x30, 0x23, 0xbd, 0xf7, 0xc1, 0x8c, 0xf4, 0xfb, 0x0f, 0x66, 0xa4, 0x37,
+	0x3f, 0x9c, 0x39, 0xf6, 0xde, 0x87, 0x33, 0xc7, 0x7e, 0xfb, 0xe1, 0xcc, 0xb1, 0xff, 0x9b, 0x4c,
+	0xbb, 0x0f, 0xd3, 0xff, 0xf8, 0xdd, 0xe9, 0xa7, 0xff, 0xf2, 0xbb, 0xfa, 0x8f, 0x01, 0x00, 0x89,
+	0xc6, 0xeb, 0x0f, 0xeb, 0x3c, 0x00, 0x00,
 }
 
-func (m *MsgProveLiveness_SessionProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.SessionProof != nil {
-		{
-			size, err := m.SessionProof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// MsgRegisterProvider allows a Storage Provider to register themselves with the network.
+	RegisterProvider(ctx context.Context, in *MsgRegisterProvider, opts ...grpc.CallOption) (*MsgRegisterProviderResponse, error)
+	// MsgCreateDeal allows a user to create a new storage deal.
+	CreateDeal(ctx context.Context, in *MsgCreateDeal, opts ...grpc.CallOption) (*MsgCreateDealResponse, error)
+	// MsgUpdateDealContent allows a user to commit or update the content (manifest) of a deal.
+	UpdateDealContent(ctx context.Context, in *MsgUpdateDealContent, opts ...grpc.CallOption) (*MsgUpdateDealContentResponse, error)
+	// MsgCreateDealFromEvm allows a user to create a new storage deal
+	// using an EVM-signed intent bridged into nilchaind.
+	CreateDealFromEvm(ctx context.Context, in *MsgCreateDealFromEvm, opts ...grpc.CallOption) (*MsgCreateDealFromEvmResponse, error)
+	// MsgUpdateDealContentFromEvm allows a user to update deal content
+	// using an EVM-signed intent.
+	UpdateDealContentFromEvm(ctx context.Context, in *MsgUpdateDealContentFromEvm, opts ...grpc.CallOption) (*MsgUpdateDealContentFromEvmResponse, error)
+	// MsgOpenRetrievalSession opens an on-chain retrieval session for a contiguous blob-range.
+	OpenRetrievalSession(ctx context.Context, in *MsgOpenRetrievalSession, opts ...grpc.CallOption) (*MsgOpenRetrievalSessionResponse, error)
+	// MsgConfirmRetrievalSession confirms completion of a retrieval session (proof-of-validation).
+	ConfirmRetrievalSession(ctx context.Context, in *MsgConfirmRetrievalSession, opts ...grpc.CallOption) (*MsgConfirmRetrievalSessionResponse, error)
+	// MsgCancelRetrievalSession cancels an expired retrieval session and unlocks fees.
+	CancelRetrievalSession(ctx context.Context, in *MsgCancelRetrievalSession, opts ...grpc.CallOption) (*MsgCancelRetrievalSessionResponse, error)
+	// MsgSubmitRetrievalSessionProof submits proof-of-retrieval for a session (provider action).
+	SubmitRetrievalSessionProof(ctx context.Context, in *MsgSubmitRetrievalSessionProof, opts ...grpc.CallOption) (*MsgSubmitRetrievalSessionProofResponse, error)
+	// MsgProveLiveness allows a Storage Provider to submit a proof of data liveness.
+	ProveLiveness(ctx context.Context, in *MsgProveLiveness, opts ...grpc.CallOption) (*MsgProveLivenessResponse, error)
+	// MsgSignalSaturation allows a Storage Provider to signal high load for a deal.
+	SignalSaturation(ctx context.Context, in *MsgSignalSaturation, opts ...grpc.CallOption) (*MsgSignalSaturationResponse, error)
+	// MsgStartSlotRepair marks a Mode 2 slot as repairing and sets a replacement candidate.
+	StartSlotRepair(ctx context.Context, in *MsgStartSlotRepair, opts ...grpc.CallOption) (*MsgStartSlotRepairResponse, error)
+	// MsgCompleteSlotRepair lets the pending provider prove it holds the slot and take it over.
+	CompleteSlotRepair(ctx context.Context, in *MsgCompleteSlotRepair, opts ...grpc.CallOption) (*MsgCompleteSlotRepairResponse, error)
+	// MsgAddCredit allows a user to top up the escrow balance for a deal.
+	AddCredit(ctx context.Context, in *MsgAddCredit, opts ...grpc.CallOption) (*MsgAddCreditResponse, error)
+	// MsgWithdrawRewards allows a Storage Provider to withdraw accumulated rewards.
+	WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards, opts ...grpc.CallOption) (*MsgWithdrawRewardsResponse, error)
+	// MsgTopUpProviderBond adds collateral to a provider's bond.
+	TopUpProviderBond(ctx context.Context, in *MsgTopUpProviderBond, opts ...grpc.CallOption) (*MsgTopUpProviderBondResponse, error)
+	// MsgUnbondProviderBond starts unbonding collateral in excess of the required bond.
+	UnbondProviderBond(ctx context.Context, in *MsgUnbondProviderBond, opts ...grpc.CallOption) (*MsgUnbondProviderBondResponse, error)
+	// MsgUpdateProvider changes a registered provider's endpoints, capacity or capabilities.
+	UpdateProvider(ctx context.Context, in *MsgUpdateProvider, opts ...grpc.CallOption) (*MsgUpdateProviderResponse, error)
+	// MsgSetProviderStatus lets a provider pause or resume new deal assignments.
+	SetProviderStatus(ctx context.Context, in *MsgSetProviderStatus, opts ...grpc.CallOption) (*MsgSetProviderStatusResponse, error)
+	// MsgDeregisterProvider starts migrating a provider's assignments so it can leave the network.
+	DeregisterProvider(ctx context.Context, in *MsgDeregisterProvider, opts ...grpc.CallOption) (*MsgDeregisterProviderResponse, error)
+	// MsgExtendDeal extends a deal's end block, topping up escrow at the storage price.
+	ExtendDeal(ctx context.Context, in *MsgExtendDeal, opts ...grpc.CallOption) (*MsgExtendDealResponse, error)
+	// MsgCloseDeal terminates a deal early and settles its escrow pro rata.
+	CloseDeal(ctx context.Context, in *MsgCloseDeal, opts ...grpc.CallOption) (*MsgCloseDealResponse, error)
+	// MsgCloseDealFromEvm closes a deal via an EVM-signed intent.
+	CloseDealFromEvm(ctx context.Context, in *MsgCloseDealFromEvm, opts ...grpc.CallOption) (*MsgCloseDealFromEvmResponse, error)
+	// MsgTransferDealOwnership proposes (or cancels) handing a deal to a new owner.
+	TransferDealOwnership(ctx context.Context, in *MsgTransferDealOwnership, opts ...grpc.CallOption) (*MsgTransferDealOwnershipResponse, error)
+	// MsgAcceptDealOwnership completes a proposed ownership transfer.
+	AcceptDealOwnership(ctx context.Context, in *MsgAcceptDealOwnership, opts ...grpc.CallOption) (*MsgAcceptDealOwnershipResponse, error)
+	// MsgTransferDealOwnershipFromEvm proposes or accepts a transfer via an EVM-signed intent.
+	TransferDealOwnershipFromEvm(ctx context.Context, in *MsgTransferDealOwnershipFromEvm, opts ...grpc.CallOption) (*MsgTransferDealOwnershipFromEvmResponse, error)
+	// MsgGrantDealAccess lets another account read a deal on the owner's behalf.
+	GrantDealAccess(ctx context.Context, in *MsgGrantDealAccess, opts ...grpc.CallOption) (*MsgGrantDealAccessResponse, error)
+	// MsgRevokeDealAccess removes a read grant.
+	RevokeDealAccess(ctx context.Context, in *MsgRevokeDealAccess, opts ...grpc.CallOption) (*MsgRevokeDealAccessResponse, error)
+	// MsgSubmitFraudProof proves that a provider served wrong data for a deal.
+	SubmitFraudProof(ctx context.Context, in *MsgSubmitFraudProof, opts ...grpc.CallOption) (*MsgSubmitFraudProofResponse, error)
+	// MsgRegisterSessionKey lets a short-lived key sign retrievals for some of the owner's deals.
+	RegisterSessionKey(ctx context.Context, in *MsgRegisterSessionKey, opts ...grpc.CallOption) (*MsgRegisterSessionKeyResponse, error)
+	// MsgRevokeSessionKey removes a session key before it expires.
+	RevokeSessionKey(ctx context.Context, in *MsgRevokeSessionKey, opts ...grpc.CallOption) (*MsgRevokeSessionKeyResponse, error)
 }
-func (m *MsgProveLivenessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgProveLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterProvider(ctx context.Context, in *MsgRegisterProvider, opts ...grpc.CallOption) (*MsgRegisterProviderResponse, error) {
+	out := new(MsgRegisterProviderResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/RegisterProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgProveLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RewardAmount) > 0 {
-		i -= len(m.RewardAmount)
-		copy(dAtA[i:], m.RewardAmount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardAmount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Tier != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Tier))
-		i--
-		dAtA[i] = 0x10
+func (c *msgClient) CreateDeal(ctx context.Context, in *MsgCreateDeal, opts ...grpc.CallOption) (*MsgCreateDealResponse, error) {
+	out := new(MsgCreateDealResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/CreateDeal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *msgClient) UpdateDealContent(ctx context.Context, in *MsgUpdateDealContent, opts ...grpc.CallOption) (*MsgUpdateDealContentResponse, error) {
+	out := new(MsgUpdateDealContentResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/UpdateDealContent", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSignalSaturation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateDealFromEvm(ctx context.Context, in *MsgCreateDealFromEvm, opts ...grpc.CallOption) (*MsgCreateDealFromEvmResponse, error) {
+	out := new(MsgCreateDealFromEvmResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/CreateDealFromEvm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSignalSaturation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateDealContentFromEvm(ctx context.Context, in *MsgUpdateDealContentFromEvm, opts ...grpc.CallOption) (*MsgUpdateDealContentFromEvmResponse, error) {
+	out := new(MsgUpdateDealContentFromEvmResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/UpdateDealContentFromEvm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSignalSaturation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DealId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
-		i--
-		dAtA[i] = 0x10
+func (c *msgClient) OpenRetrievalSession(ctx context.Context, in *MsgOpenRetrievalSession, opts ...grpc.CallOption) (*MsgOpenRetrievalSessionResponse, error) {
+	out := new(MsgOpenRetrievalSessionResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/OpenRetrievalSession", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) ConfirmRetrievalSession(ctx context.Context, in *MsgConfirmRetrievalSession, opts ...grpc.CallOption) (*MsgConfirmRetrievalSessionResponse, error) {
+	out := new(MsgConfirmRetrievalSessionResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/ConfirmRetrievalSession", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSignalSaturationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelRetrievalSession(ctx context.Context, in *MsgCancelRetrievalSession, opts ...grpc.CallOption) (*MsgCancelRetrievalSessionResponse, error) {
+	out := new(MsgCancelRetrievalSessionResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/CancelRetrievalSession", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSignalSaturationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitRetrievalSessionProof(ctx context.Context, in *MsgSubmitRetrievalSessionProof, opts ...grpc.CallOption) (*MsgSubmitRetrievalSessionProofResponse, error) {
+	out := new(MsgSubmitRetrievalSessionProofResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/SubmitRetrievalSessionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSignalSaturationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewProviders) > 0 {
-		for iNdEx := len(m.NewProviders) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.NewProviders[iNdEx])
-			copy(dAtA[i:], m.NewProviders[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.NewProviders[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) ProveLiveness(ctx context.Context, in *MsgProveLiveness, opts ...grpc.CallOption) (*MsgProveLivenessResponse, error) {
+	out := new(MsgProveLivenessResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/ProveLiveness", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgStartSlotRepair) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SignalSaturation(ctx context.Context, in *MsgSignalSaturation, opts ...grpc.CallOption) (*MsgSignalSaturationResponse, error) {
+	out := new(MsgSignalSaturationResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/SignalSaturation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgStartSlotRepair) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) StartSlotRepair(ctx context.Context, in *MsgStartSlotRepair, opts ...grpc.CallOption) (*MsgStartSlotRepairResponse, error) {
+	out := new(MsgStartSlotRepairResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/StartSlotRepair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgStartSlotRepair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PendingProvider) > 0 {
-		i -= len(m.PendingProvider)
-		copy(dAtA[i:], m.PendingProvider)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PendingProvider)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Slot != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Slot))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.DealId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CompleteSlotRepair(ctx context.Context, in *MsgCompleteSlotRepair, opts ...grpc.CallOption) (*MsgCompleteSlotRepairResponse, error) {
+	out := new(MsgCompleteSlotRepairResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/CompleteSlotRepair", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgStartSlotRepairResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddCredit(ctx context.Context, in *MsgAddCredit, opts ...grpc.CallOption) (*MsgAddCreditResponse, error) {
+	out := new(MsgAddCreditResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/AddCredit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgStartSlotRepairResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards, opts ...grpc.CallOption) (*MsgWithdrawRewardsResponse, error) {
+	out := new(MsgWithdrawRewardsResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/WithdrawRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgStartSlotRepairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) TopUpProviderBond(ctx context.Context, in *MsgTopUpProviderBond, opts ...grpc.CallOption) (*MsgTopUpProviderBondResponse, error) {
+	out := new(MsgTopUpProviderBondResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/TopUpProviderBond", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCompleteSlotRepair) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UnbondProviderBond(ctx context.Context, in *MsgUnbondProviderBond, opts ...grpc.CallOption) (*MsgUnbondProviderBondResponse, error) {
+	out := new(MsgUnbondProviderBondResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/UnbondProviderBond", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCompleteSlotRepair) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateProvider(ctx context.Context, in *MsgUpdateProvider, opts ...grpc.CallOption) (*MsgUpdateProviderResponse, error) {
+	out := new(MsgUpdateProviderResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/UpdateProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCompleteSlotRepair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Slot))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.DealId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SetProviderStatus(ctx context.Context, in *MsgSetProviderStatus, opts ...grpc.CallOption) (*MsgSetProviderStatusResponse, error) {
+	out := new(MsgSetProviderStatusResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/SetProviderStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCompleteSlotRepairResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeregisterProvider(ctx context.Context, in *MsgDeregisterProvider, opts ...grpc.CallOption) (*MsgDeregisterProviderResponse, error) {
+	out := new(MsgDeregisterProviderResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/DeregisterProvider", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCompleteSlotRepairResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ExtendDeal(ctx context.Context, in *MsgExtendDeal, opts ...grpc.CallOption) (*MsgExtendDealResponse, error) {
+	out := new(MsgExtendDealResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/ExtendDeal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCompleteSlotRepairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) CloseDeal(ctx context.Context, in *MsgCloseDeal, opts ...grpc.CallOption) (*MsgCloseDealResponse, error) {
+	out := new(MsgCloseDealResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/CloseDeal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAddCredit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CloseDealFromEvm(ctx context.Context, in *MsgCloseDealFromEvm, opts ...grpc.CallOption) (*MsgCloseDealFromEvmResponse, error) {
+	out := new(MsgCloseDealFromEvmResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/CloseDealFromEvm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAddCredit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TransferDealOwnership(ctx context.Context, in *MsgTransferDealOwnership, opts ...grpc.CallOption) (*MsgTransferDealOwnershipResponse, error) {
+	out := new(MsgTransferDealOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/TransferDealOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAddCredit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.DealId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
-		i--
-		dAtA[i] = 0x10
+func (c *msgClient) AcceptDealOwnership(ctx context.Context, in *MsgAcceptDealOwnership, opts ...grpc.CallOption) (*MsgAcceptDealOwnershipResponse, error) {
+	out := new(MsgAcceptDealOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/AcceptDealOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) TransferDealOwnershipFromEvm(ctx context.Context, in *MsgTransferDealOwnershipFromEvm, opts ...grpc.CallOption) (*MsgTransferDealOwnershipFromEvmResponse, error) {
+	out := new(MsgTransferDealOwnershipFromEvmResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/TransferDealOwnershipFromEvm", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAddCreditResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) GrantDealAccess(ctx context.Context, in *MsgGrantDealAccess, opts ...grpc.CallOption) (*MsgGrantDealAccessResponse, error) {
+	out := new(MsgGrantDealAccessResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/GrantDealAccess", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAddCreditResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RevokeDealAccess(ctx context.Context, in *MsgRevokeDealAccess, opts ...grpc.CallOption) (*MsgRevokeDealAccessResponse, error) {
+	out := new(MsgRevokeDealAccessResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/RevokeDealAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAddCreditResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.NewBalance.Size()
-		i -= size
-		if _, err := m.NewBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgWithdrawRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitFraudProof(ctx context.Context, in *MsgSubmitFraudProof, opts ...grpc.CallOption) (*MsgSubmitFraudProofResponse, error) {
+	out := new(MsgSubmitFraudProofResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/SubmitFraudProof", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgWithdrawRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgWithdrawRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RegisterSessionKey(ctx context.Context, in *MsgRegisterSessionKey, opts ...grpc.CallOption) (*MsgRegisterSessionKeyResponse, error) {
+	out := new(MsgRegisterSessionKeyResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/RegisterSessionKey", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgWithdrawRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RevokeSessionKey(ctx context.Context, in *MsgRevokeSessionKey, opts ...grpc.CallOption) (*MsgRevokeSessionKeyResponse, error) {
+	out := new(MsgRevokeSessionKeyResponse)
+	err := c.cc.Invoke(ctx, "/nilchain.nilchain.v1.Msg/RevokeSessionKey", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgWithdrawRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// MsgRegisterProvider allows a Storage Provider to register themselves with the network.
+	RegisterProvider(context.Context, *MsgRegisterProvider) (*MsgRegisterProviderResponse, error)
+	// MsgCreateDeal allows a user to create a new storage deal.
+	CreateDeal(context.Context, *MsgCreateDeal) (*MsgCreateDealResponse, error)
+	// MsgUpdateDealContent allows a user to commit or update the content (manifest) of a deal.
+	UpdateDealContent(context.Context, *MsgUpdateDealContent) (*MsgUpdateDealContentResponse, error)
+	// MsgCreateDealFromEvm allows a user to create a new storage deal
+	// using an EVM-signed intent bridged into nilchaind.
+	CreateDealFromEvm(context.Context, *MsgCreateDealFromEvm) (*MsgCreateDealFromEvmResponse, error)
+	// MsgUpdateDealContentFromEvm allows a user to update deal content
+	// using an EVM-signed intent.
+	UpdateDealContentFromEvm(context.Context, *MsgUpdateDealContentFromEvm) (*MsgUpdateDealContentFromEvmResponse, error)
+	// MsgOpenRetrievalSession opens an on-chain retrieval session for a contiguous blob-range.
+	OpenRetrievalSession(context.Context, *MsgOpenRetrievalSession) (*MsgOpenRetrievalSessionResponse, error)
+	// MsgConfirmRetrievalSession confirms completion of a retrieval session (proof-of-validation).
+	ConfirmRetrievalSession(context.Context, *MsgConfirmRetrievalSession) (*MsgConfirmRetrievalSessionResponse, error)
+	// MsgCancelRetrievalSession cancels an expired retrieval session and unlocks fees.
+	CancelRetrievalSession(context.Context, *MsgCancelRetrievalSession) (*MsgCancelRetrievalSessionResponse, error)
+	// MsgSubmitRetrievalSessionProof submits proof-of-retrieval for a session (provider action).
+	SubmitRetrievalSessionProof(context.Context, *MsgSubmitRetrievalSessionProof) (*MsgSubmitRetrievalSessionProofResponse, error)
+	// MsgProveLiveness allows a Storage Provider to submit a proof of data liveness.
+	ProveLiveness(context.Context, *MsgProveLiveness) (*MsgProveLivenessResponse, error)
+	// MsgSignalSaturation allows a Storage Provider to signal high load for a deal.
+	SignalSaturation(context.Context, *MsgSignalSaturation) (*MsgSignalSaturationResponse, error)
+	// MsgStartSlotRepair marks a Mode 2 slot as repairing and sets a replacement candidate.
+	StartSlotRepair(context.Context, *MsgStartSlotRepair) (*MsgStartSlotRepairResponse, error)
+	// MsgCompleteSlotRepair lets the pending provider prove it holds the slot and take it over.
+	CompleteSlotRepair(context.Context, *MsgCompleteSlotRepair) (*MsgCompleteSlotRepairResponse, error)
+	// MsgAddCredit allows a user to top up the escrow balance for a deal.
+	AddCredit(context.Context, *MsgAddCredit) (*MsgAddCreditResponse, error)
+	// MsgWithdrawRewards allows a Storage Provider to withdraw accumulated rewards.
+	WithdrawRewards(context.Context, *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error)
+	// MsgTopUpProviderBond adds collateral to a provider's bond.
+	TopUpProviderBond(context.Context, *MsgTopUpProviderBond) (*MsgTopUpProviderBondResponse, error)
+	// MsgUnbondProviderBond starts unbonding collateral in excess of the required bond.
+	UnbondProviderBond(context.Context, *MsgUnbondProviderBond) (*MsgUnbondProviderBondResponse, error)
+	// MsgUpdateProvider changes a registered provider's endpoints, capacity or capabilities.
+	UpdateProvider(context.Context, *MsgUpdateProvider) (*MsgUpdateProviderResponse, error)
+	// MsgSetProviderStatus lets a provider pause or resume new deal assignments.
+	SetProviderStatus(context.Context, *MsgSetProviderStatus) (*MsgSetProviderStatusResponse, error)
+	// MsgDeregisterProvider starts migrating a provider's assignments so it can leave the network.
+	DeregisterProvider(context.Context, *MsgDeregisterProvider) (*MsgDeregisterProviderResponse, error)
+	// MsgExtendDeal extends a deal's end block, topping up escrow at the storage price.
+	ExtendDeal(context.Context, *MsgExtendDeal) (*MsgExtendDealResponse, error)
+	// MsgCloseDeal terminates a deal early and settles its escrow pro rata.
+	CloseDeal(context.Context, *MsgCloseDeal) (*MsgCloseDealResponse, error)
+	// MsgCloseDealFromEvm closes a deal via an EVM-signed intent.
+	CloseDealFromEvm(context.Context, *MsgCloseDealFromEvm) (*MsgCloseDealFromEvmResponse, error)
+	// MsgTransferDealOwnership proposes (or cancels) handing a deal to a new owner.
+	TransferDealOwnership(context.Context, *MsgTransferDealOwnership) (*MsgTransferDealOwnershipResponse, error)
+	// MsgAcceptDealOwnership completes a proposed ownership transfer.
+	AcceptDealOwnership(context.Context, *MsgAcceptDealOwnership) (*MsgAcceptDealOwnershipResponse, error)
+	// MsgTransferDealOwnershipFromEvm proposes or accepts a transfer via an EVM-signed intent.
+	TransferDealOwnershipFromEvm(context.Context, *MsgTransferDealOwnershipFromEvm) (*MsgTransferDealOwnershipFromEvmResponse, error)
+	// MsgGrantDealAccess lets another account read a deal on the owner's behalf.
+	GrantDealAccess(context.Context, *MsgGrantDealAccess) (*MsgGrantDealAccessResponse, error)
+	// MsgRevokeDealAccess removes a read grant.
+	RevokeDealAccess(context.Context, *MsgRevokeDealAccess) (*MsgRevokeDealAccessResponse, error)
+	// MsgSubmitFraudProof proves that a provider served wrong data for a deal.
+	SubmitFraudProof(context.Context, *MsgSubmitFraudProof) (*MsgSubmitFraudProofResponse, error)
+	// MsgRegisterSessionKey lets a short-lived key sign retrievals for some of the owner's deals.
+	RegisterSessionKey(context.Context, *MsgRegisterSessionKey) (*MsgRegisterSessionKeyResponse, error)
+	// MsgRevokeSessionKey removes a session key before it expires.
+	RevokeSessionKey(context.Context, *MsgRevokeSessionKey) (*MsgRevokeSessionKeyResponse, error)
 }
 
-func (m *MsgWithdrawRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.AmountWithdrawn.Size()
-		i -= size
-		if _, err := m.AmountWithdrawn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (*UnimplementedMsgServer) RegisterProvider(ctx context.Context, req *MsgRegisterProvider) (*MsgRegisterProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterProvider not implemented")
+}
+func (*UnimplementedMsgServer) CreateDeal(ctx context.Context, req *MsgCreateDeal) (*MsgCreateDealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDeal not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDealContent(ctx context.Context, req *MsgUpdateDealContent) (*MsgUpdateDealContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDealContent not implemented")
+}
+func (*UnimplementedMsgServer) CreateDealFromEvm(ctx context.Context, req *MsgCreateDealFromEvm) (*MsgCreateDealFromEvmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDealFromEvm not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDealContentFromEvm(ctx context.Context, req *MsgUpdateDealContentFromEvm) (*MsgUpdateDealContentFromEvmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDealContentFromEvm not implemented")
+}
+func (*UnimplementedMsgServer) OpenRetrievalSession(ctx context.Context, req *MsgOpenRetrievalSession) (*MsgOpenRetrievalSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenRetrievalSession not implemented")
+}
+func (*UnimplementedMsgServer) ConfirmRetrievalSession(ctx context.Context, req *MsgConfirmRetrievalSession) (*MsgConfirmRetrievalSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmRetrievalSession not implemented")
+}
+func (*UnimplementedMsgServer) CancelRetrievalSession(ctx context.Context, req *MsgCancelRetrievalSession) (*MsgCancelRetrievalSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRetrievalSession not implemented")
+}
+func (*UnimplementedMsgServer) SubmitRetrievalSessionProof(ctx context.Context, req *MsgSubmitRetrievalSessionProof) (*MsgSubmitRetrievalSessionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitRetrievalSessionProof not implemented")
+}
+func (*UnimplementedMsgServer) ProveLiveness(ctx context.Context, req *MsgProveLiveness) (*MsgProveLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProveLiveness not implemented")
+}
+func (*UnimplementedMsgServer) SignalSaturation(ctx context.Context, req *MsgSignalSaturation) (*MsgSignalSaturationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalSaturation not implemented")
+}
+func (*UnimplementedMsgServer) StartSlotRepair(ctx context.Context, req *MsgStartSlotRepair) (*MsgStartSlotRepairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartSlotRepair not implemented")
+}
+func (*UnimplementedMsgServer) CompleteSlotRepair(ctx context.Context, req *MsgCompleteSlotRepair) (*MsgCompleteSlotRepairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteSlotRepair not implemented")
+}
+func (*UnimplementedMsgServer) AddCredit(ctx context.Context, req *MsgAddCredit) (*MsgAddCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCredit not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawRewards(ctx context.Context, req *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawRewards not implemented")
+}
+func (*UnimplementedMsgServer) TopUpProviderBond(ctx context.Context, req *MsgTopUpProviderBond) (*MsgTopUpProviderBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpProviderBond not implemented")
+}
+func (*UnimplementedMsgServer) UnbondProviderBond(ctx context.Context, req *MsgUnbondProviderBond) (*MsgUnbondProviderBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondProviderBond not implemented")
+}
+func (*UnimplementedMsgServer) UpdateProvider(ctx context.Context, req *MsgUpdateProvider) (*MsgUpdateProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProvider not implemented")
+}
+func (*UnimplementedMsgServer) SetProviderStatus(ctx context.Context, req *MsgSetProviderStatus) (*MsgSetProviderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProviderStatus not implemented")
+}
+func (*UnimplementedMsgServer) DeregisterProvider(ctx context.Context, req *MsgDeregisterProvider) (*MsgDeregisterProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterProvider not implemented")
+}
+func (*UnimplementedMsgServer) ExtendDeal(ctx context.Context, req *MsgExtendDeal) (*MsgExtendDealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendDeal not implemented")
+}
+func (*UnimplementedMsgServer) CloseDeal(ctx context.Context, req *MsgCloseDeal) (*MsgCloseDealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseDeal not implemented")
+}
+func (*UnimplementedMsgServer) CloseDealFromEvm(ctx context.Context, req *MsgCloseDealFromEvm) (*MsgCloseDealFromEvmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseDealFromEvm not implemented")
+}
+func (*UnimplementedMsgServer) TransferDealOwnership(ctx context.Context, req *MsgTransferDealOwnership) (*MsgTransferDealOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferDealOwnership not implemented")
+}
+func (*UnimplementedMsgServer) AcceptDealOwnership(ctx context.Context, req *MsgAcceptDealOwnership) (*MsgAcceptDealOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptDealOwnership not implemented")
+}
+func (*UnimplementedMsgServer) TransferDealOwnershipFromEvm(ctx context.Context, req *MsgTransferDealOwnershipFromEvm) (*MsgTransferDealOwnershipFromEvmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferDealOwnershipFromEvm not implemented")
+}
+func (*UnimplementedMsgServer) GrantDealAccess(ctx context.Context, req *MsgGrantDealAccess) (*MsgGrantDealAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantDealAccess not implemented")
+}
+func (*UnimplementedMsgServer) RevokeDealAccess(ctx context.Context, req *MsgRevokeDealAccess) (*MsgRevokeDealAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeDealAccess not implemented")
+}
+func (*UnimplementedMsgServer) SubmitFraudProof(ctx context.Context, req *MsgSubmitFraudProof) (*MsgSubmitFraudProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFraudProof not implemented")
+}
+func (*UnimplementedMsgServer) RegisterSessionKey(ctx context.Context, req *MsgRegisterSessionKey) (*MsgRegisterSessionKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSessionKey not implemented")
+}
+func (*UnimplementedMsgServer) RevokeSessionKey(ctx context.Context, req *MsgRevokeSessionKey) (*MsgRevokeSessionKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSessionKey not implemented")
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgRegisterProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Capabilities)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	if m.TotalStorage != 0 {
-		n += 1 + sovTx(uint64(m.TotalStorage))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/UpdateParams",
 	}
-	if len(m.Endpoints) > 0 {
-		for _, s := range m.Endpoints {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RegisterProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterProvider)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterProvider(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/RegisterProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterProvider(ctx, req.(*MsgRegisterProvider))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateDeal) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateDeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDeal)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDeal(ctx, in)
 	}
-	if m.DurationBlocks != 0 {
-		n += 1 + sovTx(uint64(m.DurationBlocks))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/CreateDeal",
 	}
-	l = len(m.ServiceHint)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDeal(ctx, req.(*MsgCreateDeal))
 	}
-	l = m.MaxMonthlySpend.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.InitialEscrowAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateDealResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateDealContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDealContent)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.DealId != 0 {
-		n += 1 + sovTx(uint64(m.DealId))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDealContent(ctx, in)
 	}
-	if len(m.AssignedProviders) > 0 {
-		for _, s := range m.AssignedProviders {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/UpdateDealContent",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDealContent(ctx, req.(*MsgUpdateDealContent))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateDealContent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_CreateDealFromEvm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDealFromEvm)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.DealId != 0 {
-		n += 1 + sovTx(uint64(m.DealId))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDealFromEvm(ctx, in)
 	}
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/CreateDealFromEvm",
 	}
-	if m.Size_ != 0 {
-		n += 1 + sovTx(uint64(m.Size_))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDealFromEvm(ctx, req.(*MsgCreateDealFromEvm))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateDealContentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateDealContentFromEvm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDealContentFromEvm)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDealContentFromEvm(ctx, in)
 	}
-	return n
-}
-
-func (m *EvmCreateDealIntent) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/UpdateDealContentFromEvm",
 	}
-	var l int
-	_ = l
-	l = len(m.CreatorEvm)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDealContentFromEvm(ctx, req.(*MsgUpdateDealContentFromEvm))
 	}
-	if m.DurationBlocks != 0 {
-		n += 1 + sovTx(uint64(m.DurationBlocks))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OpenRetrievalSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenRetrievalSession)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ServiceHint)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).OpenRetrievalSession(ctx, in)
 	}
-	l = m.InitialEscrow.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MaxMonthlySpend.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/OpenRetrievalSession",
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenRetrievalSession(ctx, req.(*MsgOpenRetrievalSession))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EvmUpdateContentIntent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CreatorEvm)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DealId != 0 {
-		n += 1 + sovTx(uint64(m.DealId))
-	}
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_ConfirmRetrievalSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfirmRetrievalSession)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SizeBytes != 0 {
-		n += 1 + sovTx(uint64(m.SizeBytes))
+	if interceptor == nil {
+		return srv.(MsgServer).ConfirmRetrievalSession(ctx, in)
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/ConfirmRetrievalSession",
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfirmRetrievalSession(ctx, req.(*MsgConfirmRetrievalSession))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateDealFromEvm) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelRetrievalSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRetrievalSession)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRetrievalSession(ctx, in)
 	}
-	if m.Intent != nil {
-		l = m.Intent.Size()
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/CancelRetrievalSession",
 	}
-	l = len(m.EvmSignature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRetrievalSession(ctx, req.(*MsgCancelRetrievalSession))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateDealFromEvmResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SubmitRetrievalSessionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitRetrievalSessionProof)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.DealId != 0 {
-		n += 1 + sovTx(uint64(m.DealId))
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitRetrievalSessionProof(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/SubmitRetrievalSessionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitRetrievalSessionProof(ctx, req.(*MsgSubmitRetrievalSessionProof))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateDealContentFromEvm) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ProveLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProveLiveness)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ProveLiveness(ctx, in)
 	}
-	if m.Intent != nil {
-		l = m.Intent.Size()
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/ProveLiveness",
 	}
-	l = len(m.EvmSignature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProveLiveness(ctx, req.(*MsgProveLiveness))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateDealContentFromEvmResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SignalSaturation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSignalSaturation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).SignalSaturation(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/SignalSaturation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SignalSaturation(ctx, req.(*MsgSignalSaturation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgOpenRetrievalSession) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_StartSlotRepair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStartSlotRepair)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).StartSlotRepair(ctx, in)
 	}
-	if m.DealId != 0 {
-		n += 1 + sovTx(uint64(m.DealId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/StartSlotRepair",
 	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StartSlotRepair(ctx, req.(*MsgStartSlotRepair))
 	}
-	l = len(m.ManifestRoot)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CompleteSlotRepair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompleteSlotRepair)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.StartMduIndex != 0 {
-		n += 1 + sovTx(uint64(m.StartMduIndex))
+	if interceptor == nil {
+		return srv.(MsgServer).CompleteSlotRepair(ctx, in)
 	}
-	if m.StartBlobIndex != 0 {
-		n += 1 + sovTx(uint64(m.StartBlobIndex))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/CompleteSlotRepair",
 	}
-	if m.BlobCount != 0 {
-		n += 1 + sovTx(uint64(m.BlobCount))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CompleteSlotRepair(ctx, req.(*MsgCompleteSlotRepair))
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddCredit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ExpiresAt != 0 {
-		n += 1 + sovTx(uint64(m.ExpiresAt))
+	if interceptor == nil {
+		return srv.(MsgServer).AddCredit(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/AddCredit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddCredit(ctx, req.(*MsgAddCredit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgOpenRetrievalSessionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_WithdrawRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawRewards)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.SessionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawRewards(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/WithdrawRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawRewards(ctx, req.(*MsgWithdrawRewards))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConfirmRetrievalSession) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_TopUpProviderBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTopUpProviderBond)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).TopUpProviderBond(ctx, in)
 	}
-	l = len(m.SessionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/TopUpProviderBond",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TopUpProviderBond(ctx, req.(*MsgTopUpProviderBond))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConfirmRetrievalSessionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UnbondProviderBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbondProviderBond)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).UnbondProviderBond(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/UnbondProviderBond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnbondProviderBond(ctx, req.(*MsgUnbondProviderBond))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelRetrievalSession) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProvider)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProvider(ctx, in)
 	}
-	l = len(m.SessionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/UpdateProvider",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProvider(ctx, req.(*MsgUpdateProvider))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelRetrievalSessionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetProviderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetProviderStatus)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).SetProviderStatus(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/SetProviderStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetProviderStatus(ctx, req.(*MsgSetProviderStatus))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitRetrievalSessionProof) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DeregisterProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterProvider)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterProvider(ctx, in)
 	}
-	l = len(m.SessionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/DeregisterProvider",
 	}
-	if len(m.Proofs) > 0 {
-		for _, e := range m.Proofs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterProvider(ctx, req.(*MsgDeregisterProvider))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitRetrievalSessionProofResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ExtendDeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendDeal)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendDeal(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/ExtendDeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendDeal(ctx, req.(*MsgExtendDeal))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProveLiveness) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_CloseDeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseDeal)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.DealId != 0 {
-		n += 1 + sovTx(uint64(m.DealId))
+	if interceptor == nil {
+		return srv.(MsgServer).CloseDeal(ctx, in)
 	}
-	if m.EpochId != 0 {
-		n += 1 + sovTx(uint64(m.EpochId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/CloseDeal",
 	}
-	if m.ProofType != nil {
-		n += m.ProofType.Size()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseDeal(ctx, req.(*MsgCloseDeal))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProveLiveness_UserReceipt) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CloseDealFromEvm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseDealFromEvm)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.UserReceipt != nil {
-		l = m.UserReceipt.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CloseDealFromEvm(ctx, in)
 	}
-	return n
-}
-func (m *MsgProveLiveness_SystemProof) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/CloseDealFromEvm",
 	}
-	var l int
-	_ = l
-	if m.SystemProof != nil {
-		l = m.SystemProof.Size()
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseDealFromEvm(ctx, req.(*MsgCloseDealFromEvm))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
-func (m *MsgProveLiveness_UserReceiptBatch) Size() (n int) {
-	if m == nil {
-		return 0
+
+func _Msg_TransferDealOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferDealOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.UserReceiptBatch != nil {
-		l = m.UserReceiptBatch.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).TransferDealOwnership(ctx, in)
 	}
-	return n
-}
-func (m *MsgProveLiveness_SessionProof) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/TransferDealOwnership",
 	}
-	var l int
-	_ = l
-	if m.SessionProof != nil {
-		l = m.SessionProof.Size()
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferDealOwnership(ctx, req.(*MsgTransferDealOwnership))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
-func (m *MsgProveLivenessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+
+func _Msg_AcceptDealOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptDealOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptDealOwnership(ctx, in)
 	}
-	if m.Tier != 0 {
-		n += 1 + sovTx(uint64(m.Tier))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/AcceptDealOwnership",
 	}
-	l = len(m.RewardAmount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptDealOwnership(ctx, req.(*MsgAcceptDealOwnership))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSignalSaturation) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_TransferDealOwnershipFromEvm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferDealOwnershipFromEvm)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).TransferDealOwnershipFromEvm(ctx, in)
 	}
-	if m.DealId != 0 {
-		n += 1 + sovTx(uint64(m.DealId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/TransferDealOwnershipFromEvm",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferDealOwnershipFromEvm(ctx, req.(*MsgTransferDealOwnershipFromEvm))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSignalSaturationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_GrantDealAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantDealAccess)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).GrantDealAccess(ctx, in)
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/GrantDealAccess",
 	}
-	if len(m.NewProviders) > 0 {
-		for _, s := range m.NewProviders {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantDealAccess(ctx, req.(*MsgGrantDealAccess))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgStartSlotRepair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_RevokeDealAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeDealAccess)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.DealId != 0 {
-		n += 1 + sovTx(uint64(m.DealId))
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeDealAccess(ctx, in)
 	}
-	if m.Slot != 0 {
-		n += 1 + sovTx(uint64(m.Slot))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/RevokeDealAccess",
 	}
-	l = len(m.PendingProvider)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeDealAccess(ctx, req.(*MsgRevokeDealAccess))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgStartSlotRepairResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SubmitFraudProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitFraudProof)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitFraudProof(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/SubmitFraudProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitFraudProof(ctx, req.(*MsgSubmitFraudProof))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCompleteSlotRepair) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RegisterSessionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterSessionKey)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterSessionKey(ctx, in)
 	}
-	if m.DealId != 0 {
-		n += 1 + sovTx(uint64(m.DealId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/RegisterSessionKey",
 	}
-	if m.Slot != 0 {
-		n += 1 + sovTx(uint64(m.Slot))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterSessionKey(ctx, req.(*MsgRegisterSessionKey))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCompleteSlotRepairResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RevokeSessionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeSessionKey)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeSessionKey(ctx, in)
 	}
-	return n
-}
-
-func (m *MsgAddCredit) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nilchain.nilchain.v1.Msg/RevokeSessionKey",
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeSessionKey(ctx, req.(*MsgRevokeSessionKey))
 	}
-	if m.DealId != 0 {
-		n += 1 + sovTx(uint64(m.DealId))
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nilchain.nilchain.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RegisterProvider",
+			Handler:    _Msg_RegisterProvider_Handler,
+		},
+		{
+			MethodName: "CreateDeal",
+			Handler:    _Msg_CreateDeal_Handler,
+		},
+		{
+			MethodName: "UpdateDealContent",
+			Handler:    _Msg_UpdateDealContent_Handler,
+		},
+		{
+			MethodName: "CreateDealFromEvm",
+			Handler:    _Msg_CreateDealFromEvm_Handler,
+		},
+		{
+			MethodName: "UpdateDealContentFromEvm",
+			Handler:    _Msg_UpdateDealContentFromEvm_Handler,
+		},
+		{
+			MethodName: "OpenRetrievalSession",
+			Handler:    _Msg_OpenRetrievalSession_Handler,
+		},
+		{
+			MethodName: "ConfirmRetrievalSession",
+			Handler:    _Msg_ConfirmRetrievalSession_Handler,
+		},
+		{
+			MethodName: "CancelRetrievalSession",
+			Handler:    _Msg_CancelRetrievalSession_Handler,
+		},
+		{
+			MethodName: "SubmitRetrievalSessionProof",
+			Handler:    _Msg_SubmitRetrievalSessionProof_Handler,
+		},
+		{
+			MethodName: "ProveLiveness",
+			Handler:    _Msg_ProveLiveness_Handler,
+		},
+		{
+			MethodName: "SignalSaturation",
+			Handler:    _Msg_SignalSaturation_Handler,
+		},
+		{
+			MethodName: "StartSlotRepair",
+			Handler:    _Msg_StartSlotRepair_Handler,
+		},
+		{
+			MethodName: "CompleteSlotRepair",
+			Handler:    _Msg_CompleteSlotRepair_Handler,
+		},
+		{
+			MethodName: "AddCredit",
+			Handler:    _Msg_AddCredit_Handler,
+		},
+		{
+			MethodName: "WithdrawRewards",
+			Handler:    _Msg_WithdrawRewards_Handler,
+		},
+		{
+			MethodName: "TopUpProviderBond",
+			Handler:    _Msg_TopUpProviderBond_Handler,
+		},
+		{
+			MethodName: "UnbondProviderBond",
+			Handler:    _Msg_UnbondProviderBond_Handler,
+		},
+		{
+			MethodName: "UpdateProvider",
+			Handler:    _Msg_UpdateProvider_Handler,
+		},
+		{
+			MethodName: "SetProviderStatus",
+			Handler:    _Msg_SetProviderStatus_Handler,
+		},
+		{
+			MethodName: "DeregisterProvider",
+			Handler:    _Msg_DeregisterProvider_Handler,
+		},
+		{
+			MethodName: "ExtendDeal",
+			Handler:    _Msg_ExtendDeal_Handler,
+		},
+		{
+			MethodName: "CloseDeal",
+			Handler:    _Msg_CloseDeal_Handler,
+		},
+		{
+			MethodName: "CloseDealFromEvm",
+			Handler:    _Msg_CloseDealFromEvm_Handler,
+		},
+		{
+			MethodName: "TransferDealOwnership",
+			Handler:    _Msg_TransferDealOwnership_Handler,
+		},
+		{
+			MethodName: "AcceptDealOwnership",
+			Handler:    _Msg_AcceptDealOwnership_Handler,
+		},
+		{
+			MethodName: "TransferDealOwnershipFromEvm",
+			Handler:    _Msg_TransferDealOwnershipFromEvm_Handler,
+		},
+		{
+			MethodName: "GrantDealAccess",
+			Handler:    _Msg_GrantDealAccess_Handler,
+		},
+		{
+			MethodName: "RevokeDealAccess",
+			Handler:    _Msg_RevokeDealAccess_Handler,
+		},
+		{
+			MethodName: "SubmitFraudProof",
+			Handler:    _Msg_SubmitFraudProof_Handler,
+		},
+		{
+			MethodName: "RegisterSessionKey",
+			Handler:    _Msg_RegisterSessionKey_Handler,
+		},
+		{
+			MethodName: "RevokeSessionKey",
+			Handler:    _Msg_RevokeSessionKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "nilchain/nilchain/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FailureDomain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Endpoints[iNdEx])
+			copy(dAtA[i:], m.Endpoints[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Endpoints[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TotalStorage != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalStorage))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Capabilities) > 0 {
+		i -= len(m.Capabilities)
+		copy(dAtA[i:], m.Capabilities)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Capabilities)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDeal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDeal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDeal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InitialEscrowAmount.Size()
+		i -= size
+		if _, err := m.InitialEscrowAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxMonthlySpend.Size()
+		i -= size
+		if _, err := m.MaxMonthlySpend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ServiceHint) > 0 {
+		i -= len(m.ServiceHint)
+		copy(dAtA[i:], m.ServiceHint)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceHint)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DurationBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DurationBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssignedProviders) > 0 {
+		for iNdEx := len(m.AssignedProviders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AssignedProviders[iNdEx])
+			copy(dAtA[i:], m.AssignedProviders[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AssignedProviders[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.DealId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDealContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDealContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDealContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DealId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDealContentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDealContentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDealContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvmCreateDealIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvmCreateDealIntent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvmCreateDealIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MaxMonthlySpend.Size()
+		i -= size
+		if _, err := m.MaxMonthlySpend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.InitialEscrow.Size()
+		i -= size
+		if _, err := m.InitialEscrow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ServiceHint) > 0 {
+		i -= len(m.ServiceHint)
+		copy(dAtA[i:], m.ServiceHint)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceHint)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DurationBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DurationBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CreatorEvm) > 0 {
+		i -= len(m.CreatorEvm)
+		copy(dAtA[i:], m.CreatorEvm)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CreatorEvm)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvmUpdateContentIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvmUpdateContentIntent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvmUpdateContentIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SizeBytes != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DealId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CreatorEvm) > 0 {
+		i -= len(m.CreatorEvm)
+		copy(dAtA[i:], m.CreatorEvm)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CreatorEvm)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDealFromEvm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDealFromEvm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDealFromEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmSignature) > 0 {
+		i -= len(m.EvmSignature)
+		copy(dAtA[i:], m.EvmSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvmSignature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Intent != nil {
+		{
+			size, err := m.Intent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDealFromEvmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDealFromEvmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDealFromEvmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvmCloseDealIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvmCloseDealIntent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvmCloseDealIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DealId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CreatorEvm) > 0 {
+		i -= len(m.CreatorEvm)
+		copy(dAtA[i:], m.CreatorEvm)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CreatorEvm)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvmTransferDealIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvmTransferDealIntent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvmTransferDealIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DealId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CreatorEvm) > 0 {
+		i -= len(m.CreatorEvm)
+		copy(dAtA[i:], m.CreatorEvm)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CreatorEvm)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDealContentFromEvm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDealContentFromEvm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDealContentFromEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmSignature) > 0 {
+		i -= len(m.EvmSignature)
+		copy(dAtA[i:], m.EvmSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvmSignature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Intent != nil {
+		{
+			size, err := m.Intent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDealContentFromEvmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDealContentFromEvmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDealContentFromEvmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenRetrievalSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenRetrievalSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenRetrievalSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BlobCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlobCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartBlobIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartBlobIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartMduIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartMduIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ManifestRoot) > 0 {
+		i -= len(m.ManifestRoot)
+		copy(dAtA[i:], m.ManifestRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ManifestRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DealId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenRetrievalSessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenRetrievalSessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenRetrievalSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfirmRetrievalSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfirmRetrievalSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmRetrievalSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfirmRetrievalSessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfirmRetrievalSessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmRetrievalSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRetrievalSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRetrievalSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRetrievalSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRetrievalSessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRetrievalSessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRetrievalSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitRetrievalSessionProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitRetrievalSessionProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitRetrievalSessionProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitRetrievalSessionProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitRetrievalSessionProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitRetrievalSessionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProveLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProveLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProveLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProofType != nil {
+		{
+			size := m.ProofType.Size()
+			i -= size
+			if _, err := m.ProofType.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.EpochId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DealId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProveLiveness_UserReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProveLiveness_UserReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UserReceipt != nil {
+		{
+			size, err := m.UserReceipt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MsgProveLiveness_SystemProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProveLiveness_SystemProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SystemProof != nil {
+		{
+			size, err := m.SystemProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MsgProveLiveness_UserReceiptBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProveLiveness_UserReceiptBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UserReceiptBatch != nil {
+		{
+			size, err := m.UserReceiptBatch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MsgProveLiveness_SessionProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProveLiveness_SessionProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SessionProof != nil {
+		{
+			size, err := m.SessionProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MsgProveLivenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProveLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProveLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardAmount) > 0 {
+		i -= len(m.RewardAmount)
+		copy(dAtA[i:], m.RewardAmount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Tier != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Tier))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignalSaturation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalSaturation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalSaturation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignalSaturationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalSaturationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalSaturationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewProviders) > 0 {
+		for iNdEx := len(m.NewProviders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NewProviders[iNdEx])
+			copy(dAtA[i:], m.NewProviders[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.NewProviders[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStartSlotRepair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStartSlotRepair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStartSlotRepair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingProvider) > 0 {
+		i -= len(m.PendingProvider)
+		copy(dAtA[i:], m.PendingProvider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PendingProvider)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Slot != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DealId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStartSlotRepairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStartSlotRepairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStartSlotRepairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteSlotRepair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteSlotRepair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteSlotRepair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Slot != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DealId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteSlotRepairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteSlotRepairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteSlotRepairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddCredit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddCredit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddCredit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DealId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddCreditResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddCreditResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddCreditResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NewBalance.Size()
+		i -= size
+		if _, err := m.NewBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BandwidthRewards.Size()
+		i -= size
+		if _, err := m.BandwidthRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StorageRewards.Size()
+		i -= size
+		if _, err := m.StorageRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.AmountWithdrawn.Size()
+		i -= size
+		if _, err := m.AmountWithdrawn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTopUpProviderBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUpProviderBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpProviderBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTopUpProviderBondResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUpProviderBondResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpProviderBondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbondProviderBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbondProviderBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondProviderBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbondProviderBondResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbondProviderBondResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondProviderBondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompletionHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Capabilities) > 0 {
+		i -= len(m.Capabilities)
+		copy(dAtA[i:], m.Capabilities)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Capabilities)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TotalStorage != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalStorage))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Endpoints[iNdEx])
+			copy(dAtA[i:], m.Endpoints[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Endpoints[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProviderStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProviderStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProviderStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProviderStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProviderStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProviderStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompletionHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PendingMigrations != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PendingMigrations))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendDeal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendDeal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendDeal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AdditionalBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AdditionalBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DealId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendDealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendDealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendDealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EscrowBalance.Size()
+		i -= size
+		if _, err := m.EscrowBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.EscrowAdded.Size()
+		i -= size
+		if _, err := m.EscrowAdded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EndBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseDeal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseDeal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseDeal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseDealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseDealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseDealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SessionsSettled != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SessionsSettled))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.EscrowRefund.Size()
+		i -= size
+		if _, err := m.EscrowRefund.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ProviderPayout.Size()
+		i -= size
+		if _, err := m.ProviderPayout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseDealFromEvm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseDealFromEvm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseDealFromEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmSignature) > 0 {
+		i -= len(m.EvmSignature)
+		copy(dAtA[i:], m.EvmSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvmSignature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Intent != nil {
+		{
+			size, err := m.Intent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseDealFromEvmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseDealFromEvmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseDealFromEvmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SessionsSettled != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SessionsSettled))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.EscrowRefund.Size()
+		i -= size
+		if _, err := m.EscrowRefund.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ProviderPayout.Size()
+		i -= size
+		if _, err := m.ProviderPayout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferDealOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferDealOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferDealOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DealId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferDealOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferDealOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferDealOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingOwner) > 0 {
+		i -= len(m.PendingOwner)
+		copy(dAtA[i:], m.PendingOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PendingOwner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptDealOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptDealOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptDealOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptDealOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptDealOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptDealOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SessionsMoved != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SessionsMoved))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PreviousOwner) > 0 {
+		i -= len(m.PreviousOwner)
+		copy(dAtA[i:], m.PreviousOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PreviousOwner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferDealOwnershipFromEvm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferDealOwnershipFromEvm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferDealOwnershipFromEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmSignature) > 0 {
+		i -= len(m.EvmSignature)
+		copy(dAtA[i:], m.EvmSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvmSignature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Intent != nil {
+		{
+			size, err := m.Intent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferDealOwnershipFromEvmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferDealOwnershipFromEvmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferDealOwnershipFromEvmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingOwner) > 0 {
+		i -= len(m.PendingOwner)
+		copy(dAtA[i:], m.PendingOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PendingOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantDealAccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantDealAccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantDealAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxFee.Size()
+		i -= size
+		if _, err := m.MaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.MaxBytes != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DealId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantDealAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantDealAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantDealAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeDealAccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeDealAccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeDealAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DealId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeDealAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeDealAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeDealAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitFraudProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitFraudProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitFraudProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderSignature) > 0 {
+		i -= len(m.ProviderSignature)
+		copy(dAtA[i:], m.ProviderSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProviderSignature)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ManifestRoot) > 0 {
+		i -= len(m.ManifestRoot)
+		copy(dAtA[i:], m.ManifestRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ManifestRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Generation != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Generation))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DealId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitFraudProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitFraudProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitFraudProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterSessionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterSessionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterSessionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxFee.Size()
+		i -= size
+		if _, err := m.MaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.MaxBytes != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DealIds) > 0 {
+		dAtA19 := make([]byte, len(m.DealIds)*10)
+		var j18 int
+		for _, num := range m.DealIds {
+			for num >= 1<<7 {
+				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j18++
+			}
+			dAtA19[j18] = uint8(num)
+			j18++
+		}
+		i -= j18
+		copy(dAtA[i:], dAtA19[:j18])
+		i = encodeVarintTx(dAtA, i, uint64(j18))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SessionKey) > 0 {
+		i -= len(m.SessionKey)
+		copy(dAtA[i:], m.SessionKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterSessionKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterSessionKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterSessionKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeSessionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeSessionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeSessionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionKey) > 0 {
+		i -= len(m.SessionKey)
+		copy(dAtA[i:], m.SessionKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeSessionKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeSessionKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeSessionKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Capabilities)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TotalStorage != 0 {
+		n += 1 + sovTx(uint64(m.TotalStorage))
+	}
+	if len(m.Endpoints) > 0 {
+		for _, s := range m.Endpoints {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FailureDomain.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreateDeal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DurationBlocks != 0 {
+		n += 1 + sovTx(uint64(m.DurationBlocks))
+	}
+	l = len(m.ServiceHint)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxMonthlySpend.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.InitialEscrowAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateDealResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		n += 1 + sovTx(uint64(m.DealId))
+	}
+	if len(m.AssignedProviders) > 0 {
+		for _, s := range m.AssignedProviders {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateDealContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovTx(uint64(m.DealId))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovTx(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *MsgUpdateDealContentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *EvmCreateDealIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CreatorEvm)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DurationBlocks != 0 {
+		n += 1 + sovTx(uint64(m.DurationBlocks))
+	}
+	l = len(m.ServiceHint)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.InitialEscrow.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxMonthlySpend.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *EvmUpdateContentIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CreatorEvm)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovTx(uint64(m.DealId))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovTx(uint64(m.SizeBytes))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDealFromEvm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Intent != nil {
+		l = m.Intent.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EvmSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDealFromEvmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		n += 1 + sovTx(uint64(m.DealId))
+	}
+	return n
+}
+
+func (m *EvmCloseDealIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CreatorEvm)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovTx(uint64(m.DealId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *EvmTransferDealIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CreatorEvm)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovTx(uint64(m.DealId))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateDealContentFromEvm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Intent != nil {
+		l = m.Intent.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EvmSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateDealContentFromEvmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgOpenRetrievalSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovTx(uint64(m.DealId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ManifestRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartMduIndex != 0 {
+		n += 1 + sovTx(uint64(m.StartMduIndex))
+	}
+	if m.StartBlobIndex != 0 {
+		n += 1 + sovTx(uint64(m.StartBlobIndex))
+	}
+	if m.BlobCount != 0 {
+		n += 1 + sovTx(uint64(m.BlobCount))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *MsgOpenRetrievalSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConfirmRetrievalSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConfirmRetrievalSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCancelRetrievalSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelRetrievalSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSubmitRetrievalSessionProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitRetrievalSessionProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgProveLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovTx(uint64(m.DealId))
+	}
+	if m.EpochId != 0 {
+		n += 1 + sovTx(uint64(m.EpochId))
+	}
+	if m.ProofType != nil {
+		n += m.ProofType.Size()
+	}
+	return n
+}
+
+func (m *MsgProveLiveness_UserReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserReceipt != nil {
+		l = m.UserReceipt.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *MsgProveLiveness_SystemProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SystemProof != nil {
+		l = m.SystemProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *MsgProveLiveness_UserReceiptBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserReceiptBatch != nil {
+		l = m.UserReceiptBatch.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *MsgProveLiveness_SessionProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionProof != nil {
+		l = m.SessionProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *MsgProveLivenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.Tier != 0 {
+		n += 1 + sovTx(uint64(m.Tier))
+	}
+	l = len(m.RewardAmount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSignalSaturation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovTx(uint64(m.DealId))
+	}
+	return n
+}
+
+func (m *MsgSignalSaturationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.NewProviders) > 0 {
+		for _, s := range m.NewProviders {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgStartSlotRepair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovTx(uint64(m.DealId))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovTx(uint64(m.Slot))
+	}
+	l = len(m.PendingProvider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStartSlotRepairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCompleteSlotRepair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovTx(uint64(m.DealId))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovTx(uint64(m.Slot))
+	}
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCompleteSlotRepairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAddCredit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovTx(uint64(m.DealId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddCreditResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NewBalance.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AmountWithdrawn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.StorageRewards.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.BandwidthRewards.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTopUpProviderBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTopUpProviderBondResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Bond.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnbondProviderBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnbondProviderBondResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompletionHeight != 0 {
+		n += 1 + sovTx(uint64(m.CompletionHeight))
+	}
+	return n
+}
+
+func (m *MsgUpdateProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Endpoints) > 0 {
+		for _, s := range m.Endpoints {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.TotalStorage != 0 {
+		n += 1 + sovTx(uint64(m.TotalStorage))
+	}
+	l = len(m.Capabilities)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Provider.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetProviderStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetProviderStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingMigrations != 0 {
+		n += 1 + sovTx(uint64(m.PendingMigrations))
+	}
+	if m.CompletionHeight != 0 {
+		n += 1 + sovTx(uint64(m.CompletionHeight))
+	}
+	return n
+}
+
+func (m *MsgExtendDeal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovTx(uint64(m.DealId))
+	}
+	if m.AdditionalBlocks != 0 {
+		n += 1 + sovTx(uint64(m.AdditionalBlocks))
+	}
+	return n
+}
+
+func (m *MsgExtendDealResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EndBlock != 0 {
+		n += 1 + sovTx(uint64(m.EndBlock))
+	}
+	l = m.EscrowAdded.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.EscrowBalance.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCloseDeal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovTx(uint64(m.DealId))
+	}
+	return n
+}
+
+func (m *MsgCloseDealResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProviderPayout.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.EscrowRefund.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SessionsSettled != 0 {
+		n += 1 + sovTx(uint64(m.SessionsSettled))
+	}
+	return n
+}
+
+func (m *MsgCloseDealFromEvm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Intent != nil {
+		l = m.Intent.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EvmSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCloseDealFromEvmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProviderPayout.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.EscrowRefund.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SessionsSettled != 0 {
+		n += 1 + sovTx(uint64(m.SessionsSettled))
+	}
+	return n
+}
+
+func (m *MsgTransferDealOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovTx(uint64(m.DealId))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferDealOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PendingOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptDealOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovTx(uint64(m.DealId))
+	}
+	return n
+}
+
+func (m *MsgAcceptDealOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SessionsMoved != 0 {
+		n += 1 + sovTx(uint64(m.SessionsMoved))
+	}
+	return n
+}
+
+func (m *MsgTransferDealOwnershipFromEvm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Intent != nil {
+		l = m.Intent.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EvmSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferDealOwnershipFromEvmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PendingOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantDealAccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovTx(uint64(m.DealId))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAt))
+	}
+	if m.MaxBytes != 0 {
+		n += 1 + sovTx(uint64(m.MaxBytes))
+	}
+	l = m.MaxFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgGrantDealAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeDealAccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovTx(uint64(m.DealId))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeDealAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitFraudProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovTx(uint64(m.DealId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Generation != 0 {
+		n += 1 + sovTx(uint64(m.Generation))
+	}
+	l = len(m.ManifestRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Proof.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProviderSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitFraudProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Reward.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterSessionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SessionKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DealIds) > 0 {
+		l = 0
+		for _, e := range m.DealIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAt))
+	}
+	if m.MaxBytes != 0 {
+		n += 1 + sovTx(uint64(m.MaxBytes))
+	}
+	l = m.MaxFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterSessionKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeSessionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SessionKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeSessionKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capabilities = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStorage", wireType)
+			}
+			m.TotalStorage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalStorage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureDomain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FailureDomain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDeal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDeal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDeal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationBlocks", wireType)
+			}
+			m.DurationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceHint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceHint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMonthlySpend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMonthlySpend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialEscrowAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialEscrowAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDealResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDealResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignedProviders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssignedProviders = append(m.AssignedProviders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDealContent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDealContent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDealContent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDealContentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDealContentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDealContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvmCreateDealIntent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvmCreateDealIntent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvmCreateDealIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorEvm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorEvm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationBlocks", wireType)
+			}
+			m.DurationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceHint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceHint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialEscrow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialEscrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMonthlySpend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMonthlySpend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvmUpdateContentIntent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvmUpdateContentIntent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvmUpdateContentIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorEvm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorEvm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDealFromEvm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDealFromEvm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDealFromEvm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Intent == nil {
+				m.Intent = &EvmCreateDealIntent{}
+			}
+			if err := m.Intent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmSignature = append(m.EvmSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmSignature == nil {
+				m.EvmSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDealFromEvmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDealFromEvmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDealFromEvmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvmCloseDealIntent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvmCloseDealIntent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvmCloseDealIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorEvm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorEvm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvmTransferDealIntent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvmTransferDealIntent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvmTransferDealIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorEvm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorEvm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDealContentFromEvm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDealContentFromEvm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDealContentFromEvm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Intent == nil {
+				m.Intent = &EvmUpdateContentIntent{}
+			}
+			if err := m.Intent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmSignature = append(m.EvmSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmSignature == nil {
+				m.EvmSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDealContentFromEvmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDealContentFromEvmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDealContentFromEvmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenRetrievalSession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenRetrievalSession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenRetrievalSession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManifestRoot = append(m.ManifestRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ManifestRoot == nil {
+				m.ManifestRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartMduIndex", wireType)
+			}
+			m.StartMduIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartMduIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlobIndex", wireType)
+			}
+			m.StartBlobIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlobIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobCount", wireType)
+			}
+			m.BlobCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenRetrievalSessionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenRetrievalSessionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenRetrievalSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = append(m.SessionId[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionId == nil {
+				m.SessionId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConfirmRetrievalSession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConfirmRetrievalSession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConfirmRetrievalSession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = append(m.SessionId[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionId == nil {
+				m.SessionId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConfirmRetrievalSessionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConfirmRetrievalSessionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConfirmRetrievalSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRetrievalSession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRetrievalSession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRetrievalSession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = append(m.SessionId[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionId == nil {
+				m.SessionId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRetrievalSessionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRetrievalSessionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRetrievalSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitRetrievalSessionProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitRetrievalSessionProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitRetrievalSessionProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = append(m.SessionId[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionId == nil {
+				m.SessionId = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, ChainedProof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitRetrievalSessionProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitRetrievalSessionProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitRetrievalSessionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProveLiveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProveLiveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProveLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserReceipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RetrievalReceipt{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.ProofType = &MsgProveLiveness_UserReceipt{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ChainedProof{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.ProofType = &MsgProveLiveness_SystemProof{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserReceiptBatch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RetrievalReceiptBatch{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.ProofType = &MsgProveLiveness_UserReceiptBatch{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RetrievalSessionProof{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.ProofType = &MsgProveLiveness_SessionProof{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProveLivenessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProveLivenessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProveLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			m.Tier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSignalSaturation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignalSaturation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignalSaturation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSignalSaturationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignalSaturationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignalSaturationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewProviders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewProviders = append(m.NewProviders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStartSlotRepair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartSlotRepair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartSlotRepair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgAddCreditResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.NewBalance.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
-
-func (m *MsgWithdrawRewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgStartSlotRepairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartSlotRepairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartSlotRepairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgWithdrawRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.AmountWithdrawn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgCompleteSlotRepair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5038,15 +13730,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCompleteSlotRepair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCompleteSlotRepair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5074,11 +13766,49 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5105,7 +13835,8 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Proofs = append(m.Proofs, ChainedProof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5130,7 +13861,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCompleteSlotRepairResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5153,12 +13884,32 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCompleteSlotRepairResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCompleteSlotRepairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5180,7 +13931,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCredit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5203,10 +13954,10 @@ func (m *MsgRegisterProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCredit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCredit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5242,8 +13993,27 @@ func (m *MsgRegisterProvider) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5271,30 +14041,63 @@ func (m *MsgRegisterProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Capabilities = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalStorage", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.TotalStorage = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalStorage |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddCreditResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddCreditResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddCreditResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5322,7 +14125,9 @@ func (m *MsgRegisterProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoints = append(m.Endpoints, string(dAtA[iNdEx:postIndex]))
+			if err := m.NewBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5345,7 +14150,7 @@ func (m *MsgRegisterProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5368,17 +14173,17 @@ func (m *MsgRegisterProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5388,12 +14193,24 @@ func (m *MsgRegisterProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5415,7 +14232,7 @@ func (m *MsgRegisterProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDeal) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5438,15 +14255,15 @@ func (m *MsgCreateDeal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDeal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDeal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountWithdrawn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5474,13 +14291,15 @@ func (m *MsgCreateDeal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if err := m.AmountWithdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DurationBlocks", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageRewards", wireType)
 			}
-			m.DurationBlocks = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5490,14 +14309,29 @@ func (m *MsgCreateDeal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DurationBlocks |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorageRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceHint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BandwidthRewards", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5525,11 +14359,63 @@ func (m *MsgCreateDeal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceHint = string(dAtA[iNdEx:postIndex])
+			if err := m.BandwidthRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTopUpProviderBond) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTopUpProviderBond: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTopUpProviderBond: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMonthlySpend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5557,15 +14443,13 @@ func (m *MsgCreateDeal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxMonthlySpend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialEscrowAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5575,23 +14459,22 @@ func (m *MsgCreateDeal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialEscrowAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5616,7 +14499,7 @@ func (m *MsgCreateDeal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDealResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTopUpProviderBondResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5639,17 +14522,17 @@ func (m *MsgCreateDealResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDealResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTopUpProviderBondResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTopUpProviderBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
 			}
-			m.DealId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5659,14 +14542,28 @@ func (m *MsgCreateDealResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DealId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignedProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5694,7 +14591,7 @@ func (m *MsgCreateDealResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssignedProviders = append(m.AssignedProviders, string(dAtA[iNdEx:postIndex]))
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5717,7 +14614,7 @@ func (m *MsgCreateDealResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDealContent) Unmarshal(dAtA []byte) error {
+func (m *MsgUnbondProviderBond) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5740,10 +14637,10 @@ func (m *MsgUpdateDealContent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDealContent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnbondProviderBond: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDealContent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnbondProviderBond: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5779,29 +14676,10 @@ func (m *MsgUpdateDealContent) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
-			}
-			m.DealId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DealId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5811,43 +14689,25 @@ func (m *MsgUpdateDealContent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
-			}
-			m.Size_ = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Size_ |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5869,7 +14729,7 @@ func (m *MsgUpdateDealContent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDealContentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnbondProviderBondResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5892,17 +14752,17 @@ func (m *MsgUpdateDealContentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDealContentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnbondProviderBondResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDealContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnbondProviderBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionHeight", wireType)
 			}
-			var v int
+			m.CompletionHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5912,12 +14772,11 @@ func (m *MsgUpdateDealContentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.CompletionHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5939,7 +14798,7 @@ func (m *MsgUpdateDealContentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EvmCreateDealIntent) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5962,98 +14821,15 @@ func (m *EvmCreateDealIntent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EvmCreateDealIntent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EvmCreateDealIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatorEvm", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CreatorEvm = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DurationBlocks", wireType)
-			}
-			m.DurationBlocks = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DurationBlocks |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceHint", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ServiceHint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialEscrow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6081,13 +14857,11 @@ func (m *EvmCreateDealIntent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialEscrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMonthlySpend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6115,15 +14889,13 @@ func (m *EvmCreateDealIntent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxMonthlySpend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Endpoints = append(m.Endpoints, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStorage", wireType)
 			}
-			m.Nonce = 0
+			m.TotalStorage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6133,14 +14905,14 @@ func (m *EvmCreateDealIntent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.TotalStorage |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6168,7 +14940,7 @@ func (m *EvmCreateDealIntent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Capabilities = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6191,7 +14963,7 @@ func (m *EvmCreateDealIntent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EvmUpdateContentIntent) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6214,138 +14986,17 @@ func (m *EvmUpdateContentIntent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EvmUpdateContentIntent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EvmUpdateContentIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatorEvm", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CreatorEvm = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
-			}
-			m.DealId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DealId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Cid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
-			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6355,23 +15006,24 @@ func (m *EvmUpdateContentIntent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6394,7 +15046,7 @@ func (m *EvmUpdateContentIntent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDealFromEvm) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProviderStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6417,15 +15069,15 @@ func (m *MsgCreateDealFromEvm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDealFromEvm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProviderStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDealFromEvm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProviderStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6453,49 +15105,13 @@ func (m *MsgCreateDealFromEvm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Intent", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Intent == nil {
-				m.Intent = &EvmCreateDealIntent{}
-			}
-			if err := m.Intent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6505,25 +15121,23 @@ func (m *MsgCreateDealFromEvm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmSignature = append(m.EvmSignature[:0], dAtA[iNdEx:postIndex]...)
-			if m.EvmSignature == nil {
-				m.EvmSignature = []byte{}
-			}
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6546,7 +15160,7 @@ func (m *MsgCreateDealFromEvm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDealFromEvmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProviderStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6569,17 +15183,17 @@ func (m *MsgCreateDealFromEvmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDealFromEvmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProviderStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDealFromEvmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProviderStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.DealId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6589,11 +15203,24 @@ func (m *MsgCreateDealFromEvmResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DealId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6615,7 +15242,7 @@ func (m *MsgCreateDealFromEvmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDealContentFromEvm) Unmarshal(dAtA []byte) error {
+func (m *MsgDeregisterProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6638,15 +15265,15 @@ func (m *MsgUpdateDealContentFromEvm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDealContentFromEvm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeregisterProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDealContentFromEvm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeregisterProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6674,77 +15301,7 @@ func (m *MsgUpdateDealContentFromEvm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Intent", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Intent == nil {
-				m.Intent = &EvmUpdateContentIntent{}
-			}
-			if err := m.Intent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmSignature", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EvmSignature = append(m.EvmSignature[:0], dAtA[iNdEx:postIndex]...)
-			if m.EvmSignature == nil {
-				m.EvmSignature = []byte{}
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6767,7 +15324,7 @@ func (m *MsgUpdateDealContentFromEvm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDealContentFromEvmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeregisterProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6790,17 +15347,17 @@ func (m *MsgUpdateDealContentFromEvmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDealContentFromEvmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeregisterProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDealContentFromEvmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeregisterProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMigrations", wireType)
 			}
-			var v int
+			m.PendingMigrations = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6810,12 +15367,30 @@ func (m *MsgUpdateDealContentFromEvmResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.PendingMigrations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionHeight", wireType)
+			}
+			m.CompletionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6837,7 +15412,7 @@ func (m *MsgUpdateDealContentFromEvmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOpenRetrievalSession) Unmarshal(dAtA []byte) error {
+func (m *MsgExtendDeal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6860,10 +15435,10 @@ func (m *MsgOpenRetrievalSession) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOpenRetrievalSession: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExtendDeal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOpenRetrievalSession: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExtendDeal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6918,10 +15493,10 @@ func (m *MsgOpenRetrievalSession) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalBlocks", wireType)
 			}
-			var stringLen uint64
+			m.AdditionalBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6931,63 +15506,66 @@ func (m *MsgOpenRetrievalSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AdditionalBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManifestRoot", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendDealResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ManifestRoot = append(m.ManifestRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.ManifestRoot == nil {
-				m.ManifestRoot = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendDealResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendDealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartMduIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
 			}
-			m.StartMduIndex = 0
+			m.EndBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6997,16 +15575,16 @@ func (m *MsgOpenRetrievalSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartMduIndex |= uint64(b&0x7F) << shift
+				m.EndBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartBlobIndex", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAdded", wireType)
 			}
-			m.StartBlobIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7016,54 +15594,31 @@ func (m *MsgOpenRetrievalSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartBlobIndex |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlobCount", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.BlobCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlobCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.EscrowAdded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowBalance", wireType)
 			}
-			m.ExpiresAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7073,11 +15628,26 @@ func (m *MsgOpenRetrievalSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiresAt |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7099,7 +15669,7 @@ func (m *MsgOpenRetrievalSession) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOpenRetrievalSessionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCloseDeal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7122,17 +15692,17 @@ func (m *MsgOpenRetrievalSessionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOpenRetrievalSessionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCloseDeal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOpenRetrievalSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCloseDeal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7142,26 +15712,43 @@ func (m *MsgOpenRetrievalSessionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionId = append(m.SessionId[:0], dAtA[iNdEx:postIndex]...)
-			if m.SessionId == nil {
-				m.SessionId = []byte{}
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7183,7 +15770,7 @@ func (m *MsgOpenRetrievalSessionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConfirmRetrievalSession) Unmarshal(dAtA []byte) error {
+func (m *MsgCloseDealResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7206,15 +15793,15 @@ func (m *MsgConfirmRetrievalSession) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConfirmRetrievalSession: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCloseDealResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConfirmRetrievalSession: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCloseDealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPayout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7242,13 +15829,15 @@ func (m *MsgConfirmRetrievalSession) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if err := m.ProviderPayout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowRefund", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7258,26 +15847,45 @@ func (m *MsgConfirmRetrievalSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionId = append(m.SessionId[:0], dAtA[iNdEx:postIndex]...)
-			if m.SessionId == nil {
-				m.SessionId = []byte{}
+			if err := m.EscrowRefund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionsSettled", wireType)
+			}
+			m.SessionsSettled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionsSettled |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7299,7 +15907,7 @@ func (m *MsgConfirmRetrievalSession) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConfirmRetrievalSessionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCloseDealFromEvm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7322,17 +15930,17 @@ func (m *MsgConfirmRetrievalSessionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConfirmRetrievalSessionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCloseDealFromEvm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConfirmRetrievalSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCloseDealFromEvm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7342,12 +15950,94 @@ func (m *MsgConfirmRetrievalSessionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Intent == nil {
+				m.Intent = &EvmCloseDealIntent{}
+			}
+			if err := m.Intent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmSignature = append(m.EvmSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmSignature == nil {
+				m.EvmSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7369,7 +16059,7 @@ func (m *MsgConfirmRetrievalSessionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelRetrievalSession) Unmarshal(dAtA []byte) error {
+func (m *MsgCloseDealFromEvmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7392,15 +16082,15 @@ func (m *MsgCancelRetrievalSession) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelRetrievalSession: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCloseDealFromEvmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelRetrievalSession: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCloseDealFromEvmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPayout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7428,13 +16118,15 @@ func (m *MsgCancelRetrievalSession) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if err := m.ProviderPayout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowRefund", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7444,81 +16136,31 @@ func (m *MsgCancelRetrievalSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionId = append(m.SessionId[:0], dAtA[iNdEx:postIndex]...)
-			if m.SessionId == nil {
-				m.SessionId = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.EscrowRefund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCancelRetrievalSessionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelRetrievalSessionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelRetrievalSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionsSettled", wireType)
 			}
-			var v int
+			m.SessionsSettled = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7528,12 +16170,11 @@ func (m *MsgCancelRetrievalSessionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.SessionsSettled |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7555,7 +16196,7 @@ func (m *MsgCancelRetrievalSessionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitRetrievalSessionProof) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferDealOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7578,10 +16219,10 @@ func (m *MsgSubmitRetrievalSessionProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitRetrievalSessionProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferDealOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitRetrievalSessionProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferDealOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7617,10 +16258,10 @@ func (m *MsgSubmitRetrievalSessionProof) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
 			}
-			var byteLen int
+			m.DealId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7630,31 +16271,16 @@ func (m *MsgSubmitRetrievalSessionProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.DealId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SessionId = append(m.SessionId[:0], dAtA[iNdEx:postIndex]...)
-			if m.SessionId == nil {
-				m.SessionId = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7664,25 +16290,23 @@ func (m *MsgSubmitRetrievalSessionProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proofs = append(m.Proofs, ChainedProof{})
-			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7705,7 +16329,7 @@ func (m *MsgSubmitRetrievalSessionProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitRetrievalSessionProofResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferDealOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7728,17 +16352,17 @@ func (m *MsgSubmitRetrievalSessionProofResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitRetrievalSessionProofResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferDealOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitRetrievalSessionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferDealOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7748,12 +16372,24 @@ func (m *MsgSubmitRetrievalSessionProofResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7775,7 +16411,7 @@ func (m *MsgSubmitRetrievalSessionProofResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProveLiveness) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptDealOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7798,10 +16434,10 @@ func (m *MsgProveLiveness) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProveLiveness: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptDealOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProveLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptDealOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7855,11 +16491,61 @@ func (m *MsgProveLiveness) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.EpochId = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptDealOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptDealOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptDealOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOwner", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7869,16 +16555,29 @@ func (m *MsgProveLiveness) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserReceipt", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var msglen int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionsMoved", wireType)
+			}
+			m.SessionsMoved = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7888,32 +16587,66 @@ func (m *MsgProveLiveness) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SessionsMoved |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RetrievalReceipt{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferDealOwnershipFromEvm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ProofType = &MsgProveLiveness_UserReceipt{v}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferDealOwnershipFromEvm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferDealOwnershipFromEvm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7923,30 +16656,27 @@ func (m *MsgProveLiveness) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ChainedProof{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.ProofType = &MsgProveLiveness_SystemProof{v}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserReceiptBatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Intent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7973,17 +16703,18 @@ func (m *MsgProveLiveness) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RetrievalReceiptBatch{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Intent == nil {
+				m.Intent = &EvmTransferDealIntent{}
+			}
+			if err := m.Intent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.ProofType = &MsgProveLiveness_UserReceiptBatch{v}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmSignature", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7993,26 +16724,25 @@ func (m *MsgProveLiveness) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RetrievalSessionProof{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EvmSignature = append(m.EvmSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmSignature == nil {
+				m.EvmSignature = []byte{}
 			}
-			m.ProofType = &MsgProveLiveness_SessionProof{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8035,7 +16765,7 @@ func (m *MsgProveLiveness) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProveLivenessResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferDealOwnershipFromEvmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8058,17 +16788,17 @@ func (m *MsgProveLivenessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProveLivenessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferDealOwnershipFromEvmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProveLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferDealOwnershipFromEvmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8078,34 +16808,27 @@ func (m *MsgProveLivenessResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.Tier = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Tier |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8133,7 +16856,7 @@ func (m *MsgProveLivenessResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardAmount = string(dAtA[iNdEx:postIndex])
+			m.PendingOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8156,7 +16879,7 @@ func (m *MsgProveLivenessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSignalSaturation) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantDealAccess) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8179,10 +16902,10 @@ func (m *MsgSignalSaturation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSignalSaturation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantDealAccess: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSignalSaturation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantDealAccess: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8236,6 +16959,110 @@ func (m *MsgSignalSaturation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8257,7 +17084,7 @@ func (m *MsgSignalSaturation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSignalSaturationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantDealAccessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8280,35 +17107,65 @@ func (m *MsgSignalSaturationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSignalSaturationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantDealAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantDealAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeDealAccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeDealAccess: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSignalSaturationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeDealAccess: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8336,11 +17193,30 @@ func (m *MsgSignalSaturationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8368,7 +17244,7 @@ func (m *MsgSignalSaturationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewProviders = append(m.NewProviders, string(dAtA[iNdEx:postIndex]))
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8391,7 +17267,7 @@ func (m *MsgSignalSaturationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStartSlotRepair) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeDealAccessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8414,10 +17290,60 @@ func (m *MsgStartSlotRepair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStartSlotRepair: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeDealAccessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStartSlotRepair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeDealAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitFraudProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitFraudProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitFraudProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8472,10 +17398,10 @@ func (m *MsgStartSlotRepair) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			m.Slot = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8485,16 +17411,48 @@ func (m *MsgStartSlotRepair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+			}
+			m.Generation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Generation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestRoot", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8504,79 +17462,98 @@ func (m *MsgStartSlotRepair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingProvider = string(dAtA[iNdEx:postIndex])
+			m.ManifestRoot = append(m.ManifestRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ManifestRoot == nil {
+				m.ManifestRoot = []byte{}
+			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgStartSlotRepairResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStartSlotRepairResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStartSlotRepairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderSignature", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8586,12 +17563,26 @@ func (m *MsgStartSlotRepairResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderSignature = append(m.ProviderSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderSignature == nil {
+				m.ProviderSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8613,7 +17604,7 @@ func (m *MsgStartSlotRepairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCompleteSlotRepair) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitFraudProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8636,15 +17627,15 @@ func (m *MsgCompleteSlotRepair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteSlotRepair: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitFraudProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteSlotRepair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitFraudProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8672,13 +17663,13 @@ func (m *MsgCompleteSlotRepair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
-			m.DealId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8688,30 +17679,25 @@ func (m *MsgCompleteSlotRepair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DealId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.Slot = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Slot |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8733,7 +17719,7 @@ func (m *MsgCompleteSlotRepair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCompleteSlotRepairResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterSessionKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8756,17 +17742,17 @@ func (m *MsgCompleteSlotRepairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteSlotRepairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterSessionKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteSlotRepairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterSessionKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8776,65 +17762,27 @@ func (m *MsgCompleteSlotRepairResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAddCredit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCredit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCredit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8862,13 +17810,89 @@ func (m *MsgAddCredit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.SessionKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DealIds = append(m.DealIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DealIds) == 0 {
+					m.DealIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DealIds = append(m.DealIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealIds", wireType)
+			}
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			m.DealId = 0
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8878,14 +17902,33 @@ func (m *MsgAddCredit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DealId |= uint64(b&0x7F) << shift
+				m.ExpiresAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8913,7 +17956,7 @@ func (m *MsgAddCredit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8938,7 +17981,7 @@ func (m *MsgAddCredit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddCreditResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterSessionKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8961,46 +18004,12 @@ func (m *MsgAddCreditResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCreditResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterSessionKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCreditResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterSessionKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewBalance", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.NewBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9022,7 +18031,7 @@ func (m *MsgAddCreditResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeSessionKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9045,10 +18054,10 @@ func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeSessionKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeSessionKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9083,6 +18092,38 @@ func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9104,7 +18145,7 @@ func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeSessionKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9127,46 +18168,12 @@ func (m *MsgWithdrawRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeSessionKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeSessionKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountWithdrawn", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.AmountWithdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
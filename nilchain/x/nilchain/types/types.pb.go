@@ -7,6 +7,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -53,6 +54,39 @@ func (SlotStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_8cb128e800f8f092, []int{0}
 }
 
+// DealStatus is the lifecycle state of a deal. Gateways and providers may
+// garbage-collect the data of deals that are no longer active.
+type DealStatus int32
+
+const (
+	DealStatus_DEAL_STATUS_UNSPECIFIED DealStatus = 0
+	DealStatus_DEAL_STATUS_ACTIVE      DealStatus = 1
+	DealStatus_DEAL_STATUS_EXPIRED     DealStatus = 2
+	DealStatus_DEAL_STATUS_CLOSED      DealStatus = 3
+)
+
+var DealStatus_name = map[int32]string{
+	0: "DEAL_STATUS_UNSPECIFIED",
+	1: "DEAL_STATUS_ACTIVE",
+	2: "DEAL_STATUS_EXPIRED",
+	3: "DEAL_STATUS_CLOSED",
+}
+
+var DealStatus_value = map[string]int32{
+	"DEAL_STATUS_UNSPECIFIED": 0,
+	"DEAL_STATUS_ACTIVE":      1,
+	"DEAL_STATUS_EXPIRED":     2,
+	"DEAL_STATUS_CLOSED":      3,
+}
+
+func (x DealStatus) String() string {
+	return proto.EnumName(DealStatus_name, int32(x))
+}
+
+func (DealStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8cb128e800f8f092, []int{1}
+}
+
 // RetrievalSessionStatus is the on-chain lifecycle state for a retrieval session.
 type RetrievalSessionStatus int32
 
@@ -91,7 +125,71 @@ func (x RetrievalSessionStatus) String() string {
 }
 
 func (RetrievalSessionStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_8cb128e800f8f092, []int{1}
+	return fileDescriptor_8cb128e800f8f092, []int{2}
+}
+
+// EvidenceKind is the misbehaviour an Evidence entry proves.
+type EvidenceKind int32
+
+const (
+	EvidenceKind_EVIDENCE_KIND_UNSPECIFIED                  EvidenceKind = 0
+	EvidenceKind_EVIDENCE_KIND_RETRIEVAL_NON_RESPONSE       EvidenceKind = 1
+	EvidenceKind_EVIDENCE_KIND_FRAUD_MANIFEST_ROOT_MISMATCH EvidenceKind = 2
+	EvidenceKind_EVIDENCE_KIND_FRAUD_INVALID_PROOF          EvidenceKind = 3
+	EvidenceKind_EVIDENCE_KIND_FRAUD_DATA_MISMATCH          EvidenceKind = 4
+)
+
+var EvidenceKind_name = map[int32]string{
+	0: "EVIDENCE_KIND_UNSPECIFIED",
+	1: "EVIDENCE_KIND_RETRIEVAL_NON_RESPONSE",
+	2: "EVIDENCE_KIND_FRAUD_MANIFEST_ROOT_MISMATCH",
+	3: "EVIDENCE_KIND_FRAUD_INVALID_PROOF",
+	4: "EVIDENCE_KIND_FRAUD_DATA_MISMATCH",
+}
+
+var EvidenceKind_value = map[string]int32{
+	"EVIDENCE_KIND_UNSPECIFIED":                  0,
+	"EVIDENCE_KIND_RETRIEVAL_NON_RESPONSE":       1,
+	"EVIDENCE_KIND_FRAUD_MANIFEST_ROOT_MISMATCH": 2,
+	"EVIDENCE_KIND_FRAUD_INVALID_PROOF":          3,
+	"EVIDENCE_KIND_FRAUD_DATA_MISMATCH":          4,
+}
+
+func (x EvidenceKind) String() string {
+	return proto.EnumName(EvidenceKind_name, int32(x))
+}
+
+func (EvidenceKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8cb128e800f8f092, []int{3}
+}
+
+// EvidenceOutcome is what the chain did to the provider on the evidence.
+type EvidenceOutcome int32
+
+const (
+	EvidenceOutcome_EVIDENCE_OUTCOME_UNSPECIFIED        EvidenceOutcome = 0
+	EvidenceOutcome_EVIDENCE_OUTCOME_HEALTH_FAILURE     EvidenceOutcome = 1
+	EvidenceOutcome_EVIDENCE_OUTCOME_SLASHED_AND_JAILED EvidenceOutcome = 2
+)
+
+var EvidenceOutcome_name = map[int32]string{
+	0: "EVIDENCE_OUTCOME_UNSPECIFIED",
+	1: "EVIDENCE_OUTCOME_HEALTH_FAILURE",
+	2: "EVIDENCE_OUTCOME_SLASHED_AND_JAILED",
+}
+
+var EvidenceOutcome_value = map[string]int32{
+	"EVIDENCE_OUTCOME_UNSPECIFIED":        0,
+	"EVIDENCE_OUTCOME_HEALTH_FAILURE":     1,
+	"EVIDENCE_OUTCOME_SLASHED_AND_JAILED": 2,
+}
+
+func (x EvidenceOutcome) String() string {
+	return proto.EnumName(EvidenceOutcome_name, int32(x))
+}
+
+func (EvidenceOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8cb128e800f8f092, []int{4}
 }
 
 // StripeReplicaProfile defines RS(K,K+M) parameters for Mode 2 (StripeReplica).
@@ -156,6 +254,11 @@ type DealSlot struct {
 	PendingProvider   string `protobuf:"bytes,4,opt,name=pending_provider,json=pendingProvider,proto3" json:"pending_provider,omitempty"`
 	StatusSinceHeight int64  `protobuf:"varint,5,opt,name=status_since_height,json=statusSinceHeight,proto3" json:"status_since_height,omitempty"`
 	RepairTargetGen   uint64 `protobuf:"varint,6,opt,name=repair_target_gen,json=repairTargetGen,proto3" json:"repair_target_gen,omitempty"`
+	// Height after which an unfinished repair is reassigned to another provider.
+	RepairDeadlineHeight uint64 `protobuf:"varint,7,opt,name=repair_deadline_height,json=repairDeadlineHeight,proto3" json:"repair_deadline_height,omitempty"`
+	// Part of the failed provider's slashed bond paid to whoever completes the
+	// repair.
+	RepairBounty types.Coin `protobuf:"bytes,8,opt,name=repair_bounty,json=repairBounty,proto3" json:"repair_bounty"`
 }
 
 func (m *DealSlot) Reset()         { *m = DealSlot{} }
@@ -233,6 +336,20 @@ func (m *DealSlot) GetRepairTargetGen() uint64 {
 	return 0
 }
 
+func (m *DealSlot) GetRepairDeadlineHeight() uint64 {
+	if m != nil {
+		return m.RepairDeadlineHeight
+	}
+	return 0
+}
+
+func (m *DealSlot) GetRepairBounty() types.Coin {
+	if m != nil {
+		return m.RepairBounty
+	}
+	return types.Coin{}
+}
+
 // Deal represents a storage deal between a user and the network.
 type Deal struct {
 	Id                 uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -258,6 +375,9 @@ type Deal struct {
 	CurrentGen uint64 `protobuf:"varint,19,opt,name=current_gen,json=currentGen,proto3" json:"current_gen,omitempty"`
 	// --- Slab accounting (bounds + policy) ---
 	WitnessMdus uint64 `protobuf:"varint,20,opt,name=witness_mdus,json=witnessMdus,proto3" json:"witness_mdus,omitempty"`
+	// --- Lifecycle ---
+	Status       DealStatus `protobuf:"varint,21,opt,name=status,proto3,enum=nilchain.nilchain.v1.DealStatus" json:"status,omitempty"`
+	PendingOwner string     `protobuf:"bytes,22,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
 }
 
 func (m *Deal) Reset()         { *m = Deal{} }
@@ -405,6 +525,20 @@ func (m *Deal) GetWitnessMdus() uint64 {
 	return 0
 }
 
+func (m *Deal) GetStatus() DealStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DealStatus_DEAL_STATUS_UNSPECIFIED
+}
+
+func (m *Deal) GetPendingOwner() string {
+	if m != nil {
+		return m.PendingOwner
+	}
+	return ""
+}
+
 // DealHeatState tracks aggregate traffic and performance metrics for a deal.
 // Used for "Heat" observability and potential future economic tilting.
 type DealHeatState struct {
@@ -477,13 +611,16 @@ func (m *DealHeatState) GetSuccessfulRetrievalsTotal() uint64 {
 
 // Provider represents a Storage Provider in the network.
 type Provider struct {
-	Address         string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	TotalStorage    uint64   `protobuf:"varint,2,opt,name=total_storage,json=totalStorage,proto3" json:"total_storage,omitempty"`
-	UsedStorage     uint64   `protobuf:"varint,3,opt,name=used_storage,json=usedStorage,proto3" json:"used_storage,omitempty"`
-	Capabilities    string   `protobuf:"bytes,4,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
-	Status          string   `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	ReputationScore int64    `protobuf:"varint,6,opt,name=reputation_score,json=reputationScore,proto3" json:"reputation_score,omitempty"`
-	Endpoints       []string `protobuf:"bytes,7,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	Address         string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	TotalStorage    uint64                `protobuf:"varint,2,opt,name=total_storage,json=totalStorage,proto3" json:"total_storage,omitempty"`
+	UsedStorage     uint64                `protobuf:"varint,3,opt,name=used_storage,json=usedStorage,proto3" json:"used_storage,omitempty"`
+	Capabilities    string                `protobuf:"bytes,4,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	Status          string                `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	ReputationScore int64                 `protobuf:"varint,6,opt,name=reputation_score,json=reputationScore,proto3" json:"reputation_score,omitempty"`
+	Endpoints       []string              `protobuf:"bytes,7,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	Bond            types.Coin            `protobuf:"bytes,8,opt,name=bond,proto3" json:"bond"`
+	ReservedStorage uint64                `protobuf:"varint,9,opt,name=reserved_storage,json=reservedStorage,proto3" json:"reserved_storage,omitempty"`
+	FailureDomain   ProviderFailureDomain `protobuf:"bytes,10,opt,name=failure_domain,json=failureDomain,proto3" json:"failure_domain"`
 }
 
 func (m *Provider) Reset()         { *m = Provider{} }
@@ -568,6 +705,91 @@ func (m *Provider) GetEndpoints() []string {
 	return nil
 }
 
+func (m *Provider) GetBond() types.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return types.Coin{}
+}
+
+func (m *Provider) GetReservedStorage() uint64 {
+	if m != nil {
+		return m.ReservedStorage
+	}
+	return 0
+}
+
+func (m *Provider) GetFailureDomain() ProviderFailureDomain {
+	if m != nil {
+		return m.FailureDomain
+	}
+	return ProviderFailureDomain{}
+}
+
+// ProviderFailureDomain labels the infrastructure a provider shares with
+// others. Providers with the same operator, region or host group are assumed
+// to fail together; empty labels are ignored and an empty operator_id stands
+// for the provider's own address.
+type ProviderFailureDomain struct {
+	OperatorId string `protobuf:"bytes,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Region     string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	HostGroup  string `protobuf:"bytes,3,opt,name=host_group,json=hostGroup,proto3" json:"host_group,omitempty"`
+}
+
+func (m *ProviderFailureDomain) Reset()         { *m = ProviderFailureDomain{} }
+func (m *ProviderFailureDomain) String() string { return proto.CompactTextString(m) }
+func (*ProviderFailureDomain) ProtoMessage()    {}
+func (*ProviderFailureDomain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cb128e800f8f092, []int{5}
+}
+func (m *ProviderFailureDomain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderFailureDomain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderFailureDomain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderFailureDomain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderFailureDomain.Merge(m, src)
+}
+func (m *ProviderFailureDomain) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderFailureDomain) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderFailureDomain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderFailureDomain proto.InternalMessageInfo
+
+func (m *ProviderFailureDomain) GetOperatorId() string {
+	if m != nil {
+		return m.OperatorId
+	}
+	return ""
+}
+
+func (m *ProviderFailureDomain) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *ProviderFailureDomain) GetHostGroup() string {
+	if m != nil {
+		return m.HostGroup
+	}
+	return ""
+}
+
 // VirtualStripe tracks overlay replicas for a deal, used for elasticity.
 type VirtualStripe struct {
 	DealId           uint64   `protobuf:"varint,1,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
@@ -579,7 +801,7 @@ func (m *VirtualStripe) Reset()         { *m = VirtualStripe{} }
 func (m *VirtualStripe) String() string { return proto.CompactTextString(m) }
 func (*VirtualStripe) ProtoMessage()    {}
 func (*VirtualStripe) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cb128e800f8f092, []int{5}
+	return fileDescriptor_8cb128e800f8f092, []int{6}
 }
 func (m *VirtualStripe) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,7 +871,7 @@ func (m *ChainedProof) Reset()         { *m = ChainedProof{} }
 func (m *ChainedProof) String() string { return proto.CompactTextString(m) }
 func (*ChainedProof) ProtoMessage()    {}
 func (*ChainedProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cb128e800f8f092, []int{6}
+	return fileDescriptor_8cb128e800f8f092, []int{7}
 }
 func (m *ChainedProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -765,7 +987,7 @@ func (m *RetrievalSession) Reset()         { *m = RetrievalSession{} }
 func (m *RetrievalSession) String() string { return proto.CompactTextString(m) }
 func (*RetrievalSession) ProtoMessage()    {}
 func (*RetrievalSession) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cb128e800f8f092, []int{7}
+	return fileDescriptor_8cb128e800f8f092, []int{8}
 }
 func (m *RetrievalSession) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -911,7 +1133,7 @@ func (m *RetrievalReceipt) Reset()         { *m = RetrievalReceipt{} }
 func (m *RetrievalReceipt) String() string { return proto.CompactTextString(m) }
 func (*RetrievalReceipt) ProtoMessage()    {}
 func (*RetrievalReceipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cb128e800f8f092, []int{8}
+	return fileDescriptor_8cb128e800f8f092, []int{9}
 }
 func (m *RetrievalReceipt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1026,7 +1248,7 @@ func (m *RetrievalReceiptBatch) Reset()         { *m = RetrievalReceiptBatch{} }
 func (m *RetrievalReceiptBatch) String() string { return proto.CompactTextString(m) }
 func (*RetrievalReceiptBatch) ProtoMessage()    {}
 func (*RetrievalReceiptBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cb128e800f8f092, []int{9}
+	return fileDescriptor_8cb128e800f8f092, []int{10}
 }
 func (m *RetrievalReceiptBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1081,7 +1303,7 @@ func (m *DownloadSessionReceipt) Reset()         { *m = DownloadSessionReceipt{}
 func (m *DownloadSessionReceipt) String() string { return proto.CompactTextString(m) }
 func (*DownloadSessionReceipt) ProtoMessage()    {}
 func (*DownloadSessionReceipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cb128e800f8f092, []int{10}
+	return fileDescriptor_8cb128e800f8f092, []int{11}
 }
 func (m *DownloadSessionReceipt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1193,7 +1415,7 @@ func (m *SessionChunkProof) Reset()         { *m = SessionChunkProof{} }
 func (m *SessionChunkProof) String() string { return proto.CompactTextString(m) }
 func (*SessionChunkProof) ProtoMessage()    {}
 func (*SessionChunkProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cb128e800f8f092, []int{11}
+	return fileDescriptor_8cb128e800f8f092, []int{12}
 }
 func (m *SessionChunkProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1267,7 +1489,7 @@ func (m *RetrievalSessionProof) Reset()         { *m = RetrievalSessionProof{} }
 func (m *RetrievalSessionProof) String() string { return proto.CompactTextString(m) }
 func (*RetrievalSessionProof) ProtoMessage()    {}
 func (*RetrievalSessionProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cb128e800f8f092, []int{12}
+	return fileDescriptor_8cb128e800f8f092, []int{13}
 }
 func (m *RetrievalSessionProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1310,649 +1532,998 @@ func (m *RetrievalSessionProof) GetChunks() []SessionChunkProof {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("nilchain.nilchain.v1.SlotStatus", SlotStatus_name, SlotStatus_value)
-	proto.RegisterEnum("nilchain.nilchain.v1.RetrievalSessionStatus", RetrievalSessionStatus_name, RetrievalSessionStatus_value)
-	proto.RegisterType((*StripeReplicaProfile)(nil), "nilchain.nilchain.v1.StripeReplicaProfile")
-	proto.RegisterType((*DealSlot)(nil), "nilchain.nilchain.v1.DealSlot")
-	proto.RegisterType((*Deal)(nil), "nilchain.nilchain.v1.Deal")
-	proto.RegisterType((*DealHeatState)(nil), "nilchain.nilchain.v1.DealHeatState")
-	proto.RegisterType((*Provider)(nil), "nilchain.nilchain.v1.Provider")
-	proto.RegisterType((*VirtualStripe)(nil), "nilchain.nilchain.v1.VirtualStripe")
-	proto.RegisterType((*ChainedProof)(nil), "nilchain.nilchain.v1.ChainedProof")
-	proto.RegisterType((*RetrievalSession)(nil), "nilchain.nilchain.v1.RetrievalSession")
-	proto.RegisterType((*RetrievalReceipt)(nil), "nilchain.nilchain.v1.RetrievalReceipt")
-	proto.RegisterType((*RetrievalReceiptBatch)(nil), "nilchain.nilchain.v1.RetrievalReceiptBatch")
-	proto.RegisterType((*DownloadSessionReceipt)(nil), "nilchain.nilchain.v1.DownloadSessionReceipt")
-	proto.RegisterType((*SessionChunkProof)(nil), "nilchain.nilchain.v1.SessionChunkProof")
-	proto.RegisterType((*RetrievalSessionProof)(nil), "nilchain.nilchain.v1.RetrievalSessionProof")
+// EpochQuotaState tracks per-epoch liveness accounting for one (deal, provider)
+// assignment. See rfcs/rfc-challenge-derivation-and-quotas.md §7.
+type EpochQuotaState struct {
+	CreditsBlobs            uint64 `protobuf:"varint,1,opt,name=credits_blobs,json=creditsBlobs,proto3" json:"credits_blobs,omitempty"`
+	SyntheticSatisfiedBlobs uint64 `protobuf:"varint,2,opt,name=synthetic_satisfied_blobs,json=syntheticSatisfiedBlobs,proto3" json:"synthetic_satisfied_blobs,omitempty"`
 }
 
-func init() { proto.RegisterFile("nilchain/nilchain/v1/types.proto", fileDescriptor_8cb128e800f8f092) }
+func (m *EpochQuotaState) Reset()         { *m = EpochQuotaState{} }
+func (m *EpochQuotaState) String() string { return proto.CompactTextString(m) }
+func (*EpochQuotaState) ProtoMessage()    {}
+func (*EpochQuotaState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cb128e800f8f092, []int{14}
+}
+func (m *EpochQuotaState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochQuotaState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochQuotaState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochQuotaState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochQuotaState.Merge(m, src)
+}
+func (m *EpochQuotaState) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochQuotaState) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochQuotaState.DiscardUnknown(m)
+}
 
-var fileDescriptor_8cb128e800f8f092 = []byte{
-	// 1950 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x23, 0x49,
-	0x15, 0x8f, 0x3f, 0x92, 0xd8, 0xcf, 0x76, 0xe2, 0xd4, 0x64, 0x32, 0xce, 0x0e, 0xc9, 0x78, 0xcc,
-	0xee, 0x8c, 0x09, 0x43, 0xa2, 0xcd, 0xa2, 0x15, 0x48, 0x08, 0x94, 0xd8, 0xce, 0x8c, 0x45, 0x3e,
-	0xac, 0xee, 0xcc, 0x80, 0x00, 0xa9, 0x55, 0xe9, 0x2e, 0xdb, 0xad, 0xb4, 0xbb, 0xac, 0xae, 0x72,
-	0xbe, 0x4e, 0xdc, 0xb9, 0x20, 0xfe, 0x07, 0xce, 0x5c, 0xe0, 0x84, 0xb8, 0xef, 0x71, 0xc5, 0x09,
-	0x71, 0x58, 0xad, 0x66, 0xc4, 0x8d, 0x3f, 0x02, 0xd5, 0xab, 0xea, 0xb6, 0xf3, 0xe1, 0x49, 0x24,
-	0xa4, 0xbd, 0x75, 0xfd, 0xde, 0x7b, 0xf5, 0xf1, 0xde, 0xaf, 0x7e, 0xaf, 0x6c, 0xa8, 0x86, 0x7e,
-	0xe0, 0xf6, 0xa9, 0x1f, 0x6e, 0x25, 0x1f, 0x67, 0x9f, 0x6f, 0xc9, 0xcb, 0x21, 0x13, 0x9b, 0xc3,
-	0x88, 0x4b, 0x4e, 0x96, 0x63, 0xc3, 0x66, 0xf2, 0x71, 0xf6, 0xf9, 0x27, 0xcb, 0x3d, 0xde, 0xe3,
-	0xe8, 0xb0, 0xa5, 0xbe, 0xb4, 0xef, 0x27, 0xab, 0x2e, 0x17, 0x03, 0x2e, 0x1c, 0x6d, 0xd0, 0x03,
-	0x6d, 0xaa, 0x6d, 0xc3, 0xb2, 0x2d, 0x23, 0x7f, 0xc8, 0x2c, 0x36, 0x0c, 0x7c, 0x97, 0x76, 0x22,
-	0xde, 0xf5, 0x03, 0x46, 0x8a, 0x90, 0x3a, 0xad, 0xa4, 0xaa, 0xa9, 0x7a, 0xc9, 0x4a, 0x9d, 0xaa,
-	0xd1, 0xa0, 0x92, 0xd6, 0xa3, 0x41, 0xed, 0x2f, 0x69, 0xc8, 0x35, 0x19, 0x0d, 0xec, 0x80, 0x4b,
-	0x42, 0x20, 0x2b, 0x02, 0x2e, 0x8d, 0x2f, 0x7e, 0x93, 0x1f, 0x43, 0x6e, 0x18, 0xf1, 0x33, 0xdf,
-	0x63, 0x11, 0x46, 0xe5, 0x77, 0x2b, 0xff, 0xfc, 0xeb, 0x8f, 0x96, 0xcd, 0xc2, 0x3b, 0x9e, 0x17,
-	0x31, 0x21, 0xd4, 0xb2, 0x61, 0xcf, 0x4a, 0x3c, 0xc9, 0x4f, 0x60, 0x4e, 0x48, 0x2a, 0x47, 0xa2,
-	0x92, 0xa9, 0xa6, 0xea, 0x0b, 0xdb, 0xd5, 0xcd, 0xbb, 0x8e, 0xb8, 0xa9, 0x56, 0xb5, 0xd1, 0xcf,
-	0x32, 0xfe, 0xa4, 0x01, 0xe5, 0x21, 0x0b, 0x3d, 0x3f, 0xec, 0x39, 0xc9, 0xba, 0xd9, 0x7b, 0xd6,
-	0x5d, 0x34, 0x11, 0x9d, 0x78, 0xf9, 0x4d, 0x78, 0xa4, 0xa7, 0x73, 0x84, 0x1f, 0xba, 0xcc, 0xe9,
-	0x33, 0xbf, 0xd7, 0x97, 0x95, 0xd9, 0x6a, 0xaa, 0x9e, 0xb1, 0x96, 0xb4, 0xc9, 0x56, 0x96, 0x37,
-	0x68, 0x20, 0x1b, 0xb0, 0x14, 0xb1, 0x21, 0xf5, 0x23, 0x47, 0xd2, 0xa8, 0xc7, 0xa4, 0xd3, 0x63,
-	0x61, 0x65, 0xae, 0x9a, 0xaa, 0x67, 0xad, 0x45, 0x6d, 0x38, 0x46, 0xfc, 0x35, 0x0b, 0x6b, 0xbf,
-	0x9f, 0x87, 0xac, 0xca, 0x18, 0x59, 0x80, 0xb4, 0xef, 0x61, 0xae, 0xb2, 0x56, 0xda, 0xf7, 0xc8,
-	0xf7, 0xa1, 0x34, 0xa0, 0xa1, 0xdf, 0x65, 0x42, 0x3a, 0x11, 0xe7, 0x12, 0xd3, 0x55, 0xb4, 0x8a,
-	0x31, 0x68, 0x71, 0x93, 0x62, 0xff, 0x8a, 0x61, 0x5a, 0xb2, 0x16, 0x7e, 0x93, 0x4d, 0x98, 0xe5,
-	0xe7, 0xe1, 0x03, 0xce, 0xa9, 0xdd, 0x48, 0x13, 0x16, 0x98, 0x70, 0x23, 0x7e, 0xee, 0x9c, 0xd0,
-	0x80, 0x86, 0x2e, 0xc3, 0x83, 0xe5, 0x77, 0xd7, 0xbe, 0xfa, 0xe6, 0xd9, 0xcc, 0xbf, 0xbf, 0x79,
-	0xf6, 0x58, 0x07, 0x0b, 0xef, 0x74, 0xd3, 0xe7, 0x5b, 0x03, 0x2a, 0xfb, 0x9b, 0xed, 0x50, 0x5a,
-	0x25, 0x1d, 0xb4, 0xab, 0x63, 0xc8, 0x33, 0x28, 0x08, 0x49, 0x23, 0xe9, 0x9c, 0x04, 0xdc, 0x3d,
-	0x35, 0xa7, 0x05, 0x84, 0x76, 0x15, 0x42, 0x9e, 0x42, 0x9e, 0x85, 0x9e, 0x31, 0xcf, 0xa3, 0x39,
-	0xc7, 0x42, 0x4f, 0x1b, 0xbf, 0x84, 0x7c, 0x5c, 0x1e, 0x51, 0xc9, 0x55, 0x33, 0x1f, 0xdd, 0xf7,
-	0xd8, 0x95, 0xbc, 0x84, 0xc5, 0x88, 0x79, 0xa3, 0xd0, 0xa3, 0xa1, 0x7b, 0xe9, 0x0c, 0xb8, 0xc7,
-	0x2a, 0x79, 0x64, 0xdb, 0xc2, 0x18, 0x3e, 0xe0, 0x1e, 0x23, 0x5b, 0xf0, 0xc8, 0x1d, 0x45, 0x11,
-	0x0b, 0xa5, 0x13, 0x69, 0x3a, 0x4b, 0x9f, 0x87, 0x15, 0xc0, 0x7d, 0x10, 0x63, 0xb2, 0xc6, 0x16,
-	0xf2, 0x1c, 0x8a, 0x82, 0x45, 0x67, 0xbe, 0x2a, 0xb7, 0x1f, 0xca, 0x4a, 0x41, 0xe5, 0xc4, 0x2a,
-	0x18, 0xec, 0x8d, 0x1f, 0x4a, 0xd2, 0x86, 0xa5, 0x01, 0xbd, 0x70, 0x06, 0x3c, 0x94, 0xfd, 0xe0,
-	0xd2, 0x11, 0x8a, 0x36, 0x95, 0xe2, 0x43, 0x72, 0xb7, 0x38, 0xa0, 0x17, 0x07, 0x3a, 0xcc, 0x56,
-	0x51, 0x64, 0x0d, 0x40, 0x72, 0x49, 0x03, 0x67, 0xe0, 0x8d, 0x44, 0x65, 0x01, 0x77, 0x95, 0x47,
-	0xe4, 0xc0, 0x1b, 0x09, 0xf2, 0x53, 0x58, 0xc5, 0xd9, 0x9d, 0x73, 0x3f, 0xf4, 0xf8, 0xb9, 0xa3,
-	0x33, 0x6d, 0x68, 0xb8, 0x88, 0xde, 0x2b, 0xe8, 0xf0, 0x2b, 0xb4, 0xdb, 0xca, 0x6c, 0xb8, 0xf8,
-	0x4b, 0x20, 0xd7, 0x43, 0x87, 0x2c, 0x94, 0x95, 0xf2, 0x43, 0x76, 0x59, 0x9e, 0x9c, 0x52, 0x85,
-	0x91, 0x23, 0x28, 0xa9, 0x1c, 0x6f, 0xab, 0xbb, 0xa4, 0xb4, 0xa0, 0xb2, 0x54, 0x4d, 0xd5, 0x0b,
-	0xdb, 0x1b, 0x53, 0xae, 0xe3, 0x1d, 0xea, 0x61, 0x15, 0x71, 0x82, 0x58, 0x4b, 0x7e, 0x01, 0x05,
-	0x3d, 0xa1, 0x12, 0x07, 0x51, 0x21, 0xd5, 0x4c, 0xbd, 0xb0, 0xbd, 0x7e, 0xf7, 0x74, 0xb1, 0xae,
-	0x58, 0x80, 0x21, 0xea, 0x53, 0x28, 0xda, 0xc5, 0x75, 0x55, 0x97, 0xec, 0x91, 0xa6, 0x9d, 0x81,
-	0x5e, 0x33, 0xac, 0xe3, 0xb9, 0x2f, 0x43, 0x26, 0x84, 0xce, 0xed, 0x32, 0x7a, 0x14, 0x0c, 0xa6,
-	0xb2, 0x5b, 0xfb, 0x90, 0x82, 0x92, 0x9a, 0xfc, 0x0d, 0xa3, 0x28, 0x1f, 0x8c, 0xbc, 0x02, 0x72,
-	0x72, 0x29, 0x99, 0x70, 0x54, 0xb9, 0x99, 0xe7, 0x60, 0x25, 0xcc, 0xdd, 0x2c, 0xa3, 0xc5, 0x46,
-	0xc3, 0xb1, 0xc2, 0xc9, 0x97, 0xf0, 0xa4, 0x4b, 0xfd, 0x80, 0x79, 0x8e, 0xdb, 0xa7, 0x41, 0xc0,
-	0xc2, 0x1e, 0x13, 0x26, 0x24, 0x8d, 0x21, 0x8f, 0xb5, 0xb9, 0x91, 0x58, 0x75, 0xdc, 0x2b, 0x20,
-	0x01, 0x15, 0xd2, 0x19, 0x0d, 0x3d, 0x2a, 0x13, 0x55, 0xc9, 0xa0, 0xaa, 0x94, 0x95, 0xe5, 0x2d,
-	0x1a, 0x4c, 0x21, 0x7f, 0x0e, 0x4f, 0xc5, 0xc8, 0x75, 0x99, 0x10, 0xdd, 0x51, 0xe0, 0x44, 0x4c,
-	0x46, 0x3e, 0x3b, 0xa3, 0x41, 0xbc, 0x52, 0x16, 0x57, 0x5a, 0x1d, 0xbb, 0x58, 0x89, 0x07, 0xae,
-	0x56, 0xfb, 0x43, 0x1a, 0x72, 0x89, 0xa2, 0x6d, 0xc3, 0x3c, 0xd5, 0x77, 0x0a, 0x4f, 0xf5, 0xb1,
-	0xdb, 0x16, 0x3b, 0x2a, 0x41, 0xd2, 0x1c, 0x15, 0x92, 0x47, 0xb4, 0xc7, 0xcc, 0xe1, 0x8a, 0x08,
-	0xda, 0x1a, 0x53, 0xe9, 0x1e, 0x09, 0xe6, 0x25, 0x3e, 0x5a, 0x98, 0x0a, 0x0a, 0x8b, 0x5d, 0x6a,
-	0x50, 0x74, 0xe9, 0x90, 0x9e, 0xf8, 0x81, 0x2f, 0x7d, 0x26, 0xb4, 0x4c, 0x59, 0xd7, 0x30, 0xb2,
-	0x92, 0x08, 0x3e, 0x6a, 0x51, 0x22, 0xe7, 0x3f, 0x80, 0x72, 0xc4, 0x86, 0x23, 0x89, 0x77, 0xd4,
-	0x11, 0x2e, 0x8f, 0x18, 0x4a, 0x4d, 0x06, 0x85, 0xd5, 0xe0, 0xb6, 0x82, 0xc9, 0xf7, 0x50, 0x6f,
-	0x86, 0xdc, 0x0f, 0xa5, 0xa8, 0xcc, 0x2b, 0x49, 0xb1, 0xc6, 0x40, 0xed, 0x4f, 0x29, 0x28, 0xbd,
-	0xf3, 0x23, 0x39, 0x52, 0x5b, 0x57, 0x34, 0x25, 0x4f, 0x60, 0xde, 0x63, 0x34, 0x70, 0x12, 0x11,
-	0x9e, 0x53, 0xc3, 0xb6, 0x87, 0x4a, 0x80, 0x2e, 0x8e, 0x1f, 0x7a, 0xec, 0xc2, 0x34, 0xbb, 0x82,
-	0xc6, 0xda, 0x0a, 0x22, 0x2d, 0x58, 0xe2, 0x67, 0x2c, 0x0a, 0xe8, 0xa5, 0x33, 0x96, 0xb1, 0xcc,
-	0x3d, 0x32, 0x56, 0x36, 0x21, 0x71, 0x51, 0x44, 0xed, 0x1f, 0x69, 0x28, 0x36, 0x14, 0xdd, 0x99,
-	0xd7, 0x89, 0x38, 0xef, 0x2a, 0xcd, 0x1c, 0x78, 0x23, 0xb3, 0xae, 0xde, 0x55, 0x6e, 0xe0, 0x8d,
-	0xf4, 0xa2, 0xeb, 0x50, 0x50, 0x46, 0xd5, 0x1b, 0x9c, 0x6e, 0x64, 0xda, 0x83, 0xf2, 0x57, 0x9d,
-	0x61, 0x2f, 0x52, 0xb9, 0x4a, 0x1a, 0x08, 0x1f, 0xb2, 0xd0, 0x0f, 0x7b, 0x58, 0x8e, 0xa2, 0x92,
-	0x1f, 0x8d, 0x1f, 0x69, 0x58, 0xc9, 0xe8, 0x49, 0xc0, 0x4f, 0x1c, 0x97, 0x0f, 0x06, 0xbe, 0x1c,
-	0x28, 0x85, 0xc8, 0xa2, 0xe7, 0x82, 0x82, 0x1b, 0x09, 0xaa, 0xae, 0xdb, 0x80, 0x45, 0xa7, 0x01,
-	0x73, 0x86, 0x54, 0xf6, 0x2b, 0xb3, 0xd5, 0x4c, 0xbd, 0x68, 0x81, 0x86, 0x3a, 0x54, 0xf6, 0x95,
-	0x90, 0xe1, 0x4c, 0x7a, 0xcb, 0x73, 0x98, 0xaa, 0xbc, 0x42, 0xf4, 0x9e, 0x9f, 0xc0, 0xfc, 0x95,
-	0x73, 0x46, 0x83, 0x11, 0xc3, 0x16, 0x50, 0xb4, 0xe6, 0xae, 0xde, 0xa9, 0x91, 0x32, 0x5c, 0x1a,
-	0x43, 0x4e, 0x1b, 0x2e, 0xb5, 0x61, 0x03, 0x96, 0x4e, 0xaf, 0x7a, 0xf1, 0x01, 0x54, 0x7a, 0x79,
-	0x17, 0x35, 0xbe, 0x68, 0x2d, 0x9e, 0x5e, 0xf5, 0xcc, 0x09, 0x30, 0x5d, 0xb5, 0xff, 0x66, 0xa1,
-	0x9c, 0xd0, 0xde, 0x66, 0x42, 0x28, 0x21, 0x5f, 0x03, 0x10, 0xfa, 0x33, 0x2e, 0x6d, 0xd1, 0xca,
-	0x1b, 0xa4, 0xed, 0x4d, 0x96, 0x3d, 0x7d, 0xad, 0xec, 0x49, 0x1b, 0xcd, 0x3c, 0xac, 0x8d, 0x4e,
-	0xbe, 0x6c, 0xb2, 0x0f, 0x7e, 0xd9, 0xdc, 0xea, 0xf2, 0xb3, 0x77, 0x74, 0xf9, 0x17, 0xb0, 0xa8,
-	0x15, 0x7f, 0x4c, 0x06, 0xdd, 0x5f, 0x4b, 0x08, 0x1f, 0xc4, 0x8c, 0xa8, 0x43, 0x39, 0xe9, 0xc1,
-	0x71, 0x09, 0xe6, 0x75, 0x3b, 0x8c, 0x1b, 0xb1, 0xa9, 0x43, 0x5c, 0x26, 0x97, 0x8f, 0x42, 0x89,
-	0x19, 0xcf, 0xea, 0x32, 0x35, 0x14, 0xa0, 0xca, 0xac, 0xaf, 0x3a, 0x6a, 0x1d, 0xa6, 0x3b, 0x6b,
-	0xe9, 0x0e, 0xb5, 0xab, 0x10, 0xb2, 0x0c, 0xb3, 0x21, 0x57, 0x4f, 0x05, 0xdd, 0x40, 0xf5, 0x40,
-	0xcd, 0xca, 0x2e, 0x86, 0x7e, 0xc4, 0x84, 0x43, 0x75, 0xc7, 0xcc, 0x5a, 0x79, 0x83, 0xec, 0x48,
-	0x75, 0x56, 0x55, 0x46, 0xe6, 0xc5, 0x52, 0x57, 0xc4, 0x9b, 0x5b, 0xd4, 0xa0, 0x91, 0xb9, 0xcf,
-	0x60, 0x41, 0xeb, 0x61, 0xe2, 0x55, 0x42, 0xaf, 0x92, 0x41, 0x8d, 0x5b, 0x33, 0x11, 0x88, 0x05,
-	0x7c, 0x11, 0xbe, 0xba, 0xbb, 0x67, 0xdc, 0x64, 0xc3, 0x8d, 0xd7, 0xe1, 0xcf, 0x00, 0xd4, 0xf3,
-	0x83, 0x79, 0x4e, 0x97, 0x31, 0x6c, 0xa4, 0xf7, 0x36, 0xc5, 0xbc, 0x0e, 0xd8, 0x63, 0xac, 0xf6,
-	0xe7, 0xcc, 0x04, 0xdd, 0x2c, 0xe6, 0x32, 0x7f, 0x28, 0xa7, 0xcb, 0xc8, 0x2a, 0xe4, 0xd8, 0x90,
-	0xbb, 0xfd, 0x31, 0xd3, 0xe6, 0x71, 0xdc, 0xf6, 0xae, 0x51, 0x27, 0xf3, 0x60, 0xea, 0x3c, 0x87,
-	0xe2, 0x64, 0x93, 0x32, 0x1d, 0xa0, 0x30, 0xd1, 0x9e, 0xc8, 0x01, 0x94, 0xf0, 0xc2, 0x38, 0x1e,
-	0x93, 0xd4, 0x0f, 0xb4, 0x9a, 0x16, 0xb6, 0x6b, 0x77, 0x27, 0x6b, 0x52, 0x7a, 0x76, 0xb3, 0x2a,
-	0x0d, 0x56, 0x11, 0xc3, 0x9b, 0x3a, 0x1a, 0x6b, 0x23, 0x58, 0xe4, 0x08, 0xbf, 0x17, 0x52, 0x39,
-	0x32, 0xda, 0x5b, 0xb4, 0x4a, 0x0a, 0xb5, 0x63, 0x70, 0x4c, 0x8e, 0xf9, 0xe9, 0xe4, 0xc8, 0xdd,
-	0x24, 0xc7, 0x53, 0xc8, 0xab, 0x17, 0x81, 0xd6, 0x95, 0x3c, 0x8a, 0x7e, 0x4e, 0x01, 0xa8, 0x2a,
-	0xcf, 0xa0, 0x10, 0xd1, 0xb0, 0xc7, 0xf4, 0xc3, 0xc7, 0x90, 0x0e, 0x10, 0xc2, 0xb7, 0x8e, 0x8a,
-	0xd6, 0x0e, 0x01, 0x0b, 0x0d, 0xf1, 0x72, 0x08, 0xec, 0xb3, 0xb0, 0x46, 0xe1, 0xf1, 0xcd, 0x32,
-	0xed, 0x52, 0xe9, 0xf6, 0xc9, 0x1b, 0xc8, 0x45, 0x7a, 0xac, 0xda, 0xa0, 0x7a, 0x7a, 0xbc, 0xb8,
-	0x87, 0x46, 0x71, 0xb8, 0xce, 0x4e, 0x12, 0x5d, 0xfb, 0x4f, 0x1a, 0x56, 0x9a, 0xfc, 0x3c, 0x0c,
-	0x38, 0xf5, 0x0c, 0xd5, 0xbe, 0x7b, 0x42, 0x5c, 0x4b, 0x61, 0xf6, 0x76, 0x0a, 0x27, 0xaf, 0xf4,
-	0xec, 0xad, 0x2b, 0xad, 0x5e, 0x52, 0xfd, 0x51, 0x78, 0x6a, 0x34, 0xc1, 0x3c, 0xe0, 0x11, 0xd2,
-	0xa2, 0xf0, 0x02, 0x16, 0xb5, 0x43, 0xc0, 0x68, 0x57, 0x8b, 0x95, 0xd6, 0xf0, 0x12, 0xc2, 0xfb,
-	0x8c, 0x76, 0x51, 0xad, 0x6e, 0xb3, 0x24, 0xf7, 0x51, 0x96, 0xe4, 0xa7, 0xb3, 0x04, 0x6e, 0xb0,
-	0xa4, 0xf6, 0x6d, 0x0a, 0x96, 0x4c, 0x7e, 0x1b, 0x6a, 0x51, 0xdd, 0x26, 0x6f, 0xd0, 0x23, 0xf5,
-	0x71, 0x7a, 0xa4, 0xaf, 0xd3, 0xe3, 0xf6, 0x25, 0xc9, 0xfc, 0x5f, 0x97, 0x64, 0x0d, 0x00, 0x13,
-	0xa4, 0xe5, 0x37, 0xab, 0x3b, 0xa0, 0x42, 0xb4, 0xf2, 0xde, 0xd7, 0x41, 0x6b, 0x7f, 0x4f, 0x4d,
-	0xd0, 0xd5, 0x9c, 0x55, 0x1f, 0xf3, 0xb7, 0xb0, 0x18, 0x77, 0x32, 0x43, 0x3c, 0x3c, 0x6a, 0x61,
-	0x9a, 0xf8, 0xdd, 0x4d, 0x48, 0xb3, 0xe9, 0x05, 0x71, 0x9d, 0xa6, 0x2d, 0x98, 0xc3, 0x32, 0x8a,
-	0x4a, 0x1a, 0x6f, 0xc2, 0xcb, 0x29, 0x6f, 0xfa, 0x9b, 0xc9, 0x37, 0xd3, 0x99, 0xe0, 0x8d, 0xdf,
-	0x01, 0x8c, 0x7f, 0x85, 0x93, 0xa7, 0xf0, 0xc4, 0xde, 0x3f, 0x3a, 0x76, 0xec, 0xe3, 0x9d, 0xe3,
-	0xb7, 0xb6, 0xf3, 0xf6, 0xd0, 0xee, 0xb4, 0x1a, 0xed, 0xbd, 0x76, 0xab, 0x59, 0x9e, 0x21, 0x2b,
-	0x40, 0x26, 0x8d, 0x3b, 0x8d, 0xe3, 0xf6, 0xbb, 0x56, 0x39, 0x45, 0x56, 0xe1, 0xf1, 0x24, 0x6e,
-	0xb5, 0x3a, 0x3b, 0x6d, 0xab, 0x7d, 0xf8, 0xba, 0x9c, 0xde, 0xf8, 0x5b, 0x1a, 0x56, 0xee, 0x96,
-	0x74, 0x52, 0x87, 0x4f, 0xad, 0xd6, 0xb1, 0xd5, 0x6e, 0xbd, 0xdb, 0xd9, 0x77, 0xec, 0x96, 0x6d,
-	0xb7, 0x8f, 0x0e, 0xef, 0x5e, 0xf7, 0x39, 0xac, 0x4d, 0xf5, 0x3c, 0xea, 0xb4, 0x0e, 0xcb, 0x29,
-	0xf2, 0x0a, 0xea, 0x53, 0x5d, 0x3a, 0xd6, 0xd1, 0xd1, 0x9e, 0x63, 0xbf, 0xdd, 0x3d, 0x68, 0x1f,
-	0x1f, 0xb7, 0x9a, 0xe5, 0x34, 0xf9, 0x21, 0xbc, 0x9c, 0xbe, 0xb4, 0xdd, 0xb2, 0x9c, 0xc6, 0xd1,
-	0xe1, 0x5e, 0xdb, 0x3a, 0x68, 0x35, 0xcb, 0x19, 0xf2, 0x02, 0x6a, 0x53, 0x9d, 0x1b, 0x47, 0x07,
-	0x9d, 0xfd, 0x96, 0x9a, 0x34, 0x4b, 0x3e, 0x85, 0xea, 0x54, 0xbf, 0xd6, 0xaf, 0x3b, 0x6d, 0xab,
-	0xd5, 0x2c, 0xcf, 0x92, 0xcf, 0xe0, 0xf9, 0xf4, 0xd9, 0x76, 0x0e, 0x1b, 0xad, 0xfd, 0x56, 0xb3,
-	0x3c, 0xb7, 0xfb, 0xc5, 0x57, 0xef, 0xd7, 0x53, 0x5f, 0xbf, 0x5f, 0x4f, 0x7d, 0xfb, 0x7e, 0x3d,
-	0xf5, 0xc7, 0x0f, 0xeb, 0x33, 0x5f, 0x7f, 0x58, 0x9f, 0xf9, 0xd7, 0x87, 0xf5, 0x99, 0xdf, 0xac,
-	0x26, 0x7f, 0x22, 0x5d, 0x8c, 0xff, 0x4f, 0xc2, 0x3f, 0x93, 0x4e, 0xe6, 0xf0, 0x6f, 0xa0, 0x2f,
-	0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0x01, 0x11, 0x08, 0x62, 0x71, 0x12, 0x00, 0x00,
+var xxx_messageInfo_EpochQuotaState proto.InternalMessageInfo
+
+func (m *EpochQuotaState) GetCreditsBlobs() uint64 {
+	if m != nil {
+		return m.CreditsBlobs
+	}
+	return 0
 }
 
-func (m *StripeReplicaProfile) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EpochQuotaState) GetSyntheticSatisfiedBlobs() uint64 {
+	if m != nil {
+		return m.SyntheticSatisfiedBlobs
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *StripeReplicaProfile) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ChallengePosition is one derived synthetic challenge for an assignment.
+type ChallengePosition struct {
+	Ordinal   uint64 `protobuf:"varint,1,opt,name=ordinal,proto3" json:"ordinal,omitempty"`
+	MduIndex  uint64 `protobuf:"varint,2,opt,name=mdu_index,json=mduIndex,proto3" json:"mdu_index,omitempty"`
+	BlobIndex uint32 `protobuf:"varint,3,opt,name=blob_index,json=blobIndex,proto3" json:"blob_index,omitempty"`
+	Satisfied bool   `protobuf:"varint,4,opt,name=satisfied,proto3" json:"satisfied,omitempty"`
 }
 
-func (m *StripeReplicaProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.M != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.M))
-		i--
-		dAtA[i] = 0x10
+func (m *ChallengePosition) Reset()         { *m = ChallengePosition{} }
+func (m *ChallengePosition) String() string { return proto.CompactTextString(m) }
+func (*ChallengePosition) ProtoMessage()    {}
+func (*ChallengePosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cb128e800f8f092, []int{15}
+}
+func (m *ChallengePosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChallengePosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChallengePosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.K != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.K))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *ChallengePosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChallengePosition.Merge(m, src)
+}
+func (m *ChallengePosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChallengePosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChallengePosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChallengePosition proto.InternalMessageInfo
+
+func (m *ChallengePosition) GetOrdinal() uint64 {
+	if m != nil {
+		return m.Ordinal
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DealSlot) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ChallengePosition) GetMduIndex() uint64 {
+	if m != nil {
+		return m.MduIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DealSlot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ChallengePosition) GetBlobIndex() uint32 {
+	if m != nil {
+		return m.BlobIndex
+	}
+	return 0
 }
 
-func (m *DealSlot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RepairTargetGen != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.RepairTargetGen))
-		i--
-		dAtA[i] = 0x30
+func (m *ChallengePosition) GetSatisfied() bool {
+	if m != nil {
+		return m.Satisfied
 	}
-	if m.StatusSinceHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.StatusSinceHeight))
-		i--
-		dAtA[i] = 0x28
+	return false
+}
+
+// ProviderUnbonding is bond collateral waiting out the unbonding period.
+type ProviderUnbonding struct {
+	Provider         string     `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	CompletionHeight uint64     `protobuf:"varint,2,opt,name=completion_height,json=completionHeight,proto3" json:"completion_height,omitempty"`
+	Amount           types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *ProviderUnbonding) Reset()         { *m = ProviderUnbonding{} }
+func (m *ProviderUnbonding) String() string { return proto.CompactTextString(m) }
+func (*ProviderUnbonding) ProtoMessage()    {}
+func (*ProviderUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cb128e800f8f092, []int{16}
+}
+func (m *ProviderUnbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderUnbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.PendingProvider) > 0 {
-		i -= len(m.PendingProvider)
-		copy(dAtA[i:], m.PendingProvider)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.PendingProvider)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Status != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *ProviderUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderUnbonding.Merge(m, src)
+}
+func (m *ProviderUnbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderUnbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderUnbonding proto.InternalMessageInfo
+
+func (m *ProviderUnbonding) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *ProviderUnbonding) GetCompletionHeight() uint64 {
+	if m != nil {
+		return m.CompletionHeight
 	}
-	if m.Slot != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Slot))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ProviderUnbonding) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *Deal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// ProviderMigration hands one deal assignment of a deregistering provider
+// over to a replacement. For Mode 2 deals the slot is repaired in place; for
+// Mode 1 deals slot is the replica index in Deal.providers.
+type ProviderMigration struct {
+	Provider         string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	DealId           uint64 `protobuf:"varint,2,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	Slot             uint32 `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Replacement      string `protobuf:"bytes,4,opt,name=replacement,proto3" json:"replacement,omitempty"`
+	CompletionHeight uint64 `protobuf:"varint,5,opt,name=completion_height,json=completionHeight,proto3" json:"completion_height,omitempty"`
+}
+
+func (m *ProviderMigration) Reset()         { *m = ProviderMigration{} }
+func (m *ProviderMigration) String() string { return proto.CompactTextString(m) }
+func (*ProviderMigration) ProtoMessage()    {}
+func (*ProviderMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cb128e800f8f092, []int{17}
+}
+func (m *ProviderMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ProviderMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderMigration.Merge(m, src)
+}
+func (m *ProviderMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderMigration.DiscardUnknown(m)
 }
 
-func (m *Deal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ProviderMigration proto.InternalMessageInfo
+
+func (m *ProviderMigration) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
 }
 
-func (m *Deal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WitnessMdus != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.WitnessMdus))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa0
+func (m *ProviderMigration) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
 	}
-	if m.CurrentGen != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CurrentGen))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
+	return 0
+}
+
+func (m *ProviderMigration) GetSlot() uint32 {
+	if m != nil {
+		return m.Slot
 	}
-	if len(m.Mode2Slots) > 0 {
-		for iNdEx := len(m.Mode2Slots) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Mode2Slots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
+	return 0
+}
+
+func (m *ProviderMigration) GetReplacement() string {
+	if m != nil {
+		return m.Replacement
 	}
-	if m.Mode2Profile != nil {
-		{
-			size, err := m.Mode2Profile.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+	return ""
+}
+
+func (m *ProviderMigration) GetCompletionHeight() uint64 {
+	if m != nil {
+		return m.CompletionHeight
 	}
-	{
-		size := m.SpendWindowSpent.Size()
-		i -= size
-		if _, err := m.SpendWindowSpent.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// DealPlacement records how a deal's providers were picked so the choice can
+// be audited later.
+type DealPlacement struct {
+	DealId  uint64            `protobuf:"varint,1,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	Choices []PlacementChoice `protobuf:"bytes,2,rep,name=choices,proto3" json:"choices"`
+}
+
+func (m *DealPlacement) Reset()         { *m = DealPlacement{} }
+func (m *DealPlacement) String() string { return proto.CompactTextString(m) }
+func (*DealPlacement) ProtoMessage()    {}
+func (*DealPlacement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cb128e800f8f092, []int{18}
+}
+func (m *DealPlacement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DealPlacement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DealPlacement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	if m.SpendWindowStartHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SpendWindowStartHeight))
-		i--
-		dAtA[i] = 0x78
+}
+func (m *DealPlacement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DealPlacement.Merge(m, src)
+}
+func (m *DealPlacement) XXX_Size() int {
+	return m.Size()
+}
+func (m *DealPlacement) XXX_DiscardUnknown() {
+	xxx_messageInfo_DealPlacement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DealPlacement proto.InternalMessageInfo
+
+func (m *DealPlacement) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
 	}
-	if m.TotalMdus != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TotalMdus))
-		i--
-		dAtA[i] = 0x70
+	return 0
+}
+
+func (m *DealPlacement) GetChoices() []PlacementChoice {
+	if m != nil {
+		return m.Choices
 	}
-	{
-		size := m.MaxMonthlySpend.Size()
-		i -= size
-		if _, err := m.MaxMonthlySpend.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return nil
+}
+
+// PlacementChoice explains one placement decision. Eligible candidates are
+// ranked by score = sha256(deal_id || block_hash || address); the first
+// candidate whose failure domains still have room under domain_limit wins.
+type PlacementChoice struct {
+	Position           uint32                `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	Provider           string                `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Height             int64                 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	BlockHash          []byte                `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Score              []byte                `protobuf:"bytes,5,opt,name=score,proto3" json:"score,omitempty"`
+	Rank               uint32                `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`
+	Candidates         uint32                `protobuf:"varint,7,opt,name=candidates,proto3" json:"candidates,omitempty"`
+	SkippedForDomain   uint32                `protobuf:"varint,8,opt,name=skipped_for_domain,json=skippedForDomain,proto3" json:"skipped_for_domain,omitempty"`
+	FailureDomain      ProviderFailureDomain `protobuf:"bytes,9,opt,name=failure_domain,json=failureDomain,proto3" json:"failure_domain"`
+	DomainLimit        uint32                `protobuf:"varint,10,opt,name=domain_limit,json=domainLimit,proto3" json:"domain_limit,omitempty"`
+	DomainLimitRelaxed bool                  `protobuf:"varint,11,opt,name=domain_limit_relaxed,json=domainLimitRelaxed,proto3" json:"domain_limit_relaxed,omitempty"`
+}
+
+func (m *PlacementChoice) Reset()         { *m = PlacementChoice{} }
+func (m *PlacementChoice) String() string { return proto.CompactTextString(m) }
+func (*PlacementChoice) ProtoMessage()    {}
+func (*PlacementChoice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cb128e800f8f092, []int{19}
+}
+func (m *PlacementChoice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlacementChoice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlacementChoice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x62
-	if len(m.ServiceHint) > 0 {
-		i -= len(m.ServiceHint)
-		copy(dAtA[i:], m.ServiceHint)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ServiceHint)))
-		i--
-		dAtA[i] = 0x5a
+}
+func (m *PlacementChoice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlacementChoice.Merge(m, src)
+}
+func (m *PlacementChoice) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlacementChoice) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlacementChoice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlacementChoice proto.InternalMessageInfo
+
+func (m *PlacementChoice) GetPosition() uint32 {
+	if m != nil {
+		return m.Position
 	}
-	if m.CurrentReplication != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CurrentReplication))
-		i--
-		dAtA[i] = 0x50
+	return 0
+}
+
+func (m *PlacementChoice) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	if m.RedundancyMode != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.RedundancyMode))
-		i--
-		dAtA[i] = 0x48
+	return ""
+}
+
+func (m *PlacementChoice) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if len(m.Providers) > 0 {
-		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Providers[iNdEx])
-			copy(dAtA[i:], m.Providers[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Providers[iNdEx])))
-			i--
-			dAtA[i] = 0x42
-		}
+	return 0
+}
+
+func (m *PlacementChoice) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
 	}
-	if m.EndBlock != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.EndBlock))
-		i--
-		dAtA[i] = 0x38
+	return nil
+}
+
+func (m *PlacementChoice) GetScore() []byte {
+	if m != nil {
+		return m.Score
 	}
-	if m.StartBlock != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.StartBlock))
-		i--
-		dAtA[i] = 0x30
+	return nil
+}
+
+func (m *PlacementChoice) GetRank() uint32 {
+	if m != nil {
+		return m.Rank
 	}
-	{
-		size := m.EscrowBalance.Size()
-		i -= size
-		if _, err := m.EscrowBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Size_ != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Size_))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ManifestRoot) > 0 {
-		i -= len(m.ManifestRoot)
-		copy(dAtA[i:], m.ManifestRoot)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ManifestRoot)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DealHeatState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PlacementChoice) GetCandidates() uint32 {
+	if m != nil {
+		return m.Candidates
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DealHeatState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PlacementChoice) GetSkippedForDomain() uint32 {
+	if m != nil {
+		return m.SkippedForDomain
+	}
+	return 0
 }
 
-func (m *DealHeatState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SuccessfulRetrievalsTotal != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SuccessfulRetrievalsTotal))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.LastUpdateHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.LastUpdateHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.FailedChallengesTotal != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.FailedChallengesTotal))
-		i--
-		dAtA[i] = 0x10
+func (m *PlacementChoice) GetFailureDomain() ProviderFailureDomain {
+	if m != nil {
+		return m.FailureDomain
 	}
-	if m.BytesServedTotal != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.BytesServedTotal))
-		i--
-		dAtA[i] = 0x8
+	return ProviderFailureDomain{}
+}
+
+func (m *PlacementChoice) GetDomainLimit() uint32 {
+	if m != nil {
+		return m.DomainLimit
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Provider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PlacementChoice) GetDomainLimitRelaxed() bool {
+	if m != nil {
+		return m.DomainLimitRelaxed
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Provider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DealAccessGrant lets a non-owner read a deal: open retrieval sessions and
+// sign retrieval receipts and gateway requests. Retrieval fees are still paid
+// from the deal's escrow, bounded by the grant's limits.
+type DealAccessGrant struct {
+	DealId        uint64                `protobuf:"varint,1,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	Grantee       string                `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	ExpiresAt     uint64                `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MaxBytes      uint64                `protobuf:"varint,4,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	BytesUsed     uint64                `protobuf:"varint,5,opt,name=bytes_used,json=bytesUsed,proto3" json:"bytes_used,omitempty"`
+	MaxFee        cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=max_fee,json=maxFee,proto3,customtype=cosmossdk.io/math.Int" json:"max_fee"`
+	FeesUsed      cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=fees_used,json=feesUsed,proto3,customtype=cosmossdk.io/math.Int" json:"fees_used"`
+	GrantedHeight int64                 `protobuf:"varint,8,opt,name=granted_height,json=grantedHeight,proto3" json:"granted_height,omitempty"`
 }
 
-func (m *Provider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Endpoints) > 0 {
-		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Endpoints[iNdEx])
-			copy(dAtA[i:], m.Endpoints[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Endpoints[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
+func (m *DealAccessGrant) Reset()         { *m = DealAccessGrant{} }
+func (m *DealAccessGrant) String() string { return proto.CompactTextString(m) }
+func (*DealAccessGrant) ProtoMessage()    {}
+func (*DealAccessGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cb128e800f8f092, []int{20}
+}
+func (m *DealAccessGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DealAccessGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DealAccessGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.ReputationScore != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ReputationScore))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *DealAccessGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DealAccessGrant.Merge(m, src)
+}
+func (m *DealAccessGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *DealAccessGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_DealAccessGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DealAccessGrant proto.InternalMessageInfo
+
+func (m *DealAccessGrant) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
 	}
-	if len(m.Capabilities) > 0 {
-		i -= len(m.Capabilities)
-		copy(dAtA[i:], m.Capabilities)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Capabilities)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *DealAccessGrant) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	if m.UsedStorage != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.UsedStorage))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *DealAccessGrant) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	if m.TotalStorage != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TotalStorage))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *DealAccessGrant) GetMaxBytes() uint64 {
+	if m != nil {
+		return m.MaxBytes
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *DealAccessGrant) GetBytesUsed() uint64 {
+	if m != nil {
+		return m.BytesUsed
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *VirtualStripe) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DealAccessGrant) GetGrantedHeight() int64 {
+	if m != nil {
+		return m.GrantedHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *VirtualStripe) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Evidence is a recorded piece of provider misbehaviour, indexed by provider
+// and by deal.
+type Evidence struct {
+	Id        uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind      EvidenceKind    `protobuf:"varint,2,opt,name=kind,proto3,enum=nilchain.nilchain.v1.EvidenceKind" json:"kind,omitempty"`
+	DealId    uint64          `protobuf:"varint,3,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	Provider  string          `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	SessionId []byte          `protobuf:"bytes,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Reporter  string          `protobuf:"bytes,6,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Height    int64           `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Outcome   EvidenceOutcome `protobuf:"varint,8,opt,name=outcome,proto3,enum=nilchain.nilchain.v1.EvidenceOutcome" json:"outcome,omitempty"`
 }
 
-func (m *VirtualStripe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OverlayProviders) > 0 {
-		for iNdEx := len(m.OverlayProviders) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.OverlayProviders[iNdEx])
-			copy(dAtA[i:], m.OverlayProviders[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.OverlayProviders[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *Evidence) Reset()         { *m = Evidence{} }
+func (m *Evidence) String() string { return proto.CompactTextString(m) }
+func (*Evidence) ProtoMessage()    {}
+func (*Evidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cb128e800f8f092, []int{21}
+}
+func (m *Evidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Evidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Evidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.StripeIndex != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.StripeIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.DealId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.DealId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *Evidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Evidence.Merge(m, src)
+}
+func (m *Evidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *Evidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Evidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Evidence proto.InternalMessageInfo
+
+func (m *Evidence) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ChainedProof) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Evidence) GetKind() EvidenceKind {
+	if m != nil {
+		return m.Kind
 	}
-	return dAtA[:n], nil
+	return EvidenceKind_EVIDENCE_KIND_UNSPECIFIED
 }
 
-func (m *ChainedProof) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Evidence) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
+	}
+	return 0
 }
 
-func (m *ChainedProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.KzgOpeningProof) > 0 {
-		i -= len(m.KzgOpeningProof)
-		copy(dAtA[i:], m.KzgOpeningProof)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.KzgOpeningProof)))
-		i--
-		dAtA[i] = 0x4a
+func (m *Evidence) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	if len(m.YValue) > 0 {
-		i -= len(m.YValue)
-		copy(dAtA[i:], m.YValue)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.YValue)))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *Evidence) GetSessionId() []byte {
+	if m != nil {
+		return m.SessionId
 	}
-	if len(m.ZValue) > 0 {
-		i -= len(m.ZValue)
-		copy(dAtA[i:], m.ZValue)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ZValue)))
-		i--
-		dAtA[i] = 0x3a
+	return nil
+}
+
+func (m *Evidence) GetReporter() string {
+	if m != nil {
+		return m.Reporter
 	}
-	if m.BlobIndex != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.BlobIndex))
-		i--
-		dAtA[i] = 0x30
+	return ""
+}
+
+func (m *Evidence) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if len(m.MerklePath) > 0 {
-		for iNdEx := len(m.MerklePath) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.MerklePath[iNdEx])
-			copy(dAtA[i:], m.MerklePath[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.MerklePath[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
+	return 0
+}
+
+func (m *Evidence) GetOutcome() EvidenceOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return EvidenceOutcome_EVIDENCE_OUTCOME_UNSPECIFIED
+}
+
+// SessionKey is a short-lived key a deal owner lets sign EIP-712 retrieval
+// receipts, download session receipts and gateway retrieval requests for some
+// of its deals, so a browser can sign without a wallet prompt per batch. The
+// bytes its receipts cover and the bandwidth they pay from escrow are metered
+// against its limits.
+type SessionKey struct {
+	Key              string                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Owner            string                `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	DealIds          []uint64              `protobuf:"varint,3,rep,packed,name=deal_ids,json=dealIds,proto3" json:"deal_ids,omitempty"`
+	ExpiresAt        uint64                `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MaxBytes         uint64                `protobuf:"varint,5,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	BytesUsed        uint64                `protobuf:"varint,6,opt,name=bytes_used,json=bytesUsed,proto3" json:"bytes_used,omitempty"`
+	MaxFee           cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=max_fee,json=maxFee,proto3,customtype=cosmossdk.io/math.Int" json:"max_fee"`
+	FeesUsed         cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=fees_used,json=feesUsed,proto3,customtype=cosmossdk.io/math.Int" json:"fees_used"`
+	RegisteredHeight int64                 `protobuf:"varint,9,opt,name=registered_height,json=registeredHeight,proto3" json:"registered_height,omitempty"`
+}
+
+func (m *SessionKey) Reset()         { *m = SessionKey{} }
+func (m *SessionKey) String() string { return proto.CompactTextString(m) }
+func (*SessionKey) ProtoMessage()    {}
+func (*SessionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cb128e800f8f092, []int{22}
+}
+func (m *SessionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.BlobCommitment) > 0 {
-		i -= len(m.BlobCommitment)
-		copy(dAtA[i:], m.BlobCommitment)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.BlobCommitment)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *SessionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionKey.Merge(m, src)
+}
+func (m *SessionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionKey proto.InternalMessageInfo
+
+func (m *SessionKey) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	if len(m.ManifestOpening) > 0 {
-		i -= len(m.ManifestOpening)
-		copy(dAtA[i:], m.ManifestOpening)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ManifestOpening)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *SessionKey) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if len(m.MduRootFr) > 0 {
-		i -= len(m.MduRootFr)
-		copy(dAtA[i:], m.MduRootFr)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.MduRootFr)))
+	return ""
+}
+
+func (m *SessionKey) GetDealIds() []uint64 {
+	if m != nil {
+		return m.DealIds
+	}
+	return nil
+}
+
+func (m *SessionKey) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *SessionKey) GetMaxBytes() uint64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+func (m *SessionKey) GetBytesUsed() uint64 {
+	if m != nil {
+		return m.BytesUsed
+	}
+	return 0
+}
+
+func (m *SessionKey) GetRegisteredHeight() int64 {
+	if m != nil {
+		return m.RegisteredHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("nilchain.nilchain.v1.SlotStatus", SlotStatus_name, SlotStatus_value)
+	proto.RegisterEnum("nilchain.nilchain.v1.DealStatus", DealStatus_name, DealStatus_value)
+	proto.RegisterEnum("nilchain.nilchain.v1.RetrievalSessionStatus", RetrievalSessionStatus_name, RetrievalSessionStatus_value)
+	proto.RegisterEnum("nilchain.nilchain.v1.EvidenceKind", EvidenceKind_name, EvidenceKind_value)
+	proto.RegisterEnum("nilchain.nilchain.v1.EvidenceOutcome", EvidenceOutcome_name, EvidenceOutcome_value)
+	proto.RegisterType((*StripeReplicaProfile)(nil), "nilchain.nilchain.v1.StripeReplicaProfile")
+	proto.RegisterType((*DealSlot)(nil), "nilchain.nilchain.v1.DealSlot")
+	proto.RegisterType((*Deal)(nil), "nilchain.nilchain.v1.Deal")
+	proto.RegisterType((*DealHeatState)(nil), "nilchain.nilchain.v1.DealHeatState")
+	proto.RegisterType((*Provider)(nil), "nilchain.nilchain.v1.Provider")
+	proto.RegisterType((*ProviderFailureDomain)(nil), "nilchain.nilchain.v1.ProviderFailureDomain")
+	proto.RegisterType((*VirtualStripe)(nil), "nilchain.nilchain.v1.VirtualStripe")
+	proto.RegisterType((*ChainedProof)(nil), "nilchain.nilchain.v1.ChainedProof")
+	proto.RegisterType((*RetrievalSession)(nil), "nilchain.nilchain.v1.RetrievalSession")
+	proto.RegisterType((*RetrievalReceipt)(nil), "nilchain.nilchain.v1.RetrievalReceipt")
+	proto.RegisterType((*RetrievalReceiptBatch)(nil), "nilchain.nilchain.v1.RetrievalReceiptBatch")
+	proto.RegisterType((*DownloadSessionReceipt)(nil), "nilchain.nilchain.v1.DownloadSessionReceipt")
+	proto.RegisterType((*SessionChunkProof)(nil), "nilchain.nilchain.v1.SessionChunkProof")
+	proto.RegisterType((*RetrievalSessionProof)(nil), "nilchain.nilchain.v1.RetrievalSessionProof")
+	proto.RegisterType((*EpochQuotaState)(nil), "nilchain.nilchain.v1.EpochQuotaState")
+	proto.RegisterType((*ChallengePosition)(nil), "nilchain.nilchain.v1.ChallengePosition")
+	proto.RegisterType((*ProviderUnbonding)(nil), "nilchain.nilchain.v1.ProviderUnbonding")
+	proto.RegisterType((*ProviderMigration)(nil), "nilchain.nilchain.v1.ProviderMigration")
+	proto.RegisterType((*DealPlacement)(nil), "nilchain.nilchain.v1.DealPlacement")
+	proto.RegisterType((*PlacementChoice)(nil), "nilchain.nilchain.v1.PlacementChoice")
+	proto.RegisterType((*DealAccessGrant)(nil), "nilchain.nilchain.v1.DealAccessGrant")
+	proto.RegisterType((*Evidence)(nil), "nilchain.nilchain.v1.Evidence")
+	proto.RegisterType((*SessionKey)(nil), "nilchain.nilchain.v1.SessionKey")
+}
+
+func init() { proto.RegisterFile("nilchain/nilchain/v1/types.proto", fileDescriptor_8cb128e800f8f092) }
+
+var fileDescriptor_8cb128e800f8f092 = []byte{
+	// 2991 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4b, 0x6f, 0x1b, 0xc9,
+	0xb5, 0x36, 0x1f, 0x12, 0xc9, 0x43, 0x52, 0xa4, 0xca, 0xb2, 0x4c, 0xd9, 0x63, 0x59, 0xa6, 0xc7,
+	0xb6, 0xae, 0xec, 0x2b, 0x5d, 0x6b, 0x06, 0xbe, 0xf7, 0x0e, 0xee, 0xcd, 0x80, 0x22, 0x5b, 0x16,
+	0x63, 0x49, 0x54, 0x9a, 0x92, 0x33, 0x48, 0x02, 0x34, 0x4a, 0xdd, 0x45, 0xb2, 0xa0, 0x66, 0x17,
+	0xd1, 0xd5, 0x94, 0x25, 0xef, 0xb2, 0xca, 0x36, 0xc8, 0x7f, 0xc8, 0x2a, 0x8b, 0x6c, 0x32, 0xab,
+	0x20, 0x8b, 0x2c, 0x02, 0xcc, 0x72, 0x10, 0x24, 0x40, 0x90, 0xc5, 0x64, 0x30, 0x46, 0x76, 0xf9,
+	0x03, 0x59, 0x25, 0xa8, 0x47, 0x37, 0x1f, 0x22, 0x25, 0xc5, 0x09, 0xb2, 0x63, 0x9d, 0x47, 0x3d,
+	0xce, 0xf9, 0xea, 0x3b, 0xa7, 0x9a, 0xb0, 0xe2, 0x51, 0xd7, 0xee, 0x60, 0xea, 0x6d, 0x44, 0x3f,
+	0x4e, 0x9f, 0x6f, 0x04, 0xe7, 0x3d, 0xc2, 0xd7, 0x7b, 0x3e, 0x0b, 0x18, 0x5a, 0x08, 0x15, 0xeb,
+	0xd1, 0x8f, 0xd3, 0xe7, 0x77, 0x16, 0xda, 0xac, 0xcd, 0xa4, 0xc1, 0x86, 0xf8, 0xa5, 0x6c, 0xef,
+	0x2c, 0xd9, 0x8c, 0x77, 0x19, 0xb7, 0x94, 0x42, 0x0d, 0xb4, 0x6a, 0x59, 0x8d, 0x36, 0x8e, 0x31,
+	0x27, 0x1b, 0xa7, 0xcf, 0x8f, 0x49, 0x80, 0x9f, 0x6f, 0xd8, 0x8c, 0x7a, 0x4a, 0x5f, 0xde, 0x84,
+	0x85, 0x66, 0xe0, 0xd3, 0x1e, 0x31, 0x49, 0xcf, 0xa5, 0x36, 0x3e, 0xf0, 0x59, 0x8b, 0xba, 0x04,
+	0xe5, 0x20, 0x76, 0x52, 0x8a, 0xad, 0xc4, 0x56, 0xf3, 0x66, 0xec, 0x44, 0x8c, 0xba, 0xa5, 0xb8,
+	0x1a, 0x75, 0xcb, 0x3f, 0x4b, 0x40, 0xba, 0x46, 0xb0, 0xdb, 0x74, 0x59, 0x80, 0x10, 0x24, 0xb9,
+	0xcb, 0x02, 0x6d, 0x2b, 0x7f, 0xa3, 0x8f, 0x21, 0xdd, 0xf3, 0xd9, 0x29, 0x75, 0x88, 0x2f, 0xbd,
+	0x32, 0x5b, 0xa5, 0xdf, 0xfe, 0xe2, 0x3f, 0x17, 0xf4, 0xc6, 0x2a, 0x8e, 0xe3, 0x13, 0xce, 0xc5,
+	0xb2, 0x5e, 0xdb, 0x8c, 0x2c, 0xd1, 0xff, 0xc0, 0x2c, 0x0f, 0x70, 0xd0, 0xe7, 0xa5, 0xc4, 0x4a,
+	0x6c, 0x75, 0x6e, 0x73, 0x65, 0x7d, 0x52, 0x08, 0xd6, 0xc5, 0xaa, 0x4d, 0x69, 0x67, 0x6a, 0x7b,
+	0x54, 0x85, 0x62, 0x8f, 0x78, 0x0e, 0xf5, 0xda, 0x56, 0xb4, 0x6e, 0xf2, 0x8a, 0x75, 0x0b, 0xda,
+	0xe3, 0x20, 0x5c, 0x7e, 0x1d, 0x6e, 0xaa, 0xe9, 0x2c, 0x4e, 0x3d, 0x9b, 0x58, 0x1d, 0x42, 0xdb,
+	0x9d, 0xa0, 0x34, 0xb3, 0x12, 0x5b, 0x4d, 0x98, 0xf3, 0x4a, 0xd5, 0x14, 0x9a, 0x1d, 0xa9, 0x40,
+	0x6b, 0x30, 0xef, 0x93, 0x1e, 0xa6, 0xbe, 0x15, 0x60, 0xbf, 0x4d, 0x02, 0xab, 0x4d, 0xbc, 0xd2,
+	0xec, 0x4a, 0x6c, 0x35, 0x69, 0x16, 0x94, 0xe2, 0x50, 0xca, 0x5f, 0x12, 0x0f, 0x7d, 0x0c, 0x8b,
+	0xda, 0xd6, 0x21, 0xd8, 0x71, 0xa9, 0x17, 0x4d, 0x9f, 0x92, 0x0e, 0x0b, 0x4a, 0x5b, 0xd3, 0x4a,
+	0xbd, 0x42, 0x0d, 0xf2, 0xda, 0xeb, 0x98, 0xf5, 0xbd, 0xe0, 0xbc, 0x94, 0x5e, 0x89, 0xad, 0x66,
+	0x37, 0x97, 0xd6, 0xf5, 0x81, 0x44, 0x4e, 0xd7, 0x75, 0x4e, 0xd7, 0xab, 0x8c, 0x7a, 0x5b, 0xc9,
+	0x2f, 0xbe, 0xba, 0x7f, 0xc3, 0xcc, 0x29, 0xaf, 0x2d, 0xe9, 0x54, 0xfe, 0x6b, 0x0a, 0x92, 0x22,
+	0x5b, 0x68, 0x0e, 0xe2, 0xd4, 0x91, 0x79, 0x4a, 0x9a, 0x71, 0xea, 0xa0, 0x87, 0x90, 0xef, 0x62,
+	0x8f, 0xb6, 0x08, 0x0f, 0x2c, 0x9f, 0xb1, 0x40, 0xa6, 0x2a, 0x67, 0xe6, 0x42, 0xa1, 0xc9, 0x74,
+	0x7a, 0xe9, 0x5b, 0x22, 0x53, 0x92, 0x34, 0xe5, 0x6f, 0xb4, 0x0e, 0x33, 0xec, 0x8d, 0x77, 0x8d,
+	0x18, 0x2b, 0x33, 0x54, 0x83, 0x39, 0xc2, 0x6d, 0x9f, 0xbd, 0xb1, 0x8e, 0xb1, 0x8b, 0x3d, 0x9b,
+	0xc8, 0xa0, 0x66, 0xb6, 0xee, 0x89, 0xdd, 0xfe, 0xf1, 0xab, 0xfb, 0xb7, 0x94, 0x33, 0x77, 0x4e,
+	0xd6, 0x29, 0xdb, 0xe8, 0xe2, 0xa0, 0xb3, 0x5e, 0xf7, 0x02, 0x33, 0xaf, 0x9c, 0xb6, 0x94, 0x0f,
+	0xba, 0x0f, 0x59, 0x1e, 0x60, 0x3f, 0xb0, 0x8e, 0x5d, 0x66, 0x9f, 0xe8, 0x48, 0x83, 0x14, 0x6d,
+	0x09, 0x09, 0xba, 0x0b, 0x19, 0xe2, 0x39, 0x5a, 0xad, 0xe2, 0x9a, 0x26, 0x9e, 0xa3, 0x94, 0x2f,
+	0x20, 0x13, 0x42, 0x83, 0x97, 0xd2, 0x2b, 0x89, 0x4b, 0xf7, 0x3d, 0x30, 0x45, 0x4f, 0xa0, 0xe0,
+	0x13, 0xa7, 0xef, 0x39, 0xd8, 0xb3, 0xcf, 0xad, 0x2e, 0x73, 0x48, 0x29, 0x23, 0x91, 0x3e, 0x37,
+	0x10, 0xef, 0x31, 0x87, 0xa0, 0x0d, 0xb8, 0x69, 0xf7, 0x7d, 0x9f, 0x78, 0x81, 0xe5, 0xab, 0xab,
+	0x14, 0x50, 0xe6, 0x95, 0x40, 0xee, 0x03, 0x69, 0x95, 0x39, 0xd0, 0xa0, 0x07, 0x90, 0xe3, 0xc4,
+	0x3f, 0xa5, 0x02, 0x6a, 0xd4, 0x0b, 0x4a, 0x59, 0x11, 0x13, 0x33, 0xab, 0x65, 0x3b, 0xd4, 0x0b,
+	0x50, 0x1d, 0xe6, 0xbb, 0xf8, 0xcc, 0xea, 0x32, 0x2f, 0xe8, 0xb8, 0xe7, 0x16, 0x17, 0x90, 0x2d,
+	0xe5, 0xae, 0x13, 0xbb, 0x42, 0x17, 0x9f, 0xed, 0x29, 0xb7, 0xa6, 0xf0, 0x42, 0xf7, 0x00, 0x02,
+	0x16, 0x60, 0xd7, 0xea, 0x3a, 0x7d, 0x5e, 0x9a, 0x93, 0xbb, 0xca, 0x48, 0xc9, 0x9e, 0xd3, 0xe7,
+	0xe8, 0x7f, 0x61, 0x49, 0xce, 0x6e, 0xbd, 0xa1, 0x9e, 0xc3, 0xde, 0x58, 0x2a, 0xd2, 0x1a, 0xa3,
+	0x05, 0x69, 0xbd, 0x28, 0x0d, 0xbe, 0x2b, 0xf5, 0x4d, 0xa1, 0xd6, 0x28, 0x7d, 0x05, 0x68, 0xd4,
+	0xb5, 0x47, 0xbc, 0xa0, 0x54, 0xbc, 0xce, 0x2e, 0x8b, 0xc3, 0x53, 0x0a, 0x37, 0xd4, 0x80, 0xbc,
+	0x88, 0xf1, 0xa6, 0xd5, 0x53, 0x3c, 0x54, 0x9a, 0x97, 0x90, 0x5f, 0x9b, 0x42, 0x05, 0x13, 0x98,
+	0xcb, 0xcc, 0xc9, 0x09, 0xf4, 0x08, 0x7d, 0x0a, 0x59, 0x35, 0xa1, 0x20, 0x26, 0x5e, 0x42, 0x2b,
+	0x89, 0xd5, 0xec, 0xe6, 0xf2, 0xe4, 0xe9, 0x42, 0x4e, 0x33, 0x41, 0xba, 0x88, 0x9f, 0x5c, 0xc0,
+	0x2e, 0xcc, 0xab, 0xb8, 0xe0, 0x37, 0x15, 0xec, 0xb4, 0x48, 0xdc, 0xed, 0x07, 0x90, 0x7b, 0x43,
+	0x03, 0x8f, 0x70, 0xae, 0x62, 0xbb, 0x20, 0x2d, 0xb2, 0x5a, 0x26, 0xa3, 0x3b, 0x60, 0xb6, 0x5b,
+	0x97, 0x31, 0x9b, 0x5c, 0x7f, 0x94, 0xd9, 0xfe, 0x1f, 0xf2, 0x21, 0xb3, 0xa9, 0x2b, 0xb7, 0x78,
+	0xc5, 0x95, 0xcb, 0x69, 0xf3, 0x86, 0xb0, 0x2e, 0xbf, 0x8b, 0x41, 0x5e, 0xcc, 0xba, 0x43, 0xb0,
+	0xe4, 0x4c, 0x82, 0x9e, 0x01, 0x3a, 0x3e, 0x0f, 0x08, 0xb7, 0x04, 0xce, 0x88, 0x63, 0x49, 0x08,
+	0x68, 0x52, 0x28, 0x4a, 0x4d, 0x53, 0x2a, 0x0e, 0x85, 0x1c, 0xbd, 0x80, 0xdb, 0x2d, 0x4c, 0x5d,
+	0xe2, 0x58, 0x76, 0x07, 0xbb, 0x2e, 0xf1, 0xda, 0x84, 0x6b, 0x97, 0xb8, 0x74, 0xb9, 0xa5, 0xd4,
+	0xd5, 0x48, 0xab, 0xfc, 0x9e, 0x01, 0x72, 0x31, 0x0f, 0xac, 0x7e, 0xcf, 0xc1, 0x41, 0xc4, 0x75,
+	0x09, 0x49, 0xa5, 0x45, 0xa1, 0x39, 0x92, 0x0a, 0x8d, 0xa0, 0x6f, 0xc1, 0x5d, 0xde, 0xb7, 0x6d,
+	0xc2, 0x79, 0xab, 0xef, 0x5a, 0x3e, 0x09, 0x7c, 0x4a, 0x4e, 0xb1, 0x1b, 0xae, 0x94, 0x94, 0x2b,
+	0x2d, 0x0d, 0x4c, 0xcc, 0xc8, 0x42, 0xae, 0x56, 0xfe, 0x4d, 0x02, 0xd2, 0x11, 0x8d, 0x6f, 0x42,
+	0x0a, 0xab, 0x88, 0x94, 0x62, 0x57, 0xc4, 0x2a, 0x34, 0x14, 0x4c, 0xa8, 0x2e, 0x07, 0x0f, 0x98,
+	0x8f, 0xdb, 0x44, 0x1f, 0x2e, 0x27, 0x85, 0x4d, 0x25, 0x13, 0x79, 0xee, 0x73, 0xe2, 0x44, 0x36,
+	0x8a, 0x11, 0xb3, 0x42, 0x16, 0x9a, 0x94, 0x21, 0x67, 0xe3, 0x1e, 0x3e, 0xa6, 0x2e, 0x0d, 0x28,
+	0xe1, 0x8a, 0x1f, 0xcd, 0x11, 0x19, 0x5a, 0x8c, 0xb0, 0x20, 0x49, 0x30, 0xca, 0xf4, 0x7f, 0x40,
+	0xd1, 0x27, 0xbd, 0x7e, 0x20, 0xc9, 0xc1, 0xe2, 0x36, 0xf3, 0x89, 0xe4, 0xb8, 0x84, 0x59, 0x18,
+	0xc8, 0x9b, 0x42, 0x8c, 0x3e, 0x90, 0x44, 0xd7, 0x63, 0xd4, 0x0b, 0x78, 0x29, 0x25, 0xb8, 0xcc,
+	0x1c, 0x08, 0xd0, 0x47, 0x90, 0x3c, 0x66, 0x9e, 0x73, 0xdd, 0x62, 0x21, 0x8d, 0xd5, 0xea, 0x1a,
+	0x12, 0xe1, 0x01, 0x33, 0x61, 0x2d, 0x53, 0xf2, 0xf0, 0x90, 0x9f, 0xc1, 0x9c, 0x48, 0x7a, 0xdf,
+	0x27, 0x96, 0xc3, 0xba, 0x98, 0x2a, 0x8e, 0xcb, 0x6e, 0x3e, 0x9d, 0x0c, 0xea, 0x30, 0x31, 0xdb,
+	0xca, 0xa7, 0x26, 0x5d, 0xf4, 0xda, 0xf9, 0xd6, 0xb0, 0xb0, 0xcc, 0xe0, 0xd6, 0x44, 0x6b, 0x71,
+	0x07, 0x59, 0x8f, 0xf8, 0x38, 0x60, 0xbe, 0xa5, 0x4b, 0x58, 0xc6, 0x84, 0x50, 0x54, 0x77, 0x44,
+	0x50, 0x7d, 0xd2, 0x16, 0x7c, 0x1b, 0x57, 0x41, 0x55, 0x23, 0xc1, 0x7a, 0x1d, 0xc6, 0x03, 0xab,
+	0xed, 0xb3, 0x7e, 0x4f, 0x66, 0x2c, 0x63, 0x66, 0x84, 0xe4, 0xa5, 0x10, 0x94, 0x7f, 0x12, 0x83,
+	0xfc, 0x6b, 0xea, 0x07, 0x7d, 0xec, 0x2a, 0x2a, 0x41, 0xb7, 0x21, 0xe5, 0x10, 0xec, 0x5a, 0x51,
+	0xa1, 0x9c, 0x15, 0xc3, 0xba, 0x23, 0xd9, 0x5a, 0x9a, 0x58, 0xd4, 0x73, 0xc8, 0x99, 0x6e, 0x86,
+	0xb2, 0x4a, 0x56, 0x17, 0x22, 0x64, 0xc0, 0x3c, 0x3b, 0x25, 0xbe, 0x8b, 0xcf, 0xad, 0x41, 0xa9,
+	0x49, 0x5c, 0x51, 0x6a, 0x8a, 0xda, 0x25, 0x3c, 0x38, 0x2f, 0xff, 0x2a, 0x0e, 0xb9, 0xaa, 0x88,
+	0x1e, 0x71, 0x0e, 0x7c, 0xc6, 0x5a, 0xa2, 0xae, 0x75, 0x9d, 0xbe, 0x5e, 0x57, 0xed, 0x2a, 0xdd,
+	0x75, 0xfa, 0x6a, 0xd1, 0x65, 0xc8, 0x0a, 0xa5, 0xa8, 0xdf, 0x56, 0xcb, 0xd7, 0x25, 0x5c, 0xd8,
+	0x8b, 0xea, 0xbd, 0xed, 0x8b, 0xc4, 0x46, 0x45, 0x9e, 0xf5, 0x88, 0x47, 0xbd, 0xb6, 0x8c, 0x43,
+	0xce, 0x2c, 0x84, 0xf2, 0x86, 0x12, 0x8b, 0x52, 0x77, 0xec, 0xb2, 0x63, 0xcb, 0x66, 0xdd, 0x2e,
+	0x0d, 0xba, 0x82, 0xc5, 0x93, 0xd2, 0x72, 0x4e, 0x88, 0xab, 0x91, 0x54, 0xa4, 0xa3, 0x4b, 0xfc,
+	0x13, 0x97, 0x58, 0x3d, 0x1c, 0x74, 0x4a, 0x33, 0x2b, 0x89, 0xd5, 0x9c, 0x09, 0x4a, 0x74, 0x80,
+	0x83, 0x8e, 0x08, 0xbb, 0x9c, 0x49, 0x6d, 0x79, 0x56, 0x86, 0x2a, 0x23, 0x24, 0x6a, 0xcf, 0xb7,
+	0x21, 0xf5, 0xd6, 0x3a, 0xc5, 0x6e, 0x9f, 0xc8, 0x32, 0x9d, 0x33, 0x67, 0xdf, 0xbe, 0x16, 0x23,
+	0xa1, 0x38, 0xd7, 0x8a, 0xb4, 0x52, 0x9c, 0x2b, 0xc5, 0x1a, 0xcc, 0x9f, 0xbc, 0x6d, 0x87, 0x07,
+	0x10, 0xe1, 0x65, 0x2d, 0x89, 0xcf, 0x9c, 0x59, 0x38, 0x79, 0xdb, 0xd6, 0x27, 0x90, 0xe1, 0x2a,
+	0xff, 0x25, 0x09, 0xc5, 0x88, 0x21, 0x9a, 0x84, 0x73, 0x0d, 0x04, 0xae, 0x7e, 0x86, 0xa9, 0xcd,
+	0x99, 0x19, 0x2d, 0xa9, 0x3b, 0xc3, 0x69, 0x8f, 0x8f, 0xa4, 0x3d, 0x6a, 0x75, 0x12, 0xd7, 0x6b,
+	0x75, 0x86, 0x3b, 0xdf, 0xe4, 0xb5, 0x3b, 0xdf, 0x0b, 0x9d, 0xd8, 0xcc, 0x84, 0x4e, 0xec, 0x31,
+	0x14, 0x54, 0x55, 0x1e, 0x80, 0x41, 0xf5, 0x40, 0x79, 0x29, 0xde, 0x0b, 0x11, 0xb1, 0x0a, 0xc5,
+	0xa8, 0x4f, 0x0a, 0x53, 0x90, 0x52, 0x2d, 0x4b, 0xd8, 0x2c, 0xe9, 0x3c, 0x84, 0x69, 0xb2, 0x45,
+	0xa3, 0x28, 0x23, 0x9e, 0x54, 0x69, 0xaa, 0xb2, 0xbe, 0x4a, 0xb3, 0x62, 0x45, 0x59, 0x16, 0x34,
+	0x1d, 0xa8, 0x2e, 0x62, 0x4b, 0x48, 0xd0, 0x02, 0xcc, 0x78, 0x4c, 0xb4, 0x73, 0xaa, 0xc9, 0x51,
+	0x03, 0x31, 0x2b, 0x39, 0xeb, 0x51, 0x9f, 0x70, 0x0b, 0xab, 0xae, 0x26, 0x69, 0x66, 0xb4, 0xa4,
+	0x12, 0x88, 0xb3, 0x8a, 0x34, 0x12, 0x27, 0xac, 0x0a, 0x39, 0x49, 0x72, 0x39, 0x25, 0xd4, 0x15,
+	0xe1, 0x11, 0xcc, 0xa9, 0xd2, 0x11, 0x59, 0xe5, 0xa5, 0x55, 0x5e, 0x4b, 0xa3, 0x06, 0x39, 0xe4,
+	0xd2, 0x39, 0x59, 0x57, 0x9f, 0x4d, 0xa6, 0xa0, 0x71, 0x34, 0x8c, 0xd5, 0xd8, 0xff, 0x03, 0x10,
+	0x2d, 0x22, 0x71, 0xac, 0x16, 0x21, 0xa5, 0xc2, 0x75, 0x1a, 0x97, 0x8c, 0x72, 0xd8, 0x26, 0xa4,
+	0xfc, 0xd3, 0xc4, 0x10, 0xdc, 0x4c, 0x62, 0x13, 0xda, 0x0b, 0xa6, 0xd3, 0xc8, 0x12, 0xa4, 0x49,
+	0x8f, 0xd9, 0x9d, 0x01, 0xd2, 0x52, 0x72, 0x5c, 0x77, 0x46, 0xa0, 0x93, 0xb8, 0x36, 0x74, 0x1e,
+	0x40, 0x6e, 0xb8, 0x9e, 0xeb, 0x62, 0x99, 0x1d, 0xaa, 0xe4, 0x68, 0x0f, 0xf2, 0xf2, 0xc2, 0x58,
+	0x0e, 0x09, 0x30, 0x75, 0x55, 0xe1, 0xc9, 0x6e, 0x96, 0x27, 0x07, 0x6b, 0x98, 0x7a, 0xc2, 0xf7,
+	0x84, 0x74, 0xaf, 0x29, 0x6f, 0x99, 0x1b, 0x4e, 0x7c, 0x8b, 0xd3, 0xb6, 0x87, 0x83, 0xbe, 0x2e,
+	0x53, 0x39, 0x33, 0x2f, 0xa4, 0xcd, 0x50, 0x38, 0x00, 0x47, 0x6a, 0x3a, 0x38, 0xd2, 0xe3, 0xe0,
+	0xb8, 0x0b, 0x99, 0x16, 0x0d, 0x79, 0x25, 0x23, 0xe9, 0x3a, 0xdd, 0xa2, 0x9a, 0x55, 0xee, 0x43,
+	0xd6, 0xc7, 0x5e, 0x9b, 0xa8, 0xe6, 0x54, 0x83, 0x0e, 0xa4, 0x48, 0xf6, 0xa3, 0xc2, 0x5b, 0x19,
+	0xb8, 0xc4, 0xd3, 0xc0, 0x4b, 0x4b, 0xc1, 0x2e, 0xf1, 0xca, 0x18, 0x6e, 0x8d, 0xa7, 0x69, 0x0b,
+	0x07, 0x76, 0x07, 0xed, 0x40, 0xda, 0x57, 0x63, 0xd1, 0x31, 0x88, 0xf6, 0xf0, 0xf1, 0x15, 0x30,
+	0x0a, 0xdd, 0x55, 0x74, 0x22, 0xef, 0xf2, 0x9f, 0xe3, 0xb0, 0x58, 0x63, 0x6f, 0x3c, 0x97, 0x61,
+	0x47, 0x43, 0xed, 0xdf, 0x0f, 0x88, 0x91, 0x10, 0x26, 0x2f, 0x86, 0x70, 0xf8, 0x4a, 0xcf, 0x5c,
+	0xb8, 0xd2, 0xa2, 0xdb, 0xed, 0xf4, 0xbd, 0x13, 0xcd, 0x09, 0xfa, 0x91, 0x25, 0x45, 0x8a, 0x14,
+	0x1e, 0x43, 0x41, 0x19, 0xb8, 0x04, 0xb7, 0x14, 0x59, 0x29, 0x0e, 0xcf, 0x4b, 0xf1, 0x2e, 0xc1,
+	0x2d, 0xc9, 0x56, 0x17, 0x51, 0x92, 0xbe, 0x14, 0x25, 0x99, 0xe9, 0x28, 0x81, 0x31, 0x94, 0x94,
+	0xbf, 0x8e, 0xc1, 0xbc, 0x8e, 0x6f, 0x55, 0x2c, 0xaa, 0xca, 0xe4, 0x18, 0x3c, 0x62, 0x97, 0xc3,
+	0x23, 0x3e, 0x0a, 0x8f, 0x8b, 0x97, 0x24, 0xf1, 0x4f, 0x5d, 0x92, 0x7b, 0x00, 0x32, 0x40, 0x8a,
+	0x7e, 0x93, 0xaa, 0x02, 0x0a, 0x89, 0x62, 0xde, 0xab, 0x2a, 0x68, 0xf9, 0x97, 0xb1, 0x21, 0xb8,
+	0xea, 0xb3, 0xaa, 0x63, 0x7e, 0x1f, 0x0a, 0x61, 0x25, 0xd3, 0xc0, 0x93, 0x47, 0xcd, 0x4e, 0x23,
+	0xbf, 0xc9, 0x80, 0xd4, 0x9b, 0x9e, 0xe3, 0x23, 0x52, 0x64, 0xc0, 0xac, 0x4c, 0x23, 0x2f, 0xc5,
+	0xe5, 0x4d, 0x78, 0x32, 0xe5, 0xdd, 0x35, 0x1e, 0x7c, 0x3d, 0x9d, 0x76, 0x2e, 0xfb, 0x50, 0x30,
+	0x04, 0x88, 0xbf, 0xd3, 0x67, 0x01, 0x56, 0xef, 0x8e, 0x87, 0x90, 0xb7, 0x7d, 0xe2, 0xd0, 0x80,
+	0xcb, 0xba, 0xc4, 0x75, 0x7e, 0x72, 0x5a, 0x28, 0x8a, 0x12, 0x47, 0x9f, 0xc0, 0x12, 0x3f, 0xf7,
+	0x82, 0x0e, 0x09, 0xa8, 0x6d, 0x71, 0x1c, 0x50, 0xde, 0xa2, 0xc4, 0xd1, 0x0e, 0x2a, 0x63, 0xb7,
+	0x23, 0x83, 0x66, 0xa8, 0x97, 0xbe, 0xe5, 0x1f, 0xc5, 0x60, 0x3e, 0x7a, 0x86, 0x1c, 0x30, 0x4e,
+	0xe5, 0x23, 0xbb, 0x04, 0x29, 0xe6, 0x3b, 0xd4, 0x8b, 0xde, 0x38, 0xe1, 0x70, 0xb4, 0xab, 0x8a,
+	0x8f, 0x75, 0x55, 0xa3, 0x0d, 0x4c, 0x62, 0xbc, 0x81, 0xf9, 0x00, 0x32, 0xd1, 0xee, 0x64, 0x72,
+	0xd3, 0xe6, 0x40, 0x50, 0xfe, 0x79, 0x0c, 0xe6, 0xc3, 0x76, 0xee, 0xc8, 0x13, 0xed, 0xb5, 0xe8,
+	0xae, 0x86, 0x6f, 0x73, 0xec, 0xda, 0xb7, 0xf9, 0x29, 0xcc, 0xdb, 0xac, 0xdb, 0x73, 0x89, 0x7c,
+	0x15, 0xe8, 0x5a, 0xa8, 0x76, 0x5b, 0x1c, 0x28, 0x74, 0x39, 0xfc, 0x6f, 0x98, 0xc5, 0x5d, 0x79,
+	0x6f, 0x13, 0xd7, 0xeb, 0xfd, 0xb5, 0x79, 0xf9, 0x4f, 0x43, 0x3b, 0xde, 0xa3, 0x6d, 0x5f, 0x7d,
+	0xa0, 0x78, 0xbf, 0x1d, 0x4f, 0x6d, 0xa5, 0xc2, 0x0f, 0x85, 0x89, 0xa1, 0x0f, 0x85, 0x9f, 0x40,
+	0x56, 0x7c, 0x2c, 0xc1, 0x36, 0x89, 0xda, 0xcd, 0xcb, 0x56, 0x19, 0x36, 0x9e, 0x1c, 0x9a, 0x99,
+	0xc9, 0xa1, 0x29, 0x33, 0xf5, 0x0e, 0x3e, 0x88, 0xbc, 0xa7, 0x12, 0xb2, 0x01, 0x29, 0xbb, 0xc3,
+	0xa8, 0x4d, 0xc2, 0x3b, 0xf0, 0x68, 0xca, 0xbb, 0x26, 0x9c, 0xaa, 0x2a, 0xad, 0x75, 0x44, 0x43,
+	0xdf, 0xf2, 0xaf, 0x13, 0x50, 0x18, 0x33, 0x41, 0x77, 0x20, 0xdd, 0xd3, 0xc0, 0xd4, 0x9f, 0x4b,
+	0xa3, 0xf1, 0x7b, 0x7e, 0x32, 0x5d, 0x84, 0xd9, 0x91, 0xb7, 0xb5, 0x1e, 0x69, 0xfc, 0xda, 0x27,
+	0x56, 0x07, 0xf3, 0x8e, 0xee, 0xe2, 0x33, 0x52, 0xb2, 0x83, 0x79, 0x47, 0xb0, 0xae, 0x7a, 0x60,
+	0xaa, 0x3e, 0x53, 0x0d, 0x44, 0x82, 0x7c, 0xec, 0x9d, 0xe8, 0x7e, 0x5d, 0xfe, 0x46, 0xcb, 0x00,
+	0x36, 0xf6, 0x1c, 0x2a, 0xba, 0x2e, 0xae, 0xdb, 0xc8, 0x21, 0x89, 0x78, 0xe8, 0xf3, 0x13, 0xda,
+	0xeb, 0x89, 0xe6, 0x89, 0xf9, 0xe1, 0x83, 0x30, 0x2d, 0xed, 0x8a, 0x5a, 0xb3, 0xcd, 0x7c, 0xfd,
+	0x8e, 0xbb, 0xf8, 0x74, 0xcc, 0xfc, 0x6b, 0x9e, 0x8e, 0xa2, 0x0d, 0x52, 0x33, 0x5a, 0x2e, 0xed,
+	0x52, 0x55, 0x33, 0xf2, 0x66, 0x56, 0xc9, 0x76, 0x85, 0x08, 0xfd, 0x17, 0x2c, 0x0c, 0x9b, 0x58,
+	0x3e, 0x71, 0xf1, 0x19, 0x71, 0x64, 0xa3, 0x90, 0x36, 0xd1, 0x90, 0xa9, 0xa9, 0x34, 0xe5, 0xdf,
+	0xc5, 0xa1, 0x20, 0x50, 0x53, 0x91, 0x1f, 0x1e, 0x5e, 0xfa, 0xf8, 0x32, 0xdc, 0x6c, 0x42, 0xaa,
+	0x2d, 0x2c, 0x08, 0xb9, 0x32, 0x7f, 0xa1, 0xe1, 0x58, 0x9d, 0x4b, 0x4c, 0xe8, 0x86, 0xc4, 0xe7,
+	0x3f, 0x55, 0xab, 0x93, 0x9a, 0xa2, 0xf0, 0x99, 0xaa, 0xd4, 0x22, 0xc5, 0xe2, 0x87, 0x25, 0x3e,
+	0x40, 0x68, 0xdc, 0x67, 0xa4, 0xe4, 0x88, 0x13, 0x07, 0xbd, 0x80, 0x94, 0xf0, 0x15, 0x1d, 0xed,
+	0xec, 0x75, 0x3a, 0xda, 0xd9, 0x2e, 0x3e, 0xdb, 0x26, 0x04, 0x7d, 0x02, 0x99, 0x16, 0x09, 0x67,
+	0x4d, 0x5d, 0xc7, 0x33, 0xdd, 0x22, 0x7a, 0xcd, 0x47, 0x30, 0xa7, 0x4e, 0x16, 0x75, 0xed, 0x69,
+	0xd5, 0xb5, 0x6b, 0xa9, 0xbe, 0x8b, 0x9f, 0xc7, 0x21, 0x6d, 0x88, 0xc4, 0x8a, 0x52, 0x3f, 0xfe,
+	0x51, 0xfa, 0x05, 0x24, 0x4f, 0xa8, 0xa7, 0xb8, 0x63, 0x6e, 0x5a, 0xf9, 0x0d, 0xbd, 0x5f, 0x51,
+	0xcf, 0x31, 0xa5, 0xfd, 0x70, 0x5e, 0x12, 0x23, 0x79, 0x79, 0xbf, 0x17, 0xd9, 0xe8, 0x7b, 0x71,
+	0x66, 0xfc, 0xbd, 0x78, 0x47, 0xf4, 0x8c, 0x3d, 0xe6, 0x07, 0xc4, 0x57, 0xe1, 0x35, 0xa3, 0xf1,
+	0xd0, 0x9d, 0x4c, 0x8d, 0xdc, 0xc9, 0x4f, 0x21, 0xc5, 0xfa, 0x81, 0xcd, 0xba, 0xaa, 0x15, 0x9a,
+	0xdb, 0x7c, 0x74, 0xf9, 0xe1, 0x1a, 0xca, 0xd8, 0x0c, 0xbd, 0xca, 0x7f, 0x8b, 0x03, 0xe8, 0xca,
+	0xfb, 0x8a, 0x9c, 0xa3, 0x35, 0x48, 0x9c, 0x90, 0xf3, 0x2b, 0x99, 0x59, 0x18, 0x0d, 0x9e, 0xb1,
+	0xf1, 0xeb, 0x3d, 0x63, 0x97, 0x20, 0xad, 0xa3, 0xa9, 0xbe, 0x60, 0x24, 0xcd, 0x94, 0x0a, 0x27,
+	0x1f, 0xc3, 0x6c, 0xf2, 0x52, 0xcc, 0xce, 0x5c, 0x8a, 0xd9, 0xd9, 0x4b, 0x30, 0x9b, 0x7a, 0x6f,
+	0xcc, 0xa6, 0xff, 0x31, 0xcc, 0x3e, 0x15, 0xff, 0xe2, 0xb4, 0x29, 0x0f, 0x88, 0x3f, 0x80, 0x6d,
+	0x46, 0x7d, 0xa8, 0x1c, 0x28, 0x14, 0x72, 0xd7, 0x7e, 0x00, 0x30, 0xf8, 0xf7, 0x09, 0xdd, 0x85,
+	0xdb, 0xcd, 0xdd, 0xc6, 0xa1, 0xd5, 0x3c, 0xac, 0x1c, 0x1e, 0x35, 0xad, 0xa3, 0xfd, 0xe6, 0x81,
+	0x51, 0xad, 0x6f, 0xd7, 0x8d, 0x5a, 0xf1, 0x06, 0x5a, 0x04, 0x34, 0xac, 0xac, 0x54, 0x0f, 0xeb,
+	0xaf, 0x8d, 0x62, 0x0c, 0x2d, 0xc1, 0xad, 0x61, 0xb9, 0x69, 0x1c, 0x54, 0xea, 0x66, 0x7d, 0xff,
+	0x65, 0x31, 0xbe, 0xe6, 0x03, 0x0c, 0xbe, 0x00, 0x8b, 0xd9, 0x6b, 0x46, 0x65, 0x77, 0xea, 0xec,
+	0xc3, 0xca, 0x68, 0xf6, 0xdb, 0x70, 0x73, 0x58, 0x6e, 0x7c, 0x76, 0x50, 0x37, 0x8d, 0x5a, 0x31,
+	0x3e, 0xee, 0x50, 0xdd, 0x6d, 0x34, 0x8d, 0x5a, 0x31, 0xb1, 0xf6, 0x79, 0x1c, 0x16, 0x27, 0x3f,
+	0x8f, 0xd1, 0x2a, 0x7c, 0x68, 0x1a, 0x87, 0x66, 0xdd, 0x78, 0x2d, 0xfc, 0x8c, 0x66, 0xb3, 0xde,
+	0xd8, 0x9f, 0xbc, 0x9b, 0x07, 0x70, 0x6f, 0xaa, 0x65, 0xe3, 0xc0, 0xd8, 0x2f, 0xc6, 0xd0, 0x33,
+	0x58, 0x9d, 0x6a, 0x72, 0x60, 0x36, 0x1a, 0xdb, 0x56, 0xf3, 0x68, 0x6b, 0xaf, 0x7e, 0x78, 0x28,
+	0x77, 0xfb, 0x14, 0x9e, 0x4c, 0x5f, 0xba, 0x69, 0x98, 0x56, 0xb5, 0xb1, 0xbf, 0x5d, 0x37, 0xf7,
+	0xc4, 0x11, 0xd0, 0x63, 0x28, 0x4f, 0x35, 0xae, 0x36, 0xf6, 0x0e, 0x76, 0x0d, 0x31, 0x69, 0x12,
+	0x7d, 0x08, 0x2b, 0x53, 0xed, 0xc2, 0x40, 0xcd, 0xa0, 0x47, 0xf0, 0x60, 0xfa, 0x6c, 0x95, 0xfd,
+	0xaa, 0xb1, 0x6b, 0xd4, 0x8a, 0xb3, 0x6b, 0xbf, 0x8f, 0x41, 0x6e, 0x98, 0x85, 0xd0, 0x3d, 0x58,
+	0x32, 0x5e, 0xd7, 0x6b, 0xc6, 0x7e, 0xd5, 0xb0, 0x5e, 0xd5, 0xf7, 0x6b, 0x63, 0x21, 0x5a, 0x85,
+	0x0f, 0x47, 0xd5, 0x83, 0x45, 0xf6, 0x1b, 0xfb, 0x96, 0x69, 0x34, 0x0f, 0x1a, 0xfb, 0x4d, 0x91,
+	0xc2, 0x75, 0x58, 0x1b, 0xb5, 0xdc, 0x36, 0x2b, 0x47, 0x35, 0x6b, 0xaf, 0xb2, 0x5f, 0xdf, 0x36,
+	0x9a, 0x87, 0x96, 0xd9, 0x68, 0x1c, 0x5a, 0x7b, 0xf5, 0xe6, 0x5e, 0xe5, 0xb0, 0xba, 0x53, 0x8c,
+	0x8b, 0x0d, 0x4f, 0xb2, 0xaf, 0xef, 0xbf, 0xae, 0xec, 0xd6, 0x6b, 0x2a, 0xb8, 0xc5, 0xc4, 0x34,
+	0xb3, 0x5a, 0xe5, 0xb0, 0x32, 0x98, 0x2d, 0xb9, 0xf6, 0xc3, 0x18, 0x14, 0xc6, 0x08, 0x08, 0xad,
+	0xc0, 0x07, 0x91, 0x6b, 0xe3, 0xe8, 0xb0, 0xda, 0xd8, 0x33, 0xc6, 0x4e, 0xf7, 0x10, 0xee, 0x5f,
+	0xb0, 0xd8, 0x31, 0x2a, 0xbb, 0x87, 0x3b, 0xd6, 0x76, 0xa5, 0xbe, 0x7b, 0x64, 0x8a, 0x83, 0x3d,
+	0x81, 0x87, 0x17, 0x8c, 0x9a, 0xbb, 0x95, 0xe6, 0x8e, 0x51, 0xb3, 0x2a, 0xfb, 0x35, 0xeb, 0xdb,
+	0x95, 0xba, 0x88, 0x6d, 0x7c, 0xeb, 0xa3, 0x2f, 0xbe, 0x59, 0x8e, 0x7d, 0xf9, 0xcd, 0x72, 0xec,
+	0xeb, 0x6f, 0x96, 0x63, 0x3f, 0x7e, 0xb7, 0x7c, 0xe3, 0xcb, 0x77, 0xcb, 0x37, 0xfe, 0xf0, 0x6e,
+	0xf9, 0xc6, 0xf7, 0x96, 0xa2, 0x3f, 0xcb, 0xcf, 0x06, 0xff, 0x9b, 0xcb, 0x3f, 0xcd, 0x8f, 0x67,
+	0xe5, 0xdf, 0xd9, 0x1f, 0xfd, 0x7d, 0x00, 0xf4, 0x54, 0x74, 0xaf, 0x59, 0x1f, 0x00, 0x00,
+}
+
+func (m *StripeReplicaProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StripeReplicaProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StripeReplicaProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.M != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.M))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.MduIndex != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.MduIndex))
+	if m.K != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.K))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RetrievalSession) Marshal() (dAtA []byte, err error) {
+func (m *DealSlot) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1962,108 +2533,69 @@ func (m *RetrievalSession) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RetrievalSession) MarshalTo(dAtA []byte) (int, error) {
+func (m *DealSlot) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RetrievalSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DealSlot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.LockedFee.Size()
-		i -= size
-		if _, err := m.LockedFee.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.RepairBounty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x7a
-	if m.Status != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.UpdatedHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.UpdatedHeight))
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.OpenedHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.OpenedHeight))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.ExpiresAt != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiresAt))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.TotalBytes != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TotalBytes))
+	dAtA[i] = 0x42
+	if m.RepairDeadlineHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RepairDeadlineHeight))
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x38
 	}
-	if m.BlobCount != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.BlobCount))
+	if m.RepairTargetGen != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RepairTargetGen))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x30
 	}
-	if m.StartBlobIndex != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.StartBlobIndex))
+	if m.StatusSinceHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StatusSinceHeight))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x28
 	}
-	if m.StartMduIndex != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.StartMduIndex))
+	if len(m.PendingProvider) > 0 {
+		i -= len(m.PendingProvider)
+		copy(dAtA[i:], m.PendingProvider)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PendingProvider)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x22
 	}
-	if len(m.ManifestRoot) > 0 {
-		i -= len(m.ManifestRoot)
-		copy(dAtA[i:], m.ManifestRoot)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ManifestRoot)))
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x18
 	}
 	if len(m.Provider) > 0 {
 		i -= len(m.Provider)
 		copy(dAtA[i:], m.Provider)
 		i = encodeVarintTypes(dAtA, i, uint64(len(m.Provider)))
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.DealId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.DealId))
-		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.SessionId) > 0 {
-		i -= len(m.SessionId)
-		copy(dAtA[i:], m.SessionId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.SessionId)))
+	if m.Slot != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Slot))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RetrievalReceipt) Marshal() (dAtA []byte, err error) {
+func (m *Deal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2073,86 +2605,182 @@ func (m *RetrievalReceipt) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RetrievalReceipt) MarshalTo(dAtA []byte) (int, error) {
+func (m *Deal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RetrievalReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Deal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RangeLen != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.RangeLen))
+	if len(m.PendingOwner) > 0 {
+		i -= len(m.PendingOwner)
+		copy(dAtA[i:], m.PendingOwner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PendingOwner)))
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	if m.RangeStart != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.RangeStart))
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	if len(m.FilePath) > 0 {
-		i -= len(m.FilePath)
-		copy(dAtA[i:], m.FilePath)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.FilePath)))
+	if m.WitnessMdus != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WitnessMdus))
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	if m.ExpiresAt != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiresAt))
+	if m.CurrentGen != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CurrentGen))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+	if len(m.Mode2Slots) > 0 {
+		for iNdEx := len(m.Mode2Slots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mode2Slots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.Mode2Profile != nil {
+		{
+			size, err := m.Mode2Profile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	if len(m.UserSignature) > 0 {
-		i -= len(m.UserSignature)
-		copy(dAtA[i:], m.UserSignature)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.UserSignature)))
+	{
+		size := m.SpendWindowSpent.Size()
+		i -= size
+		if _, err := m.SpendWindowSpent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.SpendWindowStartHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpendWindowStartHeight))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x78
+	}
+	if m.TotalMdus != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalMdus))
+		i--
+		dAtA[i] = 0x70
 	}
 	{
-		size, err := m.ProofDetails.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.MaxMonthlySpend.Size()
+		i -= size
+		if _, err := m.MaxMonthlySpend.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.ServiceHint) > 0 {
+		i -= len(m.ServiceHint)
+		copy(dAtA[i:], m.ServiceHint)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ServiceHint)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.CurrentReplication != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CurrentReplication))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RedundancyMode != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RedundancyMode))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Providers[iNdEx])
+			copy(dAtA[i:], m.Providers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Providers[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.EndBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.EscrowBalance.Size()
 		i -= size
+		if _, err := m.EscrowBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x2a
-	if m.BytesServed != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.BytesServed))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Provider)))
+	if m.Size_ != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Size_))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if m.EpochId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.EpochId))
+	if len(m.ManifestRoot) > 0 {
+		i -= len(m.ManifestRoot)
+		copy(dAtA[i:], m.ManifestRoot)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ManifestRoot)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.DealId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.DealId))
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RetrievalReceiptBatch) Marshal() (dAtA []byte, err error) {
+func (m *DealHeatState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2162,34 +2790,40 @@ func (m *RetrievalReceiptBatch) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RetrievalReceiptBatch) MarshalTo(dAtA []byte) (int, error) {
+func (m *DealHeatState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RetrievalReceiptBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DealHeatState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Receipts) > 0 {
-		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.SuccessfulRetrievalsTotal != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SuccessfulRetrievalsTotal))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastUpdateHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastUpdateHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FailedChallengesTotal != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FailedChallengesTotal))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BytesServedTotal != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BytesServedTotal))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DownloadSessionReceipt) Marshal() (dAtA []byte, err error) {
+func (m *Provider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2199,66 +2833,164 @@ func (m *DownloadSessionReceipt) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DownloadSessionReceipt) MarshalTo(dAtA []byte) (int, error) {
+func (m *Provider) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DownloadSessionReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Provider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ExpiresAt != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiresAt))
-		i--
-		dAtA[i] = 0x50
+	{
+		size, err := m.FailureDomain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+	i--
+	dAtA[i] = 0x52
+	if m.ReservedStorage != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReservedStorage))
 		i--
 		dAtA[i] = 0x48
 	}
-	if len(m.UserSignature) > 0 {
-		i -= len(m.UserSignature)
-		copy(dAtA[i:], m.UserSignature)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.UserSignature)))
-		i--
-		dAtA[i] = 0x42
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if len(m.ChunkLeafRoot) > 0 {
-		i -= len(m.ChunkLeafRoot)
-		copy(dAtA[i:], m.ChunkLeafRoot)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChunkLeafRoot)))
-		i--
-		dAtA[i] = 0x3a
+	i--
+	dAtA[i] = 0x42
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Endpoints[iNdEx])
+			copy(dAtA[i:], m.Endpoints[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Endpoints[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	if m.ChunkCount != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ChunkCount))
+	if m.ReputationScore != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReputationScore))
 		i--
 		dAtA[i] = 0x30
 	}
-	if m.TotalBytes != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TotalBytes))
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Status)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x2a
 	}
-	if len(m.FilePath) > 0 {
-		i -= len(m.FilePath)
-		copy(dAtA[i:], m.FilePath)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.FilePath)))
+	if len(m.Capabilities) > 0 {
+		i -= len(m.Capabilities)
+		copy(dAtA[i:], m.Capabilities)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Capabilities)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Provider)))
+	if m.UsedStorage != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UsedStorage))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalStorage != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalStorage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderFailureDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderFailureDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderFailureDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostGroup) > 0 {
+		i -= len(m.HostGroup)
+		copy(dAtA[i:], m.HostGroup)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.HostGroup)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.EpochId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.EpochId))
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorId) > 0 {
+		i -= len(m.OperatorId)
+		copy(dAtA[i:], m.OperatorId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OperatorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VirtualStripe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VirtualStripe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VirtualStripe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OverlayProviders) > 0 {
+		for iNdEx := len(m.OverlayProviders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OverlayProviders[iNdEx])
+			copy(dAtA[i:], m.OverlayProviders[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.OverlayProviders[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.StripeIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StripeIndex))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -2270,7 +3002,7 @@ func (m *DownloadSessionReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *SessionChunkProof) Marshal() (dAtA []byte, err error) {
+func (m *ChainedProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2280,16 +3012,42 @@ func (m *SessionChunkProof) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SessionChunkProof) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChainedProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SessionChunkProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChainedProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.KzgOpeningProof) > 0 {
+		i -= len(m.KzgOpeningProof)
+		copy(dAtA[i:], m.KzgOpeningProof)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.KzgOpeningProof)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.YValue) > 0 {
+		i -= len(m.YValue)
+		copy(dAtA[i:], m.YValue)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.YValue)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ZValue) > 0 {
+		i -= len(m.ZValue)
+		copy(dAtA[i:], m.ZValue)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ZValue)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.BlobIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlobIndex))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.MerklePath) > 0 {
 		for iNdEx := len(m.MerklePath) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.MerklePath[iNdEx])
@@ -2299,35 +3057,36 @@ func (m *SessionChunkProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x2a
 		}
 	}
-	if m.LeafIndex != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.LeafIndex))
+	if len(m.BlobCommitment) > 0 {
+		i -= len(m.BlobCommitment)
+		copy(dAtA[i:], m.BlobCommitment)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BlobCommitment)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	{
-		size, err := m.ProofDetails.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+	if len(m.ManifestOpening) > 0 {
+		i -= len(m.ManifestOpening)
+		copy(dAtA[i:], m.ManifestOpening)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ManifestOpening)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.RangeLen != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.RangeLen))
+	if len(m.MduRootFr) > 0 {
+		i -= len(m.MduRootFr)
+		copy(dAtA[i:], m.MduRootFr)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MduRootFr)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.RangeStart != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.RangeStart))
+	if m.MduIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MduIndex))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RetrievalSessionProof) Marshal() (dAtA []byte, err error) {
+func (m *RetrievalSession) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2337,536 +3096,3595 @@ func (m *RetrievalSessionProof) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RetrievalSessionProof) MarshalTo(dAtA []byte) (int, error) {
+func (m *RetrievalSession) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RetrievalSessionProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RetrievalSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Chunks) > 0 {
-		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
 	{
-		size, err := m.SessionReceipt.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.LockedFee.Size()
+		i -= size
+		if _, err := m.LockedFee.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	dAtA[i] = 0x7a
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x70
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *StripeReplicaProfile) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.UpdatedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UpdatedHeight))
+		i--
+		dAtA[i] = 0x68
 	}
-	var l int
-	_ = l
-	if m.K != 0 {
-		n += 1 + sovTypes(uint64(m.K))
+	if m.OpenedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OpenedHeight))
+		i--
+		dAtA[i] = 0x60
 	}
-	if m.M != 0 {
-		n += 1 + sovTypes(uint64(m.M))
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x58
 	}
-	return n
-}
-
-func (m *DealSlot) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x50
 	}
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovTypes(uint64(m.Slot))
+	if m.TotalBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalBytes))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.BlobCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlobCount))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.Status != 0 {
-		n += 1 + sovTypes(uint64(m.Status))
+	if m.StartBlobIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartBlobIndex))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.PendingProvider)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.StartMduIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartMduIndex))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.StatusSinceHeight != 0 {
-		n += 1 + sovTypes(uint64(m.StatusSinceHeight))
+	if len(m.ManifestRoot) > 0 {
+		i -= len(m.ManifestRoot)
+		copy(dAtA[i:], m.ManifestRoot)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ManifestRoot)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.RepairTargetGen != 0 {
-		n += 1 + sovTypes(uint64(m.RepairTargetGen))
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DealId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Deal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RetrievalReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RetrievalReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetrievalReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTypes(uint64(m.Id))
+	if m.RangeLen != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RangeLen))
+		i--
+		dAtA[i] = 0x58
 	}
-	l = len(m.ManifestRoot)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.RangeStart != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RangeStart))
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.Size_ != 0 {
-		n += 1 + sovTypes(uint64(m.Size_))
+	if len(m.FilePath) > 0 {
+		i -= len(m.FilePath)
+		copy(dAtA[i:], m.FilePath)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FilePath)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = m.EscrowBalance.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.StartBlock != 0 {
-		n += 1 + sovTypes(uint64(m.StartBlock))
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.EndBlock != 0 {
-		n += 1 + sovTypes(uint64(m.EndBlock))
+	if len(m.UserSignature) > 0 {
+		i -= len(m.UserSignature)
+		copy(dAtA[i:], m.UserSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.UserSignature)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.Providers) > 0 {
-		for _, s := range m.Providers {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
+	{
+		size, err := m.ProofDetails.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if m.RedundancyMode != 0 {
-		n += 1 + sovTypes(uint64(m.RedundancyMode))
-	}
-	if m.CurrentReplication != 0 {
-		n += 1 + sovTypes(uint64(m.CurrentReplication))
-	}
-	l = len(m.ServiceHint)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = m.MaxMonthlySpend.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.TotalMdus != 0 {
-		n += 1 + sovTypes(uint64(m.TotalMdus))
-	}
-	if m.SpendWindowStartHeight != 0 {
-		n += 1 + sovTypes(uint64(m.SpendWindowStartHeight))
-	}
-	l = m.SpendWindowSpent.Size()
-	n += 2 + l + sovTypes(uint64(l))
-	if m.Mode2Profile != nil {
-		l = m.Mode2Profile.Size()
-		n += 2 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if m.BytesServed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BytesServed))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.Mode2Slots) > 0 {
-		for _, e := range m.Mode2Slots {
-			l = e.Size()
-			n += 2 + l + sovTypes(uint64(l))
-		}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.CurrentGen != 0 {
-		n += 2 + sovTypes(uint64(m.CurrentGen))
+	if m.EpochId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.WitnessMdus != 0 {
-		n += 2 + sovTypes(uint64(m.WitnessMdus))
+	if m.DealId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DealHeatState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RetrievalReceiptBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RetrievalReceiptBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetrievalReceiptBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BytesServedTotal != 0 {
-		n += 1 + sovTypes(uint64(m.BytesServedTotal))
-	}
-	if m.FailedChallengesTotal != 0 {
-		n += 1 + sovTypes(uint64(m.FailedChallengesTotal))
-	}
-	if m.LastUpdateHeight != 0 {
-		n += 1 + sovTypes(uint64(m.LastUpdateHeight))
-	}
-	if m.SuccessfulRetrievalsTotal != 0 {
-		n += 1 + sovTypes(uint64(m.SuccessfulRetrievalsTotal))
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Provider) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DownloadSessionReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DownloadSessionReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DownloadSessionReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.TotalStorage != 0 {
-		n += 1 + sovTypes(uint64(m.TotalStorage))
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.UsedStorage != 0 {
-		n += 1 + sovTypes(uint64(m.UsedStorage))
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.Capabilities)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.UserSignature) > 0 {
+		i -= len(m.UserSignature)
+		copy(dAtA[i:], m.UserSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.UserSignature)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.ChunkLeafRoot) > 0 {
+		i -= len(m.ChunkLeafRoot)
+		copy(dAtA[i:], m.ChunkLeafRoot)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChunkLeafRoot)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.ReputationScore != 0 {
-		n += 1 + sovTypes(uint64(m.ReputationScore))
+	if m.ChunkCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChunkCount))
+		i--
+		dAtA[i] = 0x30
 	}
-	if len(m.Endpoints) > 0 {
-		for _, s := range m.Endpoints {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if m.TotalBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalBytes))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
+	if len(m.FilePath) > 0 {
+		i -= len(m.FilePath)
+		copy(dAtA[i:], m.FilePath)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FilePath)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EpochId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DealId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *VirtualStripe) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SessionChunkProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionChunkProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionChunkProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DealId != 0 {
-		n += 1 + sovTypes(uint64(m.DealId))
+	if len(m.MerklePath) > 0 {
+		for iNdEx := len(m.MerklePath) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MerklePath[iNdEx])
+			copy(dAtA[i:], m.MerklePath[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.MerklePath[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.StripeIndex != 0 {
-		n += 1 + sovTypes(uint64(m.StripeIndex))
+	if m.LeafIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LeafIndex))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.OverlayProviders) > 0 {
-		for _, s := range m.OverlayProviders {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
+	{
+		size, err := m.ProofDetails.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if m.RangeLen != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RangeLen))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RangeStart != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RangeStart))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ChainedProof) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RetrievalSessionProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RetrievalSessionProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetrievalSessionProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MduIndex != 0 {
-		n += 1 + sovTypes(uint64(m.MduIndex))
-	}
-	l = len(m.MduRootFr)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.ManifestOpening)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.BlobCommitment)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if len(m.MerklePath) > 0 {
-		for _, b := range m.MerklePath {
-			l = len(b)
-			n += 1 + l + sovTypes(uint64(l))
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.BlobIndex != 0 {
-		n += 1 + sovTypes(uint64(m.BlobIndex))
+	{
+		size, err := m.SessionReceipt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	l = len(m.ZValue)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochQuotaState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.YValue)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EpochQuotaState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochQuotaState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SyntheticSatisfiedBlobs != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SyntheticSatisfiedBlobs))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.KzgOpeningProof)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.CreditsBlobs != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CreditsBlobs))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RetrievalSession) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChallengePosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChallengePosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChallengePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SessionId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Satisfied {
+		i--
+		if m.Satisfied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.DealId != 0 {
-		n += 1 + sovTypes(uint64(m.DealId))
+	if m.BlobIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlobIndex))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.MduIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MduIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Ordinal != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Ordinal))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.ManifestRoot)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.StartMduIndex != 0 {
-		n += 1 + sovTypes(uint64(m.StartMduIndex))
-	}
-	if m.StartBlobIndex != 0 {
-		n += 1 + sovTypes(uint64(m.StartBlobIndex))
-	}
-	if m.BlobCount != 0 {
-		n += 1 + sovTypes(uint64(m.BlobCount))
-	}
-	if m.TotalBytes != 0 {
-		n += 1 + sovTypes(uint64(m.TotalBytes))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovTypes(uint64(m.Nonce))
-	}
-	if m.ExpiresAt != 0 {
-		n += 1 + sovTypes(uint64(m.ExpiresAt))
-	}
-	if m.OpenedHeight != 0 {
-		n += 1 + sovTypes(uint64(m.OpenedHeight))
-	}
-	if m.UpdatedHeight != 0 {
-		n += 1 + sovTypes(uint64(m.UpdatedHeight))
-	}
-	if m.Status != 0 {
-		n += 1 + sovTypes(uint64(m.Status))
-	}
-	l = m.LockedFee.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RetrievalReceipt) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProviderUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DealId != 0 {
-		n += 1 + sovTypes(uint64(m.DealId))
-	}
-	if m.EpochId != 0 {
-		n += 1 + sovTypes(uint64(m.EpochId))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.CompletionHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CompletionHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.BytesServed != 0 {
-		n += 1 + sovTypes(uint64(m.BytesServed))
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.ProofDetails.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.UserSignature)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovTypes(uint64(m.Nonce))
+	return dAtA[:n], nil
+}
+
+func (m *ProviderMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CompletionHeight))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.ExpiresAt != 0 {
-		n += 1 + sovTypes(uint64(m.ExpiresAt))
+	if len(m.Replacement) > 0 {
+		i -= len(m.Replacement)
+		copy(dAtA[i:], m.Replacement)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Replacement)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.FilePath)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Slot != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.RangeStart != 0 {
-		n += 1 + sovTypes(uint64(m.RangeStart))
+	if m.DealId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.RangeLen != 0 {
-		n += 1 + sovTypes(uint64(m.RangeLen))
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RetrievalReceiptBatch) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DealPlacement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DealPlacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DealPlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Receipts) > 0 {
-		for _, e := range m.Receipts {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if len(m.Choices) > 0 {
+		for iNdEx := len(m.Choices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Choices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if m.DealId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DownloadSessionReceipt) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PlacementChoice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PlacementChoice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlacementChoice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DealId != 0 {
-		n += 1 + sovTypes(uint64(m.DealId))
+	if m.DomainLimitRelaxed {
+		i--
+		if m.DomainLimitRelaxed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.EpochId != 0 {
-		n += 1 + sovTypes(uint64(m.EpochId))
+	if m.DomainLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DomainLimit))
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	{
+		size, err := m.FailureDomain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	l = len(m.FilePath)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0x4a
+	if m.SkippedForDomain != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SkippedForDomain))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.TotalBytes != 0 {
-		n += 1 + sovTypes(uint64(m.TotalBytes))
+	if m.Candidates != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Candidates))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.ChunkCount != 0 {
-		n += 1 + sovTypes(uint64(m.ChunkCount))
+	if m.Rank != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Rank))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.ChunkLeafRoot)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Score) > 0 {
+		i -= len(m.Score)
+		copy(dAtA[i:], m.Score)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Score)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.UserSignature)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovTypes(uint64(m.Nonce))
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.ExpiresAt != 0 {
-		n += 1 + sovTypes(uint64(m.ExpiresAt))
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Position != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SessionChunkProof) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DealAccessGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.RangeStart != 0 {
-		n += 1 + sovTypes(uint64(m.RangeStart))
+	return dAtA[:n], nil
+}
+
+func (m *DealAccessGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DealAccessGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GrantedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GrantedHeight))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.RangeLen != 0 {
-		n += 1 + sovTypes(uint64(m.RangeLen))
+	{
+		size := m.FeesUsed.Size()
+		i -= size
+		if _, err := m.FeesUsed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	l = m.ProofDetails.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.LeafIndex != 0 {
-		n += 1 + sovTypes(uint64(m.LeafIndex))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxFee.Size()
+		i -= size
+		if _, err := m.MaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if len(m.MerklePath) > 0 {
-		for _, b := range m.MerklePath {
-			l = len(b)
-			n += 1 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	if m.BytesUsed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BytesUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DealId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Evidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Evidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Evidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Outcome != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DealId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DealId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Kind != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RegisteredHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RegisteredHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.FeesUsed.Size()
+		i -= size
+		if _, err := m.FeesUsed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MaxFee.Size()
+		i -= size
+		if _, err := m.MaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.BytesUsed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BytesUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DealIds) > 0 {
+		dAtA11 := make([]byte, len(m.DealIds)*10)
+		var j10 int
+		for _, num := range m.DealIds {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintTypes(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RetrievalSessionProof) Size() (n int) {
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StripeReplicaProfile) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.SessionReceipt.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if len(m.Chunks) > 0 {
-		for _, e := range m.Chunks {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if m.K != 0 {
+		n += 1 + sovTypes(uint64(m.K))
+	}
+	if m.M != 0 {
+		n += 1 + sovTypes(uint64(m.M))
 	}
 	return n
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *DealSlot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovTypes(uint64(m.Slot))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	l = len(m.PendingProvider)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.StatusSinceHeight != 0 {
+		n += 1 + sovTypes(uint64(m.StatusSinceHeight))
+	}
+	if m.RepairTargetGen != 0 {
+		n += 1 + sovTypes(uint64(m.RepairTargetGen))
+	}
+	if m.RepairDeadlineHeight != 0 {
+		n += 1 + sovTypes(uint64(m.RepairDeadlineHeight))
+	}
+	l = m.RepairBounty.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
 }
-func (m *StripeReplicaProfile) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+
+func (m *Deal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.ManifestRoot)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovTypes(uint64(m.Size_))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.EscrowBalance.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.StartBlock != 0 {
+		n += 1 + sovTypes(uint64(m.StartBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovTypes(uint64(m.EndBlock))
+	}
+	if len(m.Providers) > 0 {
+		for _, s := range m.Providers {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.RedundancyMode != 0 {
+		n += 1 + sovTypes(uint64(m.RedundancyMode))
+	}
+	if m.CurrentReplication != 0 {
+		n += 1 + sovTypes(uint64(m.CurrentReplication))
+	}
+	l = len(m.ServiceHint)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.MaxMonthlySpend.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.TotalMdus != 0 {
+		n += 1 + sovTypes(uint64(m.TotalMdus))
+	}
+	if m.SpendWindowStartHeight != 0 {
+		n += 1 + sovTypes(uint64(m.SpendWindowStartHeight))
+	}
+	l = m.SpendWindowSpent.Size()
+	n += 2 + l + sovTypes(uint64(l))
+	if m.Mode2Profile != nil {
+		l = m.Mode2Profile.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if len(m.Mode2Slots) > 0 {
+		for _, e := range m.Mode2Slots {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.CurrentGen != 0 {
+		n += 2 + sovTypes(uint64(m.CurrentGen))
+	}
+	if m.WitnessMdus != 0 {
+		n += 2 + sovTypes(uint64(m.WitnessMdus))
+	}
+	if m.Status != 0 {
+		n += 2 + sovTypes(uint64(m.Status))
+	}
+	l = len(m.PendingOwner)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *DealHeatState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BytesServedTotal != 0 {
+		n += 1 + sovTypes(uint64(m.BytesServedTotal))
+	}
+	if m.FailedChallengesTotal != 0 {
+		n += 1 + sovTypes(uint64(m.FailedChallengesTotal))
+	}
+	if m.LastUpdateHeight != 0 {
+		n += 1 + sovTypes(uint64(m.LastUpdateHeight))
+	}
+	if m.SuccessfulRetrievalsTotal != 0 {
+		n += 1 + sovTypes(uint64(m.SuccessfulRetrievalsTotal))
+	}
+	return n
+}
+
+func (m *Provider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TotalStorage != 0 {
+		n += 1 + sovTypes(uint64(m.TotalStorage))
+	}
+	if m.UsedStorage != 0 {
+		n += 1 + sovTypes(uint64(m.UsedStorage))
+	}
+	l = len(m.Capabilities)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ReputationScore != 0 {
+		n += 1 + sovTypes(uint64(m.ReputationScore))
+	}
+	if len(m.Endpoints) > 0 {
+		for _, s := range m.Endpoints {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.ReservedStorage != 0 {
+		n += 1 + sovTypes(uint64(m.ReservedStorage))
+	}
+	l = m.FailureDomain.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ProviderFailureDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.HostGroup)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *VirtualStripe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		n += 1 + sovTypes(uint64(m.DealId))
+	}
+	if m.StripeIndex != 0 {
+		n += 1 + sovTypes(uint64(m.StripeIndex))
+	}
+	if len(m.OverlayProviders) > 0 {
+		for _, s := range m.OverlayProviders {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChainedProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MduIndex != 0 {
+		n += 1 + sovTypes(uint64(m.MduIndex))
+	}
+	l = len(m.MduRootFr)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ManifestOpening)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.BlobCommitment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.MerklePath) > 0 {
+		for _, b := range m.MerklePath {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.BlobIndex != 0 {
+		n += 1 + sovTypes(uint64(m.BlobIndex))
+	}
+	l = len(m.ZValue)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.YValue)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.KzgOpeningProof)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *RetrievalSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovTypes(uint64(m.DealId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ManifestRoot)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.StartMduIndex != 0 {
+		n += 1 + sovTypes(uint64(m.StartMduIndex))
+	}
+	if m.StartBlobIndex != 0 {
+		n += 1 + sovTypes(uint64(m.StartBlobIndex))
+	}
+	if m.BlobCount != 0 {
+		n += 1 + sovTypes(uint64(m.BlobCount))
+	}
+	if m.TotalBytes != 0 {
+		n += 1 + sovTypes(uint64(m.TotalBytes))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiresAt))
+	}
+	if m.OpenedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.OpenedHeight))
+	}
+	if m.UpdatedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.UpdatedHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	l = m.LockedFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *RetrievalReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		n += 1 + sovTypes(uint64(m.DealId))
+	}
+	if m.EpochId != 0 {
+		n += 1 + sovTypes(uint64(m.EpochId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.BytesServed != 0 {
+		n += 1 + sovTypes(uint64(m.BytesServed))
+	}
+	l = m.ProofDetails.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.UserSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiresAt))
+	}
+	l = len(m.FilePath)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.RangeStart != 0 {
+		n += 1 + sovTypes(uint64(m.RangeStart))
+	}
+	if m.RangeLen != 0 {
+		n += 1 + sovTypes(uint64(m.RangeLen))
+	}
+	return n
+}
+
+func (m *RetrievalReceiptBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Receipts) > 0 {
+		for _, e := range m.Receipts {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DownloadSessionReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		n += 1 + sovTypes(uint64(m.DealId))
+	}
+	if m.EpochId != 0 {
+		n += 1 + sovTypes(uint64(m.EpochId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.FilePath)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TotalBytes != 0 {
+		n += 1 + sovTypes(uint64(m.TotalBytes))
+	}
+	if m.ChunkCount != 0 {
+		n += 1 + sovTypes(uint64(m.ChunkCount))
+	}
+	l = len(m.ChunkLeafRoot)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.UserSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *SessionChunkProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RangeStart != 0 {
+		n += 1 + sovTypes(uint64(m.RangeStart))
+	}
+	if m.RangeLen != 0 {
+		n += 1 + sovTypes(uint64(m.RangeLen))
+	}
+	l = m.ProofDetails.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.LeafIndex != 0 {
+		n += 1 + sovTypes(uint64(m.LeafIndex))
+	}
+	if len(m.MerklePath) > 0 {
+		for _, b := range m.MerklePath {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RetrievalSessionProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SessionReceipt.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochQuotaState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreditsBlobs != 0 {
+		n += 1 + sovTypes(uint64(m.CreditsBlobs))
+	}
+	if m.SyntheticSatisfiedBlobs != 0 {
+		n += 1 + sovTypes(uint64(m.SyntheticSatisfiedBlobs))
+	}
+	return n
+}
+
+func (m *ChallengePosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ordinal != 0 {
+		n += 1 + sovTypes(uint64(m.Ordinal))
+	}
+	if m.MduIndex != 0 {
+		n += 1 + sovTypes(uint64(m.MduIndex))
+	}
+	if m.BlobIndex != 0 {
+		n += 1 + sovTypes(uint64(m.BlobIndex))
+	}
+	if m.Satisfied {
+		n += 2
+	}
+	return n
+}
+
+func (m *ProviderUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CompletionHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CompletionHeight))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ProviderMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovTypes(uint64(m.DealId))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovTypes(uint64(m.Slot))
+	}
+	l = len(m.Replacement)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CompletionHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CompletionHeight))
+	}
+	return n
+}
+
+func (m *DealPlacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		n += 1 + sovTypes(uint64(m.DealId))
+	}
+	if len(m.Choices) > 0 {
+		for _, e := range m.Choices {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PlacementChoice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Position != 0 {
+		n += 1 + sovTypes(uint64(m.Position))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Score)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Rank != 0 {
+		n += 1 + sovTypes(uint64(m.Rank))
+	}
+	if m.Candidates != 0 {
+		n += 1 + sovTypes(uint64(m.Candidates))
+	}
+	if m.SkippedForDomain != 0 {
+		n += 1 + sovTypes(uint64(m.SkippedForDomain))
+	}
+	l = m.FailureDomain.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.DomainLimit != 0 {
+		n += 1 + sovTypes(uint64(m.DomainLimit))
+	}
+	if m.DomainLimitRelaxed {
+		n += 2
+	}
+	return n
+}
+
+func (m *DealAccessGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DealId != 0 {
+		n += 1 + sovTypes(uint64(m.DealId))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiresAt))
+	}
+	if m.MaxBytes != 0 {
+		n += 1 + sovTypes(uint64(m.MaxBytes))
+	}
+	if m.BytesUsed != 0 {
+		n += 1 + sovTypes(uint64(m.BytesUsed))
+	}
+	l = m.MaxFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.FeesUsed.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.GrantedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.GrantedHeight))
+	}
+	return n
+}
+
+func (m *Evidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovTypes(uint64(m.Kind))
+	}
+	if m.DealId != 0 {
+		n += 1 + sovTypes(uint64(m.DealId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovTypes(uint64(m.Outcome))
+	}
+	return n
+}
+
+func (m *SessionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.DealIds) > 0 {
+		l = 0
+		for _, e := range m.DealIds {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiresAt))
+	}
+	if m.MaxBytes != 0 {
+		n += 1 + sovTypes(uint64(m.MaxBytes))
+	}
+	if m.BytesUsed != 0 {
+		n += 1 + sovTypes(uint64(m.BytesUsed))
+	}
+	l = m.MaxFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.FeesUsed.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.RegisteredHeight != 0 {
+		n += 1 + sovTypes(uint64(m.RegisteredHeight))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StripeReplicaProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StripeReplicaProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StripeReplicaProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field K", wireType)
+			}
+			m.K = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.K |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M", wireType)
+			}
+			m.M = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.M |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DealSlot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DealSlot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DealSlot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SlotStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusSinceHeight", wireType)
+			}
+			m.StatusSinceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatusSinceHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepairTargetGen", wireType)
+			}
+			m.RepairTargetGen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepairTargetGen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepairDeadlineHeight", wireType)
+			}
+			m.RepairDeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepairDeadlineHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepairBounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RepairBounty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManifestRoot = append(m.ManifestRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ManifestRoot == nil {
+				m.ManifestRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+			}
+			m.StartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyMode", wireType)
+			}
+			m.RedundancyMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyMode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReplication", wireType)
+			}
+			m.CurrentReplication = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentReplication |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceHint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceHint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMonthlySpend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMonthlySpend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMdus", wireType)
+			}
+			m.TotalMdus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalMdus |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowStartHeight", wireType)
+			}
+			m.SpendWindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendWindowStartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowSpent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendWindowSpent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode2Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mode2Profile == nil {
+				m.Mode2Profile = &StripeReplicaProfile{}
+			}
+			if err := m.Mode2Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode2Slots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode2Slots = append(m.Mode2Slots, &DealSlot{})
+			if err := m.Mode2Slots[len(m.Mode2Slots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentGen", wireType)
+			}
+			m.CurrentGen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentGen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WitnessMdus", wireType)
+			}
+			m.WitnessMdus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WitnessMdus |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DealStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DealHeatState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DealHeatState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DealHeatState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesServedTotal", wireType)
+			}
+			m.BytesServedTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesServedTotal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedChallengesTotal", wireType)
+			}
+			m.FailedChallengesTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedChallengesTotal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateHeight", wireType)
+			}
+			m.LastUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulRetrievalsTotal", wireType)
+			}
+			m.SuccessfulRetrievalsTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuccessfulRetrievalsTotal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Provider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Provider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Provider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStorage", wireType)
+			}
+			m.TotalStorage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalStorage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedStorage", wireType)
+			}
+			m.UsedStorage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedStorage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capabilities = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputationScore", wireType)
+			}
+			m.ReputationScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReputationScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedStorage", wireType)
+			}
+			m.ReservedStorage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReservedStorage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureDomain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FailureDomain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderFailureDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderFailureDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderFailureDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VirtualStripe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VirtualStripe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VirtualStripe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StripeIndex", wireType)
+			}
+			m.StripeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StripeIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverlayProviders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OverlayProviders = append(m.OverlayProviders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainedProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainedProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainedProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MduIndex", wireType)
+			}
+			m.MduIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MduIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MduRootFr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MduRootFr = append(m.MduRootFr[:0], dAtA[iNdEx:postIndex]...)
+			if m.MduRootFr == nil {
+				m.MduRootFr = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestOpening", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManifestOpening = append(m.ManifestOpening[:0], dAtA[iNdEx:postIndex]...)
+			if m.ManifestOpening == nil {
+				m.ManifestOpening = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlobCommitment = append(m.BlobCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlobCommitment == nil {
+				m.BlobCommitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePath", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerklePath = append(m.MerklePath, make([]byte, postIndex-iNdEx))
+			copy(m.MerklePath[len(m.MerklePath)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobIndex", wireType)
+			}
+			m.BlobIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZValue", wireType)
 			}
-			if iNdEx >= l {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ZValue = append(m.ZValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.ZValue == nil {
+				m.ZValue = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StripeReplicaProfile: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StripeReplicaProfile: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field K", wireType)
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YValue", wireType)
 			}
-			m.K = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2876,16 +6694,31 @@ func (m *StripeReplicaProfile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.K |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field M", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.M = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YValue = append(m.YValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.YValue == nil {
+				m.YValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KzgOpeningProof", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2895,11 +6728,26 @@ func (m *StripeReplicaProfile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.M |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KzgOpeningProof = append(m.KzgOpeningProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.KzgOpeningProof == nil {
+				m.KzgOpeningProof = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2921,7 +6769,7 @@ func (m *StripeReplicaProfile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DealSlot) Unmarshal(dAtA []byte) error {
+func (m *RetrievalSession) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2944,17 +6792,17 @@ func (m *DealSlot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DealSlot: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetrievalSession: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DealSlot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetrievalSession: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
 			}
-			m.Slot = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2964,14 +6812,48 @@ func (m *DealSlot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = append(m.SessionId[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionId == nil {
+				m.SessionId = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2999,13 +6881,13 @@ func (m *DealSlot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3015,16 +6897,29 @@ func (m *DealSlot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= SlotStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestRoot", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3034,29 +6929,31 @@ func (m *DealSlot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingProvider = string(dAtA[iNdEx:postIndex])
+			m.ManifestRoot = append(m.ManifestRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ManifestRoot == nil {
+				m.ManifestRoot = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusSinceHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartMduIndex", wireType)
 			}
-			m.StatusSinceHeight = 0
+			m.StartMduIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3066,16 +6963,16 @@ func (m *DealSlot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StatusSinceHeight |= int64(b&0x7F) << shift
+				m.StartMduIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepairTargetGen", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlobIndex", wireType)
 			}
-			m.RepairTargetGen = 0
+			m.StartBlobIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3085,66 +6982,16 @@ func (m *DealSlot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RepairTargetGen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Deal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Deal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+				m.StartBlobIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobCount", wireType)
 			}
-			m.Id = 0
+			m.BlobCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3154,16 +7001,16 @@ func (m *Deal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.BlobCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManifestRoot", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
 			}
-			var byteLen int
+			m.TotalBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3173,31 +7020,16 @@ func (m *Deal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.TotalBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ManifestRoot = append(m.ManifestRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.ManifestRoot == nil {
-				m.ManifestRoot = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.Size_ = 0
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3207,16 +7039,16 @@ func (m *Deal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Size_ |= uint64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var stringLen uint64
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3226,29 +7058,16 @@ func (m *Deal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpiresAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowBalance", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenedHeight", wireType)
 			}
-			var stringLen uint64
+			m.OpenedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3258,31 +7077,16 @@ func (m *Deal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OpenedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.EscrowBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
 			}
-			m.StartBlock = 0
+			m.UpdatedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3292,16 +7096,16 @@ func (m *Deal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartBlock |= uint64(b&0x7F) << shift
+				m.UpdatedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.EndBlock = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3311,14 +7115,14 @@ func (m *Deal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndBlock |= uint64(b&0x7F) << shift
+				m.Status |= RetrievalSessionStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3346,32 +7150,65 @@ func (m *Deal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Providers = append(m.Providers, string(dAtA[iNdEx:postIndex]))
+			if err := m.LockedFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyMode", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.RedundancyMode = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RedundancyMode |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 10:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetrievalReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetrievalReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetrievalReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReplication", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
 			}
-			m.CurrentReplication = 0
+			m.DealId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3381,16 +7218,16 @@ func (m *Deal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentReplication |= uint64(b&0x7F) << shift
+				m.DealId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceHint", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
 			}
-			var stringLen uint64
+			m.EpochId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3400,27 +7237,14 @@ func (m *Deal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ServiceHint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMonthlySpend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3448,15 +7272,13 @@ func (m *Deal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxMonthlySpend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalMdus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesServed", wireType)
 			}
-			m.TotalMdus = 0
+			m.BytesServed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3466,16 +7288,16 @@ func (m *Deal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalMdus |= uint64(b&0x7F) << shift
+				m.BytesServed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowStartHeight", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofDetails", wireType)
 			}
-			m.SpendWindowStartHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3485,16 +7307,30 @@ func (m *Deal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpendWindowStartHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowSpent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserSignature", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3504,31 +7340,31 @@ func (m *Deal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpendWindowSpent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.UserSignature = append(m.UserSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.UserSignature == nil {
+				m.UserSignature = []byte{}
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode2Profile", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var msglen int
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3538,33 +7374,35 @@ func (m *Deal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Mode2Profile == nil {
-				m.Mode2Profile = &StripeReplicaProfile{}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			if err := m.Mode2Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 18:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode2Slots", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FilePath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3574,31 +7412,29 @@ func (m *Deal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mode2Slots = append(m.Mode2Slots, &DealSlot{})
-			if err := m.Mode2Slots[len(m.Mode2Slots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FilePath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentGen", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeStart", wireType)
 			}
-			m.CurrentGen = 0
+			m.RangeStart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3608,16 +7444,16 @@ func (m *Deal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentGen |= uint64(b&0x7F) << shift
+				m.RangeStart |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WitnessMdus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeLen", wireType)
 			}
-			m.WitnessMdus = 0
+			m.RangeLen = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3627,7 +7463,7 @@ func (m *Deal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WitnessMdus |= uint64(b&0x7F) << shift
+				m.RangeLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3653,7 +7489,7 @@ func (m *Deal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DealHeatState) Unmarshal(dAtA []byte) error {
+func (m *RetrievalReceiptBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3676,36 +7512,17 @@ func (m *DealHeatState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DealHeatState: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetrievalReceiptBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DealHeatState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetrievalReceiptBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BytesServedTotal", wireType)
-			}
-			m.BytesServedTotal = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BytesServedTotal |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedChallengesTotal", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
 			}
-			m.FailedChallengesTotal = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3715,49 +7532,26 @@ func (m *DealHeatState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FailedChallengesTotal |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateHeight", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.LastUpdateHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastUpdateHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulRetrievalsTotal", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SuccessfulRetrievalsTotal = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SuccessfulRetrievalsTotal |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Receipts = append(m.Receipts, RetrievalReceipt{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3779,7 +7573,7 @@ func (m *DealHeatState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Provider) Unmarshal(dAtA []byte) error {
+func (m *DownloadSessionReceipt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3802,15 +7596,53 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Provider: wiretype end group for non-group")
+			return fmt.Errorf("proto: DownloadSessionReceipt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Provider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DownloadSessionReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3838,13 +7670,45 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalStorage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
 			}
-			m.TotalStorage = 0
+			m.TotalBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3854,16 +7718,16 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalStorage |= uint64(b&0x7F) << shift
+				m.TotalBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsedStorage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkCount", wireType)
 			}
-			m.UsedStorage = 0
+			m.ChunkCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3873,16 +7737,16 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UsedStorage |= uint64(b&0x7F) << shift
+				m.ChunkCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkLeafRoot", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3892,29 +7756,31 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Capabilities = string(dAtA[iNdEx:postIndex])
+			m.ChunkLeafRoot = append(m.ChunkLeafRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChunkLeafRoot == nil {
+				m.ChunkLeafRoot = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserSignature", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3924,29 +7790,31 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.UserSignature = append(m.UserSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.UserSignature == nil {
+				m.UserSignature = []byte{}
+			}
 			iNdEx = postIndex
-		case 6:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputationScore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.ReputationScore = 0
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3956,16 +7824,16 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReputationScore |= int64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var stringLen uint64
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3975,24 +7843,11 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpiresAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Endpoints = append(m.Endpoints, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4014,7 +7869,7 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VirtualStripe) Unmarshal(dAtA []byte) error {
+func (m *SessionChunkProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4037,17 +7892,17 @@ func (m *VirtualStripe) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VirtualStripe: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionChunkProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VirtualStripe: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionChunkProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeStart", wireType)
 			}
-			m.DealId = 0
+			m.RangeStart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4057,16 +7912,16 @@ func (m *VirtualStripe) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DealId |= uint64(b&0x7F) << shift
+				m.RangeStart |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StripeIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeLen", wireType)
 			}
-			m.StripeIndex = 0
+			m.RangeLen = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4076,16 +7931,16 @@ func (m *VirtualStripe) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StripeIndex |= uint32(b&0x7F) << shift
+				m.RangeLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OverlayProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofDetails", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4095,23 +7950,75 @@ func (m *VirtualStripe) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OverlayProviders = append(m.OverlayProviders, string(dAtA[iNdEx:postIndex]))
+			if err := m.ProofDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafIndex", wireType)
+			}
+			m.LeafIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeafIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePath", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerklePath = append(m.MerklePath, make([]byte, postIndex-iNdEx))
+			copy(m.MerklePath[len(m.MerklePath)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4134,7 +8041,7 @@ func (m *VirtualStripe) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainedProof) Unmarshal(dAtA []byte) error {
+func (m *RetrievalSessionProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4157,36 +8064,17 @@ func (m *ChainedProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainedProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetrievalSessionProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainedProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetrievalSessionProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MduIndex", wireType)
-			}
-			m.MduIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MduIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MduRootFr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionReceipt", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4196,31 +8084,30 @@ func (m *ChainedProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MduRootFr = append(m.MduRootFr[:0], dAtA[iNdEx:postIndex]...)
-			if m.MduRootFr == nil {
-				m.MduRootFr = []byte{}
+			if err := m.SessionReceipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManifestOpening", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4230,31 +8117,81 @@ func (m *ChainedProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ManifestOpening = append(m.ManifestOpening[:0], dAtA[iNdEx:postIndex]...)
-			if m.ManifestOpening == nil {
-				m.ManifestOpening = []byte{}
+			m.Chunks = append(m.Chunks, SessionChunkProof{})
+			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochQuotaState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlobCommitment", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochQuotaState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochQuotaState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditsBlobs", wireType)
 			}
-			var byteLen int
+			m.CreditsBlobs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4264,31 +8201,16 @@ func (m *ChainedProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.CreditsBlobs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlobCommitment = append(m.BlobCommitment[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlobCommitment == nil {
-				m.BlobCommitment = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerklePath", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyntheticSatisfiedBlobs", wireType)
 			}
-			var byteLen int
+			m.SyntheticSatisfiedBlobs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4298,29 +8220,66 @@ func (m *ChainedProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.SyntheticSatisfiedBlobs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MerklePath = append(m.MerklePath, make([]byte, postIndex-iNdEx))
-			copy(m.MerklePath[len(m.MerklePath)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChallengePosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChallengePosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChallengePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlobIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordinal", wireType)
 			}
-			m.BlobIndex = 0
+			m.Ordinal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4330,16 +8289,16 @@ func (m *ChainedProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlobIndex |= uint32(b&0x7F) << shift
+				m.Ordinal |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZValue", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MduIndex", wireType)
 			}
-			var byteLen int
+			m.MduIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4349,31 +8308,16 @@ func (m *ChainedProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.MduIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ZValue = append(m.ZValue[:0], dAtA[iNdEx:postIndex]...)
-			if m.ZValue == nil {
-				m.ZValue = []byte{}
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YValue", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobIndex", wireType)
 			}
-			var byteLen int
+			m.BlobIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4383,31 +8327,16 @@ func (m *ChainedProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.BlobIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.YValue = append(m.YValue[:0], dAtA[iNdEx:postIndex]...)
-			if m.YValue == nil {
-				m.YValue = []byte{}
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KzgOpeningProof", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Satisfied", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4417,26 +8346,12 @@ func (m *ChainedProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KzgOpeningProof = append(m.KzgOpeningProof[:0], dAtA[iNdEx:postIndex]...)
-			if m.KzgOpeningProof == nil {
-				m.KzgOpeningProof = []byte{}
-			}
-			iNdEx = postIndex
+			m.Satisfied = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4458,7 +8373,7 @@ func (m *ChainedProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RetrievalSession) Unmarshal(dAtA []byte) error {
+func (m *ProviderUnbonding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4481,17 +8396,17 @@ func (m *RetrievalSession) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RetrievalSession: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProviderUnbonding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetrievalSession: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProviderUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4501,31 +8416,29 @@ func (m *RetrievalSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionId = append(m.SessionId[:0], dAtA[iNdEx:postIndex]...)
-			if m.SessionId == nil {
-				m.SessionId = []byte{}
-			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionHeight", wireType)
 			}
-			m.DealId = 0
+			m.CompletionHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4535,16 +8448,16 @@ func (m *RetrievalSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DealId |= uint64(b&0x7F) << shift
+				m.CompletionHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4554,61 +8467,80 @@ func (m *RetrievalSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManifestRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4618,31 +8550,29 @@ func (m *RetrievalSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ManifestRoot = append(m.ManifestRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.ManifestRoot == nil {
-				m.ManifestRoot = []byte{}
-			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartMduIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
 			}
-			m.StartMduIndex = 0
+			m.DealId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4652,16 +8582,16 @@ func (m *RetrievalSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartMduIndex |= uint64(b&0x7F) << shift
+				m.DealId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartBlobIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
 			}
-			m.StartBlobIndex = 0
+			m.Slot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4671,16 +8601,16 @@ func (m *RetrievalSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartBlobIndex |= uint32(b&0x7F) << shift
+				m.Slot |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlobCount", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replacement", wireType)
 			}
-			m.BlobCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4690,35 +8620,29 @@ func (m *RetrievalSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlobCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.TotalBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 10:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replacement = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionHeight", wireType)
 			}
-			m.Nonce = 0
+			m.CompletionHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4728,73 +8652,66 @@ func (m *RetrievalSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.CompletionHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ExpiresAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpiresAt |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenedHeight", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.OpenedHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OpenedHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DealPlacement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpdatedHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 14:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DealPlacement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DealPlacement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
 			}
-			m.Status = 0
+			m.DealId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4804,16 +8721,16 @@ func (m *RetrievalSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= RetrievalSessionStatus(b&0x7F) << shift
+				m.DealId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockedFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Choices", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4823,23 +8740,23 @@ func (m *RetrievalSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LockedFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Choices = append(m.Choices, PlacementChoice{})
+			if err := m.Choices[len(m.Choices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4864,7 +8781,7 @@ func (m *RetrievalSession) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RetrievalReceipt) Unmarshal(dAtA []byte) error {
+func (m *PlacementChoice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4887,17 +8804,17 @@ func (m *RetrievalReceipt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RetrievalReceipt: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlacementChoice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetrievalReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlacementChoice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
-			m.DealId = 0
+			m.Position = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4907,31 +8824,12 @@ func (m *RetrievalReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DealId |= uint64(b&0x7F) << shift
+				m.Position |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
-			}
-			m.EpochId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
@@ -4963,11 +8861,11 @@ func (m *RetrievalReceipt) Unmarshal(dAtA []byte) error {
 			}
 			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BytesServed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.BytesServed = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4977,16 +8875,16 @@ func (m *RetrievalReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BytesServed |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofDetails", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4996,28 +8894,29 @@ func (m *RetrievalReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5044,16 +8943,35 @@ func (m *RetrievalReceipt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserSignature = append(m.UserSignature[:0], dAtA[iNdEx:postIndex]...)
-			if m.UserSignature == nil {
-				m.UserSignature = []byte{}
+			m.Score = append(m.Score[:0], dAtA[iNdEx:postIndex]...)
+			if m.Score == nil {
+				m.Score = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
+			}
+			m.Rank = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rank |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
 			}
-			m.Nonce = 0
+			m.Candidates = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5063,16 +8981,16 @@ func (m *RetrievalReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.Candidates |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedForDomain", wireType)
 			}
-			m.ExpiresAt = 0
+			m.SkippedForDomain = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5082,16 +9000,16 @@ func (m *RetrievalReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiresAt |= uint64(b&0x7F) << shift
+				m.SkippedForDomain |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FilePath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureDomain", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5101,29 +9019,30 @@ func (m *RetrievalReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FilePath = string(dAtA[iNdEx:postIndex])
+			if err := m.FailureDomain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RangeStart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainLimit", wireType)
 			}
-			m.RangeStart = 0
+			m.DomainLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5133,16 +9052,16 @@ func (m *RetrievalReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RangeStart |= uint64(b&0x7F) << shift
+				m.DomainLimit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RangeLen", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainLimitRelaxed", wireType)
 			}
-			m.RangeLen = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5152,11 +9071,12 @@ func (m *RetrievalReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RangeLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DomainLimitRelaxed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -5178,7 +9098,7 @@ func (m *RetrievalReceipt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RetrievalReceiptBatch) Unmarshal(dAtA []byte) error {
+func (m *DealAccessGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5201,17 +9121,36 @@ func (m *RetrievalReceiptBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RetrievalReceiptBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: DealAccessGrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetrievalReceiptBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DealAccessGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+			}
+			m.DealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5221,81 +9160,48 @@ func (m *RetrievalReceiptBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receipts = append(m.Receipts, RetrievalReceipt{})
-			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DownloadSessionReceipt) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DownloadSessionReceipt: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DownloadSessionReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
 			}
-			m.DealId = 0
+			m.MaxBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5305,16 +9211,16 @@ func (m *DownloadSessionReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DealId |= uint64(b&0x7F) << shift
+				m.MaxBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesUsed", wireType)
 			}
-			m.EpochId = 0
+			m.BytesUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5324,14 +9230,14 @@ func (m *DownloadSessionReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochId |= uint64(b&0x7F) << shift
+				m.BytesUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5359,11 +9265,13 @@ func (m *DownloadSessionReceipt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FilePath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesUsed", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5391,13 +9299,122 @@ func (m *DownloadSessionReceipt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FilePath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			if err := m.FeesUsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantedHeight", wireType)
+			}
+			m.GrantedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GrantedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Evidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= EvidenceKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DealId", wireType)
 			}
-			m.TotalBytes = 0
+			m.DealId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5407,16 +9424,16 @@ func (m *DownloadSessionReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalBytes |= uint64(b&0x7F) << shift
+				m.DealId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChunkCount", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			m.ChunkCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5426,14 +9443,27 @@ func (m *DownloadSessionReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChunkCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChunkLeafRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5460,16 +9490,16 @@ func (m *DownloadSessionReceipt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChunkLeafRoot = append(m.ChunkLeafRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.ChunkLeafRoot == nil {
-				m.ChunkLeafRoot = []byte{}
+			m.SessionId = append(m.SessionId[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionId == nil {
+				m.SessionId = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5479,31 +9509,29 @@ func (m *DownloadSessionReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserSignature = append(m.UserSignature[:0], dAtA[iNdEx:postIndex]...)
-			if m.UserSignature == nil {
-				m.UserSignature = []byte{}
-			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Nonce = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5513,16 +9541,16 @@ func (m *DownloadSessionReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
 			}
-			m.ExpiresAt = 0
+			m.Outcome = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5532,7 +9560,7 @@ func (m *DownloadSessionReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiresAt |= uint64(b&0x7F) << shift
+				m.Outcome |= EvidenceOutcome(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5558,7 +9586,7 @@ func (m *DownloadSessionReceipt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionChunkProof) Unmarshal(dAtA []byte) error {
+func (m *SessionKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5581,17 +9609,17 @@ func (m *SessionChunkProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionChunkProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionChunkProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RangeStart", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.RangeStart = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5601,16 +9629,29 @@ func (m *SessionChunkProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RangeStart |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RangeLen", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			m.RangeLen = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5620,16 +9661,105 @@ func (m *SessionChunkProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RangeLen |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofDetails", wireType)
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DealIds = append(m.DealIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DealIds) == 0 {
+					m.DealIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DealIds = append(m.DealIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealIds", wireType)
 			}
-			var msglen int
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5639,30 +9769,35 @@ func (m *SessionChunkProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExpiresAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
 			}
-			if err := m.ProofDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeafIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesUsed", wireType)
 			}
-			m.LeafIndex = 0
+			m.BytesUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5672,16 +9807,16 @@ func (m *SessionChunkProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LeafIndex |= uint32(b&0x7F) << shift
+				m.BytesUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerklePath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5691,79 +9826,31 @@ func (m *SessionChunkProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MerklePath = append(m.MerklePath, make([]byte, postIndex-iNdEx))
-			copy(m.MerklePath[len(m.MerklePath)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RetrievalSessionProof) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RetrievalSessionProof: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetrievalSessionProof: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionReceipt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesUsed", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5773,30 +9860,31 @@ func (m *RetrievalSessionProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SessionReceipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeesUsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredHeight", wireType)
 			}
-			var msglen int
+			m.RegisteredHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5806,26 +9894,11 @@ func (m *RetrievalSessionProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RegisteredHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Chunks = append(m.Chunks, SessionChunkProof{})
-			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])